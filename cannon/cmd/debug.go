@@ -0,0 +1,324 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+)
+
+var (
+	DebugInputFlag = &cli.PathFlag{
+		Name:      "input",
+		Usage:     "path of the state snapshot to debug, in JSON or the binary encoding, gzip or zstd compressed if it ends with .gz or .zst. Stdin is read by the debugger, so the path must not be empty.",
+		TakesFile: true,
+		Value:     "state.json",
+		Required:  true,
+	}
+	DebugMetaFlag = &cli.PathFlag{
+		Name:     "meta",
+		Usage:    "path to metadata file for symbol lookup, to show the function of every instruction.",
+		Value:    "meta.json",
+		Required: false,
+	}
+	DebugCheckpointFreqFlag = &cli.Uint64Flag{
+		Name:  "checkpoint-freq",
+		Usage: "number of steps between the checkpoints that stepping back restores, doubled whenever there are more than --max-checkpoints.",
+		Value: 100_000,
+	}
+	DebugMaxCheckpointsFlag = &cli.IntFlag{
+		Name:  "max-checkpoints",
+		Usage: "maximum number of checkpoints to hold in memory.",
+		Value: 100,
+	}
+)
+
+const debugHelp = `Commands:
+  step, s [n]            execute n steps (default 1)
+  continue, c            execute until a breakpoint, a watchpoint or the program exits
+  reverse-step, rs [n]   go back n steps (default 1)
+  break, b <addr>        stop when the PC reaches addr
+  watch, w <addr>        stop when the memory word at addr changes
+  delete, d <addr>       remove the breakpoint and the watchpoint at addr
+  breakpoints, bl        list the breakpoints and the watchpoints
+  info, i                show the step, PC and the current instruction
+  regs, r                show the registers
+  mem, x <addr> [n]      show n memory words from addr (default 8)
+  disasm, di [addr] [n]  disassemble n instructions from addr (default the PC, 10)
+  dump <path>            write the current state to path
+  help, h                show this help
+  quit, q                exit the debugger
+Addresses and counts may be decimal or 0x prefixed hexadecimal.`
+
+// debugREPL reads the debugger commands from in, one per line, and writes their output to out.
+type debugREPL struct {
+	d    *Debugger
+	meta *mipsevm.Metadata
+	out  io.Writer
+}
+
+var errQuit = errors.New("quit")
+
+func (r *debugREPL) run(ctx context.Context, in io.Reader) error {
+	scanner := bufio.NewScanner(in)
+	r.info()
+	for {
+		fmt.Fprint(r.out, "(cannon) ")
+		if !scanner.Scan() {
+			fmt.Fprintln(r.out)
+			return scanner.Err()
+		}
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if err := r.exec(ctx, fields[0], fields[1:]); errors.Is(err, errQuit) {
+			return nil
+		} else if err != nil && errors.Is(err, ctx.Err()) {
+			return err
+		} else if err != nil {
+			fmt.Fprintf(r.out, "error: %v\n", err)
+		}
+	}
+}
+
+func (r *debugREPL) exec(ctx context.Context, cmd string, args []string) error {
+	switch cmd {
+	case "step", "s":
+		n, err := optionalNumber(args, 0, 1)
+		if err != nil {
+			return err
+		}
+		if err := r.d.Step(n); err != nil {
+			return err
+		}
+		r.info()
+	case "continue", "c":
+		reason, err := r.d.Continue(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(r.out, "stopped: %s\n", reason)
+		r.info()
+	case "reverse-step", "rs":
+		n, err := optionalNumber(args, 0, 1)
+		if err != nil {
+			return err
+		}
+		if err := r.d.ReverseStep(n); err != nil {
+			return err
+		}
+		r.info()
+	case "break", "b", "watch", "w", "delete", "d":
+		if len(args) != 1 {
+			return fmt.Errorf("%s requires an address", cmd)
+		}
+		addr, err := parseAddress(args[0])
+		if err != nil {
+			return err
+		}
+		switch cmd {
+		case "break", "b":
+			r.d.AddBreakpoint(addr)
+		case "watch", "w":
+			r.d.AddWatchpoint(addr)
+		default:
+			if !r.d.Delete(addr) {
+				return fmt.Errorf("no breakpoint or watchpoint at %08x", addr)
+			}
+		}
+	case "breakpoints", "bl":
+		breakpoints, watchpoints := r.d.Breakpoints()
+		for _, addr := range breakpoints {
+			fmt.Fprintf(r.out, "break %08x %s\n", addr, r.meta.LookupSymbol(addr))
+		}
+		for _, addr := range watchpoints {
+			fmt.Fprintf(r.out, "watch %08x\n", addr)
+		}
+	case "info", "i":
+		r.info()
+	case "regs", "r":
+		r.regs()
+	case "mem", "x":
+		if len(args) == 0 {
+			return fmt.Errorf("%s requires an address", cmd)
+		}
+		addr, err := parseAddress(args[0])
+		if err != nil {
+			return err
+		}
+		n, err := optionalNumber(args, 1, 8)
+		if err != nil {
+			return err
+		}
+		addr &^= 3
+		for i := uint64(0); i < n; i++ {
+			if i%4 == 0 {
+				if i > 0 {
+					fmt.Fprintln(r.out)
+				}
+				fmt.Fprintf(r.out, "%08x:", addr)
+			}
+			fmt.Fprintf(r.out, " %08x", r.d.State().Memory.GetMemory(addr))
+			addr += 4
+		}
+		fmt.Fprintln(r.out)
+	case "disasm", "di":
+		addr := r.d.State().PC
+		if len(args) > 0 {
+			var err error
+			if addr, err = parseAddress(args[0]); err != nil {
+				return err
+			}
+		}
+		n, err := optionalNumber(args, 1, 10)
+		if err != nil {
+			return err
+		}
+		addr &^= 3
+		for i := uint64(0); i < n; i++ {
+			r.disasm(addr)
+			addr += 4
+		}
+	case "dump":
+		if len(args) != 1 {
+			return errors.New("dump requires a path")
+		}
+		return writeState(args[0], r.d.State())
+	case "help", "h":
+		fmt.Fprintln(r.out, debugHelp)
+	case "quit", "q":
+		return errQuit
+	default:
+		return fmt.Errorf("unknown command %q, see help", cmd)
+	}
+	return nil
+}
+
+func (r *debugREPL) info() {
+	state := r.d.State()
+	status := "running"
+	if state.Exited {
+		status = fmt.Sprintf("exited with code %d", state.ExitCode)
+	}
+	fmt.Fprintf(r.out, "step %d, %s\n", state.Step, status)
+	r.disasm(state.PC)
+}
+
+func (r *debugREPL) disasm(addr uint32) {
+	marker := " "
+	if addr == r.d.State().PC {
+		marker = ">"
+	}
+	insn := r.d.State().Memory.GetMemory(addr)
+	fmt.Fprintf(r.out, "%s %08x: %08x  %-32s <%s>\n", marker, addr, insn, mipsevm.Disassemble(addr, insn), r.meta.LookupSymbol(addr))
+}
+
+func (r *debugREPL) regs() {
+	state := r.d.State()
+	for i, value := range state.Registers {
+		fmt.Fprintf(r.out, "%5s %08x", "$"+mipsevm.RegisterName(uint32(i)), value)
+		if i%4 == 3 {
+			fmt.Fprintln(r.out)
+		} else {
+			fmt.Fprint(r.out, "  ")
+		}
+	}
+	fmt.Fprintf(r.out, "   pc %08x  nextPC %08x     hi %08x     lo %08x\n", state.PC, state.NextPC, state.HI, state.LO)
+	fmt.Fprintf(r.out, " heap %08x\n", state.Heap)
+}
+
+func parseAddress(s string) (uint32, error) {
+	addr, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid address %q: %w", s, err)
+	}
+	return uint32(addr), nil
+}
+
+// optionalNumber parses the argument at index i, or returns def if there are not enough arguments.
+func optionalNumber(args []string, i int, def uint64) (uint64, error) {
+	if len(args) <= i {
+		return def, nil
+	}
+	n, err := strconv.ParseUint(args[i], 0, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q: %w", args[i], err)
+	}
+	return n, nil
+}
+
+func Debug(ctx *cli.Context) error {
+	inputPath := ctx.Path(DebugInputFlag.Name)
+	if inputPath == "" {
+		return errors.New("the input state must be read from a file, stdin is used for the debugger commands")
+	}
+	state, err := loadState(inputPath)
+	if err != nil {
+		return err
+	}
+
+	meta := &mipsevm.Metadata{Symbols: nil} // provide empty metadata by default
+	if metaPath := ctx.Path(DebugMetaFlag.Name); metaPath != "" {
+		if meta, err = loadJSON[mipsevm.Metadata](metaPath); err != nil {
+			return fmt.Errorf("failed to load metadata: %w", err)
+		}
+	}
+
+	l := Logger(os.Stderr, log.LvlInfo)
+	outLog := &mipsevm.LoggingWriter{Name: "program std-out", Log: l}
+	errLog := &mipsevm.LoggingWriter{Name: "program std-err", Log: l}
+
+	// split CLI args after first '--'
+	args := ctx.Args().Slice()
+	for i, arg := range args {
+		if arg == "--" {
+			args = args[i+1:]
+			break
+		}
+	}
+	if len(args) == 0 {
+		args = []string{""}
+	}
+	po, err := NewProcessPreimageOracle(args[0], args[1:])
+	if err != nil {
+		return fmt.Errorf("failed to create pre-image oracle process: %w", err)
+	}
+	if err := po.Start(); err != nil {
+		return fmt.Errorf("failed to start pre-image oracle server: %w", err)
+	}
+	defer func() {
+		if err := po.Close(); err != nil {
+			l.Error("failed to close pre-image server", "err", err)
+		}
+	}()
+
+	d, err := NewDebugger(state, po, outLog, errLog, ctx.Uint64(DebugCheckpointFreqFlag.Name), ctx.Int(DebugMaxCheckpointsFlag.Name))
+	if err != nil {
+		return err
+	}
+	repl := &debugREPL{d: d, meta: meta, out: ctx.App.Writer}
+	return repl.run(ctx.Context, ctx.App.Reader)
+}
+
+var DebugCommand = &cli.Command{
+	Name:        "debug",
+	Usage:       "Debug a state snapshot interactively, instruction by instruction.",
+	Description: "Load a state snapshot and step through it with breakpoints, memory watchpoints, register and memory inspection, reverse steps and disassembly. Arguments after '--' start the pre-image server, as with run.",
+	Action:      Debug,
+	Flags: []cli.Flag{
+		DebugInputFlag,
+		DebugMetaFlag,
+		DebugCheckpointFreqFlag,
+		DebugMaxCheckpointsFlag,
+	},
+}
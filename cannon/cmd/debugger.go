@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+)
+
+// StopReason describes why the debugger stopped executing.
+type StopReason string
+
+const (
+	StopExited     StopReason = "exited"
+	StopBreakpoint StopReason = "breakpoint"
+	StopWatchpoint StopReason = "watchpoint"
+)
+
+var ErrNoEarlierStep = errors.New("cannot step back before the first checkpoint")
+
+type checkpoint struct {
+	step uint64
+	// data is the binary encoding of the state at the step
+	data []byte
+}
+
+// Debugger steps a state forwards and backwards. Stepping back restores the latest checkpoint before the step,
+// and executes the steps from there, so checkpoints are taken every checkpointFreq steps. When there are more than
+// maxCheckpoints, every other checkpoint is dropped and the steps between them are doubled, so that the whole execution since
+// the debugger started can still be reversed.
+type Debugger struct {
+	state *mipsevm.State
+	us    *mipsevm.InstrumentedState
+
+	po     mipsevm.PreimageOracle
+	stdOut io.Writer
+	stdErr io.Writer
+
+	checkpointFreq uint64
+	maxCheckpoints int
+	checkpoints    []checkpoint
+
+	breakpoints map[uint32]bool
+	// watchpoints holds the value of every watched memory word when it was last checked
+	watchpoints map[uint32]uint32
+}
+
+func NewDebugger(state *mipsevm.State, po mipsevm.PreimageOracle, stdOut, stdErr io.Writer, checkpointFreq uint64, maxCheckpoints int) (*Debugger, error) {
+	if checkpointFreq == 0 {
+		return nil, errors.New("checkpoint frequency must not be 0")
+	}
+	if maxCheckpoints < 2 {
+		return nil, errors.New("at least 2 checkpoints are required")
+	}
+	d := &Debugger{
+		state:          state,
+		us:             mipsevm.NewInstrumentedState(state, po, stdOut, stdErr),
+		po:             po,
+		stdOut:         stdOut,
+		stdErr:         stdErr,
+		checkpointFreq: checkpointFreq,
+		maxCheckpoints: maxCheckpoints,
+		breakpoints:    make(map[uint32]bool),
+		watchpoints:    make(map[uint32]uint32),
+	}
+	if err := d.checkpoint(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// State returns the current state, which is replaced when stepping back.
+func (d *Debugger) State() *mipsevm.State {
+	return d.state
+}
+
+func (d *Debugger) checkpoint() error {
+	if n := len(d.checkpoints); n > 0 && d.checkpoints[n-1].step >= d.state.Step {
+		return nil
+	}
+	var buf bytes.Buffer
+	if err := mipsevm.EncodeStateBinary(&buf, d.state); err != nil {
+		return fmt.Errorf("failed to checkpoint step %d: %w", d.state.Step, err)
+	}
+	d.checkpoints = append(d.checkpoints, checkpoint{step: d.state.Step, data: buf.Bytes()})
+	if len(d.checkpoints) > d.maxCheckpoints {
+		thinned := d.checkpoints[:0]
+		for i, cp := range d.checkpoints {
+			if i%2 == 0 {
+				thinned = append(thinned, cp)
+			}
+		}
+		d.checkpoints = thinned
+		d.checkpointFreq *= 2
+	}
+	return nil
+}
+
+func (d *Debugger) step() error {
+	if d.state.Step-d.checkpoints[len(d.checkpoints)-1].step >= d.checkpointFreq {
+		if err := d.checkpoint(); err != nil {
+			return err
+		}
+	}
+	if _, err := d.us.Step(false); err != nil {
+		return fmt.Errorf("failed at step %d (PC: %08x): %w", d.state.Step, d.state.PC, err)
+	}
+	return nil
+}
+
+// Step executes up to n steps, and stops early if the program exits.
+func (d *Debugger) Step(n uint64) error {
+	for i := uint64(0); i < n && !d.state.Exited; i++ {
+		if err := d.step(); err != nil {
+			return err
+		}
+	}
+	d.updateWatchpoints()
+	return nil
+}
+
+// Continue executes steps until the program exits, the PC reaches a breakpoint, or a watched memory word changes.
+func (d *Debugger) Continue(ctx context.Context) (StopReason, error) {
+	for {
+		if d.state.Exited {
+			return StopExited, nil
+		}
+		if d.state.Step%100 == 0 { // don't do the ctx err check (includes lock) too often
+			if err := ctx.Err(); err != nil {
+				return "", err
+			}
+		}
+		if err := d.step(); err != nil {
+			return "", err
+		}
+		if d.breakpoints[d.state.PC] {
+			d.updateWatchpoints()
+			return StopBreakpoint, nil
+		}
+		if len(d.watchpoints) > 0 && d.watchpointChanged() {
+			d.updateWatchpoints()
+			return StopWatchpoint, nil
+		}
+	}
+}
+
+// ReverseStep restores the state to n steps earlier.
+func (d *Debugger) ReverseStep(n uint64) error {
+	if n > d.state.Step || d.state.Step-n < d.checkpoints[0].step {
+		return fmt.Errorf("%w at step %d", ErrNoEarlierStep, d.checkpoints[0].step)
+	}
+	target := d.state.Step - n
+	i := sort.Search(len(d.checkpoints), func(i int) bool {
+		return d.checkpoints[i].step > target
+	}) - 1
+	state, err := mipsevm.DecodeStateBinary(bytes.NewReader(d.checkpoints[i].data), true)
+	if err != nil {
+		return fmt.Errorf("failed to restore checkpoint at step %d: %w", d.checkpoints[i].step, err)
+	}
+	// The checkpoints after the target are kept, since re-executing the steps reaches the same states.
+	d.state = state
+	d.us = mipsevm.NewInstrumentedState(state, d.po, d.stdOut, d.stdErr)
+	for d.state.Step < target {
+		if _, err := d.us.Step(false); err != nil {
+			return fmt.Errorf("failed to re-execute step %d: %w", d.state.Step, err)
+		}
+	}
+	d.updateWatchpoints()
+	return nil
+}
+
+// AddBreakpoint stops Continue when the PC reaches addr.
+func (d *Debugger) AddBreakpoint(addr uint32) {
+	d.breakpoints[addr] = true
+}
+
+// AddWatchpoint stops Continue when the memory word that holds addr changes.
+func (d *Debugger) AddWatchpoint(addr uint32) {
+	addr &^= 3
+	d.watchpoints[addr] = d.state.Memory.GetMemory(addr)
+}
+
+// Delete removes the breakpoint and the watchpoint at addr, and returns false if there are none.
+func (d *Debugger) Delete(addr uint32) bool {
+	_, isBreakpoint := d.breakpoints[addr]
+	_, isWatchpoint := d.watchpoints[addr&^3]
+	delete(d.breakpoints, addr)
+	delete(d.watchpoints, addr&^3)
+	return isBreakpoint || isWatchpoint
+}
+
+// Breakpoints returns the addresses of the breakpoints and the watchpoints, in ascending order.
+func (d *Debugger) Breakpoints() (breakpoints []uint32, watchpoints []uint32) {
+	for addr := range d.breakpoints {
+		breakpoints = append(breakpoints, addr)
+	}
+	for addr := range d.watchpoints {
+		watchpoints = append(watchpoints, addr)
+	}
+	sort.Slice(breakpoints, func(i, j int) bool { return breakpoints[i] < breakpoints[j] })
+	sort.Slice(watchpoints, func(i, j int) bool { return watchpoints[i] < watchpoints[j] })
+	return breakpoints, watchpoints
+}
+
+func (d *Debugger) watchpointChanged() bool {
+	for addr, value := range d.watchpoints {
+		if d.state.Memory.GetMemory(addr) != value {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Debugger) updateWatchpoints() {
+	for addr := range d.watchpoints {
+		d.watchpoints[addr] = d.state.Memory.GetMemory(addr)
+	}
+}
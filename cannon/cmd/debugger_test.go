@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+)
+
+// counterState counts from 1 to 50 in $t0, stores every count at 0x100, and exits with code 7 after 254 steps.
+func counterState(t *testing.T) *mipsevm.State {
+	program := []uint32{
+		0x24080000, // 0x1000: addiu $t0, $zero, 0
+		0x25080001, // 0x1004: addiu $t0, $t0, 1
+		0xAC080100, // 0x1008: sw $t0, 0x100($zero)
+		0x29090032, // 0x100c: slti $t1, $t0, 50
+		0x1520FFFC, // 0x1010: bne $t1, $zero, 0x1004
+		0x00000000, // 0x1014: nop
+		0x24021096, // 0x1018: addiu $v0, $zero, exit_group
+		0x24040007, // 0x101c: addiu $a0, $zero, 7
+		0x0000000C, // 0x1020: syscall
+	}
+	code := make([]byte, 0, len(program)*4)
+	for _, insn := range program {
+		code = binary.BigEndian.AppendUint32(code, insn)
+	}
+	state := &mipsevm.State{Memory: mipsevm.NewMemory(), PC: 0x1000, NextPC: 0x1004}
+	require.NoError(t, state.Memory.SetMemoryRange(state.PC, bytes.NewReader(code)))
+	return state
+}
+
+func TestDebugger(t *testing.T) {
+	d, err := NewDebugger(counterState(t), nil, nil, nil, 4, 4)
+	require.NoError(t, err)
+
+	require.NoError(t, d.Step(3))
+	require.Equal(t, uint64(3), d.State().Step)
+	require.Equal(t, uint32(0x100c), d.State().PC)
+
+	d.AddBreakpoint(0x1010)
+	reason, err := d.Continue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, StopBreakpoint, reason)
+	require.Equal(t, uint64(4), d.State().Step)
+
+	// continuing from a breakpoint runs until it is reached again
+	reason, err = d.Continue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, StopBreakpoint, reason)
+	require.Equal(t, uint64(9), d.State().Step)
+	require.Equal(t, uint32(2), d.State().Registers[8])
+
+	require.True(t, d.Delete(0x1010))
+	require.False(t, d.Delete(0x1010))
+
+	d.AddWatchpoint(0x102)
+	breakpoints, watchpoints := d.Breakpoints()
+	require.Empty(t, breakpoints)
+	require.Equal(t, []uint32{0x100}, watchpoints)
+	reason, err = d.Continue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, StopWatchpoint, reason)
+	require.Equal(t, uint32(3), d.State().Memory.GetMemory(0x100))
+	require.Equal(t, uint32(0x100c), d.State().PC)
+	require.True(t, d.Delete(0x100))
+
+	reason, err = d.Continue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, StopExited, reason)
+	require.Equal(t, uint64(254), d.State().Step)
+	require.Equal(t, uint8(7), d.State().ExitCode)
+	require.LessOrEqual(t, len(d.checkpoints), 4)
+	require.Greater(t, d.checkpointFreq, uint64(4))
+
+	// stepping back across the thinned checkpoints reaches the same state as stepping forward
+	require.NoError(t, d.ReverseStep(250))
+	expected, err := NewDebugger(counterState(t), nil, nil, nil, 4, 4)
+	require.NoError(t, err)
+	require.NoError(t, expected.Step(4))
+	require.Equal(t, expected.State().EncodeWitness(), d.State().EncodeWitness())
+
+	require.ErrorIs(t, d.ReverseStep(5), ErrNoEarlierStep)
+	require.NoError(t, d.ReverseStep(4))
+	require.Equal(t, uint64(0), d.State().Step)
+	require.Equal(t, uint32(0x1000), d.State().PC)
+
+	// the checkpoints after the restored step are reused when stepping forward again
+	require.NoError(t, d.Step(300))
+	require.True(t, d.State().Exited)
+	require.Equal(t, uint64(254), d.State().Step)
+}
+
+func TestDebugREPL(t *testing.T) {
+	d, err := NewDebugger(counterState(t), nil, nil, nil, 100, 10)
+	require.NoError(t, err)
+	dump := filepath.Join(t.TempDir(), "state.json")
+
+	var out bytes.Buffer
+	repl := &debugREPL{d: d, meta: &mipsevm.Metadata{}, out: &out}
+	in := strings.NewReader(strings.Join([]string{
+		"b 0x1010",
+		"bl",
+		"c",
+		"regs",
+		"x 0x100 1",
+		"",
+		"rs",
+		"di 0x1008 2",
+		"bogus",
+		"s x",
+		"dump " + dump,
+		"q",
+		"step",
+	}, "\n"))
+	require.NoError(t, repl.run(context.Background(), in))
+
+	output := out.String()
+	require.Contains(t, output, "break 00001010")
+	require.Contains(t, output, "stopped: breakpoint")
+	require.Contains(t, output, "$t0 00000001")
+	require.Contains(t, output, "00000100: 00000001\n")
+	require.Contains(t, output, "step 3, running\n> 0000100c: 29090032  slti $t1, $t0, 50")
+	require.Contains(t, output, "  00001008: ac080100  sw $t0, 256($zero)")
+	require.Contains(t, output, `error: unknown command "bogus"`)
+	require.Contains(t, output, `error: invalid number "x"`)
+	require.Equal(t, uint64(3), d.State().Step, "commands after quit are not executed")
+
+	dumped, err := loadState(dump)
+	require.NoError(t, err)
+	require.Equal(t, d.State().EncodeWitness(), dumped.EncodeWitness())
+}
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+)
+
+var (
+	DiffMaxWordsFlag = &cli.IntFlag{
+		Name:  "max-words",
+		Usage: "maximum number of changed words to print per memory page, 0 for all.",
+		Value: 16,
+	}
+	DiffMetaFlag = &cli.PathFlag{
+		Name:  "meta",
+		Usage: "path to metadata file for symbol lookup of the PCs. None if empty.",
+	}
+)
+
+var ErrStatesDiffer = errors.New("states differ")
+
+// stateDiff writes the differences between two states to out.
+type stateDiff struct {
+	out      io.Writer
+	meta     *mipsevm.Metadata
+	maxWords int
+	count    int
+}
+
+func (d *stateDiff) field(name string, a, b any) {
+	if a == b {
+		return
+	}
+	d.count++
+	fmt.Fprintf(d.out, "%-16s %v -> %v\n", name, a, b)
+}
+
+func (d *stateDiff) pc(name string, a, b uint32) {
+	if a == b {
+		return
+	}
+	d.count++
+	fmt.Fprintf(d.out, "%-16s %08x <%s> -> %08x <%s>\n", name, a, d.meta.LookupSymbol(a), b, d.meta.LookupSymbol(b))
+}
+
+func (d *stateDiff) diff(a, b *mipsevm.State) {
+	d.field("step", a.Step, b.Step)
+	d.field("exited", a.Exited, b.Exited)
+	d.field("exit code", a.ExitCode, b.ExitCode)
+	d.pc("pc", a.PC, b.PC)
+	d.pc("next pc", a.NextPC, b.NextPC)
+	d.field("lo", mipsevm.HexU32(a.LO), mipsevm.HexU32(b.LO))
+	d.field("hi", mipsevm.HexU32(a.HI), mipsevm.HexU32(b.HI))
+	d.field("heap", mipsevm.HexU32(a.Heap), mipsevm.HexU32(b.Heap))
+	for i := range a.Registers {
+		d.field(fmt.Sprintf("$%s (r%d)", mipsevm.RegisterName(uint32(i)), i), mipsevm.HexU32(a.Registers[i]), mipsevm.HexU32(b.Registers[i]))
+	}
+	d.field("preimage key", a.PreimageKey, b.PreimageKey)
+	d.field("preimage offset", a.PreimageOffset, b.PreimageOffset)
+	d.field("last hint", a.LastHint.String(), b.LastHint.String())
+	d.field("threading", threadingSummary(a.Threading), threadingSummary(b.Threading))
+	d.memory(a.Memory, b.Memory)
+}
+
+func threadingSummary(t *mipsevm.Threading) string {
+	if t == nil {
+		return "single-threaded"
+	}
+	return fmt.Sprintf("thread %d of %d, hash %s", t.ThreadID, len(t.Threads)+1, t.Hash())
+}
+
+func (d *stateDiff) memory(a, b *mipsevm.Memory) {
+	pagesA, pagesB := pagesByIndex(a), pagesByIndex(b)
+	indices := make([]uint32, 0, len(pagesA))
+	for index := range pagesA {
+		indices = append(indices, index)
+	}
+	for index := range pagesB {
+		if _, ok := pagesA[index]; !ok {
+			indices = append(indices, index)
+		}
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+
+	var zero mipsevm.Page
+	for _, index := range indices {
+		pageA, okA := pagesA[index]
+		pageB, okB := pagesB[index]
+		if !okA {
+			pageA = &zero
+		}
+		if !okB {
+			pageB = &zero
+		}
+		if *pageA == *pageB {
+			continue
+		}
+		d.count++
+		start := index << mipsevm.PageAddrSize
+		var changed []uint32
+		for offset := uint32(0); offset < mipsevm.PageSize; offset += 4 {
+			if a.GetMemory(start+offset) != b.GetMemory(start+offset) {
+				changed = append(changed, start+offset)
+			}
+		}
+		status := ""
+		if !okA {
+			status = ", only allocated in the second state"
+		} else if !okB {
+			status = ", only allocated in the first state"
+		}
+		fmt.Fprintf(d.out, "page %08x-%08x: %d words differ%s\n", start, start+mipsevm.PageSize-1, len(changed), status)
+		for i, addr := range changed {
+			if d.maxWords > 0 && i == d.maxWords {
+				fmt.Fprintf(d.out, "  ... %d more words\n", len(changed)-i)
+				break
+			}
+			fmt.Fprintf(d.out, "  %08x: %08x -> %08x\n", addr, a.GetMemory(addr), b.GetMemory(addr))
+		}
+	}
+}
+
+func pagesByIndex(m *mipsevm.Memory) map[uint32]*mipsevm.Page {
+	pages := make(map[uint32]*mipsevm.Page, m.PageCount())
+	_ = m.ForEachPage(func(pageIndex uint32, page *mipsevm.Page) error {
+		pages[pageIndex] = page
+		return nil
+	})
+	return pages
+}
+
+func Diff(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return errors.New("expected the paths of two states")
+	}
+	a, err := loadState(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	b, err := loadState(ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+	meta := &mipsevm.Metadata{Symbols: nil} // provide empty metadata by default
+	if metaPath := ctx.Path(DiffMetaFlag.Name); metaPath != "" {
+		if meta, err = loadJSON[mipsevm.Metadata](metaPath); err != nil {
+			return fmt.Errorf("failed to load metadata: %w", err)
+		}
+	}
+	d := &stateDiff{out: ctx.App.Writer, meta: meta, maxWords: ctx.Int(DiffMaxWordsFlag.Name)}
+	d.diff(a, b)
+	if d.count > 0 {
+		return fmt.Errorf("%w in %d fields and pages", ErrStatesDiffer, d.count)
+	}
+	fmt.Fprintln(d.out, "states are equal")
+	return nil
+}
+
+var DiffCommand = &cli.Command{
+	Name:        "diff",
+	Usage:       "Compare two states",
+	Description: "Compare two states, in JSON or the binary encoding, and print the fields, registers and memory words that differ. Exits with an error if the states differ.",
+	ArgsUsage:   "<stateA> <stateB>",
+	Action:      Diff,
+	Flags: []cli.Flag{
+		DiffMaxWordsFlag,
+		DiffMetaFlag,
+	},
+}
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+)
+
+func TestDiffStates(t *testing.T) {
+	a := counterState(t)
+	b := counterState(t)
+	var out bytes.Buffer
+	d := &stateDiff{out: &out, meta: &mipsevm.Metadata{}, maxWords: 2}
+	d.diff(a, b)
+	require.Zero(t, d.count)
+	require.Empty(t, out.String())
+
+	us := mipsevm.NewInstrumentedState(b, nil, nil, nil)
+	for i := 0; i < 3; i++ {
+		_, err := us.Step(false)
+		require.NoError(t, err)
+	}
+	b.Memory.SetMemory(0x1000, 1)
+	b.Memory.SetMemory(0x1004, 2)
+	b.Memory.SetMemory(0x1008, 3)
+	d.diff(a, b)
+	output := out.String()
+	require.Equal(t, 6, d.count, output)
+	require.Contains(t, output, "step             0 -> 3\n")
+	require.Contains(t, output, "pc               00001000 <!unknown> -> 0000100c <!unknown>\n")
+	require.Contains(t, output, "$t0 (r8)         00000000 -> 00000001\n")
+	require.Contains(t, output, "page 00000000-00000fff: 1 words differ, only allocated in the second state\n  00000100: 00000000 -> 00000001\n")
+	require.Contains(t, output, "page 00001000-00001fff: 3 words differ\n  00001000: 24080000 -> 00000001\n  00001004: 25080001 -> 00000002\n  ... 1 more words\n")
+	require.False(t, strings.Contains(output, "heap"))
+}
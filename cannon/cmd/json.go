@@ -7,7 +7,9 @@ import (
 	"io"
 	"os"
 	"path"
+	"strings"
 
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
 	"github.com/ethereum-optimism/optimism/op-service/ioutil"
 )
 
@@ -28,7 +30,57 @@ func loadJSON[X any](inputPath string) (*X, error) {
 	return &state, nil
 }
 
+// loadState loads the state at inputPath, in either the binary or the JSON encoding, see mipsevm.ReadState.
+func loadState(inputPath string) (*mipsevm.State, error) {
+	if inputPath == "" {
+		return nil, errors.New("no path specified")
+	}
+	f, err := ioutil.OpenDecompressed(inputPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %q: %w", inputPath, err)
+	}
+	defer f.Close()
+	state, err := mipsevm.ReadState(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode file %q: %w", inputPath, err)
+	}
+	return state, nil
+}
+
+// writeState writes the state to outputPath, in the binary encoding of mipsevm.EncodeStateBinary if the file name
+// has a .bin extension, before any .gz or .zst extension, and in JSON otherwise.
+func writeState(outputPath string, state *mipsevm.State) error {
+	if !isBinaryStatePath(outputPath) {
+		return writeJSON(outputPath, state)
+	}
+	return writeOutput(outputPath, func(out io.Writer) error {
+		if err := mipsevm.EncodeStateBinary(out, state); err != nil {
+			return fmt.Errorf("failed to encode binary state: %w", err)
+		}
+		return nil
+	})
+}
+
+func isBinaryStatePath(outputPath string) bool {
+	name := strings.TrimSuffix(strings.TrimSuffix(outputPath, ".gz"), ".zst")
+	return path.Ext(name) == ".bin"
+}
+
 func writeJSON[X any](outputPath string, value X) error {
+	return writeOutput(outputPath, func(out io.Writer) error {
+		enc := json.NewEncoder(out)
+		if err := enc.Encode(value); err != nil {
+			return fmt.Errorf("failed to encode to JSON: %w", err)
+		}
+		_, err := out.Write([]byte{'\n'})
+		if err != nil {
+			return fmt.Errorf("failed to append new-line: %w", err)
+		}
+		return nil
+	})
+}
+
+func writeOutput(outputPath string, write func(out io.Writer) error) error {
 	if outputPath == "" {
 		return nil
 	}
@@ -50,13 +102,8 @@ func writeJSON[X any](outputPath string, value X) error {
 	} else {
 		out = os.Stdout
 	}
-	enc := json.NewEncoder(out)
-	if err := enc.Encode(value); err != nil {
-		return fmt.Errorf("failed to encode to JSON: %w", err)
-	}
-	_, err := out.Write([]byte{'\n'})
-	if err != nil {
-		return fmt.Errorf("failed to append new-line: %w", err)
+	if err := write(out); err != nil {
+		return err
 	}
 	if err := finish(); err != nil {
 		return fmt.Errorf("failed to finish write: %w", err)
@@ -1,11 +1,15 @@
 package cmd
 
 import (
+	"bytes"
 	"encoding/json"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
 	"github.com/stretchr/testify/require"
 )
 
@@ -47,6 +51,30 @@ func TestRoundTripJSONWithGzip(t *testing.T) {
 	require.EqualValues(t, data, result)
 }
 
+func TestRoundTripState(t *testing.T) {
+	state := &mipsevm.State{Memory: mipsevm.NewMemory(), PC: 4, NextPC: 8, Step: 10}
+	state.Memory.SetMemory(8, 123)
+	for _, name := range []string{"state.json", "state.json.gz", "state.bin", "state.bin.gz", "state.bin.zst"} {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), name)
+			require.NoError(t, writeState(file, state))
+
+			f, err := ioutil.OpenDecompressed(file)
+			require.NoError(t, err)
+			defer f.Close()
+			magic := make([]byte, len(mipsevm.StateBinaryMagic))
+			_, err = io.ReadFull(f, magic)
+			require.NoError(t, err)
+			require.Equal(t, isBinaryStatePath(file), bytes.Equal(mipsevm.StateBinaryMagic[:], magic))
+
+			result, err := loadState(file)
+			require.NoError(t, err)
+			require.Equal(t, state.EncodeWitness(), result.EncodeWitness())
+		})
+	}
+}
+
 type jsonTestData struct {
 	A string `json:"a"`
 	B int    `json:"b"`
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"debug/elf"
+	"errors"
 	"fmt"
 
 	"github.com/urfave/cli/v2"
@@ -12,7 +13,7 @@ import (
 var (
 	LoadELFPathFlag = &cli.PathFlag{
 		Name:      "path",
-		Usage:     "Path to 32-bit big-endian MIPS ELF file",
+		Usage:     "Path to 32-bit or 64-bit big-endian MIPS ELF file. 64-bit files are loaded into a MIPS64 state.",
 		TakesFile: true,
 		Required:  true,
 	}
@@ -28,6 +29,10 @@ var (
 		Value:    "state.json",
 		Required: false,
 	}
+	LoadELFThreadsFlag = &cli.BoolFlag{
+		Name:  "threads",
+		Usage: "Run clone'd threads with a deterministic scheduler, and futex waits and wakes, for programs that use more than one thread. Proofs of multi-threaded states cannot be verified onchain. 32-bit only.",
+	}
 	LoadELFMetaFlag = &cli.PathFlag{
 		Name:     "meta",
 		Usage:    "Write metadata file, for symbol lookup during program execution. None if empty.",
@@ -45,31 +50,75 @@ func LoadELF(ctx *cli.Context) error {
 	if elfProgram.Machine != elf.EM_MIPS {
 		return fmt.Errorf("ELF is not big-endian MIPS R3000, but got %q", elfProgram.Machine.String())
 	}
+	patches := ctx.StringSlice(LoadELFPatchFlag.Name)
+	var state mipsevm.FPVMState
+	threads := ctx.Bool(LoadELFThreadsFlag.Name)
+	if elfProgram.Class == elf.ELFCLASS64 {
+		if threads {
+			return errors.New("threads are not supported by the MIPS64 state")
+		}
+		state, err = loadELF64(elfProgram, patches)
+	} else {
+		var state32 *mipsevm.State
+		state32, err = loadELF32(elfProgram, patches)
+		if threads && err == nil {
+			state32.Threading = mipsevm.NewThreading()
+		}
+		state = state32
+	}
+	if err != nil {
+		return err
+	}
+	meta, err := mipsevm.MakeMetadata(elfProgram)
+	if err != nil {
+		return fmt.Errorf("failed to compute program metadata: %w", err)
+	}
+	if err := writeJSON[*mipsevm.Metadata](ctx.Path(LoadELFMetaFlag.Name), meta); err != nil {
+		return fmt.Errorf("failed to output metadata: %w", err)
+	}
+	return writeJSON[mipsevm.FPVMState](ctx.Path(LoadELFOutFlag.Name), state)
+}
+
+func loadELF32(elfProgram *elf.File, patches []string) (*mipsevm.State, error) {
 	state, err := mipsevm.LoadELF(elfProgram)
 	if err != nil {
-		return fmt.Errorf("failed to load ELF data into VM state: %w", err)
+		return nil, fmt.Errorf("failed to load ELF data into VM state: %w", err)
 	}
-	for _, typ := range ctx.StringSlice(LoadELFPatchFlag.Name) {
+	for _, typ := range patches {
 		switch typ {
 		case "stack":
 			err = mipsevm.PatchStack(state)
 		case "go":
 			err = mipsevm.PatchGo(elfProgram, state)
 		default:
-			return fmt.Errorf("unrecognized form of patching: %q", typ)
+			return nil, fmt.Errorf("unrecognized form of patching: %q", typ)
 		}
 		if err != nil {
-			return fmt.Errorf("failed to apply patch %s: %w", typ, err)
+			return nil, fmt.Errorf("failed to apply patch %s: %w", typ, err)
 		}
 	}
-	meta, err := mipsevm.MakeMetadata(elfProgram)
+	return state, nil
+}
+
+func loadELF64(elfProgram *elf.File, patches []string) (*mipsevm.State64, error) {
+	state, err := mipsevm.LoadELF64(elfProgram)
 	if err != nil {
-		return fmt.Errorf("failed to compute program metadata: %w", err)
+		return nil, fmt.Errorf("failed to load ELF data into VM state: %w", err)
 	}
-	if err := writeJSON[*mipsevm.Metadata](ctx.Path(LoadELFMetaFlag.Name), meta); err != nil {
-		return fmt.Errorf("failed to output metadata: %w", err)
+	for _, typ := range patches {
+		switch typ {
+		case "stack":
+			err = mipsevm.PatchStack64(state)
+		case "go":
+			err = mipsevm.PatchGo64(elfProgram, state)
+		default:
+			return nil, fmt.Errorf("unrecognized form of patching: %q", typ)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply patch %s: %w", typ, err)
+		}
 	}
-	return writeJSON[*mipsevm.State](ctx.Path(LoadELFOutFlag.Name), state)
+	return state, nil
 }
 
 var LoadELFCommand = &cli.Command{
@@ -82,5 +131,6 @@ var LoadELFCommand = &cli.Command{
 		LoadELFPatchFlag,
 		LoadELFOutFlag,
 		LoadELFMetaFlag,
+		LoadELFThreadsFlag,
 	},
 }
@@ -0,0 +1,226 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	pprof "github.com/google/pprof/profile"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+)
+
+type pcProfile struct {
+	steps      uint64
+	pageFaults uint64
+}
+
+type syscallSite struct {
+	pc  uint32
+	num uint32
+}
+
+// Profiler tallies the executed instructions and the page faults, the first accesses of memory pages, by PC,
+// and the syscalls by PC and number.
+type Profiler struct {
+	meta *mipsevm.Metadata
+
+	pcs      map[uint32]*pcProfile
+	syscalls map[syscallSite]uint64
+}
+
+func NewProfiler(meta *mipsevm.Metadata) *Profiler {
+	return &Profiler{
+		meta:     meta,
+		pcs:      make(map[uint32]*pcProfile),
+		syscalls: make(map[syscallSite]uint64),
+	}
+}
+
+// Profile wraps the step function of the state, to record every step in the profile.
+func (p *Profiler) Profile(state *mipsevm.State, fn StepFn) StepFn {
+	return func(proof bool) (*mipsevm.StepWitness, error) {
+		pc := state.PC
+		insn := state.Memory.GetMemory(pc)
+		syscallNum := state.Registers[2] // v0
+		pages := state.Memory.PageCount()
+		wit, err := fn(proof)
+		if err != nil {
+			return nil, err
+		}
+		p.record(pc, insn, syscallNum, uint64(state.Memory.PageCount()-pages))
+		return wit, nil
+	}
+}
+
+func (p *Profiler) record(pc uint32, insn uint32, syscallNum uint32, pageFaults uint64) {
+	stats, ok := p.pcs[pc]
+	if !ok {
+		stats = new(pcProfile)
+		p.pcs[pc] = stats
+	}
+	stats.steps++
+	stats.pageFaults += pageFaults
+	if insn>>26 == 0 && insn&0x3F == 0xC {
+		p.syscalls[syscallSite{pc: pc, num: syscallNum}]++
+	}
+}
+
+type SymbolProfile struct {
+	Name       string         `json:"name"`
+	Start      mipsevm.HexU32 `json:"start"`
+	End        mipsevm.HexU32 `json:"end"`
+	Steps      uint64         `json:"steps"`
+	PageFaults uint64         `json:"pageFaults"`
+}
+
+type SyscallProfile struct {
+	Num   uint32 `json:"num"`
+	Name  string `json:"name"`
+	Count uint64 `json:"count"`
+}
+
+// ProfileReport holds the totals of a profile by symbol, and by syscall. Instructions outside the symbols are
+// grouped by memory page.
+type ProfileReport struct {
+	Steps      uint64           `json:"steps"`
+	PageFaults uint64           `json:"pageFaults"`
+	Symbols    []SymbolProfile  `json:"symbols"`
+	Syscalls   []SyscallProfile `json:"syscalls"`
+}
+
+func (p *Profiler) Report() *ProfileReport {
+	symbolRanges := make(map[string]mipsevm.Symbol, len(p.meta.Symbols))
+	for _, s := range p.meta.Symbols {
+		symbolRanges[s.Name] = s
+	}
+
+	report := &ProfileReport{Symbols: []SymbolProfile{}, Syscalls: []SyscallProfile{}}
+	symbols := make(map[mipsevm.Symbol]*SymbolProfile)
+	for pc, stats := range p.pcs {
+		name := p.meta.LookupSymbol(pc)
+		key, ok := symbolRanges[name]
+		if !ok {
+			key = mipsevm.Symbol{Name: name, Start: pc &^ (mipsevm.PageSize - 1), Size: mipsevm.PageSize}
+		}
+		symbol, ok := symbols[key]
+		if !ok {
+			symbol = &SymbolProfile{Name: key.Name, Start: mipsevm.HexU32(key.Start), End: mipsevm.HexU32(key.Start + key.Size)}
+			symbols[key] = symbol
+		}
+		symbol.Steps += stats.steps
+		symbol.PageFaults += stats.pageFaults
+		report.Steps += stats.steps
+		report.PageFaults += stats.pageFaults
+	}
+	for _, symbol := range symbols {
+		report.Symbols = append(report.Symbols, *symbol)
+	}
+	sort.Slice(report.Symbols, func(i, j int) bool {
+		a, b := report.Symbols[i], report.Symbols[j]
+		if a.Steps != b.Steps {
+			return a.Steps > b.Steps
+		}
+		return a.Start < b.Start
+	})
+
+	syscalls := make(map[uint32]uint64)
+	for site, count := range p.syscalls {
+		syscalls[site.num] += count
+	}
+	for num, count := range syscalls {
+		report.Syscalls = append(report.Syscalls, SyscallProfile{Num: num, Name: mipsevm.SyscallName(num), Count: count})
+	}
+	sort.Slice(report.Syscalls, func(i, j int) bool {
+		a, b := report.Syscalls[i], report.Syscalls[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Num < b.Num
+	})
+	return report
+}
+
+// PProf returns the profile in the pprof format, with the instructions, the syscalls and the page faults as
+// sample types. The syscall samples are labelled with the syscall number and name.
+func (p *Profiler) PProf() *pprof.Profile {
+	prof := &pprof.Profile{
+		SampleType: []*pprof.ValueType{
+			{Type: "instructions", Unit: "count"},
+			{Type: "syscalls", Unit: "count"},
+			{Type: "page_faults", Unit: "count"},
+		},
+		PeriodType: &pprof.ValueType{Type: "instructions", Unit: "count"},
+		Period:     1,
+	}
+	mapping := &pprof.Mapping{ID: 1, Start: 0, Limit: 1 << 32, File: "program", HasFunctions: true}
+	prof.Mapping = []*pprof.Mapping{mapping}
+
+	functions := make(map[string]*pprof.Function)
+	locations := make(map[uint32]*pprof.Location)
+	location := func(pc uint32) *pprof.Location {
+		if loc, ok := locations[pc]; ok {
+			return loc
+		}
+		name := p.meta.LookupSymbol(pc)
+		fn, ok := functions[name]
+		if !ok {
+			fn = &pprof.Function{ID: uint64(len(prof.Function) + 1), Name: name, SystemName: name}
+			functions[name] = fn
+			prof.Function = append(prof.Function, fn)
+		}
+		loc := &pprof.Location{ID: uint64(len(prof.Location) + 1), Mapping: mapping, Address: uint64(pc), Line: []pprof.Line{{Function: fn}}}
+		locations[pc] = loc
+		prof.Location = append(prof.Location, loc)
+		return loc
+	}
+
+	pcs := make([]uint32, 0, len(p.pcs))
+	for pc := range p.pcs {
+		pcs = append(pcs, pc)
+	}
+	sort.Slice(pcs, func(i, j int) bool { return pcs[i] < pcs[j] })
+	for _, pc := range pcs {
+		stats := p.pcs[pc]
+		prof.Sample = append(prof.Sample, &pprof.Sample{
+			Location: []*pprof.Location{location(pc)},
+			Value:    []int64{int64(stats.steps), 0, int64(stats.pageFaults)},
+		})
+	}
+
+	sites := make([]syscallSite, 0, len(p.syscalls))
+	for site := range p.syscalls {
+		sites = append(sites, site)
+	}
+	sort.Slice(sites, func(i, j int) bool {
+		if sites[i].pc != sites[j].pc {
+			return sites[i].pc < sites[j].pc
+		}
+		return sites[i].num < sites[j].num
+	})
+	for _, site := range sites {
+		prof.Sample = append(prof.Sample, &pprof.Sample{
+			Location: []*pprof.Location{location(site.pc)},
+			Value:    []int64{0, int64(p.syscalls[site]), 0},
+			Label:    map[string][]string{"syscall": {fmt.Sprintf("%d %s", site.num, mipsevm.SyscallName(site.num))}},
+		})
+	}
+	return prof
+}
+
+// writeProfile writes the profile to outputPath, as a JSON report if the file name has a .json extension, before any
+// .gz or .zst extension, and in the pprof format otherwise.
+func writeProfile(outputPath string, p *Profiler) error {
+	name := strings.TrimSuffix(strings.TrimSuffix(outputPath, ".gz"), ".zst")
+	if path.Ext(name) == ".json" {
+		return writeJSON(outputPath, p.Report())
+	}
+	return writeOutput(outputPath, func(out io.Writer) error {
+		if err := p.PProf().WriteUncompressed(out); err != nil {
+			return fmt.Errorf("failed to encode pprof profile: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	pprof "github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+)
+
+func profileCounter(t *testing.T) *Profiler {
+	state := counterState(t)
+	meta := &mipsevm.Metadata{Symbols: []mipsevm.Symbol{
+		{Name: "loop", Start: 0x1004, Size: 0x14},
+		{Name: "exit", Start: 0x1018, Size: 0xc},
+	}}
+	profiler := NewProfiler(meta)
+	stepFn := profiler.Profile(state, mipsevm.NewInstrumentedState(state, nil, nil, nil).Step)
+	for !state.Exited {
+		_, err := stepFn(false)
+		require.NoError(t, err)
+	}
+	return profiler
+}
+
+func TestProfileReport(t *testing.T) {
+	report := profileCounter(t).Report()
+	require.Equal(t, &ProfileReport{
+		Steps:      254,
+		PageFaults: 1,
+		Symbols: []SymbolProfile{
+			{Name: "loop", Start: 0x1004, End: 0x1018, Steps: 250, PageFaults: 1},
+			{Name: "exit", Start: 0x1018, End: 0x1024, Steps: 3},
+			{Name: "!start", Start: 0x1000, End: 0x2000, Steps: 1},
+		},
+		Syscalls: []SyscallProfile{{Num: 4246, Name: "exit_group", Count: 1}},
+	}, report)
+}
+
+func TestProfilePProf(t *testing.T) {
+	prof := profileCounter(t).PProf()
+	require.NoError(t, prof.CheckValid())
+
+	totals := make([]int64, len(prof.SampleType))
+	for _, sample := range prof.Sample {
+		for i, v := range sample.Value {
+			totals[i] += v
+		}
+		if sample.Value[1] > 0 {
+			require.Equal(t, uint64(0x1020), sample.Location[0].Address)
+			require.Equal(t, []string{"4246 exit_group"}, sample.Label["syscall"])
+		}
+	}
+	require.Equal(t, []int64{254, 1, 1}, totals)
+}
+
+func TestWriteProfile(t *testing.T) {
+	profiler := profileCounter(t)
+	dir := t.TempDir()
+
+	jsonPath := filepath.Join(dir, "profile.json.gz")
+	require.NoError(t, writeProfile(jsonPath, profiler))
+	report, err := loadJSON[ProfileReport](jsonPath)
+	require.NoError(t, err)
+	require.Equal(t, profiler.Report(), report)
+
+	pprofPath := filepath.Join(dir, "profile.pb.gz")
+	require.NoError(t, writeProfile(pprofPath, profiler))
+	f, err := os.Open(pprofPath)
+	require.NoError(t, err)
+	defer f.Close()
+	prof, err := pprof.Parse(f)
+	require.NoError(t, err)
+	require.Len(t, prof.Sample, len(profiler.PProf().Sample))
+}
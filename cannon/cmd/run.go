@@ -21,14 +21,14 @@ import (
 var (
 	RunInputFlag = &cli.PathFlag{
 		Name:      "input",
-		Usage:     "path of input JSON state. Stdin if left empty.",
+		Usage:     "path of input state, in JSON or the binary encoding, gzip or zstd compressed if it ends with .gz or .zst. Stdin if left empty.",
 		TakesFile: true,
 		Value:     "state.json",
 		Required:  true,
 	}
 	RunOutputFlag = &cli.PathFlag{
 		Name:      "output",
-		Usage:     "path of output JSON state. Not written if empty, use - to write to Stdout.",
+		Usage:     "path of output state, in the binary encoding if the file name has a .bin extension (e.g. out.bin.gz) and JSON otherwise, gzip or zstd compressed if it ends with .gz or .zst. Not written if empty, use - to write to Stdout.",
 		TakesFile: true,
 		Value:     "out.json",
 		Required:  false,
@@ -54,7 +54,7 @@ var (
 	}
 	RunSnapshotFmtFlag = &cli.StringFlag{
 		Name:     "snapshot-fmt",
-		Usage:    "format for snapshot output file names.",
+		Usage:    "format for snapshot output file names. Snapshots are written in the binary encoding if the file names have a .bin extension (e.g. state-%d.bin.gz).",
 		Value:    "state-%d.json",
 		Required: false,
 	}
@@ -76,6 +76,12 @@ var (
 		Value:    MustStepMatcherFlag("%100000"),
 		Required: false,
 	}
+	RunProfileFlag = &cli.PathFlag{
+		Name:      "profile",
+		Usage:     "path of the execution profile, with the instruction counts by PC and symbol, the syscall frequencies and the page faults, written when the run stops. A JSON report if the file name has a .json extension (e.g. profile.json.gz), and a pprof profile otherwise. Not profiled if empty.",
+		TakesFile: true,
+		Required:  false,
+	}
 	RunPProfCPU = &cli.BoolFlag{
 		Name:  "pprof.cpu",
 		Usage: "enable pprof cpu profiling",
@@ -224,7 +230,7 @@ func Run(ctx *cli.Context) error {
 		defer profile.Start(profile.NoShutdownHook, profile.ProfilePath("."), profile.CPUProfile).Stop()
 	}
 
-	state, err := loadJSON[mipsevm.State](ctx.Path(RunInputFlag.Name))
+	state, err := loadState(ctx.Path(RunInputFlag.Name))
 	if err != nil {
 		return err
 	}
@@ -283,6 +289,11 @@ func Run(ctx *cli.Context) error {
 	if po.cmd != nil {
 		stepFn = Guard(po.cmd.ProcessState, stepFn)
 	}
+	var profiler *Profiler
+	if ctx.Path(RunProfileFlag.Name) != "" {
+		profiler = NewProfiler(meta)
+		stepFn = profiler.Profile(state, stepFn)
+	}
 
 	start := time.Now()
 	startStep := state.Step
@@ -321,7 +332,7 @@ func Run(ctx *cli.Context) error {
 		}
 
 		if snapshotAt(state) {
-			if err := writeJSON(fmt.Sprintf(snapshotFmt, step), state); err != nil {
+			if err := writeState(fmt.Sprintf(snapshotFmt, step), state); err != nil {
 				return fmt.Errorf("failed to write state snapshot: %w", err)
 			}
 		}
@@ -362,9 +373,14 @@ func Run(ctx *cli.Context) error {
 		}
 	}
 
-	if err := writeJSON(ctx.Path(RunOutputFlag.Name), state); err != nil {
+	if err := writeState(ctx.Path(RunOutputFlag.Name), state); err != nil {
 		return fmt.Errorf("failed to write state output: %w", err)
 	}
+	if profiler != nil {
+		if err := writeProfile(ctx.Path(RunProfileFlag.Name), profiler); err != nil {
+			return fmt.Errorf("failed to write profile: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -383,6 +399,7 @@ var RunCommand = &cli.Command{
 		RunStopAtFlag,
 		RunMetaFlag,
 		RunInfoAtFlag,
+		RunProfileFlag,
 		RunPProfCPU,
 	},
 }
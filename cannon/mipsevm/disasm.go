@@ -0,0 +1,117 @@
+package mipsevm
+
+import "fmt"
+
+var registerNames = [32]string{
+	"zero", "at", "v0", "v1", "a0", "a1", "a2", "a3",
+	"t0", "t1", "t2", "t3", "t4", "t5", "t6", "t7",
+	"s0", "s1", "s2", "s3", "s4", "s5", "s6", "s7",
+	"t8", "t9", "k0", "k1", "gp", "sp", "fp", "ra",
+}
+
+// RegisterName returns the name of the register in the MIPS calling convention, such as "sp" for register 29.
+func RegisterName(reg uint32) string {
+	return registerNames[reg&0x1F]
+}
+
+var specialNames = map[uint32]string{
+	0x00: "sll", 0x02: "srl", 0x03: "sra", 0x04: "sllv", 0x06: "srlv", 0x07: "srav",
+	0x08: "jr", 0x09: "jalr", 0x0a: "movz", 0x0b: "movn", 0x0c: "syscall", 0x0f: "sync",
+	0x10: "mfhi", 0x11: "mthi", 0x12: "mflo", 0x13: "mtlo",
+	0x18: "mult", 0x19: "multu", 0x1a: "div", 0x1b: "divu",
+	0x20: "add", 0x21: "addu", 0x22: "sub", 0x23: "subu",
+	0x24: "and", 0x25: "or", 0x26: "xor", 0x27: "nor", 0x2a: "slt", 0x2b: "sltu",
+}
+
+var immediateNames = map[uint32]string{
+	0x08: "addi", 0x09: "addiu", 0x0a: "slti", 0x0b: "sltiu", 0x0c: "andi", 0x0d: "ori", 0x0e: "xori",
+}
+
+var memoryNames = map[uint32]string{
+	0x20: "lb", 0x21: "lh", 0x22: "lwl", 0x23: "lw", 0x24: "lbu", 0x25: "lhu", 0x26: "lwr",
+	0x28: "sb", 0x29: "sh", 0x2a: "swl", 0x2b: "sw", 0x2e: "swr", 0x30: "ll", 0x38: "sc",
+}
+
+// Disassemble returns the assembly of the instruction at pc, for the instructions that the 32-bit VM supports.
+// Other instructions are returned as a .word directive.
+func Disassemble(pc uint32, insn uint32) string {
+	opcode := insn >> 26
+	rs := RegisterName(insn >> 21)
+	rt := RegisterName(insn >> 16)
+	rd := RegisterName(insn >> 11)
+	shamt := (insn >> 6) & 0x1F
+	imm := int32(int16(insn & 0xFFFF))
+	branchTarget := pc + 4 + (SE(insn&0xFFFF, 16) << 2)
+
+	switch opcode {
+	case 0:
+		fun := insn & 0x3F
+		name, ok := specialNames[fun]
+		switch {
+		case !ok:
+		case insn == 0:
+			return "nop"
+		case fun <= 0x03:
+			return fmt.Sprintf("%s $%s, $%s, %d", name, rd, rt, shamt)
+		case fun <= 0x07:
+			return fmt.Sprintf("%s $%s, $%s, $%s", name, rd, rt, rs)
+		case fun == 0x08, fun == 0x11, fun == 0x13:
+			return fmt.Sprintf("%s $%s", name, rs)
+		case fun == 0x09:
+			return fmt.Sprintf("%s $%s, $%s", name, rd, rs)
+		case fun == 0x0c, fun == 0x0f:
+			return name
+		case fun == 0x10, fun == 0x12:
+			return fmt.Sprintf("%s $%s", name, rd)
+		case fun >= 0x18 && fun <= 0x1b:
+			return fmt.Sprintf("%s $%s, $%s", name, rs, rt)
+		default:
+			return fmt.Sprintf("%s $%s, $%s, $%s", name, rd, rs, rt)
+		}
+	case 1:
+		switch (insn >> 16) & 0x1F {
+		case 0:
+			return fmt.Sprintf("bltz $%s, 0x%08x", rs, branchTarget)
+		case 1:
+			return fmt.Sprintf("bgez $%s, 0x%08x", rs, branchTarget)
+		}
+	case 2, 3:
+		name := "j"
+		if opcode == 3 {
+			name = "jal"
+		}
+		return fmt.Sprintf("%s 0x%08x", name, ((pc+4)&0xF0000000)|((insn&0x03FFFFFF)<<2))
+	case 4, 5:
+		name := "beq"
+		if opcode == 5 {
+			name = "bne"
+		}
+		return fmt.Sprintf("%s $%s, $%s, 0x%08x", name, rs, rt, branchTarget)
+	case 6, 7:
+		name := "blez"
+		if opcode == 7 {
+			name = "bgtz"
+		}
+		return fmt.Sprintf("%s $%s, 0x%08x", name, rs, branchTarget)
+	case 0x0c, 0x0d, 0x0e:
+		return fmt.Sprintf("%s $%s, $%s, 0x%x", immediateNames[opcode], rt, rs, insn&0xFFFF)
+	case 0x08, 0x09, 0x0a, 0x0b:
+		return fmt.Sprintf("%s $%s, $%s, %d", immediateNames[opcode], rt, rs, imm)
+	case 0x0f:
+		return fmt.Sprintf("lui $%s, 0x%x", rt, insn&0xFFFF)
+	case 0x1c:
+		switch insn & 0x3F {
+		case 0x02:
+			return fmt.Sprintf("mul $%s, $%s, $%s", rd, rs, rt)
+		case 0x20:
+			return fmt.Sprintf("clz $%s, $%s", rd, rs)
+		case 0x21:
+			return fmt.Sprintf("clo $%s, $%s", rd, rs)
+		}
+	default:
+		if name, ok := memoryNames[opcode]; ok {
+			return fmt.Sprintf("%s $%s, %d($%s)", name, rt, imm, rs)
+		}
+	}
+	return fmt.Sprintf(".word 0x%08x", insn)
+}
@@ -0,0 +1,42 @@
+package mipsevm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisassemble(t *testing.T) {
+	tests := []struct {
+		pc       uint32
+		insn     uint32
+		expected string
+	}{
+		{pc: 0, insn: 0x00000000, expected: "nop"},
+		{pc: 0, insn: 0x00094080, expected: "sll $t0, $t1, 2"},
+		{pc: 0, insn: 0x01094021, expected: "addu $t0, $t0, $t1"},
+		{pc: 0, insn: 0x03e00008, expected: "jr $ra"},
+		{pc: 0, insn: 0x0000000c, expected: "syscall"},
+		{pc: 0, insn: 0x01090018, expected: "mult $t0, $t1"},
+		{pc: 0, insn: 0x00004010, expected: "mfhi $t0"},
+		{pc: 0, insn: 0x24080001, expected: "addiu $t0, $zero, 1"},
+		{pc: 0, insn: 0x2408ffff, expected: "addiu $t0, $zero, -1"},
+		{pc: 0, insn: 0x3508ffff, expected: "ori $t0, $t0, 0xffff"},
+		{pc: 0, insn: 0x3c1d7fff, expected: "lui $sp, 0x7fff"},
+		{pc: 0, insn: 0x8fbf0010, expected: "lw $ra, 16($sp)"},
+		{pc: 0, insn: 0xafa8fffc, expected: "sw $t0, -4($sp)"},
+		{pc: 0x1010, insn: 0x1520fffc, expected: "bne $t1, $zero, 0x00001004"},
+		{pc: 0x1010, insn: 0x05010002, expected: "bgez $t0, 0x0000101c"},
+		{pc: 0x10001000, insn: 0x0c000400, expected: "jal 0x10001000"},
+		{pc: 0, insn: 0x71094002, expected: "mul $t0, $t0, $t1"},
+		{pc: 0, insn: 0xfc000000, expected: ".word 0xfc000000"},
+		{pc: 0, insn: 0x00000001, expected: ".word 0x00000001"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.expected, func(t *testing.T) {
+			require.Equal(t, test.expected, Disassemble(test.pc, test.insn))
+		})
+	}
+	require.Equal(t, "sp", RegisterName(29))
+}
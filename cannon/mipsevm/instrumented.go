@@ -41,6 +41,7 @@ const (
 
 const (
 	MipsEBADF  = 0x9
+	MipsEAGAIN = 0xb
 	MipsEINVAL = 0x16
 )
 
@@ -54,6 +55,9 @@ func NewInstrumentedState(state *State, po PreimageOracle, stdOut, stdErr io.Wri
 }
 
 func (m *InstrumentedState) Step(proof bool) (wit *StepWitness, err error) {
+	if proof && m.state.Threading != nil {
+		return nil, ErrThreadsProofUnsupported
+	}
 	m.memProofEnabled = proof
 	m.lastMemAccess = ^uint32(0)
 	m.lastPreimageOffset = ^uint32(0)
@@ -69,6 +73,11 @@ func (m *InstrumentedState) Step(proof bool) (wit *StepWitness, err error) {
 	if err != nil {
 		return nil, err
 	}
+	if m.state.Threading != nil {
+		if err := m.preemptThread(); err != nil {
+			return nil, err
+		}
+	}
 
 	if proof {
 		wit.MemProof = append(wit.MemProof, m.memProof[:]...)
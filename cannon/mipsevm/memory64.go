@@ -0,0 +1,245 @@
+package mipsevm
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Page64KeySize is the number of bits of a 64-bit address that select its page.
+const Page64KeySize = 64 - PageAddrSize
+
+// memory64TreeDepth is the depth of the merkle tree of a 64-bit memory, with 32 byte leaves.
+const memory64TreeDepth = 64 - 5
+
+// Memory64 is the memory of a MIPS64 state, with 64-bit addresses. It uses the same pages as Memory,
+// and its merkle root commits to the full 64-bit address range, with empty pages as zero subtrees.
+// Unlike Memory, intermediate nodes above the pages are not cached, and merkle proofs are not supported,
+// since MIPS64 steps cannot be verified on-chain.
+type Memory64 struct {
+	// pageIndex -> cached page
+	pages map[uint64]*CachedPage
+
+	// two caches: we often read instructions from one page, and do memory things with another page.
+	// this prevents map lookups each instruction
+	lastPageKeys [2]uint64
+	lastPage     [2]*CachedPage
+}
+
+func NewMemory64() *Memory64 {
+	return &Memory64{
+		pages:        make(map[uint64]*CachedPage),
+		lastPageKeys: [2]uint64{^uint64(0), ^uint64(0)}, // default to invalid keys, to not match any pages
+	}
+}
+
+func (m *Memory64) PageCount() int {
+	return len(m.pages)
+}
+
+func (m *Memory64) ForEachPage(fn func(pageIndex uint64, page *Page) error) error {
+	for pageIndex, cachedPage := range m.pages {
+		if err := fn(pageIndex, cachedPage.Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MerkleRoot hashes the page roots pairwise up to the root of the 64-bit address range.
+func (m *Memory64) MerkleRoot() [32]byte {
+	if len(m.pages) == 0 {
+		return zeroHashes[memory64TreeDepth]
+	}
+	level := make(map[uint64][32]byte, len(m.pages))
+	for index, p := range m.pages {
+		level[index] = p.MerkleRoot()
+	}
+	// the page roots are the roots of subtrees of PageAddrSize-5 levels
+	for height := PageAddrSize - 5; height < memory64TreeDepth; height++ {
+		parents := make(map[uint64][32]byte, (len(level)+1)/2)
+		for index, node := range level {
+			parent := index >> 1
+			if _, ok := parents[parent]; ok {
+				continue // already hashed with its sibling
+			}
+			sibling, ok := level[index^1]
+			if !ok {
+				sibling = zeroHashes[height]
+			}
+			if index&1 == 0 {
+				parents[parent] = HashPair(node, sibling)
+			} else {
+				parents[parent] = HashPair(sibling, node)
+			}
+		}
+		level = parents
+	}
+	return level[0]
+}
+
+func (m *Memory64) pageLookup(pageIndex uint64) (*CachedPage, bool) {
+	// hit caches
+	if pageIndex == m.lastPageKeys[0] {
+		return m.lastPage[0], true
+	}
+	if pageIndex == m.lastPageKeys[1] {
+		return m.lastPage[1], true
+	}
+	p, ok := m.pages[pageIndex]
+
+	// only cache existing pages.
+	if ok {
+		m.lastPageKeys[1] = m.lastPageKeys[0]
+		m.lastPage[1] = m.lastPage[0]
+		m.lastPageKeys[0] = pageIndex
+		m.lastPage[0] = p
+	}
+
+	return p, ok
+}
+
+// SetDoubleword sets the 8 bytes at addr, which must be aligned to 8 bytes.
+func (m *Memory64) SetDoubleword(addr uint64, v uint64) {
+	if addr&0x7 != 0 {
+		panic(fmt.Errorf("unaligned memory access: %x", addr))
+	}
+	pageIndex := addr >> PageAddrSize
+	pageAddr := addr & PageAddrMask
+	p, ok := m.pageLookup(pageIndex)
+	if !ok {
+		// allocate the page if we have not already.
+		// Go may mmap relatively large ranges, but we only allocate the pages just in time.
+		p = m.AllocPage(pageIndex)
+	} else {
+		p.Invalidate(uint32(pageAddr)) // invalidate this branch of memory, now that the value changed
+	}
+	binary.BigEndian.PutUint64(p.Data[pageAddr:pageAddr+8], v)
+}
+
+// GetDoubleword returns the 8 bytes at addr, which must be aligned to 8 bytes.
+func (m *Memory64) GetDoubleword(addr uint64) uint64 {
+	if addr&0x7 != 0 {
+		panic(fmt.Errorf("unaligned memory access: %x", addr))
+	}
+	p, ok := m.pageLookup(addr >> PageAddrSize)
+	if !ok {
+		return 0
+	}
+	pageAddr := addr & PageAddrMask
+	return binary.BigEndian.Uint64(p.Data[pageAddr : pageAddr+8])
+}
+
+// GetMemory returns the 4 bytes at addr, which must be aligned to 4 bytes, such as an instruction.
+func (m *Memory64) GetMemory(addr uint64) uint32 {
+	if addr&0x3 != 0 {
+		panic(fmt.Errorf("unaligned memory access: %x", addr))
+	}
+	p, ok := m.pageLookup(addr >> PageAddrSize)
+	if !ok {
+		return 0
+	}
+	pageAddr := addr & PageAddrMask
+	return binary.BigEndian.Uint32(p.Data[pageAddr : pageAddr+4])
+}
+
+func (m *Memory64) AllocPage(pageIndex uint64) *CachedPage {
+	p := &CachedPage{Data: new(Page)}
+	m.pages[pageIndex] = p
+	return p
+}
+
+type pageEntry64 struct {
+	Index uint64 `json:"index"`
+	Data  *Page  `json:"data"`
+}
+
+func (m *Memory64) MarshalJSON() ([]byte, error) { // nosemgrep
+	pages := make([]pageEntry64, 0, len(m.pages))
+	for k, p := range m.pages {
+		pages = append(pages, pageEntry64{
+			Index: k,
+			Data:  p.Data,
+		})
+	}
+	sort.Slice(pages, func(i, j int) bool {
+		return pages[i].Index < pages[j].Index
+	})
+	return json.Marshal(pages)
+}
+
+func (m *Memory64) UnmarshalJSON(data []byte) error {
+	var pages []pageEntry64
+	if err := json.Unmarshal(data, &pages); err != nil {
+		return err
+	}
+	m.pages = make(map[uint64]*CachedPage)
+	m.lastPageKeys = [2]uint64{^uint64(0), ^uint64(0)}
+	m.lastPage = [2]*CachedPage{nil, nil}
+	for i, p := range pages {
+		if _, ok := m.pages[p.Index]; ok {
+			return fmt.Errorf("cannot load duplicate page, entry %d, page index %d", i, p.Index)
+		}
+		m.AllocPage(p.Index).Data = p.Data
+	}
+	return nil
+}
+
+func (m *Memory64) SetMemoryRange(addr uint64, r io.Reader) error {
+	for {
+		pageIndex := addr >> PageAddrSize
+		pageAddr := addr & PageAddrMask
+		p, ok := m.pageLookup(pageIndex)
+		if !ok {
+			p = m.AllocPage(pageIndex)
+		}
+		p.InvalidateFull()
+		n, err := r.Read(p.Data[pageAddr:])
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		addr += uint64(n)
+	}
+}
+
+type memReader64 struct {
+	m     *Memory64
+	addr  uint64
+	count uint64
+}
+
+func (r *memReader64) Read(dest []byte) (n int, err error) {
+	if r.count == 0 {
+		return 0, io.EOF
+	}
+
+	// Keep iterating over memory until we have all our data.
+	// It may wrap around the address range, and may not be aligned
+	endAddr := r.addr + r.count
+
+	pageIndex := r.addr >> PageAddrSize
+	start := r.addr & PageAddrMask
+	end := uint64(PageSize)
+
+	if pageIndex == (endAddr >> PageAddrSize) {
+		end = endAddr & PageAddrMask
+	}
+	p, ok := r.m.pageLookup(pageIndex)
+	if ok {
+		n = copy(dest, p.Data[start:end])
+	} else {
+		n = copy(dest, make([]byte, end-start)) // default to zeroes
+	}
+	r.addr += uint64(n)
+	r.count -= uint64(n)
+	return n, nil
+}
+
+func (m *Memory64) ReadMemoryRange(addr uint64, count uint64) io.Reader {
+	return &memReader64{m: m, addr: addr, count: count}
+}
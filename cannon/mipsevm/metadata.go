@@ -4,6 +4,7 @@ import (
 	"debug/elf"
 	"fmt"
 	"sort"
+	"strconv"
 )
 
 type Symbol struct {
@@ -75,3 +76,12 @@ func (v HexU32) String() string {
 func (v HexU32) MarshalText() ([]byte, error) {
 	return []byte(v.String()), nil
 }
+
+func (v *HexU32) UnmarshalText(text []byte) error {
+	n, err := strconv.ParseUint(string(text), 16, 32)
+	if err != nil {
+		return fmt.Errorf("invalid hex uint32 %q: %w", text, err)
+	}
+	*v = HexU32(n)
+	return nil
+}
@@ -16,6 +16,29 @@ const (
 	sysFcntl     = 4055
 )
 
+var syscallNames = map[uint32]string{
+	sysMmap:       "mmap",
+	sysBrk:        "brk",
+	sysClone:      "clone",
+	sysExitGroup:  "exit_group",
+	sysRead:       "read",
+	sysWrite:      "write",
+	sysFcntl:      "fcntl",
+	sysExit:       "exit",
+	sysSchedYield: "sched_yield",
+	sysNanosleep:  "nanosleep",
+	sysGetTID:     "gettid",
+	sysFutex:      "futex",
+}
+
+// SyscallName returns the name of the syscall, or "unknown" for the syscalls that the VM ignores.
+func SyscallName(num uint32) string {
+	if name, ok := syscallNames[num]; ok {
+		return name
+	}
+	return "unknown"
+}
+
 func (m *InstrumentedState) readPreimage(key [32]byte, offset uint32) (dat [32]byte, datLen uint32) {
 	preimage := m.lastPreimage
 	if key != m.lastPreimageKey {
@@ -51,6 +74,12 @@ func (m *InstrumentedState) handleSyscall() error {
 	a1 := m.state.Registers[5]
 	a2 := m.state.Registers[6]
 
+	if m.state.Threading != nil {
+		if handled, err := m.handleThreadSyscall(syscallNum, a0, a1, a2); handled {
+			return err
+		}
+	}
+
 	//fmt.Printf("syscall: %d\n", syscallNum)
 	switch syscallNum {
 	case sysMmap:
@@ -68,7 +97,7 @@ func (m *InstrumentedState) handleSyscall() error {
 		}
 	case sysBrk:
 		v0 = 0x40000000
-	case sysClone: // clone (not supported)
+	case sysClone: // clone (not supported without threads)
 		v0 = 1
 	case sysExitGroup:
 		m.state.Exited = true
@@ -332,10 +361,11 @@ func (m *InstrumentedState) mipsStep() error {
 	// memory fetch (all I-type)
 	// we do the load for stores also
 	mem := uint32(0)
+	addr := uint32(0)
 	if opcode >= 0x20 {
 		// M[R[rs]+SignExtImm]
 		rs += SE(insn&0xFFFF, 16)
-		addr := rs & 0xFFFFFFFC
+		addr = rs & 0xFFFFFFFC
 		m.trackMemAccess(addr)
 		mem = m.state.Memory.GetMemory(addr)
 		if opcode >= 0x28 && opcode != 0x30 {
@@ -378,9 +408,20 @@ func (m *InstrumentedState) mipsStep() error {
 		}
 	}
 
-	// stupid sc, write a 1 to rt
-	if opcode == 0x38 && rtReg != 0 {
-		m.state.Registers[rtReg] = 1
+	if m.state.Threading != nil && opcode == 0x30 { // ll reserves the address for sc
+		m.state.Threading.LLReserved = true
+		m.state.Threading.LLAddr = addr
+	}
+	if opcode == 0x38 {
+		// stupid sc, write a 1 to rt, unless another thread may have run since ll
+		stored := uint32(1)
+		if m.state.Threading != nil && !m.state.Threading.storeConditional(addr) {
+			stored = 0
+			storeAddr = 0xFF_FF_FF_FF
+		}
+		if rtReg != 0 {
+			m.state.Registers[rtReg] = stored
+		}
 	}
 
 	// write memory
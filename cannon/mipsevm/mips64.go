@@ -0,0 +1,649 @@
+package mipsevm
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/bits"
+)
+
+// Syscall numbers of the n64 ABI.
+const (
+	sys64Mmap      = 5009
+	sys64Brk       = 5012
+	sys64Clone     = 5055
+	sys64ExitGroup = 5205
+	sys64Read      = 5000
+	sys64Write     = 5001
+	sys64Fcntl     = 5070
+)
+
+var ErrMemProof64Unsupported = errors.New("memory proofs of 64-bit states are not supported")
+
+// InstrumentedState64 executes a State64. Steps cannot be proven, since MIPS.sol only verifies 32-bit steps.
+type InstrumentedState64 struct {
+	state *State64
+
+	stdOut io.Writer
+	stdErr io.Writer
+
+	preimageOracle PreimageOracle
+
+	// cached pre-image data, including 8 byte length prefix
+	lastPreimage []byte
+	// key for above preimage
+	lastPreimageKey [32]byte
+}
+
+func NewInstrumentedState64(state *State64, po PreimageOracle, stdOut, stdErr io.Writer) *InstrumentedState64 {
+	return &InstrumentedState64{
+		state:          state,
+		stdOut:         stdOut,
+		stdErr:         stdErr,
+		preimageOracle: po,
+	}
+}
+
+func (m *InstrumentedState64) Step(proof bool) (*StepWitness, error) {
+	if proof {
+		return nil, ErrMemProof64Unsupported
+	}
+	return nil, m.mipsStep()
+}
+
+func (m *InstrumentedState64) readPreimage(key [32]byte, offset uint32) (dat [32]byte, datLen uint32) {
+	preimage := m.lastPreimage
+	if key != m.lastPreimageKey {
+		m.lastPreimageKey = key
+		data := m.preimageOracle.GetPreimage(key)
+		// add the length prefix
+		preimage = make([]byte, 0, 8+len(data))
+		preimage = binary.BigEndian.AppendUint64(preimage, uint64(len(data)))
+		preimage = append(preimage, data...)
+		m.lastPreimage = preimage
+	}
+	datLen = uint32(copy(dat[:], preimage[offset:]))
+	return
+}
+
+func (m *InstrumentedState64) handleSyscall() error {
+	syscallNum := m.state.Registers[2] // v0
+	v0 := uint64(0)
+	v1 := uint64(0)
+
+	a0 := m.state.Registers[4]
+	a1 := m.state.Registers[5]
+	a2 := m.state.Registers[6]
+
+	switch syscallNum {
+	case sys64Mmap:
+		sz := a1
+		if sz&PageAddrMask != 0 { // adjust size to align with page size
+			sz += PageSize - (sz & PageAddrMask)
+		}
+		if a0 == 0 {
+			v0 = m.state.Heap
+			m.state.Heap += sz
+		} else {
+			v0 = a0
+		}
+	case sys64Brk:
+		v0 = brk64
+	case sys64Clone: // clone (not supported)
+		v0 = 1
+	case sys64ExitGroup:
+		m.state.Exited = true
+		m.state.ExitCode = uint8(a0)
+		return nil
+	case sys64Read:
+		// args: a0 = fd, a1 = addr, a2 = count
+		// returns: v0 = read, v1 = err code
+		switch a0 {
+		case fdStdin:
+			// leave v0 and v1 zero: read nothing, no error
+		case fdPreimageRead: // pre-image oracle
+			effAddr := a1 &^ 7
+			mem := m.state.Memory.GetDoubleword(effAddr)
+			dat, datLen := m.readPreimage(m.state.PreimageKey, m.state.PreimageOffset)
+			alignment := a1 & 7
+			space := 8 - alignment
+			if space < uint64(datLen) {
+				datLen = uint32(space)
+			}
+			if a2 < uint64(datLen) {
+				datLen = uint32(a2)
+			}
+			var outMem [8]byte
+			binary.BigEndian.PutUint64(outMem[:], mem)
+			copy(outMem[alignment:], dat[:datLen])
+			m.state.Memory.SetDoubleword(effAddr, binary.BigEndian.Uint64(outMem[:]))
+			m.state.PreimageOffset += datLen
+			v0 = uint64(datLen)
+		case fdHintRead: // hint response
+			// don't actually read into memory, just say we read it all, we ignore the result anyway
+			v0 = a2
+		default:
+			v0 = ^uint64(0)
+			v1 = MipsEBADF
+		}
+	case sys64Write:
+		// args: a0 = fd, a1 = addr, a2 = count
+		// returns: v0 = written, v1 = err code
+		switch a0 {
+		case fdStdout:
+			_, _ = io.Copy(m.stdOut, m.state.Memory.ReadMemoryRange(a1, a2))
+			v0 = a2
+		case fdStderr:
+			_, _ = io.Copy(m.stdErr, m.state.Memory.ReadMemoryRange(a1, a2))
+			v0 = a2
+		case fdHintWrite:
+			hintData, _ := io.ReadAll(m.state.Memory.ReadMemoryRange(a1, a2))
+			m.state.LastHint = append(m.state.LastHint, hintData...)
+			for len(m.state.LastHint) >= 4 { // process while there is enough data to check if there are any hints
+				hintLen := binary.BigEndian.Uint32(m.state.LastHint[:4])
+				if hintLen >= uint32(len(m.state.LastHint[4:])) {
+					hint := m.state.LastHint[4 : 4+hintLen] // without the length prefix
+					m.state.LastHint = m.state.LastHint[4+hintLen:]
+					m.preimageOracle.Hint(hint)
+				} else {
+					break // stop processing hints if there is incomplete data buffered
+				}
+			}
+			v0 = a2
+		case fdPreimageWrite:
+			effAddr := a1 &^ 7
+			mem := m.state.Memory.GetDoubleword(effAddr)
+			key := m.state.PreimageKey
+			alignment := a1 & 7
+			space := 8 - alignment
+			if space < a2 {
+				a2 = space
+			}
+			copy(key[:], key[a2:])
+			var tmp [8]byte
+			binary.BigEndian.PutUint64(tmp[:], mem)
+			copy(key[32-a2:], tmp[alignment:])
+			m.state.PreimageKey = key
+			m.state.PreimageOffset = 0
+			v0 = a2
+		default:
+			v0 = ^uint64(0)
+			v1 = MipsEBADF
+		}
+	case sys64Fcntl:
+		// args: a0 = fd, a1 = cmd
+		if a1 == 3 { // F_GETFL: get file descriptor flags
+			switch a0 {
+			case fdStdin, fdPreimageRead, fdHintRead:
+				v0 = 0 // O_RDONLY
+			case fdStdout, fdStderr, fdPreimageWrite, fdHintWrite:
+				v0 = 1 // O_WRONLY
+			default:
+				v0 = ^uint64(0)
+				v1 = MipsEBADF
+			}
+		} else {
+			v0 = ^uint64(0)
+			v1 = MipsEINVAL // cmd not recognized by this kernel
+		}
+	}
+	m.state.Registers[2] = v0
+	m.state.Registers[7] = v1
+
+	m.state.PC = m.state.NextPC
+	m.state.NextPC = m.state.NextPC + 4
+	return nil
+}
+
+func (m *InstrumentedState64) handleBranch(opcode uint32, insn uint32, rtReg uint32, rs uint64) error {
+	if m.state.NextPC != m.state.PC+4 {
+		panic("branch in delay slot")
+	}
+
+	shouldBranch := false
+	if opcode == 4 || opcode == 5 { // beq/bne
+		rt := m.state.Registers[rtReg]
+		shouldBranch = (rs == rt && opcode == 4) || (rs != rt && opcode == 5)
+	} else if opcode == 6 {
+		shouldBranch = int64(rs) <= 0 // blez
+	} else if opcode == 7 {
+		shouldBranch = int64(rs) > 0 // bgtz
+	} else if opcode == 1 {
+		// regimm
+		rtv := (insn >> 16) & 0x1F
+		if rtv == 0 { // bltz
+			shouldBranch = int64(rs) < 0
+		}
+		if rtv == 1 { // bgez
+			shouldBranch = int64(rs) >= 0
+		}
+	}
+
+	prevPC := m.state.PC
+	m.state.PC = m.state.NextPC // execute the delay slot first
+	if shouldBranch {
+		m.state.NextPC = prevPC + 4 + (SE64(uint64(insn&0xFFFF), 16) << 2) // then continue with the instruction the branch jumps to.
+	} else {
+		m.state.NextPC = m.state.NextPC + 4 // branch not taken
+	}
+	return nil
+}
+
+func (m *InstrumentedState64) handleHiLo(fun uint32, rs uint64, rt uint64, storeReg uint32) error {
+	val := uint64(0)
+	switch fun {
+	case 0x10: // mfhi
+		val = m.state.HI
+	case 0x11: // mthi
+		m.state.HI = rs
+	case 0x12: // mflo
+		val = m.state.LO
+	case 0x13: // mtlo
+		m.state.LO = rs
+	case 0x18: // mult
+		acc := uint64(int64(int32(rs)) * int64(int32(rt)))
+		m.state.HI = SE64(acc>>32, 32)
+		m.state.LO = SE64(uint64(uint32(acc)), 32)
+	case 0x19: // multu
+		acc := uint64(uint32(rs)) * uint64(uint32(rt))
+		m.state.HI = SE64(acc>>32, 32)
+		m.state.LO = SE64(uint64(uint32(acc)), 32)
+	case 0x1a: // div
+		m.state.HI = SE64(uint64(uint32(int32(rs)%int32(rt))), 32)
+		m.state.LO = SE64(uint64(uint32(int32(rs)/int32(rt))), 32)
+	case 0x1b: // divu
+		m.state.HI = SE64(uint64(uint32(rs)%uint32(rt)), 32)
+		m.state.LO = SE64(uint64(uint32(rs)/uint32(rt)), 32)
+	case 0x1c: // dmult
+		hi, lo := bits.Mul64(rs, rt)
+		// correct the unsigned product for the signs of the operands
+		if int64(rs) < 0 {
+			hi -= rt
+		}
+		if int64(rt) < 0 {
+			hi -= rs
+		}
+		m.state.HI = hi
+		m.state.LO = lo
+	case 0x1d: // dmultu
+		m.state.HI, m.state.LO = bits.Mul64(rs, rt)
+	case 0x1e: // ddiv
+		m.state.HI = uint64(int64(rs) % int64(rt))
+		m.state.LO = uint64(int64(rs) / int64(rt))
+	case 0x1f: // ddivu
+		m.state.HI = rs % rt
+		m.state.LO = rs / rt
+	}
+
+	if storeReg != 0 {
+		m.state.Registers[storeReg] = val
+	}
+
+	m.state.PC = m.state.NextPC
+	m.state.NextPC = m.state.NextPC + 4
+	return nil
+}
+
+func (m *InstrumentedState64) handleJump(linkReg uint32, dest uint64) error {
+	if m.state.NextPC != m.state.PC+4 {
+		panic("jump in delay slot")
+	}
+	prevPC := m.state.PC
+	m.state.PC = m.state.NextPC
+	m.state.NextPC = dest
+	if linkReg != 0 {
+		m.state.Registers[linkReg] = prevPC + 8 // set the link-register to the instr after the delay slot instruction.
+	}
+	return nil
+}
+
+func (m *InstrumentedState64) handleRd(storeReg uint32, val uint64, conditional bool) error {
+	if storeReg >= 32 {
+		panic("invalid register")
+	}
+	if storeReg != 0 && conditional {
+		m.state.Registers[storeReg] = val
+	}
+	m.state.PC = m.state.NextPC
+	m.state.NextPC = m.state.NextPC + 4
+	return nil
+}
+
+// isLoad64 returns true if the opcode loads from memory.
+func isLoad64(opcode uint32) bool {
+	return (opcode >= 0x20 && opcode < 0x28) || opcode == 0x1a || opcode == 0x1b || opcode == 0x30 || opcode == 0x34 || opcode == 0x37
+}
+
+// isStore64 returns true if the opcode stores to memory. The memory is also loaded for stores,
+// since the stores of less than a doubleword merge with it.
+func isStore64(opcode uint32) bool {
+	return (opcode >= 0x28 && opcode < 0x2f) || opcode == 0x38 || opcode == 0x3c || opcode == 0x3f
+}
+
+func (m *InstrumentedState64) mipsStep() error {
+	if m.state.Exited {
+		return nil
+	}
+	m.state.Step += 1
+	// instruction fetch
+	insn := m.state.Memory.GetMemory(m.state.PC)
+	opcode := insn >> 26 // 6-bits
+
+	// j-type j/jal
+	if opcode == 2 || opcode == 3 {
+		linkReg := uint32(0)
+		if opcode == 3 {
+			linkReg = 31
+		}
+		// Take top 36 bits of the next PC (its 256 MB region), and concatenate with the 26-bit offset
+		target := (m.state.NextPC &^ 0x0FFFFFFF) | uint64((insn&0x03FFFFFF)<<2)
+		return m.handleJump(linkReg, target)
+	}
+
+	// register fetch
+	rs := uint64(0) // source register 1 value
+	rt := uint64(0) // source register 2 / temp value
+	rtReg := (insn >> 16) & 0x1F
+
+	// R-type or I-type (stores rt)
+	rs = m.state.Registers[(insn>>21)&0x1F]
+	rdReg := rtReg
+	if opcode == 0 || opcode == 0x1c {
+		// R-type (stores rd)
+		rt = m.state.Registers[rtReg]
+		rdReg = (insn >> 11) & 0x1F
+	} else if isLoad64(opcode) || isStore64(opcode) {
+		// the rt value is merged with by the unaligned loads, and stored by the stores
+		rt = m.state.Registers[rtReg]
+	} else if opcode == 0xC || opcode == 0xD || opcode == 0xE {
+		// ZeroExtImm for andi, ori, xori
+		rt = uint64(insn & 0xFFFF)
+	} else {
+		// SignExtImm
+		rt = SE64(uint64(insn&0xFFFF), 16)
+	}
+
+	if (opcode >= 4 && opcode < 8) || opcode == 1 {
+		return m.handleBranch(opcode, insn, rtReg, rs)
+	}
+
+	storeAddr := ^uint64(0)
+	// memory fetch (all I-type)
+	// we do the load for stores also
+	mem := uint64(0)
+	if isLoad64(opcode) || isStore64(opcode) {
+		// M[R[rs]+SignExtImm]
+		rs += SE64(uint64(insn&0xFFFF), 16)
+		addr := rs &^ 7
+		mem = m.state.Memory.GetDoubleword(addr)
+		if isStore64(opcode) {
+			storeAddr = addr
+			// store opcodes don't write back to a register
+			rdReg = 0
+		}
+	}
+
+	// ALU
+	val := execute64(insn, rs, rt, mem)
+
+	fun := insn & 0x3f // 6-bits
+	if opcode == 0 && fun >= 8 && fun < 0x20 {
+		if fun == 8 || fun == 9 { // jr/jalr
+			linkReg := uint32(0)
+			if fun == 9 {
+				linkReg = rdReg
+			}
+			return m.handleJump(linkReg, rs)
+		}
+
+		if fun == 0xa { // movz
+			return m.handleRd(rdReg, rs, rt == 0)
+		}
+		if fun == 0xb { // movn
+			return m.handleRd(rdReg, rs, rt != 0)
+		}
+
+		// syscall (can read and write)
+		if fun == 0xC {
+			return m.handleSyscall()
+		}
+
+		// lo and hi registers
+		// can write back
+		if fun >= 0x10 && fun < 0x20 && fun != 0x14 && fun != 0x16 && fun != 0x17 {
+			return m.handleHiLo(fun, rs, rt, rdReg)
+		}
+	}
+
+	// stupid sc and scd, write a 1 to rt
+	if (opcode == 0x38 || opcode == 0x3c) && rtReg != 0 {
+		m.state.Registers[rtReg] = 1
+	}
+
+	// write memory
+	if storeAddr != ^uint64(0) {
+		m.state.Memory.SetDoubleword(storeAddr, val)
+	}
+
+	// write back the value to destination register
+	return m.handleRd(rdReg, val, true)
+}
+
+// execute64 executes the arithmetic, logic and memory instructions. The 32-bit instructions operate on the lower
+// words of the registers and sign-extend their results, as MIPS64 requires. For memory instructions, mem is the
+// doubleword that holds the addressed bytes, and stores return the updated doubleword.
+func execute64(insn uint32, rs uint64, rt uint64, mem uint64) uint64 {
+	opcode := insn >> 26 // 6-bits
+
+	if opcode == 0 || (opcode >= 8 && opcode < 0xF) || opcode == 0x18 || opcode == 0x19 {
+		fun := insn & 0x3f // 6-bits
+		// transform ArithLogI to SPECIAL
+		switch opcode {
+		case 8:
+			fun = 0x20 // addi
+		case 9:
+			fun = 0x21 // addiu
+		case 0xA:
+			fun = 0x2A // slti
+		case 0xB:
+			fun = 0x2B // sltiu
+		case 0xC:
+			fun = 0x24 // andi
+		case 0xD:
+			fun = 0x25 // ori
+		case 0xE:
+			fun = 0x26 // xori
+		case 0x18:
+			fun = 0x2C // daddi
+		case 0x19:
+			fun = 0x2D // daddiu
+		}
+
+		shamt := uint64((insn >> 6) & 0x1F)
+		switch fun {
+		case 0x00: // sll
+			return SE64(uint64(uint32(rt)<<shamt), 32)
+		case 0x02: // srl
+			return SE64(uint64(uint32(rt)>>shamt), 32)
+		case 0x03: // sra
+			return SE64(uint64(uint32(int32(rt)>>shamt)), 32)
+		case 0x04: // sllv
+			return SE64(uint64(uint32(rt)<<(rs&0x1F)), 32)
+		case 0x06: // srlv
+			return SE64(uint64(uint32(rt)>>(rs&0x1F)), 32)
+		case 0x07: // srav
+			return SE64(uint64(uint32(int32(rt)>>(rs&0x1F))), 32)
+		// functs in range [0x8, 0x1f] are handled specially by other functions, except for the doubleword shifts
+		case 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f:
+			return rs
+		case 0x14: // dsllv
+			return rt << (rs & 0x3F)
+		case 0x16: // dsrlv
+			return rt >> (rs & 0x3F)
+		case 0x17: // dsrav
+			return uint64(int64(rt) >> (rs & 0x3F))
+		// The rest includes transformed R-type arith imm instructions
+		case 0x20: // add
+			return SE64(uint64(uint32(rs)+uint32(rt)), 32)
+		case 0x21: // addu
+			return SE64(uint64(uint32(rs)+uint32(rt)), 32)
+		case 0x22: // sub
+			return SE64(uint64(uint32(rs)-uint32(rt)), 32)
+		case 0x23: // subu
+			return SE64(uint64(uint32(rs)-uint32(rt)), 32)
+		case 0x24: // and
+			return rs & rt
+		case 0x25: // or
+			return rs | rt
+		case 0x26: // xor
+			return rs ^ rt
+		case 0x27: // nor
+			return ^(rs | rt)
+		case 0x2a: // slti
+			if int64(rs) < int64(rt) {
+				return 1
+			}
+			return 0
+		case 0x2b: // sltiu
+			if rs < rt {
+				return 1
+			}
+			return 0
+		case 0x2c: // dadd
+			return rs + rt
+		case 0x2d: // daddu
+			return rs + rt
+		case 0x2e: // dsub
+			return rs - rt
+		case 0x2f: // dsubu
+			return rs - rt
+		case 0x38: // dsll
+			return rt << shamt
+		case 0x3a: // dsrl
+			return rt >> shamt
+		case 0x3b: // dsra
+			return uint64(int64(rt) >> shamt)
+		case 0x3c: // dsll32
+			return rt << (shamt + 32)
+		case 0x3e: // dsrl32
+			return rt >> (shamt + 32)
+		case 0x3f: // dsra32
+			return uint64(int64(rt) >> (shamt + 32))
+		default:
+			panic("invalid instruction")
+		}
+	} else {
+		// the byte offset of the address into the doubleword, and the word of the doubleword that holds it
+		offset := rs & 7
+		wordShift := 32 - (rs&4)*8
+		word := uint32(mem >> wordShift)
+		switch opcode {
+		// SPECIAL2
+		case 0x1C:
+			fun := insn & 0x3f // 6-bits
+			switch fun {
+			case 0x2: // mul
+				return SE64(uint64(uint32(int32(rs)*int32(rt))), 32)
+			case 0x20, 0x21: // clz, clo
+				v := uint32(rs)
+				if fun == 0x21 {
+					v = ^v
+				}
+				return uint64(bits.LeadingZeros32(v))
+			case 0x24, 0x25: // dclz, dclo
+				if fun == 0x25 {
+					rs = ^rs
+				}
+				return uint64(bits.LeadingZeros64(rs))
+			}
+		case 0x0F: // lui
+			return SE64(rt<<16, 32)
+		case 0x1a: // ldl
+			val := mem << (offset * 8)
+			mask := ^uint64(0) << (offset * 8)
+			return (rt & ^mask) | val
+		case 0x1b: // ldr
+			val := mem >> (56 - offset*8)
+			mask := ^uint64(0) >> (56 - offset*8)
+			return (rt & ^mask) | val
+		case 0x20: // lb
+			return SE64((mem>>(56-offset*8))&0xFF, 8)
+		case 0x21: // lh
+			return SE64((mem>>(48-(offset&6)*8))&0xFFFF, 16)
+		case 0x22: // lwl
+			val := word << ((rs & 3) * 8)
+			mask := uint32(0xFFFFFFFF) << ((rs & 3) * 8)
+			return SE64(uint64((uint32(rt)&^mask)|val), 32)
+		case 0x23: // lw
+			return SE64(uint64(word), 32)
+		case 0x24: // lbu
+			return (mem >> (56 - offset*8)) & 0xFF
+		case 0x25: //  lhu
+			return (mem >> (48 - (offset&6)*8)) & 0xFFFF
+		case 0x26: //  lwr
+			val := word >> (24 - (rs&3)*8)
+			mask := uint32(0xFFFFFFFF) >> (24 - (rs&3)*8)
+			return SE64(uint64((uint32(rt)&^mask)|val), 32)
+		case 0x27: // lwu
+			return uint64(word)
+		case 0x28: //  sb
+			val := (rt & 0xFF) << (56 - offset*8)
+			mask := ^(uint64(0xFF) << (56 - offset*8))
+			return (mem & mask) | val
+		case 0x29: //  sh
+			val := (rt & 0xFFFF) << (48 - (offset&6)*8)
+			mask := ^(uint64(0xFFFF) << (48 - (offset&6)*8))
+			return (mem & mask) | val
+		case 0x2a: //  swl
+			val := uint32(rt) >> ((rs & 3) * 8)
+			mask := uint32(0xFFFFFFFF) >> ((rs & 3) * 8)
+			return setWord64(mem, wordShift, (word&^mask)|val)
+		case 0x2b: //  sw
+			return setWord64(mem, wordShift, uint32(rt))
+		case 0x2c: // sdl
+			val := rt >> (offset * 8)
+			mask := ^uint64(0) >> (offset * 8)
+			return (mem & ^mask) | val
+		case 0x2d: // sdr
+			val := rt << (56 - offset*8)
+			mask := ^uint64(0) << (56 - offset*8)
+			return (mem & ^mask) | val
+		case 0x2e: //  swr
+			val := uint32(rt) << (24 - (rs&3)*8)
+			mask := uint32(0xFFFFFFFF) << (24 - (rs&3)*8)
+			return setWord64(mem, wordShift, (word&^mask)|val)
+		case 0x30: //  ll
+			return SE64(uint64(word), 32)
+		case 0x34: // lld
+			return mem
+		case 0x37: // ld
+			return mem
+		case 0x38: //  sc
+			return setWord64(mem, wordShift, uint32(rt))
+		case 0x3c: // scd
+			return rt
+		case 0x3f: // sd
+			return rt
+		default:
+			panic("invalid instruction")
+		}
+	}
+	panic("invalid instruction")
+}
+
+// setWord64 replaces the word of the doubleword at the shift.
+func setWord64(mem uint64, shift uint64, word uint32) uint64 {
+	mask := uint64(0xFFFFFFFF) << shift
+	return (mem & ^mask) | (uint64(word) << shift)
+}
+
+// SE64 sign-extends the lower idx bits of dat to 64 bits.
+func SE64(dat uint64, idx uint64) uint64 {
+	isSigned := (dat>>(idx-1))&1 != 0
+	signed := ^uint64(0) << idx
+	mask := (uint64(1) << idx) - 1
+	if isSigned {
+		return dat&mask | signed
+	}
+	return dat & mask
+}
@@ -0,0 +1,99 @@
+package mipsevm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func rType64(rs, rt, rd, sa, fun uint32) uint32 {
+	return rs<<21 | rt<<16 | rd<<11 | sa<<6 | fun
+}
+
+func iType64(opcode, rs, rt uint32, imm int16) uint32 {
+	return opcode<<26 | rs<<21 | rt<<16 | uint32(uint16(imm))
+}
+
+func TestStep64(t *testing.T) {
+	program := []uint32{
+		iType64(0x19, 0, 8, 1),        // daddiu $t0, $zero, 1
+		rType64(0, 8, 8, 4, 0x3c),     // dsll32 $t0, $t0, 4
+		iType64(0x19, 0, 9, -1),       // daddiu $t1, $zero, -1
+		iType64(0x3f, 8, 9, 8),        // sd $t1, 8($t0)
+		iType64(0x23, 8, 10, 12),      // lw $t2, 12($t0)
+		iType64(0x27, 8, 11, 12),      // lwu $t3, 12($t0)
+		rType64(9, 9, 0, 0, 0x1d),     // dmultu $t1, $t1
+		rType64(0, 0, 13, 0, 0x10),    // mfhi $t5
+		iType64(0x19, 0, 2, 5001),     // daddiu $v0, $zero, write
+		iType64(0x19, 0, 4, fdStdout), // daddiu $a0, $zero, stdout
+		iType64(0x19, 8, 5, 8),        // daddiu $a1, $t0, 8
+		iType64(0x19, 0, 6, 2),        // daddiu $a2, $zero, 2
+		0xc,                           // syscall
+		iType64(0x19, 0, 2, 5205),     // daddiu $v0, $zero, exit_group
+		iType64(0x19, 0, 4, 3),        // daddiu $a0, $zero, 3
+		0xc,                           // syscall
+	}
+	code := make([]byte, 0, len(program)*4)
+	for _, insn := range program {
+		code = binary.BigEndian.AppendUint32(code, insn)
+	}
+	state := &State64{Memory: NewMemory64(), PC: 0x1_0000_0000, NextPC: 0x1_0000_0004, Heap: heap64}
+	require.NoError(t, state.Memory.SetMemoryRange(state.PC, bytes.NewReader(code)))
+
+	var stdOut bytes.Buffer
+	us := NewInstrumentedState64(state, nil, &stdOut, nil)
+	for i := 0; i < len(program); i++ {
+		_, err := us.Step(false)
+		require.NoError(t, err)
+	}
+
+	require.True(t, state.Exited)
+	require.Equal(t, uint8(3), state.ExitCode)
+	require.Equal(t, uint64(len(program)), state.Step)
+	require.Equal(t, uint64(1<<36), state.Registers[8])
+	require.Equal(t, ^uint64(0), state.Memory.GetDoubleword(1<<36+8))
+	require.Equal(t, ^uint64(0), state.Registers[10], "lw sign-extends")
+	require.Equal(t, uint64(0xFFFF_FFFF), state.Registers[11], "lwu zero-extends")
+	require.Equal(t, uint64(1), state.LO)
+	require.Equal(t, ^uint64(1), state.Registers[13])
+	require.Equal(t, []byte{0xff, 0xff}, stdOut.Bytes())
+
+	_, err := us.Step(true)
+	require.ErrorIs(t, err, ErrMemProof64Unsupported)
+}
+
+func TestExecute64(t *testing.T) {
+	tests := []struct {
+		name     string
+		insn     uint32
+		rs       uint64
+		rt       uint64
+		mem      uint64
+		expected uint64
+	}{
+		{name: "addu sign-extends", insn: rType64(1, 2, 3, 0, 0x21), rs: 0x7FFF_FFFF, rt: 1, expected: 0xFFFF_FFFF_8000_0000},
+		{name: "daddu", insn: rType64(1, 2, 3, 0, 0x2d), rs: 0x7FFF_FFFF, rt: 1, expected: 0x8000_0000},
+		{name: "sll sign-extends", insn: rType64(0, 2, 3, 31, 0x00), rt: 1, expected: 0xFFFF_FFFF_8000_0000},
+		{name: "dsra32", insn: rType64(0, 2, 3, 0, 0x3f), rt: 0x8000_0000_0000_0000, expected: 0xFFFF_FFFF_8000_0000},
+		{name: "dsrlv", insn: rType64(1, 2, 3, 0, 0x16), rs: 40, rt: 1 << 63, expected: 1 << 23},
+		{name: "slt", insn: rType64(1, 2, 3, 0, 0x2a), rs: ^uint64(0), rt: 0, expected: 1},
+		{name: "sltu", insn: rType64(1, 2, 3, 0, 0x2b), rs: ^uint64(0), rt: 0, expected: 0},
+		{name: "lui sign-extends", insn: iType64(0x0F, 0, 2, -1), rt: SE64(0xFFFF, 16), expected: 0xFFFF_FFFF_FFFF_0000},
+		{name: "dclz", insn: 0x1C<<26 | rType64(1, 0, 3, 0, 0x24), rs: 1 << 40, expected: 23},
+		{name: "lb", insn: iType64(0x20, 1, 2, 0), rs: 0x1_0000_0007, mem: 0x80, expected: ^uint64(0x7F)},
+		{name: "lhu", insn: iType64(0x25, 1, 2, 0), rs: 0x1_0000_0002, mem: 0x0000_ABCD_0000_0000, expected: 0xABCD},
+		{name: "ldl", insn: iType64(0x1a, 1, 2, 0), rs: 0x1_0000_0006, rt: 0x1111_1111_1111_1111, mem: 0x0102_0304_0506_0708, expected: 0x0708_1111_1111_1111},
+		{name: "ldr", insn: iType64(0x1b, 1, 2, 0), rs: 0x1_0000_0001, rt: 0x1111_1111_1111_1111, mem: 0x0102_0304_0506_0708, expected: 0x1111_1111_1111_0102},
+		{name: "sb", insn: iType64(0x28, 1, 2, 0), rs: 0x1_0000_0001, rt: 0xAB, mem: 0, expected: 0x00AB_0000_0000_0000},
+		{name: "sw", insn: iType64(0x2b, 1, 2, 0), rs: 0x1_0000_0004, rt: 0x1234_5678, mem: ^uint64(0), expected: 0xFFFF_FFFF_1234_5678},
+		{name: "sdl", insn: iType64(0x2c, 1, 2, 0), rs: 0x1_0000_0006, rt: 0x0102_0304_0506_0708, mem: ^uint64(0), expected: 0xFFFF_FFFF_FFFF_0102},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, execute64(test.insn, test.rs, test.rt, test.mem))
+		})
+	}
+}
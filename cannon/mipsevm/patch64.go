@@ -0,0 +1,129 @@
+package mipsevm
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	// heap64 is where the heap of a State64 starts, above the program.
+	heap64 = 0x10_00_00_00_00
+	// brk64 is the program break that is reported to programs of a State64.
+	brk64 = 0x40_00_00_00_00
+	// sp64 is the initial stack pointer of a State64.
+	sp64 = 0x7F_FF_FF_FF_D0_00
+)
+
+// LoadELF64 loads a 64-bit ELF file into a State64.
+func LoadELF64(f *elf.File) (*State64, error) {
+	if f.Class != elf.ELFCLASS64 {
+		return nil, fmt.Errorf("expected a 64-bit ELF file, but got %v", f.Class)
+	}
+	s := &State64{
+		PC:        f.Entry,
+		NextPC:    f.Entry + 4,
+		Heap:      heap64,
+		Registers: [32]uint64{},
+		Memory:    NewMemory64(),
+	}
+
+	for i, prog := range f.Progs {
+		if prog.Type == 0x70000003 { // MIPS_ABIFLAGS
+			continue
+		}
+
+		r := io.Reader(io.NewSectionReader(prog, 0, int64(prog.Filesz)))
+		if prog.Filesz != prog.Memsz {
+			if prog.Type == elf.PT_LOAD {
+				if prog.Filesz < prog.Memsz {
+					r = io.MultiReader(r, bytes.NewReader(make([]byte, prog.Memsz-prog.Filesz)))
+				} else {
+					return nil, fmt.Errorf("invalid PT_LOAD program segment %d, file size (%d) > mem size (%d)", i, prog.Filesz, prog.Memsz)
+				}
+			} else {
+				return nil, fmt.Errorf("program segment %d has different file size (%d) than mem size (%d): filling for non PT_LOAD segments is not supported", i, prog.Filesz, prog.Memsz)
+			}
+		}
+
+		if prog.Vaddr+prog.Memsz < prog.Vaddr {
+			return nil, fmt.Errorf("program %d out of 64-bit mem range: %x - %x (size: %x)", i, prog.Vaddr, prog.Vaddr+prog.Memsz, prog.Memsz)
+		}
+		if err := s.Memory.SetMemoryRange(prog.Vaddr, r); err != nil {
+			return nil, fmt.Errorf("failed to read program segment %d: %w", i, err)
+		}
+	}
+
+	return s, nil
+}
+
+// PatchGo64 patches the Go runtime of a 64-bit program, like PatchGo.
+func PatchGo64(f *elf.File, st *State64) error {
+	symbols, err := f.Symbols()
+	if err != nil {
+		return fmt.Errorf("failed to read symbols data, cannot patch program: %w", err)
+	}
+
+	for _, s := range symbols {
+		switch s.Name {
+		case "runtime.gcenable",
+			"runtime.init.5",
+			"runtime.main.func1",
+			"runtime.deductSweepCredit",
+			"runtime.(*gcControllerState).commit",
+			"github.com/prometheus/client_golang/prometheus.init",
+			"github.com/prometheus/client_golang/prometheus.init.0",
+			"github.com/prometheus/procfs.init",
+			"github.com/prometheus/common/model.init",
+			"github.com/prometheus/client_model/go.init",
+			"github.com/prometheus/client_model/go.init.0",
+			"github.com/prometheus/client_model/go.init.1",
+			"flag.init",
+			"runtime.check":
+			// jr $ra and a nop in the delay slot, which have the same encoding in MIPS64
+			if err := st.Memory.SetMemoryRange(s.Value, bytes.NewReader([]byte{
+				0x03, 0xe0, 0x00, 0x08,
+				0, 0, 0, 0,
+			})); err != nil {
+				return fmt.Errorf("failed to patch Go runtime.gcenable: %w", err)
+			}
+		case "runtime.MemProfileRate":
+			if err := st.Memory.SetMemoryRange(s.Value, bytes.NewReader(make([]byte, 8))); err != nil { // disable mem profiling, to avoid a lot of unnecessary floating point ops
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// PatchStack64 sets up the initial stack of a 64-bit program, like PatchStack but with 8-byte words.
+func PatchStack64(st *State64) error {
+	sp := uint64(sp64)
+	// allocate 1 page for the initial stack data, and 16KB = 4 pages for the stack to grow
+	if err := st.Memory.SetMemoryRange(sp-4*PageSize, bytes.NewReader(make([]byte, 5*PageSize))); err != nil {
+		return fmt.Errorf("failed to allocate page for stack content")
+	}
+	st.Registers[29] = sp
+
+	storeMem := func(addr uint64, v uint64) {
+		var dat [8]byte
+		binary.BigEndian.PutUint64(dat[:], v)
+		_ = st.Memory.SetMemoryRange(addr, bytes.NewReader(dat[:]))
+	}
+
+	// init argc, argv, aux on stack
+	storeMem(sp+8*1, 0x42)   // argc = 0 (argument count)
+	storeMem(sp+8*2, 0x35)   // argv[n] = 0 (terminating argv)
+	storeMem(sp+8*3, 0)      // envp[term] = 0 (no env vars)
+	storeMem(sp+8*4, 6)      // auxv[0] = _AT_PAGESZ = 6 (key)
+	storeMem(sp+8*5, 4096)   // auxv[1] = page size of 4 KiB (value) - (== minPhysPageSize)
+	storeMem(sp+8*6, 25)     // auxv[2] = AT_RANDOM
+	storeMem(sp+8*7, sp+8*9) // auxv[3] = address of 16 bytes containing random value
+	storeMem(sp+8*8, 0)      // auxv[term] = 0
+
+	_ = st.Memory.SetMemoryRange(sp+8*9, bytes.NewReader([]byte("4;byfairdiceroll"))) // 16 bytes of "randomness"
+
+	return nil
+}
@@ -40,6 +40,10 @@ type State struct {
 	// Warning: the hint MAY NOT BE COMPLETE. I.e. this is buffered,
 	// and should only be read when len(LastHint) > 4 && uint32(LastHint[:4]) >= len(LastHint[4:])
 	LastHint hexutil.Bytes `json:"lastHint,omitempty"`
+
+	// Threading is nil for single-threaded states, in which clone does not create a thread,
+	// as the onchain VM implements. See Threading.
+	Threading *Threading `json:"threading,omitempty"`
 }
 
 func (s *State) VMStatus() uint8 {
@@ -67,6 +71,10 @@ func (s *State) EncodeWitness() StateWitness {
 	for _, r := range s.Registers {
 		out = binary.BigEndian.AppendUint32(out, r)
 	}
+	if s.Threading != nil {
+		threadsHash := s.Threading.Hash()
+		out = append(out, threadsHash[:]...)
+	}
 	return out
 }
 
@@ -79,13 +87,19 @@ const (
 	VMStatusUnfinished = 3
 )
 
+// StateHash hashes the witness of a State or a State64, which are told apart by the length of the witness.
 func (sw StateWitness) StateHash() (common.Hash, error) {
-	if len(sw) != 226 {
-		return common.Hash{}, fmt.Errorf("Invalid witness length. Got %d, expected at least 88", len(sw))
+	var offset int
+	switch len(sw) {
+	case StateWitnessSize, StateWitnessSizeThreads:
+		offset = 32*2 + 4*6
+	case StateWitnessSize64:
+		offset = 32*2 + 4 + 8*5
+	default:
+		return common.Hash{}, fmt.Errorf("Invalid witness length. Got %d, expected %d, %d or %d", len(sw), StateWitnessSize, StateWitnessSizeThreads, StateWitnessSize64)
 	}
 
 	hash := crypto.Keccak256Hash(sw)
-	offset := 32*2 + 4*6
 	exitCode := sw[offset]
 	exited := sw[offset+1]
 	status := vmStatus(exited == 1, exitCode)
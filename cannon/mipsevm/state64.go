@@ -0,0 +1,123 @@
+package mipsevm
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StateWitnessSize64 is the size of the state witness encoding of a State64 in bytes.
+var StateWitnessSize64 = 374
+
+// Word sizes of the states, in bits.
+const (
+	WordSize32 = 32
+	WordSize64 = 64
+)
+
+// FPVMState is implemented by the states of both word sizes.
+type FPVMState interface {
+	// WordSize returns the size of the registers and addresses of the state, in bits.
+	WordSize() int
+	GetStep() uint64
+	GetExited() bool
+	VMStatus() uint8
+	EncodeWitness() StateWitness
+}
+
+var (
+	_ FPVMState = (*State)(nil)
+	_ FPVMState = (*State64)(nil)
+)
+
+func (s *State) WordSize() int {
+	return WordSize32
+}
+
+func (s *State) GetStep() uint64 {
+	return s.Step
+}
+
+func (s *State) GetExited() bool {
+	return s.Exited
+}
+
+// State64 is the state of the MIPS64 execution mode, loaded from 64-bit ELF files.
+// The registers, the HI and LO registers, the program counters and the heap are 64 bits wide.
+type State64 struct {
+	Memory *Memory64 `json:"memory"`
+
+	PreimageKey    common.Hash `json:"preimageKey"`
+	PreimageOffset uint32      `json:"preimageOffset"` // note that the offset includes the 8-byte length prefix
+
+	PC     uint64 `json:"pc"`
+	NextPC uint64 `json:"nextPC"`
+	LO     uint64 `json:"lo"`
+	HI     uint64 `json:"hi"`
+	Heap   uint64 `json:"heap"` // to handle mmap growth
+
+	ExitCode uint8 `json:"exit"`
+	Exited   bool  `json:"exited"`
+
+	Step uint64 `json:"step"`
+
+	Registers [32]uint64 `json:"registers"`
+
+	// LastHint is optional metadata, and not part of the VM state itself. See State.LastHint.
+	LastHint hexutil.Bytes `json:"lastHint,omitempty"`
+}
+
+// MarshalJSON adds the word size to the JSON encoding, which tells it apart from the encoding of a 32-bit State.
+func (s *State64) MarshalJSON() ([]byte, error) { // nosemgrep
+	type state64 State64
+	return json.Marshal(&struct {
+		WordSize int `json:"wordSize"`
+		*state64
+	}{
+		WordSize: WordSize64,
+		state64:  (*state64)(s),
+	})
+}
+
+func (s *State64) WordSize() int {
+	return WordSize64
+}
+
+func (s *State64) GetStep() uint64 {
+	return s.Step
+}
+
+func (s *State64) GetExited() bool {
+	return s.Exited
+}
+
+func (s *State64) VMStatus() uint8 {
+	return vmStatus(s.Exited, s.ExitCode)
+}
+
+// EncodeWitness encodes the state in the same layout as State.EncodeWitness, but with 64-bit words.
+func (s *State64) EncodeWitness() StateWitness {
+	out := make([]byte, 0, StateWitnessSize64)
+	memRoot := s.Memory.MerkleRoot()
+	out = append(out, memRoot[:]...)
+	out = append(out, s.PreimageKey[:]...)
+	out = binary.BigEndian.AppendUint32(out, s.PreimageOffset)
+	out = binary.BigEndian.AppendUint64(out, s.PC)
+	out = binary.BigEndian.AppendUint64(out, s.NextPC)
+	out = binary.BigEndian.AppendUint64(out, s.LO)
+	out = binary.BigEndian.AppendUint64(out, s.HI)
+	out = binary.BigEndian.AppendUint64(out, s.Heap)
+	out = append(out, s.ExitCode)
+	if s.Exited {
+		out = append(out, 1)
+	} else {
+		out = append(out, 0)
+	}
+	out = binary.BigEndian.AppendUint64(out, s.Step)
+	for _, r := range s.Registers {
+		out = binary.BigEndian.AppendUint64(out, r)
+	}
+	return out
+}
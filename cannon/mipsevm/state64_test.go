@@ -0,0 +1,127 @@
+package mipsevm
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory64MerkleRoot(t *testing.T) {
+	m := NewMemory64()
+	require.Equal(t, zeroHashes[memory64TreeDepth], m.MerkleRoot())
+
+	// a zeroed page has the same root as no page
+	m.SetDoubleword(0x1_0000_0000, 0)
+	require.Equal(t, zeroHashes[memory64TreeDepth], m.MerkleRoot())
+
+	m.SetDoubleword(0x1_0000_0000, 0x0102030405060708)
+	root := m.MerkleRoot()
+	require.NotEqual(t, zeroHashes[memory64TreeDepth], root)
+
+	// hash the page root up the tree by hand, the page is the left child at every level but one
+	page := m.pages[0x1_0000_0000>>PageAddrSize].MerkleRoot()
+	node := page
+	for height := PageAddrSize - 5; height < memory64TreeDepth; height++ {
+		if height == 32-5 {
+			node = HashPair(zeroHashes[height], node)
+		} else {
+			node = HashPair(node, zeroHashes[height])
+		}
+	}
+	require.Equal(t, node, root)
+
+	m.SetDoubleword(0xFFFF_FFFF_FFFF_FFF8, 1)
+	require.NotEqual(t, root, m.MerkleRoot())
+	require.Equal(t, uint64(1), m.GetDoubleword(0xFFFF_FFFF_FFFF_FFF8))
+	require.Equal(t, uint32(0x01020304), m.GetMemory(0x1_0000_0000))
+	require.Equal(t, uint32(0x05060708), m.GetMemory(0x1_0000_0004))
+}
+
+func TestState64Witness(t *testing.T) {
+	state := &State64{
+		Memory:   NewMemory64(),
+		PC:       0x1_0000_0000,
+		NextPC:   0x1_0000_0004,
+		Heap:     heap64,
+		ExitCode: 1,
+		Exited:   true,
+		Step:     1234,
+	}
+	state.Registers[31] = 0xFFFF_FFFF_0000_0000
+	witness := state.EncodeWitness()
+	require.Len(t, witness, StateWitnessSize64)
+	require.Equal(t, []byte{0, 0, 0, 1, 0, 0, 0, 0}, []byte(witness[68:76]))
+
+	hash, err := witness.StateHash()
+	require.NoError(t, err)
+	require.Equal(t, uint8(VMStatusInvalid), hash[0])
+
+	_, err = StateWitness(witness[:StateWitnessSize64-1]).StateHash()
+	require.ErrorContains(t, err, "Invalid witness length")
+}
+
+func TestDecodeAnyState(t *testing.T) {
+	state64 := &State64{
+		Memory:         NewMemory64(),
+		PreimageKey:    common.Hash{0xab},
+		PreimageOffset: 12,
+		PC:             0x1_0000_0000,
+		NextPC:         0x1_0000_0004,
+		Heap:           heap64,
+		Step:           1234,
+		LastHint:       []byte{1, 2, 3},
+	}
+	state64.Registers[5] = 0x1_0000_0042
+	state64.Memory.SetDoubleword(0x2_0000_0008, 123)
+	encoded64, err := json.Marshal(state64)
+	require.NoError(t, err)
+
+	state32 := &State{Memory: NewMemory(), PC: 4, NextPC: 8, Step: 5}
+	state32.Memory.SetMemory(8, 123)
+	encoded32, err := json.Marshal(state32)
+	require.NoError(t, err)
+
+	t.Run("64-bit", func(t *testing.T) {
+		decoded, err := DecodeAnyState(bytes.NewReader(encoded64))
+		require.NoError(t, err)
+		require.Equal(t, WordSize64, decoded.WordSize())
+		require.Equal(t, state64.EncodeWitness(), decoded.EncodeWitness())
+		require.Equal(t, uint64(123), decoded.(*State64).Memory.GetDoubleword(0x2_0000_0008))
+		require.Equal(t, state64.LastHint, decoded.(*State64).LastHint)
+	})
+
+	t.Run("64-bit without memory", func(t *testing.T) {
+		decoded, err := ReadAnyStateWithoutMemory(bytes.NewReader(encoded64))
+		require.NoError(t, err)
+		require.Equal(t, state64.Step, decoded.GetStep())
+		require.Zero(t, decoded.(*State64).Memory.PageCount())
+	})
+
+	t.Run("32-bit", func(t *testing.T) {
+		decoded, err := ReadAnyState(bytes.NewReader(encoded32))
+		require.NoError(t, err)
+		require.Equal(t, WordSize32, decoded.WordSize())
+		require.Equal(t, state32.EncodeWitness(), decoded.EncodeWitness())
+	})
+
+	t.Run("32-bit binary", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, EncodeStateBinary(&buf, state32))
+		decoded, err := ReadAnyState(&buf)
+		require.NoError(t, err)
+		require.Equal(t, state32.EncodeWitness(), decoded.EncodeWitness())
+	})
+
+	t.Run("RejectWordSizeOfDecodeState", func(t *testing.T) {
+		_, err := DecodeState(bytes.NewReader(encoded64))
+		require.ErrorIs(t, err, ErrUnexpectedWordSize)
+	})
+
+	t.Run("UnknownWordSize", func(t *testing.T) {
+		_, err := DecodeAnyState(bytes.NewReader([]byte(`{"wordSize":16,"pc":4}`)))
+		require.ErrorIs(t, err, ErrUnexpectedWordSize)
+	})
+}
@@ -0,0 +1,226 @@
+package mipsevm
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// StateBinaryMagic is the header that every binary encoding of a State starts with,
+// followed by the version of the encoding.
+var StateBinaryMagic = [8]byte{'C', 'A', 'N', 'N', 'O', 'N', 'S', 'T'}
+
+// StateBinaryVersion is the version of the binary encoding of a State that EncodeStateBinary writes.
+const StateBinaryVersion = uint8(1)
+
+var ErrUnknownStateBinaryVersion = errors.New("unknown state binary encoding version")
+
+// stateBinaryHeader is the fixed size part of version 1 of the binary encoding, that follows the magic and version.
+// All integers are big endian.
+type stateBinaryHeader struct {
+	PreimageKey    [32]byte
+	PreimageOffset uint32
+	PC             uint32
+	NextPC         uint32
+	LO             uint32
+	HI             uint32
+	Heap           uint32
+	ExitCode       uint8
+	Exited         bool
+	Step           uint64
+	Registers      [32]uint32
+}
+
+// EncodeStateBinary writes the binary encoding of the state to w, which is much faster to encode and decode
+// than the JSON encoding. After the fields of the state, the encoding holds the length prefixed last hint,
+// the number of memory pages, and then the index and the raw data of every page, in ascending order of index.
+// The encoding of a multi-threaded state ends with a 1 byte, followed by the encoding of its Threading.
+func EncodeStateBinary(w io.Writer, state *State) error {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(StateBinaryMagic[:]); err != nil {
+		return err
+	}
+	if err := bw.WriteByte(StateBinaryVersion); err != nil {
+		return err
+	}
+	header := stateBinaryHeader{
+		PreimageKey:    state.PreimageKey,
+		PreimageOffset: state.PreimageOffset,
+		PC:             state.PC,
+		NextPC:         state.NextPC,
+		LO:             state.LO,
+		HI:             state.HI,
+		Heap:           state.Heap,
+		ExitCode:       state.ExitCode,
+		Exited:         state.Exited,
+		Step:           state.Step,
+		Registers:      state.Registers,
+	}
+	if err := binary.Write(bw, binary.BigEndian, &header); err != nil {
+		return err
+	}
+	if err := binary.Write(bw, binary.BigEndian, uint32(len(state.LastHint))); err != nil {
+		return err
+	}
+	if _, err := bw.Write(state.LastHint); err != nil {
+		return err
+	}
+	indices := make([]uint32, 0, state.Memory.PageCount())
+	for index := range state.Memory.pages {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return indices[i] < indices[j]
+	})
+	if err := binary.Write(bw, binary.BigEndian, uint32(len(indices))); err != nil {
+		return err
+	}
+	for _, index := range indices {
+		if err := binary.Write(bw, binary.BigEndian, index); err != nil {
+			return err
+		}
+		if _, err := bw.Write(state.Memory.pages[index].Data[:]); err != nil {
+			return err
+		}
+	}
+	if state.Threading != nil {
+		if err := bw.WriteByte(1); err != nil {
+			return err
+		}
+		if _, err := bw.Write(state.Threading.encode()); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// DecodeStateBinary decodes the binary encoding of a state, see EncodeStateBinary.
+// If withMemory is false, the memory pages are skipped and the memory of the returned state is empty.
+func DecodeStateBinary(r io.Reader, withMemory bool) (*State, error) {
+	br := bufio.NewReader(r)
+	var magic [len(StateBinaryMagic)]byte
+	if _, err := io.ReadFull(br, magic[:]); err != nil {
+		return nil, fmt.Errorf("failed to read magic: %w", err)
+	}
+	if magic != StateBinaryMagic {
+		return nil, fmt.Errorf("invalid magic %x", magic)
+	}
+	version, err := br.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read version: %w", err)
+	}
+	if version != StateBinaryVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownStateBinaryVersion, version)
+	}
+	var header stateBinaryHeader
+	if err := binary.Read(br, binary.BigEndian, &header); err != nil {
+		return nil, fmt.Errorf("failed to read state: %w", err)
+	}
+	state := &State{
+		Memory:         NewMemory(),
+		PreimageKey:    header.PreimageKey,
+		PreimageOffset: header.PreimageOffset,
+		PC:             header.PC,
+		NextPC:         header.NextPC,
+		LO:             header.LO,
+		HI:             header.HI,
+		Heap:           header.Heap,
+		ExitCode:       header.ExitCode,
+		Exited:         header.Exited,
+		Step:           header.Step,
+		Registers:      header.Registers,
+	}
+	var hintLen uint32
+	if err := binary.Read(br, binary.BigEndian, &hintLen); err != nil {
+		return nil, fmt.Errorf("failed to read last hint length: %w", err)
+	}
+	if hintLen > 0 {
+		state.LastHint = make([]byte, hintLen)
+		if _, err := io.ReadFull(br, state.LastHint); err != nil {
+			return nil, fmt.Errorf("failed to read last hint: %w", err)
+		}
+	}
+	var pageCount uint32
+	if err := binary.Read(br, binary.BigEndian, &pageCount); err != nil {
+		return nil, fmt.Errorf("failed to read page count: %w", err)
+	}
+	for i := uint32(0); i < pageCount; i++ {
+		var index uint32
+		if err := binary.Read(br, binary.BigEndian, &index); err != nil {
+			return nil, fmt.Errorf("failed to read index of page entry %d: %w", i, err)
+		}
+		if !withMemory {
+			if _, err := br.Discard(PageSize); err != nil {
+				return nil, fmt.Errorf("failed to skip page entry %d: %w", i, err)
+			}
+			continue
+		}
+		if _, ok := state.Memory.pages[index]; ok {
+			return nil, fmt.Errorf("cannot load duplicate page, entry %d, page index %d", i, index)
+		}
+		page := new(Page)
+		if _, err := io.ReadFull(br, page[:]); err != nil {
+			return nil, fmt.Errorf("failed to read page entry %d: %w", i, err)
+		}
+		state.Memory.AllocPage(index).Data = page
+	}
+	if threaded, err := br.ReadByte(); errors.Is(err, io.EOF) {
+		return state, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read threading flag: %w", err)
+	} else if threaded != 1 {
+		return nil, fmt.Errorf("invalid threading flag %d", threaded)
+	}
+	if state.Threading, err = decodeThreading(br); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// ReadState reads a state from r in either the binary or the JSON encoding, which is detected from the magic of the
+// binary encoding.
+func ReadState(r io.Reader) (*State, error) {
+	return readState(r, true)
+}
+
+// ReadStateWithoutMemory reads a state from r in either the binary or the JSON encoding, skipping the memory pages.
+// See ReadState and DecodeStateWithoutMemory.
+func ReadStateWithoutMemory(r io.Reader) (*State, error) {
+	return readState(r, false)
+}
+
+// ReadAnyState reads a State or a State64 from r, see ReadState and DecodeAnyState.
+// Only 32-bit states have a binary encoding.
+func ReadAnyState(r io.Reader) (FPVMState, error) {
+	return readAnyState(r, true)
+}
+
+// ReadAnyStateWithoutMemory reads a State or a State64 from r, skipping the memory pages.
+// See ReadAnyState and DecodeStateWithoutMemory.
+func ReadAnyStateWithoutMemory(r io.Reader) (FPVMState, error) {
+	return readAnyState(r, false)
+}
+
+func readAnyState(r io.Reader, withMemory bool) (FPVMState, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(StateBinaryMagic))
+	if err == nil && bytes.Equal(magic, StateBinaryMagic[:]) {
+		return DecodeStateBinary(br, withMemory)
+	}
+	return decodeAnyState(br, withMemory)
+}
+
+func readState(r io.Reader, withMemory bool) (*State, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(StateBinaryMagic))
+	if err == nil && bytes.Equal(magic, StateBinaryMagic[:]) {
+		return DecodeStateBinary(br, withMemory)
+	}
+	// Fall back to the JSON encoding, which is also read if the input is too short to hold the magic,
+	// so that the JSON decoder reports the error.
+	return decodeState(br, withMemory)
+}
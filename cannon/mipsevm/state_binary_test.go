@@ -0,0 +1,93 @@
+package mipsevm
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateBinary(t *testing.T) {
+	state := &State{
+		Memory:         NewMemory(),
+		PreimageKey:    common.Hash{0xab},
+		PreimageOffset: 12,
+		PC:             4,
+		NextPC:         8,
+		LO:             5,
+		HI:             6,
+		Heap:           0x1000,
+		ExitCode:       1,
+		Exited:         true,
+		Step:           1234,
+		LastHint:       []byte{1, 2, 3},
+	}
+	state.Registers[5] = 42
+	state.Memory.SetMemory(8, 123)
+	state.Memory.SetMemory(0x10000, 456)
+	var buf bytes.Buffer
+	require.NoError(t, EncodeStateBinary(&buf, state))
+	encoded := buf.Bytes()
+	require.Equal(t, StateBinaryMagic[:], encoded[:len(StateBinaryMagic)])
+
+	t.Run("WithMemory", func(t *testing.T) {
+		decoded, err := DecodeStateBinary(bytes.NewReader(encoded), true)
+		require.NoError(t, err)
+		require.Equal(t, state.EncodeWitness(), decoded.EncodeWitness())
+		require.Equal(t, state.LastHint, decoded.LastHint)
+		require.Equal(t, state.Memory.PageCount(), decoded.Memory.PageCount())
+		require.Equal(t, uint32(123), decoded.Memory.GetMemory(8))
+		require.Equal(t, uint32(456), decoded.Memory.GetMemory(0x10000))
+	})
+
+	t.Run("WithoutMemory", func(t *testing.T) {
+		decoded, err := DecodeStateBinary(bytes.NewReader(encoded), false)
+		require.NoError(t, err)
+		require.Equal(t, state.Step, decoded.Step)
+		require.Equal(t, state.Registers, decoded.Registers)
+		require.Equal(t, state.LastHint, decoded.LastHint)
+		require.Zero(t, decoded.Memory.PageCount())
+	})
+
+	t.Run("UnknownVersion", func(t *testing.T) {
+		invalid := bytes.Clone(encoded)
+		invalid[len(StateBinaryMagic)] = StateBinaryVersion + 1
+		_, err := DecodeStateBinary(bytes.NewReader(invalid), true)
+		require.ErrorIs(t, err, ErrUnknownStateBinaryVersion)
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		_, err := DecodeStateBinary(bytes.NewReader(encoded[:len(encoded)-1]), true)
+		require.Error(t, err)
+	})
+}
+
+func TestReadState(t *testing.T) {
+	state := &State{Memory: NewMemory(), PC: 4, NextPC: 8, Step: 10}
+	state.Memory.SetMemory(8, 123)
+	var binaryEncoded bytes.Buffer
+	require.NoError(t, EncodeStateBinary(&binaryEncoded, state))
+	jsonEncoded, err := json.Marshal(state)
+	require.NoError(t, err)
+
+	for name, encoded := range map[string][]byte{"Binary": binaryEncoded.Bytes(), "JSON": jsonEncoded} {
+		encoded := encoded
+		t.Run(name, func(t *testing.T) {
+			decoded, err := ReadState(bytes.NewReader(encoded))
+			require.NoError(t, err)
+			require.Equal(t, state.EncodeWitness(), decoded.EncodeWitness())
+
+			decoded, err = ReadStateWithoutMemory(bytes.NewReader(encoded))
+			require.NoError(t, err)
+			require.Equal(t, state.Step, decoded.Step)
+			require.Zero(t, decoded.Memory.PageCount())
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ReadState(bytes.NewReader([]byte("{")))
+		require.Error(t, err)
+	})
+}
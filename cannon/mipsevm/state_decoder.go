@@ -0,0 +1,153 @@
+package mipsevm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var ErrUnexpectedWordSize = errors.New("unexpected state word size")
+
+// DecodeState decodes the JSON encoding of a State from r.
+// Unlike decoding the state with a json.Decoder, the memory pages are decoded one at a time as they are read,
+// so the encoding of the memory, which is the bulk of large states, is never buffered in full.
+func DecodeState(r io.Reader) (*State, error) {
+	return decodeState(r, true)
+}
+
+// DecodeStateWithoutMemory decodes the JSON encoding of a State from r, skipping the memory pages without
+// decompressing them. The memory of the returned state is empty, so the state cannot be executed or witnessed,
+// but the other fields, such as Step and Exited, are read much faster than the full state.
+func DecodeStateWithoutMemory(r io.Reader) (*State, error) {
+	return decodeState(r, false)
+}
+
+// DecodeAnyState decodes the JSON encoding of a State or a State64 from r, which are told apart by the word size
+// that the encoding of a State64 holds. See DecodeState.
+func DecodeAnyState(r io.Reader) (FPVMState, error) {
+	return decodeAnyState(r, true)
+}
+
+func decodeState(r io.Reader, withMemory bool) (*State, error) {
+	decoded, err := decodeAnyState(r, withMemory)
+	if err != nil {
+		return nil, err
+	}
+	state, ok := decoded.(*State)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected a %d-bit state, but got a %d-bit state", ErrUnexpectedWordSize, WordSize32, decoded.WordSize())
+	}
+	return state, nil
+}
+
+func decodeAnyState(r io.Reader, withMemory bool) (FPVMState, error) {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, err
+	}
+	// The fields other than the memory are small, so they are collected and decoded into the state at the end,
+	// once the word size is known. The pages are held until then, and added to the memory of the state.
+	fields := make(map[string]json.RawMessage)
+	pages := make(map[uint64]*Page)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected state field name, but got %v", tok)
+		}
+		if key != "memory" {
+			var value json.RawMessage
+			if err := dec.Decode(&value); err != nil {
+				return nil, fmt.Errorf("invalid state field %q: %w", key, err)
+			}
+			fields[key] = value
+			continue
+		}
+		if err := decodePages(dec, pages, withMemory); err != nil {
+			return nil, fmt.Errorf("invalid memory: %w", err)
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return nil, err
+	}
+	wordSize := WordSize32
+	if value, ok := fields["wordSize"]; ok {
+		if err := json.Unmarshal(value, &wordSize); err != nil {
+			return nil, fmt.Errorf("invalid state field \"wordSize\": %w", err)
+		}
+		delete(fields, "wordSize")
+	}
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	switch wordSize {
+	case WordSize32:
+		var state State
+		if err := json.Unmarshal(encoded, &state); err != nil {
+			return nil, err
+		}
+		state.Memory = NewMemory()
+		for index, page := range pages {
+			if index > PageKeyMask {
+				return nil, fmt.Errorf("page index %d out of 32-bit mem range", index)
+			}
+			state.Memory.AllocPage(uint32(index)).Data = page
+		}
+		return &state, nil
+	case WordSize64:
+		var state State64
+		if err := json.Unmarshal(encoded, &state); err != nil {
+			return nil, err
+		}
+		state.Memory = NewMemory64()
+		for index, page := range pages {
+			state.Memory.AllocPage(index).Data = page
+		}
+		return &state, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnexpectedWordSize, wordSize)
+	}
+}
+
+func decodePages(dec *json.Decoder, pages map[uint64]*Page, withMemory bool) error {
+	if err := expectDelim(dec, '['); err != nil {
+		return err
+	}
+	for i := 0; dec.More(); i++ {
+		if !withMemory {
+			// The data of the page is skipped by the decoder, without decompressing it.
+			var entry struct {
+				Index uint64 `json:"index"`
+			}
+			if err := dec.Decode(&entry); err != nil {
+				return err
+			}
+			continue
+		}
+		var entry pageEntry64
+		if err := dec.Decode(&entry); err != nil {
+			return err
+		}
+		if _, ok := pages[entry.Index]; ok {
+			return fmt.Errorf("cannot load duplicate page, entry %d, page index %d", i, entry.Index)
+		}
+		pages[entry.Index] = entry.Data
+	}
+	return expectDelim(dec, ']')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("expected %v, but got %v", delim, tok)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package mipsevm
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeState(t *testing.T) {
+	state := &State{
+		Memory:         NewMemory(),
+		PreimageKey:    common.Hash{0xab},
+		PreimageOffset: 12,
+		PC:             4,
+		NextPC:         8,
+		Heap:           0x1000,
+		ExitCode:       1,
+		Exited:         true,
+		Step:           1234,
+		LastHint:       []byte{1, 2, 3},
+	}
+	state.Registers[5] = 42
+	state.Memory.SetMemory(8, 123)
+	state.Memory.SetMemory(0x10000, 456)
+	encoded, err := json.Marshal(state)
+	require.NoError(t, err)
+
+	t.Run("WithMemory", func(t *testing.T) {
+		decoded, err := DecodeState(bytes.NewReader(encoded))
+		require.NoError(t, err)
+		require.Equal(t, state.EncodeWitness(), decoded.EncodeWitness())
+		require.Equal(t, state.LastHint, decoded.LastHint)
+		require.Equal(t, uint32(123), decoded.Memory.GetMemory(8))
+		require.Equal(t, uint32(456), decoded.Memory.GetMemory(0x10000))
+	})
+
+	t.Run("WithoutMemory", func(t *testing.T) {
+		decoded, err := DecodeStateWithoutMemory(bytes.NewReader(encoded))
+		require.NoError(t, err)
+		require.Equal(t, state.Step, decoded.Step)
+		require.Equal(t, state.Exited, decoded.Exited)
+		require.Equal(t, state.Registers, decoded.Registers)
+		require.Zero(t, decoded.Memory.PageCount())
+	})
+}
+
+func TestDecodeStateRejectsDuplicatePages(t *testing.T) {
+	mem := NewMemory()
+	mem.SetMemory(8, 123)
+	page, err := json.Marshal(mem)
+	require.NoError(t, err)
+	pages := strings.TrimSuffix(strings.TrimPrefix(string(page), "["), "]")
+	encoded := `{"memory":[` + pages + "," + pages + `],"pc":4}`
+	_, err = DecodeState(strings.NewReader(encoded))
+	require.ErrorContains(t, err, "duplicate page")
+}
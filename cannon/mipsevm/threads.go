@@ -0,0 +1,286 @@
+package mipsevm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	sysExit       = 4001
+	sysSchedYield = 4162
+	sysNanosleep  = 4166
+	sysGetTID     = 4222
+	sysFutex      = 4238
+)
+
+const (
+	cloneVM     = 0x100
+	cloneThread = 0x10000
+
+	futexWait        = 0
+	futexWake        = 1
+	futexPrivateFlag = 128
+)
+
+// ThreadQuantum is the number of steps that a thread runs before the next runnable thread is scheduled,
+// if the thread does not block or yield before.
+const ThreadQuantum = 100_000
+
+var (
+	// ErrThreadsProofUnsupported is returned when a proof is requested for a step of a multi-threaded state,
+	// which cannot be verified onchain.
+	ErrThreadsProofUnsupported = errors.New("proofs of multi-threaded states are not supported")
+	ErrThreadsDeadlock         = errors.New("deadlock")
+)
+
+// StateWitnessSizeThreads is the size of the witness of a multi-threaded state, which holds the hash of the
+// threading state after the fields of a single-threaded state.
+var StateWitnessSizeThreads = StateWitnessSize + 32
+
+// ThreadState holds the registers of a thread that is not running.
+type ThreadState struct {
+	ID        uint32     `json:"tid"`
+	PC        uint32     `json:"pc"`
+	NextPC    uint32     `json:"nextPC"`
+	LO        uint32     `json:"lo"`
+	HI        uint32     `json:"hi"`
+	Registers [32]uint32 `json:"registers"`
+
+	// Waiting is set while the thread waits on the futex at FutexAddr, and the thread is not scheduled until it is
+	// woken up.
+	Waiting   bool   `json:"waiting"`
+	FutexAddr uint32 `json:"futexAddr"`
+}
+
+// Threading is the state of the scheduler of a multi-threaded State. The registers in the State are those of the
+// running thread, and the other threads are queued in Threads, in the order that they are scheduled in.
+//
+// A thread runs until it blocks on a futex, yields, exits, or has run for ThreadQuantum steps,
+// and then the first runnable thread in the queue is scheduled, so the schedule only depends on the steps.
+type Threading struct {
+	ThreadID     uint32 `json:"tid"`
+	NextThreadID uint32 `json:"nextTid"`
+	// SliceSteps is the number of steps since the running thread was scheduled.
+	SliceSteps uint64 `json:"sliceSteps"`
+
+	// LLReserved is set by the ll instruction, and cleared by sc and by every thread switch.
+	// The sc instruction only stores if the reservation is still held at the same address, so that ll/sc loops
+	// are atomic across thread switches.
+	LLReserved bool   `json:"llReserved"`
+	LLAddr     uint32 `json:"llAddr"`
+
+	Threads []*ThreadState `json:"threads"`
+}
+
+// NewThreading returns the threading state of a program that only runs its main thread.
+func NewThreading() *Threading {
+	return &Threading{NextThreadID: 1, Threads: []*ThreadState{}}
+}
+
+// encode returns the binary encoding of the threading state, which is hashed into the witness of the state.
+func (t *Threading) encode() []byte {
+	out := make([]byte, 0, 25+len(t.Threads)*153)
+	out = binary.BigEndian.AppendUint32(out, t.ThreadID)
+	out = binary.BigEndian.AppendUint32(out, t.NextThreadID)
+	out = binary.BigEndian.AppendUint64(out, t.SliceSteps)
+	out = appendBool(out, t.LLReserved)
+	out = binary.BigEndian.AppendUint32(out, t.LLAddr)
+	out = binary.BigEndian.AppendUint32(out, uint32(len(t.Threads)))
+	for _, th := range t.Threads {
+		out = binary.BigEndian.AppendUint32(out, th.ID)
+		out = binary.BigEndian.AppendUint32(out, th.PC)
+		out = binary.BigEndian.AppendUint32(out, th.NextPC)
+		out = binary.BigEndian.AppendUint32(out, th.LO)
+		out = binary.BigEndian.AppendUint32(out, th.HI)
+		for _, r := range th.Registers {
+			out = binary.BigEndian.AppendUint32(out, r)
+		}
+		out = appendBool(out, th.Waiting)
+		out = binary.BigEndian.AppendUint32(out, th.FutexAddr)
+	}
+	return out
+}
+
+func decodeThreading(r io.Reader) (*Threading, error) {
+	var header struct {
+		ThreadID     uint32
+		NextThreadID uint32
+		SliceSteps   uint64
+		LLReserved   bool
+		LLAddr       uint32
+		ThreadCount  uint32
+	}
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		return nil, fmt.Errorf("failed to read threading state: %w", err)
+	}
+	t := &Threading{
+		ThreadID:     header.ThreadID,
+		NextThreadID: header.NextThreadID,
+		SliceSteps:   header.SliceSteps,
+		LLReserved:   header.LLReserved,
+		LLAddr:       header.LLAddr,
+		Threads:      make([]*ThreadState, 0, header.ThreadCount),
+	}
+	for i := uint32(0); i < header.ThreadCount; i++ {
+		th := new(ThreadState)
+		if err := binary.Read(r, binary.BigEndian, th); err != nil {
+			return nil, fmt.Errorf("failed to read thread %d: %w", i, err)
+		}
+		t.Threads = append(t.Threads, th)
+	}
+	return t, nil
+}
+
+// Hash commits to the threading state, see Threading.encode.
+func (t *Threading) Hash() common.Hash {
+	return crypto.Keccak256Hash(t.encode())
+}
+
+func appendBool(out []byte, v bool) []byte {
+	if v {
+		return append(out, 1)
+	}
+	return append(out, 0)
+}
+
+// currentThread returns the registers of the running thread.
+func (m *InstrumentedState) currentThread() *ThreadState {
+	return &ThreadState{
+		ID:        m.state.Threading.ThreadID,
+		PC:        m.state.PC,
+		NextPC:    m.state.NextPC,
+		LO:        m.state.LO,
+		HI:        m.state.HI,
+		Registers: m.state.Registers,
+	}
+}
+
+// switchThread queues current, unless the running thread exited and current is nil,
+// and schedules the first runnable thread of the queue.
+func (m *InstrumentedState) switchThread(current *ThreadState) error {
+	t := m.state.Threading
+	if current != nil {
+		t.Threads = append(t.Threads, current)
+	}
+	for i, th := range t.Threads {
+		if th.Waiting {
+			continue
+		}
+		t.Threads = append(t.Threads[:i], t.Threads[i+1:]...)
+		t.ThreadID = th.ID
+		t.SliceSteps = 0
+		t.LLReserved = false
+		m.state.PC = th.PC
+		m.state.NextPC = th.NextPC
+		m.state.LO = th.LO
+		m.state.HI = th.HI
+		m.state.Registers = th.Registers
+		return nil
+	}
+	return fmt.Errorf("%w: all %d threads are waiting on a futex", ErrThreadsDeadlock, len(t.Threads))
+}
+
+// preemptThread schedules the next runnable thread once the running thread used its quantum.
+func (m *InstrumentedState) preemptThread() error {
+	t := m.state.Threading
+	if m.state.Exited {
+		return nil
+	}
+	t.SliceSteps++
+	if t.SliceSteps < ThreadQuantum {
+		return nil
+	}
+	return m.switchThread(m.currentThread())
+}
+
+func (m *InstrumentedState) syscallReturn(v0, v1 uint32) {
+	m.state.Registers[2] = v0
+	m.state.Registers[7] = v1
+	m.state.PC = m.state.NextPC
+	m.state.NextPC = m.state.NextPC + 4
+}
+
+// handleThreadSyscall handles the syscalls of multi-threaded states, and returns false for the other syscalls.
+func (m *InstrumentedState) handleThreadSyscall(syscallNum, a0, a1, a2 uint32) (bool, error) {
+	t := m.state.Threading
+	switch syscallNum {
+	case sysClone:
+		// args: a0 = flags, a1 = child stack
+		// returns: v0 = child thread id in the parent, 0 in the child
+		if a0&(cloneVM|cloneThread) != cloneVM|cloneThread {
+			m.syscallReturn(0xFFffFFff, MipsEINVAL) // only threads that share the memory are supported
+			return true, nil
+		}
+		child := m.currentThread()
+		child.ID = t.NextThreadID
+		child.PC = m.state.NextPC
+		child.NextPC = m.state.NextPC + 4
+		child.Registers[2] = 0
+		child.Registers[7] = 0
+		child.Registers[29] = a1
+		t.NextThreadID++
+		t.Threads = append(t.Threads, child)
+		m.syscallReturn(child.ID, 0)
+	case sysExit:
+		if len(t.Threads) == 0 {
+			m.state.Exited = true
+			m.state.ExitCode = uint8(a0)
+			return true, nil
+		}
+		return true, m.switchThread(nil)
+	case sysGetTID:
+		m.syscallReturn(t.ThreadID, 0)
+	case sysSchedYield, sysNanosleep:
+		m.syscallReturn(0, 0)
+		return true, m.switchThread(m.currentThread())
+	case sysFutex:
+		// args: a0 = addr, a1 = op, a2 = val, a3 = timeout
+		if a0&3 != 0 {
+			m.syscallReturn(0xFFffFFff, MipsEINVAL)
+			return true, nil
+		}
+		switch a1 &^ futexPrivateFlag {
+		case futexWait:
+			if m.state.Memory.GetMemory(a0) != a2 {
+				m.syscallReturn(0xFFffFFff, MipsEAGAIN)
+				return true, nil
+			}
+			timeout := m.state.Registers[7]
+			m.syscallReturn(0, 0)
+			current := m.currentThread()
+			// A wait with a timeout returns once the other threads ran, like a spurious wake-up,
+			// so that there is no clock in the VM.
+			if timeout == 0 {
+				current.Waiting = true
+				current.FutexAddr = a0
+			}
+			return true, m.switchThread(current)
+		case futexWake:
+			woken := uint32(0)
+			for _, th := range t.Threads {
+				if woken < a2 && th.Waiting && th.FutexAddr == a0 {
+					th.Waiting = false
+					woken++
+				}
+			}
+			m.syscallReturn(woken, 0)
+		default:
+			m.syscallReturn(0xFFffFFff, MipsEINVAL)
+		}
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// storeConditional returns true if the sc instruction at addr should store, and clears the reservation of ll.
+func (t *Threading) storeConditional(addr uint32) bool {
+	ok := t.LLReserved && t.LLAddr == addr
+	t.LLReserved = false
+	return ok
+}
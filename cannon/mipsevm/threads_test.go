@@ -0,0 +1,171 @@
+package mipsevm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// threadsState runs a main thread that clones a child thread, and waits on a futex at 0x2000 until the child
+// adds its thread id to the word at 0x2000 with ll/sc, and wakes the main thread. The main thread exits with the
+// word as exit code once the child exits.
+func threadsState(t *testing.T) *State {
+	program := []uint32{
+		0x24102000, // 0x1000: addiu $s0, $zero, 0x2000
+		0x3c040001, // 0x1004: lui $a0, 1
+		0x34840100, // 0x1008: ori $a0, $a0, 0x100 (CLONE_VM | CLONE_THREAD)
+		0x24053000, // 0x100c: addiu $a1, $zero, 0x3000 (child stack)
+		0x24021018, // 0x1010: addiu $v0, $zero, clone
+		0x0000000c, // 0x1014: syscall
+		0x1040000f, // 0x1018: beq $v0, $zero, 0x1058 (child)
+		0x00000000, // 0x101c: nop
+		0x8e080000, // 0x1020: lw $t0, 0($s0)
+		0x15000009, // 0x1024: bne $t0, $zero, 0x104c
+		0x00000000, // 0x1028: nop
+		0x26040000, // 0x102c: addiu $a0, $s0, 0
+		0x24050080, // 0x1030: addiu $a1, $zero, FUTEX_WAIT | FUTEX_PRIVATE_FLAG
+		0x24060000, // 0x1034: addiu $a2, $zero, 0
+		0x24070000, // 0x1038: addiu $a3, $zero, 0 (no timeout)
+		0x2402108e, // 0x103c: addiu $v0, $zero, futex
+		0x0000000c, // 0x1040: syscall
+		0x1000fff6, // 0x1044: beq $zero, $zero, 0x1020
+		0x00000000, // 0x1048: nop
+		0x25040000, // 0x104c: addiu $a0, $t0, 0
+		0x24021096, // 0x1050: addiu $v0, $zero, exit_group
+		0x0000000c, // 0x1054: syscall
+		0x2402107e, // 0x1058: addiu $v0, $zero, gettid
+		0x0000000c, // 0x105c: syscall
+		0xc2090000, // 0x1060: ll $t1, 0($s0)
+		0x01224821, // 0x1064: addu $t1, $t1, $v0
+		0xe2090000, // 0x1068: sc $t1, 0($s0)
+		0x1120fffc, // 0x106c: beq $t1, $zero, 0x1060
+		0x00000000, // 0x1070: nop
+		0x26040000, // 0x1074: addiu $a0, $s0, 0
+		0x24050081, // 0x1078: addiu $a1, $zero, FUTEX_WAKE | FUTEX_PRIVATE_FLAG
+		0x24060001, // 0x107c: addiu $a2, $zero, 1
+		0x2402108e, // 0x1080: addiu $v0, $zero, futex
+		0x0000000c, // 0x1084: syscall
+		0x24040000, // 0x1088: addiu $a0, $zero, 0
+		0x24020fa1, // 0x108c: addiu $v0, $zero, exit
+		0x0000000c, // 0x1090: syscall
+	}
+	code := make([]byte, 0, len(program)*4)
+	for _, insn := range program {
+		code = binary.BigEndian.AppendUint32(code, insn)
+	}
+	state := &State{Memory: NewMemory(), PC: 0x1000, NextPC: 0x1004, Threading: NewThreading()}
+	require.NoError(t, state.Memory.SetMemoryRange(state.PC, bytes.NewReader(code)))
+	return state
+}
+
+func TestThreads(t *testing.T) {
+	state := threadsState(t)
+	us := NewInstrumentedState(state, nil, nil, nil)
+	for i := 0; i < 6; i++ {
+		_, err := us.Step(false)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint32(1), state.Registers[2], "clone returns the child thread id")
+	require.Len(t, state.Threading.Threads, 1)
+	child := state.Threading.Threads[0]
+	require.Equal(t, uint32(1), child.ID)
+	require.Equal(t, uint32(0x1018), child.PC)
+	require.Equal(t, uint32(0), child.Registers[2])
+	require.Equal(t, uint32(0x3000), child.Registers[29])
+
+	witness := state.EncodeWitness()
+	require.Len(t, witness, StateWitnessSizeThreads)
+	_, err := witness.StateHash()
+	require.NoError(t, err)
+	_, err = us.Step(true)
+	require.ErrorIs(t, err, ErrThreadsProofUnsupported)
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		encoded, err := json.Marshal(state)
+		require.NoError(t, err)
+		decoded, err := DecodeState(bytes.NewReader(encoded))
+		require.NoError(t, err)
+		require.Equal(t, witness, decoded.EncodeWitness())
+
+		var buf bytes.Buffer
+		require.NoError(t, EncodeStateBinary(&buf, state))
+		decoded, err = DecodeStateBinary(&buf, true)
+		require.NoError(t, err)
+		require.Equal(t, witness, decoded.EncodeWitness())
+	})
+
+	for i := 0; i < 100 && !state.Exited; i++ {
+		_, err := us.Step(false)
+		require.NoError(t, err)
+	}
+	require.True(t, state.Exited)
+	require.Equal(t, uint8(1), state.ExitCode)
+	require.Empty(t, state.Threading.Threads, "the child thread exited")
+	require.Equal(t, uint32(0), state.Threading.ThreadID)
+}
+
+func TestThreadsDeadlock(t *testing.T) {
+	state := threadsState(t)
+	state.PC, state.NextPC = 0x102c, 0x1030
+	state.Registers[16] = 0x2000
+	us := NewInstrumentedState(state, nil, nil, nil)
+	var err error
+	for i := 0; i < 6 && err == nil; i++ {
+		_, err = us.Step(false)
+	}
+	require.ErrorIs(t, err, ErrThreadsDeadlock)
+}
+
+func TestThreadsPreemptLLSC(t *testing.T) {
+	state := threadsState(t)
+	state.PC, state.NextPC = 0x1060, 0x1064
+	state.Registers[2] = 5
+	state.Registers[16] = 0x2000
+	// another thread that spins on sched_yield
+	state.Threading.Threads = []*ThreadState{{ID: 1, PC: 0x105c, NextPC: 0x1060}}
+	state.Threading.Threads[0].Registers[2] = sysSchedYield
+	state.Threading.NextThreadID = 2
+	state.Threading.SliceSteps = ThreadQuantum - 1
+	us := NewInstrumentedState(state, nil, nil, nil)
+
+	// the ll uses the quantum, and the other thread is scheduled
+	_, err := us.Step(false)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), state.Threading.ThreadID)
+	require.False(t, state.Threading.LLReserved)
+
+	// the other thread yields back, and the sc fails since the reservation was lost
+	_, err = us.Step(false)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), state.Threading.ThreadID)
+	require.Equal(t, uint32(0x1064), state.PC)
+	for i := 0; i < 2; i++ {
+		_, err = us.Step(false)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint32(0), state.Registers[9])
+	require.Equal(t, uint32(0), state.Memory.GetMemory(0x2000))
+
+	// the beq retries ll/sc without a thread switch, which succeeds
+	for i := 0; i < 5; i++ {
+		_, err = us.Step(false)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint32(1), state.Registers[9])
+	require.Equal(t, uint32(5), state.Memory.GetMemory(0x2000))
+}
+
+func TestSingleThreadedClone(t *testing.T) {
+	state := threadsState(t)
+	state.Threading = nil
+	us := NewInstrumentedState(state, nil, nil, nil)
+	for i := 0; i < 6; i++ {
+		_, err := us.Step(false)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint32(1), state.Registers[2], "clone pretends to create a thread")
+	require.Len(t, state.EncodeWitness(), StateWitnessSize)
+}
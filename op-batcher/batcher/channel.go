@@ -3,6 +3,7 @@ package batcher
 import (
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
@@ -22,8 +23,8 @@ type channel struct {
 	channelBuilder *channelBuilder
 	// Set of unconfirmed txID -> frame data. For tx resubmission
 	pendingTransactions map[txID]txData
-	// Set of confirmed txID -> inclusion block. For determining if the channel is timed out
-	confirmedTransactions map[txID]eth.BlockID
+	// Set of confirmed frames of this channel -> inclusion block. For determining if the channel is timed out
+	confirmedTransactions map[frameID]eth.BlockID
 }
 
 func newChannel(log log.Logger, metr metrics.Metricer, cfg ChannelConfig) (*channel, error) {
@@ -37,25 +38,85 @@ func newChannel(log log.Logger, metr metrics.Metricer, cfg ChannelConfig) (*chan
 		cfg:                   cfg,
 		channelBuilder:        cb,
 		pendingTransactions:   make(map[txID]txData),
-		confirmedTransactions: make(map[txID]eth.BlockID),
+		confirmedTransactions: make(map[frameID]eth.BlockID),
 	}, nil
 }
 
-// TxFailed records a transaction as failed. It will attempt to resubmit the data
-// in the failed transaction.
+// restoredChannel creates a channel from its persisted state, with the blocks of the channel, that were
+// loaded again from L2.
+func restoredChannel(log log.Logger, metr metrics.Metricer, cfg ChannelConfig, pc PersistedChannel, blocks []*types.Block) (*channel, error) {
+	frames := make([]frameData, 0, len(pc.Frames))
+	for _, f := range pc.Frames {
+		frames = append(frames, frameData{id: frameID{chID: pc.ID, frameNumber: f.Number}, data: f.Data})
+	}
+	cfg.UseBlobs = pc.UseBlobs
+	cb, err := restoredChannelBuilder(cfg, pc.ID, frames, pc.TotalFrames, blocks)
+	if err != nil {
+		return nil, fmt.Errorf("restoring channel: %w", err)
+	}
+	ch := &channel{
+		log:                   log,
+		metr:                  metr,
+		cfg:                   cfg,
+		channelBuilder:        cb,
+		pendingTransactions:   make(map[txID]txData),
+		confirmedTransactions: make(map[frameID]eth.BlockID),
+	}
+	for _, f := range pc.Confirmed {
+		ch.confirmedTransactions[frameID{chID: pc.ID, frameNumber: f.Number}] = f.Inclusion
+		cb.FramePublished(f.Inclusion.Number)
+	}
+	return ch, nil
+}
+
+// Persisted returns the state of the channel to persist, or false if the channel has no blocks.
+// The frames of in-flight transactions are persisted as not confirmed, so they are sent again after a
+// restart. Derivation ignores frames that were already included.
+func (s *channel) Persisted() (PersistedChannel, bool) {
+	blocks := s.channelBuilder.Blocks()
+	if len(blocks) == 0 {
+		return PersistedChannel{}, false
+	}
+	first, last := blocks[0], blocks[len(blocks)-1]
+	pc := PersistedChannel{
+		ID:          s.ID(),
+		UseBlobs:    s.cfg.UseBlobs,
+		Parent:      eth.BlockID{Hash: first.ParentHash(), Number: first.NumberU64() - 1},
+		Last:        eth.ToBlockID(last),
+		TotalFrames: s.TotalFrames(),
+	}
+	for _, td := range s.pendingTransactions {
+		for _, f := range td.Frames() {
+			if f.id.chID == pc.ID {
+				pc.Frames = append(pc.Frames, PersistedFrame{Number: f.id.frameNumber, Data: f.data})
+			}
+		}
+	}
+	for _, f := range s.channelBuilder.frames {
+		pc.Frames = append(pc.Frames, PersistedFrame{Number: f.id.frameNumber, Data: f.data})
+	}
+	sort.Slice(pc.Frames, func(i, j int) bool { return pc.Frames[i].Number < pc.Frames[j].Number })
+	for id, inclusion := range s.confirmedTransactions {
+		pc.Confirmed = append(pc.Confirmed, ConfirmedFrame{Number: id.frameNumber, Inclusion: inclusion})
+	}
+	sort.Slice(pc.Confirmed, func(i, j int) bool { return pc.Confirmed[i].Number < pc.Confirmed[j].Number })
+	return pc, true
+}
+
+// TxFailed records a transaction as failed. It will attempt to resubmit the frames of this
+// channel in the failed transaction.
 func (s *channel) TxFailed(id txID) {
 	if data, ok := s.pendingTransactions[id]; ok {
 		s.log.Trace("marked transaction as failed", "id", id)
-		// Note: when the batcher is changed to send multiple frames per tx,
-		// this needs to be changed to iterate over all frames of the tx data
-		// and re-queue them.
-		s.channelBuilder.PushFrame(data.Frame())
+		for _, f := range data.Frames() {
+			if f.id.chID == s.ID() {
+				s.channelBuilder.PushFrame(f)
+			}
+		}
 		delete(s.pendingTransactions, id)
 	} else {
 		s.log.Warn("unknown transaction marked as failed", "id", id)
 	}
-
-	s.metr.RecordBatchTxFailed()
 }
 
 // TxConfirmed marks a transaction as confirmed on L1. Unfortunately even if all frames in
@@ -63,16 +124,20 @@ func (s *channel) TxFailed(id txID) {
 // resubmitted.
 // This function may reset the pending channel if the pending channel has timed out.
 func (s *channel) TxConfirmed(id txID, inclusionBlock eth.BlockID) (bool, []*types.Block) {
-	s.metr.RecordBatchTxSubmitted()
 	s.log.Debug("marked transaction as confirmed", "id", id, "block", inclusionBlock)
-	if _, ok := s.pendingTransactions[id]; !ok {
+	data, ok := s.pendingTransactions[id]
+	if !ok {
 		s.log.Warn("unknown transaction marked as confirmed", "id", id, "block", inclusionBlock)
 		// TODO: This can occur if we clear the channel while there are still pending transactions
 		// We need to keep track of stale transactions instead
 		return false, nil
 	}
 	delete(s.pendingTransactions, id)
-	s.confirmedTransactions[id] = inclusionBlock
+	for _, f := range data.Frames() {
+		if f.id.chID == s.ID() {
+			s.confirmedTransactions[f.id] = inclusionBlock
+		}
+	}
 	s.channelBuilder.FramePublished(inclusionBlock.Number)
 
 	// If this channel timed out, put the pending blocks back into the local saved blocks
@@ -126,16 +191,21 @@ func (s *channel) ID() derive.ChannelID {
 	return s.channelBuilder.ID()
 }
 
-func (s *channel) NextTxData() txData {
-	frame := s.channelBuilder.NextFrame()
-
-	txdata := txData{frame}
-	id := txdata.ID()
+// NextFrame returns the next frame of the channel, to be added to tx data, which must then be
+// registered with TxSent.
+func (s *channel) NextFrame() frameData {
+	return s.channelBuilder.NextFrame()
+}
 
-	s.log.Trace("returning next tx data", "id", id)
-	s.pendingTransactions[id] = txdata
+// PeekFrame returns the next frame of the channel, without removing it.
+func (s *channel) PeekFrame() frameData {
+	return s.channelBuilder.PeekFrame()
+}
 
-	return txdata
+// TxSent records the tx data, which contains frames of this channel, as pending.
+func (s *channel) TxSent(txdata txData) {
+	s.log.Trace("returning next tx data", "id", txdata.ID(), "num_frames", len(txdata.Frames()))
+	s.pendingTransactions[txdata.ID()] = txdata
 }
 
 func (s *channel) HasFrame() bool {
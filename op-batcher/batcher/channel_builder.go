@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"math/big"
 
 	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 var (
@@ -19,6 +22,7 @@ var (
 	ErrChannelTimeoutClose   = errors.New("close to channel timeout")
 	ErrSeqWindowClose        = errors.New("close to sequencer window timeout")
 	ErrTerminated            = errors.New("channel terminated")
+	ErrRestored              = errors.New("channel restored from persisted state")
 )
 
 type ChannelFullError struct {
@@ -58,6 +62,29 @@ type ChannelConfig struct {
 
 	// CompressorConfig contains the configuration for creating new compressors.
 	CompressorConfig compressor.Config
+
+	// BatchType is the type of batches the blocks of a channel are encoded in,
+	// derive.SingularBatchType or derive.SpanBatchType.
+	BatchType uint
+	// GenesisTimestamp and ChainID of the L2 chain are required to encode span batches.
+	GenesisTimestamp uint64
+	ChainID          *big.Int
+
+	// UseBlobs submits the frames of the channel as blobs, one frame per blob, instead of calldata.
+	// The MaxFrameSize must then fit, with the version byte, into a blob.
+	UseBlobs bool
+	// MaxFramesPerTx is the maximum number of frames, possibly of multiple channels, that are packed into
+	// a single transaction. Calldata frames are only packed while they fit into MaxFrameSize together,
+	// and a blob tx carries at most MaxBlobsPerTx blobs. If 0, each transaction carries a single frame.
+	MaxFramesPerTx int
+}
+
+// MaxBlobsPerTx is the maximum number of blobs of a blob tx, which is limited by the blob gas of a block.
+const MaxBlobsPerTx = params.MaxBlobGasPerBlock / params.BlobTxBlobGasPerBlob
+
+// ChannelConfig returns the config itself, so that a static config is a [ChannelConfigProvider].
+func (cc ChannelConfig) ChannelConfig() ChannelConfig {
+	return cc
 }
 
 // Check validates the [ChannelConfig] parameters.
@@ -83,6 +110,24 @@ func (cc *ChannelConfig) Check() error {
 		return fmt.Errorf("max frame size %d is less than the minimum 23", cc.MaxFrameSize)
 	}
 
+	// The frame and the version byte must fit into a blob.
+	if cc.UseBlobs && cc.MaxFrameSize > eth.MaxBlobDataSize-1 {
+		return fmt.Errorf("max frame size %d is larger than the maximum blob data size %d minus the version byte", cc.MaxFrameSize, eth.MaxBlobDataSize)
+	}
+
+	if cc.BatchType == derive.SpanBatchType && cc.ChainID == nil {
+		return errors.New("span batches require the L2 chain ID")
+	} else if cc.BatchType > derive.SpanBatchType {
+		return fmt.Errorf("unknown batch type %d", cc.BatchType)
+	}
+
+	if cc.MaxFramesPerTx < 0 {
+		return fmt.Errorf("max frames per tx %d cannot be negative", cc.MaxFramesPerTx)
+	}
+	if cc.UseBlobs && cc.MaxFramesPerTx > MaxBlobsPerTx {
+		return fmt.Errorf("max frames per tx %d is larger than the maximum number of blobs per tx %d", cc.MaxFramesPerTx, MaxBlobsPerTx)
+	}
+
 	return nil
 }
 
@@ -115,6 +160,8 @@ type channelBuilder struct {
 	fullErr error
 	// current channel
 	co *derive.ChannelOut
+	// id of the channel, which differs from the id of the channel out for restored channels
+	id derive.ChannelID
 	// list of blocks in the channel. Saved in case the channel must be rebuilt
 	blocks []*types.Block
 	// frames data queue, to be send as txs
@@ -132,7 +179,12 @@ func newChannelBuilder(cfg ChannelConfig) (*channelBuilder, error) {
 	if err != nil {
 		return nil, err
 	}
-	co, err := derive.NewChannelOut(c)
+	var co *derive.ChannelOut
+	if cfg.BatchType == derive.SpanBatchType {
+		co, err = derive.NewSpanChannelOut(c, cfg.GenesisTimestamp, cfg.ChainID)
+	} else {
+		co, err = derive.NewChannelOut(c)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -140,11 +192,27 @@ func newChannelBuilder(cfg ChannelConfig) (*channelBuilder, error) {
 	return &channelBuilder{
 		cfg: cfg,
 		co:  co,
+		id:  co.ID(),
 	}, nil
 }
 
+// restoredChannelBuilder creates a full channel builder of a channel that was persisted, with the frames
+// that still have to be submitted. The blocks of the channel are kept in case the channel must be rebuilt.
+func restoredChannelBuilder(cfg ChannelConfig, id derive.ChannelID, frames []frameData, totalFrames int, blocks []*types.Block) (*channelBuilder, error) {
+	c, err := newChannelBuilder(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c.id = id
+	c.frames = frames
+	c.numFrames = totalFrames
+	c.blocks = blocks
+	c.setFullErr(ErrRestored)
+	return c, nil
+}
+
 func (c *channelBuilder) ID() derive.ChannelID {
-	return c.co.ID()
+	return c.id
 }
 
 // InputBytes returns the total amount of input bytes added to the channel.
@@ -175,7 +243,11 @@ func (c *channelBuilder) Reset() error {
 	c.frames = c.frames[:0]
 	c.timeout = 0
 	c.fullErr = nil
-	return c.co.Reset()
+	if err := c.co.Reset(); err != nil {
+		return err
+	}
+	c.id = c.co.ID()
+	return nil
 }
 
 // AddBlock adds a block to the channel compression pipeline. IsFull should be
@@ -199,7 +271,7 @@ func (c *channelBuilder) AddBlock(block *types.Block) (derive.L1BlockInfo, error
 		return l1info, fmt.Errorf("converting block to batch: %w", err)
 	}
 
-	if _, err = c.co.AddBatch(batch); errors.Is(err, derive.ErrTooManyRLPBytes) || errors.Is(err, derive.CompressorFullErr) {
+	if _, err = c.co.AddSingularBatch(&batch.SingularBatch, l1info.SequenceNumber); errors.Is(err, derive.ErrTooManyRLPBytes) || errors.Is(err, derive.CompressorFullErr) {
 		c.setFullErr(err)
 		return l1info, c.FullErr()
 	} else if err != nil {
@@ -302,7 +374,8 @@ func (c *channelBuilder) IsFull() bool {
 //   - ErrMaxDurationReached if the max channel duration got reached,
 //   - ErrChannelTimeoutClose if the consensus channel timeout got too close,
 //   - ErrSeqWindowClose if the end of the sequencer window got too close,
-//   - ErrTerminated if the channel was explicitly terminated.
+//   - ErrTerminated if the channel was explicitly terminated,
+//   - ErrRestored if the channel was restored from the persisted state.
 func (c *channelBuilder) FullErr() error {
 	return c.fullErr
 }
@@ -380,7 +453,7 @@ func (c *channelBuilder) outputFrame() error {
 	}
 
 	frame := frameData{
-		id:   frameID{chID: c.co.ID(), frameNumber: fn},
+		id:   frameID{chID: c.id, frameNumber: fn},
 		data: buf.Bytes(),
 	}
 	c.frames = append(c.frames, frame)
@@ -431,6 +504,15 @@ func (c *channelBuilder) NextFrame() frameData {
 	return f
 }
 
+// PeekFrame returns the next available frame, without removing it from the queue.
+// Panics if called when there's no next frame.
+func (c *channelBuilder) PeekFrame() frameData {
+	if len(c.frames) == 0 {
+		panic("no next frame")
+	}
+	return c.frames[0]
+}
+
 // PushFrame adds the frame back to the internal frames queue. Panics if not of
 // the same channel.
 func (c *channelBuilder) PushFrame(frame frameData) {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"math/big"
 	"math/rand"
@@ -47,6 +48,14 @@ func TestChannelConfig_Check(t *testing.T) {
 	timeoutChannelConfig := defaultTestChannelConfig
 	timeoutChannelConfig.ChannelTimeout = 0
 	timeoutChannelConfig.SubSafetyMargin = 1
+	blobChannelConfig := defaultTestChannelConfig
+	blobChannelConfig.UseBlobs = true
+	blobChannelConfig.MaxFrameSize = eth.MaxBlobDataSize
+	multiBlobChannelConfig := defaultTestChannelConfig
+	multiBlobChannelConfig.UseBlobs = true
+	multiBlobChannelConfig.MaxFramesPerTx = MaxBlobsPerTx + 1
+	spanBatchChannelConfig := defaultTestChannelConfig
+	spanBatchChannelConfig.BatchType = derive.SpanBatchType
 	tests := []test{
 		{
 			input: defaultTestChannelConfig,
@@ -66,6 +75,24 @@ func TestChannelConfig_Check(t *testing.T) {
 				require.EqualError(t, output, "max frame size cannot be zero")
 			},
 		},
+		{
+			input: blobChannelConfig,
+			assertion: func(output error) {
+				require.ErrorContains(t, output, "larger than the maximum blob data size")
+			},
+		},
+		{
+			input: multiBlobChannelConfig,
+			assertion: func(output error) {
+				require.ErrorContains(t, output, "larger than the maximum number of blobs per tx")
+			},
+		},
+		{
+			input: spanBatchChannelConfig,
+			assertion: func(output error) {
+				require.EqualError(t, output, "span batches require the L2 chain ID")
+			},
+		},
 	}
 	for i := 1; i < derive.FrameV0OverHeadSize; i++ {
 		smallChannelConfig := defaultTestChannelConfig
@@ -570,6 +597,50 @@ func TestChannelBuilder_AddBlock(t *testing.T) {
 	require.ErrorIs(t, addMiniBlock(cb), derive.CompressorFullErr)
 }
 
+// TestChannelBuilder_SpanBatch tests that a span batch channel only outputs frames
+// once it is closed, and that the frames contain a single span batch of all blocks.
+func TestChannelBuilder_SpanBatch(t *testing.T) {
+	channelConfig := defaultTestChannelConfig
+	channelConfig.BatchType = derive.SpanBatchType
+	channelConfig.ChainID = big.NewInt(1234)
+	channelConfig.MaxFrameSize = 30
+
+	cb, err := newChannelBuilder(channelConfig)
+	require.NoError(t, err)
+
+	parent := common.Hash{}
+	for i := 1; i <= 3; i++ {
+		block := newMiniL2BlockWithNumberParent(0, big.NewInt(int64(i)), parent)
+		_, err := cb.AddBlock(block)
+		require.NoError(t, err)
+		parent = block.Hash()
+	}
+	require.NoError(t, cb.OutputFrames())
+	require.False(t, cb.HasFrame(), "span batch channel must not output frames before it is closed")
+
+	cb.Close()
+	require.NoError(t, cb.OutputFrames())
+	require.True(t, cb.HasFrame())
+
+	l1Block := eth.L1BlockRef{Number: 1}
+	ch := derive.NewChannel(cb.ID(), l1Block)
+	for cb.HasFrame() {
+		var frame derive.Frame
+		require.NoError(t, frame.UnmarshalBinary(bytes.NewReader(cb.NextFrame().data)))
+		require.NoError(t, ch.AddFrame(frame, l1Block))
+	}
+	require.True(t, ch.IsReady())
+
+	spanBatchTime := uint64(0)
+	readBatch, err := derive.BatchReader(&rollup.Config{SpanBatchTime: &spanBatchTime}, ch.Reader(), l1Block)
+	require.NoError(t, err)
+	batch, err := readBatch()
+	require.NoError(t, err)
+	require.Equal(t, derive.SpanBatchType, int(batch.BatchType))
+	_, err = readBatch()
+	require.ErrorIs(t, err, io.EOF)
+}
+
 // TestChannelBuilder_Reset tests the [Reset] function
 func TestChannelBuilder_Reset(t *testing.T) {
 	channelConfig := defaultTestChannelConfig
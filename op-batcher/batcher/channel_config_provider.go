@@ -0,0 +1,90 @@
+package batcher
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+)
+
+// ChannelConfigProvider provides the config of the next channel that the channel manager opens.
+type ChannelConfigProvider interface {
+	ChannelConfig() ChannelConfig
+}
+
+// dynamicEthChannelConfig provides the config of each channel by the L1 head: the calldata config until blobs
+// are enabled in the rollup config, and then the blob config, or, if auto is set, the config that is cheaper at
+// the L1 fees of the head.
+type dynamicEthChannelConfig struct {
+	log       log.Logger
+	timeout   time.Duration
+	l1Client  L1Client
+	rollupCfg *rollup.Config
+
+	calldataConfig ChannelConfig
+	blobConfig     ChannelConfig
+	auto           bool
+}
+
+func NewDynamicEthChannelConfig(lgr log.Logger, reqTimeout time.Duration, l1Client L1Client, rollupCfg *rollup.Config,
+	calldataConfig, blobConfig ChannelConfig, auto bool,
+) *dynamicEthChannelConfig {
+	return &dynamicEthChannelConfig{
+		log:            lgr,
+		timeout:        reqTimeout,
+		l1Client:       l1Client,
+		rollupCfg:      rollupCfg,
+		calldataConfig: calldataConfig,
+		blobConfig:     blobConfig,
+		auto:           auto,
+	}
+}
+
+// ChannelConfig returns the calldata config if the L1 head cannot be fetched, since calldata can always be
+// derived from.
+func (dec *dynamicEthChannelConfig) ChannelConfig() ChannelConfig {
+	ctx, cancel := context.WithTimeout(context.Background(), dec.timeout)
+	defer cancel()
+	head, err := dec.l1Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		dec.log.Warn("Failed to fetch L1 head to choose the channel config, using calldata", "err", err)
+		return dec.calldataConfig
+	}
+	// Channels that are opened once blobs are enabled are also included after.
+	if !dec.rollupCfg.IsBlobsEnabled(head.Time) || head.ExcessBlobGas == nil || head.BaseFee == nil {
+		return dec.calldataConfig
+	}
+	if !dec.auto {
+		return dec.blobConfig
+	}
+	blobBaseFee := eip4844.CalcBlobFee(*head.ExcessBlobGas)
+	useBlobs := blobsCheaper(dec.calldataConfig, dec.blobConfig, head.BaseFee, blobBaseFee)
+	dec.log.Info("Chose the data availability type of the next channel", "use_blobs", useBlobs,
+		"basefee", head.BaseFee, "blob_basefee", blobBaseFee)
+	if useBlobs {
+		return dec.blobConfig
+	}
+	return dec.calldataConfig
+}
+
+// blobsCheaper returns whether the data of full frames costs less per byte as blobs than as calldata.
+// The calldata is assumed to be non-zero bytes, as compressed data is. The priority fee is ignored, which
+// slightly favors calldata, that uses more gas.
+func blobsCheaper(calldataConfig, blobConfig ChannelConfig, baseFee, blobBaseFee *big.Int) bool {
+	calldataBytes := new(big.Int).SetUint64(calldataConfig.MaxFrameSize + 1) // version byte
+	calldataGas := new(big.Int).Mul(calldataBytes, new(big.Int).SetUint64(params.TxDataNonZeroGasEIP2028))
+	calldataGas.Add(calldataGas, big.NewInt(int64(params.TxGas)))
+	calldataCost := new(big.Int).Mul(calldataGas, baseFee)
+
+	blobBytes := new(big.Int).SetUint64(blobConfig.MaxFrameSize + 1)
+	blobCost := new(big.Int).Mul(big.NewInt(int64(params.TxGas)), baseFee)
+	blobCost.Add(blobCost, new(big.Int).Mul(new(big.Int).SetUint64(params.BlobTxBlobGasPerBlob), blobBaseFee))
+
+	// compare the cost per byte, calldataCost/calldataBytes > blobCost/blobBytes
+	return new(big.Int).Mul(calldataCost, blobBytes).Cmp(new(big.Int).Mul(blobCost, calldataBytes)) > 0
+}
@@ -0,0 +1,87 @@
+package batcher
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type mockL1Client struct {
+	head *types.Header
+	err  error
+}
+
+func (m *mockL1Client) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return m.head, m.err
+}
+
+func TestDynamicEthChannelConfig(t *testing.T) {
+	calldataCfg := defaultTestChannelConfig
+	blobCfg := defaultTestChannelConfig
+	blobCfg.UseBlobs = true
+	blobCfg.MaxFrameSize = eth.MaxBlobDataSize - 1
+	blobsTime := uint64(100)
+	rollupCfg := &rollup.Config{BlobsEnabledL1Timestamp: &blobsTime}
+
+	// the blob base fee is 1 wei without excess blob gas, and rises by ~12.5% per 393216 excess blob gas
+	noExcess := uint64(0)
+	highExcess := uint64(30_000_000)
+	tests := []struct {
+		name     string
+		head     *types.Header
+		err      error
+		auto     bool
+		useBlobs bool
+	}{
+		{name: "BeforeBlobs", head: &types.Header{Time: 99, BaseFee: big.NewInt(100), ExcessBlobGas: &noExcess}, useBlobs: false},
+		{name: "PreCancunHead", head: &types.Header{Time: 100, BaseFee: big.NewInt(100)}, useBlobs: false},
+		{name: "HeadError", err: errors.New("boom"), useBlobs: false},
+		{name: "Blobs", head: &types.Header{Time: 100, BaseFee: big.NewInt(100), ExcessBlobGas: &highExcess}, useBlobs: true},
+		{name: "AutoCheapBlobs", head: &types.Header{Time: 100, BaseFee: big.NewInt(100), ExcessBlobGas: &noExcess}, auto: true, useBlobs: true},
+		{name: "AutoExpensiveBlobs", head: &types.Header{Time: 100, BaseFee: big.NewInt(100), ExcessBlobGas: &highExcess}, auto: true, useBlobs: false},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			l1 := &mockL1Client{head: test.head, err: test.err}
+			dec := NewDynamicEthChannelConfig(testlog.Logger(t, log.LvlCrit), time.Second, l1, rollupCfg, calldataCfg, blobCfg, test.auto)
+			require.Equal(t, test.useBlobs, dec.ChannelConfig().UseBlobs)
+		})
+	}
+}
+
+func TestBlobsCheaper(t *testing.T) {
+	calldataCfg := defaultTestChannelConfig
+	blobCfg := defaultTestChannelConfig
+	blobCfg.MaxFrameSize = eth.MaxBlobDataSize - 1
+
+	// a full blob of 131072 blob gas carries ~127k bytes, which costs ~2M gas as calldata,
+	// so blobs are cheaper unless the blob base fee is ~16x the basefee.
+	baseFee := big.NewInt(1000)
+	require.True(t, blobsCheaper(calldataCfg, blobCfg, baseFee, big.NewInt(1)))
+	require.True(t, blobsCheaper(calldataCfg, blobCfg, baseFee, big.NewInt(15_000)))
+	require.False(t, blobsCheaper(calldataCfg, blobCfg, baseFee, big.NewInt(17_000)))
+}
+
+func TestTxDataBlobs(t *testing.T) {
+	td := txData{frames: []frameData{{data: []byte{0x01, 0x02, 0x03}}, {data: []byte{0x04}}}, asBlob: true}
+	blobs, err := td.Blobs()
+	require.NoError(t, err)
+	require.Len(t, blobs, 2)
+	for i, blob := range blobs {
+		data, err := blob.ToData()
+		require.NoError(t, err)
+		require.Equal(t, eth.Data(append([]byte{derive.DerivationVersion0}, td.frames[i].data...)), data)
+	}
+}
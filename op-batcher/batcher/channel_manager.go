@@ -24,10 +24,10 @@ var ErrReorg = errors.New("block does not extend existing chain")
 // channel.
 // Public functions on channelManager are safe for concurrent access.
 type channelManager struct {
-	mu   sync.Mutex
-	log  log.Logger
-	metr metrics.Metricer
-	cfg  ChannelConfig
+	mu          sync.Mutex
+	log         log.Logger
+	metr        metrics.Metricer
+	cfgProvider ChannelConfigProvider
 
 	// All blocks since the last request for new tx data.
 	blocks []*types.Block
@@ -38,19 +38,21 @@ type channelManager struct {
 	currentChannel *channel
 	// channels to read frame data from, for writing batches onchain
 	channelQueue []*channel
-	// used to lookup channels by tx ID upon tx success / failure
-	txChannels map[txID]*channel
+	// used to lookup the channels of the frames of a tx by tx ID upon tx success / failure
+	txChannels map[txID][]*channel
 
 	// if set to true, prevents production of any new channel frames
 	closed bool
 }
 
-func NewChannelManager(log log.Logger, metr metrics.Metricer, cfg ChannelConfig) *channelManager {
+// NewChannelManager creates a channel manager, which opens each channel with the config of the cfgProvider.
+// A ChannelConfig is a static provider of itself.
+func NewChannelManager(log log.Logger, metr metrics.Metricer, cfgProvider ChannelConfigProvider) *channelManager {
 	return &channelManager{
-		log:        log,
-		metr:       metr,
-		cfg:        cfg,
-		txChannels: make(map[txID]*channel),
+		log:         log,
+		metr:        metr,
+		cfgProvider: cfgProvider,
+		txChannels:  make(map[txID][]*channel),
 	}
 }
 
@@ -65,7 +67,7 @@ func (s *channelManager) Clear() {
 	s.closed = false
 	s.currentChannel = nil
 	s.channelQueue = nil
-	s.txChannels = make(map[txID]*channel)
+	s.txChannels = make(map[txID][]*channel)
 }
 
 // TxFailed records a transaction as failed. It will attempt to resubmit the data
@@ -73,16 +75,19 @@ func (s *channelManager) Clear() {
 func (s *channelManager) TxFailed(id txID) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if channel, ok := s.txChannels[id]; ok {
+	if channels, ok := s.txChannels[id]; ok {
 		delete(s.txChannels, id)
-		channel.TxFailed(id)
-		if s.closed && channel.NoneSubmitted() {
-			s.log.Info("Channel has no submitted transactions, clearing for shutdown", "chID", channel.ID())
-			s.removePendingChannel(channel)
+		for _, channel := range channels {
+			channel.TxFailed(id)
+			if s.closed && channel.NoneSubmitted() {
+				s.log.Info("Channel has no submitted transactions, clearing for shutdown", "chID", channel.ID())
+				s.removePendingChannel(channel)
+			}
 		}
 	} else {
 		s.log.Warn("transaction from unknown channel marked as failed", "id", id)
 	}
+	s.metr.RecordBatchTxFailed()
 }
 
 // TxConfirmed marks a transaction as confirmed on L1. Unfortunately even if all frames in
@@ -92,13 +97,17 @@ func (s *channelManager) TxFailed(id txID) {
 func (s *channelManager) TxConfirmed(id txID, inclusionBlock eth.BlockID) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if channel, ok := s.txChannels[id]; ok {
+	if channels, ok := s.txChannels[id]; ok {
 		delete(s.txChannels, id)
-		done, blocks := channel.TxConfirmed(id, inclusionBlock)
-		s.blocks = append(blocks, s.blocks...)
-		if done {
-			s.removePendingChannel(channel)
+		var timedOutBlocks []*types.Block
+		for _, channel := range channels {
+			done, blocks := channel.TxConfirmed(id, inclusionBlock)
+			timedOutBlocks = append(timedOutBlocks, blocks...)
+			if done {
+				s.removePendingChannel(channel)
+			}
 		}
+		s.blocks = append(timedOutBlocks, s.blocks...)
 	} else {
 		s.log.Warn("transaction from unknown channel marked as confirmed", "id", id)
 	}
@@ -125,20 +134,60 @@ func (s *channelManager) removePendingChannel(channel *channel) {
 	s.channelQueue = append(s.channelQueue[:index], s.channelQueue[index+1:]...)
 }
 
-// nextTxData pops off s.datas & handles updating the internal state
-func (s *channelManager) nextTxData(channel *channel) (txData, error) {
-	if channel == nil || !channel.HasFrame() {
+// nextTxData pops off the frames of the first channel, and of the channels after it, into the next tx data
+// & handles updating the internal state. It packs up to MaxFramesPerTx frames of channels of the same
+// data availability type into the tx data, and calldata frames only while they fit into a single frame.
+func (s *channelManager) nextTxData(first *channel) (txData, error) {
+	if first == nil || !first.HasFrame() {
 		s.log.Trace("no next tx data")
 		return txData{}, io.EOF // TODO: not enough data error instead
 	}
-	tx := channel.NextTxData()
-	s.txChannels[tx.ID()] = channel
+	maxFrames := first.cfg.MaxFramesPerTx
+	if maxFrames < 1 {
+		maxFrames = 1
+	}
+	tx := txData{asBlob: first.cfg.UseBlobs}
+	var channels []*channel
+Channels:
+	for _, ch := range s.channelsFrom(first) {
+		if ch.cfg.UseBlobs != tx.asBlob {
+			break
+		}
+		added := false
+		for ch.HasFrame() && len(tx.frames) < maxFrames {
+			if !tx.asBlob && len(tx.frames) > 0 && tx.Len()+len(ch.PeekFrame().data) > int(first.cfg.MaxFrameSize)+1 {
+				break Channels
+			}
+			tx.frames = append(tx.frames, ch.NextFrame())
+			added = true
+		}
+		if added {
+			channels = append(channels, ch)
+		}
+		if len(tx.frames) == maxFrames {
+			break
+		}
+	}
+	for _, ch := range channels {
+		ch.TxSent(tx)
+	}
+	s.txChannels[tx.ID()] = channels
 	return tx, nil
 }
 
+// channelsFrom returns the first channel, and the channels after it in the channel queue.
+func (s *channelManager) channelsFrom(first *channel) []*channel {
+	for i, ch := range s.channelQueue {
+		if ch == first {
+			return s.channelQueue[i:]
+		}
+	}
+	return []*channel{first}
+}
+
 // TxData returns the next tx data that should be submitted to L1.
 //
-// It currently only uses one frame per transaction. If the pending channel is
+// It packs up to MaxFramesPerTx frames per transaction, see nextTxData. If the pending channel is
 // full, it only returns the remaining frames of this channel until it got
 // successfully fully sent to L1. It returns io.EOF if there's no pending frame.
 func (s *channelManager) TxData(l1Head eth.BlockID) (txData, error) {
@@ -195,7 +244,8 @@ func (s *channelManager) ensureChannelWithSpace(l1Head eth.BlockID) error {
 		return nil
 	}
 
-	pc, err := newChannel(s.log, s.metr, s.cfg)
+	cfg := s.cfgProvider.ChannelConfig()
+	pc, err := newChannel(s.log, s.metr, cfg)
 	if err != nil {
 		return fmt.Errorf("creating new channel: %w", err)
 	}
@@ -204,7 +254,8 @@ func (s *channelManager) ensureChannelWithSpace(l1Head eth.BlockID) error {
 	s.log.Info("Created channel",
 		"id", pc.ID(),
 		"l1Head", l1Head,
-		"blocks_pending", len(s.blocks))
+		"blocks_pending", len(s.blocks),
+		"use_blobs", cfg.UseBlobs)
 	s.metr.RecordChannelOpened(pc.ID(), len(s.blocks))
 
 	return nil
@@ -304,6 +355,42 @@ func (s *channelManager) outputFrames() error {
 	return nil
 }
 
+// PersistedState returns the state of the closed channels that are not fully confirmed yet.
+// The open channel is not persisted, so its blocks are loaded again after a restart.
+func (s *channelManager) PersistedState() *PersistedState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state := &PersistedState{}
+	for _, ch := range s.channelQueue {
+		// only the current channel can be open, and it is the last one
+		if !ch.IsFull() {
+			break
+		}
+		pc, ok := ch.Persisted()
+		if !ok {
+			continue
+		}
+		state.Channels = append(state.Channels, pc)
+	}
+	return state
+}
+
+// RestoreChannel adds a channel of the persisted state, with its blocks, to the channel queue.
+// Blocks that are added afterwards must extend the last block of the channel.
+func (s *channelManager) RestoreChannel(pc PersistedChannel, blocks []*types.Block) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch, err := restoredChannel(s.log, s.metr, s.cfgProvider.ChannelConfig(), pc, blocks)
+	if err != nil {
+		return err
+	}
+	s.channelQueue = append(s.channelQueue, ch)
+	s.tip = pc.Last.Hash
+	s.log.Info("Restored channel", "id", pc.ID, "last_block", pc.Last,
+		"frames_pending", len(pc.Frames), "frames_confirmed", len(pc.Confirmed))
+	return nil
+}
+
 // AddL2Block adds an L2 block to the internal blocks queue. It returns ErrReorg
 // if the block does not extend the last block loaded into the state. If no
 // blocks were added yet, the parent hash check is skipped.
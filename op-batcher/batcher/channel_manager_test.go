@@ -76,6 +76,47 @@ func TestChannelManagerReturnsErrReorgWhenDrained(t *testing.T) {
 	require.ErrorIs(t, m.AddL2Block(x), ErrReorg)
 }
 
+// switchingConfigProvider returns the configs in order, one per channel.
+type switchingConfigProvider struct {
+	configs []ChannelConfig
+}
+
+func (p *switchingConfigProvider) ChannelConfig() ChannelConfig {
+	cfg := p.configs[0]
+	p.configs = p.configs[1:]
+	return cfg
+}
+
+// TestChannelManager_ChannelConfigProvider ensures that each channel is opened with the config of the provider,
+// and that the tx data of blob channels is submitted as blob.
+func TestChannelManager_ChannelConfigProvider(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	calldataCfg := ChannelConfig{
+		MaxFrameSize: 120_000,
+		CompressorConfig: compressor.Config{
+			TargetFrameSize:  1,
+			TargetNumFrames:  1,
+			ApproxComprRatio: 1.0,
+		},
+	}
+	blobCfg := calldataCfg
+	blobCfg.UseBlobs = true
+	blobCfg.MaxFrameSize = eth.MaxBlobDataSize - 1
+	m := NewChannelManager(log, metrics.NoopMetrics, &switchingConfigProvider{configs: []ChannelConfig{blobCfg, calldataCfg}})
+
+	a := newMiniL2Block(0)
+	b := newMiniL2BlockWithNumberParent(0, big.NewInt(1), a.Hash())
+	require.NoError(t, m.AddL2Block(a))
+	txdata, err := m.TxData(eth.BlockID{})
+	require.NoError(t, err)
+	require.True(t, txdata.asBlob)
+
+	require.NoError(t, m.AddL2Block(b))
+	txdata, err = m.TxData(eth.BlockID{})
+	require.NoError(t, err)
+	require.False(t, txdata.asBlob)
+}
+
 // TestChannelManager_Clear tests clearing the channel manager.
 func TestChannelManager_Clear(t *testing.T) {
 	require := require.New(t)
@@ -345,3 +386,143 @@ func TestChannelManagerCloseAllTxsFailed(t *testing.T) {
 	_, err = m.TxData(eth.BlockID{})
 	require.ErrorIs(err, io.EOF, "Expected closed channel manager to produce no more tx data")
 }
+
+// TestChannelManager_PersistAndRestore ensures that a closed channel is restored from the persisted state
+// with its confirmed frames, and that the frames of in-flight transactions are submitted again.
+func TestChannelManager_PersistAndRestore(t *testing.T) {
+	require := require.New(t)
+	log := testlog.Logger(t, log.LvlCrit)
+	cfg := ChannelConfig{
+		ChannelTimeout: 10,
+		MaxFrameSize:   24,
+		CompressorConfig: compressor.Config{
+			TargetFrameSize:  24,
+			TargetNumFrames:  1,
+			ApproxComprRatio: 1.0,
+		},
+	}
+	m := NewChannelManager(log, metrics.NoopMetrics, cfg)
+
+	a := newMiniL2Block(0)
+	require.NoError(m.AddL2Block(a))
+	confirmed, err := m.TxData(eth.BlockID{})
+	require.NoError(err)
+	inFlight, err := m.TxData(eth.BlockID{})
+	require.NoError(err)
+	m.TxConfirmed(confirmed.ID(), eth.BlockID{Number: 5, Hash: common.Hash{0x05}})
+
+	// the open channel is not persisted, its blocks are loaded again after a restart
+	require.NoError(m.AddL2Block(newMiniL2BlockWithNumberParent(0, big.NewInt(1), a.Hash())))
+
+	persistence := NewStatePersistence(t.TempDir() + "/state")
+	require.NoError(persistence.Save(m.PersistedState()))
+	state, err := persistence.Load()
+	require.NoError(err)
+	require.Len(state.Channels, 1)
+	pc := state.Channels[0]
+	require.Equal(confirmed.ID().chID, pc.ID)
+	require.Equal(eth.ToBlockID(a), pc.Last)
+	require.Equal([]ConfirmedFrame{{Number: 0, Inclusion: eth.BlockID{Number: 5, Hash: common.Hash{0x05}}}}, pc.Confirmed)
+	require.Equal(inFlight.ID().frameNumber, pc.Frames[0].Number)
+	require.Len(pc.Frames, m.channelQueue[0].TotalFrames()-1)
+
+	restored := NewChannelManager(log, metrics.NoopMetrics, cfg)
+	require.NoError(restored.RestoreChannel(pc, []*types.Block{a}))
+	require.Equal(a.Hash(), restored.tip)
+	for i := 0; i < len(pc.Frames); i++ {
+		txdata, err := restored.TxData(eth.BlockID{})
+		require.NoError(err)
+		require.Equal(frameID{chID: pc.ID, frameNumber: pc.Frames[i].Number}, txdata.ID())
+		require.Equal([]byte(pc.Frames[i].Data), txdata.Frames()[0].data)
+		restored.TxConfirmed(txdata.ID(), eth.BlockID{Number: 6})
+	}
+	// fully submitted
+	require.Empty(restored.channelQueue)
+	_, err = restored.TxData(eth.BlockID{})
+	require.ErrorIs(err, io.EOF)
+}
+
+// TestChannelManager_MultiFrameTxs ensures that frames of multiple channels are packed into a single
+// transaction, as blobs up to MaxFramesPerTx, and as calldata while the frames fit into a frame.
+func TestChannelManager_MultiFrameTxs(t *testing.T) {
+	log := testlog.Logger(t, log.LvlCrit)
+	pushFrames := func(t *testing.T, m *channelManager, sizes ...int) *channel {
+		require.NoError(t, m.ensureChannelWithSpace(eth.BlockID{}))
+		ch := m.currentChannel
+		for i, size := range sizes {
+			ch.channelBuilder.PushFrame(frameData{
+				id:   frameID{chID: ch.ID(), frameNumber: uint16(i)},
+				data: make([]byte, size),
+			})
+		}
+		// the next call opens a new channel
+		ch.Close()
+		return ch
+	}
+
+	t.Run("Blobs", func(t *testing.T) {
+		m := NewChannelManager(log, metrics.NoopMetrics, ChannelConfig{
+			ChannelTimeout: 10,
+			MaxFrameSize:   eth.MaxBlobDataSize - 1,
+			UseBlobs:       true,
+			MaxFramesPerTx: 3,
+		})
+		a := pushFrames(t, m, 100, 100)
+		b := pushFrames(t, m, 100, 100)
+
+		txdata, err := m.nextTxData(a)
+		require.NoError(t, err)
+		require.True(t, txdata.asBlob)
+		require.Len(t, txdata.Frames(), 3)
+		require.Equal(t, []*channel{a, b}, m.txChannels[txdata.ID()])
+		blobs, err := txdata.Blobs()
+		require.NoError(t, err)
+		require.Len(t, blobs, 3)
+
+		// the frames of each channel are requeued at their channel
+		m.TxFailed(txdata.ID())
+		require.Equal(t, 2, a.PendingFrames())
+		require.Equal(t, 2, b.PendingFrames())
+
+		txdata, err = m.nextTxData(a)
+		require.NoError(t, err)
+		m.TxConfirmed(txdata.ID(), eth.BlockID{Number: 1})
+		require.NotContains(t, m.channelQueue, a, "channel a is fully submitted")
+		require.Len(t, b.confirmedTransactions, 1)
+		require.Equal(t, 1, b.PendingFrames())
+	})
+
+	t.Run("Calldata", func(t *testing.T) {
+		m := NewChannelManager(log, metrics.NoopMetrics, ChannelConfig{
+			ChannelTimeout: 10,
+			MaxFrameSize:   100,
+			MaxFramesPerTx: 6,
+		})
+		a := pushFrames(t, m, 40, 40)
+		b := pushFrames(t, m, 30)
+
+		txdata, err := m.nextTxData(a)
+		require.NoError(t, err)
+		require.False(t, txdata.asBlob)
+		require.Len(t, txdata.Frames(), 2, "the third frame does not fit")
+		require.Equal(t, 81, txdata.Len())
+
+		txdata, err = m.nextTxData(b)
+		require.NoError(t, err)
+		require.Len(t, txdata.Frames(), 1)
+	})
+
+	t.Run("SameDataAvailabilityType", func(t *testing.T) {
+		calldataCfg := ChannelConfig{ChannelTimeout: 10, MaxFrameSize: 1000, MaxFramesPerTx: 6}
+		blobCfg := calldataCfg
+		blobCfg.UseBlobs = true
+		m := NewChannelManager(log, metrics.NoopMetrics, &switchingConfigProvider{configs: []ChannelConfig{blobCfg, calldataCfg}})
+		a := pushFrames(t, m, 10)
+		pushFrames(t, m, 10)
+
+		txdata, err := m.nextTxData(a)
+		require.NoError(t, err)
+		require.Len(t, txdata.Frames(), 1)
+		require.True(t, txdata.asBlob)
+	})
+}
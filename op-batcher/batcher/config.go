@@ -1,16 +1,20 @@
 package batcher
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
 	"github.com/ethereum-optimism/optimism/op-batcher/flags"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+	optracing "github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -24,6 +28,9 @@ type CLIConfig struct {
 	// RollupRpc is the HTTP provider URL for the L2 rollup node.
 	RollupRpc string
 
+	// RollupRpcTLS authenticates with the rollup node over mutual TLS, if set.
+	RollupRpcTLS optls.CLIConfig
+
 	// MaxChannelDuration is the maximum duration (in #L1-blocks) to keep a
 	// channel open. This allows to more eagerly send batcher transactions
 	// during times of low L2 transaction volume. Note that the effective
@@ -50,12 +57,35 @@ type CLIConfig struct {
 	// MaxL1TxSize is the maximum size of a batch tx submitted to L1.
 	MaxL1TxSize uint64
 
+	// MaxFramesPerTx is the maximum number of frames to pack into a single batch tx.
+	MaxFramesPerTx int
+
+	// BatchType is the type of batches to encode the blocks of a channel in, derive.SingularBatchType
+	// or derive.SpanBatchType.
+	BatchType uint
+
 	Stopped bool
 
+	// StateFile persists the channel manager state across restarts, if set.
+	StateFile string
+
+	// ThrottleBaseFee and ThrottleBlobBaseFee are the L1 fees in wei above which batch submission is
+	// throttled, if non-zero. See ThrottleConfig for the other throttle parameters.
+	ThrottleBaseFee            uint64
+	ThrottleBlobBaseFee        uint64
+	ThrottleMaxBacklog         uint64
+	ThrottleMaxChannelDuration uint64
+	ThrottleMaxL1TxSize        uint64
+
+	// DataAvailabilityType is the way that the batch data is submitted to L1: as calldata, as blobs,
+	// or as whichever is cheaper per channel.
+	DataAvailabilityType flags.DataAvailabilityType
+
 	TxMgrConfig      txmgr.CLIConfig
 	LogConfig        oplog.CLIConfig
 	MetricsConfig    opmetrics.CLIConfig
 	PprofConfig      oppprof.CLIConfig
+	TracingConfig    optracing.CLIConfig
 	CompressorConfig compressor.CLIConfig
 	RPC              oprpc.CLIConfig
 }
@@ -69,12 +99,30 @@ func (c *CLIConfig) Check() error {
 	if err := c.PprofConfig.Check(); err != nil {
 		return err
 	}
+	if err := c.TracingConfig.Check(); err != nil {
+		return err
+	}
 	if err := c.TxMgrConfig.Check(); err != nil {
 		return err
 	}
 	if err := c.RPC.Check(); err != nil {
 		return err
 	}
+	if err := c.RollupRpcTLS.Check(); err != nil {
+		return fmt.Errorf("invalid rollup rpc tls config: %w", err)
+	}
+	if err := c.CompressorConfig.Check(); err != nil {
+		return err
+	}
+	if !flags.ValidDataAvailabilityType(c.DataAvailabilityType) {
+		return fmt.Errorf("unknown data availability type: %q", c.DataAvailabilityType)
+	}
+	if c.BatchType > derive.SpanBatchType {
+		return fmt.Errorf("unknown batch type: %d", c.BatchType)
+	}
+	if c.ThrottleMaxL1TxSize == 1 {
+		return fmt.Errorf("throttle max L1 tx size must be larger than 1 byte, got %d", c.ThrottleMaxL1TxSize)
+	}
 	return nil
 }
 
@@ -85,19 +133,30 @@ func NewConfig(ctx *cli.Context) *CLIConfig {
 		L1EthRpc:        ctx.String(flags.L1EthRpcFlag.Name),
 		L2EthRpc:        ctx.String(flags.L2EthRpcFlag.Name),
 		RollupRpc:       ctx.String(flags.RollupRpcFlag.Name),
+		RollupRpcTLS:    optls.ReadCLIConfigWithPrefix(ctx, flags.RollupRpcFlag.Name),
 		SubSafetyMargin: ctx.Uint64(flags.SubSafetyMarginFlag.Name),
 		PollInterval:    ctx.Duration(flags.PollIntervalFlag.Name),
 
 		/* Optional Flags */
-		MaxPendingTransactions: ctx.Uint64(flags.MaxPendingTransactionsFlag.Name),
-		MaxChannelDuration:     ctx.Uint64(flags.MaxChannelDurationFlag.Name),
-		MaxL1TxSize:            ctx.Uint64(flags.MaxL1TxSizeBytesFlag.Name),
-		Stopped:                ctx.Bool(flags.StoppedFlag.Name),
-		TxMgrConfig:            txmgr.ReadCLIConfig(ctx),
-		LogConfig:              oplog.ReadCLIConfig(ctx),
-		MetricsConfig:          opmetrics.ReadCLIConfig(ctx),
-		PprofConfig:            oppprof.ReadCLIConfig(ctx),
-		CompressorConfig:       compressor.ReadCLIConfig(ctx),
-		RPC:                    oprpc.ReadCLIConfig(ctx),
+		MaxPendingTransactions:     ctx.Uint64(flags.MaxPendingTransactionsFlag.Name),
+		MaxChannelDuration:         ctx.Uint64(flags.MaxChannelDurationFlag.Name),
+		MaxL1TxSize:                ctx.Uint64(flags.MaxL1TxSizeBytesFlag.Name),
+		MaxFramesPerTx:             ctx.Int(flags.MaxFramesPerTxFlag.Name),
+		BatchType:                  ctx.Uint(flags.BatchTypeFlag.Name),
+		Stopped:                    ctx.Bool(flags.StoppedFlag.Name),
+		StateFile:                  ctx.String(flags.StateFileFlag.Name),
+		ThrottleBaseFee:            ctx.Uint64(flags.ThrottleBaseFeeFlag.Name),
+		ThrottleBlobBaseFee:        ctx.Uint64(flags.ThrottleBlobBaseFeeFlag.Name),
+		ThrottleMaxBacklog:         ctx.Uint64(flags.ThrottleMaxBacklogFlag.Name),
+		ThrottleMaxChannelDuration: ctx.Uint64(flags.ThrottleMaxChannelDurationFlag.Name),
+		ThrottleMaxL1TxSize:        ctx.Uint64(flags.ThrottleMaxL1TxSizeBytesFlag.Name),
+		DataAvailabilityType:       flags.DataAvailabilityType(ctx.String(flags.DataAvailabilityTypeFlag.Name)),
+		TxMgrConfig:                txmgr.ReadCLIConfig(ctx),
+		LogConfig:                  oplog.ReadCLIConfig(ctx),
+		MetricsConfig:              opmetrics.ReadCLIConfig(ctx),
+		PprofConfig:                oppprof.ReadCLIConfig(ctx),
+		TracingConfig:              optracing.ReadCLIConfig(ctx),
+		CompressorConfig:           compressor.ReadCLIConfig(ctx),
+		RPC:                        oprpc.ReadCLIConfig(ctx),
 	}
 }
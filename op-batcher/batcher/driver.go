@@ -5,22 +5,29 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"math/big"
 	_ "net/http/pprof"
 	"sync"
-	"time"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
+const tracerName = "github.com/ethereum-optimism/optimism/op-batcher/batcher"
+
 var ErrBatcherNotRunning = errors.New("batcher is not running")
 
 type L1Client interface {
@@ -45,7 +52,16 @@ type DriverSetup struct {
 	L1Client     L1Client
 	L2Client     L2Client
 	RollupClient RollupClient
-	Channel      ChannelConfig
+	// Channel provides the config of each channel, which is a static ChannelConfig,
+	// or a config that depends on the L1 fees.
+	Channel ChannelConfigProvider
+	// Clock that the polling of the driver is scheduled with. The system clock is used if nil.
+	Clock clock.Clock
+	// Persistence stores the channel manager state across restarts. The state is not persisted if nil.
+	Persistence StatePersistence
+	// Throttler is updated during L1 fee spikes, if set. It must also be the Channel config provider,
+	// or wrap it, so that new channels are opened with the throttled config.
+	Throttler *Throttler
 }
 
 // BatchSubmitter encapsulates a service responsible for submitting L2 tx
@@ -63,6 +79,9 @@ type BatchSubmitter struct {
 	mutex   sync.Mutex
 	running bool
 
+	// paused pauses the submission of batch txs, while blocks are still loaded and receipts handled.
+	paused atomic.Bool
+
 	// lastStoredBlock is the last block loaded into `state`. If it is empty it should be set to the l2 safe head.
 	lastStoredBlock eth.BlockID
 	lastL1Tip       eth.L1BlockRef
@@ -72,6 +91,12 @@ type BatchSubmitter struct {
 
 // NewBatchSubmitter initializes the BatchSubmitter driver from a preconfigured DriverSetup
 func NewBatchSubmitter(setup DriverSetup) *BatchSubmitter {
+	if setup.Clock == nil {
+		setup.Clock = clock.SystemClock
+	}
+	if setup.Persistence == nil {
+		setup.Persistence = DisabledStatePersistence{}
+	}
 	return &BatchSubmitter{
 		DriverSetup: setup,
 		state:       NewChannelManager(setup.Log, setup.Metr, setup.Channel),
@@ -96,6 +121,10 @@ func (l *BatchSubmitter) StartBatchSubmitting() error {
 
 	l.wg.Add(1)
 	go l.loop()
+	if l.Throttler != nil {
+		l.wg.Add(1)
+		go l.throttleLoop()
+	}
 
 	l.Log.Info("Batch Submitter started")
 	return nil
@@ -138,6 +167,24 @@ func (l *BatchSubmitter) StopBatchSubmitting(ctx context.Context) error {
 	return nil
 }
 
+// PauseBatchSubmitting pauses the submission of batch txs, until ResumeBatchSubmitting is called.
+// Blocks are still loaded into channels, and in-flight txs are still confirmed, while paused.
+func (l *BatchSubmitter) PauseBatchSubmitting() error {
+	if !l.paused.CompareAndSwap(false, true) {
+		return errors.New("batch submitting is already paused")
+	}
+	l.Log.Info("Paused batch submitting")
+	return nil
+}
+
+func (l *BatchSubmitter) ResumeBatchSubmitting() error {
+	if !l.paused.CompareAndSwap(true, false) {
+		return errors.New("batch submitting is not paused")
+	}
+	l.Log.Info("Resumed batch submitting")
+	return nil
+}
+
 // loadBlocksIntoState loads all blocks since the previous stored block
 // It does the following:
 // 1. Fetch the sync status of the sequencer
@@ -230,6 +277,121 @@ func (l *BatchSubmitter) calculateL2BlockRangeToStore(ctx context.Context) (eth.
 	return l.lastStoredBlock, syncStatus.UnsafeL2.ID(), nil
 }
 
+// restoreState restores the persisted channels that can still be completed, and continues loading blocks
+// after the last restored channel. The persisted channels are reconciled against L1 and L2:
+//   - channels of which blocks are already safe are derived, and dropped.
+//   - if the blocks of a channel were reorged on L2, or a confirmed frame of it was reorged on L1, or the
+//     channel is about to time out, the channel and all channels after it are dropped, and their blocks
+//     are loaded again, to be submitted in new channels.
+func (l *BatchSubmitter) restoreState(ctx context.Context) error {
+	state, err := l.Persistence.Load()
+	if err != nil {
+		return err
+	}
+	if state == nil || len(state.Channels) == 0 {
+		return nil
+	}
+	sctx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	defer cancel()
+	syncStatus, err := l.RollupClient.SyncStatus(sctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sync status: %w", err)
+	}
+	l1Tip, err := l.l1Tip(ctx)
+	if err != nil {
+		return err
+	}
+	cfg := l.Channel.ChannelConfig()
+	for _, pc := range state.Channels {
+		if pc.Parent.Number < syncStatus.SafeL2.Number {
+			l.Log.Info("Dropping persisted channel, which is already safe", "id", pc.ID, "last_block", pc.Last, "safe", syncStatus.SafeL2)
+			continue
+		}
+		if l.lastStoredBlock != (eth.BlockID{}) && pc.Parent != l.lastStoredBlock {
+			l.Log.Warn("Dropping persisted channel, which does not extend the previous channel", "id", pc.ID)
+			break
+		}
+		if ok, err := l.restorable(ctx, pc, cfg, l1Tip.Number); err != nil {
+			return err
+		} else if !ok {
+			break
+		}
+		blocks, err := l.loadRestoredBlocks(ctx, pc)
+		if err != nil {
+			return err
+		} else if blocks == nil {
+			l.Log.Warn("Dropping persisted channel, of which the blocks were reorged", "id", pc.ID, "last_block", pc.Last)
+			break
+		}
+		if err := l.state.RestoreChannel(pc, blocks); err != nil {
+			return err
+		}
+		l.lastStoredBlock = pc.Last
+	}
+	return nil
+}
+
+// restorable returns whether the confirmed frames of the persisted channel are still canonical on L1, and
+// whether the channel can still be completed before it times out.
+func (l *BatchSubmitter) restorable(ctx context.Context, pc PersistedChannel, cfg ChannelConfig, l1Head uint64) (bool, error) {
+	if len(pc.Confirmed) == 0 {
+		return true, nil
+	}
+	first := uint64(math.MaxUint64)
+	for _, f := range pc.Confirmed {
+		if f.Inclusion.Number < first {
+			first = f.Inclusion.Number
+		}
+		tctx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+		header, err := l.L1Client.HeaderByNumber(tctx, new(big.Int).SetUint64(f.Inclusion.Number))
+		cancel()
+		if err != nil {
+			return false, fmt.Errorf("getting L1 inclusion block of persisted frame: %w", err)
+		}
+		if header.Hash() != f.Inclusion.Hash {
+			l.Log.Warn("Dropping persisted channel, of which a confirmed frame was reorged", "id", pc.ID, "frame", f.Number, "inclusion", f.Inclusion)
+			return false, nil
+		}
+	}
+	if l1Head >= first+cfg.ChannelTimeout-cfg.SubSafetyMargin {
+		l.Log.Warn("Dropping persisted channel, which is close to timing out", "id", pc.ID, "first_inclusion", first, "l1_head", l1Head)
+		return false, nil
+	}
+	return true, nil
+}
+
+// loadRestoredBlocks loads the blocks of the persisted channel from L2. It returns nil if the blocks do not
+// match the persisted channel anymore.
+func (l *BatchSubmitter) loadRestoredBlocks(ctx context.Context, pc PersistedChannel) ([]*types.Block, error) {
+	var blocks []*types.Block
+	parent := pc.Parent.Hash
+	for i := pc.Parent.Number + 1; i <= pc.Last.Number; i++ {
+		tctx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+		block, err := l.L2Client.BlockByNumber(tctx, new(big.Int).SetUint64(i))
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("getting L2 block of persisted channel: %w", err)
+		}
+		if block.ParentHash() != parent {
+			return nil, nil
+		}
+		parent = block.Hash()
+		blocks = append(blocks, block)
+	}
+	if parent != pc.Last.Hash {
+		return nil, nil
+	}
+	return blocks, nil
+}
+
+// persistState persists the state of the channel manager. Errors are only logged, since the batcher
+// keeps working without, but then starts from the safe head after a restart.
+func (l *BatchSubmitter) persistState() {
+	if err := l.Persistence.Save(l.state.PersistedState()); err != nil {
+		l.Log.Error("Failed to persist batcher state", "err", err)
+	}
+}
+
 // The following things occur:
 // New L2 block (reorg or not)
 // L1 transaction is confirmed
@@ -244,24 +406,37 @@ func (l *BatchSubmitter) calculateL2BlockRangeToStore(ctx context.Context) (eth.
 func (l *BatchSubmitter) loop() {
 	defer l.wg.Done()
 
-	ticker := time.NewTicker(l.Cfg.PollInterval)
+	ticker := l.Clock.NewTicker(l.Cfg.PollInterval)
 	defer ticker.Stop()
 
 	receiptsCh := make(chan txmgr.TxReceipt[txData])
 	queue := txmgr.NewQueue[txData](l.killCtx, l.Txmgr, l.Cfg.MaxPendingTransactions)
 
+	if err := l.restoreState(l.shutdownCtx); err != nil {
+		l.Log.Warn("Failed to restore persisted state, starting from the safe head", "err", err)
+		l.state.Clear()
+		l.lastStoredBlock = eth.BlockID{}
+	}
+
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.Ch():
 			if err := l.loadBlocksIntoState(l.shutdownCtx); errors.Is(err, ErrReorg) {
 				err := l.state.Close()
 				if err != nil {
 					l.Log.Error("error closing the channel manager to handle a L2 reorg", "err", err)
 				}
-				l.publishStateToL1(queue, receiptsCh, true)
+				if l.paused.Load() {
+					l.waitForPendingTxs(queue, receiptsCh)
+				} else {
+					l.publishStateToL1(queue, receiptsCh, true)
+				}
 				l.state.Clear()
 				continue
 			}
+			if l.paused.Load() {
+				continue
+			}
 			l.publishStateToL1(queue, receiptsCh, false)
 		case r := <-receiptsCh:
 			l.handleReceipt(r)
@@ -270,33 +445,86 @@ func (l *BatchSubmitter) loop() {
 			if err != nil {
 				l.Log.Error("error closing the channel manager", "err", err)
 			}
+			if l.paused.Load() {
+				// the closed channels are resumed after a restart, if the state is persisted
+				l.waitForPendingTxs(queue, receiptsCh)
+				return
+			}
 			l.publishStateToL1(queue, receiptsCh, true)
 			return
 		}
 	}
 }
 
+// throttleLoop updates the Throttler every poll interval, by the L1 fees of the L1 head and the backlog of
+// unsafe L2 blocks, which are the blocks that are not derived from L1 yet.
+func (l *BatchSubmitter) throttleLoop() {
+	defer l.wg.Done()
+
+	ticker := l.Clock.NewTicker(l.Cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.Ch():
+			if err := l.updateThrottle(l.shutdownCtx); err != nil {
+				l.Log.Warn("Failed to update batch submission throttling", "err", err)
+			}
+		case <-l.shutdownCtx.Done():
+			return
+		}
+	}
+}
+
+func (l *BatchSubmitter) updateThrottle(ctx context.Context) error {
+	tctx, cancel := context.WithTimeout(ctx, l.Cfg.NetworkTimeout)
+	defer cancel()
+	head, err := l.L1Client.HeaderByNumber(tctx, nil)
+	if err != nil {
+		return fmt.Errorf("getting latest L1 block: %w", err)
+	}
+	syncStatus, err := l.RollupClient.SyncStatus(tctx)
+	if err != nil {
+		return fmt.Errorf("getting sync status: %w", err)
+	}
+	var backlog uint64
+	if syncStatus.UnsafeL2.Number > syncStatus.SafeL2.Number {
+		backlog = syncStatus.UnsafeL2.Number - syncStatus.SafeL2.Number
+	}
+	l.Throttler.Update(head, backlog)
+	return nil
+}
+
 // publishStateToL1 loops through the block data loaded into `state` and
 // submits the associated data to the L1 in the form of channel frames.
 func (l *BatchSubmitter) publishStateToL1(queue *txmgr.Queue[txData], receiptsCh chan txmgr.TxReceipt[txData], drain bool) {
+	ctx, span := tracing.Tracer(tracerName).Start(l.killCtx, "batcher.publish_state", trace.WithAttributes(attribute.Bool("drain", drain)))
 	txDone := make(chan struct{})
 	// send/wait and receipt reading must be on a separate goroutines to avoid deadlocks
 	go func() {
+		var err error
+		txCount := 0
 		defer func() {
 			if drain {
 				// if draining, we wait for all transactions to complete
 				queue.Wait()
 			}
+			span.SetAttributes(attribute.Int("tx_count", txCount))
+			if err == io.EOF {
+				err = nil
+			}
+			tracing.EndSpan(span, err)
 			close(txDone)
 		}()
 		for {
-			err := l.publishTxToL1(l.killCtx, queue, receiptsCh)
+			err = l.publishTxToL1(ctx, queue, receiptsCh)
 			if err != nil {
 				if drain && err != io.EOF {
 					l.Log.Error("error sending tx while draining state", "err", err)
 				}
 				return
 			}
+			txCount++
 		}
 	}()
 
@@ -305,6 +533,27 @@ func (l *BatchSubmitter) publishStateToL1(queue *txmgr.Queue[txData], receiptsCh
 		case r := <-receiptsCh:
 			l.handleReceipt(r)
 		case <-txDone:
+			l.persistState()
+			return
+		}
+	}
+}
+
+// waitForPendingTxs waits for the in-flight txs to complete, without sending new ones,
+// while submission is paused.
+func (l *BatchSubmitter) waitForPendingTxs(queue *txmgr.Queue[txData], receiptsCh chan txmgr.TxReceipt[txData]) {
+	txDone := make(chan struct{})
+	go func() {
+		queue.Wait()
+		close(txDone)
+	}()
+
+	for {
+		select {
+		case r := <-receiptsCh:
+			l.handleReceipt(r)
+		case <-txDone:
+			l.persistState()
 			return
 		}
 	}
@@ -330,6 +579,10 @@ func (l *BatchSubmitter) publishTxToL1(ctx context.Context, queue *txmgr.Queue[t
 		return err
 	}
 
+	trace.SpanFromContext(ctx).AddEvent("send_tx", trace.WithAttributes(
+		attribute.String("tx", txdata.ID().String()),
+		attribute.Int("data_size", txdata.Len()),
+		attribute.Bool("as_blob", txdata.asBlob)))
 	l.sendTransaction(txdata, queue, receiptsCh)
 	return nil
 }
@@ -339,7 +592,19 @@ func (l *BatchSubmitter) publishTxToL1(ctx context.Context, queue *txmgr.Queue[t
 // This is a blocking method. It should not be called concurrently.
 func (l *BatchSubmitter) sendTransaction(txdata txData, queue *txmgr.Queue[txData], receiptsCh chan txmgr.TxReceipt[txData]) {
 	// Do the gas estimation offline. A value of 0 will cause the [txmgr] to estimate the gas limit.
-	data := txdata.Bytes()
+	// The data of blob txs is in the blob, so their calldata is empty.
+	var data []byte
+	var blobs []*eth.Blob
+	if txdata.asBlob {
+		var err error
+		blobs, err = txdata.Blobs()
+		if err != nil {
+			l.Log.Error("Failed to encode blobs", "error", err)
+			return
+		}
+	} else {
+		data = txdata.Bytes()
+	}
 	intrinsicGas, err := core.IntrinsicGas(data, nil, false, true, true, false)
 	if err != nil {
 		l.Log.Error("Failed to calculate intrinsic gas", "error", err)
@@ -350,6 +615,7 @@ func (l *BatchSubmitter) sendTransaction(txdata txData, queue *txmgr.Queue[txDat
 		To:       &l.RollupCfg.BatchInboxAddress,
 		TxData:   data,
 		GasLimit: intrinsicGas,
+		Blobs:    blobs,
 	}
 	queue.Send(txdata, candidate, receiptsCh)
 }
@@ -357,12 +623,13 @@ func (l *BatchSubmitter) sendTransaction(txdata txData, queue *txmgr.Queue[txDat
 func (l *BatchSubmitter) handleReceipt(r txmgr.TxReceipt[txData]) {
 	// Record TX Status
 	if r.Err != nil {
-		l.Log.Warn("unable to publish tx", "err", r.Err, "data_size", r.ID.Len())
+		l.Log.Warn("unable to publish tx", "err", r.Err, "data_size", r.ID.Len(), "num_frames", len(r.ID.Frames()), "as_blob", r.ID.asBlob)
 		l.recordFailedTx(r.ID.ID(), r.Err)
 	} else {
-		l.Log.Info("tx successfully published", "tx_hash", r.Receipt.TxHash, "data_size", r.ID.Len())
+		l.Log.Info("tx successfully published", "tx_hash", r.Receipt.TxHash, "data_size", r.ID.Len(), "num_frames", len(r.ID.Frames()), "as_blob", r.ID.asBlob)
 		l.recordConfirmedTx(r.ID.ID(), r.Receipt)
 	}
+	l.persistState()
 }
 
 func (l *BatchSubmitter) recordL1Tip(l1tip eth.L1BlockRef) {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"net"
 	_ "net/http/pprof"
 	"strconv"
@@ -14,16 +15,21 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 
+	"github.com/ethereum-optimism/optimism/op-batcher/flags"
 	"github.com/ethereum-optimism/optimism/op-batcher/metrics"
 	"github.com/ethereum-optimism/optimism/op-batcher/rpc"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-service/cliapp"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
+	optracing "github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -49,27 +55,52 @@ type BatcherService struct {
 
 	// Channel builder parameters
 	Channel ChannelConfig
+	// ChannelConfigProvider provides the config of each channel. It is the Channel config if the batcher
+	// only submits calldata.
+	ChannelConfigProvider ChannelConfigProvider
+	// StatePersistence persists the channel manager state across restarts.
+	StatePersistence StatePersistence
+	// Throttler throttles batch submission during L1 fee spikes, if enabled. It wraps the ChannelConfigProvider.
+	Throttler *Throttler
 
 	driver *BatchSubmitter
 
 	Version string
 
-	pprofSrv   *httputil.HTTPServer
-	metricsSrv *httputil.HTTPServer
-	rpcServer  *oprpc.Server
+	pprofSrv    *httputil.HTTPServer
+	pprofPusher *oppprof.Pusher
+	metricsSrv  *httputil.HTTPServer
+	rpcServer   *oprpc.Server
+	tracing     *optracing.Provider
 
 	balanceMetricer io.Closer
 
 	stopped atomic.Bool
 
 	NotSubmittingOnStart bool
+
+	// Clock that the driver is scheduled with, see DriverSetup.
+	Clock clock.Clock
+}
+
+// BatcherServiceOption customizes the BatcherService, beyond what can be configured through the CLIConfig.
+type BatcherServiceOption func(bs *BatcherService)
+
+// WithClock sets the clock that the batch submitter polls the L2 chain with.
+func WithClock(cl clock.Clock) BatcherServiceOption {
+	return func(bs *BatcherService) {
+		bs.Clock = cl
+	}
 }
 
 // BatcherServiceFromCLIConfig creates a new BatcherService from a CLIConfig.
 // The service components are fully started, except for the driver,
 // which will not be submitting batches (if it was configured to) until the Start part of the lifecycle.
-func BatcherServiceFromCLIConfig(ctx context.Context, version string, cfg *CLIConfig, log log.Logger) (*BatcherService, error) {
+func BatcherServiceFromCLIConfig(ctx context.Context, version string, cfg *CLIConfig, log log.Logger, opts ...BatcherServiceOption) (*BatcherService, error) {
 	var bs BatcherService
+	for _, opt := range opts {
+		opt(&bs)
+	}
 	if err := bs.initFromCLIConfig(ctx, version, cfg, log); err != nil {
 		return nil, errors.Join(err, bs.Stop(ctx)) // try to clean up our failed initialization attempt
 	}
@@ -80,8 +111,12 @@ func (bs *BatcherService) initFromCLIConfig(ctx context.Context, version string,
 	bs.Version = version
 	bs.Log = log
 	bs.NotSubmittingOnStart = cfg.Stopped
+	bs.initStatePersistence(cfg)
 
 	bs.initMetrics(cfg)
+	if err := bs.initTracing(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to start tracing: %w", err)
+	}
 
 	bs.PollInterval = cfg.PollInterval
 	bs.MaxPendingTransactions = cfg.MaxPendingTransactions
@@ -93,9 +128,10 @@ func (bs *BatcherService) initFromCLIConfig(ctx context.Context, version string,
 	if err := bs.initRollupCfg(ctx); err != nil {
 		return fmt.Errorf("failed to load rollup config: %w", err)
 	}
-	if err := bs.initChannelConfig(cfg); err != nil {
+	if err := bs.initChannelConfig(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to init channel config: %w", err)
 	}
+	bs.initThrottler(cfg)
 	if err := bs.initTxManager(cfg); err != nil {
 		return fmt.Errorf("failed to init Tx manager: %w", err)
 	}
@@ -129,7 +165,11 @@ func (bs *BatcherService) initRPCClients(ctx context.Context, cfg *CLIConfig) er
 	}
 	bs.L2Client = l2Client
 
-	rollupClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, bs.Log, cfg.RollupRpc)
+	rollupOpts, err := dial.TLSOptions(bs.Log, cfg.RollupRpcTLS)
+	if err != nil {
+		return err
+	}
+	rollupClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, bs.Log, cfg.RollupRpc, rollupOpts...)
 	if err != nil {
 		return fmt.Errorf("failed to dial L2 rollup-client RPC: %w", err)
 	}
@@ -149,7 +189,7 @@ func (bs *BatcherService) initMetrics(cfg *CLIConfig) {
 // initBalanceMonitor depends on Metrics, L1Client and TxManager to start background-monitoring of the batcher balance.
 func (bs *BatcherService) initBalanceMonitor(cfg *CLIConfig) {
 	if cfg.MetricsConfig.Enabled {
-		bs.balanceMetricer = bs.Metrics.StartBalanceMetrics(bs.Log, bs.L1Client, bs.TxManager.From())
+		bs.balanceMetricer = bs.Metrics.StartBalanceMetrics(bs.Log, bs.L1Client, bs.TxManager.From(), cfg.MetricsConfig.BalanceThresholds())
 	}
 }
 
@@ -165,7 +205,7 @@ func (bs *BatcherService) initRollupCfg(ctx context.Context) error {
 	return nil
 }
 
-func (bs *BatcherService) initChannelConfig(cfg *CLIConfig) error {
+func (bs *BatcherService) initChannelConfig(ctx context.Context, cfg *CLIConfig) error {
 	bs.Channel = ChannelConfig{
 		SeqWindowSize:      bs.RollupConfig.SeqWindowSize,
 		ChannelTimeout:     bs.RollupConfig.ChannelTimeout,
@@ -173,10 +213,87 @@ func (bs *BatcherService) initChannelConfig(cfg *CLIConfig) error {
 		SubSafetyMargin:    cfg.SubSafetyMargin,
 		MaxFrameSize:       cfg.MaxL1TxSize - 1, // subtract 1 byte for version
 		CompressorConfig:   cfg.CompressorConfig.Config(),
+		MaxFramesPerTx:     cfg.MaxFramesPerTx,
+		BatchType:          cfg.BatchType,
+		GenesisTimestamp:   bs.RollupConfig.Genesis.L2Time,
+		ChainID:            bs.RollupConfig.L2ChainID,
 	}
 	if err := bs.Channel.Check(); err != nil {
 		return fmt.Errorf("invalid channel configuration: %w", err)
 	}
+	if err := bs.checkCompressionAlgo(ctx); err != nil {
+		return err
+	}
+	if err := bs.checkSpanBatch(ctx); err != nil {
+		return err
+	}
+	bs.ChannelConfigProvider = bs.Channel
+	if cfg.DataAvailabilityType == flags.CalldataType {
+		return nil
+	}
+
+	if bs.RollupConfig.BlobsEnabledL1Timestamp == nil {
+		return fmt.Errorf("data availability type %s requires blobs to be enabled in the rollup config", cfg.DataAvailabilityType)
+	}
+	// Blob channels target whole blobs, with one frame per blob, and up to MaxFramesPerTx blobs per tx.
+	blobCfg := bs.Channel
+	blobCfg.UseBlobs = true
+	blobCfg.MaxFrameSize = eth.MaxBlobDataSize - 1 // subtract 1 byte for version
+	blobCfg.CompressorConfig.TargetFrameSize = blobCfg.MaxFrameSize
+	if err := blobCfg.Check(); err != nil {
+		return fmt.Errorf("invalid blob channel configuration: %w", err)
+	}
+	bs.ChannelConfigProvider = NewDynamicEthChannelConfig(bs.Log, bs.NetworkTimeout, bs.L1Client, bs.RollupConfig,
+		bs.Channel, blobCfg, cfg.DataAvailabilityType == flags.AutoType)
+	bs.Log.Info("Submitting batch data as blobs once enabled", "data_availability_type", cfg.DataAvailabilityType,
+		"blobs_l1_time", *bs.RollupConfig.BlobsEnabledL1Timestamp)
+	return nil
+}
+
+// checkCompressionAlgo checks that channels compressed with an algorithm other than zlib can be derived from.
+// Channels are included after the current L1 head, so channel compression must already be active at the head.
+func (bs *BatcherService) checkCompressionAlgo(ctx context.Context) error {
+	algo := bs.Channel.CompressorConfig.CompressionAlgo
+	if algo == "" || algo == derive.Zlib {
+		return nil
+	}
+	if bs.RollupConfig.ChannelCompressionL1Timestamp == nil {
+		return fmt.Errorf("compression algorithm %s requires channel compression to be enabled in the rollup config", algo)
+	}
+	cctx, cancel := context.WithTimeout(ctx, bs.NetworkTimeout)
+	defer cancel()
+	head, err := bs.L1Client.HeaderByNumber(cctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 head to check channel compression: %w", err)
+	}
+	if !bs.RollupConfig.IsChannelCompression(head.Time) {
+		return fmt.Errorf("compression algorithm %s requires channel compression, which is only active from L1 time %d, but the L1 head time is %d",
+			algo, *bs.RollupConfig.ChannelCompressionL1Timestamp, head.Time)
+	}
+	bs.Log.Info("Compressing channels", "algo", algo, "level", bs.Channel.CompressorConfig.CompressionLevel)
+	return nil
+}
+
+// checkSpanBatch checks that span batches can be derived from, if the channels encode their blocks as span batches.
+// Like channel compression, the span batch fork is checked against the L1 inclusion time, so it must be active at the head.
+func (bs *BatcherService) checkSpanBatch(ctx context.Context) error {
+	if bs.Channel.BatchType != derive.SpanBatchType {
+		return nil
+	}
+	if bs.RollupConfig.SpanBatchTime == nil {
+		return errors.New("span batches require the span batch fork to be scheduled in the rollup config")
+	}
+	cctx, cancel := context.WithTimeout(ctx, bs.NetworkTimeout)
+	defer cancel()
+	head, err := bs.L1Client.HeaderByNumber(cctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L1 head to check span batch fork: %w", err)
+	}
+	if !bs.RollupConfig.IsSpanBatch(head.Time) {
+		return fmt.Errorf("span batches are only valid from L1 time %d, but the L1 head time is %d",
+			*bs.RollupConfig.SpanBatchTime, head.Time)
+	}
+	bs.Log.Info("Encoding channels as span batches")
 	return nil
 }
 
@@ -189,7 +306,17 @@ func (bs *BatcherService) initTxManager(cfg *CLIConfig) error {
 	return nil
 }
 
+func (bs *BatcherService) initTracing(ctx context.Context, cfg *CLIConfig) error {
+	provider, err := optracing.StartTracing(ctx, bs.Log, cfg.TracingConfig, "op-batcher", bs.Version)
+	if err != nil {
+		return err
+	}
+	bs.tracing = provider
+	return nil
+}
+
 func (bs *BatcherService) initPProf(cfg *CLIConfig) error {
+	bs.pprofPusher = oppprof.StartPusher(bs.Log, cfg.PprofConfig, "op-batcher", bs.Version)
 	if !cfg.PprofConfig.Enabled {
 		return nil
 	}
@@ -232,16 +359,56 @@ func (bs *BatcherService) initDriver() {
 		L1Client:     bs.L1Client,
 		L2Client:     bs.L2Client,
 		RollupClient: bs.RollupNode,
-		Channel:      bs.Channel,
+		Channel:      bs.ChannelConfigProvider,
+		Clock:        bs.Clock,
+		Persistence:  bs.StatePersistence,
+		Throttler:    bs.Throttler,
 	})
 }
 
+func (bs *BatcherService) initThrottler(cfg *CLIConfig) {
+	tcfg := ThrottleConfig{
+		MaxBacklog:         cfg.ThrottleMaxBacklog,
+		MaxChannelDuration: cfg.ThrottleMaxChannelDuration,
+	}
+	if cfg.ThrottleBaseFee != 0 {
+		tcfg.BaseFeeThreshold = new(big.Int).SetUint64(cfg.ThrottleBaseFee)
+	}
+	if cfg.ThrottleBlobBaseFee != 0 {
+		tcfg.BlobBaseFeeThreshold = new(big.Int).SetUint64(cfg.ThrottleBlobBaseFee)
+	}
+	if cfg.ThrottleMaxL1TxSize != 0 {
+		tcfg.MaxFrameSize = cfg.ThrottleMaxL1TxSize - 1 // subtract 1 byte for version
+	}
+	if !tcfg.Enabled() {
+		return
+	}
+	bs.Throttler = NewThrottler(bs.Log, tcfg, bs.ChannelConfigProvider)
+	bs.ChannelConfigProvider = bs.Throttler
+	bs.Log.Info("Throttling batch submission during L1 fee spikes", "basefee", tcfg.BaseFeeThreshold,
+		"blob_basefee", tcfg.BlobBaseFeeThreshold, "max_backlog", tcfg.MaxBacklog)
+}
+
+func (bs *BatcherService) initStatePersistence(cfg *CLIConfig) {
+	if cfg.StateFile == "" {
+		bs.StatePersistence = DisabledStatePersistence{}
+		return
+	}
+	bs.Log.Info("Persisting batcher state", "file", cfg.StateFile)
+	bs.StatePersistence = NewStatePersistence(cfg.StateFile)
+}
+
 func (bs *BatcherService) initRPCServer(cfg *CLIConfig) error {
+	tlsConfig, err := cfg.RPC.ServerTLSConfig(bs.Log)
+	if err != nil {
+		return err
+	}
 	server := oprpc.NewServer(
 		cfg.RPC.ListenAddr,
 		cfg.RPC.ListenPort,
 		bs.Version,
 		oprpc.WithLogger(bs.Log),
+		oprpc.WithTLSConfig(tlsConfig),
 	)
 	if cfg.RPC.EnableAdmin {
 		adminAPI := rpc.NewAdminAPI(bs.driver, bs.Metrics, bs.Log)
@@ -304,6 +471,11 @@ func (bs *BatcherService) Stop(ctx context.Context) error {
 			result = errors.Join(result, fmt.Errorf("failed to stop PProf server: %w", err))
 		}
 	}
+	if bs.pprofPusher != nil {
+		if err := bs.pprofPusher.Stop(ctx); err != nil {
+			result = errors.Join(result, fmt.Errorf("failed to stop PProf pusher: %w", err))
+		}
+	}
 	if bs.balanceMetricer != nil {
 		if err := bs.balanceMetricer.Close(); err != nil {
 			result = errors.Join(result, fmt.Errorf("failed to close balance metricer: %w", err))
@@ -314,6 +486,9 @@ func (bs *BatcherService) Stop(ctx context.Context) error {
 			result = errors.Join(result, fmt.Errorf("failed to stop metrics server: %w", err))
 		}
 	}
+	if bs.tracing != nil {
+		result = errors.Join(result, bs.tracing.Stop(ctx))
+	}
 
 	if bs.L1Client != nil {
 		bs.L1Client.Close()
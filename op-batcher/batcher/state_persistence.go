@@ -0,0 +1,113 @@
+package batcher
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+)
+
+// PersistedState is the state of the channel manager that is kept across restarts: the channels that are
+// closed, but not fully confirmed yet. The blocks of the open channel and of the blocks queue are not
+// persisted, but loaded again from L2, after the last block of the persisted channels.
+type PersistedState struct {
+	Channels []PersistedChannel `json:"channels"`
+}
+
+type PersistedChannel struct {
+	ID       derive.ChannelID `json:"id"`
+	UseBlobs bool             `json:"useBlobs"`
+	// Parent is the L2 block before the first block of the channel, and Last the last block of the channel.
+	Parent eth.BlockID `json:"parent"`
+	Last   eth.BlockID `json:"last"`
+	// TotalFrames is the number of frames of the channel.
+	TotalFrames int `json:"totalFrames"`
+	// Frames are the frames that are not confirmed yet, including the frames of in-flight transactions.
+	Frames []PersistedFrame `json:"frames"`
+	// Confirmed are the frames that were confirmed, with the L1 block that included them.
+	Confirmed []ConfirmedFrame `json:"confirmed"`
+}
+
+type PersistedFrame struct {
+	Number uint16        `json:"number"`
+	Data   hexutil.Bytes `json:"data"`
+}
+
+type ConfirmedFrame struct {
+	Number    uint16      `json:"number"`
+	Inclusion eth.BlockID `json:"inclusion"`
+}
+
+// StatePersistence stores the channel manager state, so that closed channels are resumed after a restart,
+// instead of being orphaned, or having their blocks submitted again.
+type StatePersistence interface {
+	Save(state *PersistedState) error
+	// Load returns nil if no state was persisted yet.
+	Load() (*PersistedState, error)
+}
+
+var _ StatePersistence = (*ActiveStatePersistence)(nil)
+var _ StatePersistence = DisabledStatePersistence{}
+
+type ActiveStatePersistence struct {
+	lock sync.Mutex
+	file string
+}
+
+func NewStatePersistence(file string) *ActiveStatePersistence {
+	return &ActiveStatePersistence{file: file}
+}
+
+// Save writes the state to a temp file, that is then renamed into place, so that the persisted state is
+// not corrupted if the batcher stops while writing.
+func (p *ActiveStatePersistence) Save(state *PersistedState) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("marshal batcher state: %w", err)
+	}
+	dir := filepath.Dir(p.file)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("create state dir (%v): %w", p.file, err)
+	}
+	if err := ioutil.WriteFileAtomic(p.file, data, 0644); err != nil {
+		return fmt.Errorf("write state file (%v): %w", p.file, err)
+	}
+	return nil
+}
+
+func (p *ActiveStatePersistence) Load() (*PersistedState, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	data, err := os.ReadFile(p.file)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("read state file (%v): %w", p.file, err)
+	}
+	var state PersistedState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("invalid state file (%v): %w", p.file, err)
+	}
+	return &state, nil
+}
+
+// DisabledStatePersistence does not persist the state, so that the batcher starts from the safe head.
+type DisabledStatePersistence struct{}
+
+func (DisabledStatePersistence) Save(state *PersistedState) error {
+	return nil
+}
+
+func (DisabledStatePersistence) Load() (*PersistedState, error) {
+	return nil, nil
+}
@@ -0,0 +1,44 @@
+package batcher
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+func TestActiveStatePersistence(t *testing.T) {
+	t.Run("NilWhenFileDoesNotExist", func(t *testing.T) {
+		p := NewStatePersistence(t.TempDir() + "/state")
+		state, err := p.Load()
+		require.NoError(t, err)
+		require.Nil(t, state)
+	})
+
+	t.Run("PersistState", func(t *testing.T) {
+		p1 := NewStatePersistence(t.TempDir() + "/state")
+		state := &PersistedState{Channels: []PersistedChannel{{
+			UseBlobs:    true,
+			Parent:      eth.BlockID{Number: 1},
+			Last:        eth.BlockID{Number: 2},
+			TotalFrames: 2,
+			Frames:      []PersistedFrame{{Number: 1, Data: []byte{0x01}}},
+			Confirmed:   []ConfirmedFrame{{Number: 0, Inclusion: eth.BlockID{Number: 3}}},
+		}}}
+		require.NoError(t, p1.Save(state))
+
+		p2 := NewStatePersistence(p1.file)
+		loaded, err := p2.Load()
+		require.NoError(t, err)
+		require.Equal(t, state, loaded)
+	})
+
+	t.Run("InvalidFile", func(t *testing.T) {
+		p := NewStatePersistence(t.TempDir() + "/state")
+		require.NoError(t, os.WriteFile(p.file, []byte("{"), 0644))
+		_, err := p.Load()
+		require.ErrorContains(t, err, "invalid state file")
+	})
+}
@@ -0,0 +1,97 @@
+package batcher
+
+import (
+	"math/big"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ThrottleConfig configures the throttling of batch submission during L1 fee spikes.
+type ThrottleConfig struct {
+	// BaseFeeThreshold is the L1 basefee, in wei, above which submission is throttled. Disabled if nil.
+	BaseFeeThreshold *big.Int
+	// BlobBaseFeeThreshold is the L1 blob basefee, in wei, above which submission is throttled. Disabled if nil.
+	BlobBaseFeeThreshold *big.Int
+	// MaxBacklog is the number of unsafe L2 blocks, above which submission is not throttled, even during a fee
+	// spike, so that the safe head does not fall too far behind.
+	MaxBacklog uint64
+	// MaxChannelDuration is the max channel duration of channels that are opened while throttled. If 0, the
+	// max channel duration is not changed.
+	MaxChannelDuration uint64
+	// MaxFrameSize is the max frame size of channels that are opened while throttled. It only lowers the max
+	// frame size. If 0, the max frame size is not changed.
+	MaxFrameSize uint64
+}
+
+// Enabled returns whether any fee threshold is set.
+func (c *ThrottleConfig) Enabled() bool {
+	return c.BaseFeeThreshold != nil || c.BlobBaseFeeThreshold != nil
+}
+
+// Throttler is a ChannelConfigProvider that adjusts the config of the inner provider while throttled.
+// Whether submission is throttled is updated by the control loop of the driver, by the L1 fees of the
+// L1 head and by the backlog of unsafe L2 blocks. Channels that are already open keep their config.
+type Throttler struct {
+	log   log.Logger
+	cfg   ThrottleConfig
+	inner ChannelConfigProvider
+
+	throttled atomic.Bool
+}
+
+func NewThrottler(lgr log.Logger, cfg ThrottleConfig, inner ChannelConfigProvider) *Throttler {
+	return &Throttler{
+		log:   lgr,
+		cfg:   cfg,
+		inner: inner,
+	}
+}
+
+func (t *Throttler) ChannelConfig() ChannelConfig {
+	cc := t.inner.ChannelConfig()
+	if !t.throttled.Load() {
+		return cc
+	}
+	if t.cfg.MaxChannelDuration != 0 {
+		cc.MaxChannelDuration = t.cfg.MaxChannelDuration
+	}
+	if t.cfg.MaxFrameSize != 0 && t.cfg.MaxFrameSize < cc.MaxFrameSize {
+		cc.MaxFrameSize = t.cfg.MaxFrameSize
+		if cc.CompressorConfig.TargetFrameSize > cc.MaxFrameSize {
+			cc.CompressorConfig.TargetFrameSize = cc.MaxFrameSize
+		}
+	}
+	return cc
+}
+
+// Throttled returns whether new channels are opened with the throttled config.
+func (t *Throttler) Throttled() bool {
+	return t.throttled.Load()
+}
+
+// Update throttles submission if the basefee or blob basefee of the L1 head is above its threshold, unless
+// the backlog of unsafe L2 blocks is above the max backlog. It returns whether submission is throttled.
+func (t *Throttler) Update(head *types.Header, backlog uint64) bool {
+	spike := false
+	if t.cfg.BaseFeeThreshold != nil && head.BaseFee != nil && head.BaseFee.Cmp(t.cfg.BaseFeeThreshold) > 0 {
+		spike = true
+	}
+	var blobBaseFee *big.Int
+	if head.ExcessBlobGas != nil {
+		blobBaseFee = eip4844.CalcBlobFee(*head.ExcessBlobGas)
+		if t.cfg.BlobBaseFeeThreshold != nil && blobBaseFee.Cmp(t.cfg.BlobBaseFeeThreshold) > 0 {
+			spike = true
+		}
+	}
+	throttle := spike && backlog <= t.cfg.MaxBacklog
+	if prev := t.throttled.Swap(throttle); prev != throttle {
+		t.log.Info("Changed batch submission throttling", "throttled", throttle, "l1_head", head.Number,
+			"basefee", head.BaseFee, "blob_basefee", blobBaseFee, "backlog", backlog)
+	} else if spike && !throttle {
+		t.log.Debug("Not throttling batch submission during fee spike, backlog too large", "backlog", backlog)
+	}
+	return throttle
+}
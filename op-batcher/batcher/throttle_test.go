@@ -0,0 +1,70 @@
+package batcher
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestThrottlerChannelConfig(t *testing.T) {
+	cfg := ThrottleConfig{
+		BaseFeeThreshold:   big.NewInt(1000),
+		MaxBacklog:         10,
+		MaxChannelDuration: 20,
+		MaxFrameSize:       50_000,
+	}
+	th := NewThrottler(testlog.Logger(t, log.LvlCrit), cfg, defaultTestChannelConfig)
+	require.Equal(t, defaultTestChannelConfig, th.ChannelConfig())
+
+	require.True(t, th.Update(&types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(1001)}, 10))
+	cc := th.ChannelConfig()
+	require.Equal(t, uint64(20), cc.MaxChannelDuration)
+	require.Equal(t, uint64(50_000), cc.MaxFrameSize)
+	require.Equal(t, uint64(50_000), cc.CompressorConfig.TargetFrameSize)
+
+	// the max frame size is only lowered
+	cfg.MaxFrameSize = 200_000
+	th = NewThrottler(testlog.Logger(t, log.LvlCrit), cfg, defaultTestChannelConfig)
+	require.True(t, th.Update(&types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(1001)}, 0))
+	cc = th.ChannelConfig()
+	require.Equal(t, defaultTestChannelConfig.MaxFrameSize, cc.MaxFrameSize)
+	require.Equal(t, defaultTestChannelConfig.CompressorConfig, cc.CompressorConfig)
+}
+
+func TestThrottlerUpdate(t *testing.T) {
+	// the blob base fee is 1 wei without excess blob gas
+	noExcess := uint64(0)
+	highExcess := uint64(30_000_000)
+	cfg := ThrottleConfig{
+		BaseFeeThreshold:     big.NewInt(1000),
+		BlobBaseFeeThreshold: big.NewInt(1000),
+		MaxBacklog:           10,
+	}
+	tests := []struct {
+		name      string
+		head      *types.Header
+		backlog   uint64
+		throttled bool
+	}{
+		{name: "LowFees", head: &types.Header{BaseFee: big.NewInt(1000), ExcessBlobGas: &noExcess}},
+		{name: "BaseFeeSpike", head: &types.Header{BaseFee: big.NewInt(1001), ExcessBlobGas: &noExcess}, throttled: true},
+		{name: "BlobBaseFeeSpike", head: &types.Header{BaseFee: big.NewInt(10), ExcessBlobGas: &highExcess}, throttled: true},
+		{name: "PreCancunHead", head: &types.Header{BaseFee: big.NewInt(10)}},
+		{name: "MaxBacklog", head: &types.Header{BaseFee: big.NewInt(1001)}, backlog: 10, throttled: true},
+		{name: "LargeBacklog", head: &types.Header{BaseFee: big.NewInt(1001)}, backlog: 11},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			test.head.Number = big.NewInt(1)
+			th := NewThrottler(testlog.Logger(t, log.LvlCrit), cfg, defaultTestChannelConfig)
+			require.Equal(t, test.throttled, th.Update(test.head, test.backlog))
+			require.Equal(t, test.throttled, th.Throttled())
+		})
+	}
+}
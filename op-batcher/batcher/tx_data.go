@@ -4,47 +4,70 @@ import (
 	"fmt"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
 // txData represents the data for a single transaction.
 //
-// Note: The batcher currently sends exactly one frame per transaction. This
-// might change in the future to allow for multiple frames from possibly
-// different channels.
+// A transaction holds one or more frames, possibly from different channels. As calldata, the
+// frames are concatenated after a single version byte. As blobs, each frame is the data of its
+// own blob, after a version byte.
 type txData struct {
-	frame frameData
+	frames []frameData
+	// asBlob is set if the data is submitted as blobs of a blob tx, instead of calldata.
+	asBlob bool
 }
 
 // ID returns the id for this transaction data. It can be used as a map key.
+// The id of the first frame identifies the transaction, since every frame is sent in one transaction at a time.
 func (td *txData) ID() txID {
-	return td.frame.id
+	return td.frames[0].id
 }
 
 // Bytes returns the transaction data. It's a version byte (0) followed by the
 // concatenated frames for this transaction.
 func (td *txData) Bytes() []byte {
-	return append([]byte{derive.DerivationVersion0}, td.frame.data...)
+	data := make([]byte, 0, td.Len())
+	data = append(data, derive.DerivationVersion0)
+	for _, f := range td.frames {
+		data = append(data, f.data...)
+	}
+	return data
 }
 
+// Len returns the length of the calldata of the transaction data.
 func (td *txData) Len() int {
-	return 1 + len(td.frame.data)
+	l := 1
+	for _, f := range td.frames {
+		l += len(f.data)
+	}
+	return l
 }
 
-// Frame returns the single frame of this tx data.
-//
-// Note: when the batcher is changed to possibly send multiple frames per tx,
-// this should be changed to a func Frames() []frameData.
-func (td *txData) Frame() frameData {
-	return td.frame
+// Blobs returns the frames of the transaction data encoded as blobs, one blob per frame.
+// The channel config ensures that each frame fits.
+func (td *txData) Blobs() ([]*eth.Blob, error) {
+	blobs := make([]*eth.Blob, 0, len(td.frames))
+	for _, f := range td.frames {
+		var blob eth.Blob
+		if err := blob.FromData(append([]byte{derive.DerivationVersion0}, f.data...)); err != nil {
+			return nil, fmt.Errorf("failed to encode frame %v as blob: %w", f.id, err)
+		}
+		blobs = append(blobs, &blob)
+	}
+	return blobs, nil
+}
+
+// Frames returns the frames of this tx data.
+func (td *txData) Frames() []frameData {
+	return td.frames
 }
 
 // txID is an opaque identifier for a transaction.
 // It's internal fields should not be inspected after creation & are subject to change.
 // This ID must be trivially comparable & work as a map key.
 //
-// Note: transactions currently only hold a single frame, so it can be
-// identified by the frame. This needs to be changed once the batcher is changed
-// to send multiple frames per tx.
+// A transaction is identified by its first frame.
 type txID = frameID
 
 func (id txID) String() string {
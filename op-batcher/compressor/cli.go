@@ -1,8 +1,10 @@
 package compressor
 
 import (
+	"fmt"
 	"strings"
 
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	"github.com/urfave/cli/v2"
 )
@@ -12,6 +14,8 @@ const (
 	TargetNumFramesFlagName     = "target-num-frames"
 	ApproxComprRatioFlagName    = "approx-compr-ratio"
 	KindFlagName                = "compressor"
+	CompressionAlgoFlagName     = "compression-algo"
+	CompressionLevelFlagName    = "compression-level"
 )
 
 func CLIFlags(envPrefix string) []cli.Flag {
@@ -40,9 +44,28 @@ func CLIFlags(envPrefix string) []cli.Flag {
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "COMPRESSOR"),
 			Value:   RatioKind,
 		},
+		&cli.StringFlag{
+			Name:    CompressionAlgoFlagName,
+			Usage:   "The algorithm to compress channels with. Algorithms other than zlib require channel compression to be active in the rollup config. Valid options: " + strings.Join(algoKeys(), ", "),
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "COMPRESSION_ALGO"),
+			Value:   derive.Zlib.String(),
+		},
+		&cli.IntFlag{
+			Name:    CompressionLevelFlagName,
+			Usage:   "The compression level of the compression algorithm: 1-9 for zlib, 1-11 for brotli, 1-22 for zstd. 0 uses the default level of the algorithm",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "COMPRESSION_LEVEL"),
+		},
 	}
 }
 
+func algoKeys() []string {
+	keys := make([]string, 0, len(derive.CompressionAlgos))
+	for _, a := range derive.CompressionAlgos {
+		keys = append(keys, a.String())
+	}
+	return keys
+}
+
 type CLIConfig struct {
 	// TargetL1TxSizeBytes to target when creating channel frames. Note that if the
 	// realized compression ratio is worse than the approximate, more frames may
@@ -57,6 +80,22 @@ type CLIConfig struct {
 	ApproxComprRatio float64
 	// Type of compressor to use. Must be one of KindKeys.
 	Kind string
+	// CompressionAlgo to compress channels with. Must be one of derive.CompressionAlgos, or unset for zlib.
+	CompressionAlgo derive.CompressionAlgo
+	// CompressionLevel of the CompressionAlgo, or 0 for its default level.
+	CompressionLevel int
+}
+
+// Check validates the compression algorithm and level. An unset algorithm defaults to zlib.
+func (c *CLIConfig) Check() error {
+	algo := c.CompressionAlgo
+	if algo == "" {
+		algo = derive.Zlib
+	}
+	if !derive.ValidCompressionAlgo(algo) {
+		return fmt.Errorf("unknown compression algorithm: %q", algo)
+	}
+	return derive.CheckCompressionLevel(algo, c.CompressionLevel)
 }
 
 func (c *CLIConfig) Config() Config {
@@ -65,6 +104,8 @@ func (c *CLIConfig) Config() Config {
 		TargetNumFrames:  c.TargetNumFrames,
 		ApproxComprRatio: c.ApproxComprRatio,
 		Kind:             c.Kind,
+		CompressionAlgo:  c.CompressionAlgo,
+		CompressionLevel: c.CompressionLevel,
 	}
 }
 
@@ -74,5 +115,7 @@ func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 		TargetL1TxSizeBytes: ctx.Uint64(TargetL1TxSizeBytesFlagName),
 		TargetNumFrames:     ctx.Int(TargetNumFramesFlagName),
 		ApproxComprRatio:    ctx.Float64(ApproxComprRatioFlagName),
+		CompressionAlgo:     derive.CompressionAlgo(ctx.String(CompressionAlgoFlagName)),
+		CompressionLevel:    ctx.Int(CompressionLevelFlagName),
 	}
 }
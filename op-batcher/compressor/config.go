@@ -19,6 +19,19 @@ type Config struct {
 	// Kind of compressor to use. Must be one of KindKeys. If unset, NewCompressor
 	// will default to RatioKind.
 	Kind string
+	// CompressionAlgo to compress the channels with. If unset, channels are compressed with zlib.
+	CompressionAlgo derive.CompressionAlgo
+	// CompressionLevel of the CompressionAlgo. If 0, the default level of the algorithm is used.
+	CompressionLevel int
+}
+
+// NewChannelCompressor creates a compressor of the configured algorithm and level.
+func (c Config) NewChannelCompressor() (derive.ChannelCompressor, error) {
+	algo := c.CompressionAlgo
+	if algo == "" {
+		algo = derive.Zlib
+	}
+	return derive.NewChannelCompressor(algo, c.CompressionLevel)
 }
 
 func (c Config) NewCompressor() (derive.Compressor, error) {
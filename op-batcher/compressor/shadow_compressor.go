@@ -1,20 +1,14 @@
 package compressor
 
 import (
-	"bytes"
-	"compress/zlib"
-
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 )
 
 type ShadowCompressor struct {
 	config Config
 
-	buf      bytes.Buffer
-	compress *zlib.Writer
-
-	shadowBuf      bytes.Buffer
-	shadowCompress *zlib.Writer
+	compress       derive.ChannelCompressor
+	shadowCompress derive.ChannelCompressor
 
 	fullErr error
 }
@@ -26,17 +20,18 @@ type ShadowCompressor struct {
 // exception to this rule: the first write to the buffer is not checked against the
 // target, which allows individual blocks larger than the target to be included (and will
 // be split across multiple channel frames).
+// Both buffers compress with the configured algorithm, so the estimate holds for each algorithm.
 func NewShadowCompressor(config Config) (derive.Compressor, error) {
 	c := &ShadowCompressor{
 		config: config,
 	}
 
 	var err error
-	c.compress, err = zlib.NewWriterLevel(&c.buf, zlib.BestCompression)
+	c.compress, err = config.NewChannelCompressor()
 	if err != nil {
 		return nil, err
 	}
-	c.shadowCompress, err = zlib.NewWriterLevel(&c.shadowBuf, zlib.BestCompression)
+	c.shadowCompress, err = config.NewChannelCompressor()
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +48,7 @@ func (t *ShadowCompressor) Write(p []byte) (int, error) {
 	if err != nil {
 		return 0, err
 	}
-	if uint64(t.shadowBuf.Len()) > t.config.TargetFrameSize*uint64(t.config.TargetNumFrames) {
+	if uint64(t.shadowCompress.Len()) > t.config.TargetFrameSize*uint64(t.config.TargetNumFrames) {
 		t.fullErr = derive.CompressorFullErr
 		if t.Len() > 0 {
 			// only return an error if we've already written data to this compressor before
@@ -69,19 +64,17 @@ func (t *ShadowCompressor) Close() error {
 }
 
 func (t *ShadowCompressor) Read(p []byte) (int, error) {
-	return t.buf.Read(p)
+	return t.compress.Read(p)
 }
 
 func (t *ShadowCompressor) Reset() {
-	t.buf.Reset()
-	t.compress.Reset(&t.buf)
-	t.shadowBuf.Reset()
-	t.shadowCompress.Reset(&t.shadowBuf)
+	t.compress.Reset()
+	t.shadowCompress.Reset()
 	t.fullErr = nil
 }
 
 func (t *ShadowCompressor) Len() int {
-	return t.buf.Len()
+	return t.compress.Len()
 }
 
 func (t *ShadowCompressor) Flush() error {
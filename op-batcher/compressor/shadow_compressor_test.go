@@ -110,3 +110,38 @@ func TestShadowCompressor(t *testing.T) {
 		})
 	}
 }
+
+func TestShadowCompressorAlgos(t *testing.T) {
+	const targetFrameSize = 1000
+	// compressible data, so that the size of the output depends on the algorithm
+	chunk := func(i int) []byte {
+		return bytes.Repeat([]byte{byte(i), byte(i >> 8), 0, 0}, 25)
+	}
+	for _, algo := range derive.CompressionAlgos {
+		algo := algo
+		t.Run(algo.String(), func(t *testing.T) {
+			t.Parallel()
+			sc, err := compressor.NewShadowCompressor(compressor.Config{
+				TargetFrameSize:  targetFrameSize,
+				TargetNumFrames:  1,
+				CompressionAlgo:  algo,
+				CompressionLevel: 0,
+			})
+			require.NoError(t, err)
+
+			var written int
+			for i := 0; ; i++ {
+				_, err := sc.Write(append(chunk(i), randomBytes(t, 8)...))
+				if err != nil {
+					require.ErrorIs(t, err, derive.CompressorFullErr)
+					break
+				}
+				written++
+			}
+			require.Greater(t, written, 1)
+			require.NoError(t, sc.Close())
+			require.LessOrEqual(t, sc.Len(), targetFrameSize)
+			t.Logf("%s wrote %d chunks into %d bytes", algo, written, sc.Len())
+		})
+	}
+}
@@ -8,10 +8,13 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
+	openum "github.com/ethereum-optimism/optimism/op-service/enum"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+	optracing "github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -35,7 +38,7 @@ var (
 	}
 	RollupRpcFlag = &cli.StringFlag{
 		Name:    "rollup-rpc",
-		Usage:   "HTTP provider URL for Rollup node",
+		Usage:   "HTTP provider URL for Rollup node. Multiple comma-separated URLs are failed over between.",
 		EnvVars: prefixEnvVars("ROLLUP_RPC"),
 	}
 	// Optional flags
@@ -71,11 +74,69 @@ var (
 		Value:   120_000,
 		EnvVars: prefixEnvVars("MAX_L1_TX_SIZE_BYTES"),
 	}
+	MaxFramesPerTxFlag = &cli.IntFlag{
+		Name: "max-frames-per-tx",
+		Usage: "The maximum number of frames, possibly of multiple channels, to pack into a single batch tx. " +
+			"Calldata frames are only packed while they fit into the max L1 tx size, and blob txs carry up to 6 blobs.",
+		Value:   1,
+		EnvVars: prefixEnvVars("MAX_FRAMES_PER_TX"),
+	}
+	BatchTypeFlag = &cli.UintFlag{
+		Name: "batch-type",
+		Usage: "The batch type to encode the blocks of a channel in. 0 for singular batches, 1 for span batches, " +
+			"which requires the span batch fork to be active.",
+		Value:   0,
+		EnvVars: prefixEnvVars("BATCH_TYPE"),
+	}
 	StoppedFlag = &cli.BoolFlag{
 		Name:    "stopped",
 		Usage:   "Initialize the batcher in a stopped state. The batcher can be started using the admin_startBatcher RPC",
 		EnvVars: prefixEnvVars("STOPPED"),
 	}
+	StateFileFlag = &cli.StringFlag{
+		Name: "state-file",
+		Usage: "File to persist the closed channels that are not fully confirmed yet in, so that they are resumed " +
+			"after a restart. If unset, the batcher starts submitting from the safe head after a restart.",
+		EnvVars: prefixEnvVars("STATE_FILE"),
+	}
+	ThrottleBaseFeeFlag = &cli.Uint64Flag{
+		Name: "throttle-basefee-wei",
+		Usage: "The L1 basefee in wei above which batch submission is throttled, by opening channels with the " +
+			"throttle max channel duration and max L1 tx size. 0 to disable.",
+		EnvVars: prefixEnvVars("THROTTLE_BASEFEE_WEI"),
+	}
+	ThrottleBlobBaseFeeFlag = &cli.Uint64Flag{
+		Name:    "throttle-blob-basefee-wei",
+		Usage:   "The L1 blob basefee in wei above which batch submission is throttled. 0 to disable.",
+		EnvVars: prefixEnvVars("THROTTLE_BLOB_BASEFEE_WEI"),
+	}
+	ThrottleMaxBacklogFlag = &cli.Uint64Flag{
+		Name:    "throttle-max-backlog",
+		Usage:   "The number of unsafe L2 blocks above which batch submission is not throttled, even during an L1 fee spike.",
+		Value:   300,
+		EnvVars: prefixEnvVars("THROTTLE_MAX_BACKLOG"),
+	}
+	ThrottleMaxChannelDurationFlag = &cli.Uint64Flag{
+		Name:    "throttle-max-channel-duration",
+		Usage:   "The maximum duration of L1-blocks to keep a channel open while throttled. 0 to keep the max channel duration.",
+		EnvVars: prefixEnvVars("THROTTLE_MAX_CHANNEL_DURATION"),
+	}
+	ThrottleMaxL1TxSizeBytesFlag = &cli.Uint64Flag{
+		Name:    "throttle-max-l1-tx-size-bytes",
+		Usage:   "The maximum size of a calldata batch tx submitted to L1 while throttled. 0 to keep the max L1 tx size.",
+		EnvVars: prefixEnvVars("THROTTLE_MAX_L1_TX_SIZE_BYTES"),
+	}
+	DataAvailabilityTypeFlag = &cli.GenericFlag{
+		Name: "data-availability-type",
+		Usage: "The data availability type to use for submitting batches to the L1. Valid options: " +
+			openum.EnumString(DataAvailabilityTypes) + ". With auto, each channel is submitted as calldata " +
+			"or blobs, whichever is cheaper at the L1 fees when the channel is opened.",
+		Value: func() *DataAvailabilityType {
+			out := CalldataType
+			return &out
+		}(),
+		EnvVars: prefixEnvVars("DATA_AVAILABILITY_TYPE"),
+	}
 	// Legacy Flags
 	SequencerHDPathFlag = txmgr.SequencerHDPathFlag
 )
@@ -92,15 +153,26 @@ var optionalFlags = []cli.Flag{
 	MaxPendingTransactionsFlag,
 	MaxChannelDurationFlag,
 	MaxL1TxSizeBytesFlag,
+	MaxFramesPerTxFlag,
+	BatchTypeFlag,
 	StoppedFlag,
+	StateFileFlag,
+	ThrottleBaseFeeFlag,
+	ThrottleBlobBaseFeeFlag,
+	ThrottleMaxBacklogFlag,
+	ThrottleMaxChannelDurationFlag,
+	ThrottleMaxL1TxSizeBytesFlag,
+	DataAvailabilityTypeFlag,
 	SequencerHDPathFlag,
 }
 
 func init() {
 	optionalFlags = append(optionalFlags, oprpc.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, optls.OptionalCLIFlags(EnvVarPrefix, RollupRpcFlag.Name)...)
 	optionalFlags = append(optionalFlags, oplog.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, oppprof.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, optracing.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, txmgr.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, compressor.CLIFlags(EnvVarPrefix)...)
 
@@ -0,0 +1,47 @@
+package flags
+
+import "fmt"
+
+// DataAvailabilityType is the way that the batcher submits the batch data to L1.
+type DataAvailabilityType string
+
+const (
+	// CalldataType submits the batch data as calldata of regular txs.
+	CalldataType DataAvailabilityType = "calldata"
+	// BlobsType submits the batch data as blobs of EIP-4844 blob txs, once blobs are enabled in the rollup config.
+	BlobsType DataAvailabilityType = "blobs"
+	// AutoType submits each channel as calldata or as blobs, whichever is cheaper at the current L1 fees.
+	AutoType DataAvailabilityType = "auto"
+)
+
+var DataAvailabilityTypes = []DataAvailabilityType{
+	CalldataType,
+	BlobsType,
+	AutoType,
+}
+
+func (kind DataAvailabilityType) String() string {
+	return string(kind)
+}
+
+func (kind *DataAvailabilityType) Set(value string) error {
+	if !ValidDataAvailabilityType(DataAvailabilityType(value)) {
+		return fmt.Errorf("unknown data-availability type: %q", value)
+	}
+	*kind = DataAvailabilityType(value)
+	return nil
+}
+
+func (kind *DataAvailabilityType) Clone() any {
+	cpy := *kind
+	return &cpy
+}
+
+func ValidDataAvailabilityType(value DataAvailabilityType) bool {
+	for _, k := range DataAvailabilityTypes {
+		if k == value {
+			return true
+		}
+	}
+	return false
+}
@@ -30,7 +30,7 @@ type Metricer interface {
 
 	opmetrics.RPCMetricer
 
-	StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer
+	StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address, thresholds opmetrics.BalanceThresholds) io.Closer
 
 	RecordLatestL1Block(l1ref eth.L1BlockRef)
 	RecordL2BlocksLoaded(l2ref eth.L2BlockRef)
@@ -194,8 +194,12 @@ func (m *Metrics) Document() []opmetrics.DocumentedMetric {
 	return m.factory.Document()
 }
 
-func (m *Metrics) StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address) io.Closer {
-	return opmetrics.LaunchBalanceMetrics(l, m.registry, m.ns, client, account)
+func (m *Metrics) StartBalanceMetrics(l log.Logger, client *ethclient.Client, account common.Address, thresholds opmetrics.BalanceThresholds) io.Closer {
+	return opmetrics.LaunchBalanceMetrics(l, m.registry, m.ns, client, opmetrics.MonitoredAccount{
+		Name:       "batcher",
+		Address:    account,
+		Thresholds: thresholds,
+	})
 }
 
 // RecordInfo sets a pseudo-metric that contains versioning and
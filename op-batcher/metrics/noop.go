@@ -42,6 +42,6 @@ func (*noopMetrics) RecordChannelTimedOut(derive.ChannelID)       {}
 func (*noopMetrics) RecordBatchTxSubmitted() {}
 func (*noopMetrics) RecordBatchTxSuccess()   {}
 func (*noopMetrics) RecordBatchTxFailed()    {}
-func (*noopMetrics) StartBalanceMetrics(log.Logger, *ethclient.Client, common.Address) io.Closer {
+func (*noopMetrics) StartBalanceMetrics(log.Logger, *ethclient.Client, common.Address, opmetrics.BalanceThresholds) io.Closer {
 	return nil
 }
@@ -13,6 +13,8 @@ import (
 type BatcherDriver interface {
 	StartBatchSubmitting() error
 	StopBatchSubmitting(ctx context.Context) error
+	PauseBatchSubmitting() error
+	ResumeBatchSubmitting() error
 }
 
 type adminAPI struct {
@@ -41,3 +43,12 @@ func (a *adminAPI) StartBatcher(_ context.Context) error {
 func (a *adminAPI) StopBatcher(ctx context.Context) error {
 	return a.b.StopBatchSubmitting(ctx)
 }
+
+// PauseBatcher pauses the submission of batch txs, without stopping the batcher, e.g. during L1 fee spikes.
+func (a *adminAPI) PauseBatcher(_ context.Context) error {
+	return a.b.PauseBatchSubmitting()
+}
+
+func (a *adminAPI) ResumeBatcher(_ context.Context) error {
+	return a.b.ResumeBatchSubmitting()
+}
@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 const AddressManagerStorageLayoutJSON = "{\"storage\":[{\"astId\":1000,\"contract\":\"src/legacy/AddressManager.sol:AddressManager\",\"label\":\"_owner\",\"offset\":0,\"slot\":\"0\",\"type\":\"t_address\"},{\"astId\":1001,\"contract\":\"src/legacy/AddressManager.sol:AddressManager\",\"label\":\"addresses\",\"offset\":0,\"slot\":\"1\",\"type\":\"t_mapping(t_bytes32,t_address)\"}],\"types\":{\"t_address\":{\"encoding\":\"inplace\",\"label\":\"address\",\"numberOfBytes\":\"20\"},\"t_bytes32\":{\"encoding\":\"inplace\",\"label\":\"bytes32\",\"numberOfBytes\":\"32\"},\"t_mapping(t_bytes32,t_address)\":{\"encoding\":\"mapping\",\"label\":\"mapping(bytes32 =\u003e address)\",\"numberOfBytes\":\"32\",\"key\":\"t_bytes32\",\"value\":\"t_address\"}}}"
@@ -22,4 +24,19 @@ func init() {
 
 	layouts["AddressManager"] = AddressManagerStorageLayout
 	deployedBytecodes["AddressManager"] = AddressManagerDeployedBin
+	initBytecodes["AddressManager"] = AddressManagerMetaData.Bin
+}
+
+func init() {
+	filterer, err := NewAddressManagerFilterer(common.Address{}, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	registerEventDecoder("AddressManager", common.HexToHash("0x9416a153a346f93d95f94b064ae3f148b6460473c6e82b3f9fc2521b873fcd6c"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseAddressSet(log)
+	})
+	registerEventDecoder("AddressManager", common.HexToHash("0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseOwnershipTransferred(log)
+	})
 }
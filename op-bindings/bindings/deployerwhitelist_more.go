@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 const DeployerWhitelistStorageLayoutJSON = "{\"storage\":[{\"astId\":1000,\"contract\":\"src/legacy/DeployerWhitelist.sol:DeployerWhitelist\",\"label\":\"owner\",\"offset\":0,\"slot\":\"0\",\"type\":\"t_address\"},{\"astId\":1001,\"contract\":\"src/legacy/DeployerWhitelist.sol:DeployerWhitelist\",\"label\":\"whitelist\",\"offset\":0,\"slot\":\"1\",\"type\":\"t_mapping(t_address,t_bool)\"}],\"types\":{\"t_address\":{\"encoding\":\"inplace\",\"label\":\"address\",\"numberOfBytes\":\"20\"},\"t_bool\":{\"encoding\":\"inplace\",\"label\":\"bool\",\"numberOfBytes\":\"1\"},\"t_mapping(t_address,t_bool)\":{\"encoding\":\"mapping\",\"label\":\"mapping(address =\u003e bool)\",\"numberOfBytes\":\"32\",\"key\":\"t_address\",\"value\":\"t_bool\"}}}"
@@ -22,4 +24,22 @@ func init() {
 
 	layouts["DeployerWhitelist"] = DeployerWhitelistStorageLayout
 	deployedBytecodes["DeployerWhitelist"] = DeployerWhitelistDeployedBin
+	initBytecodes["DeployerWhitelist"] = DeployerWhitelistMetaData.Bin
+}
+
+func init() {
+	filterer, err := NewDeployerWhitelistFilterer(common.Address{}, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	registerEventDecoder("DeployerWhitelist", common.HexToHash("0xb532073b38c83145e3e5135377a08bf9aab55bc0fd7c1179cd4fb995d2a5159c"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseOwnerChanged(log)
+	})
+	registerEventDecoder("DeployerWhitelist", common.HexToHash("0xc0e106cf568e50698fdbde1eff56f5a5c966cc7958e37e276918e9e4ccdf8cd4"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseWhitelistDisabled(log)
+	})
+	registerEventDecoder("DeployerWhitelist", common.HexToHash("0x8daaf060c3306c38e068a75c054bf96ecd85a3db1252712c4d93632744c42e0d"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseWhitelistStatusChanged(log)
+	})
 }
@@ -22,4 +22,5 @@ func init() {
 
 	layouts["ISemver"] = ISemverStorageLayout
 	deployedBytecodes["ISemver"] = ISemverDeployedBin
+	initBytecodes["ISemver"] = ISemverMetaData.Bin
 }
@@ -22,4 +22,5 @@ func init() {
 
 	layouts["L1Block"] = L1BlockStorageLayout
 	deployedBytecodes["L1Block"] = L1BlockDeployedBin
+	initBytecodes["L1Block"] = L1BlockMetaData.Bin
 }
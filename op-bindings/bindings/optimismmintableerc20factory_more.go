@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 const OptimismMintableERC20FactoryStorageLayoutJSON = "{\"storage\":[{\"astId\":1000,\"contract\":\"src/universal/OptimismMintableERC20Factory.sol:OptimismMintableERC20Factory\",\"label\":\"_initialized\",\"offset\":0,\"slot\":\"0\",\"type\":\"t_uint8\"},{\"astId\":1001,\"contract\":\"src/universal/OptimismMintableERC20Factory.sol:OptimismMintableERC20Factory\",\"label\":\"_initializing\",\"offset\":1,\"slot\":\"0\",\"type\":\"t_bool\"},{\"astId\":1002,\"contract\":\"src/universal/OptimismMintableERC20Factory.sol:OptimismMintableERC20Factory\",\"label\":\"bridge\",\"offset\":2,\"slot\":\"0\",\"type\":\"t_address\"}],\"types\":{\"t_address\":{\"encoding\":\"inplace\",\"label\":\"address\",\"numberOfBytes\":\"20\"},\"t_bool\":{\"encoding\":\"inplace\",\"label\":\"bool\",\"numberOfBytes\":\"1\"},\"t_uint8\":{\"encoding\":\"inplace\",\"label\":\"uint8\",\"numberOfBytes\":\"1\"}}}"
@@ -22,4 +24,22 @@ func init() {
 
 	layouts["OptimismMintableERC20Factory"] = OptimismMintableERC20FactoryStorageLayout
 	deployedBytecodes["OptimismMintableERC20Factory"] = OptimismMintableERC20FactoryDeployedBin
+	initBytecodes["OptimismMintableERC20Factory"] = OptimismMintableERC20FactoryMetaData.Bin
+}
+
+func init() {
+	filterer, err := NewOptimismMintableERC20FactoryFilterer(common.Address{}, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	registerEventDecoder("OptimismMintableERC20Factory", common.HexToHash("0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseInitialized(log)
+	})
+	registerEventDecoder("OptimismMintableERC20Factory", common.HexToHash("0x52fe89dd5930f343d25650b62fd367bae47088bcddffd2a88350a6ecdd620cdb"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseOptimismMintableERC20Created(log)
+	})
+	registerEventDecoder("OptimismMintableERC20Factory", common.HexToHash("0xceeb8e7d520d7f3b65fc11a262b91066940193b05d4f93df07cfdced0eb551cf"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseStandardL2TokenCreated(log)
+	})
 }
@@ -24,4 +24,5 @@ func init() {
 
 	layouts["PreimageOracle"] = PreimageOracleStorageLayout
 	deployedBytecodes["PreimageOracle"] = PreimageOracleDeployedBin
+	initBytecodes["PreimageOracle"] = PreimageOracleMetaData.Bin
 }
@@ -1,11 +1,16 @@
 package bindings
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 // layouts respresents the set of storage layouts. It is populated in an init function.
@@ -15,6 +20,31 @@ var layouts = make(map[string]*solc.StorageLayout)
 // in an init function.
 var deployedBytecodes = make(map[string]string)
 
+// initBytecodes represents the set of init bytecodes, the creation code
+// without constructor arguments. It is populated in an init function.
+var initBytecodes = make(map[string]string)
+
+// immutableReferences represents the set of immutable references, the offsets
+// of the values of immutables in the deployed bytecodes, keyed by canonical IDs
+// of the immutables. It is populated in an init function, for the contracts
+// that have immutables.
+var immutableReferences = make(map[string]solc.ImmutableReferences)
+
+// selectors represents the set of maps of the selectors of the functions and
+// errors of contracts to their signatures. It is populated in an init function,
+// if the bindings are generated with selectors.
+var selectors = make(map[string]map[string]string)
+
+// eventDecoder decodes the logs of an event of a contract into the typed event of the bindings.
+type eventDecoder struct {
+	contract string
+	decode   func(log types.Log) (interface{}, error)
+}
+
+// eventDecoders represents the set of event decoders by the topic of the
+// event, ordered by contract name. It is populated in init functions.
+var eventDecoders = make(map[common.Hash][]eventDecoder)
+
 // GetStorageLayout returns the storage layout of a contract by name.
 func GetStorageLayout(name string) (*solc.StorageLayout, error) {
 	layout := layouts[name]
@@ -38,6 +68,96 @@ func GetDeployedBytecode(name string) ([]byte, error) {
 	return common.FromHex(bc), nil
 }
 
+// GetImmutableReferences returns the immutable references of a contract by name.
+// The references are empty if the contract has no immutables.
+func GetImmutableReferences(name string) (solc.ImmutableReferences, error) {
+	if deployedBytecodes[name] == "" {
+		return nil, fmt.Errorf("%s: deployed bytecode not found", name)
+	}
+	return immutableReferences[name], nil
+}
+
+// DecodeLog decodes a log into the typed event of the bindings, for example
+// a *L2OutputOracleOutputProposed. Contracts can emit events with the same
+// signature, in which case the log is decoded as the event of the first
+// contract by name that it decodes as. Use DecodeContractLog if the contract
+// that emitted the log is known.
+func DecodeLog(log types.Log) (interface{}, error) {
+	if len(log.Topics) == 0 {
+		return nil, errors.New("anonymous events are not supported")
+	}
+	decoders := eventDecoders[log.Topics[0]]
+	if len(decoders) == 0 {
+		return nil, fmt.Errorf("%s: event not found", log.Topics[0])
+	}
+	var err error
+	for _, d := range decoders {
+		var event interface{}
+		if event, err = d.decode(log); err == nil {
+			return event, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: failed to decode event: %w", log.Topics[0], err)
+}
+
+// DecodeContractLog decodes a log of a contract by name into the typed event of the bindings.
+func DecodeContractLog(name string, log types.Log) (interface{}, error) {
+	if len(log.Topics) == 0 {
+		return nil, errors.New("anonymous events are not supported")
+	}
+	for _, d := range eventDecoders[log.Topics[0]] {
+		if d.contract == name {
+			return d.decode(log)
+		}
+	}
+	return nil, fmt.Errorf("%s: event %s not found", name, log.Topics[0])
+}
+
+// registerEventDecoder registers the decoder of an event of a contract.
+func registerEventDecoder(contract string, topic common.Hash, decode func(log types.Log) (interface{}, error)) {
+	decoders := append(eventDecoders[topic], eventDecoder{contract: contract, decode: decode})
+	sort.SliceStable(decoders, func(i, j int) bool {
+		return decoders[i].contract < decoders[j].contract
+	})
+	eventDecoders[topic] = decoders
+}
+
+// GetSelectors returns the signatures of the functions and errors of a contract by name,
+// keyed by their hex encoded selectors without 0x prefix.
+func GetSelectors(name string) (map[string]string, error) {
+	s := selectors[name]
+	if s == nil {
+		return nil, fmt.Errorf("%s: selectors not found", name)
+	}
+	return s, nil
+}
+
+// GetInitBytecode returns the init bytecode of a contract by name.
+func GetInitBytecode(name string) ([]byte, error) {
+	bc := initBytecodes[name]
+	if bc == "" {
+		return nil, fmt.Errorf("%s: init bytecode not found", name)
+	}
+
+	if !isHex(bc) {
+		return nil, fmt.Errorf("%s: invalid init bytecode", name)
+	}
+
+	return common.FromHex(bc), nil
+}
+
+// parseConstructorArgs decodes the ABI encoded constructor arguments of a contract.
+// The data is either the input of the creation transaction of the contract,
+// which starts with the init bytecode, or the encoded arguments alone.
+func parseConstructorArgs(metadata *bind.MetaData, data []byte) ([]interface{}, error) {
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(data, common.FromHex(metadata.Bin))
+	return parsed.Constructor.Inputs.Unpack(data)
+}
+
 // isHexCharacter returns bool of c being a valid hexadecimal.
 func isHexCharacter(c byte) bool {
 	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
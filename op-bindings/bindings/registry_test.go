@@ -0,0 +1,169 @@
+package bindings
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bytecode"
+)
+
+func TestParseConstructorArgs(t *testing.T) {
+	parsed, err := L2OutputOracleMetaData.GetAbi()
+	require.NoError(t, err)
+	args, err := parsed.Pack("", big.NewInt(1800), big.NewInt(2), big.NewInt(604800))
+	require.NoError(t, err)
+	expected := []interface{}{big.NewInt(1800), big.NewInt(2), big.NewInt(604800)}
+
+	t.Run("Args", func(t *testing.T) {
+		values, err := parseConstructorArgs(L2OutputOracleMetaData, args)
+		require.NoError(t, err)
+		require.Equal(t, expected, values)
+	})
+
+	t.Run("CreationInput", func(t *testing.T) {
+		input := append(common.FromHex(L2OutputOracleMetaData.Bin), args...)
+		values, err := parseConstructorArgs(L2OutputOracleMetaData, input)
+		require.NoError(t, err)
+		require.Equal(t, expected, values)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := parseConstructorArgs(L2OutputOracleMetaData, args[:40])
+		require.Error(t, err)
+	})
+
+	t.Run("Generated", func(t *testing.T) {
+		input := append(common.FromHex(L2OutputOracleMetaData.Bin), args...)
+		values, err := ParseL2OutputOracleConstructorArgs(input)
+		require.NoError(t, err)
+		require.Equal(t, expected, values)
+	})
+}
+
+func TestDecodeLog(t *testing.T) {
+	defer func(decoders map[common.Hash][]eventDecoder) {
+		eventDecoders = decoders
+	}(eventDecoders)
+	eventDecoders = make(map[common.Hash][]eventDecoder)
+
+	parsed, err := L2OutputOracleMetaData.GetAbi()
+	require.NoError(t, err)
+	filterer, err := NewL2OutputOracleFilterer(common.Address{}, nil)
+	require.NoError(t, err)
+	outputProposed := parsed.Events["OutputProposed"]
+	registerEventDecoder("L2OutputOracle", outputProposed.ID, func(log types.Log) (interface{}, error) {
+		return filterer.ParseOutputProposed(log)
+	})
+
+	data, err := outputProposed.Inputs.NonIndexed().Pack(big.NewInt(1700000000))
+	require.NoError(t, err)
+	log := types.Log{
+		Topics: []common.Hash{
+			outputProposed.ID,
+			common.HexToHash("0x01"),
+			common.BigToHash(big.NewInt(2)),
+			common.BigToHash(big.NewInt(3)),
+		},
+		Data: data,
+	}
+
+	t.Run("Decode", func(t *testing.T) {
+		event, err := DecodeLog(log)
+		require.NoError(t, err)
+		require.IsType(t, &L2OutputOracleOutputProposed{}, event)
+		proposed := event.(*L2OutputOracleOutputProposed)
+		require.Equal(t, common.HexToHash("0x01"), common.Hash(proposed.OutputRoot))
+		require.Equal(t, big.NewInt(2), proposed.L2OutputIndex)
+		require.Equal(t, big.NewInt(3), proposed.L2BlockNumber)
+		require.Equal(t, big.NewInt(1700000000), proposed.L1Timestamp)
+	})
+
+	t.Run("DecodeContract", func(t *testing.T) {
+		event, err := DecodeContractLog("L2OutputOracle", log)
+		require.NoError(t, err)
+		require.IsType(t, &L2OutputOracleOutputProposed{}, event)
+
+		_, err = DecodeContractLog("OptimismPortal", log)
+		require.Error(t, err)
+	})
+
+	t.Run("UnknownEvent", func(t *testing.T) {
+		_, err := DecodeLog(types.Log{Topics: []common.Hash{common.HexToHash("0x02")}})
+		require.Error(t, err)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := DecodeLog(types.Log{Topics: log.Topics[:2], Data: data})
+		require.Error(t, err)
+	})
+}
+
+func TestDecodeRegisteredLog(t *testing.T) {
+	parsed, err := OptimismPortalMetaData.GetAbi()
+	require.NoError(t, err)
+	deposited := parsed.Events["TransactionDeposited"]
+	opaqueData := []byte{0x01, 0x02, 0x03}
+	data, err := deposited.Inputs.NonIndexed().Pack(opaqueData)
+	require.NoError(t, err)
+	log := types.Log{
+		Topics: []common.Hash{
+			deposited.ID,
+			common.BytesToHash(common.HexToAddress("0x1111").Bytes()),
+			common.BytesToHash(common.HexToAddress("0x2222").Bytes()),
+			common.BigToHash(big.NewInt(0)),
+		},
+		Data: data,
+	}
+
+	event, err := DecodeLog(log)
+	require.NoError(t, err)
+	require.IsType(t, &OptimismPortalTransactionDeposited{}, event)
+	tx := event.(*OptimismPortalTransactionDeposited)
+	require.Equal(t, common.HexToAddress("0x1111"), tx.From)
+	require.Equal(t, common.HexToAddress("0x2222"), tx.To)
+	require.Zero(t, tx.Version.Sign())
+	require.Equal(t, opaqueData, tx.OpaqueData)
+
+	event, err = DecodeContractLog("OptimismPortal", log)
+	require.NoError(t, err)
+	require.IsType(t, &OptimismPortalTransactionDeposited{}, event)
+}
+
+func TestGetImmutableReferences(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	deployer := crypto.PubkeyToAddress(key.PublicKey)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{deployer: {Balance: big.NewInt(1e18)}}, 30_000_000)
+	defer backend.Close()
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	require.NoError(t, err)
+	addr, _, _, err := DeployL2OutputOracle(opts, backend, big.NewInt(1800), big.NewInt(2), big.NewInt(604800))
+	require.NoError(t, err)
+	backend.Commit()
+	code, err := backend.CodeAt(context.Background(), addr, nil)
+	require.NoError(t, err)
+
+	expected, err := GetDeployedBytecode("L2OutputOracle")
+	require.NoError(t, err)
+	refs, err := GetImmutableReferences("L2OutputOracle")
+	require.NoError(t, err)
+	require.NotEmpty(t, refs)
+	c := bytecode.Compare(expected, code, refs)
+	require.True(t, c.Match(), "deployed code must only differ in the immutables")
+	require.NotEmpty(t, c.Immutables)
+
+	refs, err = GetImmutableReferences("Proxy")
+	require.NoError(t, err)
+	require.Empty(t, refs, "Proxy has no immutables")
+	_, err = GetImmutableReferences("Unknown")
+	require.Error(t, err)
+}
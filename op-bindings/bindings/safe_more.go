@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 const SafeStorageLayoutJSON = "{\"storage\":[{\"astId\":1000,\"contract\":\"lib/safe-contracts/contracts/Safe.sol:Safe\",\"label\":\"singleton\",\"offset\":0,\"slot\":\"0\",\"type\":\"t_address\"},{\"astId\":1001,\"contract\":\"lib/safe-contracts/contracts/Safe.sol:Safe\",\"label\":\"modules\",\"offset\":0,\"slot\":\"1\",\"type\":\"t_mapping(t_address,t_address)\"},{\"astId\":1002,\"contract\":\"lib/safe-contracts/contracts/Safe.sol:Safe\",\"label\":\"owners\",\"offset\":0,\"slot\":\"2\",\"type\":\"t_mapping(t_address,t_address)\"},{\"astId\":1003,\"contract\":\"lib/safe-contracts/contracts/Safe.sol:Safe\",\"label\":\"ownerCount\",\"offset\":0,\"slot\":\"3\",\"type\":\"t_uint256\"},{\"astId\":1004,\"contract\":\"lib/safe-contracts/contracts/Safe.sol:Safe\",\"label\":\"threshold\",\"offset\":0,\"slot\":\"4\",\"type\":\"t_uint256\"},{\"astId\":1005,\"contract\":\"lib/safe-contracts/contracts/Safe.sol:Safe\",\"label\":\"nonce\",\"offset\":0,\"slot\":\"5\",\"type\":\"t_uint256\"},{\"astId\":1006,\"contract\":\"lib/safe-contracts/contracts/Safe.sol:Safe\",\"label\":\"_deprecatedDomainSeparator\",\"offset\":0,\"slot\":\"6\",\"type\":\"t_bytes32\"},{\"astId\":1007,\"contract\":\"lib/safe-contracts/contracts/Safe.sol:Safe\",\"label\":\"signedMessages\",\"offset\":0,\"slot\":\"7\",\"type\":\"t_mapping(t_bytes32,t_uint256)\"},{\"astId\":1008,\"contract\":\"lib/safe-contracts/contracts/Safe.sol:Safe\",\"label\":\"approvedHashes\",\"offset\":0,\"slot\":\"8\",\"type\":\"t_mapping(t_address,t_mapping(t_bytes32,t_uint256))\"}],\"types\":{\"t_address\":{\"encoding\":\"inplace\",\"label\":\"address\",\"numberOfBytes\":\"20\"},\"t_bytes32\":{\"encoding\":\"inplace\",\"label\":\"bytes32\",\"numberOfBytes\":\"32\"},\"t_mapping(t_address,t_address)\":{\"encoding\":\"mapping\",\"label\":\"mapping(address =\u003e address)\",\"numberOfBytes\":\"32\",\"key\":\"t_address\",\"value\":\"t_address\"},\"t_mapping(t_address,t_mapping(t_bytes32,t_uint256))\":{\"encoding\":\"mapping\",\"label\":\"mapping(address =\u003e mapping(bytes32 =\u003e uint256))\",\"numberOfBytes\":\"32\",\"key\":\"t_address\",\"value\":\"t_mapping(t_bytes32,t_uint256)\"},\"t_mapping(t_bytes32,t_uint256)\":{\"encoding\":\"mapping\",\"label\":\"mapping(bytes32 =\u003e uint256)\",\"numberOfBytes\":\"32\",\"key\":\"t_bytes32\",\"value\":\"t_uint256\"},\"t_uint256\":{\"encoding\":\"inplace\",\"label\":\"uint256\",\"numberOfBytes\":\"32\"}}}"
@@ -22,4 +24,58 @@ func init() {
 
 	layouts["Safe"] = SafeStorageLayout
 	deployedBytecodes["Safe"] = SafeDeployedBin
+	initBytecodes["Safe"] = SafeMetaData.Bin
+}
+
+func init() {
+	filterer, err := NewSafeFilterer(common.Address{}, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	registerEventDecoder("Safe", common.HexToHash("0x9465fa0c962cc76958e6373a993326400c1c94f8be2fe3a952adfa7f60b2ea26"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseAddedOwner(log)
+	})
+	registerEventDecoder("Safe", common.HexToHash("0xf2a0eb156472d1440255b0d7c1e19cc07115d1051fe605b0dce69acfec884d9c"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseApproveHash(log)
+	})
+	registerEventDecoder("Safe", common.HexToHash("0x5ac6c46c93c8d0e53714ba3b53db3e7c046da994313d7ed0d192028bc7c228b0"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseChangedFallbackHandler(log)
+	})
+	registerEventDecoder("Safe", common.HexToHash("0x1151116914515bc0891ff9047a6cb32cf902546f83066499bcf8ba33d2353fa2"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseChangedGuard(log)
+	})
+	registerEventDecoder("Safe", common.HexToHash("0x610f7ff2b304ae8903c3de74c60c6ab1f7d6226b3f52c5161905bb5ad4039c93"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseChangedThreshold(log)
+	})
+	registerEventDecoder("Safe", common.HexToHash("0xaab4fa2b463f581b2b32cb3b7e3b704b9ce37cc209b5fb4d77e593ace4054276"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseDisabledModule(log)
+	})
+	registerEventDecoder("Safe", common.HexToHash("0xecdf3a3effea5783a3c4c2140e677577666428d44ed9d474a0b3a4c9943f8440"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseEnabledModule(log)
+	})
+	registerEventDecoder("Safe", common.HexToHash("0x23428b18acfb3ea64b08dc0c1d296ea9c09702c09083ca5272e64d115b687d23"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseExecutionFailure(log)
+	})
+	registerEventDecoder("Safe", common.HexToHash("0xacd2c8702804128fdb0db2bb49f6d127dd0181c13fd45dbfe16de0930e2bd375"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseExecutionFromModuleFailure(log)
+	})
+	registerEventDecoder("Safe", common.HexToHash("0x6895c13664aa4f67288b25d7a21d7aaa34916e355fb9b6fae0a139a9085becb8"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseExecutionFromModuleSuccess(log)
+	})
+	registerEventDecoder("Safe", common.HexToHash("0x442e715f626346e8c54381002da614f62bee8d27386535b2521ec8540898556e"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseExecutionSuccess(log)
+	})
+	registerEventDecoder("Safe", common.HexToHash("0xf8d49fc529812e9a7c5c50e69c20f0dccc0db8fa95c98bc58cc9a4f1c1299eaf"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseRemovedOwner(log)
+	})
+	registerEventDecoder("Safe", common.HexToHash("0x3d0ce9bfc3ed7d6862dbb28b2dea94561fe714a1b4d019aa8af39730d1ad7c3d"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseSafeReceived(log)
+	})
+	registerEventDecoder("Safe", common.HexToHash("0x141df868a6331af528e38c83b7aa03edc19be66e37ae67f9285bf4f8e3c6a1a8"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseSafeSetup(log)
+	})
+	registerEventDecoder("Safe", common.HexToHash("0xe7f4675038f4f6034dfcbbb24c4dc08e4ebf10eb9d257d3d02c0f38d122ac6e4"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseSignMsg(log)
+	})
 }
@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 const SafeProxyFactoryStorageLayoutJSON = "{\"storage\":null,\"types\":{}}"
@@ -22,4 +24,16 @@ func init() {
 
 	layouts["SafeProxyFactory"] = SafeProxyFactoryStorageLayout
 	deployedBytecodes["SafeProxyFactory"] = SafeProxyFactoryDeployedBin
+	initBytecodes["SafeProxyFactory"] = SafeProxyFactoryMetaData.Bin
+}
+
+func init() {
+	filterer, err := NewSafeProxyFactoryFilterer(common.Address{}, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	registerEventDecoder("SafeProxyFactory", common.HexToHash("0x4f51faf6c4561ff95f067657e43439f0f856d97c04d9ec9070a6199ad418e235"), func(log types.Log) (interface{}, error) {
+		return filterer.ParseProxyCreation(log)
+	})
 }
@@ -22,4 +22,5 @@ func init() {
 
 	layouts["StorageSetter"] = StorageSetterStorageLayout
 	deployedBytecodes["StorageSetter"] = StorageSetterDeployedBin
+	initBytecodes["StorageSetter"] = StorageSetterMetaData.Bin
 }
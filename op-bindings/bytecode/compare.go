@@ -0,0 +1,110 @@
+package bytecode
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+// Range is a range of bytes in the bytecode, from Start until, but excluding, End.
+type Range struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// Comparison is the result of comparing the expected deployed bytecode of a contract
+// with the code of a live deployment of the contract.
+type Comparison struct {
+	// MetadataMatch is true if the metadata hash that the compiler appends to the code is the same.
+	MetadataMatch bool `json:"metadataMatch"`
+	// Immutables are the ranges of the values of immutables that differ, at the immutable references of the contract.
+	// The values of immutables are only known once the contract is deployed.
+	Immutables []Range `json:"immutables"`
+	// Diffs are the other ranges of the code, excluding the metadata, that differ.
+	Diffs []Range `json:"diffs"`
+}
+
+// Match returns true if the code only differs in the values of immutables.
+func (c *Comparison) Match() bool {
+	return c.MetadataMatch && len(c.Diffs) == 0
+}
+
+// SplitMetadata splits the CBOR encoded metadata that solc appends to the code.
+// The last two bytes of the code are the length of the metadata.
+// The metadata is empty if the code does not end with valid metadata length.
+func SplitMetadata(code []byte) (body []byte, metadata []byte) {
+	if len(code) < 2 {
+		return code, nil
+	}
+	n := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	if n+2 > len(code) {
+		return code, nil
+	}
+	split := len(code) - n - 2
+	return code[:split], code[split:]
+}
+
+// Compare compares the expected deployed bytecode of a contract with the code of a live deployment.
+// The code of the live deployment may differ from the expected bytecode in the values of the immutables,
+// at the offsets of the immutable references of the contract.
+func Compare(expected []byte, actual []byte, refs solc.ImmutableReferences) *Comparison {
+	expectedBody, expectedMetadata := SplitMetadata(expected)
+	actualBody, actualMetadata := SplitMetadata(actual)
+	c := &Comparison{
+		MetadataMatch: bytes.Equal(expectedMetadata, actualMetadata),
+		Immutables:    []Range{},
+		Diffs:         []Range{},
+	}
+
+	n := min(len(expectedBody), len(actualBody))
+	immutables := immutableRanges(refs, n)
+	// immutableAt maps each offset of the code to the index+1 of the immutable range it is in, if any
+	immutableAt := make([]int, n)
+	for i, r := range immutables {
+		for p := r.Start; p < r.End; p++ {
+			immutableAt[p] = i + 1
+		}
+	}
+	for i := 0; i < n; {
+		if expectedBody[i] == actualBody[i] {
+			i++
+			continue
+		}
+		if k := immutableAt[i]; k > 0 {
+			// a value with zero bytes differs in multiple ranges of the same immutable
+			if r := immutables[k-1]; len(c.Immutables) == 0 || c.Immutables[len(c.Immutables)-1] != r {
+				c.Immutables = append(c.Immutables, r)
+			}
+			i++
+			continue
+		}
+		start := i
+		for i < n && expectedBody[i] != actualBody[i] && immutableAt[i] == 0 {
+			i++
+		}
+		c.Diffs = append(c.Diffs, Range{Start: start, End: i})
+	}
+	if len(expectedBody) != len(actualBody) {
+		c.Diffs = append(c.Diffs, Range{Start: n, End: max(len(expectedBody), len(actualBody))})
+	}
+	return c
+}
+
+// immutableRanges returns the ranges of the values of the immutables within the first n bytes of the code,
+// ordered by their offsets.
+func immutableRanges(refs solc.ImmutableReferences, n int) []Range {
+	var ranges []Range
+	for _, offsets := range refs {
+		for _, offset := range offsets {
+			r := Range{Start: int(offset.Start), End: int(offset.Start + offset.Length)}
+			if r.End <= n {
+				ranges = append(ranges, r)
+			}
+		}
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].Start < ranges[j].Start
+	})
+	return ranges
+}
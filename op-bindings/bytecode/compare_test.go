@@ -0,0 +1,83 @@
+package bytecode
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+// code returns bytecode that pushes an immutable placeholder, followed by the metadata.
+func code(immutable []byte, metadata []byte) []byte {
+	out := []byte{0x60, 0x80, 0x60, 0x40, 0x52, 0x7f}
+	out = append(out, immutable...)
+	out = append(out, 0x50, 0x00)
+	out = append(out, metadata...)
+	return append(out, 0x00, byte(len(metadata)))
+}
+
+func TestSplitMetadata(t *testing.T) {
+	metadata := []byte{0xa2, 0x64, 0x69, 0x70, 0x66, 0x73}
+	c := code(make([]byte, 32), metadata)
+	body, meta := SplitMetadata(c)
+	require.Equal(t, c[:len(c)-len(metadata)-2], body)
+	require.Equal(t, append(metadata, 0x00, byte(len(metadata))), meta)
+
+	// code without valid metadata length is not split
+	body, meta = SplitMetadata([]byte{0x60, 0xff, 0xff})
+	require.Equal(t, []byte{0x60, 0xff, 0xff}, body)
+	require.Nil(t, meta)
+}
+
+func TestCompare(t *testing.T) {
+	metadata := []byte{0xa2, 0x64, 0x69, 0x70, 0x66, 0x73}
+	placeholder := make([]byte, 32)
+	value := bytes.Repeat([]byte{0xab}, 32)
+	value[10] = 0 // the value shares a byte with the placeholder
+	refs := solc.ImmutableReferences{"1000": {{Start: 6, Length: 32}}}
+
+	t.Run("Equal", func(t *testing.T) {
+		c := Compare(code(placeholder, metadata), code(placeholder, metadata), refs)
+		require.True(t, c.Match())
+		require.Empty(t, c.Immutables)
+	})
+	t.Run("Immutable", func(t *testing.T) {
+		c := Compare(code(placeholder, metadata), code(value, metadata), refs)
+		require.True(t, c.Match())
+		require.Equal(t, []Range{{Start: 6, End: 38}}, c.Immutables)
+		require.Empty(t, c.Diffs)
+	})
+	t.Run("NoReference", func(t *testing.T) {
+		// the values of immutables are only ignored at the immutable references
+		c := Compare(code(placeholder, metadata), code(value, metadata), nil)
+		require.False(t, c.Match())
+		require.Empty(t, c.Immutables)
+		require.Equal(t, []Range{{Start: 6, End: 16}, {Start: 17, End: 38}}, c.Diffs)
+	})
+	t.Run("Metadata", func(t *testing.T) {
+		c := Compare(code(placeholder, metadata), code(value, []byte{0xa2, 0x64, 0x69, 0x70, 0x66, 0x74}), refs)
+		require.False(t, c.Match())
+		require.False(t, c.MetadataMatch)
+		require.Len(t, c.Immutables, 1)
+		require.Empty(t, c.Diffs)
+	})
+	t.Run("Code", func(t *testing.T) {
+		actual := code(placeholder, metadata)
+		actual[1] = 0x81
+		c := Compare(code(placeholder, metadata), actual, refs)
+		require.False(t, c.Match())
+		require.True(t, c.MetadataMatch)
+		require.Equal(t, []Range{{Start: 1, End: 2}}, c.Diffs)
+	})
+	t.Run("Length", func(t *testing.T) {
+		expected := code(placeholder, metadata)
+		actual := append([]byte{}, expected[:len(expected)-len(metadata)-2]...)
+		actual = append(actual, 0x00)
+		actual = append(actual, expected[len(expected)-len(metadata)-2:]...)
+		c := Compare(expected, actual, refs)
+		require.False(t, c.Match())
+		require.Equal(t, []Range{{Start: 40, End: 41}}, c.Diffs)
+	})
+}
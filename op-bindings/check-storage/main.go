@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"path"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/ast"
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/foundry"
+	"github.com/ethereum-optimism/optimism/op-bindings/storage"
+)
+
+type flags struct {
+	ForgeArtifacts string
+	Contracts      string
+	MonorepoBase   string
+}
+
+// check-storage compares the storage layouts of newly built forge artifacts with the layouts in the bindings,
+// and fails if the storage layout of any contract changed in a way that is not upgrade safe.
+func main() {
+	var f flags
+	flag.StringVar(&f.ForgeArtifacts, "forge-artifacts", "", "Forge artifacts directory, to load the storage layouts from")
+	flag.StringVar(&f.Contracts, "contracts", "artifacts.json", "Path to file containing list of contracts to check the storage layouts of")
+	flag.StringVar(&f.MonorepoBase, "monorepo-base", "", "Base of the monorepo")
+	flag.Parse()
+
+	if f.ForgeArtifacts == "" {
+		log.Fatal("must provide -forge-artifacts")
+	}
+	if f.MonorepoBase == "" {
+		log.Fatal("must provide -monorepo-base")
+	}
+
+	contractData, err := os.ReadFile(f.Contracts)
+	if err != nil {
+		log.Fatalf("error reading contract list: %v\n", err)
+	}
+	contracts := []string{}
+	if err := json.Unmarshal(contractData, &contracts); err != nil {
+		log.Fatalf("error parsing contract list: %v\n", err)
+	}
+
+	incompatible := 0
+	for _, name := range contracts {
+		previous, err := bindings.GetStorageLayout(name)
+		if err != nil {
+			log.Printf("skipping %s, it has no bindings yet\n", name)
+			continue
+		}
+
+		artifactPath := path.Join(f.ForgeArtifacts, name+".sol", name+".json")
+		artifactData, err := os.ReadFile(artifactPath)
+		if err != nil {
+			log.Fatalf("error reading forge-artifact of %s: %v\n", name, err)
+		}
+		var artifact foundry.Artifact
+		if err := json.Unmarshal(artifactData, &artifact); err != nil {
+			log.Fatalf("failed to parse forge artifact of %s: %v\n", name, err)
+		}
+		next := ast.CanonicalizeASTIDs(&artifact.StorageLayout, f.MonorepoBase)
+
+		for _, i := range storage.Compare(previous, next) {
+			log.Printf("%s: %s\n", name, i)
+			incompatible++
+		}
+	}
+	if incompatible > 0 {
+		log.Fatalf("found %d storage layout changes that are not upgrade safe\n", incompatible)
+	}
+	log.Printf("checked the storage layouts of %d contracts\n", len(contracts))
+}
@@ -12,17 +12,20 @@ import (
 // round trip marshaling/unmarshaling of the abi.ABI type
 // causes issues.
 type Artifact struct {
-	Abi              json.RawMessage    `json:"abi"`
-	StorageLayout    solc.StorageLayout `json:"storageLayout"`
-	DeployedBytecode DeployedBytecode   `json:"deployedBytecode"`
-	Bytecode         Bytecode           `json:"bytecode"`
+	Abi               json.RawMessage    `json:"abi"`
+	StorageLayout     solc.StorageLayout `json:"storageLayout"`
+	DeployedBytecode  DeployedBytecode   `json:"deployedBytecode"`
+	Bytecode          Bytecode           `json:"bytecode"`
+	MethodIdentifiers map[string]string  `json:"methodIdentifiers"`
+	DevDoc            DevDoc             `json:"devdoc"`
+	UserDoc           UserDoc            `json:"userdoc"`
 }
 
 type DeployedBytecode struct {
-	SourceMap           string          `json:"sourceMap"`
-	Object              hexutil.Bytes   `json:"object"`
-	LinkReferences      json.RawMessage `json:"linkReferences"`
-	ImmutableReferences json.RawMessage `json:"immutableReferences"`
+	SourceMap           string                   `json:"sourceMap"`
+	Object              hexutil.Bytes            `json:"object"`
+	LinkReferences      json.RawMessage          `json:"linkReferences"`
+	ImmutableReferences solc.ImmutableReferences `json:"immutableReferences"`
 }
 
 type Bytecode struct {
@@ -30,3 +33,37 @@ type Bytecode struct {
 	Object         hexutil.Bytes   `json:"object"`
 	LinkReferences json.RawMessage `json:"linkReferences"`
 }
+
+// DevDoc is the developer documentation of a contract, from its NatSpec comments.
+// The methods and events are keyed by signature, the errors by signature as well,
+// with an entry for every definition of the error.
+type DevDoc struct {
+	Kind    string                   `json:"kind"`
+	Version int                      `json:"version"`
+	Title   string                   `json:"title,omitempty"`
+	Author  string                   `json:"author,omitempty"`
+	Details string                   `json:"details,omitempty"`
+	Methods map[string]DevDocEntry   `json:"methods,omitempty"`
+	Events  map[string]DevDocEntry   `json:"events,omitempty"`
+	Errors  map[string][]DevDocEntry `json:"errors,omitempty"`
+}
+
+type DevDocEntry struct {
+	Details string            `json:"details,omitempty"`
+	Params  map[string]string `json:"params,omitempty"`
+	Returns map[string]string `json:"returns,omitempty"`
+}
+
+// UserDoc is the user documentation of a contract, from its NatSpec comments.
+type UserDoc struct {
+	Kind    string                    `json:"kind"`
+	Version int                       `json:"version"`
+	Notice  string                    `json:"notice,omitempty"`
+	Methods map[string]UserDocEntry   `json:"methods,omitempty"`
+	Events  map[string]UserDocEntry   `json:"events,omitempty"`
+	Errors  map[string][]UserDocEntry `json:"errors,omitempty"`
+}
+
+type UserDocEntry struct {
+	Notice string `json:"notice,omitempty"`
+}
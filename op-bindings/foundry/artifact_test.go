@@ -0,0 +1,47 @@
+package foundry
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testArtifact = `{
+	"abi": [],
+	"bytecode": {"object": "0x00", "sourceMap": "", "linkReferences": {}},
+	"deployedBytecode": {"object": "0x00", "sourceMap": "", "linkReferences": {}, "immutableReferences": {}},
+	"methodIdentifiers": {"transfer(address,uint256)": "a9059cbb"},
+	"devdoc": {
+		"kind": "dev",
+		"version": 1,
+		"title": "Token",
+		"methods": {"transfer(address,uint256)": {"details": "Moves tokens.", "params": {"to": "The recipient."}, "returns": {"_0": "Whether it succeeded."}}},
+		"errors": {"InsufficientBalance()": [{"details": "The balance is too low."}]}
+	},
+	"userdoc": {
+		"kind": "user",
+		"version": 1,
+		"notice": "A token.",
+		"methods": {"transfer(address,uint256)": {"notice": "Transfers tokens."}},
+		"events": {"Transfer(address,address,uint256)": {"notice": "Tokens were transferred."}}
+	}
+}`
+
+func TestArtifactDocs(t *testing.T) {
+	var artifact Artifact
+	require.NoError(t, json.Unmarshal([]byte(testArtifact), &artifact))
+
+	require.Equal(t, map[string]string{"transfer(address,uint256)": "a9059cbb"}, artifact.MethodIdentifiers)
+
+	require.Equal(t, "Token", artifact.DevDoc.Title)
+	transfer := artifact.DevDoc.Methods["transfer(address,uint256)"]
+	require.Equal(t, "Moves tokens.", transfer.Details)
+	require.Equal(t, map[string]string{"to": "The recipient."}, transfer.Params)
+	require.Equal(t, map[string]string{"_0": "Whether it succeeded."}, transfer.Returns)
+	require.Equal(t, []DevDocEntry{{Details: "The balance is too low."}}, artifact.DevDoc.Errors["InsufficientBalance()"])
+
+	require.Equal(t, "A token.", artifact.UserDoc.Notice)
+	require.Equal(t, "Transfers tokens.", artifact.UserDoc.Methods["transfer(address,uint256)"].Notice)
+	require.Equal(t, "Tokens were transferred.", artifact.UserDoc.Events["Transfer(address,address,uint256)"].Notice)
+}
@@ -1,47 +1,101 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"flag"
+	"fmt"
 	"log"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
 	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"golang.org/x/sync/errgroup"
+
 	"github.com/ethereum-optimism/optimism/op-bindings/ast"
 	"github.com/ethereum-optimism/optimism/op-bindings/foundry"
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum-optimism/optimism/op-bindings/vyper"
 )
 
 type flags struct {
 	ForgeArtifacts string
+	VyperArtifacts string
 	Contracts      string
 	SourceMaps     string
+	NoBytecode     string
 	OutDir         string
 	Package        string
 	MonorepoBase   string
+	Jobs           int
+	KeepGoing      bool
+	Report         string
+	Manifest       string
+	Force          bool
+	Selectors      bool
+}
+
+// contractResult is the outcome of generating the bindings of a contract, as written to the report.
+type contractResult struct {
+	Name  string `json:"name"`
+	Error string `json:"error,omitempty"`
 }
 
 type data struct {
-	Name              string
-	StorageLayout     string
-	DeployedBin       string
-	Package           string
-	DeployedSourceMap string
+	Name                string
+	StorageLayout       string
+	DeployedBin         string
+	Package             string
+	DeployedSourceMap   string
+	ImmutableReferences string
+	HasConstructorArgs  bool
+	NoBytecode          bool
+	Events              []eventData
+	Selectors           []selectorData
+}
+
+// selectorData is a function or error of a contract, that calls and reverts are decoded as.
+type selectorData struct {
+	// Selector is the hex encoded selector, without 0x prefix like the method identifiers of solc.
+	Selector  string
+	Signature string
+}
+
+// eventData is an event of a contract, that the logs are decoded as.
+type eventData struct {
+	// Topic is the hash of the signature of the event, the first topic of its logs.
+	Topic string
+	// GoName is the name of the event in the abigen bindings.
+	GoName string
 }
 
 func main() {
 	var f flags
 	flag.StringVar(&f.ForgeArtifacts, "forge-artifacts", "", "Forge artifacts directory, to load sourcemaps from, if available")
+	flag.StringVar(&f.VyperArtifacts, "vyper-artifacts", "", "Vyper artifacts directory, of <Name>.json files, for the contracts that are not in the forge artifacts")
 	flag.StringVar(&f.OutDir, "out", "", "Output directory to put code in")
 	flag.StringVar(&f.Contracts, "contracts", "artifacts.json", "Path to file containing list of contracts to generate bindings for")
 	flag.StringVar(&f.SourceMaps, "source-maps", "", "Comma-separated list of contracts to generate source-maps for")
+	flag.StringVar(&f.NoBytecode, "no-bytecode", "", "Comma-separated list of contracts to generate ABI-only bindings for, without their bytecode, or * for all contracts")
 	flag.StringVar(&f.Package, "package", "artifacts", "Go package name")
 	flag.StringVar(&f.MonorepoBase, "monorepo-base", "", "Base of the monorepo")
+	flag.IntVar(&f.Jobs, "jobs", runtime.NumCPU(), "Number of contracts to generate bindings for concurrently")
+	flag.BoolVar(&f.KeepGoing, "keep-going", false, "Generate the bindings of all contracts, even if some of them fail")
+	flag.StringVar(&f.Report, "report", "", "Path to write a JSON report of the contracts that were generated, and why the others failed")
+	flag.StringVar(&f.Manifest, "manifest", "", "Path to the manifest of the hashes of the inputs of the bindings, to only generate the bindings of contracts that changed")
+	flag.BoolVar(&f.Force, "force", false, "Generate the bindings of all contracts, even if they did not change since the manifest was written")
+	flag.BoolVar(&f.Selectors, "selectors", false, "Generate maps of the selectors of the functions and errors of the contracts to their signatures")
 	flag.Parse()
 
 	if f.MonorepoBase == "" {
@@ -64,9 +118,17 @@ func main() {
 		sourceMapsSet[k] = struct{}{}
 	}
 
+	noBytecodeSet := make(map[string]struct{})
+	for _, k := range strings.Split(f.NoBytecode, ",") {
+		noBytecodeSet[k] = struct{}{}
+	}
+
 	if len(contracts) == 0 {
 		log.Fatalf("must define a list of contracts")
 	}
+	if f.Jobs < 1 {
+		log.Fatalf("must use at least one job, got %d", f.Jobs)
+	}
 
 	t := template.Must(template.New("artifact").Parse(tmpl))
 
@@ -108,95 +170,334 @@ func main() {
 		log.Fatal(err)
 	}
 
-	for _, name := range contracts {
-		log.Printf("generating code for %s\n", name)
+	cwd, err := os.Getwd()
+	if err != nil {
+		log.Fatalf("error getting cwd: %v\n", err)
+	}
 
-		artifactPath := path.Join(f.ForgeArtifacts, name+".sol", name+".json")
-		forgeArtifactData, err := os.ReadFile(artifactPath)
-		if errors.Is(err, os.ErrNotExist) {
-			log.Printf("cannot find forge-artifact for %s at standard path %s, trying %s\n", name, artifactPath, artifactPaths[name])
-			artifactPath = artifactPaths[name]
-			forgeArtifactData, err = os.ReadFile(artifactPath)
-			if errors.Is(err, os.ErrNotExist) {
-				log.Fatalf("cannot find forge-artifact of %q\n", name)
-			}
+	m := &manifest{hashes: make(map[string]string)}
+	if f.Manifest != "" {
+		m, err = readManifest(f.Manifest)
+		if err != nil {
+			log.Fatalf("error reading manifest: %v\n", err)
 		}
+	}
 
-		log.Printf("using forge-artifact %s\n", artifactPath)
-		var artifact foundry.Artifact
-		if err := json.Unmarshal(forgeArtifactData, &artifact); err != nil {
-			log.Fatalf("failed to parse forge artifact of %q: %v\n", name, err)
-		}
+	g := &generator{
+		flags:         f,
+		tmpl:          t,
+		tempDir:       dir,
+		cwd:           cwd,
+		artifactPaths: artifactPaths,
+		sourceMaps:    sourceMapsSet,
+		noBytecode:    noBytecodeSet,
+		manifest:      m,
+	}
 
-		rawAbi := artifact.Abi
-		if err != nil {
-			log.Fatalf("error marshaling abi: %v\n", err)
+	results, err := g.generateAll(contracts)
+
+	if f.Manifest != "" {
+		if err := m.write(f.Manifest, contracts); err != nil {
+			log.Fatalf("error writing manifest: %v\n", err)
 		}
-		abiFile := path.Join(dir, name+".abi")
-		if err := os.WriteFile(abiFile, rawAbi, 0o600); err != nil {
-			log.Fatalf("error writing file: %v\n", err)
+	}
+	if f.Report != "" {
+		if err := writeReport(f.Report, results); err != nil {
+			log.Fatalf("error writing report: %v\n", err)
 		}
-		rawBytecode := artifact.Bytecode.Object.String()
-		if err != nil {
-			log.Fatalf("error marshaling bytecode: %v\n", err)
+		log.Printf("wrote report %s\n", f.Report)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	failed := 0
+	for _, r := range results {
+		if r.Error != "" {
+			log.Printf("failed to generate %s: %s\n", r.Name, r.Error)
+			failed++
 		}
-		bytecodeFile := path.Join(dir, name+".bin")
+	}
+	if failed > 0 {
+		log.Fatalf("failed to generate %d of %d contracts\n", failed, len(contracts))
+	}
+}
+
+// generateAll generates the bindings of the contracts, and returns the results in the order of the contracts.
+// The contracts are generated concurrently, but every contract is written to its own files,
+// so the output does not depend on the order in which the contracts are processed.
+// Unless the generator keeps going, the first error is returned, and contracts that were not started yet are skipped.
+func (g *generator) generateAll(contracts []string) ([]contractResult, error) {
+	results := make([]contractResult, len(contracts))
+	group, ctx := errgroup.WithContext(context.Background())
+	group.SetLimit(g.flags.Jobs)
+	for i, name := range contracts {
+		i, name := i, name
+		group.Go(func() error {
+			results[i].Name = name
+			if ctx.Err() != nil {
+				results[i].Error = "skipped after an earlier failure"
+				return nil
+			}
+			err := g.generate(name)
+			if err != nil {
+				results[i].Error = err.Error()
+			}
+			if g.flags.KeepGoing {
+				return nil
+			}
+			return err
+		})
+	}
+	return results, group.Wait()
+}
+
+// writeReport writes the results of all contracts, in the order of the contract list.
+func writeReport(path string, results []contractResult) error {
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+type generator struct {
+	flags         flags
+	tmpl          *template.Template
+	tempDir       string
+	cwd           string
+	artifactPaths map[string]string
+	sourceMaps    map[string]struct{}
+	noBytecode    map[string]struct{}
+	manifest      *manifest
+}
+
+// generate writes the abigen bindings and the additional metadata of the contract.
+func (g *generator) generate(name string) error {
+	artifact, artifactData, err := g.readArtifact(name)
+	if err != nil {
+		g.manifest.remove(name)
+		return err
+	}
+
+	_, withSourceMap := g.sourceMaps[name]
+	noBytecode := g.withoutBytecode(name)
+	hash := g.inputHash(name, artifactData)
+	if !g.flags.Force && g.manifest.unchanged(name, hash) && g.outputsExist(name) {
+		log.Printf("skipping %s, it did not change\n", name)
+		return nil
+	}
+	// the contract is only added back to the manifest once its bindings are generated
+	g.manifest.remove(name)
+
+	log.Printf("generating code for %s\n", name)
+
+	rawAbi := artifact.Abi
+	parsedAbi, err := abi.JSON(bytes.NewReader(rawAbi))
+	if err != nil {
+		return fmt.Errorf("failed to parse abi of %q: %w", name, err)
+	}
+	abiFile := path.Join(g.tempDir, name+".abi")
+	if err := os.WriteFile(abiFile, rawAbi, 0o600); err != nil {
+		return fmt.Errorf("error writing file: %w", err)
+	}
+	lowerName := strings.ToLower(name)
+	outFile := path.Join(g.cwd, g.flags.Package, lowerName+".go")
+	args := []string{"--abi", abiFile, "--pkg", g.flags.Package, "--type", name, "--out", outFile}
+
+	// without the bytecode, abigen only generates the bindings to call the contract and filter its events
+	if !noBytecode {
+		rawBytecode := artifact.Bytecode.Object.String()
+		bytecodeFile := path.Join(g.tempDir, name+".bin")
 		if err := os.WriteFile(bytecodeFile, []byte(rawBytecode), 0o600); err != nil {
-			log.Fatalf("error writing file: %v\n", err)
+			return fmt.Errorf("error writing file: %w", err)
 		}
+		args = append(args, "--bin", bytecodeFile)
+	}
 
-		cwd, err := os.Getwd()
-		if err != nil {
-			log.Fatalf("error getting cwd: %v\n", err)
-		}
+	cmd := exec.Command("abigen", args...)
+	cmd.Stdout = os.Stdout
 
-		lowerName := strings.ToLower(name)
-		outFile := path.Join(cwd, f.Package, lowerName+".go")
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error running abigen for %s: %w", name, err)
+	}
 
-		cmd := exec.Command("abigen", "--abi", abiFile, "--bin", bytecodeFile, "--pkg", f.Package, "--type", name, "--out", outFile)
-		cmd.Stdout = os.Stdout
+	storage := artifact.StorageLayout
+	canonicalStorage := ast.CanonicalizeASTIDs(&storage, g.flags.MonorepoBase)
+	ser, err := json.Marshal(canonicalStorage)
+	if err != nil {
+		return fmt.Errorf("error marshaling storage: %w", err)
+	}
+	serStr := strings.Replace(string(ser), "\"", "\\\"", -1)
 
-		if err := cmd.Run(); err != nil {
-			log.Fatalf("error running abigen: %v\n", err)
-		}
+	deployedSourceMap := ""
+	if withSourceMap && !noBytecode {
+		deployedSourceMap = artifact.DeployedBytecode.SourceMap
+	}
 
-		storage := artifact.StorageLayout
-		canonicalStorage := ast.CanonicalizeASTIDs(&storage, f.MonorepoBase)
-		ser, err := json.Marshal(canonicalStorage)
+	immutableRefs := ""
+	if refs := artifact.DeployedBytecode.ImmutableReferences; len(refs) > 0 && !noBytecode {
+		ser, err := json.Marshal(canonicalizeImmutableReferences(refs))
 		if err != nil {
-			log.Fatalf("error marshaling storage: %v\n", err)
+			return fmt.Errorf("error marshaling immutable references: %w", err)
 		}
-		serStr := strings.Replace(string(ser), "\"", "\\\"", -1)
+		immutableRefs = strings.Replace(string(ser), "\"", "\\\"", -1)
+	}
 
-		deployedSourceMap := ""
-		if _, ok := sourceMapsSet[name]; ok {
-			deployedSourceMap = artifact.DeployedBytecode.SourceMap
+	// abigen does not generate the bindings of anonymous events
+	var events []eventData
+	for _, event := range parsedAbi.Events {
+		if event.Anonymous {
+			continue
 		}
+		events = append(events, eventData{Topic: event.ID.Hex(), GoName: abi.ToCamelCase(event.Name)})
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].GoName < events[j].GoName
+	})
+
+	var selectors []selectorData
+	if g.flags.Selectors {
+		for signature, selector := range artifact.MethodIdentifiers {
+			selectors = append(selectors, selectorData{Selector: selector, Signature: signature})
+		}
+		// the method identifiers only include the functions, reverts are decoded with the errors of the abi
+		for _, e := range parsedAbi.Errors {
+			selectors = append(selectors, selectorData{Selector: hex.EncodeToString(e.ID[:4]), Signature: e.Sig})
+		}
+		sort.Slice(selectors, func(i, j int) bool {
+			return selectors[i].Selector < selectors[j].Selector
+		})
+	}
 
-		d := data{
-			Name:              name,
-			StorageLayout:     serStr,
-			DeployedBin:       artifact.DeployedBytecode.Object.String(),
-			Package:           f.Package,
-			DeployedSourceMap: deployedSourceMap,
+	d := data{
+		Name:                name,
+		StorageLayout:       serStr,
+		DeployedBin:         artifact.DeployedBytecode.Object.String(),
+		Package:             g.flags.Package,
+		DeployedSourceMap:   deployedSourceMap,
+		ImmutableReferences: immutableRefs,
+		HasConstructorArgs:  len(parsedAbi.Constructor.Inputs) > 0,
+		NoBytecode:          noBytecode,
+		Events:              events,
+		Selectors:           selectors,
+	}
+
+	fname := filepath.Join(g.flags.OutDir, strings.ToLower(name)+"_more.go")
+	outfile, err := os.OpenFile(
+		fname,
+		os.O_RDWR|os.O_CREATE|os.O_TRUNC,
+		0o600,
+	)
+	if err != nil {
+		return fmt.Errorf("error opening %s: %w", fname, err)
+	}
+	defer outfile.Close()
+
+	if err := g.tmpl.Execute(outfile, d); err != nil {
+		return fmt.Errorf("error writing template %s: %w", outfile.Name(), err)
+	}
+	log.Printf("wrote file %s\n", outfile.Name())
+	g.manifest.set(name, hash)
+	return nil
+}
+
+// canonicalizeImmutableReferences replaces the AST IDs of the immutables with IDs in the order of their
+// first reference in the deployed bytecode, like ast.CanonicalizeASTIDs does for the storage layouts,
+// so that the bindings do not change when the AST IDs do.
+func canonicalizeImmutableReferences(refs solc.ImmutableReferences) solc.ImmutableReferences {
+	ids := make([]string, 0, len(refs))
+	for id := range refs {
+		ids = append(ids, id)
+	}
+	first := func(id string) uint {
+		start := ^uint(0)
+		for _, offset := range refs[id] {
+			start = min(start, offset.Start)
 		}
+		return start
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return first(ids[i]) < first(ids[j])
+	})
+	out := make(solc.ImmutableReferences, len(refs))
+	for i, id := range ids {
+		offsets := append([]solc.ImmutableReferenceOffset{}, refs[id]...)
+		sort.Slice(offsets, func(i, j int) bool {
+			return offsets[i].Start < offsets[j].Start
+		})
+		out[strconv.Itoa(1000+i)] = offsets
+	}
+	return out
+}
 
-		fname := filepath.Join(f.OutDir, strings.ToLower(name)+"_more.go")
-		outfile, err := os.OpenFile(
-			fname,
-			os.O_RDWR|os.O_CREATE|os.O_TRUNC,
-			0o600,
-		)
-		if err != nil {
-			log.Fatalf("error opening %s: %v\n", fname, err)
+// inputHash returns the hash of the inputs that the bindings of the contract are generated from:
+// the artifact, and the template and flags that the bindings are generated with.
+func (g *generator) inputHash(name string, artifactData []byte) string {
+	_, withSourceMap := g.sourceMaps[name]
+	return inputHash(string(artifactData), tmpl, g.flags.Package, g.flags.MonorepoBase, fmt.Sprint(withSourceMap), fmt.Sprint(g.withoutBytecode(name)), fmt.Sprint(g.flags.Selectors))
+}
+
+// withoutBytecode returns true if the bindings of the contract are generated without its bytecode.
+func (g *generator) withoutBytecode(name string) bool {
+	_, all := g.noBytecode["*"]
+	_, ok := g.noBytecode[name]
+	return all || ok
+}
+
+// outputsExist returns true if the files that are generated for the contract exist.
+func (g *generator) outputsExist(name string) bool {
+	for _, p := range []string{
+		path.Join(g.cwd, g.flags.Package, strings.ToLower(name)+".go"),
+		filepath.Join(g.flags.OutDir, strings.ToLower(name)+"_more.go"),
+	} {
+		if _, err := os.Stat(p); err != nil {
+			return false
 		}
+	}
+	return true
+}
 
-		if err := t.Execute(outfile, d); err != nil {
-			log.Fatalf("error writing template %s: %v", outfile.Name(), err)
+// readArtifact reads the forge artifact of the contract, and returns it along with the raw data it was read from.
+// Contracts that are not in the forge artifacts are read from the vyper artifacts, if there are any,
+// and converted into forge artifacts.
+func (g *generator) readArtifact(name string) (*foundry.Artifact, []byte, error) {
+	artifactPath := path.Join(g.flags.ForgeArtifacts, name+".sol", name+".json")
+	forgeArtifactData, err := os.ReadFile(artifactPath)
+	if errors.Is(err, os.ErrNotExist) {
+		log.Printf("cannot find forge-artifact for %s at standard path %s, trying %s\n", name, artifactPath, g.artifactPaths[name])
+		artifactPath = g.artifactPaths[name]
+		forgeArtifactData, err = os.ReadFile(artifactPath)
+		if errors.Is(err, os.ErrNotExist) {
+			if g.flags.VyperArtifacts != "" {
+				return g.readVyperArtifact(name)
+			}
+			return nil, nil, fmt.Errorf("cannot find forge-artifact of %q", name)
 		}
-		outfile.Close()
-		log.Printf("wrote file %s\n", outfile.Name())
 	}
+
+	log.Printf("using forge-artifact %s\n", artifactPath)
+	var artifact foundry.Artifact
+	if err := json.Unmarshal(forgeArtifactData, &artifact); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse forge artifact of %q: %w", name, err)
+	}
+	return &artifact, forgeArtifactData, nil
+}
+
+func (g *generator) readVyperArtifact(name string) (*foundry.Artifact, []byte, error) {
+	artifactPath := path.Join(g.flags.VyperArtifacts, name+".json")
+	vyperArtifactData, err := os.ReadFile(artifactPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, fmt.Errorf("cannot find forge-artifact or vyper artifact of %q", name)
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("error reading vyper artifact of %q: %w", name, err)
+	}
+
+	log.Printf("using vyper artifact %s\n", artifactPath)
+	var artifact vyper.Artifact
+	if err := json.Unmarshal(vyperArtifactData, &artifact); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse vyper artifact of %q: %w", name, err)
+	}
+	return artifact.ToFoundry(name), vyperArtifactData, nil
 }
 
 var tmpl = `// Code generated - DO NOT EDIT.
@@ -208,22 +509,68 @@ import (
 	"encoding/json"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+{{- if .Events}}
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+{{- end}}
 )
 
 const {{.Name}}StorageLayoutJSON = "{{.StorageLayout}}"
 
 var {{.Name}}StorageLayout = new(solc.StorageLayout)
-
+{{if not .NoBytecode}}
 var {{.Name}}DeployedBin = "{{.DeployedBin}}"
-{{if .DeployedSourceMap}}
+{{end}}{{if .DeployedSourceMap}}
 var {{.Name}}DeployedSourceMap = "{{.DeployedSourceMap}}"
+{{end}}{{if .ImmutableReferences}}
+const {{.Name}}ImmutableReferencesJSON = "{{.ImmutableReferences}}"
+
+var {{.Name}}ImmutableReferences = make(solc.ImmutableReferences)
+{{end}}{{if .Selectors}}
+var {{.Name}}Selectors = map[string]string{
+{{- range .Selectors}}
+	"{{.Selector}}": "{{.Signature}}",
+{{- end}}
+}
+{{end}}{{if .HasConstructorArgs}}
+// Parse{{.Name}}ConstructorArgs decodes the constructor arguments of {{.Name}},
+// from the input of its creation transaction, or from the encoded arguments alone.
+func Parse{{.Name}}ConstructorArgs(data []byte) ([]interface{}, error) {
+	return parseConstructorArgs({{.Name}}MetaData, data)
+}
 {{end}}
 func init() {
 	if err := json.Unmarshal([]byte({{.Name}}StorageLayoutJSON), {{.Name}}StorageLayout); err != nil {
 		panic(err)
 	}
+{{if .ImmutableReferences}}
+	if err := json.Unmarshal([]byte({{.Name}}ImmutableReferencesJSON), &{{.Name}}ImmutableReferences); err != nil {
+		panic(err)
+	}
 
+	immutableReferences["{{.Name}}"] = {{.Name}}ImmutableReferences
+{{end}}
 	layouts["{{.Name}}"] = {{.Name}}StorageLayout
+{{- if .Selectors}}
+	selectors["{{.Name}}"] = {{.Name}}Selectors
+{{- end}}
+{{- if not .NoBytecode}}
 	deployedBytecodes["{{.Name}}"] = {{.Name}}DeployedBin
+	initBytecodes["{{.Name}}"] = {{.Name}}MetaData.Bin
+{{- end}}
+}
+{{- if .Events}}
+
+func init() {
+	filterer, err := New{{.Name}}Filterer(common.Address{}, nil)
+	if err != nil {
+		panic(err)
+	}
+{{range .Events}}
+	registerEventDecoder("{{$.Name}}", common.HexToHash("{{.Topic}}"), func(log types.Log) (interface{}, error) {
+		return filterer.Parse{{.GoName}}(log)
+	})
+{{- end}}
 }
+{{- end}}
 `
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+// setupGenerator creates a generator with forge artifacts of the contracts, whose bindings are up to date.
+// Their bindings are skipped, so they are "generated" without abigen.
+func setupGenerator(t *testing.T, upToDate ...string) *generator {
+	dir := t.TempDir()
+	g := &generator{
+		flags: flags{
+			ForgeArtifacts: filepath.Join(dir, "forge-artifacts"),
+			OutDir:         filepath.Join(dir, "out"),
+			Package:        "bindings",
+			MonorepoBase:   dir,
+			Jobs:           2,
+		},
+		tmpl:          template.Must(template.New("artifact").Parse(tmpl)),
+		tempDir:       t.TempDir(),
+		cwd:           dir,
+		artifactPaths: make(map[string]string),
+		manifest:      &manifest{hashes: make(map[string]string)},
+	}
+	require.NoError(t, os.MkdirAll(g.flags.OutDir, 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, g.flags.Package), 0o755))
+	for _, name := range upToDate {
+		artifact := []byte(`{"abi": [], "bytecode": {"object": "0x00"}, "deployedBytecode": {"object": "0x00"}}`)
+		require.NoError(t, os.MkdirAll(filepath.Join(g.flags.ForgeArtifacts, name+".sol"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(g.flags.ForgeArtifacts, name+".sol", name+".json"), artifact, 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, g.flags.Package, strings.ToLower(name)+".go"), nil, 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(g.flags.OutDir, strings.ToLower(name)+"_more.go"), nil, 0o644))
+		g.manifest.set(name, g.inputHash(name, artifact))
+	}
+	return g
+}
+
+func TestGenerateAll(t *testing.T) {
+	tests := []struct {
+		name      string
+		keepGoing bool
+		contracts []string
+		failed    []string
+		err       bool
+	}{
+		{name: "UpToDate", contracts: []string{"L1Block", "L2OutputOracle", "SystemConfig"}},
+		{name: "KeepGoing", keepGoing: true, contracts: []string{"L1Block", "Missing", "SystemConfig", "AlsoMissing"}, failed: []string{"Missing", "AlsoMissing"}},
+		{name: "Abort", contracts: []string{"Missing"}, failed: []string{"Missing"}, err: true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			g := setupGenerator(t, "L1Block", "L2OutputOracle", "SystemConfig")
+			g.flags.KeepGoing = test.keepGoing
+			results, err := g.generateAll(test.contracts)
+			if test.err {
+				require.ErrorContains(t, err, "cannot find forge-artifact")
+			} else {
+				require.NoError(t, err)
+			}
+			require.Len(t, results, len(test.contracts))
+			for i, name := range test.contracts {
+				require.Equal(t, name, results[i].Name, "results must be in the order of the contracts")
+				failed := false
+				for _, f := range test.failed {
+					failed = failed || f == name
+				}
+				if failed {
+					require.Contains(t, results[i].Error, "cannot find forge-artifact")
+					require.NotContains(t, g.manifest.hashes, name, "failed contracts must not be in the manifest")
+				} else {
+					require.Empty(t, results[i].Error)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateAllSkipsAfterFailure(t *testing.T) {
+	g := setupGenerator(t, "L1Block", "SystemConfig")
+	// with a single job, the contracts after the failed one are not started before the failure cancels the others
+	g.flags.Jobs = 1
+	results, err := g.generateAll([]string{"Missing", "L1Block", "SystemConfig"})
+	require.ErrorContains(t, err, "cannot find forge-artifact")
+	require.Equal(t, []contractResult{
+		{Name: "Missing", Error: err.Error()},
+		{Name: "L1Block", Error: "skipped after an earlier failure"},
+		{Name: "SystemConfig", Error: "skipped after an earlier failure"},
+	}, results)
+}
+
+func TestWriteReport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, writeReport(path, []contractResult{
+		{Name: "L1Block"},
+		{Name: "Missing", Error: "cannot find forge-artifact of \"Missing\""},
+	}))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var report []map[string]string
+	require.NoError(t, json.Unmarshal(data, &report))
+	require.Equal(t, []map[string]string{
+		{"name": "L1Block"},
+		{"name": "Missing", "error": "cannot find forge-artifact of \"Missing\""},
+	}, report)
+}
+
+func TestWithoutBytecode(t *testing.T) {
+	g := &generator{noBytecode: map[string]struct{}{"Safe": {}}}
+	require.True(t, g.withoutBytecode("Safe"))
+	require.False(t, g.withoutBytecode("L1Block"))
+	g.noBytecode["*"] = struct{}{}
+	require.True(t, g.withoutBytecode("L1Block"))
+}
+
+func TestTemplate(t *testing.T) {
+	base := data{
+		Name:          "Example",
+		StorageLayout: `{\"storage\":[],\"types\":{}}`,
+		DeployedBin:   "0x6080",
+		Package:       "bindings",
+	}
+	tests := []struct {
+		name     string
+		modify   func(d *data)
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "Minimal",
+			modify:   func(d *data) {},
+			contains: []string{`var ExampleDeployedBin = "0x6080"`, `deployedBytecodes["Example"] = ExampleDeployedBin`, `initBytecodes["Example"] = ExampleMetaData.Bin`},
+			excludes: []string{"registerEventDecoder", "ConstructorArgs", "immutableReferences", "selectors", `"github.com/ethereum/go-ethereum/common"`},
+		},
+		{
+			name:     "NoBytecode",
+			modify:   func(d *data) { d.NoBytecode = true },
+			contains: []string{`layouts["Example"] = ExampleStorageLayout`},
+			excludes: []string{"ExampleDeployedBin", "initBytecodes"},
+		},
+		{
+			name:     "SourceMap",
+			modify:   func(d *data) { d.DeployedSourceMap = "1:2:3" },
+			contains: []string{`var ExampleDeployedSourceMap = "1:2:3"`},
+		},
+		{
+			name:     "ConstructorArgs",
+			modify:   func(d *data) { d.HasConstructorArgs = true },
+			contains: []string{"func ParseExampleConstructorArgs(data []byte) ([]interface{}, error) {", "parseConstructorArgs(ExampleMetaData, data)"},
+		},
+		{
+			name: "Events",
+			modify: func(d *data) {
+				d.Events = []eventData{{Topic: "0x01", GoName: "Initialized"}, {Topic: "0x02", GoName: "Paused"}}
+			},
+			contains: []string{`"github.com/ethereum/go-ethereum/core/types"`, "NewExampleFilterer(common.Address{}, nil)", `registerEventDecoder("Example", common.HexToHash("0x02"), func(log types.Log) (interface{}, error) {`, "return filterer.ParsePaused(log)"},
+		},
+		{
+			name:     "ImmutableReferences",
+			modify:   func(d *data) { d.ImmutableReferences = `{\"1000\":[{\"length\":32,\"start\":1}]}` },
+			contains: []string{`const ExampleImmutableReferencesJSON = "{\"1000\":[{\"length\":32,\"start\":1}]}"`, `immutableReferences["Example"] = ExampleImmutableReferences`},
+		},
+		{
+			name:     "Selectors",
+			modify:   func(d *data) { d.Selectors = []selectorData{{Selector: "8da5cb5b", Signature: "owner()"}} },
+			contains: []string{`"8da5cb5b": "owner()",`, `selectors["Example"] = ExampleSelectors`},
+		},
+	}
+	tpl := template.Must(template.New("artifact").Parse(tmpl))
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			d := base
+			test.modify(&d)
+			var out bytes.Buffer
+			require.NoError(t, tpl.Execute(&out, d))
+			formatted, err := format.Source(out.Bytes())
+			require.NoError(t, err, "must generate valid Go")
+			require.Equal(t, string(formatted), out.String(), "must generate formatted Go")
+			for _, s := range test.contains {
+				require.Contains(t, out.String(), s)
+			}
+			for _, s := range test.excludes {
+				require.NotContains(t, out.String(), s)
+			}
+		})
+	}
+}
+
+func TestCanonicalizeImmutableReferences(t *testing.T) {
+	tests := []struct {
+		name     string
+		refs     solc.ImmutableReferences
+		expected solc.ImmutableReferences
+	}{
+		{name: "Empty", refs: solc.ImmutableReferences{}, expected: solc.ImmutableReferences{}},
+		{
+			name:     "Single",
+			refs:     solc.ImmutableReferences{"52312": {{Start: 100, Length: 32}}},
+			expected: solc.ImmutableReferences{"1000": {{Start: 100, Length: 32}}},
+		},
+		{
+			name: "OrderedByFirstReference",
+			refs: solc.ImmutableReferences{
+				"7":   {{Start: 500, Length: 32}, {Start: 20, Length: 32}},
+				"123": {{Start: 300, Length: 32}},
+				"45":  {{Start: 100, Length: 32}},
+			},
+			expected: solc.ImmutableReferences{
+				"1000": {{Start: 20, Length: 32}, {Start: 500, Length: 32}},
+				"1001": {{Start: 100, Length: 32}},
+				"1002": {{Start: 300, Length: 32}},
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, canonicalizeImmutableReferences(test.refs))
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// manifest records the hashes of the inputs that the bindings of every contract
+// were last generated from, so that the bindings of unchanged contracts are not
+// generated again.
+type manifest struct {
+	mu     sync.Mutex
+	hashes map[string]string
+}
+
+// readManifest reads the manifest at the path. A missing manifest is empty.
+func readManifest(path string) (*manifest, error) {
+	m := &manifest{hashes: make(map[string]string)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &m.hashes); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if m.hashes == nil {
+		m.hashes = make(map[string]string)
+	}
+	for name, hash := range m.hashes {
+		if b, err := hex.DecodeString(hash); err != nil || len(b) != sha256.Size {
+			return nil, fmt.Errorf("invalid hash %q of %s in manifest", hash, name)
+		}
+	}
+	return m, nil
+}
+
+// unchanged returns true if the bindings of the contract were generated from inputs with the hash.
+func (m *manifest) unchanged(name string, hash string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.hashes[name] == hash
+}
+
+func (m *manifest) set(name string, hash string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hashes[name] = hash
+}
+
+func (m *manifest) remove(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.hashes, name)
+}
+
+// write writes the hashes of the contracts, and drops the contracts that are no longer generated.
+func (m *manifest) write(path string, contracts []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	hashes := make(map[string]string)
+	for _, name := range contracts {
+		if hash, ok := m.hashes[name]; ok {
+			hashes[name] = hash
+		}
+	}
+	data, err := json.MarshalIndent(hashes, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// inputHash hashes the inputs that the bindings of a contract are generated from.
+func inputHash(inputs ...string) string {
+	h := sha256.New()
+	for _, input := range inputs {
+		// the length prefix keeps the boundaries between the inputs
+		fmt.Fprintf(h, "%d:", len(input))
+		h.Write([]byte(input))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
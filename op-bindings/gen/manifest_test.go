@@ -0,0 +1,72 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadManifest(t *testing.T) {
+	hash := inputHash("artifact")
+	tests := []struct {
+		name     string
+		contents string
+		expected map[string]string
+		err      string
+	}{
+		{name: "Missing", expected: map[string]string{}},
+		{name: "Empty", contents: "{}", expected: map[string]string{}},
+		{name: "Null", contents: "null", expected: map[string]string{}},
+		{name: "Hashes", contents: `{"L1Block": "` + hash + `"}`, expected: map[string]string{"L1Block": hash}},
+		{name: "InvalidJSON", contents: `{"L1Block": `, err: "failed to parse manifest"},
+		{name: "NotHex", contents: `{"L1Block": "` + strings.Repeat("z", 64) + `"}`, err: "invalid hash"},
+		{name: "ShortHash", contents: `{"L1Block": "` + hash[:62] + `"}`, err: "invalid hash"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "manifest.json")
+			if test.contents != "" {
+				require.NoError(t, os.WriteFile(path, []byte(test.contents), 0o644))
+			}
+			m, err := readManifest(path)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expected, m.hashes)
+		})
+	}
+}
+
+func TestManifest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "manifest.json")
+	m, err := readManifest(path)
+	require.NoError(t, err)
+	require.False(t, m.unchanged("L1Block", inputHash("a")))
+
+	m.set("L1Block", inputHash("a"))
+	m.set("Removed", inputHash("b"))
+	m.set("Dropped", inputHash("c"))
+	m.remove("Removed")
+	require.True(t, m.unchanged("L1Block", inputHash("a")))
+	require.False(t, m.unchanged("L1Block", inputHash("b")))
+	require.False(t, m.unchanged("Removed", inputHash("b")))
+
+	// contracts that are no longer in the contract list are dropped
+	require.NoError(t, m.write(path, []string{"L1Block", "Removed"}))
+	read, err := readManifest(path)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"L1Block": inputHash("a")}, read.hashes)
+}
+
+func TestInputHash(t *testing.T) {
+	require.Equal(t, inputHash("a", "b"), inputHash("a", "b"))
+	require.NotEqual(t, inputHash("a", "b"), inputHash("b", "a"))
+	require.NotEqual(t, inputHash("ab", "c"), inputHash("a", "bc"), "must keep the boundaries between the inputs")
+	require.NotEqual(t, inputHash("a"), inputHash("a", ""))
+}
@@ -105,6 +105,8 @@ type CompilerOutputBytecode struct {
 	Opcodes        string         `json:"opcodes"`
 	SourceMap      string         `json:"sourceMap"`
 	LinkReferences LinkReferences `json:"linkReferences"`
+	// ImmutableReferences is only set for the deployed bytecode.
+	ImmutableReferences ImmutableReferences `json:"immutableReferences,omitempty"`
 }
 
 type LinkReferences map[string]LinkReference
@@ -115,6 +117,15 @@ type LinkReferenceOffset struct {
 	Start  uint `json:"start"`
 }
 
+// ImmutableReferences maps the AST IDs of immutables to the offsets
+// of their values in the deployed bytecode.
+type ImmutableReferences map[string][]ImmutableReferenceOffset
+
+type ImmutableReferenceOffset struct {
+	Length uint `json:"length"`
+	Start  uint `json:"start"`
+}
+
 type CompilerOutputSources map[string]CompilerOutputSource
 
 type CompilerOutputSource struct {
@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+// gapPrefix is the prefix of the labels of the arrays that reserve storage for future variables.
+const gapPrefix = "__gap"
+
+// Incompatibility is a change of a storage variable that is not safe in an upgrade of the contract,
+// because the new implementation reads the storage of the variable differently.
+type Incompatibility struct {
+	Label  string `json:"label"`
+	Slot   uint   `json:"slot"`
+	Offset uint   `json:"offset"`
+	Reason string `json:"reason"`
+}
+
+func (i Incompatibility) String() string {
+	return fmt.Sprintf("%s at slot %d offset %d: %s", i.Label, i.Slot, i.Offset, i.Reason)
+}
+
+// position is the location of a variable in storage.
+type position struct {
+	slot   uint
+	offset uint
+}
+
+// Compare compares the storage layout of a contract with the layout of its previous version,
+// and returns the changes that are not upgrade safe.
+//
+// Every variable of the previous layout must be at the same slot and offset, with the same type.
+// Variables may be renamed, and new variables may be added in storage that was not used before.
+// The storage that a gap reserves may be taken by new variables, as long as the gap still ends at the same slot.
+func Compare(previous *solc.StorageLayout, next *solc.StorageLayout) []Incompatibility {
+	var out []Incompatibility
+	nextEntries := make(map[position]solc.StorageLayoutEntry)
+	nextLabels := make(map[string]solc.StorageLayoutEntry)
+	for _, entry := range next.Storage {
+		nextEntries[position{entry.Slot, entry.Offset}] = entry
+		nextLabels[entry.Label] = entry
+	}
+
+	for _, entry := range previous.Storage {
+		incompatible := func(format string, args ...any) {
+			out = append(out, Incompatibility{Label: entry.Label, Slot: entry.Slot, Offset: entry.Offset, Reason: fmt.Sprintf(format, args...)})
+		}
+		prevType := previous.Types[entry.Type]
+
+		if strings.HasPrefix(entry.Label, gapPrefix) {
+			nextGap, ok := nextLabels[entry.Label]
+			if !ok {
+				incompatible("gap was removed")
+				continue
+			}
+			prevEnd := entry.Slot + slots(prevType)
+			nextEnd := nextGap.Slot + slots(next.Types[nextGap.Type])
+			if prevEnd != nextEnd {
+				incompatible("gap ends at slot %d instead of slot %d", nextEnd, prevEnd)
+			}
+			continue
+		}
+
+		nextEntry, ok := nextEntries[position{entry.Slot, entry.Offset}]
+		if !ok {
+			if moved, ok := nextLabels[entry.Label]; ok {
+				incompatible("moved to slot %d offset %d", moved.Slot, moved.Offset)
+			} else {
+				incompatible("was removed")
+			}
+			continue
+		}
+		nextType := next.Types[nextEntry.Type]
+		if prevType.Label != nextType.Label || prevType.NumberOfBytes != nextType.NumberOfBytes || prevType.Encoding != nextType.Encoding {
+			incompatible("type changed from %s to %s", describe(prevType), describe(nextType))
+		}
+	}
+	return out
+}
+
+// slots returns the number of slots that a variable of the type takes up.
+func slots(t solc.StorageLayoutType) uint {
+	return (t.NumberOfBytes + 31) / 32
+}
+
+func describe(t solc.StorageLayoutType) string {
+	return fmt.Sprintf("%s (%d bytes, %s)", t.Label, t.NumberOfBytes, t.Encoding)
+}
@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+)
+
+var testTypes = map[string]solc.StorageLayoutType{
+	"t_address":                    {Encoding: "inplace", Label: "address", NumberOfBytes: 20},
+	"t_bool":                       {Encoding: "inplace", Label: "bool", NumberOfBytes: 1},
+	"t_uint256":                    {Encoding: "inplace", Label: "uint256", NumberOfBytes: 32},
+	"t_array(t_uint256)50_storage": {Encoding: "inplace", Label: "uint256[50]", NumberOfBytes: 1600},
+	"t_array(t_uint256)49_storage": {Encoding: "inplace", Label: "uint256[49]", NumberOfBytes: 1568},
+}
+
+func layout(entries ...solc.StorageLayoutEntry) *solc.StorageLayout {
+	return &solc.StorageLayout{Storage: entries, Types: testTypes}
+}
+
+func entry(label string, slot uint, offset uint, typ string) solc.StorageLayoutEntry {
+	return solc.StorageLayoutEntry{Label: label, Slot: slot, Offset: offset, Type: typ}
+}
+
+func TestCompare(t *testing.T) {
+	previous := layout(
+		entry("owner", 0, 0, "t_address"),
+		entry("paused", 0, 20, "t_bool"),
+		entry("total", 1, 0, "t_uint256"),
+		entry("__gap", 2, 0, "t_array(t_uint256)50_storage"),
+	)
+
+	tests := []struct {
+		name     string
+		next     *solc.StorageLayout
+		expected []Incompatibility
+	}{
+		{
+			name: "Unchanged",
+			next: previous,
+		},
+		{
+			name: "RenamedAndAppended",
+			next: layout(
+				entry("admin", 0, 0, "t_address"),
+				entry("paused", 0, 20, "t_bool"),
+				entry("total", 1, 0, "t_uint256"),
+				entry("__gap", 2, 0, "t_array(t_uint256)50_storage"),
+				entry("extra", 52, 0, "t_uint256"),
+			),
+		},
+		{
+			name: "TakenFromGap",
+			next: layout(
+				entry("owner", 0, 0, "t_address"),
+				entry("paused", 0, 20, "t_bool"),
+				entry("total", 1, 0, "t_uint256"),
+				entry("extra", 2, 0, "t_uint256"),
+				entry("__gap", 3, 0, "t_array(t_uint256)49_storage"),
+			),
+		},
+		{
+			name: "GapNotShrunk",
+			next: layout(
+				entry("owner", 0, 0, "t_address"),
+				entry("paused", 0, 20, "t_bool"),
+				entry("total", 1, 0, "t_uint256"),
+				entry("extra", 2, 0, "t_uint256"),
+				entry("__gap", 3, 0, "t_array(t_uint256)50_storage"),
+			),
+			expected: []Incompatibility{
+				{Label: "__gap", Slot: 2, Reason: "gap ends at slot 53 instead of slot 52"},
+			},
+		},
+		{
+			name: "TypeChanged",
+			next: layout(
+				entry("owner", 0, 0, "t_address"),
+				entry("paused", 0, 20, "t_bool"),
+				entry("total", 1, 0, "t_address"),
+				entry("__gap", 2, 0, "t_array(t_uint256)50_storage"),
+			),
+			expected: []Incompatibility{
+				{Label: "total", Slot: 1, Reason: "type changed from uint256 (32 bytes, inplace) to address (20 bytes, inplace)"},
+			},
+		},
+		{
+			name: "MovedAndRemoved",
+			next: layout(
+				entry("owner", 0, 0, "t_address"),
+				entry("total", 0, 20, "t_uint256"),
+				entry("__gap", 2, 0, "t_array(t_uint256)50_storage"),
+			),
+			expected: []Incompatibility{
+				{Label: "paused", Slot: 0, Offset: 20, Reason: "type changed from bool (1 bytes, inplace) to uint256 (32 bytes, inplace)"},
+				{Label: "total", Slot: 1, Reason: "moved to slot 0 offset 20"},
+			},
+		},
+		{
+			name: "GapRemoved",
+			next: layout(
+				entry("owner", 0, 0, "t_address"),
+				entry("paused", 0, 20, "t_bool"),
+			),
+			expected: []Incompatibility{
+				{Label: "total", Slot: 1, Reason: "was removed"},
+				{Label: "__gap", Slot: 2, Reason: "gap was removed"},
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, Compare(previous, test.next))
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/bytecode"
+)
+
+type flags struct {
+	RPC       string
+	Addresses string
+}
+
+type result struct {
+	Name    string         `json:"name"`
+	Address common.Address `json:"address"`
+	Match   bool           `json:"match"`
+	*bytecode.Comparison
+}
+
+// verify compares the deployed bytecode in the bindings with the code of the live deployments of the contracts.
+// It writes a JSON report of the differences to stdout, and fails if the code differs in more than the immutables.
+func main() {
+	var f flags
+	flag.StringVar(&f.RPC, "rpc", "", "RPC URL of the chain that the contracts are deployed on")
+	flag.StringVar(&f.Addresses, "addresses", "", "Path to JSON file that maps contract names to the addresses of their deployments")
+	flag.Parse()
+
+	if f.RPC == "" {
+		log.Fatal("must provide -rpc")
+	}
+	if f.Addresses == "" {
+		log.Fatal("must provide -addresses")
+	}
+
+	addressData, err := os.ReadFile(f.Addresses)
+	if err != nil {
+		log.Fatalf("error reading addresses: %v\n", err)
+	}
+	addresses := make(map[string]common.Address)
+	if err := json.Unmarshal(addressData, &addresses); err != nil {
+		log.Fatalf("error parsing addresses: %v\n", err)
+	}
+	names := make([]string, 0, len(addresses))
+	for name := range addresses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	client, err := ethclient.DialContext(ctx, f.RPC)
+	if err != nil {
+		log.Fatalf("error dialing rpc: %v\n", err)
+	}
+	defer client.Close()
+
+	results := make([]result, 0, len(names))
+	mismatches := 0
+	for _, name := range names {
+		expected, err := bindings.GetDeployedBytecode(name)
+		if err != nil {
+			log.Fatalf("error getting deployed bytecode of %s: %v\n", name, err)
+		}
+		immutables, err := bindings.GetImmutableReferences(name)
+		if err != nil {
+			log.Fatalf("error getting immutable references of %s: %v\n", name, err)
+		}
+		actual, err := client.CodeAt(ctx, addresses[name], nil)
+		if err != nil {
+			log.Fatalf("error getting code of %s at %s: %v\n", name, addresses[name], err)
+		}
+		if len(actual) == 0 {
+			log.Fatalf("no code deployed for %s at %s\n", name, addresses[name])
+		}
+		c := bytecode.Compare(expected, actual, immutables)
+		if !c.Match() {
+			mismatches++
+			log.Printf("%s at %s does not match the bindings: metadata match %v, %d immutables, %d diffs\n", name, addresses[name], c.MetadataMatch, len(c.Immutables), len(c.Diffs))
+		}
+		results = append(results, result{Name: name, Address: addresses[name], Match: c.Match(), Comparison: c})
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(results); err != nil {
+		log.Fatalf("error writing report: %v\n", err)
+	}
+	if mismatches > 0 {
+		log.Fatalf("%d of %d contracts do not match the bindings\n", mismatches, len(results))
+	}
+}
@@ -0,0 +1,99 @@
+package vyper
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/foundry"
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Artifact represents a vyper compilation artifact, the output of the
+// compiler for a single contract in the combined_json format, which is also
+// what titanoboa writes. The Abi is left as a json.RawMessage for the same
+// reason as in the foundry artifact.
+type Artifact struct {
+	Abi             json.RawMessage `json:"abi"`
+	Bytecode        hexutil.Bytes   `json:"bytecode"`
+	BytecodeRuntime hexutil.Bytes   `json:"bytecode_runtime"`
+	SourceMap       string          `json:"source_map"`
+	Layout          Layout          `json:"layout"`
+	// MethodIdentifiers maps the signatures of the functions to their 0x prefixed selectors.
+	MethodIdentifiers map[string]string `json:"method_identifiers"`
+}
+
+// Layout represents the storage layout that vyper outputs for a contract.
+type Layout struct {
+	StorageLayout map[string]StorageLayoutEntry `json:"storage_layout"`
+}
+
+// StorageLayoutEntry is the storage of a variable. Variables that do not
+// fit in a single slot take up NSlots consecutive slots.
+type StorageLayoutEntry struct {
+	Type   string `json:"type"`
+	Slot   uint   `json:"slot"`
+	NSlots uint   `json:"n_slots"`
+}
+
+// ToFoundry converts the artifact into a foundry artifact, so that the
+// bindings of vyper contracts are generated in the same way as the
+// bindings of solidity contracts.
+func (a *Artifact) ToFoundry(name string) *foundry.Artifact {
+	methodIdentifiers := make(map[string]string, len(a.MethodIdentifiers))
+	for signature, selector := range a.MethodIdentifiers {
+		methodIdentifiers[signature] = strings.TrimPrefix(selector, "0x")
+	}
+	return &foundry.Artifact{
+		Abi:           a.Abi,
+		StorageLayout: a.Layout.toSolc(name),
+		DeployedBytecode: foundry.DeployedBytecode{
+			SourceMap: a.SourceMap,
+			Object:    a.BytecodeRuntime,
+		},
+		Bytecode: foundry.Bytecode{
+			Object: a.Bytecode,
+		},
+		MethodIdentifiers: methodIdentifiers,
+	}
+}
+
+// toSolc converts the layout into a solc storage layout, ordered by slot.
+// Vyper types are kept as they are, and vyper packs no variables in a slot,
+// so all offsets are zero.
+func (l *Layout) toSolc(contract string) solc.StorageLayout {
+	out := solc.StorageLayout{
+		Storage: make([]solc.StorageLayoutEntry, 0, len(l.StorageLayout)),
+		Types:   make(map[string]solc.StorageLayoutType),
+	}
+	for label, entry := range l.StorageLayout {
+		out.Storage = append(out.Storage, solc.StorageLayoutEntry{
+			Contract: contract,
+			Label:    label,
+			Slot:     entry.Slot,
+			Type:     entry.Type,
+		})
+		encoding := "inplace"
+		if strings.HasPrefix(entry.Type, "HashMap[") {
+			encoding = "mapping"
+		}
+		out.Types[entry.Type] = solc.StorageLayoutType{
+			Encoding:      encoding,
+			Label:         entry.Type,
+			NumberOfBytes: 32 * max(entry.NSlots, 1),
+		}
+	}
+	sort.Slice(out.Storage, func(i, j int) bool {
+		if out.Storage[i].Slot != out.Storage[j].Slot {
+			return out.Storage[i].Slot < out.Storage[j].Slot
+		}
+		return out.Storage[i].Label < out.Storage[j].Label
+	})
+	// vyper has no AST IDs in its layout, but the entries need distinct
+	// IDs to be canonicalized like solc storage layouts
+	for i := range out.Storage {
+		out.Storage[i].AstId = uint(i + 1)
+	}
+	return out
+}
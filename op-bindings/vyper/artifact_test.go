@@ -0,0 +1,44 @@
+package vyper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+const testArtifact = `{
+	"abi": [{"inputs": [], "name": "owner", "outputs": [{"name": "", "type": "address"}], "stateMutability": "view", "type": "function"}],
+	"bytecode": "0x6100aa",
+	"bytecode_runtime": "0x600035",
+	"source_map": "",
+	"method_identifiers": {"owner()": "0x8da5cb5b"},
+	"layout": {
+		"storage_layout": {
+			"balances": {"type": "HashMap[address, uint256]", "slot": 1, "n_slots": 1},
+			"owner": {"type": "address", "slot": 0, "n_slots": 1},
+			"name": {"type": "String[64]", "slot": 2, "n_slots": 3}
+		}
+	}
+}`
+
+func TestToFoundry(t *testing.T) {
+	var artifact Artifact
+	require.NoError(t, json.Unmarshal([]byte(testArtifact), &artifact))
+
+	converted := artifact.ToFoundry("Token")
+	require.JSONEq(t, string(artifact.Abi), string(converted.Abi))
+	require.Equal(t, hexutil.Bytes{0x61, 0x00, 0xaa}, converted.Bytecode.Object)
+	require.Equal(t, hexutil.Bytes{0x60, 0x00, 0x35}, converted.DeployedBytecode.Object)
+	require.Equal(t, map[string]string{"owner()": "8da5cb5b"}, converted.MethodIdentifiers)
+
+	require.Equal(t, []solc.StorageLayoutEntry{
+		{AstId: 1, Contract: "Token", Label: "owner", Slot: 0, Type: "address"},
+		{AstId: 2, Contract: "Token", Label: "balances", Slot: 1, Type: "HashMap[address, uint256]"},
+		{AstId: 3, Contract: "Token", Label: "name", Slot: 2, Type: "String[64]"},
+	}, converted.StorageLayout.Storage)
+	require.Equal(t, solc.StorageLayoutType{Encoding: "mapping", Label: "HashMap[address, uint256]", NumberOfBytes: 32}, converted.StorageLayout.Types["HashMap[address, uint256]"])
+	require.Equal(t, solc.StorageLayoutType{Encoding: "inplace", Label: "String[64]", NumberOfBytes: 96}, converted.StorageLayout.Types["String[64]"])
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/urfave/cli/v2"
@@ -71,25 +72,22 @@ func Main(cliCtx *cli.Context) error {
 
 	go p2pNode.DiscoveryProcess(ctx, logger, config, p2pConfig.TargetPeers())
 
+	shutdown := opio.NewShutdown(logger)
+	shutdown.RegisterCloser("p2p", p2pNode.Close)
+
 	metricsCfg := opmetrics.ReadCLIConfig(cliCtx)
 	if metricsCfg.Enabled {
 		log.Debug("starting metrics server", "addr", metricsCfg.ListenAddr, "port", metricsCfg.ListenPort)
 		metricsSrv, err := m.StartServer(metricsCfg.ListenAddr, metricsCfg.ListenPort)
 		if err != nil {
-			return fmt.Errorf("failed to start metrics server: %w", err)
+			return errors.Join(fmt.Errorf("failed to start metrics server: %w", err), shutdown.Stop(context.Background()))
 		}
-		defer func() {
-			if err := metricsSrv.Stop(context.Background()); err != nil {
-				log.Error("failed to stop metrics server", "err", err)
-			}
-		}()
+		shutdown.Register("metrics", metricsSrv.Stop)
 		log.Info("started metrics server", "addr", metricsSrv.Addr())
 		m.RecordUp()
 	}
 
-	opio.BlockOnInterrupts()
-
-	return nil
+	return shutdown.BlockAndStop(ctx, 30*time.Second)
 }
 
 // validateConfig ensures the minimal config required to run a bootnode
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+)
+
+func main() {
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(isatty.IsTerminal(os.Stderr.Fd()))))
+
+	app := &cli.App{
+		Name:  "chain-spec",
+		Usage: "Generate the configuration of a new OP Stack chain from a single chain spec",
+		Commands: []*cli.Command{
+			{
+				Name: "genesis",
+				Usage: "Writes the deploy config to deploy the L1 contracts with. " +
+					"Once the L1 contracts are deployed, also writes the L2 genesis and the rollup config",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "spec",
+						Required: true,
+						Usage:    "Path to the chain spec, in TOML if it has the .toml extension, in JSON otherwise",
+					},
+					&cli.StringFlag{
+						Name:  "outdir",
+						Value: ".",
+						Usage: "Directory to write deploy-config.json, genesis-l2.json and rollup.json to",
+					},
+					&cli.StringFlag{
+						Name:  "l1-deployments",
+						Usage: "Path to the L1 deployments file. If set, the L2 genesis and rollup config are written too",
+					},
+					&cli.StringFlag{
+						Name:  "l1-rpc",
+						Usage: "L1 RPC URL, to fetch the L1 starting block from. Required with --l1-deployments",
+					},
+				},
+				Action: genesisAction,
+			},
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error generating chain config", "err", err)
+	}
+}
+
+func genesisAction(ctx *cli.Context) error {
+	specPath := ctx.String("spec")
+	log.Info("Chain spec", "path", specPath)
+	spec, err := genesis.NewChainSpec(specPath)
+	if err != nil {
+		return err
+	}
+	if err := spec.Check(); err != nil {
+		return err
+	}
+	config, err := spec.DeployConfig()
+	if err != nil {
+		return err
+	}
+
+	outdir := ctx.String("outdir")
+	if err := os.MkdirAll(outdir, 0o755); err != nil {
+		return fmt.Errorf("cannot create output directory: %w", err)
+	}
+	if err := writeJSON(filepath.Join(outdir, "deploy-config.json"), config); err != nil {
+		return err
+	}
+
+	deploymentsPath := ctx.String("l1-deployments")
+	if deploymentsPath == "" {
+		log.Info("No L1 deployments, skipping the L2 genesis and rollup config")
+		return nil
+	}
+	deployments, err := genesis.NewL1Deployments(deploymentsPath)
+	if err != nil {
+		return fmt.Errorf("cannot read L1 deployments: %w", err)
+	}
+	config.SetDeployments(deployments)
+	if err := config.CheckAddresses(); err != nil {
+		return err
+	}
+
+	if ctx.String("l1-rpc") == "" {
+		return errors.New("must specify --l1-rpc with --l1-deployments")
+	}
+	client, err := ethclient.Dial(ctx.String("l1-rpc"))
+	if err != nil {
+		return fmt.Errorf("cannot dial %s: %w", ctx.String("l1-rpc"), err)
+	}
+	var l1StartBlock *types.Block
+	if hash, ok := config.L1StartingBlockTag.Hash(); ok {
+		l1StartBlock, err = client.BlockByHash(ctx.Context, hash)
+	} else {
+		num, _ := config.L1StartingBlockTag.Number()
+		l1StartBlock, err = client.BlockByNumber(ctx.Context, big.NewInt(num.Int64()))
+	}
+	if err != nil {
+		return fmt.Errorf("error getting l1 start block: %w", err)
+	}
+	log.Info("Using L1 Start Block", "number", l1StartBlock.Number(), "hash", l1StartBlock.Hash().Hex())
+
+	l2Genesis, err := genesis.BuildL2Genesis(config, l1StartBlock)
+	if err != nil {
+		return fmt.Errorf("error creating l2 genesis: %w", err)
+	}
+	l2GenesisBlock := l2Genesis.ToBlock()
+	rollupConfig, err := config.RollupConfig(l1StartBlock, l2GenesisBlock.Hash(), l2GenesisBlock.Number().Uint64())
+	if err != nil {
+		return err
+	}
+	if err := rollupConfig.Check(); err != nil {
+		return fmt.Errorf("generated rollup config does not pass validation: %w", err)
+	}
+
+	if err := writeJSON(filepath.Join(outdir, "genesis-l2.json"), l2Genesis); err != nil {
+		return err
+	}
+	return writeJSON(filepath.Join(outdir, "rollup.json"), rollupConfig)
+}
+
+func writeJSON(outfile string, input any) error {
+	f, err := os.OpenFile(outfile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(input); err != nil {
+		return err
+	}
+	log.Info("Wrote file", "path", outfile)
+	return nil
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -24,6 +26,10 @@ func main() {
 				Required: true,
 				Usage:    "File system path to the deploy config",
 			},
+			&cli.BoolFlag{
+				Name:  "json",
+				Usage: "Write the validation errors and warnings to stdout as JSON",
+			},
 		},
 		Action: entrypoint,
 	}
@@ -39,14 +45,40 @@ func entrypoint(ctx *cli.Context) error {
 	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
 	log.Info("Checking deploy config", "name", name, "path", path)
 
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("deploy config at %s not found: %w", path, err)
+	}
+	checksums, err := genesis.ValidateDeployConfigJSON(raw)
+	if err != nil {
+		return err
+	}
 	config, err := genesis.NewDeployConfig(path)
 	if err != nil {
 		return err
 	}
 
-	// Check the config, no need to call `CheckAddresses()`
-	if err := config.Check(); err != nil {
-		return err
+	// Check the config, no need to check the L1 deployment addresses
+	results := append(checksums, config.Validate()...)
+	if ctx.Bool("json") {
+		if results == nil {
+			results = genesis.ValidationErrors{}
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(results); err != nil {
+			return err
+		}
+	}
+	for _, w := range results.Warnings() {
+		log.Warn(w.Message, "field", w.Field, "code", w.Code)
+	}
+	errs := results.Errors()
+	for _, e := range errs {
+		log.Error(e.Message, "field", e.Field, "code", e.Code)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %d errors", genesis.ErrInvalidDeployConfig, len(errs))
 	}
 
 	log.Info("Valid deploy config")
@@ -48,7 +48,8 @@ func main() {
 				EnvVars: []string{"L2_RPC_URL"},
 			},
 		},
-		Action: entrypoint,
+		Action:   entrypoint,
+		Commands: []*cli.Command{verifyPredeploysCommand},
 	}
 
 	if err := app.Run(os.Args); err != nil {
@@ -890,7 +891,7 @@ func getInitializing(name string, addr common.Address, client *ethclient.Client)
 
 // getStorageValue will get the value of a named storage slot in a contract. It isn't smart about
 // automatically converting from a byte slice to a type, it is the caller's responsibility to do that.
-func getStorageValue(name, entryName string, addr common.Address, client *ethclient.Client) ([]byte, error) {
+func getStorageValue(name, entryName string, addr common.Address, client storageReader) ([]byte, error) {
 	layout, err := bindings.GetStorageLayout(name)
 	if err != nil {
 		return nil, err
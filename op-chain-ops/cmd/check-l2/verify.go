@@ -0,0 +1,258 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/bytecode"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+)
+
+type storageReader interface {
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+type stateReader interface {
+	storageReader
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+}
+
+// Status is the outcome of a predeploy check.
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusFail Status = "fail"
+	// StatusWarn is used for non-standard, but not necessarily invalid, configuration.
+	StatusWarn Status = "warn"
+	StatusSkip Status = "skip"
+)
+
+// CheckResult is the result of a single check of a predeploy.
+type CheckResult struct {
+	Predeploy string         `json:"predeploy"`
+	Address   common.Address `json:"address"`
+	Check     string         `json:"check"`
+	Status    Status         `json:"status"`
+	Detail    string         `json:"detail,omitempty"`
+}
+
+// Report is the result of verifying all predeploys.
+type Report struct {
+	Results  []CheckResult `json:"results"`
+	Passed   int           `json:"passed"`
+	Failed   int           `json:"failed"`
+	Warnings int           `json:"warnings"`
+}
+
+func (r *Report) add(name string, addr common.Address, check string, status Status, detail string, args ...any) {
+	r.Results = append(r.Results, CheckResult{
+		Predeploy: name,
+		Address:   addr,
+		Check:     check,
+		Status:    status,
+		Detail:    fmt.Sprintf(detail, args...),
+	})
+	switch status {
+	case StatusPass:
+		r.Passed++
+	case StatusFail:
+		r.Failed++
+	case StatusWarn:
+		r.Warnings++
+	}
+}
+
+// expectedSlot is a storage value of a predeploy that is the same on every OP Stack chain.
+type expectedSlot struct {
+	label string
+	// value is the expected value of the slot, or nil if the value should be non-zero.
+	value []byte
+}
+
+var expectedSlots = map[string][]expectedSlot{
+	"L2CrossDomainMessenger": {
+		{label: "_initialized"},
+	},
+	"L2StandardBridge": {
+		{label: "_initialized"},
+		{label: "messenger", value: predeploys.L2CrossDomainMessengerAddr.Bytes()},
+	},
+	"L2ERC721Bridge": {
+		{label: "_initialized"},
+		{label: "messenger", value: predeploys.L2CrossDomainMessengerAddr.Bytes()},
+	},
+	"OptimismMintableERC20Factory": {
+		{label: "_initialized"},
+		{label: "bridge", value: predeploys.L2StandardBridgeAddr.Bytes()},
+	},
+}
+
+var verifyPredeploysCommand = &cli.Command{
+	Name: "verify-predeploys",
+	Usage: "Verify the code hash, proxy admin and key storage slots of all predeploys against the op-bindings metadata, " +
+		"and write a pass/fail report",
+	Flags: []cli.Flag{
+		&cli.PathFlag{
+			Name:  "report",
+			Usage: "Path to write the JSON report to. If not specified, the report is written to stdout",
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		client, err := ethclient.DialContext(ctx.Context, ctx.String("l2-rpc-url"))
+		if err != nil {
+			return fmt.Errorf("cannot dial L2 RPC: %w", err)
+		}
+		defer client.Close()
+
+		report, err := verifyPredeploys(ctx.Context, client)
+		if err != nil {
+			return err
+		}
+
+		var out io.Writer = os.Stdout
+		if path := ctx.Path("report"); path != "" {
+			f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return err
+		}
+
+		log.Info("Verified predeploys", "passed", report.Passed, "failed", report.Failed, "warnings", report.Warnings)
+		if report.Failed > 0 {
+			return fmt.Errorf("%d predeploy checks failed", report.Failed)
+		}
+		return nil
+	},
+}
+
+// verifyPredeploys checks every predeploy, and returns a report of the results.
+// An error is only returned if the state could not be read.
+func verifyPredeploys(ctx context.Context, client stateReader) (*Report, error) {
+	names := make([]string, 0, len(predeploys.Predeploys))
+	for name := range predeploys.Predeploys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	report := new(Report)
+	proxyCode, err := bindings.GetDeployedBytecode("Proxy")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		addr := *predeploys.Predeploys[name]
+		codeAddr := addr
+		if predeploys.IsProxied(addr) {
+			code, err := client.CodeAt(ctx, addr, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get code of %s: %w", name, err)
+			}
+			if bytes.Equal(code, proxyCode) {
+				report.add(name, addr, "proxy-code", StatusPass, "")
+			} else {
+				report.add(name, addr, "proxy-code", StatusFail, "code hash %s does not match the proxy code hash %s", crypto.Keccak256Hash(code), crypto.Keccak256Hash(proxyCode))
+			}
+
+			admin, err := client.StorageAt(ctx, addr, genesis.AdminSlot, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get proxy admin of %s: %w", name, err)
+			}
+			if adminAddr := common.BytesToAddress(admin); adminAddr == predeploys.ProxyAdminAddr {
+				report.add(name, addr, "proxy-admin", StatusPass, "")
+			} else {
+				report.add(name, addr, "proxy-admin", StatusFail, "proxy admin is %s, expected %s", adminAddr, predeploys.ProxyAdminAddr)
+			}
+
+			standardImpl, err := genesis.AddressToCodeNamespace(addr)
+			if err != nil {
+				return nil, err
+			}
+			impl, err := client.StorageAt(ctx, addr, genesis.ImplementationSlot, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get implementation of %s: %w", name, err)
+			}
+			codeAddr = common.BytesToAddress(impl)
+			if codeAddr == standardImpl {
+				report.add(name, addr, "implementation", StatusPass, "")
+			} else {
+				report.add(name, addr, "implementation", StatusWarn, "implementation is %s, not the standard %s", codeAddr, standardImpl)
+			}
+		}
+
+		expected, err := bindings.GetDeployedBytecode(name)
+		if err != nil {
+			return nil, err
+		}
+		immutables, err := bindings.GetImmutableReferences(name)
+		if err != nil {
+			return nil, err
+		}
+		code, err := client.CodeAt(ctx, codeAddr, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get code of %s: %w", name, err)
+		}
+		switch {
+		case addr == predeploys.GovernanceTokenAddr && (len(code) == 0 || bytes.Equal(code, proxyCode)):
+			// without governance, the governance token address is left as a plain predeploy proxy
+			report.add(name, codeAddr, "code", StatusSkip, "governance is not enabled")
+			continue
+		case bytecode.Compare(expected, code, immutables).Match():
+			report.add(name, codeAddr, "code", StatusPass, "")
+		default:
+			report.add(name, codeAddr, "code", StatusFail, "code hash %s does not match the op-bindings bytecode", crypto.Keccak256Hash(code))
+		}
+
+		for _, slot := range expectedSlots[name] {
+			check := "storage." + slot.label
+			value, err := getStorageValue(name, slot.label, addr, client)
+			if err != nil {
+				report.add(name, addr, check, StatusFail, "failed to read storage: %v", err)
+				continue
+			}
+			// getStorageValue returns the value in little-endian order
+			value = reverse(value)
+			switch {
+			case slot.value == nil && new(big.Int).SetBytes(value).Sign() != 0:
+				report.add(name, addr, check, StatusPass, "")
+			case slot.value == nil:
+				report.add(name, addr, check, StatusFail, "value is zero")
+			case bytes.Equal(value, slot.value):
+				report.add(name, addr, check, StatusPass, "")
+			default:
+				report.add(name, addr, check, StatusFail, "value is %x, expected %x", value, slot.value)
+			}
+		}
+	}
+	return report, nil
+}
+
+func reverse(b []byte) []byte {
+	out := make([]byte, len(b))
+	for i := range b {
+		out[len(b)-1-i] = b[i]
+	}
+	return out
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+)
+
+type allocReader core.GenesisAlloc
+
+func (a allocReader) StorageAt(_ context.Context, account common.Address, key common.Hash, _ *big.Int) ([]byte, error) {
+	v := a[account].Storage[key]
+	return v[:], nil
+}
+
+func (a allocReader) CodeAt(_ context.Context, account common.Address, _ *big.Int) ([]byte, error) {
+	return a[account].Code, nil
+}
+
+func buildL2Genesis(t *testing.T, enableGovernance bool) core.GenesisAlloc {
+	config, err := genesis.NewDeployConfig("../../genesis/testdata/test-deploy-config-devnet-l1.json")
+	require.NoError(t, err)
+	config.EnableGovernance = enableGovernance
+	config.FundDevAccounts = false
+	l1Block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(0), BaseFee: big.NewInt(7)})
+	gen, err := genesis.BuildL2Genesis(config, l1Block)
+	require.NoError(t, err)
+	return gen.Alloc
+}
+
+func TestVerifyPredeploys(t *testing.T) {
+	for _, governance := range []bool{true, false} {
+		alloc := buildL2Genesis(t, governance)
+		report, err := verifyPredeploys(context.Background(), allocReader(alloc))
+		require.NoError(t, err)
+		for _, r := range report.Results {
+			require.NotEqual(t, StatusFail, r.Status, "%s %s: %s", r.Predeploy, r.Check, r.Detail)
+		}
+		require.Zero(t, report.Warnings)
+		require.Positive(t, report.Passed)
+	}
+
+	t.Run("detects misconfiguration", func(t *testing.T) {
+		alloc := buildL2Genesis(t, true)
+		bridge := alloc[predeploys.L2StandardBridgeAddr]
+		bridge.Storage[genesis.AdminSlot] = common.Hash{0x01}
+		bridge.Storage[common.BigToHash(big.NewInt(3))] = common.Hash{}
+		alloc[predeploys.L2StandardBridgeAddr] = bridge
+
+		impl, err := genesis.AddressToCodeNamespace(predeploys.L1BlockAddr)
+		require.NoError(t, err)
+		account := alloc[impl]
+		account.Code = append(common.CopyBytes(account.Code[:len(account.Code)-1]), account.Code[len(account.Code)-1]^0xff)
+		alloc[impl] = account
+
+		report, err := verifyPredeploys(context.Background(), allocReader(alloc))
+		require.NoError(t, err)
+		failed := make(map[string]bool)
+		for _, r := range report.Results {
+			if r.Status == StatusFail {
+				failed[r.Predeploy+" "+r.Check] = true
+			}
+		}
+		require.Equal(t, map[string]bool{
+			"L2StandardBridge proxy-admin":       true,
+			"L2StandardBridge storage.messenger": true,
+			"L1Block code":                       true,
+		}, failed)
+		require.Equal(t, 3, report.Failed)
+	})
+}
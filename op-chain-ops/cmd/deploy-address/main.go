@@ -0,0 +1,165 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/deployer"
+)
+
+var outfileFlag = &cli.PathFlag{
+	Name:  "outfile",
+	Usage: "Path to write the output to. If not specified, it is written to stdout",
+}
+
+var computeCommand = &cli.Command{
+	Name:  "compute",
+	Usage: "Compute the address of a CREATE or CREATE2 deployment",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "deployer",
+			Usage:    "Address of the deployer, the sender of the CREATE or the contract that executes the CREATE2",
+			Required: true,
+		},
+		&cli.Uint64Flag{
+			Name:  "nonce",
+			Usage: "Nonce of the deployer, for a CREATE deployment",
+		},
+		&cli.StringFlag{
+			Name:  "salt",
+			Usage: "Salt of a CREATE2 deployment. If set, the address is computed for CREATE2",
+		},
+		&cli.StringFlag{
+			Name:  "contract",
+			Usage: "Name of the contract in op-bindings to take the init code of a CREATE2 deployment from",
+		},
+		&cli.StringFlag{
+			Name:  "init-code",
+			Usage: "Init code of a CREATE2 deployment, instead of --contract",
+		},
+		&cli.StringFlag{
+			Name:  "constructor-args",
+			Usage: "ABI encoded constructor arguments that are appended to the init code",
+		},
+	},
+	Action: compute,
+}
+
+var verifyCommand = &cli.Command{
+	Name:  "verify",
+	Usage: "Verify a list of deterministic deployments against a live chain",
+	Flags: []cli.Flag{
+		&cli.PathFlag{
+			Name:     "deployments",
+			Usage:    "Path to the JSON list of expected deployments",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "rpc-url",
+			Usage:    "RPC URL of the chain to verify the deployments on",
+			Required: true,
+		},
+		outfileFlag,
+	},
+	Action: verify,
+}
+
+func main() {
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(isatty.IsTerminal(os.Stderr.Fd()))))
+
+	app := &cli.App{
+		Name:     "deploy-address",
+		Usage:    "Compute and verify the addresses of deterministic deployments",
+		Commands: []*cli.Command{computeCommand, verifyCommand},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error computing deployment address", "err", err)
+	}
+}
+
+func compute(ctx *cli.Context) error {
+	deployerAddr := ctx.String("deployer")
+	if !common.IsHexAddress(deployerAddr) {
+		return fmt.Errorf("%q is not an address", deployerAddr)
+	}
+	d := deployer.DeterministicDeployment{
+		Scheme:          deployer.SchemeCreate,
+		Deployer:        common.HexToAddress(deployerAddr),
+		Nonce:           ctx.Uint64("nonce"),
+		Contract:        ctx.String("contract"),
+		InitCode:        common.FromHex(ctx.String("init-code")),
+		ConstructorArgs: common.FromHex(ctx.String("constructor-args")),
+	}
+	if ctx.IsSet("salt") {
+		d.Scheme = deployer.SchemeCreate2
+		d.Salt = common.HexToHash(ctx.String("salt"))
+	}
+	addr, err := d.ComputeAddress()
+	if err != nil {
+		return err
+	}
+	fmt.Println(addr.Hex())
+	return nil
+}
+
+func verify(ctx *cli.Context) error {
+	deployments, err := deployer.NewDeterministicDeployments(ctx.Path("deployments"))
+	if err != nil {
+		return err
+	}
+	rpcURL := ctx.String("rpc-url")
+	client, err := ethclient.DialContext(ctx.Context, rpcURL)
+	if err != nil {
+		return fmt.Errorf("cannot dial %s: %w", rpcURL, err)
+	}
+	defer client.Close()
+
+	results, err := deployer.VerifyDeployments(ctx.Context, client, deployments)
+	if err != nil {
+		return err
+	}
+	failed := 0
+	for _, result := range results {
+		if result.OK() {
+			log.Info("Deployment verified", "name", result.Name, "address", result.Address)
+			continue
+		}
+		failed++
+		for _, problem := range result.Problems {
+			log.Error("Deployment invalid", "name", result.Name, "address", result.Address, "problem", problem)
+		}
+	}
+	if err := writeJSON(ctx.Path("outfile"), results); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d deployments are invalid", failed, len(results))
+	}
+	return nil
+}
+
+// writeJSON writes the input to the file, or to stdout if the path is empty.
+func writeJSON(path string, input any) error {
+	var out io.Writer = os.Stdout
+	if path != "" {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(input)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+)
+
+// Report is the result of comparing two genesis states.
+type Report struct {
+	RootA common.Hash           `json:"rootA"`
+	RootB common.Hash           `json:"rootB"`
+	Diffs []genesis.AccountDiff `json:"diffs"`
+}
+
+func main() {
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(isatty.IsTerminal(os.Stderr.Fd()))))
+
+	app := &cli.App{
+		Name:  "genesis-diff",
+		Usage: "Compare a genesis file against another genesis file, or against the genesis state of a live chain",
+		Flags: []cli.Flag{
+			&cli.PathFlag{
+				Name:     "genesis",
+				Usage:    "Path to the genesis file to compare",
+				Required: true,
+			},
+			&cli.PathFlag{
+				Name:  "other-genesis",
+				Usage: "Path to the genesis file to compare against",
+			},
+			&cli.StringFlag{
+				Name:  "rpc-url",
+				Usage: "RPC URL of the chain to compare against, at block 0",
+			},
+			&cli.PathFlag{
+				Name:  "outfile",
+				Usage: "Path to write the report to. If not specified, it is written to stdout",
+			},
+		},
+		Action: entrypoint,
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error comparing genesis", "err", err)
+	}
+}
+
+func entrypoint(ctx *cli.Context) error {
+	otherPath := ctx.Path("other-genesis")
+	rpcURL := ctx.String("rpc-url")
+	if (otherPath == "") == (rpcURL == "") {
+		return errors.New("must specify exactly one of --other-genesis or --rpc-url")
+	}
+
+	gen, err := loadGenesis(ctx.Path("genesis"))
+	if err != nil {
+		return err
+	}
+	report := &Report{RootA: gen.ToBlock().Root()}
+
+	var other core.GenesisAlloc
+	if otherPath != "" {
+		otherGen, err := loadGenesis(otherPath)
+		if err != nil {
+			return err
+		}
+		report.RootB = otherGen.ToBlock().Root()
+		other = otherGen.Alloc
+	} else {
+		client, err := ethclient.DialContext(ctx.Context, rpcURL)
+		if err != nil {
+			return fmt.Errorf("cannot dial %s: %w", rpcURL, err)
+		}
+		defer client.Close()
+		header, err := client.HeaderByNumber(ctx.Context, common.Big0)
+		if err != nil {
+			return fmt.Errorf("cannot fetch genesis header: %w", err)
+		}
+		report.RootB = header.Root
+		log.Info("Fetching genesis accounts", "count", len(gen.Alloc))
+		other, err = genesis.FetchAlloc(ctx.Context, client, common.Big0, gen.Alloc)
+		if err != nil {
+			return err
+		}
+	}
+
+	report.Diffs = genesis.DiffAllocs(gen.Alloc, other)
+	for _, diff := range report.Diffs {
+		if diff.Slot != nil {
+			log.Warn("Account differs", "address", diff.Address, "field", diff.Field, "slot", *diff.Slot, "a", diff.A, "b", diff.B)
+		} else {
+			log.Warn("Account differs", "address", diff.Address, "field", diff.Field, "a", diff.A, "b", diff.B)
+		}
+	}
+	if report.RootA != report.RootB {
+		log.Warn("State roots differ", "a", report.RootA, "b", report.RootB)
+	}
+	if err := writeJSON(ctx.Path("outfile"), report); err != nil {
+		return err
+	}
+	if len(report.Diffs) > 0 {
+		return fmt.Errorf("found %d differences", len(report.Diffs))
+	}
+	if report.RootA != report.RootB {
+		// accounts and storage that are only on the live chain cannot be listed
+		return errors.New("state roots differ")
+	}
+	log.Info("Genesis states match", "root", report.RootA)
+	return nil
+}
+
+func loadGenesis(path string) (*core.Genesis, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("genesis at %s not found: %w", path, err)
+	}
+	defer file.Close()
+	var gen core.Genesis
+	if err := json.NewDecoder(file).Decode(&gen); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal genesis: %w", err)
+	}
+	return &gen, nil
+}
+
+// writeJSON writes the input to the file, or to stdout if the path is empty.
+func writeJSON(path string, input any) error {
+	var out io.Writer = os.Stdout
+	if path != "" {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(input)
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/safe"
+)
+
+// SignerView is what the owners of the Safe sign, along with its EIP-712 hashes.
+type SignerView struct {
+	Safe    common.Address     `json:"safe"`
+	ChainID *big.Int           `json:"chainId"`
+	Tx      *safe.SafeTx       `json:"tx"`
+	Hashes  *safe.SafeTxHashes `json:"hashes"`
+}
+
+func main() {
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(isatty.IsTerminal(os.Stderr.Fd()))))
+
+	app := &cli.App{
+		Name:  "safe-bundle",
+		Usage: "Build a Safe tx-builder bundle, and its EIP-712 hashes, from a list of admin operations",
+		Flags: []cli.Flag{
+			&cli.PathFlag{
+				Name:     "ops",
+				Usage:    "Path to the JSON list of admin operations",
+				Required: true,
+			},
+			&cli.Uint64Flag{
+				Name:     "chain-id",
+				Usage:    "Chain ID of the chain that the Safe is on",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     "safe",
+				Usage:    "Address of the Safe",
+				Required: true,
+			},
+			&cli.Uint64Flag{
+				Name:     "nonce",
+				Usage:    "Nonce of the Safe that the bundle is executed at",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  "multisend",
+				Usage: "Address of the MultiSendCallOnly contract, to execute a bundle of more than one operation",
+				Value: safe.MultiSendCallOnly.Hex(),
+			},
+			&cli.PathFlag{
+				Name:  "outfile",
+				Usage: "Path to write the bundle to. If not specified, it is written to stdout",
+			},
+			&cli.PathFlag{
+				Name:  "hashes-out",
+				Usage: "Path to write the Safe transaction and its EIP-712 hashes to, for signers to verify",
+			},
+			&cli.PathFlag{
+				Name:  "calls-out",
+				Usage: "Path to write the operations to as plain calls, to send them directly instead",
+			},
+		},
+		Action: entrypoint,
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error building safe bundle", "err", err)
+	}
+}
+
+func entrypoint(ctx *cli.Context) error {
+	safeAddr, err := parseAddress(ctx.String("safe"))
+	if err != nil {
+		return fmt.Errorf("invalid safe address: %w", err)
+	}
+	multiSend, err := parseAddress(ctx.String("multisend"))
+	if err != nil {
+		return fmt.Errorf("invalid multisend address: %w", err)
+	}
+	chainID := new(big.Int).SetUint64(ctx.Uint64("chain-id"))
+
+	ops, err := safe.NewAdminOps(ctx.Path("ops"))
+	if err != nil {
+		return err
+	}
+	batch, err := ops.Batch(chainID, safeAddr)
+	if err != nil {
+		return err
+	}
+	tx, err := batch.SafeTx(multiSend, ctx.Uint64("nonce"))
+	if err != nil {
+		return err
+	}
+	hashes := tx.Hashes(chainID, safeAddr)
+	log.Info("Built safe bundle", "operations", len(batch.Transactions), "nonce", tx.Nonce,
+		"domainHash", hashes.DomainHash, "messageHash", hashes.MessageHash, "safeTxHash", hashes.SafeTxHash)
+
+	if path := ctx.Path("hashes-out"); path != "" {
+		view := &SignerView{Safe: safeAddr, ChainID: chainID, Tx: tx, Hashes: &hashes}
+		if err := writeJSON(path, view); err != nil {
+			return err
+		}
+	}
+	if path := ctx.Path("calls-out"); path != "" {
+		calls, err := ops.Calls()
+		if err != nil {
+			return err
+		}
+		if err := writeJSON(path, calls); err != nil {
+			return err
+		}
+	}
+	return writeJSON(ctx.Path("outfile"), batch)
+}
+
+func parseAddress(s string) (common.Address, error) {
+	if !common.IsHexAddress(s) {
+		return common.Address{}, fmt.Errorf("%q is not an address", s)
+	}
+	return common.HexToAddress(s), nil
+}
+
+// writeJSON writes the input to the file, or to stdout if the path is empty.
+func writeJSON(path string, input any) error {
+	var out io.Writer = os.Stdout
+	if path != "" {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(input)
+}
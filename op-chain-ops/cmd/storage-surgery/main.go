@@ -0,0 +1,292 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/common"
+	gstate "github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/surgery"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+)
+
+func main() {
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(isatty.IsTerminal(os.Stderr.Fd()))))
+
+	app := &cli.App{
+		Name:  "storage-surgery",
+		Usage: "Apply storage mutations to a state dump or a live forked chain, with a reviewable diff",
+		Flags: []cli.Flag{
+			&cli.PathFlag{
+				Name:     "mutations",
+				Usage:    "Path to a JSON list of storage mutations: set, move and clear",
+				Required: true,
+			},
+			&cli.PathFlag{
+				Name:  "state-dump",
+				Usage: "Path to the state dump to mutate",
+			},
+			&cli.PathFlag{
+				Name:  "outfile",
+				Usage: "Path to write the mutated state dump to. Defaults to overwriting the state dump",
+			},
+			&cli.StringFlag{
+				Name:  "rpc-url",
+				Usage: "RPC URL of a live forked chain to mutate, e.g. served by anvil. It must support hardhat_setStorageAt to apply the mutations",
+			},
+			&cli.Uint64Flag{
+				Name:  "block",
+				Usage: "Block number to read the storage of the live chain at, for a dry-run. Defaults to the latest block",
+			},
+			&cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "Only print the diff, and the resulting state root of the state dump, without writing any state",
+			},
+			&cli.PathFlag{
+				Name:  "diff-out",
+				Usage: "Path to write the changes to as JSON, for review",
+			},
+			&cli.PathFlag{
+				Name:  "contracts",
+				Usage: "Path to a JSON object of account addresses to contract names, to decode the fields of the changes written to --diff-out with the storage layouts",
+			},
+		},
+		Action: entrypoint,
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error in storage surgery", "err", err)
+	}
+}
+
+func entrypoint(ctx *cli.Context) error {
+	dumpPath := ctx.Path("state-dump")
+	rpcURL := ctx.String("rpc-url")
+	if (dumpPath == "") == (rpcURL == "") {
+		return errors.New("must specify exactly one of --state-dump or --rpc-url")
+	}
+	dryRun := ctx.Bool("dry-run")
+
+	mutations, err := surgery.NewMutations(ctx.Path("mutations"))
+	if err != nil {
+		return err
+	}
+	log.Info("Loaded storage mutations", "count", len(mutations))
+
+	if dumpPath != "" {
+		// Only the mutated accounts are held in memory, the rest of the dump is streamed through
+		mutated := make(map[common.Address]struct{})
+		for _, m := range mutations {
+			mutated[m.Address] = struct{}{}
+		}
+		accounts := &gstate.Dump{Accounts: make(map[common.Address]gstate.DumpAccount)}
+		before, err := hashStateDump(dumpPath, func(addr common.Address, account *gstate.DumpAccount) {
+			if _, ok := mutated[addr]; ok {
+				accounts.Accounts[addr] = *account
+			}
+		})
+		if err != nil {
+			return err
+		}
+		state := surgery.NewDumpState(accounts)
+		changes, err := plan(ctx, state, mutations)
+		if err != nil {
+			return err
+		}
+		if err := state.Apply(changes); err != nil {
+			return err
+		}
+		outfile := ctx.Path("outfile")
+		if outfile == "" {
+			outfile = dumpPath
+		}
+		if dryRun {
+			log.Info("Dry-run, not writing the state dump")
+		} else {
+			log.Info("Writing state dump", "path", outfile)
+		}
+		after, err := writeStateDump(dumpPath, outfile, state.Dump(), dryRun)
+		if err != nil {
+			return err
+		}
+		log.Info("Computed state root", "before", before, "after", after)
+		return nil
+	}
+
+	client, err := rpc.DialContext(ctx.Context, rpcURL)
+	if err != nil {
+		return fmt.Errorf("cannot dial %s: %w", rpcURL, err)
+	}
+	defer client.Close()
+	var block *big.Int
+	if ctx.IsSet("block") {
+		if !dryRun {
+			return errors.New("cannot apply mutations at a historical block, use --dry-run")
+		}
+		block = new(big.Int).SetUint64(ctx.Uint64("block"))
+	}
+	state := surgery.NewRPCState(client, block)
+	changes, err := plan(ctx, state, mutations)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		log.Info("Dry-run, not writing the storage changes")
+		return nil
+	}
+	log.Info("Writing storage changes", "count", len(changes))
+	return state.Apply(ctx.Context, changes)
+}
+
+// plan computes the changes, and writes them for review.
+func plan(ctx *cli.Context, reader surgery.StorageReader, mutations []surgery.Mutation) ([]surgery.Change, error) {
+	changes, err := surgery.Plan(ctx.Context, reader, mutations)
+	if err != nil {
+		return nil, err
+	}
+	if err := surgery.WriteDiff(os.Stdout, changes); err != nil {
+		return nil, err
+	}
+	if diffOut := ctx.Path("diff-out"); diffOut != "" {
+		var diff any = changes
+		if contractsPath := ctx.Path("contracts"); contractsPath != "" {
+			layouts, err := loadLayouts(contractsPath)
+			if err != nil {
+				return nil, err
+			}
+			diff = layouts.NameChanges(changes)
+		}
+		if err := writeJSON(diffOut, diff); err != nil {
+			return nil, err
+		}
+	}
+	return changes, nil
+}
+
+// loadLayouts loads the storage layouts of the contracts of the accounts in the file.
+func loadLayouts(path string) (*surgery.Layouts, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("contracts at %s not found: %w", path, err)
+	}
+	var contracts map[common.Address]string
+	if err := json.Unmarshal(file, &contracts); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal contracts: %w", err)
+	}
+	return surgery.NewLayouts(contracts)
+}
+
+// hashStateDump streams the dump at dumpPath account by account, and returns its state root.
+// Each account is passed to fn, which must copy what it keeps of the account.
+func hashStateDump(dumpPath string, fn func(addr common.Address, account *gstate.DumpAccount)) (common.Hash, error) {
+	hasher, err := genesis.NewDumpHasher()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	defer hasher.Close()
+	if _, err := genesis.StreamStateDump(dumpPath, func(addr common.Address, account *gstate.DumpAccount) error {
+		fn(addr, account)
+		return hasher.AddAccount(addr, account)
+	}); err != nil {
+		return common.Hash{}, err
+	}
+	return hasher.Root()
+}
+
+// writeStateDump streams the dump at dumpPath to the outfile account by account, with the accounts of mutated
+// in place of the accounts in the dump, and returns the state root of the result.
+// The outfile is only computed and not written on a dry-run.
+func writeStateDump(dumpPath string, outfile string, mutated *gstate.Dump, dryRun bool) (common.Hash, error) {
+	var out io.Writer = io.Discard
+	var file *ioutil.AtomicFile
+	if !dryRun {
+		// The outfile may be the dump itself, so it is only replaced once the whole dump is read
+		var err error
+		file, err = ioutil.CreateAtomic(outfile, 0o644)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		defer file.Abort()
+		out = file
+	}
+	writer, err := genesis.NewDumpWriter(out)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	hasher, err := genesis.NewDumpHasher()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	defer hasher.Close()
+	write := func(addr common.Address, account *gstate.DumpAccount) error {
+		if err := hasher.AddAccount(addr, account); err != nil {
+			return err
+		}
+		return writer.WriteAccount(addr, account)
+	}
+
+	written := make(map[common.Address]struct{})
+	if _, err := genesis.StreamStateDump(dumpPath, func(addr common.Address, account *gstate.DumpAccount) error {
+		if m, ok := mutated.Accounts[addr]; ok {
+			account = &m
+			written[addr] = struct{}{}
+		}
+		return write(addr, account)
+	}); err != nil {
+		return common.Hash{}, err
+	}
+	// Accounts that are created by the mutations come last
+	var created []common.Address
+	for addr := range mutated.Accounts {
+		if _, ok := written[addr]; !ok {
+			created = append(created, addr)
+		}
+	}
+	sort.Slice(created, func(i, j int) bool {
+		return created[i].Cmp(created[j]) < 0
+	})
+	for _, addr := range created {
+		account := mutated.Accounts[addr]
+		if err := write(addr, &account); err != nil {
+			return common.Hash{}, err
+		}
+	}
+
+	root, err := hasher.Root()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := writer.Close(fmt.Sprintf("%x", root)); err != nil {
+		return common.Hash{}, err
+	}
+	if file != nil {
+		if err := file.Commit(); err != nil {
+			return common.Hash{}, err
+		}
+	}
+	return root, nil
+}
+
+func writeJSON(outfile string, input any) error {
+	f, err := os.OpenFile(outfile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	return enc.Encode(input)
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/upgrades"
+)
+
+func main() {
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(isatty.IsTerminal(os.Stderr.Fd()))))
+
+	app := &cli.App{
+		Name:  "upgrade-bundle",
+		Usage: "Build a Safe tx-builder bundle that upgrades the L1 contracts to the release of op-bindings",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "l1-rpc-url",
+				Value:   "http://127.0.0.1:8545",
+				Usage:   "L1 RPC URL",
+				EnvVars: []string{"L1_RPC_URL"},
+			},
+			&cli.PathFlag{
+				Name:     "deployments",
+				Usage:    "Path to the L1 deployments file, with the ProxyAdmin and the proxies to upgrade",
+				Required: true,
+			},
+			&cli.PathFlag{
+				Name:     "targets",
+				Usage:    "Path to the upgrade targets, the implementations to upgrade to keyed by contract name",
+				Required: true,
+			},
+			&cli.PathFlag{
+				Name:  "outfile",
+				Value: "bundle.json",
+				Usage: "File to write the tx-builder bundle to",
+			},
+			&cli.PathFlag{
+				Name:  "report",
+				Usage: "File to write the report to. If not specified, the report is written to stdout",
+			},
+			&cli.PathFlag{
+				Name:  "plan-out",
+				Usage: "File to write the upgrade plan to as JSON",
+			},
+		},
+		Action: entrypoint,
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error building upgrade bundle", "err", err)
+	}
+}
+
+func entrypoint(ctx *cli.Context) error {
+	deployments, err := genesis.NewL1Deployments(ctx.Path("deployments"))
+	if err != nil {
+		return fmt.Errorf("cannot read L1 deployments: %w", err)
+	}
+	targets, err := upgrades.NewUpgradeTargets(ctx.Path("targets"))
+	if err != nil {
+		return err
+	}
+	client, err := ethclient.Dial(ctx.String("l1-rpc-url"))
+	if err != nil {
+		return fmt.Errorf("cannot dial %s: %w", ctx.String("l1-rpc-url"), err)
+	}
+	chainID, err := client.ChainID(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("cannot fetch L1 chain ID: %w", err)
+	}
+
+	plan, err := upgrades.PlanUpgrades(ctx.Context, client, deployments, targets)
+	if err != nil {
+		return err
+	}
+	for _, u := range plan.Upgrades {
+		log.Info(u.Contract, "status", u.Status, "current", u.CurrentImplementation, "target", u.TargetImplementation)
+	}
+
+	if reportPath := ctx.Path("report"); reportPath != "" {
+		f, err := os.OpenFile(reportPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if err := plan.WriteReport(f); err != nil {
+			return err
+		}
+		log.Info("Wrote file", "path", reportPath)
+	} else if err := plan.WriteReport(os.Stdout); err != nil {
+		return err
+	}
+	if planPath := ctx.Path("plan-out"); planPath != "" {
+		if err := writeJSON(planPath, plan); err != nil {
+			return err
+		}
+	}
+
+	if failed := plan.Failed(); len(failed) > 0 {
+		return fmt.Errorf("%d upgrades revert when simulated, see the report", len(failed))
+	}
+	if len(plan.Pending()) == 0 {
+		log.Info("All contracts are up to date, no bundle to write")
+		return nil
+	}
+	batch, err := plan.Batch(chainID)
+	if err != nil {
+		return err
+	}
+	return writeJSON(ctx.Path("outfile"), batch)
+}
+
+func writeJSON(outfile string, input any) error {
+	f, err := os.OpenFile(outfile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(input); err != nil {
+		return err
+	}
+	log.Info("Wrote file", "path", outfile)
+	return nil
+}
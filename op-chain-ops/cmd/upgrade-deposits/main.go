@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/upgrades"
+)
+
+func main() {
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(isatty.IsTerminal(os.Stderr.Fd()))))
+
+	app := &cli.App{
+		Name:  "upgrade-deposits",
+		Usage: "Build the deposit transactions of a network upgrade from a declarative spec, as a JSON artifact for review",
+		Flags: []cli.Flag{
+			&cli.PathFlag{
+				Name:     "spec",
+				Usage:    "Path to the network upgrade spec",
+				Required: true,
+			},
+			&cli.PathFlag{
+				Name:  "outfile",
+				Usage: "Path to write the artifact to. If not specified, it is written to stdout",
+			},
+		},
+		Action: entrypoint,
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error building network upgrade deposits", "err", err)
+	}
+}
+
+func entrypoint(ctx *cli.Context) error {
+	spec, err := upgrades.NewNetworkUpgradeSpec(ctx.Path("spec"))
+	if err != nil {
+		return err
+	}
+	artifact, err := spec.Build()
+	if err != nil {
+		return err
+	}
+	for _, tx := range artifact.Transactions {
+		log.Info("Built upgrade deposit", "intent", tx.Intent, "sourceHash", tx.SourceHash, "hash", tx.Hash)
+	}
+
+	var out io.Writer = os.Stdout
+	if path := ctx.Path("outfile"); path != "" {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(artifact)
+}
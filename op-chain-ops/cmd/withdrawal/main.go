@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/withdrawals"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+)
+
+// l2Client serves both the receipts and the storage proofs of the L2 chain.
+type l2Client struct {
+	rpc  *rpc.Client
+	eth  *ethclient.Client
+	geth *gethclient.Client
+}
+
+var _ withdrawals.L2Client = (*l2Client)(nil)
+
+func (c *l2Client) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return c.eth.TransactionReceipt(ctx, txHash)
+}
+
+func (c *l2Client) GetProof(ctx context.Context, account common.Address, keys []string, blockNumber *big.Int) (*gethclient.AccountResult, error) {
+	return c.geth.GetProof(ctx, account, keys, blockNumber)
+}
+
+func (c *l2Client) Close() {
+	c.rpc.Close()
+}
+
+// Calldata is the L1 transaction to submit, to prove or finalize a withdrawal.
+type Calldata struct {
+	To   common.Address `json:"to"`
+	Data hexutil.Bytes  `json:"data"`
+	// Parameters are the prove parameters the calldata was encoded from, only set for prove.
+	Parameters *withdrawals.ProveParameters `json:"parameters,omitempty"`
+}
+
+var flags = []cli.Flag{
+	&cli.StringFlag{
+		Name:     "l2-rpc-url",
+		Usage:    "L2 RPC URL, it must support eth_getProof",
+		Required: true,
+	},
+	&cli.StringFlag{
+		Name:     "tx-hash",
+		Usage:    "Hash of the L2 transaction that initiated the withdrawal",
+		Required: true,
+	},
+	&cli.StringFlag{
+		Name:     "portal-address",
+		Usage:    "Address of the OptimismPortal on L1",
+		Required: true,
+	},
+	&cli.PathFlag{
+		Name:  "outfile",
+		Usage: "Path to write the calldata to as JSON. If not specified, it is written to stdout",
+	},
+}
+
+func main() {
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(isatty.IsTerminal(os.Stderr.Fd()))))
+
+	app := &cli.App{
+		Name:  "withdrawal",
+		Usage: "Build the L1 calldata to prove and finalize withdrawals",
+		Commands: []*cli.Command{
+			{
+				Name:  "prove",
+				Usage: "Build the proveWithdrawalTransaction calldata, against the first output proposal that includes the withdrawal",
+				Flags: append([]cli.Flag{
+					&cli.StringFlag{
+						Name:     "l1-rpc-url",
+						Usage:    "L1 RPC URL, to read the proposed outputs from the L2OutputOracle",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:     "rollup-rpc-url",
+						Usage:    "Rollup node RPC URL, to read the output that the withdrawal is proven against",
+						Required: true,
+					},
+				}, flags...),
+				Action: prove,
+			},
+			{
+				Name:   "finalize",
+				Usage:  "Build the finalizeWithdrawalTransaction calldata",
+				Flags:  flags,
+				Action: finalize,
+			},
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("error building withdrawal calldata", "err", err)
+	}
+}
+
+func prove(ctx *cli.Context) error {
+	portalAddr, txHash, err := parseArgs(ctx)
+	if err != nil {
+		return err
+	}
+	l2, err := dialL2(ctx)
+	if err != nil {
+		return err
+	}
+	defer l2.Close()
+
+	l1, err := ethclient.DialContext(ctx.Context, ctx.String("l1-rpc-url"))
+	if err != nil {
+		return fmt.Errorf("cannot dial L1 RPC: %w", err)
+	}
+	defer l1.Close()
+	portal, err := bindings.NewOptimismPortalCaller(portalAddr, l1)
+	if err != nil {
+		return err
+	}
+	oracleAddr, err := portal.L2ORACLE(&bind.CallOpts{Context: ctx.Context})
+	if err != nil {
+		return fmt.Errorf("failed to get L2OutputOracle address: %w", err)
+	}
+	oracle, err := bindings.NewL2OutputOracleCaller(oracleAddr, l1)
+	if err != nil {
+		return err
+	}
+
+	rollupRPC, err := rpc.DialContext(ctx.Context, ctx.String("rollup-rpc-url"))
+	if err != nil {
+		return fmt.Errorf("cannot dial rollup RPC: %w", err)
+	}
+	defer rollupRPC.Close()
+	rollup := sources.NewRollupClient(client.NewBaseRPCClient(rollupRPC))
+
+	params, err := withdrawals.BuildProveParameters(ctx.Context, l2, rollup, oracle, txHash)
+	if err != nil {
+		return err
+	}
+	data, err := params.ProveCalldata()
+	if err != nil {
+		return err
+	}
+	log.Info("Built prove calldata", "l2OutputIndex", params.L2OutputIndex, "proofNodes", len(params.WithdrawalProof))
+	return writeCalldata(ctx, &Calldata{To: portalAddr, Data: data, Parameters: params})
+}
+
+func finalize(ctx *cli.Context) error {
+	portalAddr, txHash, err := parseArgs(ctx)
+	if err != nil {
+		return err
+	}
+	l2, err := dialL2(ctx)
+	if err != nil {
+		return err
+	}
+	defer l2.Close()
+
+	receipt, err := l2.TransactionReceipt(ctx.Context, txHash)
+	if err != nil {
+		return fmt.Errorf("failed to get withdrawal receipt: %w", err)
+	}
+	w, err := withdrawals.WithdrawalFromReceipt(receipt)
+	if err != nil {
+		return err
+	}
+	data, err := withdrawals.FinalizeCalldata(w)
+	if err != nil {
+		return err
+	}
+	log.Info("Built finalize calldata", "nonce", w.Nonce)
+	return writeCalldata(ctx, &Calldata{To: portalAddr, Data: data})
+}
+
+func parseArgs(ctx *cli.Context) (common.Address, common.Hash, error) {
+	portal := ctx.String("portal-address")
+	if !common.IsHexAddress(portal) {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid portal address: %s", portal)
+	}
+	var txHash common.Hash
+	if err := txHash.UnmarshalText([]byte(ctx.String("tx-hash"))); err != nil {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid tx hash: %w", err)
+	}
+	return common.HexToAddress(portal), txHash, nil
+}
+
+func dialL2(ctx *cli.Context) (*l2Client, error) {
+	rpcClient, err := rpc.DialContext(ctx.Context, ctx.String("l2-rpc-url"))
+	if err != nil {
+		return nil, fmt.Errorf("cannot dial L2 RPC: %w", err)
+	}
+	return &l2Client{
+		rpc:  rpcClient,
+		eth:  ethclient.NewClient(rpcClient),
+		geth: gethclient.New(rpcClient),
+	}, nil
+}
+
+func writeCalldata(ctx *cli.Context, calldata *Calldata) error {
+	var out io.Writer = os.Stdout
+	if path := ctx.Path("outfile"); path != "" {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(calldata)
+}
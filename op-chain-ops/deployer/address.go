@@ -0,0 +1,155 @@
+package deployer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+)
+
+// Scheme is the opcode that a contract is deployed with, which determines its address.
+type Scheme string
+
+const (
+	SchemeCreate  Scheme = "create"
+	SchemeCreate2 Scheme = "create2"
+)
+
+// DeterministicDeployment is a contract deployment of which the address can be computed ahead of time.
+// CREATE addresses depend on the deployer and its nonce, CREATE2 addresses depend on the deployer,
+// the salt and the init code.
+type DeterministicDeployment struct {
+	Name     string         `json:"name"`
+	Scheme   Scheme         `json:"scheme"`
+	Deployer common.Address `json:"deployer"`
+	Nonce    uint64         `json:"nonce,omitempty"`
+	Salt     common.Hash    `json:"salt,omitempty"`
+	// Contract is the name of the contract in op-bindings to take the init code from, if InitCode is not set.
+	Contract string        `json:"contract,omitempty"`
+	InitCode hexutil.Bytes `json:"initCode,omitempty"`
+	// ConstructorArgs are the ABI encoded constructor arguments, which are appended to the init code.
+	ConstructorArgs hexutil.Bytes `json:"constructorArgs,omitempty"`
+	// Address is the expected address of the deployment.
+	Address common.Address `json:"address"`
+	// CodeHash is the expected hash of the deployed code, if it is known.
+	CodeHash *common.Hash `json:"codeHash,omitempty"`
+}
+
+// NewDeterministicDeployments reads a JSON list of deterministic deployments from disk.
+func NewDeterministicDeployments(path string) ([]DeterministicDeployment, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("deployments at %s not found: %w", path, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(file))
+	dec.DisallowUnknownFields()
+	var deployments []DeterministicDeployment
+	if err := dec.Decode(&deployments); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal deployments: %w", err)
+	}
+	return deployments, nil
+}
+
+// CreateAddress returns the address of a contract that is deployed with CREATE.
+func CreateAddress(deployer common.Address, nonce uint64) common.Address {
+	return crypto.CreateAddress(deployer, nonce)
+}
+
+// Create2Address returns the address of a contract that is deployed with CREATE2.
+func Create2Address(deployer common.Address, salt common.Hash, initCode []byte) common.Address {
+	return crypto.CreateAddress2(deployer, salt, crypto.Keccak256(initCode))
+}
+
+// FullInitCode returns the init code of the deployment, including the constructor arguments.
+func (d *DeterministicDeployment) FullInitCode() ([]byte, error) {
+	initCode := d.InitCode
+	if len(initCode) == 0 {
+		if d.Contract == "" {
+			return nil, errors.New("one of contract or initCode is required")
+		}
+		code, err := bindings.GetInitBytecode(d.Contract)
+		if err != nil {
+			return nil, err
+		}
+		initCode = code
+	} else if d.Contract != "" {
+		return nil, errors.New("only one of contract or initCode can be set")
+	}
+	return append(common.CopyBytes(initCode), d.ConstructorArgs...), nil
+}
+
+// ComputeAddress computes the address of the deployment.
+func (d *DeterministicDeployment) ComputeAddress() (common.Address, error) {
+	switch d.Scheme {
+	case SchemeCreate:
+		return CreateAddress(d.Deployer, d.Nonce), nil
+	case SchemeCreate2:
+		initCode, err := d.FullInitCode()
+		if err != nil {
+			return common.Address{}, err
+		}
+		return Create2Address(d.Deployer, d.Salt, initCode), nil
+	default:
+		return common.Address{}, fmt.Errorf("unknown deployment scheme %q", d.Scheme)
+	}
+}
+
+// CodeClient reads the code of accounts. It is implemented by ethclient.Client.
+type CodeClient interface {
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+}
+
+// VerifyResult is the result of verifying a deterministic deployment.
+type VerifyResult struct {
+	Name     string         `json:"name"`
+	Address  common.Address `json:"address"`
+	Computed common.Address `json:"computed"`
+	Deployed bool           `json:"deployed"`
+	CodeHash common.Hash    `json:"codeHash,omitempty"`
+	Problems []string       `json:"problems,omitempty"`
+}
+
+// OK returns true if the deployment is at its expected address, with its expected code.
+func (r *VerifyResult) OK() bool {
+	return len(r.Problems) == 0
+}
+
+// VerifyDeployments verifies that the deployments are at their computed addresses on a live chain.
+func VerifyDeployments(ctx context.Context, client CodeClient, deployments []DeterministicDeployment) ([]VerifyResult, error) {
+	results := make([]VerifyResult, 0, len(deployments))
+	for i := range deployments {
+		d := &deployments[i]
+		computed, err := d.ComputeAddress()
+		if err != nil {
+			return nil, fmt.Errorf("invalid deployment %s: %w", d.Name, err)
+		}
+		result := VerifyResult{Name: d.Name, Address: d.Address, Computed: computed}
+		if computed != d.Address {
+			result.Problems = append(result.Problems, fmt.Sprintf("computed address %s does not match expected address %s", computed, d.Address))
+		}
+		code, err := client.CodeAt(ctx, computed, nil)
+		if err != nil {
+			return nil, fmt.Errorf("cannot fetch code of %s at %s: %w", d.Name, computed, err)
+		}
+		result.Deployed = len(code) > 0
+		if !result.Deployed {
+			result.Problems = append(result.Problems, fmt.Sprintf("no code at %s", computed))
+		} else {
+			result.CodeHash = crypto.Keccak256Hash(code)
+			if d.CodeHash != nil && *d.CodeHash != result.CodeHash {
+				result.Problems = append(result.Problems, fmt.Sprintf("code hash %s does not match expected code hash %s", result.CodeHash, *d.CodeHash))
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,103 @@
+package deployer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+)
+
+// TestCreate2Address checks the examples of EIP-1014.
+func TestCreate2Address(t *testing.T) {
+	tests := []struct {
+		deployer string
+		salt     string
+		initCode []byte
+		address  string
+	}{
+		{"0x0000000000000000000000000000000000000000", "0x00", []byte{0x00}, "0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38"},
+		{"0xdeadbeef00000000000000000000000000000000", "0x00", []byte{0x00}, "0xB928f69Bb1D91Cd65274e3c79d8986362984fDA3"},
+		{"0x00000000000000000000000000000000deadbeef", "0xcafebabe", common.FromHex("0xdeadbeef"), "0x60f3f640a8508fC6a86d45DF051962668E1e8AC7"},
+		{"0x0000000000000000000000000000000000000000", "0x00", []byte{}, "0xE33C0C7F7df4809055C3ebA6c09CFe4BaF1BD9e0"},
+	}
+	for _, test := range tests {
+		salt := common.HexToHash(test.salt)
+		require.Equal(t, common.HexToAddress(test.address), Create2Address(common.HexToAddress(test.deployer), salt, test.initCode))
+	}
+}
+
+func TestComputeAddress(t *testing.T) {
+	initCode, err := bindings.GetInitBytecode("Proxy")
+	require.NoError(t, err)
+	require.Equal(t, common.FromHex(bindings.ProxyMetaData.Bin), initCode)
+
+	args := common.LeftPadBytes([]byte{0x01}, 32)
+	d := DeterministicDeployment{
+		Scheme:          SchemeCreate2,
+		Deployer:        common.Address{1},
+		Salt:            common.Hash{2},
+		Contract:        "Proxy",
+		ConstructorArgs: args,
+	}
+	addr, err := d.ComputeAddress()
+	require.NoError(t, err)
+	require.Equal(t, crypto.CreateAddress2(common.Address{1}, common.Hash{2}, crypto.Keccak256(initCode, args)), addr)
+
+	d.InitCode = initCode
+	_, err = d.ComputeAddress()
+	require.ErrorContains(t, err, "only one of contract or initCode")
+	d.Contract = ""
+	inlined, err := d.ComputeAddress()
+	require.NoError(t, err)
+	require.Equal(t, addr, inlined)
+
+	d.Contract, d.InitCode = "NotAContract", nil
+	_, err = d.ComputeAddress()
+	require.ErrorContains(t, err, "init bytecode not found")
+
+	d.Scheme = "create3"
+	_, err = d.ComputeAddress()
+	require.ErrorContains(t, err, "unknown deployment scheme")
+}
+
+func TestVerifyDeployments(t *testing.T) {
+	backend := NewL1Backend()
+	opts, err := bind.NewKeyedTransactorWithChainID(TestKey, ChainID)
+	require.NoError(t, err)
+	deployed, _, _, err := bindings.DeployProxy(opts, backend, TestAddress)
+	require.NoError(t, err)
+	backend.Commit()
+	code, err := backend.CodeAt(context.Background(), deployed, nil)
+	require.NoError(t, err)
+	codeHash := crypto.Keccak256Hash(code)
+	wrongHash := common.Hash{1}
+
+	deployments := []DeterministicDeployment{
+		{Name: "Proxy", Scheme: SchemeCreate, Deployer: TestAddress, Nonce: 0, Address: deployed, CodeHash: &codeHash},
+		{Name: "WrongNonce", Scheme: SchemeCreate, Deployer: TestAddress, Nonce: 1, Address: deployed},
+		{Name: "WrongCode", Scheme: SchemeCreate, Deployer: TestAddress, Nonce: 0, Address: deployed, CodeHash: &wrongHash},
+	}
+	results, err := VerifyDeployments(context.Background(), backend, deployments)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	require.True(t, results[0].OK(), results[0].Problems)
+	require.Equal(t, deployed, results[0].Computed)
+	require.Equal(t, codeHash, results[0].CodeHash)
+
+	require.False(t, results[1].OK())
+	require.False(t, results[1].Deployed)
+	require.Len(t, results[1].Problems, 2)
+
+	require.True(t, results[2].Deployed)
+	require.Equal(t, []string{"code hash " + codeHash.String() + " does not match expected code hash " + wrongHash.String()}, results[2].Problems)
+
+	_, err = VerifyDeployments(context.Background(), backend, []DeterministicDeployment{{Name: "Invalid", Scheme: SchemeCreate2}})
+	require.ErrorContains(t, err, "invalid deployment Invalid")
+}
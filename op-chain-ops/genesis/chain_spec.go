@@ -0,0 +1,353 @@
+package genesis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Defaults of the optional chain spec fields, these match the standard OP Stack chain configuration.
+const (
+	defaultL1BlockTime                      = 12
+	defaultL2BlockTime                      = 2
+	defaultMaxSequencerDrift                = 600
+	defaultSequencerWindowSize              = 3600
+	defaultChannelTimeout                   = 300
+	defaultFinalizationPeriodSeconds        = 604800
+	defaultL2OutputOracleSubmissionInterval = 120
+	defaultGasPriceOracleOverhead           = 188
+	defaultGasPriceOracleScalar             = 684_000
+	defaultEIP1559Denominator               = 50
+	defaultEIP1559Elasticity                = 6
+	defaultDeploymentWaitConfirmations      = 1
+)
+
+var (
+	// defaultMinimumWithdrawalAmount is 10 ETH
+	defaultMinimumWithdrawalAmount = new(big.Int).Mul(big.NewInt(10), big.NewInt(params.Ether))
+	// defaultWithdrawalNetwork sends the fee vault withdrawals to L1
+	defaultWithdrawalNetwork = WithdrawalNetwork("remote")
+)
+
+// ChainSpec is the declarative description of a new OP Stack chain. It holds only the choices that
+// differ between chains, and is converted into the deploy config that the L1 contracts are deployed
+// with and that the L2 genesis and rollup config are generated from.
+// Optional fields are left at their zero value to use the standard configuration.
+type ChainSpec struct {
+	L1ChainID uint64 `json:"l1ChainID" toml:"l1-chain-id"`
+	L2ChainID uint64 `json:"l2ChainID" toml:"l2-chain-id"`
+	// L1StartingBlockTag is the L1 block, by number or hash, that the L2 chain starts at.
+	L1StartingBlockTag string `json:"l1StartingBlockTag" toml:"l1-starting-block-tag"`
+	// L1BlockTime is optional, and defaults to 12 seconds.
+	L1BlockTime uint64 `json:"l1BlockTime,omitempty" toml:"l1-block-time"`
+	// L2BlockTime is optional, and defaults to 2 seconds.
+	L2BlockTime uint64 `json:"l2BlockTime,omitempty" toml:"l2-block-time"`
+	// MaxSequencerDrift is optional, and defaults to 600 seconds.
+	MaxSequencerDrift uint64 `json:"maxSequencerDrift,omitempty" toml:"max-sequencer-drift"`
+	// SequencerWindowSize is optional, and defaults to 3600 L1 blocks.
+	SequencerWindowSize uint64 `json:"sequencerWindowSize,omitempty" toml:"sequencer-window-size"`
+	// ChannelTimeout is optional, and defaults to 300 L1 blocks.
+	ChannelTimeout uint64 `json:"channelTimeout,omitempty" toml:"channel-timeout"`
+	// FinalizationPeriodSeconds is optional, and defaults to 7 days.
+	FinalizationPeriodSeconds uint64 `json:"finalizationPeriodSeconds,omitempty" toml:"finalization-period-seconds"`
+	// L2OutputOracleSubmissionInterval is optional, and defaults to 120 L2 blocks.
+	L2OutputOracleSubmissionInterval uint64 `json:"l2OutputOracleSubmissionInterval,omitempty" toml:"l2-output-oracle-submission-interval"`
+	// L2GenesisBlockGasLimit is optional, and defaults to the gas limit of the L2 genesis.
+	L2GenesisBlockGasLimit uint64 `json:"l2GenesisBlockGasLimit,omitempty" toml:"l2-genesis-block-gas-limit"`
+	// BatchInboxAddress is optional, and defaults to 0xff00..00 followed by the L2 chain ID.
+	BatchInboxAddress common.Address `json:"batchInboxAddress,omitempty" toml:"batch-inbox-address"`
+
+	Forks      ChainSpecForks      `json:"forks" toml:"forks"`
+	Fees       ChainSpecFees       `json:"fees" toml:"fees"`
+	Roles      ChainSpecRoles      `json:"roles" toml:"roles"`
+	Governance ChainSpecGovernance `json:"governance" toml:"governance"`
+}
+
+// ChainSpecForks are the activation times of the L2 forks, in seconds after the L2 genesis.
+// A fork that is not set is not scheduled, and 0 activates the fork at genesis.
+type ChainSpecForks struct {
+	Regolith  *uint64 `json:"regolith,omitempty" toml:"regolith"`
+	Canyon    *uint64 `json:"canyon,omitempty" toml:"canyon"`
+	SpanBatch *uint64 `json:"spanBatch,omitempty" toml:"span-batch"`
+}
+
+// ChainSpecFees configure the L1 data fee, the EIP-1559 parameters and the fee vaults of the L2 chain.
+// All fields are optional.
+type ChainSpecFees struct {
+	GasPriceOracleOverhead      uint64       `json:"gasPriceOracleOverhead,omitempty" toml:"gas-price-oracle-overhead"`
+	GasPriceOracleScalar        uint64       `json:"gasPriceOracleScalar,omitempty" toml:"gas-price-oracle-scalar"`
+	EIP1559Denominator          uint64       `json:"eip1559Denominator,omitempty" toml:"eip1559-denominator"`
+	EIP1559Elasticity           uint64       `json:"eip1559Elasticity,omitempty" toml:"eip1559-elasticity"`
+	L2GenesisBlockBaseFeePerGas *hexutil.Big `json:"l2GenesisBlockBaseFeePerGas,omitempty" toml:"l2-genesis-block-base-fee-per-gas"`
+	// VaultMinimumWithdrawalAmount applies to all fee vaults, and defaults to 10 ETH.
+	VaultMinimumWithdrawalAmount *hexutil.Big `json:"vaultMinimumWithdrawalAmount,omitempty" toml:"vault-minimum-withdrawal-amount"`
+	// VaultWithdrawalNetwork applies to all fee vaults, and defaults to L1 ("remote").
+	VaultWithdrawalNetwork WithdrawalNetwork `json:"vaultWithdrawalNetwork,omitempty" toml:"vault-withdrawal-network"`
+}
+
+// ChainSpecRoles are the accounts that operate and own the chain.
+type ChainSpecRoles struct {
+	ProxyAdminOwner  common.Address `json:"proxyAdminOwner" toml:"proxy-admin-owner"`
+	FinalSystemOwner common.Address `json:"finalSystemOwner" toml:"final-system-owner"`
+	Guardian         common.Address `json:"guardian" toml:"guardian"`
+	Sequencer        common.Address `json:"sequencer" toml:"sequencer"`
+	Batcher          common.Address `json:"batcher" toml:"batcher"`
+	Proposer         common.Address `json:"proposer" toml:"proposer"`
+	Challenger       common.Address `json:"challenger" toml:"challenger"`
+	// FeeRecipient receives the fees of the vaults that do not have their own recipient set.
+	FeeRecipient               common.Address `json:"feeRecipient,omitempty" toml:"fee-recipient"`
+	BaseFeeVaultRecipient      common.Address `json:"baseFeeVaultRecipient,omitempty" toml:"base-fee-vault-recipient"`
+	L1FeeVaultRecipient        common.Address `json:"l1FeeVaultRecipient,omitempty" toml:"l1-fee-vault-recipient"`
+	SequencerFeeVaultRecipient common.Address `json:"sequencerFeeVaultRecipient,omitempty" toml:"sequencer-fee-vault-recipient"`
+}
+
+// ChainSpecGovernance configures the governance token predeploy.
+type ChainSpecGovernance struct {
+	Enabled     bool           `json:"enabled" toml:"enabled"`
+	TokenName   string         `json:"tokenName,omitempty" toml:"token-name"`
+	TokenSymbol string         `json:"tokenSymbol,omitempty" toml:"token-symbol"`
+	TokenOwner  common.Address `json:"tokenOwner,omitempty" toml:"token-owner"`
+}
+
+// NewChainSpec reads a chain spec from a TOML file, if the path has the .toml extension, or from a JSON file otherwise.
+func NewChainSpec(path string) (*ChainSpec, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("chain spec at %s not found: %w", path, err)
+	}
+
+	var spec ChainSpec
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		md, err := toml.Decode(string(file), &spec)
+		if err != nil {
+			return nil, fmt.Errorf("cannot unmarshal chain spec: %w", err)
+		}
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			return nil, fmt.Errorf("cannot unmarshal chain spec: unknown fields %v", undecoded)
+		}
+		return &spec, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(file))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&spec); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal chain spec: %w", err)
+	}
+	return &spec, nil
+}
+
+// DefaultBatchInboxAddress returns the conventional batch inbox address of a chain:
+// 0xff, followed by zeros, followed by the L2 chain ID.
+func DefaultBatchInboxAddress(l2ChainID uint64) common.Address {
+	var addr common.Address
+	addr[0] = 0xff
+	new(big.Int).SetUint64(l2ChainID).FillBytes(addr[12:])
+	return addr
+}
+
+// DeployConfig converts the chain spec into a deploy config, with the defaults filled in.
+// The deploy config is not checked, see Validate.
+func (s *ChainSpec) DeployConfig() (*DeployConfig, error) {
+	var tag *MarshalableRPCBlockNumberOrHash
+	if s.L1StartingBlockTag != "" {
+		tag = new(MarshalableRPCBlockNumberOrHash)
+		if err := tag.UnmarshalJSON([]byte(fmt.Sprintf("%q", s.L1StartingBlockTag))); err != nil {
+			return nil, fmt.Errorf("invalid L1 starting block tag %q: %w", s.L1StartingBlockTag, err)
+		}
+	}
+	batchInbox := s.BatchInboxAddress
+	if batchInbox == (common.Address{}) {
+		batchInbox = DefaultBatchInboxAddress(s.L2ChainID)
+	}
+	minWithdrawal := s.Fees.VaultMinimumWithdrawalAmount
+	if minWithdrawal == nil {
+		minWithdrawal = (*hexutil.Big)(defaultMinimumWithdrawalAmount)
+	}
+	withdrawalNetwork := s.Fees.VaultWithdrawalNetwork
+	if withdrawalNetwork == "" {
+		withdrawalNetwork = defaultWithdrawalNetwork
+	}
+
+	return &DeployConfig{
+		L1StartingBlockTag:                       tag,
+		L1ChainID:                                s.L1ChainID,
+		L2ChainID:                                s.L2ChainID,
+		L2BlockTime:                              orDefault(s.L2BlockTime, defaultL2BlockTime),
+		FinalizationPeriodSeconds:                orDefault(s.FinalizationPeriodSeconds, defaultFinalizationPeriodSeconds),
+		MaxSequencerDrift:                        orDefault(s.MaxSequencerDrift, defaultMaxSequencerDrift),
+		SequencerWindowSize:                      orDefault(s.SequencerWindowSize, defaultSequencerWindowSize),
+		ChannelTimeout:                           orDefault(s.ChannelTimeout, defaultChannelTimeout),
+		P2PSequencerAddress:                      s.Roles.Sequencer,
+		BatchInboxAddress:                        batchInbox,
+		BatchSenderAddress:                       s.Roles.Batcher,
+		L2OutputOracleSubmissionInterval:         orDefault(s.L2OutputOracleSubmissionInterval, defaultL2OutputOracleSubmissionInterval),
+		L2OutputOracleStartingTimestamp:          -1, // the L2 genesis is the L1 starting block
+		L2OutputOracleProposer:                   s.Roles.Proposer,
+		L2OutputOracleChallenger:                 s.Roles.Challenger,
+		L1BlockTime:                              orDefault(s.L1BlockTime, defaultL1BlockTime),
+		L2GenesisBlockGasLimit:                   hexutil.Uint64(orDefault(s.L2GenesisBlockGasLimit, defaultGasLimit)),
+		L2GenesisBlockBaseFeePerGas:              orDefaultBig(s.Fees.L2GenesisBlockBaseFeePerGas, big.NewInt(params.InitialBaseFee)),
+		L2GenesisRegolithTimeOffset:              (*hexutil.Uint64)(s.Forks.Regolith),
+		L2GenesisCanyonTimeOffset:                (*hexutil.Uint64)(s.Forks.Canyon),
+		L2GenesisSpanBatchTimeOffset:             (*hexutil.Uint64)(s.Forks.SpanBatch),
+		ProxyAdminOwner:                          s.Roles.ProxyAdminOwner,
+		FinalSystemOwner:                         s.Roles.FinalSystemOwner,
+		PortalGuardian:                           s.Roles.Guardian,
+		BaseFeeVaultRecipient:                    orDefaultAddress(s.Roles.BaseFeeVaultRecipient, s.Roles.FeeRecipient),
+		L1FeeVaultRecipient:                      orDefaultAddress(s.Roles.L1FeeVaultRecipient, s.Roles.FeeRecipient),
+		SequencerFeeVaultRecipient:               orDefaultAddress(s.Roles.SequencerFeeVaultRecipient, s.Roles.FeeRecipient),
+		BaseFeeVaultMinimumWithdrawalAmount:      minWithdrawal,
+		L1FeeVaultMinimumWithdrawalAmount:        minWithdrawal,
+		SequencerFeeVaultMinimumWithdrawalAmount: minWithdrawal,
+		BaseFeeVaultWithdrawalNetwork:            withdrawalNetwork,
+		L1FeeVaultWithdrawalNetwork:              withdrawalNetwork,
+		SequencerFeeVaultWithdrawalNetwork:       withdrawalNetwork,
+		GasPriceOracleOverhead:                   orDefault(s.Fees.GasPriceOracleOverhead, defaultGasPriceOracleOverhead),
+		GasPriceOracleScalar:                     orDefault(s.Fees.GasPriceOracleScalar, defaultGasPriceOracleScalar),
+		EnableGovernance:                         s.Governance.Enabled,
+		GovernanceTokenSymbol:                    s.Governance.TokenSymbol,
+		GovernanceTokenName:                      s.Governance.TokenName,
+		GovernanceTokenOwner:                     s.Governance.TokenOwner,
+		DeploymentWaitConfirmations:              defaultDeploymentWaitConfirmations,
+		EIP1559Elasticity:                        orDefault(s.Fees.EIP1559Elasticity, defaultEIP1559Elasticity),
+		EIP1559Denominator:                       orDefault(s.Fees.EIP1559Denominator, defaultEIP1559Denominator),
+	}, nil
+}
+
+// Validate checks the invariants of the chain spec, and of the deploy config that it converts into.
+// Problems with the chain spec itself are reported on the JSON names of the chain spec fields,
+// and problems with the deploy config on the names of the deploy config fields.
+func (s *ChainSpec) Validate() ValidationErrors {
+	var v validator
+	config, err := s.DeployConfig()
+	if err != nil {
+		v.add("l1StartingBlockTag", ValidationInvalid, SeverityError, "%v", err)
+		return v.results
+	}
+	if s.L1ChainID != 0 && s.L1ChainID == s.L2ChainID {
+		v.add("l2ChainID", ValidationInvariant, SeverityError, "L2 chain ID (%d) is the same as the L1 chain ID", s.L2ChainID)
+	}
+	s.validateForks(&v)
+	s.validateRoles(&v, config)
+	return append(v.results, config.Validate()...)
+}
+
+// Check will ensure that the chain spec is sane and return an error when it is not.
+// It returns the first problem found by Validate, and logs the warnings.
+func (s *ChainSpec) Check() error {
+	results := s.Validate()
+	for _, w := range results.Warnings() {
+		log.Warn(w.Message, "field", w.Field)
+	}
+	if errs := results.Errors(); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// validateForks checks that the forks are scheduled in the order that they build upon each other:
+// a fork can only be scheduled if the forks before it are, and cannot activate before them.
+// The deploy config already checks the forks against Regolith, so only the later forks are checked here.
+func (s *ChainSpec) validateForks(v *validator) {
+	forks := []struct {
+		field  string
+		name   string
+		offset *uint64
+	}{
+		{"forks.regolith", "Regolith", s.Forks.Regolith},
+		{"forks.canyon", "Canyon", s.Forks.Canyon},
+		{"forks.spanBatch", "SpanBatch", s.Forks.SpanBatch},
+	}
+	for i := 2; i < len(forks); i++ {
+		prev, fork := forks[i-1], forks[i]
+		if fork.offset == nil {
+			continue
+		}
+		if prev.offset == nil {
+			v.add(fork.field, ValidationInvariant, SeverityError, "%s is scheduled, but %s is not", fork.name, prev.name)
+		} else if *fork.offset < *prev.offset {
+			v.add(fork.field, ValidationInvariant, SeverityError, "%s time offset (%d) is before the %s time offset (%d)", fork.name, *fork.offset, prev.name, *prev.offset)
+		}
+	}
+}
+
+// validateRoles checks that the roles are consistent with each other:
+// the hot keys of the chain operators cannot be shared, nor be used to own the chain,
+// and the batch inbox cannot be an account of any of the roles.
+func (s *ChainSpec) validateRoles(v *validator, config *DeployConfig) {
+	hot := []struct {
+		field string
+		name  string
+		addr  common.Address
+	}{
+		{"roles.sequencer", "sequencer", s.Roles.Sequencer},
+		{"roles.batcher", "batcher", s.Roles.Batcher},
+		{"roles.proposer", "proposer", s.Roles.Proposer},
+	}
+	owners := []struct {
+		field string
+		name  string
+		addr  common.Address
+	}{
+		{"roles.proxyAdminOwner", "proxy admin owner", s.Roles.ProxyAdminOwner},
+		{"roles.finalSystemOwner", "final system owner", s.Roles.FinalSystemOwner},
+		{"roles.guardian", "guardian", s.Roles.Guardian},
+		{"roles.challenger", "challenger", s.Roles.Challenger},
+	}
+	for i, a := range hot {
+		if a.addr == (common.Address{}) {
+			continue
+		}
+		for _, b := range hot[i+1:] {
+			if a.addr == b.addr {
+				v.add(b.field, ValidationInvariant, SeverityError, "%s (%s) is the same account as the %s", b.name, b.addr, a.name)
+			}
+		}
+		for _, o := range owners {
+			if a.addr == o.addr {
+				v.add(o.field, ValidationInvariant, SeverityWarning, "%s (%s) is the same account as the %s, which is a hot key", o.name, o.addr, a.name)
+			}
+		}
+	}
+	inbox := config.BatchInboxAddress
+	for _, r := range append(hot, owners...) {
+		if r.addr == inbox {
+			v.add("batchInboxAddress", ValidationInvariant, SeverityError, "batch inbox (%s) is the same account as the %s", inbox, r.name)
+		}
+	}
+	if s.BatchInboxAddress != (common.Address{}) && s.BatchInboxAddress != DefaultBatchInboxAddress(s.L2ChainID) {
+		v.add("batchInboxAddress", ValidationInvariant, SeverityWarning, "batch inbox (%s) is not the conventional address %s", s.BatchInboxAddress, DefaultBatchInboxAddress(s.L2ChainID))
+	}
+}
+
+func orDefault(v uint64, def uint64) uint64 {
+	if v == 0 {
+		return def
+	}
+	return v
+}
+
+func orDefaultBig(v *hexutil.Big, def *big.Int) *hexutil.Big {
+	if v == nil {
+		return (*hexutil.Big)(def)
+	}
+	return v
+}
+
+func orDefaultAddress(v common.Address, def common.Address) common.Address {
+	if v == (common.Address{}) {
+		return def
+	}
+	return v
+}
@@ -0,0 +1,130 @@
+package genesis
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestNewChainSpec(t *testing.T) {
+	fromTOML, err := NewChainSpec("./testdata/chain-spec.toml")
+	require.NoError(t, err)
+	fromJSON, err := NewChainSpec("./testdata/chain-spec.json")
+	require.NoError(t, err)
+	require.Equal(t, fromJSON, fromTOML)
+	require.Equal(t, uint64(3600), *fromTOML.Forks.SpanBatch)
+	require.Equal(t, common.HexToAddress("0x3c44cdddb6a900fa2b585dd299e03d12fa4293bc"), fromTOML.Roles.Batcher)
+}
+
+func TestChainSpecDeployConfig(t *testing.T) {
+	spec, err := NewChainSpec("./testdata/chain-spec.toml")
+	require.NoError(t, err)
+	require.NoError(t, spec.Check())
+
+	config, err := spec.DeployConfig()
+	require.NoError(t, err)
+	require.NoError(t, config.Check())
+	require.Equal(t, common.HexToAddress("0xff00000000000000000000000000000000000385"), config.BatchInboxAddress)
+	require.Equal(t, spec.Roles.FeeRecipient, config.BaseFeeVaultRecipient)
+	require.Equal(t, spec.Roles.FeeRecipient, config.SequencerFeeVaultRecipient)
+	require.Equal(t, WithdrawalNetwork("local"), config.L1FeeVaultWithdrawalNetwork)
+	require.Equal(t, uint64(defaultSequencerWindowSize), config.SequencerWindowSize)
+	require.Equal(t, uint64(1000000), config.GasPriceOracleScalar)
+
+	// once the L1 contracts are deployed, the L2 genesis and rollup config can be generated
+	deployments, err := NewL1Deployments("./testdata/l1-deployments.json")
+	require.NoError(t, err)
+	config.SetDeployments(deployments)
+	require.NoError(t, config.CheckAddresses())
+	l1StartBlock := types.NewBlockWithHeader(&types.Header{
+		Number:  big.NewInt(0),
+		Time:    1000,
+		BaseFee: big.NewInt(1_000_000_000),
+	})
+	l2Genesis, err := BuildL2Genesis(config, l1StartBlock)
+	require.NoError(t, err)
+	l2GenesisBlock := l2Genesis.ToBlock()
+	rollupConfig, err := config.RollupConfig(l1StartBlock, l2GenesisBlock.Hash(), l2GenesisBlock.Number().Uint64())
+	require.NoError(t, err)
+	require.NoError(t, rollupConfig.Check())
+	require.Equal(t, uint64(1000+3600), *rollupConfig.SpanBatchTime)
+}
+
+func TestChainSpecValidate(t *testing.T) {
+	u64 := func(v uint64) *uint64 { return &v }
+	tests := []struct {
+		name   string
+		modify func(s *ChainSpec)
+		field  string
+		code   ValidationCode
+	}{
+		{
+			name:   "invalid starting block tag",
+			modify: func(s *ChainSpec) { s.L1StartingBlockTag = "genesis" },
+			field:  "l1StartingBlockTag",
+			code:   ValidationInvalid,
+		},
+		{
+			name:   "same chain IDs",
+			modify: func(s *ChainSpec) { s.L2ChainID = s.L1ChainID },
+			field:  "l2ChainID",
+			code:   ValidationInvariant,
+		},
+		{
+			name:   "span batch before canyon",
+			modify: func(s *ChainSpec) { s.Forks.Canyon = u64(100); s.Forks.SpanBatch = u64(50) },
+			field:  "forks.spanBatch",
+			code:   ValidationInvariant,
+		},
+		{
+			name:   "span batch without canyon",
+			modify: func(s *ChainSpec) { s.Forks.Canyon = nil },
+			field:  "forks.spanBatch",
+			code:   ValidationInvariant,
+		},
+		{
+			name:   "shared batcher and proposer",
+			modify: func(s *ChainSpec) { s.Roles.Proposer = s.Roles.Batcher },
+			field:  "roles.proposer",
+			code:   ValidationInvariant,
+		},
+		{
+			name:   "batch inbox is a role",
+			modify: func(s *ChainSpec) { s.BatchInboxAddress = s.Roles.Guardian },
+			field:  "batchInboxAddress",
+			code:   ValidationInvariant,
+		},
+		{
+			name:   "missing role",
+			modify: func(s *ChainSpec) { s.Roles.Batcher = common.Address{} },
+			field:  "batchSenderAddress",
+			code:   ValidationRequired,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			spec, err := NewChainSpec("./testdata/chain-spec.toml")
+			require.NoError(t, err)
+			test.modify(spec)
+			errs := spec.Validate().Errors()
+			require.NotEmpty(t, errs)
+			require.Equal(t, test.field, errs[0].Field)
+			require.Equal(t, test.code, errs[0].Code)
+			require.ErrorIs(t, spec.Check(), ErrInvalidDeployConfig)
+		})
+	}
+
+	t.Run("owner is a hot key", func(t *testing.T) {
+		spec, err := NewChainSpec("./testdata/chain-spec.toml")
+		require.NoError(t, err)
+		spec.Roles.Guardian = spec.Roles.Sequencer
+		results := spec.Validate()
+		require.Empty(t, results.Errors())
+		require.Equal(t, "roles.guardian", results.Warnings()[0].Field)
+	})
+}
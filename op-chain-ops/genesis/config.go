@@ -101,6 +101,9 @@ type DeployConfig struct {
 	L1GenesisBlockGasUsed       hexutil.Uint64 `json:"l1GenesisBlockGasUsed"`
 	L1GenesisBlockParentHash    common.Hash    `json:"l1GenesisBlockParentHash"`
 	L1GenesisBlockBaseFeePerGas *hexutil.Big   `json:"l1GenesisBlockBaseFeePerGas"`
+	// L1CancunTimeOffset is the number of seconds after the L1 genesis block that the L1 Cancun fork activates.
+	// Set it to 0 to activate at genesis. Nil to disable Cancun. Cancun is not supported with clique.
+	L1CancunTimeOffset *hexutil.Uint64 `json:"l1CancunTimeOffset,omitempty"`
 
 	L2GenesisBlockNonce         hexutil.Uint64 `json:"l2GenesisBlockNonce"`
 	L2GenesisBlockGasLimit      hexutil.Uint64 `json:"l2GenesisBlockGasLimit"`
@@ -232,120 +235,15 @@ func (d *DeployConfig) Copy() *DeployConfig {
 	return &cpy
 }
 
-// Check will ensure that the config is sane and return an error when it is not
+// Check will ensure that the config is sane and return an error when it is not.
+// It returns the first problem found by Validate, and logs the warnings.
 func (d *DeployConfig) Check() error {
-	if d.L1StartingBlockTag == nil {
-		return fmt.Errorf("%w: L1StartingBlockTag cannot be nil", ErrInvalidDeployConfig)
+	results := d.Validate()
+	for _, w := range results.Warnings() {
+		log.Warn(w.Message, "field", w.Field)
 	}
-	if d.L1ChainID == 0 {
-		return fmt.Errorf("%w: L1ChainID cannot be 0", ErrInvalidDeployConfig)
-	}
-	if d.L2ChainID == 0 {
-		return fmt.Errorf("%w: L2ChainID cannot be 0", ErrInvalidDeployConfig)
-	}
-	if d.L2BlockTime == 0 {
-		return fmt.Errorf("%w: L2BlockTime cannot be 0", ErrInvalidDeployConfig)
-	}
-	if d.FinalizationPeriodSeconds == 0 {
-		return fmt.Errorf("%w: FinalizationPeriodSeconds cannot be 0", ErrInvalidDeployConfig)
-	}
-	if d.L2OutputOracleStartingBlockNumber == 0 {
-		log.Warn("L2OutputOracleStartingBlockNumber is 0, should only be 0 for fresh chains")
-	}
-	if d.PortalGuardian == (common.Address{}) {
-		return fmt.Errorf("%w: PortalGuardian cannot be address(0)", ErrInvalidDeployConfig)
-	}
-	if d.MaxSequencerDrift == 0 {
-		return fmt.Errorf("%w: MaxSequencerDrift cannot be 0", ErrInvalidDeployConfig)
-	}
-	if d.SequencerWindowSize == 0 {
-		return fmt.Errorf("%w: SequencerWindowSize cannot be 0", ErrInvalidDeployConfig)
-	}
-	if d.ChannelTimeout == 0 {
-		return fmt.Errorf("%w: ChannelTimeout cannot be 0", ErrInvalidDeployConfig)
-	}
-	if d.P2PSequencerAddress == (common.Address{}) {
-		return fmt.Errorf("%w: P2PSequencerAddress cannot be address(0)", ErrInvalidDeployConfig)
-	}
-	if d.BatchInboxAddress == (common.Address{}) {
-		return fmt.Errorf("%w: BatchInboxAddress cannot be address(0)", ErrInvalidDeployConfig)
-	}
-	if d.BatchSenderAddress == (common.Address{}) {
-		return fmt.Errorf("%w: BatchSenderAddress cannot be address(0)", ErrInvalidDeployConfig)
-	}
-	if d.L2OutputOracleSubmissionInterval == 0 {
-		return fmt.Errorf("%w: L2OutputOracleSubmissionInterval cannot be 0", ErrInvalidDeployConfig)
-	}
-	if d.L2OutputOracleStartingTimestamp == 0 {
-		log.Warn("L2OutputOracleStartingTimestamp is 0")
-	}
-	if d.L2OutputOracleProposer == (common.Address{}) {
-		return fmt.Errorf("%w: L2OutputOracleProposer cannot be address(0)", ErrInvalidDeployConfig)
-	}
-	if d.L2OutputOracleChallenger == (common.Address{}) {
-		return fmt.Errorf("%w: L2OutputOracleChallenger cannot be address(0)", ErrInvalidDeployConfig)
-	}
-	if d.FinalSystemOwner == (common.Address{}) {
-		return fmt.Errorf("%w: FinalSystemOwner cannot be address(0)", ErrInvalidDeployConfig)
-	}
-	if d.ProxyAdminOwner == (common.Address{}) {
-		return fmt.Errorf("%w: ProxyAdminOwner cannot be address(0)", ErrInvalidDeployConfig)
-	}
-	if d.BaseFeeVaultRecipient == (common.Address{}) {
-		return fmt.Errorf("%w: BaseFeeVaultRecipient cannot be address(0)", ErrInvalidDeployConfig)
-	}
-	if d.L1FeeVaultRecipient == (common.Address{}) {
-		return fmt.Errorf("%w: L1FeeVaultRecipient cannot be address(0)", ErrInvalidDeployConfig)
-	}
-	if d.SequencerFeeVaultRecipient == (common.Address{}) {
-		return fmt.Errorf("%w: SequencerFeeVaultRecipient cannot be address(0)", ErrInvalidDeployConfig)
-	}
-	if !d.BaseFeeVaultWithdrawalNetwork.Valid() {
-		return fmt.Errorf("%w: BaseFeeVaultWithdrawalNetwork can only be 0 (L1) or 1 (L2)", ErrInvalidDeployConfig)
-	}
-	if !d.L1FeeVaultWithdrawalNetwork.Valid() {
-		return fmt.Errorf("%w: L1FeeVaultWithdrawalNetwork can only be 0 (L1) or 1 (L2)", ErrInvalidDeployConfig)
-	}
-	if !d.SequencerFeeVaultWithdrawalNetwork.Valid() {
-		return fmt.Errorf("%w: SequencerFeeVaultWithdrawalNetwork can only be 0 (L1) or 1 (L2)", ErrInvalidDeployConfig)
-	}
-	if d.GasPriceOracleOverhead == 0 {
-		log.Warn("GasPriceOracleOverhead is 0")
-	}
-	if d.GasPriceOracleScalar == 0 {
-		return fmt.Errorf("%w: GasPriceOracleScalar cannot be 0", ErrInvalidDeployConfig)
-	}
-	if d.EIP1559Denominator == 0 {
-		return fmt.Errorf("%w: EIP1559Denominator cannot be 0", ErrInvalidDeployConfig)
-	}
-	if d.EIP1559Elasticity == 0 {
-		return fmt.Errorf("%w: EIP1559Elasticity cannot be 0", ErrInvalidDeployConfig)
-	}
-	if d.L2GenesisBlockGasLimit == 0 {
-		return fmt.Errorf("%w: L2 genesis block gas limit cannot be 0", ErrInvalidDeployConfig)
-	}
-	// When the initial resource config is made to be configurable by the DeployConfig, ensure
-	// that this check is updated to use the values from the DeployConfig instead of the defaults.
-	if uint64(d.L2GenesisBlockGasLimit) < uint64(DefaultResourceConfig.MaxResourceLimit+DefaultResourceConfig.SystemTxMaxGas) {
-		return fmt.Errorf("%w: L2 genesis block gas limit is too small", ErrInvalidDeployConfig)
-	}
-	if d.L2GenesisBlockBaseFeePerGas == nil {
-		return fmt.Errorf("%w: L2 genesis block base fee per gas cannot be nil", ErrInvalidDeployConfig)
-	}
-	if d.EnableGovernance {
-		if d.GovernanceTokenName == "" {
-			return fmt.Errorf("%w: GovernanceToken.name cannot be empty", ErrInvalidDeployConfig)
-		}
-		if d.GovernanceTokenSymbol == "" {
-			return fmt.Errorf("%w: GovernanceToken.symbol cannot be empty", ErrInvalidDeployConfig)
-		}
-		if d.GovernanceTokenOwner == (common.Address{}) {
-			return fmt.Errorf("%w: GovernanceToken owner cannot be address(0)", ErrInvalidDeployConfig)
-		}
-	}
-	// L2 block time must always be smaller than L1 block time
-	if d.L1BlockTime < d.L2BlockTime {
-		return fmt.Errorf("L2 block time (%d) is larger than L1 block time (%d)", d.L2BlockTime, d.L1BlockTime)
+	if errs := results.Errors(); len(errs) > 0 {
+		return errs[0]
 	}
 	return nil
 }
@@ -641,18 +539,20 @@ func NewL1Deployments(path string) (*L1Deployments, error) {
 	return &deployments, nil
 }
 
-// NewStateDump will read a Dump JSON file from disk
+// NewStateDump will read a Dump JSON file from disk into memory.
+// Use StreamStateDump to process large dumps account by account instead.
 func NewStateDump(path string) (*gstate.Dump, error) {
-	file, err := os.ReadFile(path)
+	file, err := os.Open(path)
 	if err != nil {
 		return nil, fmt.Errorf("dump at %s not found: %w", path, err)
 	}
+	defer file.Close()
 
-	var dump gstate.Dump
-	if err := json.Unmarshal(file, &dump); err != nil {
+	dump, err := ReadStateDump(file)
+	if err != nil {
 		return nil, fmt.Errorf("cannot unmarshal dump: %w", err)
 	}
-	return &dump, nil
+	return dump, nil
 }
 
 // NewL2ImmutableConfig will create an ImmutableConfig given an instance of a
@@ -678,6 +578,15 @@ func NewL2ImmutableConfig(config *DeployConfig, block *types.Block) (immutables.
 	if config.L1FeeVaultRecipient == (common.Address{}) {
 		return immutable, fmt.Errorf("L1FeeVaultRecipient cannot be address(0): %w", ErrInvalidImmutablesConfig)
 	}
+	if config.SequencerFeeVaultMinimumWithdrawalAmount == nil {
+		return immutable, fmt.Errorf("SequencerFeeVaultMinimumWithdrawalAmount cannot be nil: %w", ErrInvalidImmutablesConfig)
+	}
+	if config.BaseFeeVaultMinimumWithdrawalAmount == nil {
+		return immutable, fmt.Errorf("BaseFeeVaultMinimumWithdrawalAmount cannot be nil: %w", ErrInvalidImmutablesConfig)
+	}
+	if config.L1FeeVaultMinimumWithdrawalAmount == nil {
+		return immutable, fmt.Errorf("L1FeeVaultMinimumWithdrawalAmount cannot be nil: %w", ErrInvalidImmutablesConfig)
+	}
 
 	immutable["L2StandardBridge"] = immutables.ImmutableValues{
 		"otherBridge": config.L1StandardBridgeProxy,
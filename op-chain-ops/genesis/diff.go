@@ -0,0 +1,164 @@
+package genesis
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// DiffField is the part of an account that differs between two allocs.
+type DiffField string
+
+const (
+	DiffAccount  DiffField = "account"
+	DiffBalance  DiffField = "balance"
+	DiffNonce    DiffField = "nonce"
+	DiffCodeHash DiffField = "codeHash"
+	DiffStorage  DiffField = "storage"
+)
+
+// AccountDiff is a difference of an account between two allocs. A and B are the
+// values of the field in each alloc. A missing account or storage slot is empty.
+type AccountDiff struct {
+	Address common.Address `json:"address"`
+	Field   DiffField      `json:"field"`
+	Slot    *common.Hash   `json:"slot,omitempty"`
+	A       string         `json:"a"`
+	B       string         `json:"b"`
+}
+
+// DiffAllocs returns the differences between two allocs, sorted by address.
+// A storage slot that is set to zero is equal to a slot that is not set.
+func DiffAllocs(a, b core.GenesisAlloc) []AccountDiff {
+	addrs := make(map[common.Address]struct{})
+	for addr := range a {
+		addrs[addr] = struct{}{}
+	}
+	for addr := range b {
+		addrs[addr] = struct{}{}
+	}
+	sorted := make([]common.Address, 0, len(addrs))
+	for addr := range addrs {
+		sorted = append(sorted, addr)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) < 0
+	})
+
+	diffs := make([]AccountDiff, 0)
+	for _, addr := range sorted {
+		accountA, okA := a[addr]
+		accountB, okB := b[addr]
+		if okA != okB {
+			diffs = append(diffs, AccountDiff{Address: addr, Field: DiffAccount, A: presence(okA), B: presence(okB)})
+		}
+		diffs = append(diffs, diffAccount(addr, &accountA, &accountB)...)
+	}
+	return diffs
+}
+
+func presence(ok bool) string {
+	if ok {
+		return "present"
+	}
+	return "missing"
+}
+
+func diffAccount(addr common.Address, a, b *core.GenesisAccount) []AccountDiff {
+	var diffs []AccountDiff
+	balanceA, balanceB := bigOrZero(a.Balance), bigOrZero(b.Balance)
+	if balanceA.Cmp(balanceB) != 0 {
+		diffs = append(diffs, AccountDiff{Address: addr, Field: DiffBalance, A: balanceA.String(), B: balanceB.String()})
+	}
+	if a.Nonce != b.Nonce {
+		diffs = append(diffs, AccountDiff{Address: addr, Field: DiffNonce, A: fmt.Sprint(a.Nonce), B: fmt.Sprint(b.Nonce)})
+	}
+	if !bytes.Equal(a.Code, b.Code) {
+		diffs = append(diffs, AccountDiff{Address: addr, Field: DiffCodeHash, A: crypto.Keccak256Hash(a.Code).Hex(), B: crypto.Keccak256Hash(b.Code).Hex()})
+	}
+
+	slots := make(map[common.Hash]struct{})
+	for slot := range a.Storage {
+		slots[slot] = struct{}{}
+	}
+	for slot := range b.Storage {
+		slots[slot] = struct{}{}
+	}
+	sorted := make([]common.Hash, 0, len(slots))
+	for slot := range slots {
+		sorted = append(sorted, slot)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i][:], sorted[j][:]) < 0
+	})
+	for _, slot := range sorted {
+		valueA, valueB := a.Storage[slot], b.Storage[slot]
+		if valueA != valueB {
+			slot := slot
+			diffs = append(diffs, AccountDiff{Address: addr, Field: DiffStorage, Slot: &slot, A: valueA.Hex(), B: valueB.Hex()})
+		}
+	}
+	return diffs
+}
+
+func bigOrZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
+
+// StateClient reads the state of a live chain. It is implemented by ethclient.Client.
+type StateClient interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// FetchAlloc reads the accounts of the alloc from a live chain at the given block, to compare them.
+// The state of a chain cannot be listed over RPC, so only the accounts and storage slots that
+// are in the alloc are read. Accounts that are empty on the chain are not included.
+func FetchAlloc(ctx context.Context, client StateClient, block *big.Int, alloc core.GenesisAlloc) (core.GenesisAlloc, error) {
+	fetched := make(core.GenesisAlloc)
+	count := 0
+	for addr, account := range alloc {
+		balance, err := client.BalanceAt(ctx, addr, block)
+		if err != nil {
+			return nil, fmt.Errorf("cannot fetch balance of %s: %w", addr, err)
+		}
+		nonce, err := client.NonceAt(ctx, addr, block)
+		if err != nil {
+			return nil, fmt.Errorf("cannot fetch nonce of %s: %w", addr, err)
+		}
+		code, err := client.CodeAt(ctx, addr, block)
+		if err != nil {
+			return nil, fmt.Errorf("cannot fetch code of %s: %w", addr, err)
+		}
+		storage := make(map[common.Hash]common.Hash)
+		for slot := range account.Storage {
+			value, err := client.StorageAt(ctx, addr, slot, block)
+			if err != nil {
+				return nil, fmt.Errorf("cannot fetch storage slot %s of %s: %w", slot, addr, err)
+			}
+			if value := common.BytesToHash(value); value != (common.Hash{}) {
+				storage[slot] = value
+			}
+		}
+		if balance.Sign() != 0 || nonce != 0 || len(code) != 0 || len(storage) != 0 {
+			fetched[addr] = core.GenesisAccount{Balance: balance, Nonce: nonce, Code: code, Storage: storage}
+		}
+		count++
+		if count%100 == 0 {
+			log.Info("Fetching accounts", "count", count, "total", len(alloc))
+		}
+	}
+	return fetched, nil
+}
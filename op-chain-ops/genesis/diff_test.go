@@ -0,0 +1,101 @@
+package genesis
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestDiffAllocs(t *testing.T) {
+	a := core.GenesisAlloc{
+		common.Address{1}: {Balance: big.NewInt(1), Nonce: 1, Code: []byte{0x01}, Storage: map[common.Hash]common.Hash{
+			{1}: {1},
+			{2}: {}, // a zero slot is equal to no slot
+		}},
+		common.Address{2}: {Balance: big.NewInt(2)},
+	}
+	b := core.GenesisAlloc{
+		common.Address{1}: {Balance: big.NewInt(1), Nonce: 1, Code: []byte{0x01}, Storage: map[common.Hash]common.Hash{
+			{1}: {1},
+		}},
+		common.Address{2}: {Balance: big.NewInt(2)},
+	}
+	require.Empty(t, DiffAllocs(a, b))
+
+	b[common.Address{1}] = core.GenesisAccount{Balance: big.NewInt(3), Nonce: 2, Code: []byte{0x02}, Storage: map[common.Hash]common.Hash{
+		{1}: {2},
+		{3}: {3},
+	}}
+	delete(b, common.Address{2})
+	b[common.Address{3}] = core.GenesisAccount{Nonce: 1}
+
+	slot1, slot3 := common.Hash{1}, common.Hash{3}
+	require.Equal(t, []AccountDiff{
+		{Address: common.Address{1}, Field: DiffBalance, A: "1", B: "3"},
+		{Address: common.Address{1}, Field: DiffNonce, A: "1", B: "2"},
+		{Address: common.Address{1}, Field: DiffCodeHash, A: crypto.Keccak256Hash([]byte{0x01}).Hex(), B: crypto.Keccak256Hash([]byte{0x02}).Hex()},
+		{Address: common.Address{1}, Field: DiffStorage, Slot: &slot1, A: common.Hash{1}.Hex(), B: common.Hash{2}.Hex()},
+		{Address: common.Address{1}, Field: DiffStorage, Slot: &slot3, A: common.Hash{}.Hex(), B: common.Hash{3}.Hex()},
+		{Address: common.Address{2}, Field: DiffAccount, A: "present", B: "missing"},
+		{Address: common.Address{2}, Field: DiffBalance, A: "2", B: "0"},
+		{Address: common.Address{3}, Field: DiffAccount, A: "missing", B: "present"},
+		{Address: common.Address{3}, Field: DiffNonce, A: "0", B: "1"},
+	}, DiffAllocs(a, b))
+}
+
+// allocClient serves the state of an alloc, like a live chain.
+type allocClient core.GenesisAlloc
+
+func (c allocClient) BalanceAt(_ context.Context, account common.Address, _ *big.Int) (*big.Int, error) {
+	return bigOrZero(c[account].Balance), nil
+}
+
+func (c allocClient) NonceAt(_ context.Context, account common.Address, _ *big.Int) (uint64, error) {
+	return c[account].Nonce, nil
+}
+
+func (c allocClient) CodeAt(_ context.Context, account common.Address, _ *big.Int) ([]byte, error) {
+	return c[account].Code, nil
+}
+
+func (c allocClient) StorageAt(_ context.Context, account common.Address, key common.Hash, _ *big.Int) ([]byte, error) {
+	value := c[account].Storage[key]
+	return value[:], nil
+}
+
+func TestFetchAlloc(t *testing.T) {
+	chain := core.GenesisAlloc{
+		common.Address{1}: {Balance: big.NewInt(1), Code: []byte{0x01}, Storage: map[common.Hash]common.Hash{
+			{1}: {1},
+			{2}: {2}, // not in the alloc, so it is not fetched
+		}},
+		common.Address{3}: {Nonce: 1}, // not in the alloc, so it is not fetched
+	}
+	alloc := core.GenesisAlloc{
+		common.Address{1}: {Balance: big.NewInt(1), Code: []byte{0x01}, Storage: map[common.Hash]common.Hash{
+			{1}: {1},
+			{4}: {4},
+		}},
+		common.Address{2}: {Balance: big.NewInt(2)},
+	}
+	fetched, err := FetchAlloc(context.Background(), allocClient(chain), common.Big0, alloc)
+	require.NoError(t, err)
+	require.Equal(t, core.GenesisAlloc{
+		common.Address{1}: {Balance: big.NewInt(1), Code: []byte{0x01}, Storage: map[common.Hash]common.Hash{
+			{1}: {1},
+		}},
+	}, fetched)
+
+	slot4 := common.Hash{4}
+	require.Equal(t, []AccountDiff{
+		{Address: common.Address{1}, Field: DiffStorage, Slot: &slot4, A: common.Hash{4}.Hex(), B: common.Hash{}.Hex()},
+		{Address: common.Address{2}, Field: DiffAccount, A: "present", B: "missing"},
+		{Address: common.Address{2}, Field: DiffBalance, A: "2", B: "0"},
+	}, DiffAllocs(alloc, fetched))
+}
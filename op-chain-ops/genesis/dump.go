@@ -0,0 +1,374 @@
+package genesis
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	gstate "github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// dumpProgressInterval is the interval at which the progress of reading or writing a state dump is logged.
+var dumpProgressInterval = 8 * time.Second
+
+// dumpProgress logs the progress of streaming a state dump.
+type dumpProgress struct {
+	msg      string
+	accounts uint64
+	slots    uint64
+	start    time.Time
+	logged   time.Time
+}
+
+func newDumpProgress(msg string) *dumpProgress {
+	now := time.Now()
+	return &dumpProgress{msg: msg, start: now, logged: now}
+}
+
+func (p *dumpProgress) account(account *gstate.DumpAccount) {
+	p.accounts++
+	p.slots += uint64(len(account.Storage))
+	if time.Since(p.logged) > dumpProgressInterval {
+		log.Info(p.msg, "accounts", p.accounts, "slots", p.slots, "elapsed", common.PrettyDuration(time.Since(p.start)))
+		p.logged = time.Now()
+	}
+}
+
+func (p *dumpProgress) done() {
+	log.Info(p.msg, "accounts", p.accounts, "slots", p.slots, "elapsed", common.PrettyDuration(time.Since(p.start)), "done", true)
+}
+
+// DumpReader iterates over the accounts of a state dump, without reading the whole dump into memory.
+// Only a single account, with its storage, is held in memory at a time.
+type DumpReader struct {
+	dec      *json.Decoder
+	root     string
+	accounts bool
+	done     bool
+	progress *dumpProgress
+}
+
+// NewDumpReader reads the state dump from r, up to its first account.
+func NewDumpReader(r io.Reader) (*DumpReader, error) {
+	d := &DumpReader{
+		dec:      json.NewDecoder(bufio.NewReaderSize(r, 1<<20)),
+		progress: newDumpProgress("Reading state dump"),
+	}
+	if err := d.expectDelim('{'); err != nil {
+		return nil, err
+	}
+	if err := d.readFields(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Root returns the state root of the dump. The root is known once it has been read:
+// dumps written by geth start with it, dumps written by a DumpWriter end with it.
+func (d *DumpReader) Root() string {
+	return d.root
+}
+
+// Next returns the next account of the dump. It returns io.EOF once all accounts have been read.
+func (d *DumpReader) Next() (common.Address, *gstate.DumpAccount, error) {
+	for !d.done {
+		if !d.accounts {
+			if err := d.readFields(); err != nil {
+				return common.Address{}, nil, err
+			}
+			continue
+		}
+		if !d.dec.More() {
+			if err := d.expectDelim('}'); err != nil {
+				return common.Address{}, nil, err
+			}
+			d.accounts = false
+			continue
+		}
+		key, err := d.dec.Token()
+		if err != nil {
+			return common.Address{}, nil, fmt.Errorf("cannot read dump account: %w", err)
+		}
+		hex, ok := key.(string)
+		if !ok {
+			return common.Address{}, nil, fmt.Errorf("unexpected dump account key %v", key)
+		}
+		var addr common.Address
+		if err := addr.UnmarshalText([]byte(hex)); err != nil {
+			return common.Address{}, nil, fmt.Errorf("invalid dump account address %q: %w", hex, err)
+		}
+		var account gstate.DumpAccount
+		if err := d.dec.Decode(&account); err != nil {
+			return common.Address{}, nil, fmt.Errorf("cannot unmarshal dump account %s: %w", addr, err)
+		}
+		d.progress.account(&account)
+		return addr, &account, nil
+	}
+	return common.Address{}, nil, io.EOF
+}
+
+// readFields reads the top-level fields of the dump, until the start of the accounts or the end of the dump.
+func (d *DumpReader) readFields() error {
+	for d.dec.More() {
+		key, err := d.dec.Token()
+		if err != nil {
+			return fmt.Errorf("cannot read dump: %w", err)
+		}
+		switch key {
+		case "root":
+			if err := d.dec.Decode(&d.root); err != nil {
+				return fmt.Errorf("cannot unmarshal dump root: %w", err)
+			}
+		case "accounts":
+			tok, err := d.dec.Token()
+			if err != nil {
+				return fmt.Errorf("cannot read dump accounts: %w", err)
+			}
+			if tok == nil {
+				continue
+			}
+			if tok != json.Delim('{') {
+				return fmt.Errorf("unexpected dump accounts %v", tok)
+			}
+			d.accounts = true
+			return nil
+		default:
+			var skip json.RawMessage
+			if err := d.dec.Decode(&skip); err != nil {
+				return fmt.Errorf("cannot read dump field %v: %w", key, err)
+			}
+		}
+	}
+	if err := d.expectDelim('}'); err != nil {
+		return err
+	}
+	d.done = true
+	d.progress.done()
+	return nil
+}
+
+func (d *DumpReader) expectDelim(delim json.Delim) error {
+	tok, err := d.dec.Token()
+	if err != nil {
+		return fmt.Errorf("cannot read dump: %w", err)
+	}
+	if tok != delim {
+		return fmt.Errorf("unexpected dump token %v, expected %v", tok, delim)
+	}
+	return nil
+}
+
+// ReadStateDump reads a whole state dump from r into memory.
+// Use a DumpReader, or StreamStateDump, to process large dumps account by account instead.
+func ReadStateDump(r io.Reader) (*gstate.Dump, error) {
+	dump := &gstate.Dump{Accounts: make(map[common.Address]gstate.DumpAccount)}
+	root, err := streamDump(r, func(addr common.Address, account *gstate.DumpAccount) error {
+		dump.Accounts[addr] = *account
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	dump.Root = root
+	return dump, nil
+}
+
+// StreamStateDump reads the state dump file at path, and calls fn with each account in the order of the file.
+// Only a single account is held in memory at a time. It returns the state root recorded in the dump, if any.
+func StreamStateDump(path string, fn func(addr common.Address, account *gstate.DumpAccount) error) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("dump at %s not found: %w", path, err)
+	}
+	defer file.Close()
+	return streamDump(file, fn)
+}
+
+func streamDump(r io.Reader, fn func(addr common.Address, account *gstate.DumpAccount) error) (string, error) {
+	reader, err := NewDumpReader(r)
+	if err != nil {
+		return "", err
+	}
+	for {
+		addr, account, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return reader.Root(), nil
+		} else if err != nil {
+			return "", err
+		}
+		if err := fn(addr, account); err != nil {
+			return "", err
+		}
+	}
+}
+
+// dumpHasherFlushSize is the number of accounts and storage slots after which a DumpHasher flushes its state to disk.
+var dumpHasherFlushSize = 100_000
+
+// DumpHasher computes the state root of a state dump account by account. The state is flushed to a temporary
+// database on disk as the accounts are added, so that the state of large dumps is not held in memory.
+// The root matches the state root of a genesis with the accounts of the dump as its allocs.
+type DumpHasher struct {
+	dir     string
+	db      ethdb.Database
+	stateDB gstate.Database
+	state   *gstate.StateDB
+	pending int
+}
+
+// NewDumpHasher creates a DumpHasher of an empty state. Close must be called to remove its temporary database.
+func NewDumpHasher() (*DumpHasher, error) {
+	dir, err := os.MkdirTemp("", "dump-hasher")
+	if err != nil {
+		return nil, fmt.Errorf("cannot create temp dir of state: %w", err)
+	}
+	db, err := rawdb.NewLevelDBDatabase(dir, 64, 64, "", false)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("cannot open temp state db: %w", err), os.RemoveAll(dir))
+	}
+	h := &DumpHasher{dir: dir, db: db, stateDB: gstate.NewDatabase(db)}
+	h.state, err = gstate.New(types.EmptyRootHash, h.stateDB, nil)
+	if err != nil {
+		return nil, errors.Join(err, h.Close())
+	}
+	return h, nil
+}
+
+// AddAccount adds an account to the state. Each account must only be added once.
+func (h *DumpHasher) AddAccount(addr common.Address, account *gstate.DumpAccount) error {
+	balance, ok := new(big.Int).SetString(account.Balance, 10)
+	if !ok {
+		return fmt.Errorf("failed to parse balance of %s", addr)
+	}
+	h.state.AddBalance(addr, balance)
+	h.state.SetCode(addr, account.Code)
+	h.state.SetNonce(addr, account.Nonce)
+	for k, v := range account.Storage {
+		h.state.SetState(addr, k, common.HexToHash(v))
+	}
+	h.pending += 1 + len(account.Storage)
+	if h.pending >= dumpHasherFlushSize {
+		if _, err := h.flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Root returns the state root of the accounts that were added.
+func (h *DumpHasher) Root() (common.Hash, error) {
+	return h.flush()
+}
+
+// flush commits the pending accounts to the database on disk, and continues from the committed state.
+func (h *DumpHasher) flush() (common.Hash, error) {
+	root, err := h.state.Commit(0, false)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("cannot commit state: %w", err)
+	}
+	if err := h.stateDB.TrieDB().Commit(root, false); err != nil {
+		return common.Hash{}, fmt.Errorf("cannot flush state: %w", err)
+	}
+	h.state, err = gstate.New(root, h.stateDB, nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	h.pending = 0
+	return root, nil
+}
+
+// Close removes the temporary database of the state.
+func (h *DumpHasher) Close() error {
+	return errors.Join(h.db.Close(), os.RemoveAll(h.dir))
+}
+
+// DumpWriter writes a state dump account by account, in the format of geth state dumps.
+// The state root is written last, so it can be computed while the accounts are written.
+type DumpWriter struct {
+	w        *bufio.Writer
+	count    int
+	progress *dumpProgress
+}
+
+// NewDumpWriter starts writing a state dump to w. Close must be called to complete the dump.
+func NewDumpWriter(w io.Writer) (*DumpWriter, error) {
+	d := &DumpWriter{
+		w:        bufio.NewWriterSize(w, 1<<20),
+		progress: newDumpProgress("Writing state dump"),
+	}
+	if _, err := d.w.WriteString("{\n  \"accounts\": {"); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// WriteAccount writes the next account of the dump.
+func (d *DumpWriter) WriteAccount(addr common.Address, account *gstate.DumpAccount) error {
+	data, err := json.Marshal(account)
+	if err != nil {
+		return fmt.Errorf("cannot marshal dump account %s: %w", addr, err)
+	}
+	sep := "\n    "
+	if d.count > 0 {
+		sep = ",\n    "
+	}
+	if _, err := fmt.Fprintf(d.w, "%s\"%s\": %s", sep, addr.Hex(), data); err != nil {
+		return err
+	}
+	d.count++
+	d.progress.account(account)
+	return nil
+}
+
+// Close writes the state root, and flushes the dump.
+func (d *DumpWriter) Close(root string) error {
+	rootJSON, err := json.Marshal(root)
+	if err != nil {
+		return err
+	}
+	end := "\n  },\n"
+	if d.count == 0 {
+		end = "},\n"
+	}
+	if _, err := fmt.Fprintf(d.w, "%s  \"root\": %s\n}\n", end, rootJSON); err != nil {
+		return err
+	}
+	if err := d.w.Flush(); err != nil {
+		return err
+	}
+	d.progress.done()
+	return nil
+}
+
+// WriteStateDump writes the state dump to w, with the accounts sorted by address.
+func WriteStateDump(w io.Writer, dump *gstate.Dump) error {
+	addrs := make([]common.Address, 0, len(dump.Accounts))
+	for addr := range dump.Accounts {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].Cmp(addrs[j]) < 0
+	})
+	writer, err := NewDumpWriter(w)
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		account := dump.Accounts[addr]
+		if err := writer.WriteAccount(addr, &account); err != nil {
+			return err
+		}
+	}
+	return writer.Close(dump.Root)
+}
@@ -0,0 +1,181 @@
+package genesis
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	gstate "github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestReadStateDump(t *testing.T) {
+	data, err := os.ReadFile("testdata/allocs-l1.json")
+	require.NoError(t, err)
+	var expected gstate.Dump
+	require.NoError(t, json.Unmarshal(data, &expected))
+
+	dump, err := NewStateDump("testdata/allocs-l1.json")
+	require.NoError(t, err)
+	require.Equal(t, &expected, dump)
+}
+
+func TestDumpReaderNext(t *testing.T) {
+	reader, err := NewDumpReader(strings.NewReader(`{
+		"extra": [1, {"a": 2}],
+		"accounts": {
+			"0x0000000000000000000000000000000000000001": {"balance": "1", "nonce": 2, "storage": {"0x0000000000000000000000000000000000000000000000000000000000000001": "02"}},
+			"0x0000000000000000000000000000000000000002": {"balance": "3", "nonce": 0}
+		},
+		"root": "0x1234"
+	}`))
+	require.NoError(t, err)
+	// the root is only known once it is read, after the accounts
+	require.Empty(t, reader.Root())
+
+	addr, account, err := reader.Next()
+	require.NoError(t, err)
+	require.Equal(t, common.Address{19: 1}, addr)
+	require.Equal(t, uint64(2), account.Nonce)
+	require.Equal(t, "02", account.Storage[common.Hash{31: 1}])
+
+	addr, account, err = reader.Next()
+	require.NoError(t, err)
+	require.Equal(t, common.Address{19: 2}, addr)
+	require.Equal(t, "3", account.Balance)
+
+	_, _, err = reader.Next()
+	require.ErrorIs(t, err, io.EOF)
+	require.Equal(t, "0x1234", reader.Root())
+	_, _, err = reader.Next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestDumpReaderInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		dump string
+		err  string
+	}{
+		{name: "NotObject", dump: `[]`, err: "unexpected dump token"},
+		{name: "Accounts", dump: `{"accounts": []}`, err: "unexpected dump accounts"},
+		{name: "Address", dump: `{"accounts": {"0x01": {}}}`, err: "invalid dump account address"},
+		{name: "Account", dump: `{"accounts": {"0x0000000000000000000000000000000000000001": {"nonce": "x"}}}`, err: "cannot unmarshal dump account"},
+		{name: "Truncated", dump: `{"accounts": {"0x0000000000000000000000000000000000000001": {}`, err: "cannot read dump"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ReadStateDump(strings.NewReader(test.dump))
+			require.ErrorContains(t, err, test.err)
+		})
+	}
+}
+
+func TestWriteStateDump(t *testing.T) {
+	dump, err := NewStateDump("testdata/allocs-l1.json")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteStateDump(&buf, dump))
+	// the output is valid JSON, that decodes to the same dump
+	var decoded gstate.Dump
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Equal(t, dump, &decoded)
+
+	// and it can be streamed back in
+	streamed, err := ReadStateDump(&buf)
+	require.NoError(t, err)
+	require.Equal(t, dump, streamed)
+}
+
+func TestWriteStateDumpEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteStateDump(&buf, &gstate.Dump{Root: "0x01"}))
+	var decoded gstate.Dump
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Equal(t, "0x01", decoded.Root)
+	require.Empty(t, decoded.Accounts)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestDumpWriterError(t *testing.T) {
+	writer, err := NewDumpWriter(failingWriter{})
+	require.NoError(t, err)
+	require.NoError(t, writer.WriteAccount(common.Address{}, &gstate.DumpAccount{Balance: "0"}))
+	require.ErrorContains(t, writer.Close(""), "write failed")
+}
+
+func TestStreamStateDump(t *testing.T) {
+	dump, err := NewStateDump("testdata/allocs-l1.json")
+	require.NoError(t, err)
+	accounts := make(map[common.Address]gstate.DumpAccount)
+	root, err := StreamStateDump("testdata/allocs-l1.json", func(addr common.Address, account *gstate.DumpAccount) error {
+		accounts[addr] = *account
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, dump.Root, root)
+	require.Equal(t, dump.Accounts, accounts)
+
+	_, err = StreamStateDump("testdata/allocs-l1.json", func(addr common.Address, account *gstate.DumpAccount) error {
+		return errors.New("stop")
+	})
+	require.ErrorContains(t, err, "stop")
+}
+
+func TestDumpHasher(t *testing.T) {
+	dump, err := NewStateDump("testdata/allocs-l1.json")
+	require.NoError(t, err)
+	alloc := make(core.GenesisAlloc, len(dump.Accounts))
+	for addr, account := range dump.Accounts {
+		balance, ok := new(big.Int).SetString(account.Balance, 10)
+		require.True(t, ok)
+		storage := make(map[common.Hash]common.Hash, len(account.Storage))
+		for k, v := range account.Storage {
+			storage[k] = common.HexToHash(v)
+		}
+		alloc[addr] = core.GenesisAccount{Balance: balance, Nonce: account.Nonce, Code: account.Code, Storage: storage}
+	}
+	expected := (&core.Genesis{Alloc: alloc}).ToBlock().Root()
+
+	// flush the state to disk many times while hashing
+	defer func(size int) {
+		dumpHasherFlushSize = size
+	}(dumpHasherFlushSize)
+	dumpHasherFlushSize = 10
+
+	hasher, err := NewDumpHasher()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, hasher.Close())
+	}()
+	_, err = StreamStateDump("testdata/allocs-l1.json", hasher.AddAccount)
+	require.NoError(t, err)
+	root, err := hasher.Root()
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+
+	empty, err := NewDumpHasher()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, empty.Close())
+	}()
+	root, err = empty.Root()
+	require.NoError(t, err)
+	require.Equal(t, types.EmptyRootHash, root)
+}
@@ -163,6 +163,9 @@ func NewL1Genesis(config *DeployConfig) (*core.Genesis, error) {
 	if timestamp == 0 {
 		timestamp = hexutil.Uint64(time.Now().Unix())
 	}
+	if config.L1CancunTimeOffset != nil && !config.L1UseClique {
+		chainConfig.CancunTime = u64ptr(uint64(timestamp) + uint64(*config.L1CancunTimeOffset))
+	}
 
 	return &core.Genesis{
 		Config:     &chainConfig,
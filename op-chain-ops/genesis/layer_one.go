@@ -45,6 +45,33 @@ func init() {
 // It is expected that the dump contains all of the required state to bootstrap
 // the L1 chain.
 func BuildL1DeveloperGenesis(config *DeployConfig, dump *gstate.Dump, l1Deployments *L1Deployments, postProcess bool) (*core.Genesis, error) {
+	if dump == nil {
+		return buildL1DeveloperGenesis(config, nil, l1Deployments, postProcess)
+	}
+	return buildL1DeveloperGenesis(config, func(fn func(common.Address, *gstate.DumpAccount) error) error {
+		for address, account := range dump.Accounts {
+			account := account
+			if err := fn(address, &account); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, l1Deployments, postProcess)
+}
+
+// BuildL1DeveloperGenesisFromDump is like BuildL1DeveloperGenesis, but streams the accounts of the state dump file
+// at dumpPath into the genesis, so that the dump is not held in memory next to the genesis.
+func BuildL1DeveloperGenesisFromDump(config *DeployConfig, dumpPath string, l1Deployments *L1Deployments, postProcess bool) (*core.Genesis, error) {
+	return buildL1DeveloperGenesis(config, func(fn func(common.Address, *gstate.DumpAccount) error) error {
+		_, err := StreamStateDump(dumpPath, fn)
+		return err
+	}, l1Deployments, postProcess)
+}
+
+// buildL1DeveloperGenesis builds the L1 genesis, with the accounts of the dump that are iterated over by forEach,
+// if not nil.
+func buildL1DeveloperGenesis(config *DeployConfig, forEach func(fn func(common.Address, *gstate.DumpAccount) error) error,
+	l1Deployments *L1Deployments, postProcess bool) (*core.Genesis, error) {
 	log.Info("Building developer L1 genesis block")
 	genesis, err := NewL1Genesis(config)
 	if err != nil {
@@ -55,8 +82,8 @@ func BuildL1DeveloperGenesis(config *DeployConfig, dump *gstate.Dump, l1Deployme
 	FundDevAccounts(memDB)
 	SetPrecompileBalances(memDB)
 
-	if dump != nil {
-		for address, account := range dump.Accounts {
+	if forEach != nil {
+		err := forEach(func(address common.Address, account *gstate.DumpAccount) error {
 			name := "<unknown>"
 			if l1Deployments != nil {
 				if n := l1Deployments.GetName(address); n != "" {
@@ -69,7 +96,7 @@ func BuildL1DeveloperGenesis(config *DeployConfig, dump *gstate.Dump, l1Deployme
 
 			balance, ok := new(big.Int).SetString(account.Balance, 10)
 			if !ok {
-				return nil, fmt.Errorf("failed to parse balance for %s", address)
+				return fmt.Errorf("failed to parse balance for %s", address)
 			}
 			memDB.AddBalance(address, balance)
 			memDB.SetCode(address, account.Code)
@@ -77,6 +104,10 @@ func BuildL1DeveloperGenesis(config *DeployConfig, dump *gstate.Dump, l1Deployme
 				log.Info("Setting storage", "name", name, "key", key.Hex(), "value", value)
 				memDB.SetState(address, key, common.HexToHash(value))
 			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 
 		// This should only be used if we are expecting Optimism specific state to be set
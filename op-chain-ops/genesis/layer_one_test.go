@@ -138,3 +138,19 @@ func TestBuildL1DeveloperGenesis(t *testing.T) {
 	_, err = bridge.DepositETH(tOpts, 200000, nil)
 	require.NoError(t, err)
 }
+
+func TestBuildL1DeveloperGenesisFromDump(t *testing.T) {
+	config, err := NewDeployConfig("testdata/test-deploy-config-full.json")
+	require.NoError(t, err)
+	dump, err := NewStateDump("testdata/allocs-l1.json")
+	require.NoError(t, err)
+
+	expected, err := BuildL1DeveloperGenesis(config, dump, nil, false)
+	require.NoError(t, err)
+	streamed, err := BuildL1DeveloperGenesisFromDump(config, "testdata/allocs-l1.json", nil, false)
+	require.NoError(t, err)
+	require.Equal(t, expected.Alloc, streamed.Alloc)
+
+	_, err = BuildL1DeveloperGenesisFromDump(config, "testdata/missing.json", nil, false)
+	require.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,89 @@
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+// OverlayAccount describes the changes to an account of the genesis allocs.
+// Fields that are not set are left unchanged. Storage slots that are set to zero are deleted.
+type OverlayAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Nonce   *hexutil.Uint64             `json:"nonce,omitempty"`
+	Code    *hexutil.Bytes              `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// AllocsOverlay adds or overrides accounts of the genesis allocs,
+// e.g. to fund accounts or add contracts on top of the predeploys of a custom network.
+type AllocsOverlay map[common.Address]OverlayAccount
+
+// NewAllocsOverlay reads an AllocsOverlay JSON file from disk.
+func NewAllocsOverlay(path string) (AllocsOverlay, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("allocs overlay at %s not found: %w", path, err)
+	}
+
+	var overlay AllocsOverlay
+	if err := json.Unmarshal(file, &overlay); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal allocs overlay at %s: %w", path, err)
+	}
+	return overlay, nil
+}
+
+// Apply applies the overlay to the allocs. Accounts that do not exist yet are created.
+func (o AllocsOverlay) Apply(alloc core.GenesisAlloc) {
+	for addr, overlay := range o {
+		account, ok := alloc[addr]
+		if !ok {
+			account = core.GenesisAccount{Balance: new(big.Int)}
+		}
+		if overlay.Balance != nil {
+			account.Balance = overlay.Balance.ToInt()
+		}
+		if overlay.Nonce != nil {
+			account.Nonce = uint64(*overlay.Nonce)
+		}
+		if overlay.Code != nil {
+			account.Code = *overlay.Code
+		}
+		if len(overlay.Storage) > 0 {
+			storage := make(map[common.Hash]common.Hash, len(account.Storage)+len(overlay.Storage))
+			for k, v := range account.Storage {
+				storage[k] = v
+			}
+			for k, v := range overlay.Storage {
+				if v == (common.Hash{}) {
+					delete(storage, k)
+				} else {
+					storage[k] = v
+				}
+			}
+			account.Storage = storage
+		}
+		alloc[addr] = account
+	}
+}
+
+// ApplyAllocsOverlays reads the overlay files, and applies them to the genesis, in order.
+// Later overlays take precedence over earlier overlays.
+func ApplyAllocsOverlays(genesis *core.Genesis, paths ...string) error {
+	for _, path := range paths {
+		overlay, err := NewAllocsOverlay(path)
+		if err != nil {
+			return err
+		}
+		if genesis.Alloc == nil {
+			genesis.Alloc = make(core.GenesisAlloc)
+		}
+		overlay.Apply(genesis.Alloc)
+	}
+	return nil
+}
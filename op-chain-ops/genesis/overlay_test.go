@@ -0,0 +1,51 @@
+package genesis
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+func TestApplyAllocsOverlays(t *testing.T) {
+	funded := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	predeploy := common.HexToAddress("0x4200000000000000000000000000000000000042")
+	slot1 := common.BigToHash(big.NewInt(1))
+	slot2 := common.BigToHash(big.NewInt(2))
+	slot3 := common.BigToHash(big.NewInt(3))
+
+	gen := &core.Genesis{Alloc: core.GenesisAlloc{
+		predeploy: {
+			Balance: big.NewInt(7),
+			Code:    []byte{0x01},
+			Storage: map[common.Hash]common.Hash{
+				slot1: common.BigToHash(big.NewInt(0x11)),
+				slot2: common.BigToHash(big.NewInt(0x22)),
+				slot3: common.BigToHash(big.NewInt(0x33)),
+			},
+		},
+	}}
+
+	err := ApplyAllocsOverlays(gen, "testdata/overlays/fund.json", "testdata/overlays/override.json")
+	require.NoError(t, err)
+
+	// later overlays take precedence
+	account := gen.Alloc[funded]
+	require.Equal(t, big.NewInt(0x200), account.Balance)
+	require.Equal(t, uint64(3), account.Nonce)
+	require.Equal(t, []byte{0x60, 0x80}, account.Code)
+
+	// unset fields of existing accounts are left unchanged, zero storage values are deleted
+	account = gen.Alloc[predeploy]
+	require.Equal(t, big.NewInt(7), account.Balance)
+	require.Equal(t, []byte{0x01}, account.Code)
+	require.Equal(t, map[common.Hash]common.Hash{
+		slot1: common.BigToHash(big.NewInt(0xaa)),
+		slot3: common.BigToHash(big.NewInt(0x33)),
+	}, account.Storage)
+
+	require.ErrorContains(t, ApplyAllocsOverlays(gen, "testdata/overlays/missing.json"), "not found")
+}
@@ -0,0 +1,269 @@
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ValidationCode categorizes a deploy config validation error.
+type ValidationCode string
+
+const (
+	// ValidationRequired is used for a field that is missing or zero.
+	ValidationRequired ValidationCode = "required"
+	// ValidationInvalid is used for a field with an out-of-range value.
+	ValidationInvalid ValidationCode = "invalid"
+	// ValidationInvariant is used for fields that are inconsistent with each other.
+	ValidationInvariant ValidationCode = "invariant"
+	// ValidationChecksum is used for an address with an invalid EIP-55 checksum.
+	ValidationChecksum ValidationCode = "checksum"
+)
+
+// ValidationSeverity is the severity of a deploy config validation error.
+type ValidationSeverity string
+
+const (
+	SeverityError   ValidationSeverity = "error"
+	SeverityWarning ValidationSeverity = "warning"
+)
+
+// ValidationError is a machine-readable problem with a deploy config.
+type ValidationError struct {
+	// Field is the JSON name of the field that the problem is reported on.
+	Field    string             `json:"field"`
+	Code     ValidationCode     `json:"code"`
+	Severity ValidationSeverity `json:"severity"`
+	Message  string             `json:"message"`
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrInvalidDeployConfig, e.Message)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidDeployConfig
+}
+
+// ValidationErrors are all the problems with a deploy config, in order of the checks.
+type ValidationErrors []*ValidationError
+
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, len(v))
+	for i, e := range v {
+		msgs[i] = e.Message
+	}
+	return fmt.Sprintf("%v: %s", ErrInvalidDeployConfig, strings.Join(msgs, "; "))
+}
+
+func (v ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(v))
+	for i, e := range v {
+		errs[i] = e
+	}
+	return errs
+}
+
+// Errors returns the problems with error severity.
+func (v ValidationErrors) Errors() ValidationErrors {
+	return v.filter(SeverityError)
+}
+
+// Warnings returns the problems with warning severity.
+func (v ValidationErrors) Warnings() ValidationErrors {
+	return v.filter(SeverityWarning)
+}
+
+func (v ValidationErrors) filter(severity ValidationSeverity) ValidationErrors {
+	var out ValidationErrors
+	for _, e := range v {
+		if e.Severity == severity {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+type validator struct {
+	results ValidationErrors
+}
+
+func (v *validator) add(field string, code ValidationCode, severity ValidationSeverity, format string, args ...any) {
+	v.results = append(v.results, &ValidationError{
+		Field:    field,
+		Code:     code,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) requireAddress(field string, name string, addr common.Address) {
+	if addr == (common.Address{}) {
+		v.add(field, ValidationRequired, SeverityError, "%s cannot be address(0)", name)
+	}
+}
+
+func (v *validator) requireNonZero(field string, name string, value uint64) {
+	if value == 0 {
+		v.add(field, ValidationRequired, SeverityError, "%s cannot be 0", name)
+	}
+}
+
+// maxGasPriceOracleScalar is the scalar above which the L1 fee is unlikely to be intended:
+// the scalar has 6 decimals, so this charges 10x the L1 data cost.
+const maxGasPriceOracleScalar = 10_000_000
+
+// Validate checks the deploy config, and returns all the problems with it, instead of only the first.
+// Problems with warning severity do not make the deploy config invalid.
+func (d *DeployConfig) Validate() ValidationErrors {
+	var v validator
+	if d.L1StartingBlockTag == nil {
+		v.add("l1StartingBlockTag", ValidationRequired, SeverityError, "L1StartingBlockTag cannot be nil")
+	}
+	v.requireNonZero("l1ChainID", "L1ChainID", d.L1ChainID)
+	v.requireNonZero("l2ChainID", "L2ChainID", d.L2ChainID)
+	v.requireNonZero("l2BlockTime", "L2BlockTime", d.L2BlockTime)
+	v.requireNonZero("finalizationPeriodSeconds", "FinalizationPeriodSeconds", d.FinalizationPeriodSeconds)
+	if d.L2OutputOracleStartingBlockNumber == 0 {
+		v.add("l2OutputOracleStartingBlockNumber", ValidationRequired, SeverityWarning, "L2OutputOracleStartingBlockNumber is 0, should only be 0 for fresh chains")
+	}
+	v.requireAddress("portalGuardian", "PortalGuardian", d.PortalGuardian)
+	v.requireNonZero("maxSequencerDrift", "MaxSequencerDrift", d.MaxSequencerDrift)
+	v.requireNonZero("sequencerWindowSize", "SequencerWindowSize", d.SequencerWindowSize)
+	v.requireNonZero("channelTimeout", "ChannelTimeout", d.ChannelTimeout)
+	v.requireAddress("p2pSequencerAddress", "P2PSequencerAddress", d.P2PSequencerAddress)
+	v.requireAddress("batchInboxAddress", "BatchInboxAddress", d.BatchInboxAddress)
+	v.requireAddress("batchSenderAddress", "BatchSenderAddress", d.BatchSenderAddress)
+	v.requireNonZero("l2OutputOracleSubmissionInterval", "L2OutputOracleSubmissionInterval", d.L2OutputOracleSubmissionInterval)
+	if d.L2OutputOracleStartingTimestamp == 0 {
+		v.add("l2OutputOracleStartingTimestamp", ValidationRequired, SeverityWarning, "L2OutputOracleStartingTimestamp is 0")
+	}
+	v.requireAddress("l2OutputOracleProposer", "L2OutputOracleProposer", d.L2OutputOracleProposer)
+	v.requireAddress("l2OutputOracleChallenger", "L2OutputOracleChallenger", d.L2OutputOracleChallenger)
+	v.requireAddress("finalSystemOwner", "FinalSystemOwner", d.FinalSystemOwner)
+	v.requireAddress("proxyAdminOwner", "ProxyAdminOwner", d.ProxyAdminOwner)
+	v.requireAddress("baseFeeVaultRecipient", "BaseFeeVaultRecipient", d.BaseFeeVaultRecipient)
+	v.requireAddress("l1FeeVaultRecipient", "L1FeeVaultRecipient", d.L1FeeVaultRecipient)
+	v.requireAddress("sequencerFeeVaultRecipient", "SequencerFeeVaultRecipient", d.SequencerFeeVaultRecipient)
+	if !d.BaseFeeVaultWithdrawalNetwork.Valid() {
+		v.add("baseFeeVaultWithdrawalNetwork", ValidationInvalid, SeverityError, "BaseFeeVaultWithdrawalNetwork can only be 0 (L1) or 1 (L2)")
+	}
+	if !d.L1FeeVaultWithdrawalNetwork.Valid() {
+		v.add("l1FeeVaultWithdrawalNetwork", ValidationInvalid, SeverityError, "L1FeeVaultWithdrawalNetwork can only be 0 (L1) or 1 (L2)")
+	}
+	if !d.SequencerFeeVaultWithdrawalNetwork.Valid() {
+		v.add("sequencerFeeVaultWithdrawalNetwork", ValidationInvalid, SeverityError, "SequencerFeeVaultWithdrawalNetwork can only be 0 (L1) or 1 (L2)")
+	}
+	// the L2 genesis cannot be generated without the fee vault immutables,
+	// but the deploy configs of some existing chains are only used to deploy the L1 contracts
+	if d.BaseFeeVaultMinimumWithdrawalAmount == nil {
+		v.add("baseFeeVaultMinimumWithdrawalAmount", ValidationRequired, SeverityWarning, "BaseFeeVaultMinimumWithdrawalAmount is not set, it is required to generate the L2 genesis")
+	}
+	if d.L1FeeVaultMinimumWithdrawalAmount == nil {
+		v.add("l1FeeVaultMinimumWithdrawalAmount", ValidationRequired, SeverityWarning, "L1FeeVaultMinimumWithdrawalAmount is not set, it is required to generate the L2 genesis")
+	}
+	if d.SequencerFeeVaultMinimumWithdrawalAmount == nil {
+		v.add("sequencerFeeVaultMinimumWithdrawalAmount", ValidationRequired, SeverityWarning, "SequencerFeeVaultMinimumWithdrawalAmount is not set, it is required to generate the L2 genesis")
+	}
+	if d.GasPriceOracleOverhead == 0 {
+		v.add("gasPriceOracleOverhead", ValidationRequired, SeverityWarning, "GasPriceOracleOverhead is 0")
+	}
+	v.requireNonZero("gasPriceOracleScalar", "GasPriceOracleScalar", d.GasPriceOracleScalar)
+	if d.GasPriceOracleScalar > maxGasPriceOracleScalar {
+		v.add("gasPriceOracleScalar", ValidationInvalid, SeverityWarning, "GasPriceOracleScalar (%d) charges more than 10x the L1 data cost", d.GasPriceOracleScalar)
+	}
+	v.requireNonZero("eip1559Denominator", "EIP1559Denominator", d.EIP1559Denominator)
+	v.requireNonZero("eip1559Elasticity", "EIP1559Elasticity", d.EIP1559Elasticity)
+	if d.L2GenesisBlockGasLimit == 0 {
+		v.add("l2GenesisBlockGasLimit", ValidationRequired, SeverityError, "L2 genesis block gas limit cannot be 0")
+	} else if uint64(d.L2GenesisBlockGasLimit) < uint64(DefaultResourceConfig.MaxResourceLimit+DefaultResourceConfig.SystemTxMaxGas) {
+		// When the initial resource config is made to be configurable by the DeployConfig, ensure
+		// that this check is updated to use the values from the DeployConfig instead of the defaults.
+		v.add("l2GenesisBlockGasLimit", ValidationInvalid, SeverityError, "L2 genesis block gas limit is too small")
+	}
+	if d.L2GenesisBlockBaseFeePerGas == nil {
+		v.add("l2GenesisBlockBaseFeePerGas", ValidationRequired, SeverityError, "L2 genesis block base fee per gas cannot be nil")
+	}
+	if d.EnableGovernance {
+		if d.GovernanceTokenName == "" {
+			v.add("governanceTokenName", ValidationRequired, SeverityError, "GovernanceToken.name cannot be empty")
+		}
+		if d.GovernanceTokenSymbol == "" {
+			v.add("governanceTokenSymbol", ValidationRequired, SeverityError, "GovernanceToken.symbol cannot be empty")
+		}
+		v.requireAddress("governanceTokenOwner", "GovernanceToken owner", d.GovernanceTokenOwner)
+	}
+	// L2 block time must always be smaller than L1 block time
+	if d.L1BlockTime < d.L2BlockTime {
+		v.add("l2BlockTime", ValidationInvariant, SeverityError, "L2 block time (%d) is larger than L1 block time (%d)", d.L2BlockTime, d.L1BlockTime)
+	}
+	if d.ChannelTimeout >= d.SequencerWindowSize && d.SequencerWindowSize != 0 {
+		v.add("channelTimeout", ValidationInvariant, SeverityWarning, "ChannelTimeout (%d) is not smaller than SequencerWindowSize (%d)", d.ChannelTimeout, d.SequencerWindowSize)
+	}
+	if d.L1CancunTimeOffset != nil && d.L1UseClique {
+		v.add("l1CancunTimeOffset", ValidationInvariant, SeverityError, "L1 Cancun is scheduled, but L1 uses clique")
+	}
+	d.validateForks(&v)
+	return v.results
+}
+
+// validateForks checks that every scheduled fork activates at or after Regolith,
+// which all later forks build upon.
+func (d *DeployConfig) validateForks(v *validator) {
+	forks := []struct {
+		field  string
+		name   string
+		offset *uint64
+	}{
+		{"l2GenesisCanyonTimeOffset", "Canyon", (*uint64)(d.L2GenesisCanyonTimeOffset)},
+		{"l2GenesisSpanBatchTimeOffset", "SpanBatch", (*uint64)(d.L2GenesisSpanBatchTimeOffset)},
+	}
+	regolith := (*uint64)(d.L2GenesisRegolithTimeOffset)
+	for _, fork := range forks {
+		if fork.offset == nil {
+			continue
+		}
+		if regolith == nil {
+			v.add(fork.field, ValidationInvariant, SeverityError, "%s is scheduled, but Regolith is not", fork.name)
+		} else if *fork.offset < *regolith {
+			v.add(fork.field, ValidationInvariant, SeverityError, "%s time offset (%d) is before the Regolith time offset (%d)", fork.name, *fork.offset, *regolith)
+		}
+	}
+}
+
+var addressPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+
+// ValidateDeployConfigJSON checks the address checksums of a raw deploy config.
+// Addresses in all lowercase or all uppercase have no checksum, and are accepted.
+// The checksums are lost once the deploy config is decoded, so they are checked on the raw JSON.
+func ValidateDeployConfigJSON(data []byte) (ValidationErrors, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal deploy config: %w", err)
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var v validator
+	for _, name := range names {
+		var s string
+		if err := json.Unmarshal(fields[name], &s); err != nil || !addressPattern.MatchString(s) {
+			continue
+		}
+		hex := s[2:]
+		if hex == strings.ToLower(hex) || hex == strings.ToUpper(hex) {
+			continue
+		}
+		if checksummed := common.HexToAddress(s).Hex(); checksummed != s {
+			v.add(name, ValidationChecksum, SeverityError, "%s has an invalid checksum, expected %s", s, checksummed)
+		}
+	}
+	return v.results, nil
+}
@@ -0,0 +1,117 @@
+package genesis
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func TestValidate(t *testing.T) {
+	config, err := NewDeployConfig("testdata/test-deploy-config-full.json")
+	require.NoError(t, err)
+	require.Empty(t, config.Validate().Errors())
+	require.NoError(t, config.Check())
+
+	config.L1ChainID = 0
+	config.PortalGuardian = common.Address{}
+	config.BaseFeeVaultWithdrawalNetwork = "L3"
+	results := config.Validate().Errors()
+	require.Len(t, results, 3)
+	require.Equal(t, &ValidationError{
+		Field:    "l1ChainID",
+		Code:     ValidationRequired,
+		Severity: SeverityError,
+		Message:  "L1ChainID cannot be 0",
+	}, results[0])
+	require.Equal(t, "portalGuardian", results[1].Field)
+	require.Equal(t, ValidationInvalid, results[2].Code)
+
+	// Check returns the first error, and errors are recognizable as an invalid deploy config
+	err = config.Check()
+	require.Equal(t, results[0], err)
+	require.ErrorIs(t, err, ErrInvalidDeployConfig)
+	require.ErrorIs(t, results, ErrInvalidDeployConfig)
+	var validationErr *ValidationError
+	require.True(t, errors.As(results, &validationErr))
+	require.Equal(t, "l1ChainID", validationErr.Field)
+}
+
+func TestValidateForks(t *testing.T) {
+	offset := func(v uint64) *hexutil.Uint64 {
+		o := hexutil.Uint64(v)
+		return &o
+	}
+	tests := []struct {
+		name      string
+		regolith  *hexutil.Uint64
+		canyon    *hexutil.Uint64
+		spanBatch *hexutil.Uint64
+		field     string
+		err       string
+	}{
+		{name: "NoForks"},
+		{name: "Ordered", regolith: offset(0), canyon: offset(10), spanBatch: offset(10)},
+		{name: "CanyonWithoutRegolith", canyon: offset(10), field: "l2GenesisCanyonTimeOffset", err: "Canyon is scheduled, but Regolith is not"},
+		{name: "SpanBatchBeforeRegolith", regolith: offset(20), spanBatch: offset(10), field: "l2GenesisSpanBatchTimeOffset", err: "SpanBatch time offset (10) is before the Regolith time offset (20)"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			config, err := NewDeployConfig("testdata/test-deploy-config-full.json")
+			require.NoError(t, err)
+			config.L2GenesisRegolithTimeOffset = test.regolith
+			config.L2GenesisCanyonTimeOffset = test.canyon
+			config.L2GenesisSpanBatchTimeOffset = test.spanBatch
+			errs := config.Validate().Errors()
+			if test.err == "" {
+				require.Empty(t, errs)
+				return
+			}
+			require.Len(t, errs, 1)
+			require.Equal(t, ValidationInvariant, errs[0].Code)
+			require.Equal(t, test.field, errs[0].Field)
+			require.Equal(t, test.err, errs[0].Message)
+		})
+	}
+}
+
+func TestValidateWarnings(t *testing.T) {
+	config, err := NewDeployConfig("testdata/test-deploy-config-full.json")
+	require.NoError(t, err)
+	config.GasPriceOracleScalar = maxGasPriceOracleScalar + 1
+	config.SequencerFeeVaultMinimumWithdrawalAmount = nil
+	results := config.Validate()
+	require.Empty(t, results.Errors())
+	fields := make([]string, 0)
+	for _, w := range results.Warnings() {
+		fields = append(fields, w.Field)
+	}
+	require.Contains(t, fields, "gasPriceOracleScalar")
+	require.Contains(t, fields, "sequencerFeeVaultMinimumWithdrawalAmount")
+	require.NoError(t, config.Check())
+}
+
+func TestValidateDeployConfigJSON(t *testing.T) {
+	results, err := ValidateDeployConfigJSON([]byte(`{
+		"l1ChainID": 900,
+		"finalSystemOwner": "0x9965507D1a55bcC2695C58ba16FB37d819B0A4dc",
+		"proxyAdminOwner": "0x9965507d1a55bcc2695c58ba16fb37d819b0a4dc",
+		"portalGuardian": "0x9965507D1A55BCC2695C58BA16FB37D819B0A4DC",
+		"batchSenderAddress": "0x9965507d1a55bcC2695C58ba16FB37d819B0A4dc",
+		"l1GenesisBlockMixHash": "0x0000000000000000000000000000000000000000000000000000000000000000"
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, ValidationErrors{{
+		Field:    "batchSenderAddress",
+		Code:     ValidationChecksum,
+		Severity: SeverityError,
+		Message:  "0x9965507d1a55bcC2695C58ba16FB37d819B0A4dc has an invalid checksum, expected 0x9965507D1a55bcC2695C58ba16FB37d819B0A4dc",
+	}}, results)
+
+	_, err = ValidateDeployConfigJSON([]byte(`[]`))
+	require.Error(t, err)
+}
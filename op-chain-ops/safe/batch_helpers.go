@@ -51,6 +51,10 @@ func stringifyArg(argument any) (string, error) {
 		return strconv.FormatUint(uint64(arg), 10), nil
 	case []byte:
 		return hexutil.Encode(arg), nil
+	case common.Hash:
+		return arg.Hex(), nil
+	case [32]byte:
+		return common.Hash(arg).Hex(), nil
 	case []any:
 		ret := make([]string, len(arg))
 		for i, v := range arg {
@@ -129,6 +133,15 @@ func unstringifyArg(arg string, typ string) (any, error) {
 		return arg, nil
 	case "bytes":
 		return hexutil.Decode(arg)
+	case "bytes32":
+		val, err := hexutil.Decode(arg)
+		if err != nil {
+			return nil, err
+		}
+		if len(val) != 32 {
+			return nil, fmt.Errorf("expected 32 bytes, got %d", len(val))
+		}
+		return [32]byte(val), nil
 	default:
 		return nil, fmt.Errorf("unknown type: %s", typ)
 	}
@@ -189,6 +202,8 @@ func stringifyType(t abi.Type) (string, error) {
 		return t.String(), nil
 	case abi.BytesTy:
 		return t.String(), nil
+	case abi.FixedBytesTy:
+		return t.String(), nil
 	default:
 		return "", fmt.Errorf("unknown type: %d", t.T)
 	}
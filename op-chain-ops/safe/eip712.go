@@ -0,0 +1,155 @@
+package safe
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SafeOperation is the type of call that a Safe makes.
+type SafeOperation uint8
+
+const (
+	SafeOperationCall         SafeOperation = 0
+	SafeOperationDelegateCall SafeOperation = 1
+)
+
+// MultiSendCallOnly is the canonical deployment of the MultiSendCallOnly v1.3.0 contract,
+// which the tx-builder app delegate-calls to execute a batch of more than one transaction.
+var MultiSendCallOnly = common.HexToAddress("0x40A2aCCbd92BCA938b02010E17A5b8929b49130D")
+
+var (
+	domainSeparatorTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(uint256 chainId,address verifyingContract)"))
+	safeTxTypeHash          = crypto.Keccak256Hash([]byte("SafeTx(address to,uint256 value,bytes data,uint8 operation,uint256 safeTxGas,uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)"))
+)
+
+// SafeTx is the transaction that the owners of a Safe sign, to execute a batch.
+// Gas refunds are not used: the owner that executes the transaction pays for its gas.
+type SafeTx struct {
+	To        common.Address `json:"to"`
+	Value     *big.Int       `json:"value"`
+	Data      hexutil.Bytes  `json:"data"`
+	Operation SafeOperation  `json:"operation"`
+	Nonce     uint64         `json:"nonce"`
+}
+
+// SafeTxHashes are the EIP-712 hashes of a SafeTx. Hardware wallets display the domain and message hashes
+// when signing, so signers can check them against an independently built bundle.
+type SafeTxHashes struct {
+	DomainHash  common.Hash `json:"domainHash"`
+	MessageHash common.Hash `json:"messageHash"`
+	SafeTxHash  common.Hash `json:"safeTxHash"`
+}
+
+// SafeTx returns the transaction that executes the batch from the Safe, with the given Safe nonce.
+// A batch of a single transaction is called directly, a larger batch is delegate-called through multiSend.
+func (b *Batch) SafeTx(multiSend common.Address, nonce uint64) (*SafeTx, error) {
+	if len(b.Transactions) == 0 {
+		return nil, errors.New("empty batch")
+	}
+	if err := b.Check(); err != nil {
+		return nil, err
+	}
+	if len(b.Transactions) == 1 {
+		tx := b.Transactions[0]
+		data, err := tx.calldata()
+		if err != nil {
+			return nil, err
+		}
+		return &SafeTx{To: tx.To, Value: tx.Value, Data: data, Operation: SafeOperationCall, Nonce: nonce}, nil
+	}
+
+	var packed []byte
+	for i, tx := range b.Transactions {
+		data, err := tx.calldata()
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+		packed = append(packed, byte(SafeOperationCall))
+		packed = append(packed, tx.To.Bytes()...)
+		value := tx.Value
+		if value == nil {
+			value = new(big.Int)
+		}
+		packed = append(packed, common.BigToHash(value).Bytes()...)
+		packed = append(packed, common.BigToHash(new(big.Int).SetUint64(uint64(len(data)))).Bytes()...)
+		packed = append(packed, data...)
+	}
+	bytesTy, err := abi.NewType("bytes", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	multiSendMethod := abi.NewMethod("multiSend", "multiSend", abi.Function, "payable", false, true,
+		abi.Arguments{{Name: "transactions", Type: bytesTy}}, nil)
+	encoded, err := multiSendMethod.Inputs.Pack(packed)
+	if err != nil {
+		return nil, err
+	}
+	data := append(common.CopyBytes(multiSendMethod.ID), encoded...)
+	return &SafeTx{To: multiSend, Value: new(big.Int), Data: data, Operation: SafeOperationDelegateCall, Nonce: nonce}, nil
+}
+
+// calldata returns the calldata of the transaction, encoding it from the input values if it is not set.
+func (bt *BatchTransaction) calldata() ([]byte, error) {
+	if len(bt.Data) > 0 {
+		return bt.Data, nil
+	}
+	if len(bt.Method.Inputs) == 0 && bt.Method.Name == "" {
+		return nil, nil
+	}
+	values := make([]any, len(bt.Method.Inputs))
+	for i, input := range bt.Method.Inputs {
+		value, ok := bt.InputValues[input.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing input %s", input.Name)
+		}
+		arg, err := unstringifyArg(value, input.Type)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = arg
+	}
+	encoded, err := bt.Arguments().PackValues(values)
+	if err != nil {
+		return nil, err
+	}
+	return append(crypto.Keccak256([]byte(bt.Signature()))[:4], encoded...), nil
+}
+
+// Hashes computes the EIP-712 hashes of the transaction, for the Safe on the given chain.
+// The domain is the one of Safe v1.3.0 and later.
+func (tx *SafeTx) Hashes(chainID *big.Int, safe common.Address) SafeTxHashes {
+	domainHash := crypto.Keccak256Hash(
+		domainSeparatorTypeHash[:],
+		common.BigToHash(chainID).Bytes(),
+		common.BytesToHash(safe.Bytes()).Bytes(),
+	)
+	value := tx.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	var nonce [32]byte
+	binary.BigEndian.PutUint64(nonce[24:], tx.Nonce)
+	var zero [32]byte
+	messageHash := crypto.Keccak256Hash(
+		safeTxTypeHash[:],
+		common.BytesToHash(tx.To.Bytes()).Bytes(),
+		common.BigToHash(value).Bytes(),
+		crypto.Keccak256(tx.Data),
+		common.BigToHash(big.NewInt(int64(tx.Operation))).Bytes(),
+		zero[:], // safeTxGas
+		zero[:], // baseGas
+		zero[:], // gasPrice
+		zero[:], // gasToken
+		zero[:], // refundReceiver
+		nonce[:],
+	)
+	safeTxHash := crypto.Keccak256Hash([]byte{0x19, 0x01}, domainHash[:], messageHash[:])
+	return SafeTxHashes{DomainHash: domainHash, MessageHash: messageHash, SafeTxHash: safeTxHash}
+}
@@ -0,0 +1,174 @@
+package safe
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// AdminOp is a declarative admin operation, a call of a contract method.
+// The same operations can be sent directly by the admin account, or bundled
+// for the admin multisig.
+type AdminOp struct {
+	Description string         `json:"description,omitempty"`
+	To          common.Address `json:"to"`
+	Value       *big.Int       `json:"value,omitempty"`
+	// Signature is the signature of the method to call, with optional parameter names,
+	// e.g. "upgrade(address _proxy, address _implementation)".
+	Signature string `json:"signature"`
+	// Args are the arguments of the call, in the string format of the tx-builder app.
+	Args []string `json:"args"`
+}
+
+// Call is a call that can be sent directly.
+type Call struct {
+	To    common.Address `json:"to"`
+	Value *big.Int       `json:"value"`
+	Data  hexutil.Bytes  `json:"data"`
+}
+
+// AdminOps is a named list of admin operations.
+type AdminOps struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Operations  []AdminOp `json:"operations"`
+}
+
+// NewAdminOps reads a JSON list of admin operations from disk.
+func NewAdminOps(path string) (*AdminOps, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("admin operations at %s not found: %w", path, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(file))
+	dec.DisallowUnknownFields()
+	var ops AdminOps
+	if err := dec.Decode(&ops); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal admin operations: %w", err)
+	}
+	if len(ops.Operations) == 0 {
+		return nil, errors.New("no admin operations")
+	}
+	return &ops, nil
+}
+
+func (o *AdminOp) value() *big.Int {
+	if o.Value == nil {
+		return new(big.Int)
+	}
+	return o.Value
+}
+
+// Method returns the method of the operation, and the parsed arguments.
+func (o *AdminOp) Method() (abi.Method, []any, error) {
+	method, err := parseMethod(o.Signature, o.value().Sign() > 0)
+	if err != nil {
+		return abi.Method{}, nil, err
+	}
+	if len(o.Args) != len(method.Inputs) {
+		return abi.Method{}, nil, fmt.Errorf("requires %d inputs but got %d for %s", len(method.Inputs), len(o.Args), method.Sig)
+	}
+	args := make([]any, len(o.Args))
+	for i, input := range method.Inputs {
+		args[i], err = unstringifyArg(o.Args[i], input.Type.String())
+		if err != nil {
+			return abi.Method{}, nil, fmt.Errorf("invalid argument %s of %s: %w", input.Name, method.Sig, err)
+		}
+	}
+	return method, args, nil
+}
+
+// Call encodes the operation as a call.
+func (o *AdminOp) Call() (*Call, error) {
+	method, args, err := o.Method()
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := method.Inputs.Pack(args...)
+	if err != nil {
+		return nil, err
+	}
+	data := append(common.CopyBytes(method.ID), encoded...)
+	return &Call{To: o.To, Value: o.value(), Data: data}, nil
+}
+
+// Calls encodes the operations as calls, to send them directly.
+func (a *AdminOps) Calls() ([]*Call, error) {
+	calls := make([]*Call, len(a.Operations))
+	for i := range a.Operations {
+		call, err := a.Operations[i].Call()
+		if err != nil {
+			return nil, fmt.Errorf("invalid admin operation %d: %w", i, err)
+		}
+		calls[i] = call
+	}
+	return calls, nil
+}
+
+// Batch bundles the operations as a tx-builder batch of the Safe.
+func (a *AdminOps) Batch(chainID *big.Int, safe common.Address) (*Batch, error) {
+	batch := &Batch{
+		Version: "1.0",
+		ChainID: chainID,
+		Meta: BatchMeta{
+			CreatedFromSafeAddress: safe.Hex(),
+			Name:                   a.Name,
+			Description:            a.Description,
+		},
+	}
+	for i := range a.Operations {
+		op := &a.Operations[i]
+		method, args, err := op.Method()
+		if err != nil {
+			return nil, fmt.Errorf("invalid admin operation %d: %w", i, err)
+		}
+		iface := &abi.ABI{Methods: map[string]abi.Method{method.Name: method}}
+		if err := batch.AddCall(op.To, op.value(), method.Name, args, iface); err != nil {
+			return nil, fmt.Errorf("invalid admin operation %d: %w", i, err)
+		}
+	}
+	return batch, batch.Check()
+}
+
+// parseMethod parses a method signature with optional parameter names.
+// Unnamed parameters are named by their position, e.g. arg0, as the tx-builder app refers to inputs by name.
+// Tuples are not supported.
+func parseMethod(sig string, payable bool) (abi.Method, error) {
+	open := strings.Index(sig, "(")
+	if open <= 0 || !strings.HasSuffix(sig, ")") {
+		return abi.Method{}, fmt.Errorf("invalid method signature %q", sig)
+	}
+	name := strings.TrimSpace(sig[:open])
+	params := strings.TrimSpace(sig[open+1 : len(sig)-1])
+	var inputs abi.Arguments
+	if params != "" {
+		for i, param := range strings.Split(params, ",") {
+			fields := strings.Fields(param)
+			if len(fields) == 0 || len(fields) > 2 || strings.ContainsAny(param, "()") {
+				return abi.Method{}, fmt.Errorf("invalid parameter %q of method signature %q", param, sig)
+			}
+			typ, err := abi.NewType(fields[0], "", nil)
+			if err != nil {
+				return abi.Method{}, fmt.Errorf("invalid parameter type %q of method signature %q: %w", fields[0], sig, err)
+			}
+			argName := fmt.Sprintf("arg%d", i)
+			if len(fields) == 2 {
+				argName = fields[1]
+			}
+			inputs = append(inputs, abi.Argument{Name: argName, Type: typ})
+		}
+	}
+	mutability := "nonpayable"
+	if payable {
+		mutability = "payable"
+	}
+	return abi.NewMethod(name, name, abi.Function, mutability, false, payable, inputs, nil), nil
+}
@@ -0,0 +1,190 @@
+package safe
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+)
+
+func TestAdminOpsCalls(t *testing.T) {
+	ops, err := NewAdminOps("testdata/admin-ops.json")
+	require.NoError(t, err)
+	calls, err := ops.Calls()
+	require.NoError(t, err)
+	require.Len(t, calls, 2)
+
+	proxyAdminABI, err := bindings.ProxyAdminMetaData.GetAbi()
+	require.NoError(t, err)
+	expected, err := proxyAdminABI.Pack("upgrade",
+		common.HexToAddress("0x99C9fc46f92E8a1c0deC1b1747d010903E884bE1"),
+		common.HexToAddress("0x64B5a5Ed26DCb17370Ff4d33a8D503f0fbD06CfF"))
+	require.NoError(t, err)
+	require.Equal(t, &Call{
+		To:    common.HexToAddress("0x543bA4AADBAb8f9025686Bd03993043599c6fB04"),
+		Value: new(big.Int),
+		Data:  expected,
+	}, calls[0])
+	require.Equal(t, hexutil.Bytes(crypto.Keccak256([]byte("pause()"))[:4]), calls[1].Data)
+}
+
+func TestAdminOpsBatch(t *testing.T) {
+	ops, err := NewAdminOps("testdata/admin-ops.json")
+	require.NoError(t, err)
+	safe := common.HexToAddress("0x5a0Aae59D09fccBdDb6C6CcEB07B7279367C3d2A")
+	batch, err := ops.Batch(big.NewInt(1), safe)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 2)
+	require.Equal(t, safe.Hex(), batch.Meta.CreatedFromSafeAddress)
+	require.Equal(t, "upgrade", batch.Transactions[0].Method.Name)
+	require.Equal(t, map[string]string{
+		"_proxy":          "0x99C9fc46f92E8a1c0deC1b1747d010903E884bE1",
+		"_implementation": "0x64B5a5Ed26DCb17370Ff4d33a8D503f0fbD06CfF",
+	}, batch.Transactions[0].InputValues)
+
+	// the bundle calldata matches the calldata for direct sending
+	calls, err := ops.Calls()
+	require.NoError(t, err)
+	for i, call := range calls {
+		require.Equal(t, []byte(call.Data), batch.Transactions[i].Data)
+	}
+
+	// the bundle survives a JSON round-trip
+	data, err := json.Marshal(batch)
+	require.NoError(t, err)
+	decoded := new(Batch)
+	require.NoError(t, json.Unmarshal(data, decoded))
+	require.NoError(t, decoded.Check())
+}
+
+func TestAdminOpInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		op   AdminOp
+		err  string
+	}{
+		{name: "NoParens", op: AdminOp{Signature: "pause"}, err: "invalid method signature"},
+		{name: "Tuple", op: AdminOp{Signature: "f((uint256,uint256) x)", Args: []string{"1"}}, err: "invalid parameter"},
+		{name: "UnknownType", op: AdminOp{Signature: "f(foo x)", Args: []string{"1"}}, err: "invalid parameter type"},
+		{name: "ArgCount", op: AdminOp{Signature: "f(uint256 x)"}, err: "requires 1 inputs but got 0"},
+		{name: "BadArg", op: AdminOp{Signature: "f(uint256 x)", Args: []string{"0x01"}}, err: "invalid argument x"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.op.Call()
+			require.ErrorContains(t, err, test.err)
+		})
+	}
+}
+
+func TestParseMethod(t *testing.T) {
+	method, err := parseMethod("setGasConfig(uint256 _overhead, uint256)", false)
+	require.NoError(t, err)
+	require.Equal(t, "setGasConfig(uint256,uint256)", method.Sig)
+	require.Equal(t, "_overhead", method.Inputs[0].Name)
+	require.Equal(t, "arg1", method.Inputs[1].Name)
+
+	method, err = parseMethod("setPrestate(bytes32 _prestate)", false)
+	require.NoError(t, err)
+	op := AdminOp{Signature: "setPrestate(bytes32 _prestate)", Args: []string{common.Hash{0x01}.Hex()}}
+	call, err := op.Call()
+	require.NoError(t, err)
+	require.Equal(t, method.ID, []byte(call.Data[:4]))
+	require.Equal(t, common.Hash{0x01}.Bytes(), []byte(call.Data[4:]))
+}
+
+func TestSafeTx(t *testing.T) {
+	ops, err := NewAdminOps("testdata/admin-ops.json")
+	require.NoError(t, err)
+	batch, err := ops.Batch(big.NewInt(1), common.Address{})
+	require.NoError(t, err)
+
+	tx, err := batch.SafeTx(MultiSendCallOnly, 7)
+	require.NoError(t, err)
+	require.Equal(t, MultiSendCallOnly, tx.To)
+	require.Equal(t, SafeOperationDelegateCall, tx.Operation)
+	require.Equal(t, crypto.Keccak256([]byte("multiSend(bytes)"))[:4], []byte(tx.Data[:4]))
+
+	// a single transaction is called directly
+	batch.Transactions = batch.Transactions[:1]
+	tx, err = batch.SafeTx(MultiSendCallOnly, 7)
+	require.NoError(t, err)
+	require.Equal(t, batch.Transactions[0].To, tx.To)
+	require.Equal(t, SafeOperationCall, tx.Operation)
+	require.Equal(t, batch.Transactions[0].Data, []byte(tx.Data))
+
+	_, err = new(Batch).SafeTx(MultiSendCallOnly, 0)
+	require.ErrorContains(t, err, "empty batch")
+}
+
+// TestSafeTxHashes checks the hashes against the generic EIP-712 implementation of geth.
+func TestSafeTxHashes(t *testing.T) {
+	chainID := big.NewInt(10)
+	safe := common.HexToAddress("0x5a0Aae59D09fccBdDb6C6CcEB07B7279367C3d2A")
+	tx := &SafeTx{
+		To:        common.HexToAddress("0x543bA4AADBAb8f9025686Bd03993043599c6fB04"),
+		Value:     big.NewInt(5),
+		Data:      []byte{0xde, 0xad, 0xbe, 0xef},
+		Operation: SafeOperationDelegateCall,
+		Nonce:     42,
+	}
+	hashes := tx.Hashes(chainID, safe)
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"SafeTx": {
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+				{Name: "operation", Type: "uint8"},
+				{Name: "safeTxGas", Type: "uint256"},
+				{Name: "baseGas", Type: "uint256"},
+				{Name: "gasPrice", Type: "uint256"},
+				{Name: "gasToken", Type: "address"},
+				{Name: "refundReceiver", Type: "address"},
+				{Name: "nonce", Type: "uint256"},
+			},
+		},
+		PrimaryType: "SafeTx",
+		Domain: apitypes.TypedDataDomain{
+			ChainId:           (*math.HexOrDecimal256)(chainID),
+			VerifyingContract: safe.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"to":             tx.To.Hex(),
+			"value":          "5",
+			"data":           hexutil.Encode(tx.Data),
+			"operation":      "1",
+			"safeTxGas":      "0",
+			"baseGas":        "0",
+			"gasPrice":       "0",
+			"gasToken":       common.Address{}.Hex(),
+			"refundReceiver": common.Address{}.Hex(),
+			"nonce":          "42",
+		},
+	}
+	domainHash, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	require.NoError(t, err)
+	messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	require.NoError(t, err)
+	safeTxHash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+
+	require.Equal(t, common.BytesToHash(domainHash), hashes.DomainHash)
+	require.Equal(t, common.BytesToHash(messageHash), hashes.MessageHash)
+	require.Equal(t, common.BytesToHash(safeTxHash), hashes.SafeTxHash)
+}
@@ -0,0 +1,88 @@
+package surgery
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	gstate "github.com/ethereum/go-ethereum/core/state"
+)
+
+// DumpState is a state dump that mutations can be applied to.
+type DumpState struct {
+	dump *gstate.Dump
+}
+
+var _ StorageReader = (*DumpState)(nil)
+
+func NewDumpState(dump *gstate.Dump) *DumpState {
+	if dump.Accounts == nil {
+		dump.Accounts = make(map[common.Address]gstate.DumpAccount)
+	}
+	return &DumpState{dump: dump}
+}
+
+// Dump returns the underlying state dump.
+func (d *DumpState) Dump() *gstate.Dump {
+	return d.dump
+}
+
+func (d *DumpState) StorageAt(_ context.Context, addr common.Address, slot common.Hash) (common.Hash, error) {
+	account, ok := d.dump.Accounts[addr]
+	if !ok {
+		return common.Hash{}, nil
+	}
+	return common.HexToHash(account.Storage[slot]), nil
+}
+
+// Apply writes the changes to the dump. Accounts that do not exist yet are created.
+// The state root of the dump is updated to match the changed state.
+func (d *DumpState) Apply(changes []Change) error {
+	for _, c := range changes {
+		account, ok := d.dump.Accounts[c.Address]
+		if !ok {
+			account = gstate.DumpAccount{Balance: "0"}
+		}
+		if account.Storage == nil {
+			account.Storage = make(map[common.Hash]string)
+		}
+		if c.After == (common.Hash{}) {
+			delete(account.Storage, c.Slot)
+		} else {
+			// match the encoding of geth state dumps: unprefixed hex, without leading zero bytes
+			account.Storage[c.Slot] = common.Bytes2Hex(bytes.TrimLeft(c.After[:], "\x00"))
+		}
+		d.dump.Accounts[c.Address] = account
+	}
+	root, err := d.StateRoot()
+	if err != nil {
+		return err
+	}
+	d.dump.Root = fmt.Sprintf("%x", root)
+	return nil
+}
+
+// StateRoot computes the state root of the dump.
+func (d *DumpState) StateRoot() (common.Hash, error) {
+	alloc := make(core.GenesisAlloc, len(d.dump.Accounts))
+	for addr, account := range d.dump.Accounts {
+		balance, ok := new(big.Int).SetString(account.Balance, 10)
+		if !ok {
+			return common.Hash{}, fmt.Errorf("failed to parse balance of %s", addr)
+		}
+		storage := make(map[common.Hash]common.Hash, len(account.Storage))
+		for k, v := range account.Storage {
+			storage[k] = common.HexToHash(v)
+		}
+		alloc[addr] = core.GenesisAccount{
+			Balance: balance,
+			Nonce:   account.Nonce,
+			Code:    account.Code,
+			Storage: storage,
+		}
+	}
+	return (&core.Genesis{Alloc: alloc}).ToBlock().Root(), nil
+}
@@ -0,0 +1,98 @@
+package surgery
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StateDB is the storage of a geth state database, e.g. a *state.StateDB,
+// or the MemoryStateDB that the genesis state is built with.
+type StateDB interface {
+	GetState(addr common.Address, key common.Hash) common.Hash
+	SetState(addr common.Address, key common.Hash, value common.Hash)
+}
+
+// Editor reads and writes the typed storage fields of accounts in a state database,
+// by the names of the storage layouts, and tracks the slots that it modifies for review.
+type Editor struct {
+	db      StateDB
+	layouts *Layouts
+
+	original map[slotKey]common.Hash
+	fields   map[slotKey][]*Field
+}
+
+func NewEditor(db StateDB, layouts *Layouts) *Editor {
+	return &Editor{
+		db:       db,
+		layouts:  layouts,
+		original: make(map[slotKey]common.Hash),
+		fields:   make(map[slotKey][]*Field),
+	}
+}
+
+// Get reads the value of a storage field, see Layouts.Field for the supported fields.
+// Addresses are decoded as common.Address, integers as *big.Int, bytes32 as common.Hash and strings as string.
+func (e *Editor) Get(addr common.Address, label string, keys ...any) (any, error) {
+	f, err := e.layouts.Field(addr, label, keys...)
+	if err != nil {
+		return nil, err
+	}
+	return f.Decode(e.db.GetState(addr, f.Slot))
+}
+
+// Set writes the value of a storage field, leaving the fields that are packed into the same slot unchanged.
+// The values are encoded like the values of the genesis storage config.
+func (e *Editor) Set(addr common.Address, label string, value any, keys ...any) error {
+	f, err := e.layouts.Field(addr, label, keys...)
+	if err != nil {
+		return err
+	}
+	word, err := f.Encode(e.db.GetState(addr, f.Slot), value)
+	if err != nil {
+		return fmt.Errorf("cannot set %s of %s: %w", f.Name, addr, err)
+	}
+	k := slotKey{addr, f.Slot}
+	if !e.hasField(k, f.Name) {
+		e.fields[k] = append(e.fields[k], f)
+	}
+	e.SetSlot(addr, f.Slot, word)
+	return nil
+}
+
+// SetSlot writes a raw storage slot, for storage that is not described by a storage layout.
+func (e *Editor) SetSlot(addr common.Address, slot common.Hash, value common.Hash) {
+	k := slotKey{addr, slot}
+	if _, ok := e.original[k]; !ok {
+		e.original[k] = e.db.GetState(addr, slot)
+	}
+	e.db.SetState(addr, slot, value)
+}
+
+func (e *Editor) hasField(k slotKey, name string) bool {
+	for _, f := range e.fields[k] {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Changes returns the changes of the slots that were written, sorted by address and slot.
+// Slots that were written back to their original value are not included.
+func (e *Editor) Changes() []Change {
+	var changes []Change
+	for k, before := range e.original {
+		if after := e.db.GetState(k.addr, k.slot); after != before {
+			changes = append(changes, Change{Address: k.addr, Slot: k.slot, Before: before, After: after})
+		}
+	}
+	sortChanges(changes)
+	return changes
+}
+
+// Diff returns the changes with the changed fields decoded, including the written mapping entries.
+func (e *Editor) Diff() []NamedChange {
+	return e.layouts.nameChanges(e.Changes(), e.fields)
+}
@@ -0,0 +1,102 @@
+package surgery
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	gstate "github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+)
+
+func TestEditor(t *testing.T) {
+	db, err := gstate.New(types.EmptyRootHash, gstate.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	l1Block := predeploys.L1BlockAddr
+	token := predeploys.GovernanceTokenAddr
+	layouts, err := NewLayouts(map[common.Address]string{
+		l1Block: "L1Block",
+		token:   "GovernanceToken",
+	})
+	require.NoError(t, err)
+	// number and timestamp are packed into slot 0
+	db.SetState(l1Block, slot(0), common.HexToHash("0x0000000000000000000000000000000000000000000000650000000000000007"))
+	editor := NewEditor(db, layouts)
+
+	number, err := editor.Get(l1Block, "number")
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(7), number)
+	require.NoError(t, editor.Set(l1Block, "timestamp", uint64(1000)))
+	timestamp, err := editor.Get(l1Block, "timestamp")
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), timestamp)
+	number, err = editor.Get(l1Block, "number")
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(7), number, "packed fields are left unchanged")
+	require.ErrorContains(t, editor.Set(l1Block, "number", new(big.Int).Lsh(common.Big1, 64)), "overflows uint64")
+
+	alice := common.Address{0xaa}
+	require.NoError(t, editor.Set(token, "_name", "Token"))
+	require.NoError(t, editor.Set(token, "_owner", alice))
+	require.NoError(t, editor.Set(token, "_balances", big.NewInt(1e18), alice))
+	balance, err := editor.Get(token, "_balances", alice)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1e18), balance)
+	name, err := editor.Get(token, "_name")
+	require.NoError(t, err)
+	require.Equal(t, "Token", name)
+	require.NoError(t, editor.Set(token, "_allowances", uint64(5), alice, token))
+	// set and revert a field: it is not part of the changes
+	require.NoError(t, editor.Set(token, "_totalSupply", uint64(1)))
+	require.NoError(t, editor.Set(token, "_totalSupply", uint64(0)))
+
+	require.ErrorContains(t, editor.Set(token, "_balances", uint64(1)), "missing a key")
+	require.ErrorContains(t, editor.Set(token, "_owner", alice, alice), "not a mapping")
+	require.ErrorIs(t, editor.Set(token, "_nonces", uint64(1), alice), errUnsupportedType)
+	_, err = editor.Get(token, "_unknown")
+	require.ErrorContains(t, err, "not found")
+
+	balanceSlot := crypto.Keccak256Hash(common.LeftPadBytes(alice[:], 32), slot(0).Bytes())
+	allowanceSlot := crypto.Keccak256Hash(common.LeftPadBytes(token[:], 32),
+		crypto.Keccak256(common.LeftPadBytes(alice[:], 32), slot(1).Bytes()))
+	require.Equal(t, common.BigToHash(big.NewInt(5)), db.GetState(token, allowanceSlot))
+
+	changes := editor.Changes()
+	// the L1Block slot 0, and the token _name, _owner, _balances and _allowances slots
+	require.Len(t, changes, 5)
+	diff := editor.Diff()
+	byName := make(map[string]FieldChange)
+	for _, c := range diff {
+		for _, f := range c.Fields {
+			byName[c.Contract+"."+f.Name] = f
+		}
+	}
+	require.Equal(t, FieldChange{Name: "timestamp", Type: "uint64", Before: "101", After: "1000"}, byName["L1Block.timestamp"])
+	require.NotContains(t, byName, "L1Block.number", "unchanged packed fields are not part of the diff")
+	require.Equal(t, FieldChange{Name: "_name", Type: "string", Before: "", After: "Token"}, byName["GovernanceToken._name"])
+	require.Equal(t, alice.Hex(), byName["GovernanceToken._owner"].After)
+	require.Equal(t, "1000000000000000000", byName["GovernanceToken._balances["+alice.Hex()+"]"].After)
+	require.Equal(t, "5", byName["GovernanceToken._allowances["+alice.Hex()+"]["+token.Hex()+"]"].After)
+	for _, c := range diff {
+		if c.Slot == balanceSlot {
+			require.Equal(t, "GovernanceToken", c.Contract)
+		}
+	}
+
+	// the diff of raw changes only names the fields that can be found by slot
+	named := layouts.NameChanges(changes)
+	for _, c := range named {
+		if c.Slot == balanceSlot {
+			require.Empty(t, c.Fields)
+		}
+	}
+	_, err = json.Marshal(named)
+	require.NoError(t, err)
+}
@@ -0,0 +1,295 @@
+package surgery
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/solc"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/state"
+)
+
+var errUnsupportedType = errors.New("unsupported storage type")
+
+// Layouts are the storage layouts of the contracts of accounts, as registered by op-bindings.
+// They locate the storage fields of the accounts by name, and decode the slots of storage changes.
+type Layouts struct {
+	contracts map[common.Address]*contractLayout
+}
+
+type contractLayout struct {
+	name   string
+	layout *solc.StorageLayout
+}
+
+// NewLayouts loads the storage layouts of the contracts of the given accounts, keyed by address.
+// For proxied contracts, this is the name of the implementation contract.
+func NewLayouts(contracts map[common.Address]string) (*Layouts, error) {
+	l := &Layouts{contracts: make(map[common.Address]*contractLayout, len(contracts))}
+	for addr, name := range contracts {
+		layout, err := bindings.GetStorageLayout(name)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load storage layout of %s: %w", addr, err)
+		}
+		l.contracts[addr] = &contractLayout{name: name, layout: layout}
+	}
+	return l, nil
+}
+
+// Field is a typed storage field of an account, located with the storage layout of its contract.
+type Field struct {
+	Address  common.Address
+	Contract string
+	// Name is the label of the storage variable, followed by the keys for mapping entries, e.g. _balances[0x..].
+	Name   string
+	Slot   common.Hash
+	Offset uint
+	Type   solc.StorageLayoutType
+}
+
+// Field locates the storage variable with the given label, of the contract of the account.
+// Entries of mappings are located by their keys, one key per level of the mapping.
+// Only value types of at most 32 bytes, and the slot of strings and bytes, are supported.
+func (l *Layouts) Field(addr common.Address, label string, keys ...any) (*Field, error) {
+	c, ok := l.contracts[addr]
+	if !ok {
+		return nil, fmt.Errorf("no storage layout for %s", addr)
+	}
+	entry, err := c.layout.GetStorageLayoutEntry(label)
+	if err != nil {
+		return nil, fmt.Errorf("storage of %s (%s): %w", addr, c.name, err)
+	}
+	typ, err := c.layout.GetStorageLayoutType(entry.Type)
+	if err != nil {
+		return nil, fmt.Errorf("storage type of %s.%s: %w", c.name, label, err)
+	}
+	f := &Field{
+		Address:  addr,
+		Contract: c.name,
+		Name:     label,
+		Slot:     common.BigToHash(new(big.Int).SetUint64(uint64(entry.Slot))),
+		Offset:   entry.Offset,
+		Type:     typ,
+	}
+	for _, key := range keys {
+		if f.Type.Encoding != "mapping" {
+			return nil, fmt.Errorf("%s.%s is not a mapping", c.name, f.Name)
+		}
+		keyType, err := c.layout.GetStorageLayoutType(f.Type.Key)
+		if err != nil {
+			return nil, fmt.Errorf("key type of %s.%s: %w", c.name, f.Name, err)
+		}
+		if keyType.Encoding != "inplace" {
+			return nil, fmt.Errorf("%w: %s.%s has %s keys", errUnsupportedType, c.name, f.Name, keyType.Label)
+		}
+		encodedKey, err := encodeValue(keyType, key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key of %s.%s: %w", c.name, f.Name, err)
+		}
+		valueType, err := c.layout.GetStorageLayoutType(f.Type.Value)
+		if err != nil {
+			return nil, fmt.Errorf("value type of %s.%s: %w", c.name, f.Name, err)
+		}
+		f.Name = fmt.Sprintf("%s[%s]", f.Name, formatValue(decodeValue(keyType, encodedKey[:])))
+		f.Slot = crypto.Keccak256Hash(encodedKey[:], f.Slot[:])
+		f.Offset = 0
+		f.Type = valueType
+	}
+	if f.Type.Encoding == "mapping" {
+		return nil, fmt.Errorf("%s.%s is a mapping, missing a key", c.name, f.Name)
+	}
+	if !f.supported() {
+		return nil, fmt.Errorf("%w: %s.%s is a %s", errUnsupportedType, c.name, f.Name, f.Type.Label)
+	}
+	return f, nil
+}
+
+func (f *Field) supported() bool {
+	switch f.Type.Encoding {
+	case "inplace":
+		return f.Type.NumberOfBytes <= 32 && !strings.HasPrefix(f.Type.Label, "struct") && !strings.HasSuffix(f.Type.Label, "]")
+	case "bytes":
+		return true
+	default:
+		return false
+	}
+}
+
+// Decode decodes the value of the field from the slot that it is stored in.
+// Strings and bytes of 32 bytes or longer are stored outside of the slot, and cannot be decoded.
+func (f *Field) Decode(word common.Hash) (any, error) {
+	if f.Type.Encoding == "bytes" {
+		if word[31]&1 == 1 {
+			return nil, fmt.Errorf("%w: %s is a long %s", errUnsupportedType, f.Name, f.Type.Label)
+		}
+		data := common.CopyBytes(word[:word[31]/2])
+		if f.Type.Label == "string" {
+			return string(data), nil
+		}
+		return hexutil.Bytes(data), nil
+	}
+	end := 32 - f.Offset
+	return decodeValue(f.Type, word[end-f.Type.NumberOfBytes:end]), nil
+}
+
+// Encode sets the value of the field in the slot that it is stored in,
+// leaving the other fields that are packed into the same slot unchanged.
+func (f *Field) Encode(word common.Hash, value any) (common.Hash, error) {
+	encoded, err := encodeValue(f.Type, value)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("cannot encode %s: %w", f.Name, err)
+	}
+	if f.Type.Encoding == "bytes" {
+		return encoded, nil
+	}
+	for _, b := range encoded[:32-f.Type.NumberOfBytes] {
+		if b != 0 {
+			return common.Hash{}, fmt.Errorf("cannot encode %s: value overflows %s", f.Name, f.Type.Label)
+		}
+	}
+	end := 32 - f.Offset
+	copy(word[end-f.Type.NumberOfBytes:end], encoded[32-f.Type.NumberOfBytes:])
+	return word, nil
+}
+
+// FieldsAt returns the fields that are stored in the slot of the account.
+// Mapping entries cannot be found by slot, the keys of the mapping are not known.
+func (l *Layouts) FieldsAt(addr common.Address, slot common.Hash) []*Field {
+	c, ok := l.contracts[addr]
+	if !ok {
+		return nil
+	}
+	var fields []*Field
+	for _, entry := range c.layout.Storage {
+		if common.BigToHash(new(big.Int).SetUint64(uint64(entry.Slot))) != slot {
+			continue
+		}
+		// errors are for types that cannot be decoded
+		if f, err := l.Field(addr, entry.Label); err == nil {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// NamedChange is a change of a storage slot, with the changed fields of the slot decoded.
+type NamedChange struct {
+	Change
+	Contract string        `json:"contract,omitempty"`
+	Fields   []FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange is the change of a storage field, with the values decoded to strings.
+type FieldChange struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// NameChanges decodes the fields of the changed slots, for review.
+// The changes of accounts without a storage layout, and of slots that
+// do not hold fields that can be decoded, are included without any fields.
+func (l *Layouts) NameChanges(changes []Change) []NamedChange {
+	return l.nameChanges(changes, nil)
+}
+
+func (l *Layouts) nameChanges(changes []Change, known map[slotKey][]*Field) []NamedChange {
+	named := make([]NamedChange, 0, len(changes))
+	for _, c := range changes {
+		n := NamedChange{Change: c}
+		if contract, ok := l.contracts[c.Address]; ok {
+			n.Contract = contract.name
+		}
+		fields := append(append([]*Field(nil), known[slotKey{c.Address, c.Slot}]...), l.FieldsAt(c.Address, c.Slot)...)
+		seen := make(map[string]struct{})
+		for _, f := range fields {
+			if _, ok := seen[f.Name]; ok {
+				continue
+			}
+			seen[f.Name] = struct{}{}
+			if fc, ok := f.change(c.Before, c.After); ok {
+				n.Fields = append(n.Fields, fc)
+			}
+		}
+		named = append(named, n)
+	}
+	return named
+}
+
+// change decodes the field from the slot before and after the change, if the field changed.
+func (f *Field) change(before, after common.Hash) (FieldChange, bool) {
+	fc := FieldChange{Name: f.Name, Type: f.Type.Label}
+	b, errB := f.Decode(before)
+	a, errA := f.Decode(after)
+	if errB != nil || errA != nil {
+		// fall back to the raw slot, for values that cannot be decoded
+		fc.Before, fc.After = before.Hex(), after.Hex()
+		return fc, before != after
+	}
+	fc.Before, fc.After = formatValue(b), formatValue(a)
+	return fc, fc.Before != fc.After
+}
+
+// encodeValue encodes a value of a value type, or a short string, into a slot,
+// with the encoders of the genesis storage config.
+func encodeValue(typ solc.StorageLayoutType, value any) (common.Hash, error) {
+	label := typ.Label
+	switch {
+	case label == "bool":
+		return state.EncodeBoolValue(value, 0)
+	case label == "address", strings.HasPrefix(label, "contract "):
+		return state.EncodeAddressValue(value, 0)
+	case strings.HasPrefix(label, "uint"), strings.HasPrefix(label, "enum "):
+		return state.EncodeUintValue(value, 0)
+	case label == "bytes32":
+		return state.EncodeBytes32Value(value, 0)
+	case typ.Encoding == "bytes" && label == "string":
+		return state.EncodeStringValue(value, 0)
+	default:
+		return common.Hash{}, fmt.Errorf("%w: %s", errUnsupportedType, label)
+	}
+}
+
+// decodeValue decodes the bytes of a value type: booleans, addresses and contracts,
+// unsigned and signed integers and enums, and fixed size bytes.
+func decodeValue(typ solc.StorageLayoutType, b []byte) any {
+	label := typ.Label
+	switch {
+	case label == "bool":
+		return b[len(b)-1] != 0
+	case label == "address", strings.HasPrefix(label, "contract "):
+		return common.BytesToAddress(b)
+	case strings.HasPrefix(label, "uint"), strings.HasPrefix(label, "enum "):
+		return new(big.Int).SetBytes(b)
+	case strings.HasPrefix(label, "int"):
+		v := new(big.Int).SetBytes(b)
+		if len(b) > 0 && b[0]&0x80 != 0 {
+			v.Sub(v, new(big.Int).Lsh(common.Big1, uint(len(b))*8))
+		}
+		return v
+	case label == "bytes32":
+		return common.BytesToHash(b)
+	default:
+		return hexutil.Bytes(common.CopyBytes(b))
+	}
+}
+
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
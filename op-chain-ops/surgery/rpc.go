@@ -0,0 +1,50 @@
+package surgery
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// RPCState is the state of a live chain, e.g. a local fork of a network served by anvil or hardhat.
+// The state root of a live chain cannot be computed locally: only the changed storage is reported.
+type RPCState struct {
+	client *rpc.Client
+	block  *big.Int
+}
+
+var _ StorageReader = (*RPCState)(nil)
+
+// NewRPCState reads the storage at the given block, or at the latest block if block is nil.
+func NewRPCState(client *rpc.Client, block *big.Int) *RPCState {
+	return &RPCState{client: client, block: block}
+}
+
+func (r *RPCState) blockTag() string {
+	if r.block == nil {
+		return "latest"
+	}
+	return hexutil.EncodeBig(r.block)
+}
+
+func (r *RPCState) StorageAt(ctx context.Context, addr common.Address, slot common.Hash) (common.Hash, error) {
+	var result hexutil.Bytes
+	if err := r.client.CallContext(ctx, &result, "eth_getStorageAt", addr, slot, r.blockTag()); err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(result), nil
+}
+
+// Apply writes the changes with the hardhat_setStorageAt method, which is supported by anvil and hardhat forks.
+func (r *RPCState) Apply(ctx context.Context, changes []Change) error {
+	for _, c := range changes {
+		if err := r.client.CallContext(ctx, nil, "hardhat_setStorageAt", c.Address, c.Slot, c.After); err != nil {
+			return fmt.Errorf("failed to set slot %s of %s: %w", c.Slot, c.Address, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+// Package surgery applies declarative storage mutations to state,
+// producing a reviewable diff of the changes before any state is written.
+// With the storage layouts of op-bindings, the Editor reads and writes storage fields
+// by name, and the diff of the changes is decoded to the names of the changed fields.
+package surgery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Op is the type of storage mutation.
+type Op string
+
+const (
+	// OpSet sets the slot to the value.
+	OpSet Op = "set"
+	// OpMove moves the value of the slot to another slot, and clears the original slot.
+	OpMove Op = "move"
+	// OpClear clears a range of consecutive slots.
+	OpClear Op = "clear"
+)
+
+// maxClearCount bounds the number of slots that a single clear mutation may touch.
+const maxClearCount = 1 << 16
+
+// Mutation is a declarative change to the storage of an account.
+type Mutation struct {
+	Op      Op             `json:"op"`
+	Address common.Address `json:"address"`
+	// Slot is the slot to set, the slot to move from, or the first slot to clear.
+	Slot common.Hash `json:"slot"`
+	// Value is the value to set, used by OpSet.
+	Value common.Hash `json:"value,omitempty"`
+	// To is the slot to move to, used by OpMove.
+	To common.Hash `json:"to,omitempty"`
+	// Count is the number of slots to clear, used by OpClear.
+	Count uint64 `json:"count,omitempty"`
+}
+
+// Check checks that the mutation is well-formed.
+func (m *Mutation) Check() error {
+	switch m.Op {
+	case OpSet:
+	case OpMove:
+		if m.Slot == m.To {
+			return fmt.Errorf("cannot move slot %s of %s to itself", m.Slot, m.Address)
+		}
+	case OpClear:
+		if m.Count == 0 {
+			return fmt.Errorf("clear of %s at slot %s has zero count", m.Address, m.Slot)
+		}
+		if m.Count > maxClearCount {
+			return fmt.Errorf("clear of %s at slot %s has count %d, exceeding the max of %d", m.Address, m.Slot, m.Count, maxClearCount)
+		}
+		end := new(big.Int).Add(m.Slot.Big(), new(big.Int).SetUint64(m.Count-1))
+		if end.BitLen() > 256 {
+			return fmt.Errorf("clear of %s at slot %s overflows the slot range", m.Address, m.Slot)
+		}
+	default:
+		return fmt.Errorf("unknown storage mutation %q", m.Op)
+	}
+	return nil
+}
+
+// NewMutations reads a JSON list of mutations from disk, and checks them.
+func NewMutations(path string) ([]Mutation, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("storage mutations at %s not found: %w", path, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(file))
+	dec.DisallowUnknownFields()
+	var mutations []Mutation
+	if err := dec.Decode(&mutations); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal storage mutations: %w", err)
+	}
+	for i := range mutations {
+		if err := mutations[i].Check(); err != nil {
+			return nil, fmt.Errorf("invalid storage mutation %d: %w", i, err)
+		}
+	}
+	return mutations, nil
+}
+
+// StorageReader reads the storage of the state that is mutated.
+type StorageReader interface {
+	StorageAt(ctx context.Context, addr common.Address, slot common.Hash) (common.Hash, error)
+}
+
+// Change is the change of a storage slot, as result of the mutations.
+type Change struct {
+	Address common.Address `json:"address"`
+	Slot    common.Hash    `json:"slot"`
+	Before  common.Hash    `json:"before"`
+	After   common.Hash    `json:"after"`
+}
+
+type slotKey struct {
+	addr common.Address
+	slot common.Hash
+}
+
+// Plan applies the mutations, in order, to a view of the state,
+// and returns the resulting changes, sorted by address and slot.
+// Slots that end up with their original value are not included. The state itself is not modified.
+func Plan(ctx context.Context, reader StorageReader, mutations []Mutation) ([]Change, error) {
+	original := make(map[slotKey]common.Hash)
+	current := make(map[slotKey]common.Hash)
+	get := func(addr common.Address, slot common.Hash) (common.Hash, error) {
+		k := slotKey{addr, slot}
+		if v, ok := current[k]; ok {
+			return v, nil
+		}
+		v, err := reader.StorageAt(ctx, addr, slot)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("failed to read slot %s of %s: %w", slot, addr, err)
+		}
+		original[k] = v
+		current[k] = v
+		return v, nil
+	}
+	set := func(addr common.Address, slot common.Hash, value common.Hash) error {
+		if _, err := get(addr, slot); err != nil {
+			return err
+		}
+		current[slotKey{addr, slot}] = value
+		return nil
+	}
+
+	for i, m := range mutations {
+		if err := m.Check(); err != nil {
+			return nil, fmt.Errorf("invalid storage mutation %d: %w", i, err)
+		}
+		var err error
+		switch m.Op {
+		case OpSet:
+			err = set(m.Address, m.Slot, m.Value)
+		case OpMove:
+			var v common.Hash
+			v, err = get(m.Address, m.Slot)
+			if err == nil {
+				err = errors.Join(set(m.Address, m.To, v), set(m.Address, m.Slot, common.Hash{}))
+			}
+		case OpClear:
+			slot := m.Slot.Big()
+			for j := uint64(0); j < m.Count && err == nil; j++ {
+				err = set(m.Address, common.BigToHash(slot), common.Hash{})
+				slot.Add(slot, common.Big1)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply storage mutation %d (%s): %w", i, m.Op, err)
+		}
+	}
+
+	var changes []Change
+	for k, after := range current {
+		if before := original[k]; before != after {
+			changes = append(changes, Change{Address: k.addr, Slot: k.slot, Before: before, After: after})
+		}
+	}
+	sortChanges(changes)
+	return changes, nil
+}
+
+func sortChanges(changes []Change) {
+	sort.Slice(changes, func(i, j int) bool {
+		if c := bytes.Compare(changes[i].Address[:], changes[j].Address[:]); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(changes[i].Slot[:], changes[j].Slot[:]) < 0
+	})
+}
+
+// WriteDiff writes a human-readable before/after diff of the changes, grouped by account.
+func WriteDiff(w io.Writer, changes []Change) error {
+	if len(changes) == 0 {
+		_, err := fmt.Fprintln(w, "no storage changes")
+		return err
+	}
+	for i, c := range changes {
+		if i == 0 || changes[i-1].Address != c.Address {
+			if _, err := fmt.Fprintf(w, "account %s\n", c.Address); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "  slot %s\n  - %s\n  + %s\n", c.Slot, c.Before, c.After); err != nil {
+			return err
+		}
+	}
+	return nil
+}
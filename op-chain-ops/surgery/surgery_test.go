@@ -0,0 +1,82 @@
+package surgery
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	gstate "github.com/ethereum/go-ethereum/core/state"
+)
+
+func slot(n int64) common.Hash {
+	return common.BigToHash(big.NewInt(n))
+}
+
+func TestPlan(t *testing.T) {
+	addr := common.Address{0xaa}
+	other := common.Address{0xbb}
+	dump := &gstate.Dump{Accounts: map[common.Address]gstate.DumpAccount{
+		addr: {
+			Balance: "100",
+			Storage: map[common.Hash]string{
+				slot(1): "11",
+				slot(2): "22",
+				slot(3): "33",
+				slot(4): "44",
+			},
+		},
+	}}
+	state := NewDumpState(dump)
+	before, err := state.StateRoot()
+	require.NoError(t, err)
+
+	mutations := []Mutation{
+		{Op: OpMove, Address: addr, Slot: slot(1), To: slot(10)},
+		{Op: OpClear, Address: addr, Slot: slot(2), Count: 2},
+		{Op: OpSet, Address: other, Slot: slot(5), Value: slot(0x55)},
+		// set and revert a slot: it is not part of the changes
+		{Op: OpSet, Address: addr, Slot: slot(4), Value: slot(0x99)},
+		{Op: OpSet, Address: addr, Slot: slot(4), Value: slot(0x44)},
+	}
+	changes, err := Plan(context.Background(), state, mutations)
+	require.NoError(t, err)
+	require.Equal(t, []Change{
+		{Address: addr, Slot: slot(1), Before: slot(0x11), After: common.Hash{}},
+		{Address: addr, Slot: slot(2), Before: slot(0x22), After: common.Hash{}},
+		{Address: addr, Slot: slot(3), Before: slot(0x33), After: common.Hash{}},
+		{Address: addr, Slot: slot(10), Before: common.Hash{}, After: slot(0x11)},
+		{Address: other, Slot: slot(5), Before: common.Hash{}, After: slot(0x55)},
+	}, changes)
+
+	// planning does not modify the state
+	after, err := state.StateRoot()
+	require.NoError(t, err)
+	require.Equal(t, before, after)
+
+	var diff bytes.Buffer
+	require.NoError(t, WriteDiff(&diff, changes))
+	require.Contains(t, diff.String(), "account "+addr.String()+"\n  slot "+slot(1).String())
+
+	require.NoError(t, state.Apply(changes))
+	require.Equal(t, map[common.Hash]string{slot(4): "44", slot(10): "11"}, dump.Accounts[addr].Storage)
+	require.Equal(t, map[common.Hash]string{slot(5): "55"}, dump.Accounts[other].Storage)
+	after, err = state.StateRoot()
+	require.NoError(t, err)
+	require.NotEqual(t, before, after)
+	require.Equal(t, common.HexToHash(dump.Root), after)
+}
+
+func TestMutationCheck(t *testing.T) {
+	require.NoError(t, (&Mutation{Op: OpSet}).Check())
+	require.ErrorContains(t, (&Mutation{Op: "swap"}).Check(), "unknown")
+	require.ErrorContains(t, (&Mutation{Op: OpMove, Slot: slot(1), To: slot(1)}).Check(), "itself")
+	require.ErrorContains(t, (&Mutation{Op: OpClear, Slot: slot(1)}).Check(), "zero count")
+	require.ErrorContains(t, (&Mutation{Op: OpClear, Slot: slot(1), Count: maxClearCount + 1}).Check(), "exceeding")
+	maxSlot := common.HexToHash("0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	require.NoError(t, (&Mutation{Op: OpClear, Slot: maxSlot, Count: 1}).Check())
+	require.ErrorContains(t, (&Mutation{Op: OpClear, Slot: maxSlot, Count: 2}).Check(), "overflows")
+}
@@ -0,0 +1,291 @@
+package upgrades
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/bytecode"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/safe"
+)
+
+// UpgradeTarget is the implementation that a proxied L1 contract is upgraded to.
+// The implementation must be a deployment of the contract of the same name in op-bindings.
+type UpgradeTarget struct {
+	Implementation common.Address `json:"implementation"`
+	// Calldata is optional, if set the proxy is upgraded with upgradeAndCall, e.g. to initialize the new implementation.
+	Calldata hexutil.Bytes `json:"calldata,omitempty"`
+}
+
+// NewUpgradeTargets reads the targets of an upgrade from disk, a JSON object of
+// upgrade targets keyed by contract name, e.g. L1StandardBridge.
+func NewUpgradeTargets(path string) (map[string]UpgradeTarget, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("upgrade targets at %s not found: %w", path, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(file))
+	dec.DisallowUnknownFields()
+	var targets map[string]UpgradeTarget
+	if err := dec.Decode(&targets); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal upgrade targets: %w", err)
+	}
+	if len(targets) == 0 {
+		return nil, errors.New("no upgrade targets")
+	}
+	return targets, nil
+}
+
+// UpgradeStatus is whether a proxied contract needs to be upgraded.
+type UpgradeStatus string
+
+const (
+	UpgradeStatusUpToDate UpgradeStatus = "up-to-date"
+	UpgradeStatusUpgrade  UpgradeStatus = "upgrade"
+)
+
+// ProxyUpgrade is the upgrade of a single proxied contract.
+type ProxyUpgrade struct {
+	Contract              string         `json:"contract"`
+	Proxy                 common.Address `json:"proxy"`
+	CurrentImplementation common.Address `json:"currentImplementation"`
+	CurrentVersion        string         `json:"currentVersion"`
+	TargetImplementation  common.Address `json:"targetImplementation"`
+	TargetVersion         string         `json:"targetVersion"`
+	Calldata              hexutil.Bytes  `json:"calldata,omitempty"`
+	Status                UpgradeStatus  `json:"status"`
+	Reason                string         `json:"reason"`
+	// Gas is the estimated gas of the upgrade call, when sent by the owner of the ProxyAdmin.
+	Gas uint64 `json:"gas,omitempty"`
+	// SimulationError is set if the upgrade call reverts.
+	SimulationError string `json:"simulationError,omitempty"`
+}
+
+// UpgradePlan is the set of upgrades of the proxied L1 contracts of a chain,
+// sent by the owner of the ProxyAdmin.
+type UpgradePlan struct {
+	ProxyAdmin common.Address `json:"proxyAdmin"`
+	Owner      common.Address `json:"owner"`
+	Upgrades   []ProxyUpgrade `json:"upgrades"`
+}
+
+// PlanUpgrades computes which of the proxied L1 contracts in the deployments need to be upgraded to the targets,
+// and simulates each upgrade call from the owner of the ProxyAdmin.
+//
+// The code of each target implementation is checked against the deployed bytecode in op-bindings, so the targets
+// must be deployments of the release of op-bindings that this tool is built with. A proxy is up to date if
+// it already points at the target, or at another deployment of the same bytecode.
+// Immutables are ignored when comparing bytecode, as they are filled in at deployment.
+func PlanUpgrades(ctx context.Context, backend bind.ContractBackend, deployments *genesis.L1Deployments, targets map[string]UpgradeTarget) (*UpgradePlan, error) {
+	proxyAdmin, err := bindings.NewProxyAdminCaller(deployments.ProxyAdmin, backend)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	owner, err := proxyAdmin.Owner(opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch owner of ProxyAdmin %s: %w", deployments.ProxyAdmin, err)
+	}
+
+	proxies := make(map[string]common.Address)
+	deployments.ForEach(func(name string, addr common.Address) {
+		proxies[name] = addr
+	})
+	names := make([]string, 0, len(targets))
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	proxyAdminABI, err := bindings.ProxyAdminMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &UpgradePlan{ProxyAdmin: deployments.ProxyAdmin, Owner: owner}
+	for _, name := range names {
+		target := targets[name]
+		proxy, ok := proxies[name+"Proxy"]
+		if !ok || proxy == (common.Address{}) {
+			return nil, fmt.Errorf("no proxy of %s in the L1 deployments", name)
+		}
+		expected, err := bindings.GetDeployedBytecode(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		immutables, err := bindings.GetImmutableReferences(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		code, err := backend.CodeAt(ctx, target.Implementation, nil)
+		if err != nil {
+			return nil, fmt.Errorf("cannot fetch code of %s implementation %s: %w", name, target.Implementation, err)
+		}
+		if !bytecode.Compare(expected, code, immutables).Match() {
+			return nil, fmt.Errorf("%s implementation %s does not match the bytecode in op-bindings", name, target.Implementation)
+		}
+
+		current, err := proxyAdmin.GetProxyImplementation(opts, proxy)
+		if err != nil {
+			return nil, fmt.Errorf("cannot fetch implementation of %s: %w", name+"Proxy", err)
+		}
+		u := ProxyUpgrade{
+			Contract:              name,
+			Proxy:                 proxy,
+			CurrentImplementation: current,
+			CurrentVersion:        tryVersion(ctx, proxy, backend),
+			TargetImplementation:  target.Implementation,
+			TargetVersion:         tryVersion(ctx, target.Implementation, backend),
+			Calldata:              target.Calldata,
+			Status:                UpgradeStatusUpgrade,
+			Reason:                "implementation differs from the target",
+		}
+		if current == target.Implementation {
+			u.Status, u.Reason = UpgradeStatusUpToDate, "proxy points at the target"
+			plan.Upgrades = append(plan.Upgrades, u)
+			continue
+		}
+		currentCode, err := backend.CodeAt(ctx, current, nil)
+		if err != nil {
+			return nil, fmt.Errorf("cannot fetch code of %s implementation %s: %w", name, current, err)
+		}
+		if bytecode.Compare(expected, currentCode, immutables).Match() {
+			u.Status, u.Reason = UpgradeStatusUpToDate, "implementation has the target bytecode"
+			plan.Upgrades = append(plan.Upgrades, u)
+			continue
+		}
+
+		method, args := u.call()
+		data, err := proxyAdminABI.Pack(method, args...)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode upgrade of %s: %w", name, err)
+		}
+		gas, err := backend.EstimateGas(ctx, ethereum.CallMsg{
+			From: owner,
+			To:   &deployments.ProxyAdmin,
+			Data: data,
+		})
+		if err != nil {
+			u.SimulationError = err.Error()
+		} else {
+			u.Gas = gas
+		}
+		plan.Upgrades = append(plan.Upgrades, u)
+	}
+	return plan, nil
+}
+
+// call returns the name of the ProxyAdmin method and the arguments of the upgrade.
+func (u *ProxyUpgrade) call() (string, []any) {
+	if len(u.Calldata) > 0 {
+		return "upgradeAndCall", []any{u.Proxy, u.TargetImplementation, []byte(u.Calldata)}
+	}
+	return "upgrade", []any{u.Proxy, u.TargetImplementation}
+}
+
+// Pending returns the upgrades that need to be sent.
+func (p *UpgradePlan) Pending() []ProxyUpgrade {
+	var pending []ProxyUpgrade
+	for _, u := range p.Upgrades {
+		if u.Status == UpgradeStatusUpgrade {
+			pending = append(pending, u)
+		}
+	}
+	return pending
+}
+
+// Failed returns the upgrades that revert when simulated.
+func (p *UpgradePlan) Failed() []ProxyUpgrade {
+	var failed []ProxyUpgrade
+	for _, u := range p.Pending() {
+		if u.SimulationError != "" {
+			failed = append(failed, u)
+		}
+	}
+	return failed
+}
+
+// Batch bundles the pending upgrades as a tx-builder batch of the owner of the ProxyAdmin.
+func (p *UpgradePlan) Batch(chainID *big.Int) (*safe.Batch, error) {
+	proxyAdminABI, err := bindings.ProxyAdminMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	pending := p.Pending()
+	if len(pending) == 0 {
+		return nil, errors.New("all contracts are up to date")
+	}
+	batch := &safe.Batch{
+		Version: "1.0",
+		ChainID: chainID,
+		Meta: safe.BatchMeta{
+			CreatedFromSafeAddress: p.Owner.Hex(),
+			Name:                   "Upgrade L1 contracts",
+			Description:            fmt.Sprintf("Upgrade %d L1 contracts through the ProxyAdmin at %s", len(pending), p.ProxyAdmin),
+		},
+	}
+	for _, u := range pending {
+		method, args := u.call()
+		if err := batch.AddCall(p.ProxyAdmin, common.Big0, method, args, proxyAdminABI); err != nil {
+			return nil, fmt.Errorf("cannot add upgrade of %s: %w", u.Contract, err)
+		}
+	}
+	return batch, batch.Check()
+}
+
+// WriteReport writes a human-readable report of the plan, for review of the upgrade.
+func (p *UpgradePlan) WriteReport(w io.Writer) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "ProxyAdmin: %s\n", p.ProxyAdmin)
+	fmt.Fprintf(&buf, "Owner:      %s\n", p.Owner)
+	for _, u := range p.Upgrades {
+		fmt.Fprintf(&buf, "\n%s (%s): %s, %s\n", u.Contract, u.Proxy, u.Status, u.Reason)
+		fmt.Fprintf(&buf, "  current: %s %s\n", u.CurrentImplementation, formatVersion(u.CurrentVersion))
+		fmt.Fprintf(&buf, "  target:  %s %s\n", u.TargetImplementation, formatVersion(u.TargetVersion))
+		if u.Status != UpgradeStatusUpgrade {
+			continue
+		}
+		method, _ := u.call()
+		fmt.Fprintf(&buf, "  call:    %s\n", method)
+		if len(u.Calldata) > 0 {
+			fmt.Fprintf(&buf, "  data:    %s\n", u.Calldata)
+		}
+		if u.SimulationError != "" {
+			fmt.Fprintf(&buf, "  simulation FAILED: %s\n", u.SimulationError)
+		} else {
+			fmt.Fprintf(&buf, "  simulation ok, gas: %d\n", u.Gas)
+		}
+	}
+	fmt.Fprintf(&buf, "\n%d contracts, %d to upgrade, %d failed simulations\n", len(p.Upgrades), len(p.Pending()), len(p.Failed()))
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// tryVersion gets the version of a contract for the report, contracts without a version are reported without one.
+func tryVersion(ctx context.Context, addr common.Address, backend bind.ContractBackend) string {
+	version, err := getVersion(ctx, addr, backend)
+	if err != nil {
+		return ""
+	}
+	return version
+}
+
+func formatVersion(version string) string {
+	if version == "" {
+		return "(no version)"
+	}
+	return "v" + version
+}
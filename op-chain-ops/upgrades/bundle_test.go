@@ -0,0 +1,131 @@
+package upgrades
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+)
+
+func TestPlanUpgrades(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{owner: {Balance: big.NewInt(1e18)}}, 30_000_000)
+	defer backend.Close()
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	require.NoError(t, err)
+
+	proxyAdminAddr, _, proxyAdmin, err := bindings.DeployProxyAdmin(opts, backend, owner)
+	require.NoError(t, err)
+	deployProxy := func() common.Address {
+		addr, _, _, err := bindings.DeployProxy(opts, backend, proxyAdminAddr)
+		require.NoError(t, err)
+		return addr
+	}
+	protocolVersionsProxy := deployProxy()
+	systemConfigProxy := deployProxy()
+	l1StandardBridgeProxy := deployProxy()
+	storageSetter, _, _, err := bindings.DeployStorageSetter(opts, backend)
+	require.NoError(t, err)
+	protocolVersions, _, _, err := bindings.DeployProtocolVersions(opts, backend)
+	require.NoError(t, err)
+	systemConfig, _, _, err := bindings.DeploySystemConfig(opts, backend)
+	require.NoError(t, err)
+	otherSystemConfig, _, _, err := bindings.DeploySystemConfig(opts, backend)
+	require.NoError(t, err)
+	l1StandardBridge, _, _, err := bindings.DeployL1StandardBridge(opts, backend)
+	require.NoError(t, err)
+	backend.Commit()
+
+	// ProtocolVersions needs an upgrade, SystemConfig already has the bytecode of the target
+	_, err = proxyAdmin.Upgrade(opts, protocolVersionsProxy, storageSetter)
+	require.NoError(t, err)
+	_, err = proxyAdmin.Upgrade(opts, systemConfigProxy, otherSystemConfig)
+	require.NoError(t, err)
+	_, err = proxyAdmin.Upgrade(opts, l1StandardBridgeProxy, storageSetter)
+	require.NoError(t, err)
+	backend.Commit()
+
+	deployments := &genesis.L1Deployments{
+		ProxyAdmin:            proxyAdminAddr,
+		ProtocolVersionsProxy: protocolVersionsProxy,
+		SystemConfigProxy:     systemConfigProxy,
+		L1StandardBridgeProxy: l1StandardBridgeProxy,
+	}
+	targets := map[string]UpgradeTarget{
+		"ProtocolVersions": {Implementation: protocolVersions},
+		"SystemConfig":     {Implementation: systemConfig},
+		// the implementation has no fallback, so the call reverts
+		"L1StandardBridge": {Implementation: l1StandardBridge, Calldata: []byte{0xde, 0xad, 0xbe, 0xef}},
+	}
+	ctx := context.Background()
+	plan, err := PlanUpgrades(ctx, backend, deployments, targets)
+	require.NoError(t, err)
+	require.Equal(t, owner, plan.Owner)
+	require.Len(t, plan.Upgrades, 3)
+
+	bridge, pv, sc := plan.Upgrades[0], plan.Upgrades[1], plan.Upgrades[2]
+	require.Equal(t, "L1StandardBridge", bridge.Contract)
+	require.Equal(t, UpgradeStatusUpgrade, bridge.Status)
+	require.NotEmpty(t, bridge.SimulationError)
+
+	require.Equal(t, "ProtocolVersions", pv.Contract)
+	require.Equal(t, UpgradeStatusUpgrade, pv.Status)
+	require.Equal(t, storageSetter, pv.CurrentImplementation)
+	require.Empty(t, pv.SimulationError)
+	require.NotZero(t, pv.Gas)
+	require.NotEmpty(t, pv.TargetVersion)
+
+	require.Equal(t, "SystemConfig", sc.Contract)
+	require.Equal(t, UpgradeStatusUpToDate, sc.Status)
+	require.Equal(t, otherSystemConfig, sc.CurrentImplementation)
+	require.Equal(t, sc.TargetVersion, sc.CurrentVersion)
+
+	require.Len(t, plan.Pending(), 2)
+	require.Len(t, plan.Failed(), 1)
+
+	batch, err := plan.Batch(big.NewInt(1337))
+	require.NoError(t, err)
+	require.Equal(t, owner.Hex(), batch.Meta.CreatedFromSafeAddress)
+	require.Len(t, batch.Transactions, 2)
+	require.Equal(t, "upgradeAndCall", batch.Transactions[0].Method.Name)
+	require.Equal(t, "upgrade", batch.Transactions[1].Method.Name)
+	require.Equal(t, proxyAdminAddr, batch.Transactions[1].To)
+	require.Equal(t, protocolVersionsProxy.Hex(), batch.Transactions[1].InputValues["_proxy"])
+
+	var report strings.Builder
+	require.NoError(t, plan.WriteReport(&report))
+	require.Contains(t, report.String(), "SystemConfig ("+systemConfigProxy.String()+"): up-to-date")
+	require.Contains(t, report.String(), "simulation FAILED")
+	require.Contains(t, report.String(), "3 contracts, 2 to upgrade, 1 failed simulations")
+
+	// the targets must be deployments of the contracts in op-bindings
+	_, err = PlanUpgrades(ctx, backend, deployments, map[string]UpgradeTarget{
+		"ProtocolVersions": {Implementation: storageSetter},
+	})
+	require.ErrorContains(t, err, "does not match the bytecode")
+	_, err = PlanUpgrades(ctx, backend, deployments, map[string]UpgradeTarget{
+		"OptimismPortal": {Implementation: storageSetter},
+	})
+	require.ErrorContains(t, err, "no proxy of OptimismPortal")
+}
+
+func TestNewUpgradeTargets(t *testing.T) {
+	targets, err := NewUpgradeTargets("testdata/upgrade-targets.json")
+	require.NoError(t, err)
+	require.Len(t, targets, 2)
+	require.Empty(t, targets["L1StandardBridge"].Calldata)
+	require.NotEmpty(t, targets["L1CrossDomainMessenger"].Calldata)
+}
@@ -0,0 +1,236 @@
+package upgrades
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+)
+
+// DepositOp is the type of network upgrade deposit.
+type DepositOp string
+
+const (
+	// DepositOpDeploy deploys a contract from the init code in the data.
+	DepositOpDeploy DepositOp = "deploy"
+	// DepositOpUpgrade upgrades a predeploy proxy to a new implementation,
+	// optionally calling the implementation with the data.
+	DepositOpUpgrade DepositOp = "upgrade"
+	// DepositOpCall calls a contract with the data, e.g. to set a new system config value.
+	DepositOpCall DepositOp = "call"
+)
+
+// UpgradeDeposit is a declarative deposit transaction of a network upgrade.
+type UpgradeDeposit struct {
+	// Intent identifies the deposit in a human-readable way, e.g. "Ecotone: L1 Block Deployment".
+	// The source hash of the deposit is derived from it, so it must be unique across all network upgrades.
+	Intent string         `json:"intent"`
+	Op     DepositOp      `json:"op"`
+	From   common.Address `json:"from"`
+	// To is the contract to call, or the proxy to upgrade.
+	To *common.Address `json:"to,omitempty"`
+	// Implementation is the implementation to upgrade the proxy to.
+	Implementation *common.Address `json:"implementation,omitempty"`
+	// Deployment is the intent of an earlier deploy in the same upgrade,
+	// to upgrade the proxy to the contract that it deploys, instead of a fixed implementation.
+	Deployment string `json:"deployment,omitempty"`
+	// Nonce is the nonce of the sender at the time of a deploy, to compute the address of the deployed contract.
+	Nonce uint64 `json:"nonce,omitempty"`
+	// Data is the init code of a deploy, the calldata of a call, or the optional calldata of an upgrade.
+	Data hexutil.Bytes `json:"data,omitempty"`
+	Gas  uint64        `json:"gas"`
+}
+
+// Check checks that the deposit is well-formed.
+func (d *UpgradeDeposit) Check() error {
+	if d.Intent == "" {
+		return errors.New("missing intent")
+	}
+	if d.Gas == 0 {
+		return fmt.Errorf("deposit %q has no gas", d.Intent)
+	}
+	switch d.Op {
+	case DepositOpDeploy:
+		if d.To != nil {
+			return fmt.Errorf("deploy %q cannot have a to address", d.Intent)
+		}
+		if len(d.Data) == 0 {
+			return fmt.Errorf("deploy %q has no init code", d.Intent)
+		}
+	case DepositOpUpgrade:
+		if d.To == nil {
+			return fmt.Errorf("upgrade %q has no proxy to upgrade", d.Intent)
+		}
+		if (d.Implementation == nil) == (d.Deployment == "") {
+			return fmt.Errorf("upgrade %q must specify exactly one of implementation or deployment", d.Intent)
+		}
+	case DepositOpCall:
+		if d.To == nil {
+			return fmt.Errorf("call %q has no to address", d.Intent)
+		}
+	default:
+		return fmt.Errorf("unknown deposit op %q of %q", d.Op, d.Intent)
+	}
+	return nil
+}
+
+// NetworkUpgradeSpec is the declarative spec of the deposit transactions of a network upgrade.
+type NetworkUpgradeSpec struct {
+	Name     string           `json:"name"`
+	Deposits []UpgradeDeposit `json:"deposits"`
+}
+
+// NewNetworkUpgradeSpec reads a network upgrade spec from disk, and checks it.
+func NewNetworkUpgradeSpec(path string) (*NetworkUpgradeSpec, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("network upgrade spec at %s not found: %w", path, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(file))
+	dec.DisallowUnknownFields()
+	var spec NetworkUpgradeSpec
+	if err := dec.Decode(&spec); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal network upgrade spec: %w", err)
+	}
+	if err := spec.Check(); err != nil {
+		return nil, err
+	}
+	return &spec, nil
+}
+
+// Check checks that every deposit is well-formed, and that intents are unique.
+func (s *NetworkUpgradeSpec) Check() error {
+	if s.Name == "" {
+		return errors.New("network upgrade has no name")
+	}
+	if len(s.Deposits) == 0 {
+		return fmt.Errorf("network upgrade %s has no deposits", s.Name)
+	}
+	deploys := make(map[string]struct{})
+	intents := make(map[string]struct{})
+	for i := range s.Deposits {
+		d := &s.Deposits[i]
+		if err := d.Check(); err != nil {
+			return fmt.Errorf("invalid deposit %d: %w", i, err)
+		}
+		if _, ok := intents[d.Intent]; ok {
+			return fmt.Errorf("duplicate intent %q", d.Intent)
+		}
+		intents[d.Intent] = struct{}{}
+		if d.Deployment != "" {
+			if _, ok := deploys[d.Deployment]; !ok {
+				return fmt.Errorf("upgrade %q refers to unknown deployment %q, it must be deployed earlier in the upgrade", d.Intent, d.Deployment)
+			}
+		}
+		if d.Op == DepositOpDeploy {
+			deploys[d.Intent] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// UpgradeTransaction is a deposit transaction of a network upgrade, as included in the artifact for review.
+type UpgradeTransaction struct {
+	Intent     string          `json:"intent"`
+	SourceHash common.Hash     `json:"sourceHash"`
+	From       common.Address  `json:"from"`
+	To         *common.Address `json:"to"`
+	Gas        uint64          `json:"gas"`
+	Data       hexutil.Bytes   `json:"data"`
+	// DeployedAddress is the address of the contract that is deployed, only set for deploys.
+	DeployedAddress *common.Address `json:"deployedAddress,omitempty"`
+	// Hash is the transaction hash of the deposit.
+	Hash common.Hash `json:"hash"`
+	// Raw is the opaque encoding of the deposit, as included in the L2 block.
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// NetworkUpgradeArtifact is the result of building a network upgrade spec.
+type NetworkUpgradeArtifact struct {
+	Name         string               `json:"name"`
+	Transactions []UpgradeTransaction `json:"transactions"`
+}
+
+// Opaque returns the opaque encodings of the deposits, in order of inclusion.
+func (a *NetworkUpgradeArtifact) Opaque() []hexutil.Bytes {
+	out := make([]hexutil.Bytes, len(a.Transactions))
+	for i := range a.Transactions {
+		out[i] = a.Transactions[i].Raw
+	}
+	return out
+}
+
+// Build builds the deposit transactions of the network upgrade. The result only depends on the spec.
+func (s *NetworkUpgradeSpec) Build() (*NetworkUpgradeArtifact, error) {
+	if err := s.Check(); err != nil {
+		return nil, err
+	}
+	proxyABI, err := bindings.ProxyMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	deployed := make(map[string]common.Address)
+	artifact := &NetworkUpgradeArtifact{Name: s.Name}
+	for _, d := range s.Deposits {
+		source := derive.UpgradeDepositSource{Intent: d.Intent}
+		tx := UpgradeTransaction{
+			Intent:     d.Intent,
+			SourceHash: source.SourceHash(),
+			From:       d.From,
+			Gas:        d.Gas,
+		}
+		switch d.Op {
+		case DepositOpDeploy:
+			addr := crypto.CreateAddress(d.From, d.Nonce)
+			deployed[d.Intent] = addr
+			tx.DeployedAddress = &addr
+			tx.Data = d.Data
+		case DepositOpUpgrade:
+			impl := deployed[d.Deployment]
+			if d.Implementation != nil {
+				impl = *d.Implementation
+			}
+			// the proxy can be upgraded by the zero address, the sender of system deposits
+			if len(d.Data) == 0 {
+				tx.Data, err = proxyABI.Pack("upgradeTo", impl)
+			} else {
+				tx.Data, err = proxyABI.Pack("upgradeToAndCall", impl, []byte(d.Data))
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode upgrade %q: %w", d.Intent, err)
+			}
+			tx.To = d.To
+		case DepositOpCall:
+			tx.To = d.To
+			tx.Data = d.Data
+		}
+
+		deposit := types.NewTx(&types.DepositTx{
+			SourceHash:          tx.SourceHash,
+			From:                tx.From,
+			To:                  tx.To,
+			Mint:                nil,
+			Value:               common.Big0,
+			Gas:                 tx.Gas,
+			IsSystemTransaction: false,
+			Data:                tx.Data,
+		})
+		tx.Hash = deposit.Hash()
+		tx.Raw, err = deposit.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode deposit %q: %w", d.Intent, err)
+		}
+		artifact.Transactions = append(artifact.Transactions, tx)
+	}
+	return artifact, nil
+}
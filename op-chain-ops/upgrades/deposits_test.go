@@ -0,0 +1,113 @@
+package upgrades
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+)
+
+func TestNetworkUpgradeSpecBuild(t *testing.T) {
+	spec, err := NewNetworkUpgradeSpec("testdata/network-upgrade.json")
+	require.NoError(t, err)
+
+	artifact, err := spec.Build()
+	require.NoError(t, err)
+	require.Equal(t, "Example", artifact.Name)
+	require.Len(t, artifact.Transactions, 4)
+
+	// the build is deterministic
+	again, err := spec.Build()
+	require.NoError(t, err)
+	require.Equal(t, artifact, again)
+
+	proxyABI, err := bindings.ProxyMetaData.GetAbi()
+	require.NoError(t, err)
+
+	deploy := artifact.Transactions[0]
+	source := derive.UpgradeDepositSource{Intent: "Example: GasPriceOracle Deployment"}
+	require.Equal(t, source.SourceHash(), deploy.SourceHash)
+	require.Nil(t, deploy.To)
+	deployedAddr := crypto.CreateAddress(common.HexToAddress("0x4210000000000000000000000000000000000001"), 0)
+	require.Equal(t, &deployedAddr, deploy.DeployedAddress)
+
+	upgrade := artifact.Transactions[1]
+	require.Equal(t, common.Address{}, upgrade.From)
+	expected, err := proxyABI.Pack("upgradeTo", deployedAddr)
+	require.NoError(t, err)
+	require.Equal(t, expected, []byte(upgrade.Data))
+
+	upgradeAndCall := artifact.Transactions[2]
+	expected, err = proxyABI.Pack("upgradeToAndCall", common.HexToAddress("0xc0d3c0d3c0d3c0d3c0d3c0d3c0d3c0d3c0d30015"), []byte{0x81, 0x29, 0xfc, 0x1c})
+	require.NoError(t, err)
+	require.Equal(t, expected, []byte(upgradeAndCall.Data))
+
+	for i, opaque := range artifact.Opaque() {
+		var tx types.Transaction
+		require.NoError(t, tx.UnmarshalBinary(opaque))
+		require.Equal(t, uint8(types.DepositTxType), tx.Type())
+		require.Equal(t, artifact.Transactions[i].Hash, tx.Hash())
+		require.Equal(t, artifact.Transactions[i].SourceHash, tx.SourceHash())
+		require.Equal(t, artifact.Transactions[i].Gas, tx.Gas())
+		require.False(t, tx.IsSystemTx())
+	}
+}
+
+func TestNetworkUpgradeSpecCheck(t *testing.T) {
+	proxy := common.HexToAddress("0x4200000000000000000000000000000000000015")
+	impl := common.HexToAddress("0xc0d3c0d3c0d3c0d3c0d3c0d3c0d3c0d3c0d30015")
+	tests := []struct {
+		name     string
+		deposits []UpgradeDeposit
+		err      string
+	}{
+		{
+			name:     "NoGas",
+			deposits: []UpgradeDeposit{{Intent: "a", Op: DepositOpCall, To: &proxy}},
+			err:      "has no gas",
+		},
+		{
+			name: "DuplicateIntent",
+			deposits: []UpgradeDeposit{
+				{Intent: "a", Op: DepositOpCall, To: &proxy, Gas: 1},
+				{Intent: "a", Op: DepositOpCall, To: &proxy, Gas: 1},
+			},
+			err: "duplicate intent",
+		},
+		{
+			name:     "UnknownDeployment",
+			deposits: []UpgradeDeposit{{Intent: "a", Op: DepositOpUpgrade, To: &proxy, Deployment: "b", Gas: 1}},
+			err:      "unknown deployment",
+		},
+		{
+			name:     "ImplementationAndDeployment",
+			deposits: []UpgradeDeposit{{Intent: "a", Op: DepositOpUpgrade, To: &proxy, Implementation: &impl, Deployment: "b", Gas: 1}},
+			err:      "exactly one of implementation or deployment",
+		},
+		{
+			name:     "DeployWithoutCode",
+			deposits: []UpgradeDeposit{{Intent: "a", Op: DepositOpDeploy, Gas: 1}},
+			err:      "no init code",
+		},
+		{
+			name:     "UnknownOp",
+			deposits: []UpgradeDeposit{{Intent: "a", Op: "selfdestruct", Gas: 1}},
+			err:      "unknown deposit op",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			spec := &NetworkUpgradeSpec{Name: "Example", Deposits: test.deposits}
+			require.ErrorContains(t, spec.Check(), test.err)
+			_, err := spec.Build()
+			require.ErrorContains(t, err, test.err)
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Package withdrawals builds the parameters to prove and finalize withdrawals on L1.
+package withdrawals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+	opwithdrawals "github.com/ethereum-optimism/optimism/op-node/withdrawals"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// ErrNoOutputProposed is returned when no output has been proposed yet for the L2 block of the withdrawal.
+var ErrNoOutputProposed = errors.New("no output proposed for the withdrawal yet")
+
+// L2Client fetches the withdrawal receipt and the storage proof of the withdrawal.
+type L2Client interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	GetProof(ctx context.Context, account common.Address, keys []string, blockNumber *big.Int) (*gethclient.AccountResult, error)
+}
+
+// RollupClient fetches the output at the L2 block that the withdrawal is proven against.
+type RollupClient interface {
+	OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error)
+}
+
+// OutputOracle fetches the proposed output that the withdrawal is proven against.
+type OutputOracle interface {
+	LatestBlockNumber(opts *bind.CallOpts) (*big.Int, error)
+	GetL2OutputIndexAfter(opts *bind.CallOpts, l2BlockNumber *big.Int) (*big.Int, error)
+	GetL2Output(opts *bind.CallOpts, l2OutputIndex *big.Int) (bindings.TypesOutputProposal, error)
+}
+
+// OutputRootProof is the preimage of the output root that the withdrawal is proven against.
+type OutputRootProof struct {
+	Version                  common.Hash `json:"version"`
+	StateRoot                common.Hash `json:"stateRoot"`
+	MessagePasserStorageRoot common.Hash `json:"messagePasserStorageRoot"`
+	LatestBlockhash          common.Hash `json:"latestBlockhash"`
+}
+
+// ProveParameters are the arguments of OptimismPortal.proveWithdrawalTransaction.
+type ProveParameters struct {
+	Withdrawal      *crossdomain.Withdrawal `json:"withdrawal"`
+	L2OutputIndex   *hexutil.Big            `json:"l2OutputIndex"`
+	OutputRootProof OutputRootProof         `json:"outputRootProof"`
+	WithdrawalProof []hexutil.Bytes         `json:"withdrawalProof"`
+}
+
+// WithdrawalFromReceipt parses the withdrawal that was initiated by the transaction of the receipt.
+func WithdrawalFromReceipt(receipt *types.Receipt) (*crossdomain.Withdrawal, error) {
+	ev, err := opwithdrawals.ParseMessagePassed(receipt)
+	if err != nil {
+		return nil, err
+	}
+	w := crossdomain.NewWithdrawal(ev.Nonce, &ev.Sender, &ev.Target, ev.Value, ev.GasLimit, ev.Data)
+	hash, err := w.Hash()
+	if err != nil {
+		return nil, err
+	}
+	if hash != ev.WithdrawalHash {
+		return nil, fmt.Errorf("computed withdrawal hash %s does not match the emitted withdrawal hash %s", hash, common.Hash(ev.WithdrawalHash))
+	}
+	return w, nil
+}
+
+// BuildProveParameters builds the parameters to prove the withdrawal that was initiated by the L2 transaction.
+// The withdrawal is proven against the first output proposal that includes the withdrawal,
+// and the output of the rollup node must match the proposed output.
+func BuildProveParameters(ctx context.Context, l2 L2Client, rollup RollupClient, oracle OutputOracle, txHash common.Hash) (*ProveParameters, error) {
+	receipt, err := l2.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get withdrawal receipt: %w", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("withdrawal transaction %s failed", txHash)
+	}
+	w, err := WithdrawalFromReceipt(receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	latest, err := oracle.LatestBlockNumber(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest proposed L2 block: %w", err)
+	}
+	if latest.Cmp(receipt.BlockNumber) < 0 {
+		return nil, fmt.Errorf("%w: withdrawal in L2 block %d, latest proposal is at L2 block %d", ErrNoOutputProposed, receipt.BlockNumber, latest)
+	}
+	index, err := oracle.GetL2OutputIndexAfter(opts, receipt.BlockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L2 output index: %w", err)
+	}
+	proposal, err := oracle.GetL2Output(opts, index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L2 output %d: %w", index, err)
+	}
+
+	output, err := rollup.OutputAtBlock(ctx, proposal.L2BlockNumber.Uint64())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get output at L2 block %d: %w", proposal.L2BlockNumber, err)
+	}
+	if output.OutputRoot != proposal.OutputRoot {
+		return nil, fmt.Errorf("output root %s at L2 block %d does not match the proposed output root %s",
+			output.OutputRoot, proposal.L2BlockNumber, eth.Bytes32(proposal.OutputRoot))
+	}
+
+	slot, err := w.StorageSlot()
+	if err != nil {
+		return nil, err
+	}
+	proof, err := l2.GetProof(ctx, predeploys.L2ToL1MessagePasserAddr, []string{slot.String()}, proposal.L2BlockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get withdrawal proof: %w", err)
+	}
+	if proof.StorageHash != output.WithdrawalStorageRoot {
+		return nil, fmt.Errorf("proof storage root %s does not match the output withdrawal storage root %s", proof.StorageHash, output.WithdrawalStorageRoot)
+	}
+	if err := opwithdrawals.VerifyProof(output.StateRoot, proof); err != nil {
+		return nil, fmt.Errorf("invalid withdrawal proof: %w", err)
+	}
+	if len(proof.StorageProof) != 1 {
+		return nil, fmt.Errorf("expected 1 storage proof, got %d", len(proof.StorageProof))
+	}
+	if proof.StorageProof[0].Value.Sign() == 0 {
+		return nil, fmt.Errorf("withdrawal %s is not in the L2ToL1MessagePasser at L2 block %d", txHash, proposal.L2BlockNumber)
+	}
+	trieNodes := make([]hexutil.Bytes, len(proof.StorageProof[0].Proof))
+	for i, node := range proof.StorageProof[0].Proof {
+		trieNodes[i] = common.FromHex(node)
+	}
+
+	return &ProveParameters{
+		Withdrawal:    w,
+		L2OutputIndex: (*hexutil.Big)(index),
+		OutputRootProof: OutputRootProof{
+			Version:                  common.Hash(output.Version),
+			StateRoot:                output.StateRoot,
+			MessagePasserStorageRoot: output.WithdrawalStorageRoot,
+			LatestBlockhash:          output.BlockRef.Hash,
+		},
+		WithdrawalProof: trieNodes,
+	}, nil
+}
+
+// ProveCalldata encodes the calldata of OptimismPortal.proveWithdrawalTransaction.
+func (p *ProveParameters) ProveCalldata() ([]byte, error) {
+	portalABI, err := bindings.OptimismPortalMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	proof := make([][]byte, len(p.WithdrawalProof))
+	for i, node := range p.WithdrawalProof {
+		proof[i] = node
+	}
+	outputRootProof := bindings.TypesOutputRootProof{
+		Version:                  p.OutputRootProof.Version,
+		StateRoot:                p.OutputRootProof.StateRoot,
+		MessagePasserStorageRoot: p.OutputRootProof.MessagePasserStorageRoot,
+		LatestBlockhash:          p.OutputRootProof.LatestBlockhash,
+	}
+	return portalABI.Pack("proveWithdrawalTransaction", p.Withdrawal.WithdrawalTransaction(), p.L2OutputIndex.ToInt(), outputRootProof, proof)
+}
+
+// FinalizeCalldata encodes the calldata of OptimismPortal.finalizeWithdrawalTransaction.
+func FinalizeCalldata(w *crossdomain.Withdrawal) ([]byte, error) {
+	portalABI, err := bindings.OptimismPortalMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return portalABI.Pack("finalizeWithdrawalTransaction", w.WithdrawalTransaction())
+}
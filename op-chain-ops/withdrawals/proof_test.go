@@ -0,0 +1,265 @@
+package withdrawals
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+	opwithdrawals "github.com/ethereum-optimism/optimism/op-node/withdrawals"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// proofList collects the trie nodes of a proof, like the eth_getProof RPC.
+type proofList []string
+
+func (p *proofList) Put(key []byte, value []byte) error {
+	*p = append(*p, hexutil.Encode(value))
+	return nil
+}
+
+func (p *proofList) Delete(key []byte) error {
+	return errors.New("not supported")
+}
+
+type fakeL2 struct {
+	receipt     *types.Receipt
+	storageRoot common.Hash
+	stateRoot   common.Hash
+	account     types.StateAccount
+	accountTrie *trie.Trie
+	storageTrie *trie.Trie
+}
+
+func (f *fakeL2) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if txHash != f.receipt.TxHash {
+		return nil, errors.New("not found")
+	}
+	return f.receipt, nil
+}
+
+func (f *fakeL2) GetProof(ctx context.Context, account common.Address, keys []string, blockNumber *big.Int) (*gethclient.AccountResult, error) {
+	var accountProof proofList
+	if err := f.accountTrie.Prove(crypto.Keccak256(account[:]), &accountProof); err != nil {
+		return nil, err
+	}
+	result := &gethclient.AccountResult{
+		Address:      account,
+		AccountProof: accountProof,
+		Balance:      f.account.Balance,
+		CodeHash:     common.BytesToHash(f.account.CodeHash),
+		Nonce:        f.account.Nonce,
+		StorageHash:  f.account.Root,
+	}
+	for _, key := range keys {
+		var storageProof proofList
+		if err := f.storageTrie.Prove(crypto.Keccak256(common.FromHex(key)), &storageProof); err != nil {
+			return nil, err
+		}
+		value, err := f.storageTrie.Get(crypto.Keccak256(common.FromHex(key)))
+		if err != nil {
+			return nil, err
+		}
+		result.StorageProof = append(result.StorageProof, gethclient.StorageResult{
+			Key:   key,
+			Value: new(big.Int).SetBytes(value),
+			Proof: storageProof,
+		})
+	}
+	return result, nil
+}
+
+type fakeRollup struct {
+	output *eth.OutputResponse
+}
+
+func (f *fakeRollup) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	if blockNum != f.output.BlockRef.Number {
+		return nil, errors.New("not found")
+	}
+	return f.output, nil
+}
+
+type fakeOracle struct {
+	index    *big.Int
+	proposal bindings.TypesOutputProposal
+}
+
+func (f *fakeOracle) LatestBlockNumber(opts *bind.CallOpts) (*big.Int, error) {
+	return f.proposal.L2BlockNumber, nil
+}
+
+func (f *fakeOracle) GetL2OutputIndexAfter(opts *bind.CallOpts, l2BlockNumber *big.Int) (*big.Int, error) {
+	return f.index, nil
+}
+
+func (f *fakeOracle) GetL2Output(opts *bind.CallOpts, l2OutputIndex *big.Int) (bindings.TypesOutputProposal, error) {
+	return f.proposal, nil
+}
+
+func testWithdrawal() *crossdomain.Withdrawal {
+	sender := common.Address{0xaa}
+	target := common.Address{0xbb}
+	nonce := crossdomain.EncodeVersionedNonce(big.NewInt(3), common.Big1)
+	return crossdomain.NewWithdrawal(nonce, &sender, &target, big.NewInt(1000), big.NewInt(100_000), []byte{0x01, 0x02})
+}
+
+func messagePassedReceipt(t *testing.T, w *crossdomain.Withdrawal) *types.Receipt {
+	passerABI, err := bindings.L2ToL1MessagePasserMetaData.GetAbi()
+	require.NoError(t, err)
+	hash, err := w.Hash()
+	require.NoError(t, err)
+	ev := passerABI.Events["MessagePassed"]
+	data, err := ev.Inputs.NonIndexed().Pack(w.Value, w.GasLimit, []byte(w.Data), hash)
+	require.NoError(t, err)
+	return &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		TxHash:      common.Hash{0x01},
+		BlockNumber: big.NewInt(105),
+		Logs: []*types.Log{{
+			Address: predeploys.L2ToL1MessagePasserAddr,
+			Topics: []common.Hash{
+				opwithdrawals.MessagePassedTopic,
+				common.BigToHash(w.Nonce),
+				common.BytesToHash(w.Sender[:]),
+				common.BytesToHash(w.Target[:]),
+			},
+			Data: data,
+		}},
+	}
+}
+
+func setup(t *testing.T) (*fakeL2, *fakeRollup, *fakeOracle, *crossdomain.Withdrawal) {
+	w := testWithdrawal()
+	slot, err := w.StorageSlot()
+	require.NoError(t, err)
+
+	db := trie.NewDatabase(rawdb.NewMemoryDatabase(), nil)
+	storageTrie := trie.NewEmpty(db)
+	require.NoError(t, storageTrie.Update(crypto.Keccak256(slot[:]), []byte{0x01}))
+	// another withdrawal, so the proof is not trivial
+	require.NoError(t, storageTrie.Update(crypto.Keccak256(common.Hash{0x01}.Bytes()), []byte{0x01}))
+
+	account := types.StateAccount{
+		Nonce:    0,
+		Balance:  new(big.Int),
+		Root:     storageTrie.Hash(),
+		CodeHash: crypto.Keccak256([]byte{0x60}),
+	}
+	accountData, err := rlp.EncodeToBytes(&account)
+	require.NoError(t, err)
+	accountTrie := trie.NewEmpty(db)
+	require.NoError(t, accountTrie.Update(crypto.Keccak256(predeploys.L2ToL1MessagePasserAddr[:]), accountData))
+	require.NoError(t, accountTrie.Update(crypto.Keccak256(common.Address{0x01}.Bytes()), accountData))
+
+	l2 := &fakeL2{
+		receipt:     messagePassedReceipt(t, w),
+		storageRoot: account.Root,
+		stateRoot:   accountTrie.Hash(),
+		account:     account,
+		accountTrie: accountTrie,
+		storageTrie: storageTrie,
+	}
+	blockRef := eth.L2BlockRef{Hash: common.Hash{0xbb}, Number: 120}
+	outputRoot := eth.OutputRoot(&eth.OutputV0{
+		StateRoot:                eth.Bytes32(l2.stateRoot),
+		MessagePasserStorageRoot: eth.Bytes32(l2.storageRoot),
+		BlockHash:                blockRef.Hash,
+	})
+	rollup := &fakeRollup{output: &eth.OutputResponse{
+		OutputRoot:            outputRoot,
+		BlockRef:              blockRef,
+		WithdrawalStorageRoot: l2.storageRoot,
+		StateRoot:             l2.stateRoot,
+	}}
+	oracle := &fakeOracle{
+		index: big.NewInt(4),
+		proposal: bindings.TypesOutputProposal{
+			OutputRoot:    outputRoot,
+			L2BlockNumber: new(big.Int).SetUint64(blockRef.Number),
+		},
+	}
+	return l2, rollup, oracle, w
+}
+
+func TestBuildProveParameters(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		l2, rollup, oracle, w := setup(t)
+		params, err := BuildProveParameters(context.Background(), l2, rollup, oracle, l2.receipt.TxHash)
+		require.NoError(t, err)
+		require.Equal(t, w, params.Withdrawal)
+		require.Equal(t, big.NewInt(4), params.L2OutputIndex.ToInt())
+		require.Equal(t, OutputRootProof{
+			StateRoot:                l2.stateRoot,
+			MessagePasserStorageRoot: l2.storageRoot,
+			LatestBlockhash:          common.Hash{0xbb},
+		}, params.OutputRootProof)
+		require.NotEmpty(t, params.WithdrawalProof)
+
+		calldata, err := params.ProveCalldata()
+		require.NoError(t, err)
+		portalABI, err := bindings.OptimismPortalMetaData.GetAbi()
+		require.NoError(t, err)
+		require.Equal(t, portalABI.Methods["proveWithdrawalTransaction"].ID, calldata[:4])
+		args, err := portalABI.Methods["proveWithdrawalTransaction"].Inputs.Unpack(calldata[4:])
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(4), args[1])
+		require.Len(t, args[3], len(params.WithdrawalProof))
+	})
+
+	t.Run("NotProposed", func(t *testing.T) {
+		l2, rollup, oracle, _ := setup(t)
+		oracle.proposal.L2BlockNumber = big.NewInt(100)
+		_, err := BuildProveParameters(context.Background(), l2, rollup, oracle, l2.receipt.TxHash)
+		require.ErrorIs(t, err, ErrNoOutputProposed)
+	})
+
+	t.Run("OutputRootMismatch", func(t *testing.T) {
+		l2, rollup, oracle, _ := setup(t)
+		oracle.proposal.OutputRoot = [32]byte{0x01}
+		_, err := BuildProveParameters(context.Background(), l2, rollup, oracle, l2.receipt.TxHash)
+		require.ErrorContains(t, err, "does not match the proposed output root")
+	})
+
+	t.Run("InvalidStateRoot", func(t *testing.T) {
+		l2, rollup, oracle, _ := setup(t)
+		rollup.output.StateRoot = common.Hash{0x01}
+		_, err := BuildProveParameters(context.Background(), l2, rollup, oracle, l2.receipt.TxHash)
+		require.ErrorContains(t, err, "invalid withdrawal proof")
+	})
+
+	t.Run("FailedTransaction", func(t *testing.T) {
+		l2, rollup, oracle, _ := setup(t)
+		l2.receipt.Status = types.ReceiptStatusFailed
+		_, err := BuildProveParameters(context.Background(), l2, rollup, oracle, l2.receipt.TxHash)
+		require.ErrorContains(t, err, "failed")
+	})
+}
+
+func TestFinalizeCalldata(t *testing.T) {
+	w := testWithdrawal()
+	calldata, err := FinalizeCalldata(w)
+	require.NoError(t, err)
+	portalABI, err := bindings.OptimismPortalMetaData.GetAbi()
+	require.NoError(t, err)
+	method := portalABI.Methods["finalizeWithdrawalTransaction"]
+	require.Equal(t, method.ID, calldata[:4])
+	args, err := method.Inputs.Unpack(calldata[4:])
+	require.NoError(t, err)
+	require.Len(t, args, 1)
+}
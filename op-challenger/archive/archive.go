@@ -0,0 +1,227 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	archiveExt     = ".tar.gz"
+	outcomeFile    = "outcome.json"
+	claimsFile     = "claims.json"
+	gameDataDir    = "data"
+	archiveNameFmt = "game-%v" + archiveExt
+)
+
+// excludedDirs are the dirs of the game data that are not archived, since they can be large and are regenerated
+// from the absolute prestate and the L1 and L2 chains: the preimages and the snapshots of the cannon executions.
+var excludedDirs = map[string]bool{
+	"preimages": true,
+	"snapshots": true,
+}
+
+// Outcome is the resolution outcome of a game.
+type Outcome struct {
+	Game                    common.Address `json:"game"`
+	Status                  string         `json:"status"`
+	AgreeWithProposedOutput bool           `json:"agreeWithProposedOutput"`
+	Won                     bool           `json:"won"`
+	ArchivedAt              uint64         `json:"archivedAt"`
+}
+
+type Claim struct {
+	Index        int          `json:"index"`
+	ParentIndex  int          `json:"parentIndex"`
+	Value        common.Hash  `json:"value"`
+	Depth        int          `json:"depth"`
+	IndexAtDepth *hexutil.Big `json:"indexAtDepth"`
+	Countered    bool         `json:"countered"`
+	Clock        uint64       `json:"clock"`
+}
+
+// Archiver packages the data of resolved games and uploads it to a Store, for post-mortems and to prove the
+// behaviour of the challenger in past games.
+type Archiver struct {
+	logger    log.Logger
+	store     Store
+	retention time.Duration
+	now       func() time.Time
+}
+
+// NewArchiver creates an archiver that uploads to store, and removes the archives older than retention from it.
+// Archives are kept forever if retention is 0.
+func NewArchiver(logger log.Logger, store Store, retention time.Duration) *Archiver {
+	return &Archiver{
+		logger:    logger,
+		store:     store,
+		retention: retention,
+		now:       time.Now,
+	}
+}
+
+// ArchiveName returns the name of the archive of the game.
+func ArchiveName(game common.Address) string {
+	return fmt.Sprintf(archiveNameFmt, game.Hex())
+}
+
+// Archive packages the outcome and the claims of the game, and its data in dir, into a tarball and uploads it.
+// The data includes the final cannon states and the proofs that were generated, but not the preimages or snapshots.
+func (a *Archiver) Archive(ctx context.Context, game common.Address, status gameTypes.GameStatus, agreeWithProposedOutput bool, claims []faultTypes.Claim, dir string) error {
+	won := status == gameTypes.GameStatusDefenderWon
+	if agreeWithProposedOutput {
+		won = status == gameTypes.GameStatusChallengerWon
+	}
+	outcome := Outcome{
+		Game:                    game,
+		Status:                  status.String(),
+		AgreeWithProposedOutput: agreeWithProposedOutput,
+		Won:                     won,
+		ArchivedAt:              uint64(a.now().Unix()),
+	}
+	file, err := os.CreateTemp("", "game-archive-*"+archiveExt)
+	if err != nil {
+		return fmt.Errorf("create archive file: %w", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+	if err := writeArchive(file, outcome, claims, dir); err != nil {
+		return fmt.Errorf("write archive of game %v: %w", game, err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	name := ArchiveName(game)
+	if err := a.store.Put(ctx, name, file); err != nil {
+		return fmt.Errorf("upload archive of game %v: %w", game, err)
+	}
+	a.logger.Info("Archived game", "game", game, "status", status, "archive", name)
+	if err := a.removeExpired(ctx); err != nil {
+		a.logger.Warn("Failed to remove expired game archives", "err", err)
+	}
+	return nil
+}
+
+func (a *Archiver) removeExpired(ctx context.Context) error {
+	if a.retention == 0 {
+		return nil
+	}
+	objects, err := a.store.List(ctx)
+	if err != nil {
+		return fmt.Errorf("list archives: %w", err)
+	}
+	expiry := a.now().Add(-a.retention)
+	var errs []error
+	for _, obj := range objects {
+		if !obj.ModTime.Before(expiry) {
+			continue
+		}
+		a.logger.Info("Removing expired game archive", "archive", obj.Name, "modTime", obj.ModTime)
+		if err := a.store.Delete(ctx, obj.Name); err != nil {
+			errs = append(errs, fmt.Errorf("remove archive %v: %w", obj.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func writeArchive(w io.Writer, outcome Outcome, claims []faultTypes.Claim, dir string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	if err := writeJSONEntry(tw, outcomeFile, outcome); err != nil {
+		return err
+	}
+	archived := make([]Claim, 0, len(claims))
+	for _, claim := range claims {
+		archived = append(archived, Claim{
+			Index:        claim.ContractIndex,
+			ParentIndex:  claim.ParentContractIndex,
+			Value:        claim.Value,
+			Depth:        claim.Depth(),
+			IndexAtDepth: (*hexutil.Big)(claim.IndexAtDepth()),
+			Countered:    claim.Countered,
+			Clock:        claim.Clock,
+		})
+	}
+	if err := writeJSONEntry(tw, claimsFile, archived); err != nil {
+		return err
+	}
+	if err := writeDirEntries(tw, dir); err != nil {
+		return err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func writeJSONEntry(tw *tar.Writer, name string, value any) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode %v: %w", name, err)
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(data))}); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, bytes.NewReader(data))
+	return err
+}
+
+// writeDirEntries adds the files in dir to the archive, under gameDataDir.
+func writeDirEntries(tw *tar.Writer, dir string) error {
+	if _, err := os.Stat(dir); errors.Is(err, fs.ErrNotExist) {
+		// Games that were played without a trace provider that persists data, such as the alphabet games
+		return nil
+	}
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if excludedDirs[entry.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(filepath.Join(gameDataDir, rel))
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.CopyN(tw, file, info.Size())
+		return err
+	})
+}
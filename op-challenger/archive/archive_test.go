@@ -0,0 +1,137 @@
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive(t *testing.T) {
+	game := common.Address{0xaa}
+	gameDir := t.TempDir()
+	writeFile(t, filepath.Join(gameDir, "final.bin.gz"), "final")
+	writeFile(t, filepath.Join(gameDir, "proofs", "100.json.gz"), "proof")
+	writeFile(t, filepath.Join(gameDir, "0x01", "proofs", "200.json.gz"), "bottom proof")
+	writeFile(t, filepath.Join(gameDir, "preimages", "0x02.txt"), "preimage")
+	writeFile(t, filepath.Join(gameDir, "snapshots", "100.bin.gz"), "snapshot")
+	claims := []faultTypes.Claim{
+		{
+			ClaimData:     faultTypes.ClaimData{Value: common.Hash{0x01}, Position: faultTypes.NewPosition(0, big.NewInt(0))},
+			ContractIndex: 0,
+		},
+		{
+			ClaimData:           faultTypes.ClaimData{Value: common.Hash{0x02}, Position: faultTypes.NewPosition(1, big.NewInt(1))},
+			Countered:           true,
+			ContractIndex:       1,
+			ParentContractIndex: 0,
+		},
+	}
+
+	storeDir := t.TempDir()
+	store, err := NewLocalStore(storeDir)
+	require.NoError(t, err)
+	archiver := NewArchiver(testlog.Logger(t, log.LvlInfo), store, 0)
+	archiver.now = func() time.Time { return time.Unix(1234, 0) }
+	require.NoError(t, archiver.Archive(context.Background(), game, gameTypes.GameStatusDefenderWon, false, claims, gameDir))
+
+	entries := readArchive(t, filepath.Join(storeDir, ArchiveName(game)))
+	var outcome Outcome
+	require.NoError(t, json.Unmarshal(entries[outcomeFile], &outcome))
+	require.Equal(t, Outcome{
+		Game:       game,
+		Status:     gameTypes.GameStatusDefenderWon.String(),
+		Won:        true,
+		ArchivedAt: 1234,
+	}, outcome)
+
+	var archivedClaims []Claim
+	require.NoError(t, json.Unmarshal(entries[claimsFile], &archivedClaims))
+	require.Len(t, archivedClaims, 2)
+	require.Equal(t, common.Hash{0x02}, archivedClaims[1].Value)
+	require.Equal(t, 1, archivedClaims[1].Depth)
+	require.True(t, archivedClaims[1].Countered)
+
+	require.Equal(t, "final", string(entries["data/final.bin.gz"]))
+	require.Equal(t, "proof", string(entries["data/proofs/100.json.gz"]))
+	require.Equal(t, "bottom proof", string(entries["data/0x01/proofs/200.json.gz"]))
+	require.NotContains(t, entries, "data/preimages/0x02.txt")
+	require.NotContains(t, entries, "data/snapshots/100.bin.gz")
+}
+
+func TestArchiveWithoutGameDir(t *testing.T) {
+	storeDir := t.TempDir()
+	store, err := NewLocalStore(storeDir)
+	require.NoError(t, err)
+	archiver := NewArchiver(testlog.Logger(t, log.LvlInfo), store, 0)
+	game := common.Address{0xaa}
+	err = archiver.Archive(context.Background(), game, gameTypes.GameStatusChallengerWon, true, nil, filepath.Join(t.TempDir(), "missing"))
+	require.NoError(t, err)
+
+	entries := readArchive(t, filepath.Join(storeDir, ArchiveName(game)))
+	require.Len(t, entries, 2)
+	var outcome Outcome
+	require.NoError(t, json.Unmarshal(entries[outcomeFile], &outcome))
+	require.True(t, outcome.Won)
+}
+
+func TestRemoveExpiredArchives(t *testing.T) {
+	storeDir := t.TempDir()
+	store, err := NewLocalStore(storeDir)
+	require.NoError(t, err)
+	now := time.Now()
+	expired := filepath.Join(storeDir, ArchiveName(common.Address{0x01}))
+	writeFile(t, expired, "expired")
+	require.NoError(t, os.Chtimes(expired, now.Add(-2*time.Hour), now.Add(-2*time.Hour)))
+	recent := filepath.Join(storeDir, ArchiveName(common.Address{0x02}))
+	writeFile(t, recent, "recent")
+	other := filepath.Join(storeDir, "other.txt")
+	writeFile(t, other, "other")
+	require.NoError(t, os.Chtimes(other, now.Add(-2*time.Hour), now.Add(-2*time.Hour)))
+
+	archiver := NewArchiver(testlog.Logger(t, log.LvlInfo), store, time.Hour)
+	require.NoError(t, archiver.Archive(context.Background(), common.Address{0x03}, gameTypes.GameStatusChallengerWon, false, nil, t.TempDir()))
+
+	require.NoFileExists(t, expired)
+	require.FileExists(t, recent)
+	require.FileExists(t, other)
+	require.FileExists(t, filepath.Join(storeDir, ArchiveName(common.Address{0x03})))
+}
+
+func writeFile(t *testing.T, path string, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func readArchive(t *testing.T, path string) map[string][]byte {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	gz, err := gzip.NewReader(file)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	entries := make(map[string][]byte)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return entries
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		entries[header.Name] = data
+	}
+}
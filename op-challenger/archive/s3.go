@@ -0,0 +1,242 @@
+package archive
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	s3Timeout     = 5 * time.Minute
+	amzDateFormat = "20060102T150405Z"
+)
+
+// S3Store stores the archives in a bucket of an object store with an S3 compatible API, such as Amazon S3 or the XML
+// API of Google Cloud Storage. The requests are signed with AWS Signature Version 4, and address the bucket in the
+// path so that any endpoint can be used.
+type S3Store struct {
+	endpoint *url.URL
+	region   string
+	bucket   string
+	prefix   string
+	creds    Credentials
+	client   *http.Client
+	now      func() time.Time
+}
+
+func NewS3Store(endpoint string, region string, bucket string, prefix string, creds Credentials) (*S3Store, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive endpoint %v: %w", endpoint, err)
+	}
+	return &S3Store{
+		endpoint: u,
+		region:   region,
+		bucket:   bucket,
+		prefix:   prefix,
+		creds:    creds,
+		client:   &http.Client{Timeout: s3Timeout},
+		now:      time.Now,
+	}, nil
+}
+
+func (s *S3Store) Put(ctx context.Context, name string, data io.ReadSeeker) error {
+	hash := sha256.New()
+	size, err := io.Copy(hash, data)
+	if err != nil {
+		return fmt.Errorf("hash archive: %w", err)
+	}
+	if _, err := data.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	req, err := s.newRequest(ctx, http.MethodPut, s.key(name), nil, io.NopCloser(data), hex.EncodeToString(hash.Sum(nil)))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	_, err = s.do(req)
+	return err
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (s *S3Store) List(ctx context.Context) ([]Object, error) {
+	var objects []Object
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}}
+		if s.prefix != "" {
+			query.Set("prefix", strings.TrimSuffix(s.prefix, "/")+"/")
+		}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		req, err := s.newRequest(ctx, http.MethodGet, "", query, nil, emptyPayloadHash)
+		if err != nil {
+			return nil, err
+		}
+		body, err := s.do(req)
+		if err != nil {
+			return nil, err
+		}
+		var result listBucketResult
+		if err := xml.Unmarshal(body, &result); err != nil {
+			return nil, fmt.Errorf("decode list of archives: %w", err)
+		}
+		for _, obj := range result.Contents {
+			name := path.Base(obj.Key)
+			if !strings.HasSuffix(name, archiveExt) || s.key(name) != obj.Key {
+				continue
+			}
+			objects = append(objects, Object{Name: name, ModTime: obj.LastModified})
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+func (s *S3Store) Delete(ctx context.Context, name string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, s.key(name), nil, nil, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	_, err = s.do(req)
+	return err
+}
+
+func (s *S3Store) key(name string) string {
+	if s.prefix == "" {
+		return name
+	}
+	return strings.TrimSuffix(s.prefix, "/") + "/" + name
+}
+
+func (s *S3Store) do(req *http.Request) ([]byte, error) {
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%v %v: unexpected status code %v: %s", req.Method, req.URL.Path, resp.StatusCode, body)
+	}
+	return body, nil
+}
+
+// emptyPayloadHash is the SHA-256 hash of an empty request body.
+var emptyPayloadHash = hex.EncodeToString(sha256.New().Sum(nil))
+
+// newRequest creates a request for the object with the key in the bucket, or for the bucket if the key is empty,
+// signed with AWS Signature Version 4.
+func (s *S3Store) newRequest(ctx context.Context, method string, key string, query url.Values, body io.ReadCloser, payloadHash string) (*http.Request, error) {
+	u := *s.endpoint
+	u.Path = "/" + s.bucket
+	if key != "" {
+		u.Path += "/" + key
+	}
+	u.RawPath = canonicalURI(u.Path)
+	u.RawQuery = canonicalQuery(query)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	signV4(req, s.creds, s.region, payloadHash, s.now())
+	return req, nil
+}
+
+// signV4 signs the request with AWS Signature Version 4. The query of the request must already be canonical.
+func signV4(req *http.Request, creds Credentials, region string, payloadHash string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format(amzDateFormat)
+	date := now.Format("20060102")
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI(req.URL.Path),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host + "\n" + "x-amz-content-sha256:" + payloadHash + "\n" + "x-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + region + "/s3/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+	key := hmacSHA256([]byte("AWS4"+creds.SecretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%v/%v, SignedHeaders=%v, Signature=%v",
+		creds.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// canonicalURI encodes every segment of the path as required by AWS Signature Version 4.
+func canonicalURI(p string) string {
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// canonicalQuery encodes the query sorted by key, as required by AWS Signature Version 4.
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var params []string
+	for _, k := range keys {
+		for _, v := range query[k] {
+			params = append(params, uriEncode(k)+"="+uriEncode(v))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// uriEncode encodes all bytes of s except the unreserved characters, as required by AWS Signature Version 4.
+func uriEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') || c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
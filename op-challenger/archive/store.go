@@ -0,0 +1,132 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+)
+
+var (
+	ErrUnknownScheme      = errors.New("unknown archive scheme, expected file, s3 or gs")
+	ErrMissingBucket      = errors.New("missing archive bucket")
+	ErrMissingCredentials = errors.New("missing archive access key id or secret access key")
+)
+
+// Object is an archive in a Store.
+type Object struct {
+	Name    string
+	ModTime time.Time
+}
+
+// Store stores the archives of games.
+type Store interface {
+	// Put stores the archive with the name, replacing any archive with the same name.
+	Put(ctx context.Context, name string, data io.ReadSeeker) error
+	// List returns the archives in the store.
+	List(ctx context.Context) ([]Object, error)
+	// Delete removes the archive with the name.
+	Delete(ctx context.Context, name string) error
+}
+
+// Credentials are the credentials of an object store.
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// NewStore creates the store of the archives at dest, which is a file:// URL of a local dir, an s3:// URL of an
+// Amazon S3 bucket, or a gs:// URL of a Google Cloud Storage bucket. The path of an S3 or GCS URL is the prefix of the
+// archive names. GCS buckets are accessed through the S3 compatible XML API, with HMAC keys as the credentials.
+// The endpoint and region are only used for object stores, and default to those of the provider if empty.
+func NewStore(dest string, endpoint string, region string, creds Credentials) (Store, error) {
+	u, err := url.Parse(dest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive destination %v: %w", dest, err)
+	}
+	switch u.Scheme {
+	case "file":
+		dir := u.Path
+		if u.Host != "" {
+			// Relative dir, such as file://archives
+			dir = filepath.Join(u.Host, u.Path)
+		}
+		return NewLocalStore(dir)
+	case "s3", "gs":
+		if u.Host == "" {
+			return nil, fmt.Errorf("%w: %v", ErrMissingBucket, dest)
+		}
+		if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+			return nil, ErrMissingCredentials
+		}
+		if region == "" {
+			region = "us-east-1"
+			if u.Scheme == "gs" {
+				region = "auto"
+			}
+		}
+		if endpoint == "" {
+			endpoint = fmt.Sprintf("https://s3.%v.amazonaws.com", region)
+			if u.Scheme == "gs" {
+				endpoint = "https://storage.googleapis.com"
+			}
+		}
+		return NewS3Store(endpoint, region, u.Host, strings.TrimPrefix(u.Path, "/"), creds)
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnknownScheme, dest)
+	}
+}
+
+// LocalStore stores the archives in a local dir.
+type LocalStore struct {
+	dir string
+}
+
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create archive dir %v: %w", dir, err)
+	}
+	return &LocalStore{dir: dir}, nil
+}
+
+func (s *LocalStore) Put(_ context.Context, name string, data io.ReadSeeker) error {
+	file, err := ioutil.CreateAtomic(filepath.Join(s.dir, name), 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Abort()
+	if _, err := io.Copy(file, data); err != nil {
+		return err
+	}
+	return file.Commit()
+}
+
+func (s *LocalStore) List(_ context.Context) ([]Object, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	var objects []Object
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), archiveExt) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, Object{Name: entry.Name(), ModTime: info.ModTime()})
+	}
+	return objects, nil
+}
+
+func (s *LocalStore) Delete(_ context.Context, name string) error {
+	return os.Remove(filepath.Join(s.dir, name))
+}
@@ -0,0 +1,60 @@
+package archive
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStore(t *testing.T) {
+	creds := Credentials{AccessKeyID: "id", SecretAccessKey: "secret"}
+
+	t.Run("Local", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "archives")
+		store, err := NewStore("file://"+dir, "", "", Credentials{})
+		require.NoError(t, err)
+		require.IsType(t, &LocalStore{}, store)
+		require.DirExists(t, dir)
+	})
+
+	t.Run("S3", func(t *testing.T) {
+		store, err := NewStore("s3://bucket/prefix", "", "eu-west-1", creds)
+		require.NoError(t, err)
+		s3, ok := store.(*S3Store)
+		require.True(t, ok)
+		require.Equal(t, "https://s3.eu-west-1.amazonaws.com", s3.endpoint.String())
+		require.Equal(t, "bucket", s3.bucket)
+		require.Equal(t, "prefix", s3.prefix)
+	})
+
+	t.Run("GCS", func(t *testing.T) {
+		store, err := NewStore("gs://bucket", "", "", creds)
+		require.NoError(t, err)
+		s3, ok := store.(*S3Store)
+		require.True(t, ok)
+		require.Equal(t, "https://storage.googleapis.com", s3.endpoint.String())
+		require.Equal(t, "auto", s3.region)
+	})
+
+	t.Run("CustomEndpoint", func(t *testing.T) {
+		store, err := NewStore("s3://bucket", "http://localhost:9000", "", creds)
+		require.NoError(t, err)
+		require.Equal(t, "http://localhost:9000", store.(*S3Store).endpoint.String())
+	})
+
+	t.Run("MissingBucket", func(t *testing.T) {
+		_, err := NewStore("s3://", "", "", creds)
+		require.ErrorIs(t, err, ErrMissingBucket)
+	})
+
+	t.Run("MissingCredentials", func(t *testing.T) {
+		_, err := NewStore("gs://bucket", "", "", Credentials{AccessKeyID: "id"})
+		require.ErrorIs(t, err, ErrMissingCredentials)
+	})
+
+	t.Run("UnknownScheme", func(t *testing.T) {
+		_, err := NewStore("ftp://bucket", "", "", creds)
+		require.ErrorIs(t, err, ErrUnknownScheme)
+	})
+}
@@ -10,11 +10,11 @@ import (
 )
 
 // Main is the programmatic entry-point for running op-challenger
-func Main(ctx context.Context, logger log.Logger, cfg *config.Config) error {
+func Main(ctx context.Context, logger log.Logger, cfg *config.Config, opts ...game.Option) error {
 	if err := cfg.Check(); err != nil {
 		return err
 	}
-	service, err := game.NewService(ctx, logger, cfg)
+	service, err := game.NewService(ctx, logger, cfg, opts...)
 	if err != nil {
 		return fmt.Errorf("failed to create the fault service: %w", err)
 	}
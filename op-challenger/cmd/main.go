@@ -26,7 +26,9 @@ var VersionWithMeta = opservice.FormatVersion(version.Version, GitCommit, GitDat
 
 func main() {
 	args := os.Args
-	if err := run(args, op_challenger.Main); err != nil {
+	if err := run(args, func(ctx context.Context, log log.Logger, config *config.Config) error {
+		return op_challenger.Main(ctx, log, config)
+	}); err != nil {
 		log.Crit("Application failed", "err", err)
 	}
 }
@@ -25,6 +25,7 @@ var (
 	datadir                 = "./test_data"
 	cannonL2                = "http://example.com:9545"
 	rollupRpc               = "http://example.com:8555"
+	outputSplitDepth        = "30"
 	alphabetTrace           = "abcdefghijz"
 	agreeWithProposedOutput = "true"
 )
@@ -304,6 +305,32 @@ func TestRollupRpc(t *testing.T) {
 	})
 }
 
+func TestExperimentalOutputCannon(t *testing.T) {
+	t.Run("RequiredForOutputCannonTrace", func(t *testing.T) {
+		verifyArgsInvalid(t, "flag experimental-output-cannon is required", addRequiredArgsExcept(config.TraceTypeOutputCannon, "--experimental-output-cannon"))
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeOutputCannon))
+		require.True(t, cfg.ExperimentalOutputCannon)
+	})
+}
+
+func TestOutputSplitDepth(t *testing.T) {
+	t.Run("NotRequiredForCannonTrace", func(t *testing.T) {
+		configForArgs(t, addRequiredArgsExcept(config.TraceTypeCannon, "--output-split-depth"))
+	})
+
+	t.Run("RequiredForOutputCannonTrace", func(t *testing.T) {
+		verifyArgsInvalid(t, "flag output-split-depth is required", addRequiredArgsExcept(config.TraceTypeOutputCannon, "--output-split-depth"))
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeOutputCannon))
+		require.Equal(t, uint64(30), cfg.OutputSplitDepth)
+	})
+}
+
 func TestCannonL2(t *testing.T) {
 	t.Run("NotRequiredForAlphabetTrace", func(t *testing.T) {
 		configForArgs(t, addRequiredArgsExcept(config.TraceTypeAlphabet, "--cannon-l2"))
@@ -353,6 +380,62 @@ func TestCannonInfoFreq(t *testing.T) {
 	})
 }
 
+func TestCannonPrefetch(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon))
+		require.False(t, cfg.CannonPrefetch)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon, "--cannon-prefetch"))
+		require.True(t, cfg.CannonPrefetch)
+	})
+}
+
+func TestCannonProofCacheSize(t *testing.T) {
+	t.Run("UsesDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon))
+		require.Equal(t, config.DefaultCannonProofCacheSize, cfg.CannonProofCacheSize)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon, "--cannon-proof-cache-size=1234"))
+		require.Equal(t, uint64(1234), cfg.CannonProofCacheSize)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon, "--cannon-proof-cache-size=0"))
+		require.Zero(t, cfg.CannonProofCacheSize)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid value \"abc\" for flag -cannon-proof-cache-size",
+			addRequiredArgs(config.TraceTypeCannon, "--cannon-proof-cache-size=abc"))
+	})
+}
+
+func TestCannonExecutionLimits(t *testing.T) {
+	t.Run("UsesDefaults", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon))
+		require.Zero(t, cfg.CannonMaxParallel)
+		require.Zero(t, cfg.CannonMemoryQuota)
+		require.Zero(t, cfg.CannonDiskQuota)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeCannon,
+			"--cannon-max-parallel=3", "--cannon-memory-quota=1234", "--cannon-disk-quota=5678"))
+		require.Equal(t, uint(3), cfg.CannonMaxParallel)
+		require.Equal(t, uint64(1234), cfg.CannonMemoryQuota)
+		require.Equal(t, uint64(5678), cfg.CannonDiskQuota)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid value \"abc\" for flag -cannon-max-parallel",
+			addRequiredArgs(config.TraceTypeCannon, "--cannon-max-parallel=abc"))
+	})
+}
+
 func TestGameWindow(t *testing.T) {
 	t.Run("UsesDefault", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
@@ -370,6 +453,98 @@ func TestGameWindow(t *testing.T) {
 	})
 }
 
+func TestMode(t *testing.T) {
+	t.Run("DefaultToNormal", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Equal(t, config.ModeNormal, cfg.Mode)
+	})
+
+	t.Run("Shadow", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--mode=shadow"))
+		require.Equal(t, config.ModeShadow, cfg.Mode)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "unknown mode: \"foo\"", addRequiredArgs(config.TraceTypeAlphabet, "--mode=foo"))
+	})
+}
+
+func TestStrategy(t *testing.T) {
+	t.Run("DefaultToHonest", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.Strategies)
+		require.Equal(t, config.StrategyHonest, cfg.StrategyFor(config.TraceTypeAlphabet))
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--strategy=alphabet=defensive", "--strategy=cannon=freeloader-counter"))
+		require.Equal(t, config.StrategyDefensive, cfg.StrategyFor(config.TraceTypeAlphabet))
+		require.Equal(t, config.StrategyFreeloaderCounter, cfg.StrategyFor(config.TraceTypeCannon))
+		require.Equal(t, config.StrategyHonest, cfg.StrategyFor(config.TraceTypeOutputCannon))
+	})
+
+	t.Run("MissingTraceType", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid strategy \"defensive\"", addRequiredArgs(config.TraceTypeAlphabet, "--strategy=defensive"))
+	})
+
+	t.Run("UnknownTraceType", func(t *testing.T) {
+		verifyArgsInvalid(t, "unknown trace type: \"foo\"", addRequiredArgs(config.TraceTypeAlphabet, "--strategy=foo=defensive"))
+	})
+
+	t.Run("UnknownStrategy", func(t *testing.T) {
+		verifyArgsInvalid(t, "unknown strategy: \"foo\"", addRequiredArgs(config.TraceTypeAlphabet, "--strategy=alphabet=foo"))
+	})
+}
+
+func TestNotify(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.NotifyWebhookURL)
+		require.Empty(t, cfg.NotifySlackWebhookURL)
+		require.Empty(t, cfg.NotifyPagerDutyRoutingKey)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--notify.webhook-url=http://example.com/hook",
+			"--notify.slack-webhook-url=http://example.com/slack",
+			"--notify.pagerduty-routing-key=abc"))
+		require.Equal(t, "http://example.com/hook", cfg.NotifyWebhookURL)
+		require.Equal(t, "http://example.com/slack", cfg.NotifySlackWebhookURL)
+		require.Equal(t, "abc", cfg.NotifyPagerDutyRoutingKey)
+	})
+}
+
+func TestArchive(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.ArchiveDest)
+		require.Zero(t, cfg.ArchiveRetention)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--archive.dest=s3://bucket/prefix",
+			"--archive.retention=720h",
+			"--archive.endpoint=http://localhost:9000",
+			"--archive.region=eu-west-1",
+			"--archive.access-key-id=id",
+			"--archive.secret-access-key=secret"))
+		require.Equal(t, "s3://bucket/prefix", cfg.ArchiveDest)
+		require.Equal(t, 720*time.Hour, cfg.ArchiveRetention)
+		require.Equal(t, "http://localhost:9000", cfg.ArchiveEndpoint)
+		require.Equal(t, "eu-west-1", cfg.ArchiveRegion)
+		require.Equal(t, "id", cfg.ArchiveAccessKeyID)
+		require.Equal(t, "secret", cfg.ArchiveSecretAccessKey)
+	})
+
+	t.Run("InvalidRetention", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid value \"abc\" for flag -archive.retention",
+			addRequiredArgs(config.TraceTypeAlphabet, "--archive.retention=abc"))
+	})
+}
+
 func TestRequireEitherCannonNetworkOrRollupAndGenesis(t *testing.T) {
 	verifyArgsInvalid(
 		t,
@@ -492,6 +667,8 @@ func addRequiredAlphabetArgs(args map[string]string) {
 func addRequiredOutputCannonArgs(args map[string]string) {
 	addRequiredCannonArgs(args)
 	args["--rollup-rpc"] = rollupRpc
+	args["--output-split-depth"] = outputSplitDepth
+	args["--experimental-output-cannon"] = "true"
 }
 
 func addRequiredCannonArgs(args map[string]string) {
@@ -10,8 +10,11 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	optracing "github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -34,6 +37,10 @@ var (
 	ErrCannonNetworkAndL2Genesis     = errors.New("only specify one of network or l2 genesis path")
 	ErrCannonNetworkUnknown          = errors.New("unknown cannon network")
 	ErrMissingRollupRpc              = errors.New("missing rollup rpc url")
+	ErrMissingOutputSplitDepth       = errors.New("missing output split depth")
+	ErrOutputCannonExperimental      = errors.New("output_cannon trace type is experimental and must be enabled explicitly")
+	ErrUnknownMode                   = errors.New("unknown mode")
+	ErrUnknownStrategy               = errors.New("unknown strategy")
 )
 
 type TraceType string
@@ -47,15 +54,18 @@ const (
 	CannonFaultGameID = 0
 
 	// Devnet games
-	AlphabetFaultGameID = 255
+	// OutputCannonFaultGameID is a placeholder: no game contract of this type exists yet.
+	OutputCannonFaultGameID = 253
+	AlphabetFaultGameID     = 255
 )
 
 var TraceTypes = []TraceType{TraceTypeAlphabet, TraceTypeCannon, TraceTypeOutputCannon}
 
 // GameIdToString maps game IDs to their string representation.
 var GameIdToString = map[uint8]string{
-	CannonFaultGameID:   "Cannon",
-	AlphabetFaultGameID: "Alphabet",
+	CannonFaultGameID:       "Cannon",
+	OutputCannonFaultGameID: "OutputCannon",
+	AlphabetFaultGameID:     "Alphabet",
 }
 
 func (t TraceType) String() string {
@@ -85,10 +95,72 @@ func ValidTraceType(value TraceType) bool {
 	return false
 }
 
+type Mode string
+
+const (
+	// ModeNormal plays the games by sending transactions.
+	ModeNormal Mode = "normal"
+	// ModeShadow evaluates the games like ModeNormal, but only logs the transactions that would be sent.
+	ModeShadow Mode = "shadow"
+)
+
+var Modes = []Mode{ModeNormal, ModeShadow}
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+// Set implements the Set method required by the [cli.Generic] interface.
+func (m *Mode) Set(value string) error {
+	if !slices.Contains(Modes, Mode(value)) {
+		return fmt.Errorf("unknown mode: %q", value)
+	}
+	*m = Mode(value)
+	return nil
+}
+
+func (m *Mode) Clone() any {
+	cpy := *m
+	return &cpy
+}
+
+type Strategy string
+
+const (
+	// StrategyHonest counters the claims that disagree with the trace on the path of valid claims from the root claim.
+	StrategyHonest Strategy = "honest"
+	// StrategyFreeloaderCounter also counters the claims that disagree with the trace below invalid claims.
+	StrategyFreeloaderCounter Strategy = "freeloader-counter"
+	// StrategyDefensive plays like StrategyHonest, but only the games whose root claim agrees with the trace.
+	StrategyDefensive Strategy = "defensive"
+)
+
+var Strategies = []Strategy{StrategyHonest, StrategyFreeloaderCounter, StrategyDefensive}
+
+func (s Strategy) String() string {
+	return string(s)
+}
+
+// Set implements the Set method required by the [cli.Generic] interface.
+func (s *Strategy) Set(value string) error {
+	if !slices.Contains(Strategies, Strategy(value)) {
+		return fmt.Errorf("unknown strategy: %q", value)
+	}
+	*s = Strategy(value)
+	return nil
+}
+
+func (s *Strategy) Clone() any {
+	cpy := *s
+	return &cpy
+}
+
 const (
 	DefaultPollInterval       = time.Second * 12
 	DefaultCannonSnapshotFreq = uint(1_000_000_000)
 	DefaultCannonInfoFreq     = uint(10_000_000)
+	// DefaultCannonProofCacheSize is the default maximum size of the cannon proofs that are shared between games.
+	DefaultCannonProofCacheSize = uint64(1 << 30)
 	// DefaultGameWindow is the default maximum time duration in the past
 	// that the challenger will look for games to progress.
 	// The default value is 11 days, which is a 4 day resolution buffer
@@ -110,12 +182,16 @@ type Config struct {
 	PollInterval            time.Duration    // Polling interval for latest-block subscription when using an HTTP RPC provider
 
 	TraceTypes []TraceType // Type of traces supported
+	// Strategies are the strategies to play the games of each trace type with, StrategyHonest for trace types without one
+	Strategies map[TraceType]Strategy
 
 	// Specific to the alphabet trace provider
 	AlphabetTrace string // String for the AlphabetTraceProvider
 
 	// Specific to the output cannon trace type
-	RollupRpc string
+	RollupRpc                string
+	OutputSplitDepth         uint64 // Depth of the output root bisection, below which the cannon execution trace is bisected
+	ExperimentalOutputCannon bool   // Allow the output cannon trace type, which no game contract implements yet
 
 	// Specific to the cannon trace provider
 	CannonBin              string // Path to the cannon executable to run when generating trace data
@@ -127,10 +203,35 @@ type Config struct {
 	CannonL2               string // L2 RPC Url
 	CannonSnapshotFreq     uint   // Frequency of snapshots to create when executing cannon (in VM instructions)
 	CannonInfoFreq         uint   // Frequency of cannon progress log messages (in VM instructions)
+	CannonPrefetch         bool   // Fetch all pre-images before executing cannon, so the execution never waits on fetches
+	CannonProofCacheSize   uint64 // Maximum size of the cannon proofs that are shared between games (in bytes), 0 to not share proofs
+	CannonMaxParallel      uint   // Maximum number of cannon executions of all games to run in parallel, 0 for no limit
+	CannonMemoryQuota      uint64 // Maximum resident memory of a cannon execution and its server (in bytes), 0 for no limit
+	CannonDiskQuota        uint64 // Maximum size of the data dir of a game while cannon is executed (in bytes), 0 for no limit
 
 	TxMgrConfig   txmgr.CLIConfig
 	MetricsConfig opmetrics.CLIConfig
 	PprofConfig   oppprof.CLIConfig
+	TracingConfig optracing.CLIConfig
+
+	Mode Mode // Mode the challenger runs in, the games are evaluated without sending transactions in ModeShadow
+
+	RPCEnabled bool // Enables the RPC server that serves the challenger API, and the admin API if enabled in RPCConfig
+	RPCConfig  oprpc.CLIConfig
+
+	NotifyWebhookURL          string // URL that notifications are posted to as JSON, none if empty
+	NotifySlackWebhookURL     string // Slack incoming webhook URL that notifications are sent to, none if empty
+	NotifyPagerDutyRoutingKey string // Routing key of the PagerDuty integration that notifications trigger alerts of, none if empty
+
+	ArchiveDest            string        // URL of the local dir (file://), S3 bucket (s3://) or GCS bucket (gs://) that resolved games are archived to, none if empty
+	ArchiveRetention       time.Duration // Time to keep the archives of games for, forever if 0
+	ArchiveEndpoint        string        // Endpoint of the object store, the endpoint of the provider if empty
+	ArchiveRegion          string        // Region of the object store, the default region of the provider if empty
+	ArchiveAccessKeyID     string        // Access key id of the object store, the id of an HMAC key for GCS
+	ArchiveSecretAccessKey string        // Secret access key of the object store, the secret of an HMAC key for GCS
+
+	// Clock that the games are monitored with. Not configurable through flags, the system clock is used if nil.
+	Clock clock.Clock
 }
 
 func NewConfig(
@@ -153,15 +254,28 @@ func NewConfig(
 		TxMgrConfig:   txmgr.NewCLIConfig(l1EthRpc, txmgr.DefaultChallengerFlagValues),
 		MetricsConfig: opmetrics.DefaultCLIConfig(),
 		PprofConfig:   oppprof.DefaultCLIConfig(),
+		TracingConfig: optracing.DefaultCLIConfig(),
+		RPCConfig:     oprpc.DefaultCLIConfig(),
 
 		Datadir: datadir,
 
-		CannonSnapshotFreq: DefaultCannonSnapshotFreq,
-		CannonInfoFreq:     DefaultCannonInfoFreq,
-		GameWindow:         DefaultGameWindow,
+		Mode: ModeNormal,
+
+		CannonSnapshotFreq:   DefaultCannonSnapshotFreq,
+		CannonInfoFreq:       DefaultCannonInfoFreq,
+		CannonProofCacheSize: DefaultCannonProofCacheSize,
+		GameWindow:           DefaultGameWindow,
 	}
 }
 
+// StrategyFor returns the strategy to play the games of the trace type with.
+func (c Config) StrategyFor(t TraceType) Strategy {
+	if strategy, ok := c.Strategies[t]; ok {
+		return strategy
+	}
+	return StrategyHonest
+}
+
 func (c Config) TraceTypeEnabled(t TraceType) bool {
 	return slices.Contains(c.TraceTypes, t)
 }
@@ -182,10 +296,24 @@ func (c Config) Check() error {
 	if c.MaxConcurrency == 0 {
 		return ErrMaxConcurrencyZero
 	}
+	if !slices.Contains(Modes, c.Mode) {
+		return fmt.Errorf("%w: %q", ErrUnknownMode, c.Mode)
+	}
+	for traceType, strategy := range c.Strategies {
+		if !slices.Contains(Strategies, strategy) {
+			return fmt.Errorf("%w: %q for trace type %v", ErrUnknownStrategy, strategy, traceType)
+		}
+	}
 	if c.TraceTypeEnabled(TraceTypeOutputCannon) {
+		if !c.ExperimentalOutputCannon {
+			return ErrOutputCannonExperimental
+		}
 		if c.RollupRpc == "" {
 			return ErrMissingRollupRpc
 		}
+		if c.OutputSplitDepth == 0 {
+			return ErrMissingOutputSplitDepth
+		}
 	}
 	if c.TraceTypeEnabled(TraceTypeCannon) || c.TraceTypeEnabled(TraceTypeOutputCannon) {
 		if c.CannonBin == "" {
@@ -237,5 +365,11 @@ func (c Config) Check() error {
 	if err := c.PprofConfig.Check(); err != nil {
 		return err
 	}
+	if err := c.TracingConfig.Check(); err != nil {
+		return err
+	}
+	if err := c.RPCConfig.Check(); err != nil {
+		return err
+	}
 	return nil
 }
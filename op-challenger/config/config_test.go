@@ -21,6 +21,7 @@ var (
 	validDatadir               = "/tmp/data"
 	validCannonL2              = "http://localhost:9545"
 	validRollupRpc             = "http://localhost:8555"
+	validOutputSplitDepth      = uint64(30)
 	agreeWithProposedOutput    = true
 )
 
@@ -37,7 +38,9 @@ func validConfig(traceType TraceType) Config {
 		cfg.CannonNetwork = validCannonNetwork
 	}
 	if traceType == TraceTypeOutputCannon {
+		cfg.ExperimentalOutputCannon = true
 		cfg.RollupRpc = validRollupRpc
+		cfg.OutputSplitDepth = validOutputSplitDepth
 	}
 	return cfg
 }
@@ -109,6 +112,46 @@ func TestDatadirRequired(t *testing.T) {
 	require.ErrorIs(t, config.Check(), ErrMissingDatadir)
 }
 
+func TestMode(t *testing.T) {
+	t.Run("DefaultToNormal", func(t *testing.T) {
+		config := validConfig(TraceTypeAlphabet)
+		require.Equal(t, ModeNormal, config.Mode)
+	})
+
+	t.Run("Shadow", func(t *testing.T) {
+		config := validConfig(TraceTypeAlphabet)
+		config.Mode = ModeShadow
+		require.NoError(t, config.Check())
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		config := validConfig(TraceTypeAlphabet)
+		config.Mode = "foo"
+		require.ErrorIs(t, config.Check(), ErrUnknownMode)
+	})
+}
+
+func TestStrategies(t *testing.T) {
+	t.Run("DefaultToHonest", func(t *testing.T) {
+		config := validConfig(TraceTypeAlphabet)
+		require.Equal(t, StrategyHonest, config.StrategyFor(TraceTypeAlphabet))
+	})
+
+	t.Run("PerTraceType", func(t *testing.T) {
+		config := validConfig(TraceTypeAlphabet)
+		config.Strategies = map[TraceType]Strategy{TraceTypeCannon: StrategyDefensive}
+		require.NoError(t, config.Check())
+		require.Equal(t, StrategyDefensive, config.StrategyFor(TraceTypeCannon))
+		require.Equal(t, StrategyHonest, config.StrategyFor(TraceTypeAlphabet))
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		config := validConfig(TraceTypeAlphabet)
+		config.Strategies = map[TraceType]Strategy{TraceTypeAlphabet: "foo"}
+		require.ErrorIs(t, config.Check(), ErrUnknownStrategy)
+	})
+}
+
 func TestMaxConcurrency(t *testing.T) {
 	t.Run("Required", func(t *testing.T) {
 		config := validConfig(TraceTypeAlphabet)
@@ -135,6 +178,18 @@ func TestRollupRpcRequired(t *testing.T) {
 	require.ErrorIs(t, config.Check(), ErrMissingRollupRpc)
 }
 
+func TestOutputCannonRequiresExperimental(t *testing.T) {
+	config := validConfig(TraceTypeOutputCannon)
+	config.ExperimentalOutputCannon = false
+	require.ErrorIs(t, config.Check(), ErrOutputCannonExperimental)
+}
+
+func TestOutputSplitDepthRequired(t *testing.T) {
+	config := validConfig(TraceTypeOutputCannon)
+	config.OutputSplitDepth = 0
+	require.ErrorIs(t, config.Check(), ErrMissingOutputSplitDepth)
+}
+
 func TestCannonL2Required(t *testing.T) {
 	config := validConfig(TraceTypeCannon)
 	config.CannonL2 = ""
@@ -199,7 +254,9 @@ func TestRequireConfigForAllSupportedTraceTypes(t *testing.T) {
 	cfg := validConfig(TraceTypeCannon)
 	cfg.TraceTypes = []TraceType{TraceTypeCannon, TraceTypeOutputCannon, TraceTypeAlphabet}
 	// Set all required options and check its valid
+	cfg.ExperimentalOutputCannon = true
 	cfg.RollupRpc = validRollupRpc
+	cfg.OutputSplitDepth = validOutputSplitDepth
 	cfg.AlphabetTrace = validAlphabetTrace
 	require.NoError(t, cfg.Check())
 
@@ -207,6 +264,9 @@ func TestRequireConfigForAllSupportedTraceTypes(t *testing.T) {
 	cfg.RollupRpc = ""
 	require.ErrorIs(t, cfg.Check(), ErrMissingRollupRpc)
 	cfg.RollupRpc = validRollupRpc
+	cfg.OutputSplitDepth = 0
+	require.ErrorIs(t, cfg.Check(), ErrMissingOutputSplitDepth)
+	cfg.OutputSplitDepth = validOutputSplitDepth
 
 	// Require cannon specific args
 	cfg.CannonL2 = ""
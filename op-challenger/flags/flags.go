@@ -16,6 +16,8 @@ import (
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	optracing "github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -50,6 +52,20 @@ var (
 		Usage:   "The trace types to support. Valid options: " + openum.EnumString(config.TraceTypes),
 		EnvVars: prefixEnvVars("TRACE_TYPE"),
 	}
+	ModeFlag = &cli.StringFlag{
+		Name: "mode",
+		Usage: "Mode to run in. Valid options: " + openum.EnumString(config.Modes) + ". " +
+			"In shadow mode the games are evaluated and the transactions that would be sent are logged, but never sent.",
+		EnvVars: prefixEnvVars("MODE"),
+		Value:   config.ModeNormal.String(),
+	}
+	StrategyFlag = &cli.StringSliceFlag{
+		Name: "strategy",
+		Usage: "The strategy to play the games of a trace type with, as <trace-type>=<strategy>. Valid strategies: " +
+			openum.EnumString(config.Strategies) + ". Games of trace types without a strategy are played with " +
+			config.StrategyHonest.String() + ".",
+		EnvVars: prefixEnvVars("STRATEGY"),
+	}
 	AgreeWithProposedOutputFlag = &cli.BoolFlag{
 		Name:    "agree-with-proposed-output",
 		Usage:   "Temporary hardcoded flag if we agree or disagree with the proposed output.",
@@ -75,9 +91,20 @@ var (
 	}
 	RollupRpcFlag = &cli.StringFlag{
 		Name:    "rollup-rpc",
-		Usage:   "HTTP provider URL for the rollup node",
+		Usage:   "HTTP provider URL for the rollup node. Multiple comma-separated URLs are failed over between.",
 		EnvVars: prefixEnvVars("ROLLUP_RPC"),
 	}
+	OutputSplitDepthFlag = &cli.Uint64Flag{
+		Name:    "output-split-depth",
+		Usage:   "Depth of the output root bisection of the game, below which the cannon trace is bisected (output_cannon trace type only)",
+		EnvVars: prefixEnvVars("OUTPUT_SPLIT_DEPTH"),
+	}
+	ExperimentalOutputCannonFlag = &cli.BoolFlag{
+		Name: "experimental-output-cannon",
+		Usage: "Allow the output_cannon trace type. It is experimental: no game contract of its game type exists yet, " +
+			"and the local inputs of its cannon traces do not match the local data of the existing game contracts",
+		EnvVars: prefixEnvVars("EXPERIMENTAL_OUTPUT_CANNON"),
+	}
 	AlphabetFlag = &cli.StringFlag{
 		Name:    "alphabet",
 		Usage:   "Correct Alphabet Trace (alphabet trace type only)",
@@ -133,12 +160,88 @@ var (
 		EnvVars: prefixEnvVars("CANNON_INFO_FREQ"),
 		Value:   config.DefaultCannonInfoFreq,
 	}
+	CannonPrefetchFlag = &cli.BoolFlag{
+		Name:    "cannon-prefetch",
+		Usage:   "Fetch all pre-images with a native run of the op-program client before every cannon execution, so that cannon never waits on L1 or L2 RPC fetches (cannon trace type only)",
+		EnvVars: prefixEnvVars("CANNON_PREFETCH"),
+	}
+	CannonProofCacheSizeFlag = &cli.Uint64Flag{
+		Name:    "cannon-proof-cache-size",
+		Usage:   "Maximum size in bytes of the cannon proofs that are shared between games that dispute the same claim, 0 to not share proofs (cannon trace type only)",
+		EnvVars: prefixEnvVars("CANNON_PROOF_CACHE_SIZE"),
+		Value:   config.DefaultCannonProofCacheSize,
+	}
+	CannonMaxParallelFlag = &cli.UintFlag{
+		Name:    "cannon-max-parallel",
+		Usage:   "Maximum number of cannon executions of all games to run in parallel, prioritised by the time left on the game clocks, 0 for no limit (cannon trace type only)",
+		EnvVars: prefixEnvVars("CANNON_MAX_PARALLEL"),
+	}
+	CannonMemoryQuotaFlag = &cli.Uint64Flag{
+		Name:    "cannon-memory-quota",
+		Usage:   "Maximum resident memory in bytes of a cannon execution and its op-program server, over which the execution is stopped, 0 for no limit. Only enforced on Linux (cannon trace type only)",
+		EnvVars: prefixEnvVars("CANNON_MEMORY_QUOTA"),
+	}
+	CannonDiskQuotaFlag = &cli.Uint64Flag{
+		Name:    "cannon-disk-quota",
+		Usage:   "Maximum size in bytes of the data dir of a game, over which cannon executions are stopped, 0 for no limit (cannon trace type only)",
+		EnvVars: prefixEnvVars("CANNON_DISK_QUOTA"),
+	}
 	GameWindowFlag = &cli.DurationFlag{
 		Name:    "game-window",
 		Usage:   "The time window which the challenger will look for games to progress.",
 		EnvVars: prefixEnvVars("GAME_WINDOW"),
 		Value:   config.DefaultGameWindow,
 	}
+	RPCEnabledFlag = &cli.BoolFlag{
+		Name:    "rpc.enabled",
+		Usage:   "Enable the RPC server that serves the challenger API to inspect the games that are played.",
+		EnvVars: prefixEnvVars("RPC_ENABLED"),
+	}
+	NotifyWebhookURLFlag = &cli.StringFlag{
+		Name:    "notify.webhook-url",
+		Usage:   "URL to post notifications to as JSON, when an invalid root claim is countered, a claim that agrees with the trace is countered at max depth, or a game is lost.",
+		EnvVars: prefixEnvVars("NOTIFY_WEBHOOK_URL"),
+	}
+	NotifySlackWebhookURLFlag = &cli.StringFlag{
+		Name:    "notify.slack-webhook-url",
+		Usage:   "Slack incoming webhook URL to send notifications to.",
+		EnvVars: prefixEnvVars("NOTIFY_SLACK_WEBHOOK_URL"),
+	}
+	NotifyPagerDutyRoutingKeyFlag = &cli.StringFlag{
+		Name:    "notify.pagerduty-routing-key",
+		Usage:   "Routing key of the PagerDuty Events API v2 integration to trigger alerts with for notifications.",
+		EnvVars: prefixEnvVars("NOTIFY_PAGERDUTY_ROUTING_KEY"),
+	}
+	ArchiveDestFlag = &cli.StringFlag{
+		Name:    "archive.dest",
+		Usage:   "Where to archive the final cannon states, proofs, claims and outcome of resolved games: a local dir (file:///path), an S3 bucket (s3://bucket/prefix) or a GCS bucket (gs://bucket/prefix). Games are not archived if empty.",
+		EnvVars: prefixEnvVars("ARCHIVE_DEST"),
+	}
+	ArchiveRetentionFlag = &cli.DurationFlag{
+		Name:    "archive.retention",
+		Usage:   "Time to keep the archives of games for, after which they are removed. Archives are kept forever if 0.",
+		EnvVars: prefixEnvVars("ARCHIVE_RETENTION"),
+	}
+	ArchiveEndpointFlag = &cli.StringFlag{
+		Name:    "archive.endpoint",
+		Usage:   "Endpoint of the S3 compatible object store to archive games to. Defaults to the endpoint of Amazon S3 in the region, or of GCS.",
+		EnvVars: prefixEnvVars("ARCHIVE_ENDPOINT"),
+	}
+	ArchiveRegionFlag = &cli.StringFlag{
+		Name:    "archive.region",
+		Usage:   "Region of the object store to archive games to. Defaults to us-east-1 for S3 and auto for GCS.",
+		EnvVars: prefixEnvVars("ARCHIVE_REGION"),
+	}
+	ArchiveAccessKeyIDFlag = &cli.StringFlag{
+		Name:    "archive.access-key-id",
+		Usage:   "Access key id of the object store to archive games to, the access id of an HMAC key for GCS.",
+		EnvVars: prefixEnvVars("ARCHIVE_ACCESS_KEY_ID"),
+	}
+	ArchiveSecretAccessKeyFlag = &cli.StringFlag{
+		Name:    "archive.secret-access-key",
+		Usage:   "Secret access key of the object store to archive games to, the secret of an HMAC key for GCS.",
+		EnvVars: prefixEnvVars("ARCHIVE_SECRET_ACCESS_KEY"),
+	}
 )
 
 // requiredFlags are checked by [CheckRequired]
@@ -152,9 +255,13 @@ var requiredFlags = []cli.Flag{
 
 // optionalFlags is a list of unchecked cli flags
 var optionalFlags = []cli.Flag{
+	ModeFlag,
+	StrategyFlag,
 	MaxConcurrencyFlag,
 	HTTPPollInterval,
 	RollupRpcFlag,
+	OutputSplitDepthFlag,
+	ExperimentalOutputCannonFlag,
 	AlphabetFlag,
 	GameAllowlistFlag,
 	CannonNetworkFlag,
@@ -166,7 +273,22 @@ var optionalFlags = []cli.Flag{
 	CannonL2Flag,
 	CannonSnapshotFreqFlag,
 	CannonInfoFreqFlag,
+	CannonPrefetchFlag,
+	CannonProofCacheSizeFlag,
+	CannonMaxParallelFlag,
+	CannonMemoryQuotaFlag,
+	CannonDiskQuotaFlag,
 	GameWindowFlag,
+	RPCEnabledFlag,
+	NotifyWebhookURLFlag,
+	NotifySlackWebhookURLFlag,
+	NotifyPagerDutyRoutingKeyFlag,
+	ArchiveDestFlag,
+	ArchiveRetentionFlag,
+	ArchiveEndpointFlag,
+	ArchiveRegionFlag,
+	ArchiveAccessKeyIDFlag,
+	ArchiveSecretAccessKeyFlag,
 }
 
 func init() {
@@ -174,6 +296,8 @@ func init() {
 	optionalFlags = append(optionalFlags, txmgr.CLIFlagsWithDefaults(envVarPrefix, txmgr.DefaultChallengerFlagValues)...)
 	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(envVarPrefix)...)
 	optionalFlags = append(optionalFlags, oppprof.CLIFlags(envVarPrefix)...)
+	optionalFlags = append(optionalFlags, optracing.CLIFlags(envVarPrefix)...)
+	optionalFlags = append(optionalFlags, oprpc.CLIFlags(envVarPrefix)...)
 
 	Flags = append(requiredFlags, optionalFlags...)
 }
@@ -224,12 +348,18 @@ func CheckRequired(ctx *cli.Context, traceTypes []config.TraceType) error {
 				return fmt.Errorf("flag %s is required", "alphabet")
 			}
 		case config.TraceTypeOutputCannon:
+			if !ctx.Bool(ExperimentalOutputCannonFlag.Name) {
+				return fmt.Errorf("flag %s is required, the %s trace type is experimental", ExperimentalOutputCannonFlag.Name, config.TraceTypeOutputCannon)
+			}
 			if err := CheckCannonFlags(ctx); err != nil {
 				return err
 			}
 			if !ctx.IsSet(RollupRpcFlag.Name) {
 				return fmt.Errorf("flag %s is required", RollupRpcFlag.Name)
 			}
+			if !ctx.IsSet(OutputSplitDepthFlag.Name) {
+				return fmt.Errorf("flag %s is required", OutputSplitDepthFlag.Name)
+			}
 		default:
 			return fmt.Errorf("invalid trace type. must be one of %v", config.TraceTypes)
 		}
@@ -251,6 +381,30 @@ func parseTraceTypes(ctx *cli.Context) ([]config.TraceType, error) {
 	return traceTypes, nil
 }
 
+// parseStrategies parses the strategies of the trace types, each given as <trace-type>=<strategy>.
+func parseStrategies(ctx *cli.Context) (map[config.TraceType]config.Strategy, error) {
+	var strategies map[config.TraceType]config.Strategy
+	for _, value := range ctx.StringSlice(StrategyFlag.Name) {
+		typeName, strategyName, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid strategy %q, expected <trace-type>=<strategy>", value)
+		}
+		var traceType config.TraceType
+		if err := traceType.Set(typeName); err != nil {
+			return nil, err
+		}
+		var strategy config.Strategy
+		if err := strategy.Set(strategyName); err != nil {
+			return nil, err
+		}
+		if strategies == nil {
+			strategies = make(map[config.TraceType]config.Strategy)
+		}
+		strategies[traceType] = strategy
+	}
+	return strategies, nil
+}
+
 // NewConfigFromCLI parses the Config from the provided flags or environment variables.
 func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 	traceTypes, err := parseTraceTypes(ctx)
@@ -264,6 +418,14 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	var mode config.Mode
+	if err := mode.Set(ctx.String(ModeFlag.Name)); err != nil {
+		return nil, err
+	}
+	strategies, err := parseStrategies(ctx)
+	if err != nil {
+		return nil, err
+	}
 	var allowedGames []common.Address
 	if ctx.StringSlice(GameAllowlistFlag.Name) != nil {
 		for _, addr := range ctx.StringSlice(GameAllowlistFlag.Name) {
@@ -278,6 +440,8 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 	txMgrConfig := txmgr.ReadCLIConfig(ctx)
 	metricsConfig := opmetrics.ReadCLIConfig(ctx)
 	pprofConfig := oppprof.ReadCLIConfig(ctx)
+	tracingConfig := optracing.ReadCLIConfig(ctx)
+	rpcConfig := oprpc.ReadCLIConfig(ctx)
 
 	maxConcurrency := ctx.Uint(MaxConcurrencyFlag.Name)
 	if maxConcurrency == 0 {
@@ -285,28 +449,49 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 	}
 	return &config.Config{
 		// Required Flags
-		L1EthRpc:                ctx.String(L1EthRpcFlag.Name),
-		TraceTypes:              traceTypes,
-		GameFactoryAddress:      gameFactoryAddress,
-		GameAllowlist:           allowedGames,
-		GameWindow:              ctx.Duration(GameWindowFlag.Name),
-		MaxConcurrency:          maxConcurrency,
-		PollInterval:            ctx.Duration(HTTPPollInterval.Name),
-		RollupRpc:               ctx.String(RollupRpcFlag.Name),
-		AlphabetTrace:           ctx.String(AlphabetFlag.Name),
-		CannonNetwork:           ctx.String(CannonNetworkFlag.Name),
-		CannonRollupConfigPath:  ctx.String(CannonRollupConfigFlag.Name),
-		CannonL2GenesisPath:     ctx.String(CannonL2GenesisFlag.Name),
-		CannonBin:               ctx.String(CannonBinFlag.Name),
-		CannonServer:            ctx.String(CannonServerFlag.Name),
-		CannonAbsolutePreState:  ctx.String(CannonPreStateFlag.Name),
-		Datadir:                 ctx.String(DatadirFlag.Name),
-		CannonL2:                ctx.String(CannonL2Flag.Name),
-		CannonSnapshotFreq:      ctx.Uint(CannonSnapshotFreqFlag.Name),
-		CannonInfoFreq:          ctx.Uint(CannonInfoFreqFlag.Name),
-		AgreeWithProposedOutput: ctx.Bool(AgreeWithProposedOutputFlag.Name),
-		TxMgrConfig:             txMgrConfig,
-		MetricsConfig:           metricsConfig,
-		PprofConfig:             pprofConfig,
+		L1EthRpc:                  ctx.String(L1EthRpcFlag.Name),
+		TraceTypes:                traceTypes,
+		Mode:                      mode,
+		Strategies:                strategies,
+		GameFactoryAddress:        gameFactoryAddress,
+		GameAllowlist:             allowedGames,
+		GameWindow:                ctx.Duration(GameWindowFlag.Name),
+		MaxConcurrency:            maxConcurrency,
+		PollInterval:              ctx.Duration(HTTPPollInterval.Name),
+		RollupRpc:                 ctx.String(RollupRpcFlag.Name),
+		OutputSplitDepth:          ctx.Uint64(OutputSplitDepthFlag.Name),
+		ExperimentalOutputCannon:  ctx.Bool(ExperimentalOutputCannonFlag.Name),
+		AlphabetTrace:             ctx.String(AlphabetFlag.Name),
+		CannonNetwork:             ctx.String(CannonNetworkFlag.Name),
+		CannonRollupConfigPath:    ctx.String(CannonRollupConfigFlag.Name),
+		CannonL2GenesisPath:       ctx.String(CannonL2GenesisFlag.Name),
+		CannonBin:                 ctx.String(CannonBinFlag.Name),
+		CannonServer:              ctx.String(CannonServerFlag.Name),
+		CannonAbsolutePreState:    ctx.String(CannonPreStateFlag.Name),
+		Datadir:                   ctx.String(DatadirFlag.Name),
+		CannonL2:                  ctx.String(CannonL2Flag.Name),
+		CannonSnapshotFreq:        ctx.Uint(CannonSnapshotFreqFlag.Name),
+		CannonInfoFreq:            ctx.Uint(CannonInfoFreqFlag.Name),
+		CannonPrefetch:            ctx.Bool(CannonPrefetchFlag.Name),
+		CannonProofCacheSize:      ctx.Uint64(CannonProofCacheSizeFlag.Name),
+		CannonMaxParallel:         ctx.Uint(CannonMaxParallelFlag.Name),
+		CannonMemoryQuota:         ctx.Uint64(CannonMemoryQuotaFlag.Name),
+		CannonDiskQuota:           ctx.Uint64(CannonDiskQuotaFlag.Name),
+		AgreeWithProposedOutput:   ctx.Bool(AgreeWithProposedOutputFlag.Name),
+		TxMgrConfig:               txMgrConfig,
+		MetricsConfig:             metricsConfig,
+		PprofConfig:               pprofConfig,
+		TracingConfig:             tracingConfig,
+		RPCEnabled:                ctx.Bool(RPCEnabledFlag.Name),
+		NotifyWebhookURL:          ctx.String(NotifyWebhookURLFlag.Name),
+		NotifySlackWebhookURL:     ctx.String(NotifySlackWebhookURLFlag.Name),
+		NotifyPagerDutyRoutingKey: ctx.String(NotifyPagerDutyRoutingKeyFlag.Name),
+		ArchiveDest:               ctx.String(ArchiveDestFlag.Name),
+		ArchiveRetention:          ctx.Duration(ArchiveRetentionFlag.Name),
+		ArchiveEndpoint:           ctx.String(ArchiveEndpointFlag.Name),
+		ArchiveRegion:             ctx.String(ArchiveRegionFlag.Name),
+		ArchiveAccessKeyID:        ctx.String(ArchiveAccessKeyIDFlag.Name),
+		ArchiveSecretAccessKey:    ctx.String(ArchiveSecretAccessKeyFlag.Name),
+		RPCConfig:                 rpcConfig,
 	}, nil
 }
@@ -7,11 +7,16 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/game/store"
 	"github.com/ethereum/go-ethereum/common"
 	"golang.org/x/exp/slices"
 )
 
-const gameDirPrefix = "game-"
+const (
+	gameDirPrefix = "game-"
+	// proofCacheDir is the directory of the cannon proofs that are shared between games.
+	proofCacheDir = "proof-cache"
+)
 
 // diskManager coordinates the storage of game data on disk.
 type diskManager struct {
@@ -53,3 +58,13 @@ func (d *diskManager) RemoveAllExcept(keep []common.Address) error {
 	}
 	return errors.Join(errs...)
 }
+
+// persistentDiskManager also removes the persisted state of the games that are no longer played.
+type persistentDiskManager struct {
+	*diskManager
+	store *store.Store
+}
+
+func (d *persistentDiskManager) RemoveAllExcept(keep []common.Address) error {
+	return errors.Join(d.diskManager.RemoveAllExcept(keep), d.store.RemoveAllExcept(keep))
+}
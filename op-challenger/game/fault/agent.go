@@ -5,13 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/solver"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-challenger/notify"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
+	"golang.org/x/exp/slices"
 )
 
 // Responder takes a response action & executes.
@@ -28,27 +32,72 @@ type ClaimLoader interface {
 	FetchClaims(ctx context.Context) ([]types.Claim, error)
 }
 
+// PendingActions records the actions that are being sent, so that the actions that are in flight
+// when the challenger restarts are not sent again.
+type PendingActions interface {
+	IsPending(action types.Action) (bool, error)
+	Record(action types.Action) error
+	Complete(action types.Action) error
+}
+
+// noPendingActions does not record any actions, so no action is ever pending.
+type noPendingActions struct{}
+
+func (noPendingActions) IsPending(action types.Action) (bool, error) { return false, nil }
+func (noPendingActions) Record(action types.Action) error            { return nil }
+func (noPendingActions) Complete(action types.Action) error          { return nil }
+
 type Agent struct {
-	metrics                 metrics.Metricer
-	solver                  *solver.GameSolver
+	metrics                 metrics.GameMetricer
+	clock                   clock.Clock
+	strategy                solver.Strategy
+	trace                   types.TraceAccessor
 	loader                  ClaimLoader
 	responder               Responder
 	updater                 types.OracleUpdater
+	pending                 PendingActions
+	notifier                notify.Notifier
 	maxDepth                int
+	gameDuration            time.Duration
 	agreeWithProposedOutput bool
 	log                     log.Logger
+
+	// observed holds the time that each claim was first seen by the agent, by contract index,
+	// to record the time until the agent countered the claim.
+	observed map[int]time.Time
+
+	// plannedLock guards planned, the actions that were calculated the last time the agent acted.
+	plannedLock sync.Mutex
+	planned     []types.Action
 }
 
-func NewAgent(m metrics.Metricer, loader ClaimLoader, maxDepth int, trace types.TraceProvider, responder Responder, updater types.OracleUpdater, agreeWithProposedOutput bool, log log.Logger) *Agent {
+// NewAgent creates the agent that plays a game with the strategy, or with the honest actor strategy if it is nil.
+// The game duration is the total time on the chess clocks of both teams.
+func NewAgent(m metrics.GameMetricer, loader ClaimLoader, maxDepth int, gameDuration time.Duration, trace types.TraceAccessor, strategy solver.Strategy, responder Responder, updater types.OracleUpdater, pending PendingActions, notifier notify.Notifier, agreeWithProposedOutput bool, log log.Logger) *Agent {
+	if pending == nil {
+		pending = noPendingActions{}
+	}
+	if notifier == nil {
+		notifier = notify.NoopNotifier
+	}
+	if strategy == nil {
+		strategy = solver.NewGameSolver(maxDepth, trace)
+	}
 	return &Agent{
 		metrics:                 m,
-		solver:                  solver.NewGameSolver(maxDepth, trace),
+		clock:                   clock.SystemClock,
+		strategy:                strategy,
+		trace:                   trace,
 		loader:                  loader,
 		responder:               responder,
 		updater:                 updater,
+		pending:                 pending,
+		notifier:                notifier,
 		maxDepth:                maxDepth,
+		gameDuration:            gameDuration,
 		agreeWithProposedOutput: agreeWithProposedOutput,
 		log:                     log,
+		observed:                make(map[int]time.Time),
 	}
 }
 
@@ -61,15 +110,28 @@ func (a *Agent) Act(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("create game from contracts: %w", err)
 	}
+	a.notifyCounteredClaims(ctx, game)
+	a.observeClaims(game)
 
 	// Calculate the actions to take
-	actions, err := a.solver.CalculateNextActions(ctx, game)
+	actions, err := a.strategy.CalculateNextActions(ctx, game)
 	if err != nil {
 		log.Error("Failed to calculate all required moves", "err", err)
 	}
+	a.recordClockRemaining(game, actions)
+	a.plannedLock.Lock()
+	a.planned = actions
+	a.plannedLock.Unlock()
 
 	// Perform the actions
 	for _, action := range actions {
+		if action.Type == types.ActionTypeMove && action.ParentIdx == 0 {
+			a.notifier.Notify(ctx, notify.Event{
+				Type:       notify.EventInvalidRootClaim,
+				ClaimIndex: 0,
+				Message:    "Countering the root claim, which disagrees with the trace",
+			})
+		}
 		log := a.log.New("action", action.Type, "is_attack", action.IsAttack, "parent", action.ParentIdx)
 		if action.Type == types.ActionTypeStep {
 			log = log.New("prestate", common.Bytes2Hex(action.PreState), "proof", common.Bytes2Hex(action.ProofData))
@@ -77,6 +139,13 @@ func (a *Agent) Act(ctx context.Context) error {
 			log = log.New("value", action.Value)
 		}
 
+		if pending, err := a.pending.IsPending(action); err != nil {
+			log.Error("Failed to check if action is pending", "err", err)
+		} else if pending {
+			log.Info("Skipping action that is still in flight")
+			continue
+		}
+
 		if action.OracleData != nil {
 			a.log.Info("Updating oracle data", "oracleKey", action.OracleData.OracleKey, "oracleData", action.OracleData.OracleData)
 			if err := a.updater.UpdateOracle(ctx, action.OracleData); err != nil {
@@ -91,14 +160,115 @@ func (a *Agent) Act(ctx context.Context) error {
 			a.metrics.RecordGameStep()
 		}
 		log.Info("Performing action")
+		if err := a.pending.Record(action); err != nil {
+			log.Error("Failed to record pending action", "err", err)
+		}
 		err := a.responder.PerformAction(ctx, action)
 		if err != nil {
 			log.Error("Action failed", "err", err)
+		} else if observed, ok := a.observed[action.ParentIdx]; ok {
+			a.metrics.RecordResponseLatency(a.clock.Now().Sub(observed).Seconds())
+		}
+		if err := a.pending.Complete(action); err != nil {
+			log.Error("Failed to complete pending action", "err", err)
 		}
 	}
 	return nil
 }
 
+// notifyCounteredClaims notifies of the claims at the max depth that the agent agrees with, but were countered by a
+// step. The step proves that the claim is invalid, so the trace that the agent plays the game with must be wrong.
+func (a *Agent) notifyCounteredClaims(ctx context.Context, game types.Game) {
+	for _, claim := range game.Claims() {
+		if !claim.Countered || claim.Depth() != a.maxDepth || !game.AgreeWithClaimLevel(claim) {
+			continue
+		}
+		value, err := a.trace.Get(ctx, game, claim, claim.Position)
+		if err != nil {
+			a.log.Warn("Failed to check if countered claim agrees with the trace", "claimIdx", claim.ContractIndex, "err", err)
+			continue
+		}
+		if value != claim.Value {
+			continue
+		}
+		a.notifier.Notify(ctx, notify.Event{
+			Type:       notify.EventClaimCountered,
+			ClaimIndex: claim.ContractIndex,
+			Message:    "Claim at max depth that agrees with the trace was countered by a step",
+		})
+	}
+}
+
+// observeClaims records the time that new claims were first seen, and the depth of the deepest claim.
+func (a *Agent) observeClaims(game types.Game) {
+	now := a.clock.Now()
+	maxDepth := 0
+	for _, claim := range game.Claims() {
+		if _, ok := a.observed[claim.ContractIndex]; !ok {
+			a.observed[claim.ContractIndex] = now
+		}
+		if claim.Depth() > maxDepth {
+			maxDepth = claim.Depth()
+		}
+	}
+	a.metrics.RecordMaxDepth(maxDepth)
+}
+
+// recordClockRemaining records the least time left on the chess clock of the agent to counter the claims that the
+// actions respond to. Nothing is recorded if there are no actions.
+//
+// A claim can only be countered while the duration of the clock of its parent, plus the time since the claim was
+// made, does not exceed half of the game duration.
+func (a *Agent) recordClockRemaining(game types.Game, actions []types.Action) {
+	claims := game.Claims()
+	now := a.clock.Now()
+	var remaining time.Duration
+	recorded := false
+	for _, action := range actions {
+		if action.ParentIdx < 0 || action.ParentIdx >= len(claims) {
+			continue
+		}
+		claim := claims[action.ParentIdx]
+		used := now.Sub(time.Unix(int64(claim.Clock), 0))
+		if !claim.IsRoot() && claim.ParentContractIndex < len(claims) {
+			used += time.Duration(claims[claim.ParentContractIndex].Duration) * time.Second
+		}
+		left := a.gameDuration/2 - used
+		if left < 0 {
+			left = 0
+		}
+		if !recorded || left < remaining {
+			remaining = left
+			recorded = true
+		}
+	}
+	if recorded {
+		a.metrics.RecordClockRemaining(remaining.Seconds())
+	}
+}
+
+// PlannedActions returns the actions that were calculated the last time the agent acted,
+// including the actions that were skipped because they were still pending.
+func (a *Agent) PlannedActions() []types.Action {
+	a.plannedLock.Lock()
+	defer a.plannedLock.Unlock()
+	return slices.Clone(a.planned)
+}
+
+// Resolve resolves the claims and the game, even if the game will be lost.
+// Returns an error if the game cannot be resolved yet.
+func (a *Agent) Resolve(ctx context.Context) error {
+	if err := a.resolveClaims(ctx); err != nil {
+		return fmt.Errorf("failed to resolve claims: %w", err)
+	}
+	status, err := a.responder.CallResolve(ctx)
+	if err != nil {
+		return fmt.Errorf("game is not resolvable: %w", err)
+	}
+	a.log.Info("Resolving game on request", "status", status)
+	return a.responder.Resolve(ctx)
+}
+
 // shouldResolve returns true if the agent should resolve the game.
 // This method will return false if the game is still in progress.
 func (a *Agent) shouldResolve(status gameTypes.GameStatus) bool {
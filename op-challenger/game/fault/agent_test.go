@@ -3,17 +3,23 @@ package fault
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/test"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/alphabet"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-challenger/notify"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 )
 
@@ -108,14 +114,213 @@ func TestLoadClaimsWhenGameNotResolvable(t *testing.T) {
 	require.Zero(t, responder.resolveClaimCount, "should not send resolveClaim")
 }
 
+func TestSkipPendingActions(t *testing.T) {
+	for _, isPending := range []bool{true, false} {
+		isPending := isPending
+		t.Run(fmt.Sprintf("Pending_%v", isPending), func(t *testing.T) {
+			agent, claimLoader, responder := setupTestAgent(t, true)
+			pending := &stubPendingActions{isPending: isPending}
+			agent.pending = pending
+			responder.callResolveErr = errors.New("game is not resolvable")
+			responder.callResolveClaimErr = errors.New("claim is not resolvable")
+			depth := 4
+			claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcd", uint64(depth)))
+			// the agent is on the other side of the incorrect root claim, so attacks it
+			claimLoader.claims = []types.Claim{
+				claimBuilder.CreateRootClaim(false),
+			}
+
+			require.NoError(t, agent.Act(context.Background()))
+			require.Len(t, agent.PlannedActions(), 1, "should report planned action, even if pending")
+
+			if isPending {
+				require.Zero(t, responder.performActionCount, "should not send pending action again")
+				require.Zero(t, pending.recorded)
+			} else {
+				require.Equal(t, 1, responder.performActionCount, "should send action")
+				require.Equal(t, 1, pending.recorded, "should record action before sending it")
+				require.Equal(t, 1, pending.completed, "should complete action after sending it")
+			}
+		})
+	}
+}
+
+func TestResolveLosingGame(t *testing.T) {
+	agent, _, responder := setupTestAgent(t, false)
+	responder.callResolveStatus = gameTypes.GameStatusChallengerWon
+
+	require.NoError(t, agent.Resolve(context.Background()))
+	require.Equal(t, 1, responder.resolveCount, "should resolve game even if it is lost")
+}
+
+func TestResolveUnresolvableGame(t *testing.T) {
+	agent, _, responder := setupTestAgent(t, false)
+	responder.callResolveErr = errors.New("game is not resolvable")
+
+	require.ErrorIs(t, agent.Resolve(context.Background()), responder.callResolveErr)
+	require.Zero(t, responder.resolveCount, "should not resolve game")
+}
+
+func TestNotifyInvalidRootClaim(t *testing.T) {
+	agent, claimLoader, responder := setupTestAgent(t, true)
+	notifier := &stubNotifier{}
+	agent.notifier = notifier
+	responder.callResolveErr = errors.New("game is not resolvable")
+	responder.callResolveClaimErr = errors.New("claim is not resolvable")
+	depth := 4
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcd", uint64(depth)))
+	claimLoader.claims = []types.Claim{
+		claimBuilder.CreateRootClaim(false),
+	}
+
+	require.NoError(t, agent.Act(context.Background()))
+	require.Len(t, notifier.events, 1)
+	require.Equal(t, notify.EventInvalidRootClaim, notifier.events[0].Type)
+	require.Equal(t, 0, notifier.events[0].ClaimIndex)
+}
+
+func TestNotifyCounteredClaimAtMaxDepth(t *testing.T) {
+	agent, claimLoader, responder := setupTestAgent(t, false)
+	notifier := &stubNotifier{}
+	agent.notifier = notifier
+	responder.callResolveErr = errors.New("game is not resolvable")
+	responder.callResolveClaimErr = errors.New("claim is not resolvable")
+	depth := 4
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcd", uint64(depth)))
+	root := claimBuilder.CreateRootClaim(true)
+	claim1 := claimBuilder.AttackClaim(root, false)
+	claim1.ContractIndex = 1
+	claim2 := claimBuilder.AttackClaim(claim1, true)
+	claim2.ContractIndex = 2
+	claim3 := claimBuilder.AttackClaim(claim2, false)
+	claim3.ContractIndex = 3
+	// The agent agrees with claim4, so it must not have been countered by a valid step
+	claim4 := claimBuilder.AttackClaim(claim3, true)
+	claim4.ContractIndex = 4
+	claim4.Countered = true
+	// The agent disagrees with claim5, so it is expected to be countered
+	claim5 := claimBuilder.DefendClaim(claim3, false)
+	claim5.ContractIndex = 5
+	claim5.Countered = true
+	claimLoader.claims = []types.Claim{root, claim1, claim2, claim3, claim4, claim5}
+
+	require.NoError(t, agent.Act(context.Background()))
+	require.Len(t, notifier.events, 1)
+	require.Equal(t, notify.EventClaimCountered, notifier.events[0].Type)
+	require.Equal(t, 4, notifier.events[0].ClaimIndex)
+}
+
+func TestRecordGameMetrics(t *testing.T) {
+	setup := func(t *testing.T, agreeWithProposedOutput bool, claims func(*test.ClaimBuilder) []types.Claim) (*Agent, *stubGameMetrics, *clock.DeterministicClock, *stubPendingActions) {
+		agent, claimLoader, responder := setupTestAgent(t, agreeWithProposedOutput)
+		m := &stubGameMetrics{GameMetricer: agent.metrics}
+		agent.metrics = m
+		clk := clock.NewDeterministicClock(time.Unix(1000, 0))
+		agent.clock = clk
+		agent.gameDuration = 1000 * time.Second
+		pending := &stubPendingActions{isPending: true}
+		agent.pending = pending
+		responder.callResolveErr = errors.New("game is not resolvable")
+		responder.callResolveClaimErr = errors.New("claim is not resolvable")
+		depth := 4
+		claimLoader.claims = claims(test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcd", uint64(depth))))
+		return agent, m, clk, pending
+	}
+
+	t.Run("CounterRootClaim", func(t *testing.T) {
+		agent, m, clk, pending := setup(t, true, func(builder *test.ClaimBuilder) []types.Claim {
+			root := builder.CreateRootClaim(false)
+			root.Clock = 900
+			return []types.Claim{root}
+		})
+
+		// the action is still pending, so the claim is observed but not countered yet
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 0, m.maxDepth)
+		require.Equal(t, []float64{400}, m.clockRemaining)
+		require.Empty(t, m.latencies)
+
+		clk.AdvanceTime(30 * time.Second)
+		pending.isPending = false
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, []float64{400, 370}, m.clockRemaining)
+		require.Equal(t, []float64{30}, m.latencies)
+	})
+
+	t.Run("CounterClaimWithGrandparentDuration", func(t *testing.T) {
+		agent, m, _, _ := setup(t, false, func(builder *test.ClaimBuilder) []types.Claim {
+			root := builder.CreateRootClaim(true)
+			root.Duration = 50
+			claim1 := builder.AttackClaim(root, false)
+			claim1.ContractIndex = 1
+			claim1.Clock = 950
+			return []types.Claim{root, claim1}
+		})
+
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, 1, m.maxDepth)
+		require.Equal(t, []float64{400}, m.clockRemaining)
+	})
+
+	t.Run("ClockExpired", func(t *testing.T) {
+		agent, m, _, _ := setup(t, true, func(builder *test.ClaimBuilder) []types.Claim {
+			root := builder.CreateRootClaim(false)
+			root.Clock = 100
+			return []types.Claim{root}
+		})
+
+		require.NoError(t, agent.Act(context.Background()))
+		require.Equal(t, []float64{0}, m.clockRemaining)
+	})
+}
+
+type stubGameMetrics struct {
+	metrics.GameMetricer
+	latencies      []float64
+	maxDepth       int
+	clockRemaining []float64
+}
+
+func (s *stubGameMetrics) RecordResponseLatency(t float64) {
+	s.latencies = append(s.latencies, t)
+}
+
+func (s *stubGameMetrics) RecordMaxDepth(depth int) {
+	s.maxDepth = depth
+}
+
+func (s *stubGameMetrics) RecordClockRemaining(t float64) {
+	s.clockRemaining = append(s.clockRemaining, t)
+}
+
+type stubPendingActions struct {
+	isPending bool
+	recorded  int
+	completed int
+}
+
+func (s *stubPendingActions) IsPending(action types.Action) (bool, error) {
+	return s.isPending, nil
+}
+
+func (s *stubPendingActions) Record(action types.Action) error {
+	s.recorded++
+	return nil
+}
+
+func (s *stubPendingActions) Complete(action types.Action) error {
+	s.completed++
+	return nil
+}
+
 func setupTestAgent(t *testing.T, agreeWithProposedOutput bool) (*Agent, *stubClaimLoader, *stubResponder) {
 	logger := testlog.Logger(t, log.LvlInfo)
 	claimLoader := &stubClaimLoader{}
 	depth := 4
-	trace := alphabet.NewTraceProvider("abcd", uint64(depth))
+	provider := alphabet.NewTraceProvider("abcd", uint64(depth))
 	responder := &stubResponder{}
 	updater := &stubUpdater{}
-	agent := NewAgent(metrics.NoopMetrics, claimLoader, depth, trace, responder, updater, agreeWithProposedOutput, logger)
+	agent := NewAgent(metrics.ForGame(metrics.NoopMetrics, common.Address{}), claimLoader, depth, 7*24*time.Hour, trace.NewSimpleTraceAccessor(provider), nil, responder, updater, nil, nil, agreeWithProposedOutput, logger)
 	return agent, claimLoader, responder
 }
 
@@ -140,6 +345,8 @@ type stubResponder struct {
 	callResolveClaimCount int
 	callResolveClaimErr   error
 	resolveClaimCount     int
+
+	performActionCount int
 }
 
 func (s *stubResponder) CallResolve(ctx context.Context) (gameTypes.GameStatus, error) {
@@ -163,6 +370,7 @@ func (s *stubResponder) ResolveClaim(ctx context.Context, clainIdx uint64) error
 }
 
 func (s *stubResponder) PerformAction(ctx context.Context, response types.Action) error {
+	s.performActionCount++
 	return nil
 }
 
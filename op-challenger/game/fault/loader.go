@@ -25,6 +25,7 @@ type MinimalFaultDisputeGameCaller interface {
 	Status(opts *bind.CallOpts) (uint8, error)
 	ClaimDataLen(opts *bind.CallOpts) (*big.Int, error)
 	MAXGAMEDEPTH(opts *bind.CallOpts) (*big.Int, error)
+	GAMEDURATION(opts *bind.CallOpts) (uint64, error)
 	ABSOLUTEPRESTATE(opts *bind.CallOpts) ([32]byte, error)
 }
 
@@ -78,6 +79,11 @@ func (l *loader) FetchGameDepth(ctx context.Context) (uint64, error) {
 	return gameDepth.Uint64(), nil
 }
 
+// FetchGameDuration fetches the duration of the game in seconds, of which each team has half on its chess clock.
+func (l *loader) FetchGameDuration(ctx context.Context) (uint64, error) {
+	return l.caller.GAMEDURATION(&bind.CallOpts{Context: ctx})
+}
+
 // fetchClaim fetches a single [Claim] with a hydrated parent.
 func (l *loader) fetchClaim(ctx context.Context, arrIndex uint64) (types.Claim, error) {
 	callOpts := bind.CallOpts{
@@ -96,6 +102,7 @@ func (l *loader) fetchClaim(ctx context.Context, arrIndex uint64) (types.Claim,
 		},
 		Countered:           fetchedClaim.Countered,
 		Clock:               fetchedClaim.Clock.Uint64(),
+		Duration:            new(big.Int).Rsh(fetchedClaim.Clock, 64).Uint64(),
 		ContractIndex:       int(arrIndex),
 		ParentContractIndex: int(fetchedClaim.ParentIndex),
 	}
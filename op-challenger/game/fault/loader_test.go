@@ -18,6 +18,7 @@ var (
 	mockClaimDataError    = fmt.Errorf("claim data errored")
 	mockClaimLenError     = fmt.Errorf("claim len errored")
 	mockMaxGameDepthError = fmt.Errorf("max game depth errored")
+	mockGameDurationError = fmt.Errorf("game duration errored")
 	mockPrestateError     = fmt.Errorf("prestate errored")
 	mockStatusError       = fmt.Errorf("status errored")
 )
@@ -85,6 +86,26 @@ func TestLoader_FetchGameDepth(t *testing.T) {
 	})
 }
 
+// TestLoader_FetchGameDuration tests fetching the game duration.
+func TestLoader_FetchGameDuration(t *testing.T) {
+	t.Run("Succeeds", func(t *testing.T) {
+		mockCaller := newMockCaller()
+		mockCaller.gameDuration = 7 * 24 * 60 * 60
+		loader := NewLoader(mockCaller)
+		duration, err := loader.FetchGameDuration(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, uint64(7*24*60*60), duration)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		mockCaller := newMockCaller()
+		mockCaller.durationError = true
+		loader := NewLoader(mockCaller)
+		_, err := loader.FetchGameDuration(context.Background())
+		require.ErrorIs(t, err, mockGameDurationError)
+	})
+}
+
 // TestLoader_FetchAbsolutePrestateHash tests fetching the absolute prestate hash.
 func TestLoader_FetchAbsolutePrestateHash(t *testing.T) {
 	t.Run("Succeeds", func(t *testing.T) {
@@ -139,7 +160,8 @@ func TestLoader_FetchClaims(t *testing.T) {
 					Position: types.NewPositionFromGIndex(expectedClaims[2].Position),
 				},
 				Countered:           false,
-				Clock:               uint64(0),
+				Clock:               uint64(100),
+				Duration:            uint64(5),
 				ContractIndex:       2,
 				ParentContractIndex: 1,
 			},
@@ -169,9 +191,11 @@ type mockCaller struct {
 	claimDataError    bool
 	claimLenError     bool
 	maxGameDepthError bool
+	durationError     bool
 	prestateError     bool
 	statusError       bool
 	maxGameDepth      uint64
+	gameDuration      uint64
 	currentIndex      uint64
 	status            uint8
 	returnClaims      []struct {
@@ -209,7 +233,7 @@ func newMockCaller() *mockCaller {
 				Claim:       [32]byte{0x02},
 				Position:    big.NewInt(3),
 				Countered:   false,
-				Clock:       big.NewInt(0),
+				Clock:       new(big.Int).Add(new(big.Int).Lsh(big.NewInt(5), 64), big.NewInt(100)),
 				ParentIndex: 1,
 			},
 		},
@@ -258,6 +282,13 @@ func (m *mockCaller) MAXGAMEDEPTH(opts *bind.CallOpts) (*big.Int, error) {
 	return big.NewInt(int64(m.maxGameDepth)), nil
 }
 
+func (m *mockCaller) GAMEDURATION(opts *bind.CallOpts) (uint64, error) {
+	if m.durationError {
+		return 0, mockGameDurationError
+	}
+	return m.gameDuration, nil
+}
+
 func (m *mockCaller) ABSOLUTEPRESTATE(opts *bind.CallOpts) ([32]byte, error) {
 	if m.prestateError {
 		return [32]byte{}, mockPrestateError
@@ -3,20 +3,31 @@ package fault
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/responder"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/solver"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/store"
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-challenger/notify"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
+const tracerName = "github.com/ethereum-optimism/optimism/op-challenger/game/fault"
+
 type actor func(ctx context.Context) error
 
 type GameInfo interface {
@@ -24,15 +35,45 @@ type GameInfo interface {
 	GetClaimCount(context.Context) (uint64, error)
 }
 
+// GameStore persists the state of the games that are played, see store.Store.
+type GameStore interface {
+	RecordGame(game store.GameRecord) error
+	PendingActions(addr common.Address) *store.PendingActions
+}
+
+// GameArchiver archives the data of resolved games, see archive.Archiver.
+type GameArchiver interface {
+	Archive(ctx context.Context, game common.Address, status gameTypes.GameStatus, agreeWithProposedOutput bool, claims []types.Claim, dir string) error
+}
+
+// executionStatusReporter is implemented by the trace accessors and prestate providers that execute cannon to generate the trace.
+type executionStatusReporter interface {
+	ExecutionStatus() cannon.ExecutionStatus
+}
+
+var errGameResolved = errors.New("game is already resolved")
+
 type GamePlayer struct {
 	act                     actor
 	agreeWithProposedOutput bool
 	loader                  GameInfo
+	claims                  ClaimLoader
+	agent                   *Agent
+	accessor                types.TraceAccessor
+	prestate                types.PrestateProvider
 	logger                  log.Logger
 	status                  gameTypes.GameStatus
+	addr                    common.Address
+	gameStore               GameStore
+	notifier                notify.GameNotifier
+	archiver                GameArchiver
+	metrics                 metrics.GameMetricer
+	dir                     string
+	// archivePending is true when the game resolved while it was played, until it is archived
+	archivePending bool
 }
 
-type resourceCreator func(addr common.Address, gameDepth uint64, dir string) (types.TraceProvider, types.OracleUpdater, error)
+type resourceCreator func(addr common.Address, gameDepth uint64, dir string) (types.TraceAccessor, types.PrestateProvider, types.OracleUpdater, error)
 
 func NewGamePlayer(
 	ctx context.Context,
@@ -41,11 +82,20 @@ func NewGamePlayer(
 	cfg *config.Config,
 	dir string,
 	addr common.Address,
+	traceType config.TraceType,
 	txMgr txmgr.TxManager,
 	client bind.ContractCaller,
 	creator resourceCreator,
+	gameStore GameStore,
+	notifier notify.Notifier,
+	archiver GameArchiver,
 ) (*GamePlayer, error) {
 	logger = logger.New("game", addr)
+	if notifier == nil {
+		notifier = notify.NoopNotifier
+	}
+	gameNotifier := notify.ForGame(notifier, addr)
+	gameMetrics := metrics.ForGame(m, addr)
 	contract, err := bindings.NewFaultDisputeGameCaller(addr, client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to bind the fault dispute game contract: %w", err)
@@ -60,29 +110,41 @@ func NewGamePlayer(
 	if status != gameTypes.GameStatusInProgress {
 		logger.Info("Game already resolved", "status", status)
 		// Game is already complete so skip creating the trace provider, loading game inputs etc.
-		return &GamePlayer{
+		g := &GamePlayer{
 			logger:                  logger,
 			loader:                  loader,
+			claims:                  loader,
 			agreeWithProposedOutput: cfg.AgreeWithProposedOutput,
 			status:                  status,
+			addr:                    addr,
+			gameStore:               gameStore,
+			notifier:                gameNotifier,
+			archiver:                archiver,
+			dir:                     dir,
 			// Act function does nothing because the game is already complete
 			act: func(ctx context.Context) error {
 				return nil
 			},
-		}, nil
+		}
+		g.recordStatus(status)
+		return g, nil
 	}
 
 	gameDepth, err := loader.FetchGameDepth(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch the game depth: %w", err)
 	}
+	gameDuration, err := loader.FetchGameDuration(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the game duration: %w", err)
+	}
 
-	provider, updater, err := creator(addr, gameDepth, dir)
+	accessor, prestate, updater, err := creator(addr, gameDepth, dir)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create trace provider: %w", err)
 	}
 
-	if err := ValidateAbsolutePrestate(ctx, provider, loader); err != nil {
+	if err := ValidateAbsolutePrestate(ctx, prestate, loader); err != nil {
 		return nil, fmt.Errorf("failed to validate absolute prestate: %w", err)
 	}
 
@@ -91,13 +153,52 @@ func NewGamePlayer(
 		return nil, fmt.Errorf("failed to create the responder: %w", err)
 	}
 
-	return &GamePlayer{
-		act:                     NewAgent(m, loader, int(gameDepth), provider, responder, updater, cfg.AgreeWithProposedOutput, logger).Act,
+	var agentResponder Responder = responder
+	if cfg.Mode == config.ModeShadow {
+		// Evaluate the game as usual, but only log the transactions that would be sent
+		agentResponder = newShadowResponder(logger, responder)
+		updater = &shadowOracleUpdater{log: logger}
+	}
+
+	var pending PendingActions
+	if gameStore != nil {
+		pending = gameStore.PendingActions(addr)
+	}
+	strategy, err := newStrategy(cfg.StrategyFor(traceType), int(gameDepth), accessor)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Playing game", "strategy", cfg.StrategyFor(traceType))
+	agent := NewAgent(gameMetrics, loader, int(gameDepth), time.Duration(gameDuration)*time.Second, accessor, strategy, agentResponder, updater, pending, gameNotifier, cfg.AgreeWithProposedOutput, logger)
+	g := &GamePlayer{
+		act:                     agent.Act,
 		agreeWithProposedOutput: cfg.AgreeWithProposedOutput,
 		loader:                  loader,
+		claims:                  loader,
+		agent:                   agent,
+		metrics:                 gameMetrics,
+		accessor:                accessor,
+		prestate:                prestate,
 		logger:                  logger,
 		status:                  status,
-	}, nil
+		addr:                    addr,
+		gameStore:               gameStore,
+		notifier:                gameNotifier,
+		archiver:                archiver,
+		dir:                     dir,
+	}
+	g.recordStatus(status)
+	return g, nil
+}
+
+// recordStatus records the status of the game in the game store, if there is one.
+func (g *GamePlayer) recordStatus(status gameTypes.GameStatus) {
+	if g.gameStore == nil {
+		return
+	}
+	if err := g.gameStore.RecordGame(store.GameRecord{Proxy: g.addr, Status: status}); err != nil {
+		g.logger.Warn("Failed to record game status", "err", err)
+	}
 }
 
 func (g *GamePlayer) Status() gameTypes.GameStatus {
@@ -105,14 +206,22 @@ func (g *GamePlayer) Status() gameTypes.GameStatus {
 }
 
 func (g *GamePlayer) ProgressGame(ctx context.Context) gameTypes.GameStatus {
+	ctx, span := tracing.Tracer(tracerName).Start(ctx, "challenger.progress_game",
+		oteltrace.WithAttributes(attribute.String("game", g.addr.Hex())))
+	var actErr error
+	defer func() {
+		span.SetAttributes(attribute.String("status", g.status.String()))
+		tracing.EndSpan(span, actErr)
+	}()
 	if g.status != gameTypes.GameStatusInProgress {
 		// Game is already complete so don't try to perform further actions.
 		g.logger.Trace("Skipping completed game")
+		g.archive(ctx)
 		return g.status
 	}
 	g.logger.Trace("Checking if actions are required")
-	if err := g.act(ctx); err != nil {
-		g.logger.Error("Error when acting on game", "err", err)
+	if actErr = g.act(ctx); actErr != nil {
+		g.logger.Error("Error when acting on game", "err", actErr)
 	}
 	status, err := g.loader.GetGameStatus(ctx)
 	if err != nil {
@@ -120,10 +229,75 @@ func (g *GamePlayer) ProgressGame(ctx context.Context) gameTypes.GameStatus {
 		return gameTypes.GameStatusInProgress
 	}
 	g.logGameStatus(ctx, status)
+	if status != g.status {
+		g.recordStatus(status)
+		g.archivePending = status != gameTypes.GameStatusInProgress && g.archiver != nil
+		if status != gameTypes.GameStatusInProgress && g.metrics != nil {
+			g.metrics.Forget()
+		}
+	}
 	g.status = status
+	g.archive(ctx)
 	return status
 }
 
+// archive archives the game if it resolved while it was played, and was not archived yet.
+// Failures are retried the next time the game is progressed.
+func (g *GamePlayer) archive(ctx context.Context) {
+	if !g.archivePending {
+		return
+	}
+	claims, err := g.claims.FetchClaims(ctx)
+	if err != nil {
+		g.logger.Warn("Failed to fetch claims to archive game", "err", err)
+		return
+	}
+	if err := g.archiver.Archive(ctx, g.addr, g.status, g.agreeWithProposedOutput, claims, g.dir); err != nil {
+		g.logger.Error("Failed to archive game", "err", err)
+		return
+	}
+	g.archivePending = false
+}
+
+// ArchivePending returns true if the game resolved while it was played, and was not archived yet.
+func (g *GamePlayer) ArchivePending() bool {
+	return g.archivePending
+}
+
+// Claims returns the current claims of the game.
+func (g *GamePlayer) Claims(ctx context.Context) ([]types.Claim, error) {
+	return g.claims.FetchClaims(ctx)
+}
+
+// PlannedActions returns the actions that were calculated the last time the game was progressed.
+// Returns nil if the game was already resolved when the player was created.
+func (g *GamePlayer) PlannedActions() []types.Action {
+	if g.agent == nil {
+		return nil
+	}
+	return g.agent.PlannedActions()
+}
+
+// Resolve resolves the game, even if the game will be lost.
+func (g *GamePlayer) Resolve(ctx context.Context) error {
+	if g.agent == nil {
+		return errGameResolved
+	}
+	return g.agent.Resolve(ctx)
+}
+
+// CannonStatus returns the status of the executions of cannon of the game.
+// Returns false if the game is not played with a trace provider that executes cannon.
+func (g *GamePlayer) CannonStatus() (cannon.ExecutionStatus, bool) {
+	if reporter, ok := g.accessor.(executionStatusReporter); ok {
+		return reporter.ExecutionStatus(), true
+	}
+	if reporter, ok := g.prestate.(executionStatusReporter); ok {
+		return reporter.ExecutionStatus(), true
+	}
+	return cannon.ExecutionStatus{}, false
+}
+
 func (g *GamePlayer) logGameStatus(ctx context.Context, status gameTypes.GameStatus) {
 	if status == gameTypes.GameStatusInProgress {
 		claimCount, err := g.loader.GetClaimCount(ctx)
@@ -134,6 +308,7 @@ func (g *GamePlayer) logGameStatus(ctx context.Context, status gameTypes.GameSta
 		g.logger.Info("Game info", "claims", claimCount, "status", status)
 		return
 	}
+	g.notifier.Resolved()
 	var expectedStatus gameTypes.GameStatus
 	if g.agreeWithProposedOutput {
 		expectedStatus = gameTypes.GameStatusChallengerWon
@@ -144,6 +319,24 @@ func (g *GamePlayer) logGameStatus(ctx context.Context, status gameTypes.GameSta
 		g.logger.Info("Game won", "status", status)
 	} else {
 		g.logger.Error("Game lost", "status", status)
+		g.notifier.Notify(ctx, notify.Event{
+			Type:    notify.EventGameLost,
+			Message: fmt.Sprintf("Game resolved with status %v, expected %v", status, expectedStatus),
+		})
+	}
+}
+
+// newStrategy creates the strategy with the name that the game is played with.
+func newStrategy(name config.Strategy, gameDepth int, accessor types.TraceAccessor) (solver.Strategy, error) {
+	switch name {
+	case config.StrategyHonest:
+		return solver.NewGameSolver(gameDepth, accessor), nil
+	case config.StrategyFreeloaderCounter:
+		return solver.NewFreeloaderCounterSolver(gameDepth, accessor), nil
+	case config.StrategyDefensive:
+		return solver.NewDefensiveSolver(gameDepth, accessor), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", config.ErrUnknownStrategy, name)
 	}
 }
 
@@ -152,7 +345,7 @@ type PrestateLoader interface {
 }
 
 // ValidateAbsolutePrestate validates the absolute prestate of the fault game.
-func ValidateAbsolutePrestate(ctx context.Context, trace types.TraceProvider, loader PrestateLoader) error {
+func ValidateAbsolutePrestate(ctx context.Context, trace types.PrestateProvider, loader PrestateLoader) error {
 	providerPrestateHash, err := trace.AbsolutePreStateCommitment(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get the trace provider's absolute prestate: %w", err)
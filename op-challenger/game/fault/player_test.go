@@ -7,8 +7,11 @@ import (
 	"testing"
 
 	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	gameTypes "github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/notify"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -44,6 +47,7 @@ func TestProgressGame_LogGameStatus(t *testing.T) {
 		agreeWithOutput bool
 		logLevel        log.Lvl
 		logMsg          string
+		notified        bool
 	}{
 		{
 			name:            "GameLostAsDefender",
@@ -51,6 +55,7 @@ func TestProgressGame_LogGameStatus(t *testing.T) {
 			agreeWithOutput: false,
 			logLevel:        log.LvlError,
 			logMsg:          "Game lost",
+			notified:        true,
 		},
 		{
 			name:            "GameLostAsChallenger",
@@ -58,6 +63,7 @@ func TestProgressGame_LogGameStatus(t *testing.T) {
 			agreeWithOutput: true,
 			logLevel:        log.LvlError,
 			logMsg:          "Game lost",
+			notified:        true,
 		},
 		{
 			name:            "GameWonAsDefender",
@@ -85,6 +91,8 @@ func TestProgressGame_LogGameStatus(t *testing.T) {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
 			handler, game, gameState := setupProgressGameTest(t, test.agreeWithOutput)
+			notifier := &stubNotifier{}
+			game.notifier = notifier
 			gameState.status = test.status
 
 			status := game.ProgressGame(context.Background())
@@ -93,6 +101,13 @@ func TestProgressGame_LogGameStatus(t *testing.T) {
 			errLog := handler.FindLog(test.logLevel, test.logMsg)
 			require.NotNil(t, errLog, "should log game result")
 			require.Equal(t, test.status, errLog.GetContextValue("status"))
+			if test.notified {
+				require.Len(t, notifier.events, 1)
+				require.Equal(t, notify.EventGameLost, notifier.events[0].Type)
+			} else {
+				require.Empty(t, notifier.events)
+			}
+			require.Equal(t, test.status != gameTypes.GameStatusInProgress, notifier.resolved)
 		})
 	}
 }
@@ -115,6 +130,36 @@ func TestDoNotActOnCompleteGame(t *testing.T) {
 	}
 }
 
+func TestArchiveResolvedGame(t *testing.T) {
+	_, game, gameState := setupProgressGameTest(t, true)
+	archiver := &stubArchiver{err: errors.New("boom")}
+	game.archiver = archiver
+	game.addr = common.Address{0xaa}
+	game.dir = "/game/dir"
+	game.claims = gameState
+	gameState.claims = []types.Claim{{ClaimData: types.ClaimData{Value: common.Hash{0x01}}}}
+
+	game.ProgressGame(context.Background())
+	require.Empty(t, archiver.archived, "should not archive game in progress")
+
+	gameState.status = gameTypes.GameStatusChallengerWon
+	game.ProgressGame(context.Background())
+	require.Len(t, archiver.archived, 1)
+
+	// Retries the failed archival
+	archiver.err = nil
+	game.ProgressGame(context.Background())
+	require.Len(t, archiver.archived, 2)
+	archived := archiver.archived[1]
+	require.Equal(t, common.Address{0xaa}, archived.game)
+	require.Equal(t, gameTypes.GameStatusChallengerWon, archived.status)
+	require.Equal(t, gameState.claims, archived.claims)
+	require.Equal(t, "/game/dir", archived.dir)
+
+	game.ProgressGame(context.Background())
+	require.Len(t, archiver.archived, 2, "should only archive once")
+}
+
 // TestValidateAbsolutePrestate tests that the absolute prestate is validated
 // correctly by the service component.
 func TestValidateAbsolutePrestate(t *testing.T) {
@@ -152,6 +197,18 @@ func TestValidateAbsolutePrestate(t *testing.T) {
 	})
 }
 
+func TestNewStrategy(t *testing.T) {
+	accessor := trace.NewSimpleTraceAccessor(newMockTraceProvider(false, nil))
+	for _, name := range config.Strategies {
+		strategy, err := newStrategy(name, 4, accessor)
+		require.NoError(t, err, name)
+		require.NotNil(t, strategy, name)
+	}
+
+	_, err := newStrategy("foo", 4, accessor)
+	require.ErrorIs(t, err, config.ErrUnknownStrategy)
+}
+
 func setupProgressGameTest(t *testing.T, agreeWithProposedRoot bool) (*testlog.CapturingHandler, *GamePlayer, *stubGameState) {
 	logger := testlog.Logger(t, log.LvlDebug)
 	handler := &testlog.CapturingHandler{
@@ -164,12 +221,44 @@ func setupProgressGameTest(t *testing.T, agreeWithProposedRoot bool) (*testlog.C
 		agreeWithProposedOutput: agreeWithProposedRoot,
 		loader:                  gameState,
 		logger:                  logger,
+		notifier:                notify.ForGame(notify.NoopNotifier, common.Address{}),
 	}
 	return handler, game, gameState
 }
 
+type stubNotifier struct {
+	events   []notify.Event
+	resolved bool
+}
+
+func (s *stubNotifier) Notify(ctx context.Context, event notify.Event) {
+	s.events = append(s.events, event)
+}
+
+func (s *stubNotifier) Resolved() {
+	s.resolved = true
+}
+
+type archivedGame struct {
+	game   common.Address
+	status gameTypes.GameStatus
+	claims []types.Claim
+	dir    string
+}
+
+type stubArchiver struct {
+	archived []archivedGame
+	err      error
+}
+
+func (s *stubArchiver) Archive(_ context.Context, game common.Address, status gameTypes.GameStatus, _ bool, claims []types.Claim, dir string) error {
+	s.archived = append(s.archived, archivedGame{game: game, status: status, claims: claims, dir: dir})
+	return s.err
+}
+
 type stubGameState struct {
 	status     gameTypes.GameStatus
+	claims     []types.Claim
 	claimCount uint64
 	callCount  int
 	actErr     error
@@ -185,6 +274,10 @@ func (s *stubGameState) GetGameStatus(ctx context.Context) (gameTypes.GameStatus
 	return s.status, nil
 }
 
+func (s *stubGameState) FetchClaims(ctx context.Context) ([]types.Claim, error) {
+	return s.claims, nil
+}
+
 func (s *stubGameState) GetClaimCount(ctx context.Context) (uint64, error) {
 	return s.claimCount, nil
 }
@@ -3,14 +3,20 @@ package fault
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/alphabet"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/outputs"
 	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-challenger/notify"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -18,14 +24,139 @@ import (
 )
 
 var (
-	cannonGameType   = uint8(0)
-	alphabetGameType = uint8(255)
+	cannonGameType       = uint8(config.CannonFaultGameID)
+	outputCannonGameType = uint8(config.OutputCannonFaultGameID)
+	alphabetGameType     = uint8(config.AlphabetFaultGameID)
 )
 
+// gameTraceTypes maps the game types to the trace type that the strategy to play them with is configured for.
+// Game types that are not listed are played with the honest actor strategy.
+var gameTraceTypes = map[uint8]config.TraceType{
+	cannonGameType:       config.TraceTypeCannon,
+	outputCannonGameType: config.TraceTypeOutputCannon,
+	alphabetGameType:     config.TraceTypeAlphabet,
+}
+
 type Registry interface {
 	RegisterGameType(gameType uint8, creator scheduler.PlayerCreator)
 }
 
+// Resources are the resources of the challenger that trace providers are created with.
+type Resources struct {
+	Logger  log.Logger
+	Metrics metrics.Metricer
+	Config  *config.Config
+	TxMgr   txmgr.TxManager
+	Client  bind.ContractCaller
+	// Cannon are the resources that the cannon trace providers of all games share.
+	Cannon cannon.Shared
+}
+
+// TraceProviderFactory creates the trace accessor, the provider of the absolute prestate and the oracle updater of
+// the game at addr, using dir to persist data of the game.
+// Games that are played with a single trace provider use trace.NewSimpleTraceAccessor to access it.
+type TraceProviderFactory func(ctx context.Context, res Resources, addr common.Address, gameDepth uint64, dir string) (faultTypes.TraceAccessor, faultTypes.PrestateProvider, faultTypes.OracleUpdater, error)
+
+// TraceProviderRegistry holds the trace provider factory of every game type that the challenger plays.
+type TraceProviderRegistry struct {
+	factories map[uint8]TraceProviderFactory
+}
+
+func NewTraceProviderRegistry() *TraceProviderRegistry {
+	return &TraceProviderRegistry{
+		factories: make(map[uint8]TraceProviderFactory),
+	}
+}
+
+// RegisterTraceProvider registers the TraceProviderFactory to use for a specific game type.
+// Panics if the same game type is registered multiple times, since this indicates a significant programmer error.
+func (r *TraceProviderRegistry) RegisterTraceProvider(gameType uint8, factory TraceProviderFactory) {
+	if _, ok := r.factories[gameType]; ok {
+		panic(fmt.Errorf("duplicate trace provider registered for game type: %v", gameType))
+	}
+	r.factories[gameType] = factory
+}
+
+// GameTypes returns the registered game types, in ascending order.
+func (r *TraceProviderRegistry) GameTypes() []uint8 {
+	gameTypes := make([]uint8, 0, len(r.factories))
+	for gameType := range r.factories {
+		gameTypes = append(gameTypes, gameType)
+	}
+	sort.Slice(gameTypes, func(i, j int) bool {
+		return gameTypes[i] < gameTypes[j]
+	})
+	return gameTypes
+}
+
+// RegisterTraceProviders registers the trace providers of the trace types that are enabled in the config.
+func RegisterTraceProviders(providers *TraceProviderRegistry, cfg *config.Config) {
+	if cfg.TraceTypeEnabled(config.TraceTypeCannon) {
+		providers.RegisterTraceProvider(cannonGameType, newCannonTraceProvider)
+	}
+	if cfg.TraceTypeEnabled(config.TraceTypeOutputCannon) {
+		providers.RegisterTraceProvider(outputCannonGameType, newOutputCannonTraceProvider)
+	}
+	if cfg.TraceTypeEnabled(config.TraceTypeAlphabet) {
+		providers.RegisterTraceProvider(alphabetGameType, newAlphabetTraceProvider)
+	}
+}
+
+func newCannonTraceProvider(ctx context.Context, res Resources, addr common.Address, gameDepth uint64, dir string) (faultTypes.TraceAccessor, faultTypes.PrestateProvider, faultTypes.OracleUpdater, error) {
+	provider, err := cannon.NewTraceProvider(ctx, res.Logger, res.Metrics, res.Config, res.Client, res.Cannon, dir, addr, gameDepth)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("create cannon trace provider: %w", err)
+	}
+	updater, err := cannon.NewOracleUpdater(ctx, res.Logger, res.TxMgr, addr, res.Client)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create the cannon updater: %w", err)
+	}
+	return trace.NewSimpleTraceAccessor(provider), provider, updater, nil
+}
+
+// newOutputCannonTraceProvider creates the trace accessor of games that bisect the output roots of the L2 blocks
+// between the starting and the disputed output of the game down to the split depth, and then bisect the cannon
+// execution trace of a single block.
+func newOutputCannonTraceProvider(ctx context.Context, res Resources, addr common.Address, gameDepth uint64, dir string) (faultTypes.TraceAccessor, faultTypes.PrestateProvider, faultTypes.OracleUpdater, error) {
+	contract, err := bindings.NewFaultDisputeGameCaller(addr, res.Client)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("create caller for game %v: %w", addr, err)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	l1Head, err := contract.L1Head(opts)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fetch L1 head of game %v: %w", addr, err)
+	}
+	proposals, err := contract.Proposals(opts)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fetch proposals of game %v: %w", addr, err)
+	}
+	var deadline time.Time
+	if res.Cannon.Executions != nil {
+		deadline, err = cannon.FetchGameDeadline(ctx, contract)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("fetch deadline of game %v: %w", addr, err)
+		}
+	}
+	accessor, err := outputs.NewOutputCannonTraceAccessor(ctx, res.Logger, res.Metrics, res.Config, res.Cannon, deadline, l1Head, dir, gameDepth,
+		res.Config.OutputSplitDepth, proposals.Starting.L2BlockNumber.Uint64(), proposals.Disputed.L2BlockNumber.Uint64())
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("create output cannon trace accessor: %w", err)
+	}
+	updater, err := cannon.NewOracleUpdater(ctx, res.Logger, res.TxMgr, addr, res.Client)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create the cannon updater: %w", err)
+	}
+	return accessor, cannon.NewPrestateProvider(res.Config.CannonAbsolutePreState), updater, nil
+}
+
+func newAlphabetTraceProvider(ctx context.Context, res Resources, addr common.Address, gameDepth uint64, dir string) (faultTypes.TraceAccessor, faultTypes.PrestateProvider, faultTypes.OracleUpdater, error) {
+	provider := alphabet.NewTraceProvider(res.Config.AlphabetTrace, gameDepth)
+	updater := alphabet.NewOracleUpdater(res.Logger)
+	return trace.NewSimpleTraceAccessor(provider), provider, updater, nil
+}
+
+// RegisterGameTypes registers a game player for every game type that has a trace provider.
 func RegisterGameTypes(
 	registry Registry,
 	ctx context.Context,
@@ -34,33 +165,31 @@ func RegisterGameTypes(
 	cfg *config.Config,
 	txMgr txmgr.TxManager,
 	client bind.ContractCaller,
+	providers *TraceProviderRegistry,
+	gameStore GameStore,
+	cannonShared cannon.Shared,
+	notifier notify.Notifier,
+	archiver GameArchiver,
 ) {
-	if cfg.TraceTypeEnabled(config.TraceTypeCannon) {
-		resourceCreator := func(addr common.Address, gameDepth uint64, dir string) (faultTypes.TraceProvider, faultTypes.OracleUpdater, error) {
-			provider, err := cannon.NewTraceProvider(ctx, logger, m, cfg, client, dir, addr, gameDepth)
-			if err != nil {
-				return nil, nil, fmt.Errorf("create cannon trace provider: %w", err)
-			}
-			updater, err := cannon.NewOracleUpdater(ctx, logger, txMgr, addr, client)
-			if err != nil {
-				return nil, nil, fmt.Errorf("failed to create the cannon updater: %w", err)
-			}
-			return provider, updater, nil
-		}
-		playerCreator := func(game types.GameMetadata, dir string) (scheduler.GamePlayer, error) {
-			return NewGamePlayer(ctx, logger, m, cfg, dir, game.Proxy, txMgr, client, resourceCreator)
-		}
-		registry.RegisterGameType(cannonGameType, playerCreator)
+	res := Resources{
+		Logger:  logger,
+		Metrics: m,
+		Config:  cfg,
+		TxMgr:   txMgr,
+		Client:  client,
+		Cannon:  cannonShared,
 	}
-	if cfg.TraceTypeEnabled(config.TraceTypeAlphabet) {
-		resourceCreator := func(addr common.Address, gameDepth uint64, dir string) (faultTypes.TraceProvider, faultTypes.OracleUpdater, error) {
-			provider := alphabet.NewTraceProvider(cfg.AlphabetTrace, gameDepth)
-			updater := alphabet.NewOracleUpdater(logger)
-			return provider, updater, nil
+	for _, gameType := range providers.GameTypes() {
+		factory := providers.factories[gameType]
+		traceType := gameTraceTypes[gameType]
+		resourceCreator := func(addr common.Address, gameDepth uint64, dir string) (faultTypes.TraceAccessor, faultTypes.PrestateProvider, faultTypes.OracleUpdater, error) {
+			gameRes := res
+			gameRes.Metrics = metrics.ForGame(m, addr)
+			return factory(ctx, gameRes, addr, gameDepth, dir)
 		}
 		playerCreator := func(game types.GameMetadata, dir string) (scheduler.GamePlayer, error) {
-			return NewGamePlayer(ctx, logger, m, cfg, dir, game.Proxy, txMgr, client, resourceCreator)
+			return NewGamePlayer(ctx, logger, m, cfg, dir, game.Proxy, traceType, txMgr, client, resourceCreator, gameStore, notifier, archiver)
 		}
-		registry.RegisterGameType(alphabetGameType, playerCreator)
+		registry.RegisterGameType(gameType, playerCreator)
 	}
 }
@@ -0,0 +1,52 @@
+package fault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func stubTraceProvider(ctx context.Context, res Resources, addr common.Address, gameDepth uint64, dir string) (faultTypes.TraceAccessor, faultTypes.PrestateProvider, faultTypes.OracleUpdater, error) {
+	return nil, nil, nil, nil
+}
+
+func TestRegisterTraceProviders(t *testing.T) {
+	cfg := &config.Config{TraceTypes: []config.TraceType{config.TraceTypeAlphabet, config.TraceTypeCannon, config.TraceTypeOutputCannon}}
+	providers := NewTraceProviderRegistry()
+	RegisterTraceProviders(providers, cfg)
+	providers.RegisterTraceProvider(3, stubTraceProvider)
+	require.Equal(t, []uint8{cannonGameType, 3, outputCannonGameType, alphabetGameType}, providers.GameTypes())
+}
+
+func TestPanicsOnDuplicateTraceProvider(t *testing.T) {
+	providers := NewTraceProviderRegistry()
+	providers.RegisterTraceProvider(0, stubTraceProvider)
+	require.Panics(t, func() {
+		providers.RegisterTraceProvider(0, stubTraceProvider)
+	})
+}
+
+func TestRegisterGameTypes(t *testing.T) {
+	providers := NewTraceProviderRegistry()
+	providers.RegisterTraceProvider(3, stubTraceProvider)
+	providers.RegisterTraceProvider(7, stubTraceProvider)
+	registry := &stubRegistry{creators: make(map[uint8]scheduler.PlayerCreator)}
+	RegisterGameTypes(registry, context.Background(), nil, nil, &config.Config{}, nil, nil, providers, nil, cannon.Shared{}, nil, nil)
+	require.Len(t, registry.creators, 2)
+	require.Contains(t, registry.creators, uint8(3))
+	require.Contains(t, registry.creators, uint8(7))
+}
+
+type stubRegistry struct {
+	creators map[uint8]scheduler.PlayerCreator
+}
+
+func (r *stubRegistry) RegisterGameType(gameType uint8, creator scheduler.PlayerCreator) {
+	r.creators[gameType] = creator
+}
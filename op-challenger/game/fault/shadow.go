@@ -0,0 +1,81 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var errShadowResolvedClaim = errors.New("claim was already resolved in shadow mode")
+
+// shadowResponder evaluates the game with the read-only calls of the responder it wraps, but never sends
+// transactions. The transactions that would be sent are only logged.
+type shadowResponder struct {
+	Responder
+	log log.Logger
+
+	// resolvedLock guards resolved, the claims that would have been resolved. They are reported as not resolvable,
+	// otherwise the agent would keep trying to resolve them, since they are never resolved onchain.
+	resolvedLock sync.Mutex
+	resolved     map[uint64]bool
+}
+
+func newShadowResponder(logger log.Logger, responder Responder) *shadowResponder {
+	return &shadowResponder{
+		Responder: responder,
+		log:       logger,
+		resolved:  make(map[uint64]bool),
+	}
+}
+
+func (r *shadowResponder) Resolve(ctx context.Context) error {
+	r.log.Info("Shadow mode: not resolving game")
+	return nil
+}
+
+func (r *shadowResponder) CallResolveClaim(ctx context.Context, claimIdx uint64) error {
+	r.resolvedLock.Lock()
+	resolved := r.resolved[claimIdx]
+	r.resolvedLock.Unlock()
+	if resolved {
+		return errShadowResolvedClaim
+	}
+	return r.Responder.CallResolveClaim(ctx, claimIdx)
+}
+
+func (r *shadowResponder) ResolveClaim(ctx context.Context, claimIdx uint64) error {
+	r.resolvedLock.Lock()
+	r.resolved[claimIdx] = true
+	r.resolvedLock.Unlock()
+	r.log.Info("Shadow mode: not resolving claim", "claimIdx", claimIdx)
+	return nil
+}
+
+func (r *shadowResponder) PerformAction(ctx context.Context, action types.Action) error {
+	log := r.log.New("action", action.Type, "is_attack", action.IsAttack, "parent", action.ParentIdx)
+	if action.Type == types.ActionTypeStep {
+		log = log.New("prestate", common.Bytes2Hex(action.PreState), "proof", common.Bytes2Hex(action.ProofData))
+	} else {
+		log = log.New("value", action.Value)
+	}
+	if action.Type == types.ActionTypeMove && action.ParentIdx == 0 {
+		log.Warn("Shadow mode: not sending action, the root claim is invalid")
+	} else {
+		log.Info("Shadow mode: not sending action")
+	}
+	return nil
+}
+
+// shadowOracleUpdater never sends the preimages to the oracle, it only logs them.
+type shadowOracleUpdater struct {
+	log log.Logger
+}
+
+func (u *shadowOracleUpdater) UpdateOracle(ctx context.Context, data *types.PreimageOracleData) error {
+	u.log.Info("Shadow mode: not updating oracle", "oracleKey", data.OracleKey)
+	return nil
+}
@@ -0,0 +1,65 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/test"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShadowResponder(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+
+	t.Run("DoNotSendTransactions", func(t *testing.T) {
+		delegate := &stubResponder{}
+		responder := newShadowResponder(logger, delegate)
+		require.NoError(t, responder.PerformAction(context.Background(), types.Action{Type: types.ActionTypeMove}))
+		require.NoError(t, responder.Resolve(context.Background()))
+		require.NoError(t, responder.ResolveClaim(context.Background(), 1))
+		require.Zero(t, delegate.performActionCount)
+		require.Zero(t, delegate.resolveCount)
+		require.Zero(t, delegate.resolveClaimCount)
+	})
+
+	t.Run("CheckResolutionWithDelegate", func(t *testing.T) {
+		delegate := &stubResponder{callResolveErr: errors.New("not resolvable")}
+		responder := newShadowResponder(logger, delegate)
+		_, err := responder.CallResolve(context.Background())
+		require.ErrorIs(t, err, delegate.callResolveErr)
+		require.NoError(t, responder.CallResolveClaim(context.Background(), 1))
+		require.Equal(t, 1, delegate.callResolveCount)
+		require.Equal(t, 1, delegate.callResolveClaimCount)
+	})
+
+	t.Run("ClaimNotResolvableAfterShadowResolve", func(t *testing.T) {
+		delegate := &stubResponder{}
+		responder := newShadowResponder(logger, delegate)
+		require.NoError(t, responder.ResolveClaim(context.Background(), 1))
+		require.ErrorIs(t, responder.CallResolveClaim(context.Background(), 1), errShadowResolvedClaim)
+		require.NoError(t, responder.CallResolveClaim(context.Background(), 2))
+	})
+}
+
+func TestAgentInShadowMode(t *testing.T) {
+	agent, claimLoader, delegate := setupTestAgent(t, true)
+	agent.responder = newShadowResponder(testlog.Logger(t, log.LvlInfo), delegate)
+	agent.updater = &shadowOracleUpdater{log: testlog.Logger(t, log.LvlInfo)}
+	delegate.callResolveErr = errors.New("game is not resolvable")
+	depth := 4
+	claimBuilder := test.NewClaimBuilder(t, depth, alphabet.NewTraceProvider("abcd", uint64(depth)))
+	claimLoader.claims = []types.Claim{
+		claimBuilder.CreateRootClaim(false),
+	}
+
+	// The claim stays resolvable onchain, so this would not return if the shadow resolved claim was resolved again
+	require.NoError(t, agent.Act(context.Background()))
+	require.Len(t, agent.PlannedActions(), 1, "should plan to counter the root claim")
+	require.Zero(t, delegate.performActionCount, "should not send action")
+	require.Zero(t, delegate.resolveClaimCount, "should not resolve claim")
+}
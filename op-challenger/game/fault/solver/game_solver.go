@@ -8,16 +8,34 @@ import (
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 )
 
+// Strategy calculates the actions to take in a game.
+type Strategy interface {
+	CalculateNextActions(ctx context.Context, game types.Game) ([]types.Action, error)
+}
+
+// GameSolver is the honest actor strategy. It counters the claims that disagree with the trace, as long as the claims
+// they respond to are on the path of valid claims from the root claim.
 type GameSolver struct {
 	claimSolver *claimSolver
 }
 
-func NewGameSolver(gameDepth int, trace types.TraceProvider) *GameSolver {
+func NewGameSolver(gameDepth int, trace types.TraceAccessor) *GameSolver {
 	return &GameSolver{
 		claimSolver: newClaimSolver(gameDepth, trace),
 	}
 }
 
+// NewFreeloaderCounterSolver creates a GameSolver that also counters the claims that disagree with the trace below
+// invalid claims. The honest actor leaves these freeloading claims uncountered, since the invalid claims they respond
+// to are countered anyway.
+func NewFreeloaderCounterSolver(gameDepth int, trace types.TraceAccessor) *GameSolver {
+	claimSolver := newClaimSolver(gameDepth, trace)
+	claimSolver.counterFreeloaders = true
+	return &GameSolver{
+		claimSolver: claimSolver,
+	}
+}
+
 func (s *GameSolver) CalculateNextActions(ctx context.Context, game types.Game) ([]types.Action, error) {
 	var errs []error
 	var actions []types.Action
@@ -0,0 +1,36 @@
+package solver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+)
+
+// DefensiveSolver only plays games whose root claim agrees with the trace, as the honest actor. It defends valid
+// outputs, but never disputes an output.
+type DefensiveSolver struct {
+	*GameSolver
+}
+
+func NewDefensiveSolver(gameDepth int, trace types.TraceAccessor) *DefensiveSolver {
+	return &DefensiveSolver{
+		GameSolver: NewGameSolver(gameDepth, trace),
+	}
+}
+
+func (s *DefensiveSolver) CalculateNextActions(ctx context.Context, game types.Game) ([]types.Action, error) {
+	claims := game.Claims()
+	if len(claims) == 0 {
+		return nil, nil
+	}
+	root := claims[0]
+	agree, err := s.claimSolver.agreeWithClaim(ctx, game, root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check root claim: %w", err)
+	}
+	if !agree {
+		return nil, nil
+	}
+	return s.GameSolver.CalculateNextActions(ctx, game)
+}
@@ -0,0 +1,83 @@
+package solver
+
+import (
+	"context"
+	"testing"
+
+	faulttest "github.com/ethereum-optimism/optimism/op-challenger/game/fault/test"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrategies(t *testing.T) {
+	maxDepth := 4
+	claimBuilder := faulttest.NewAlphabetClaimBuilder(t, maxDepth)
+	accessor := trace.NewSimpleTraceAccessor(claimBuilder.CorrectTraceProvider())
+
+	tests := []struct {
+		name                string
+		strategy            Strategy
+		agreeWithOutputRoot bool
+		rootClaimCorrect    bool
+		setupGame           func(builder *faulttest.GameBuilder)
+	}{
+		{
+			name:                "FreeloaderCounter_CounterFreeloader",
+			strategy:            NewFreeloaderCounterSolver(maxDepth, accessor),
+			agreeWithOutputRoot: true,
+			setupGame: func(builder *faulttest.GameBuilder) {
+				builder.Seq().ExpectAttack()
+				// An invalid claim on our level, with an invalid claim that freeloads on it
+				builder.Seq().Attack(common.Hash{0xaa}).Attack(common.Hash{0xbb}).ExpectAttack()
+			},
+		},
+		{
+			name:                "Honest_IgnoreFreeloader",
+			strategy:            NewGameSolver(maxDepth, accessor),
+			agreeWithOutputRoot: true,
+			setupGame: func(builder *faulttest.GameBuilder) {
+				builder.Seq().ExpectAttack()
+				builder.Seq().Attack(common.Hash{0xaa}).Attack(common.Hash{0xbb})
+			},
+		},
+		{
+			name:                "Defensive_DefendValidRootClaim",
+			strategy:            NewDefensiveSolver(maxDepth, accessor),
+			agreeWithOutputRoot: false,
+			rootClaimCorrect:    true,
+			setupGame: func(builder *faulttest.GameBuilder) {
+				builder.Seq().Attack(common.Hash{0xaa}).ExpectAttack()
+			},
+		},
+		{
+			name:                "Defensive_DoNotDisputeInvalidRootClaim",
+			strategy:            NewDefensiveSolver(maxDepth, accessor),
+			agreeWithOutputRoot: true,
+			setupGame: func(builder *faulttest.GameBuilder) {
+				builder.Seq().Attack(common.Hash{0xaa})
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			builder := claimBuilder.GameBuilder(test.agreeWithOutputRoot, test.rootClaimCorrect)
+			test.setupGame(builder)
+			game := builder.Game
+
+			actions, err := test.strategy.CalculateNextActions(context.Background(), game)
+			require.NoError(t, err)
+			for _, action := range actions {
+				require.NoError(t, checkRules(game, action), "Attempting to perform invalid action")
+			}
+			expected := builder.ExpectedActions
+			if expected == nil {
+				expected = []types.Action{}
+			}
+			require.ElementsMatch(t, expected, actions)
+		})
+	}
+}
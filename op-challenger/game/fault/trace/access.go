@@ -0,0 +1,48 @@
+package trace
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ProviderSelector selects the [types.TraceProvider] that provides the trace at pos, when evaluated for the ref claim.
+// It returns the position to request from the selected provider, which may be relative to the part of the game
+// that the provider is used in.
+type ProviderSelector func(ctx context.Context, game types.Game, ref types.Claim, pos types.Position) (types.Position, types.TraceProvider, error)
+
+var _ types.TraceAccessor = (*Accessor)(nil)
+
+// Accessor is a [types.TraceAccessor] that routes the requests to the [types.TraceProvider] of the [ProviderSelector].
+type Accessor struct {
+	selector ProviderSelector
+}
+
+func NewAccessor(selector ProviderSelector) *Accessor {
+	return &Accessor{selector}
+}
+
+// NewSimpleTraceAccessor creates a [types.TraceAccessor] that plays the whole game with the same trace provider.
+func NewSimpleTraceAccessor(trace types.TraceProvider) *Accessor {
+	selector := func(_ context.Context, _ types.Game, _ types.Claim, pos types.Position) (types.Position, types.TraceProvider, error) {
+		return pos, trace, nil
+	}
+	return NewAccessor(selector)
+}
+
+func (t *Accessor) Get(ctx context.Context, game types.Game, ref types.Claim, pos types.Position) (common.Hash, error) {
+	pos, provider, err := t.selector(ctx, game, ref, pos)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return provider.Get(ctx, pos)
+}
+
+func (t *Accessor) GetStepData(ctx context.Context, game types.Game, ref types.Claim, pos types.Position) (prestate []byte, proofData []byte, preimageData *types.PreimageOracleData, err error) {
+	pos, provider, err := t.selector(ctx, game, ref, pos)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return provider.GetStepData(ctx, pos)
+}
@@ -0,0 +1,55 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimpleTraceAccessor(t *testing.T) {
+	provider := alphabet.NewTraceProvider("abcd", 2)
+	accessor := NewSimpleTraceAccessor(provider)
+	pos := types.NewPosition(2, big.NewInt(1))
+
+	expected, err := provider.Get(context.Background(), pos)
+	require.NoError(t, err)
+	actual, err := accessor.Get(context.Background(), nil, types.Claim{}, pos)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	expectedPre, expectedProof, _, err := provider.GetStepData(context.Background(), pos)
+	require.NoError(t, err)
+	actualPre, actualProof, _, err := accessor.GetStepData(context.Background(), nil, types.Claim{}, pos)
+	require.NoError(t, err)
+	require.Equal(t, expectedPre, actualPre)
+	require.Equal(t, expectedProof, actualProof)
+}
+
+func TestAccessorSelectsProvider(t *testing.T) {
+	provider := alphabet.NewTraceProvider("abcd", 2)
+	ref := types.Claim{ClaimData: types.ClaimData{Value: common.Hash{0x01}, Position: types.NewPosition(1, common.Big0)}}
+	accessor := NewAccessor(func(ctx context.Context, game types.Game, r types.Claim, pos types.Position) (types.Position, types.TraceProvider, error) {
+		require.Equal(t, ref, r)
+		return types.NewPosition(2, big.NewInt(3)), provider, nil
+	})
+	expected, err := provider.Get(context.Background(), types.NewPosition(2, big.NewInt(3)))
+	require.NoError(t, err)
+	actual, err := accessor.Get(context.Background(), nil, ref, types.NewPosition(5, common.Big0))
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	selectErr := errors.New("boom")
+	accessor = NewAccessor(func(ctx context.Context, game types.Game, r types.Claim, pos types.Position) (types.Position, types.TraceProvider, error) {
+		return types.Position{}, nil, selectErr
+	})
+	_, err = accessor.Get(context.Background(), nil, ref, types.NewPosition(5, common.Big0))
+	require.ErrorIs(t, err, selectErr)
+	_, _, _, err = accessor.GetStepData(context.Background(), nil, ref, types.NewPosition(5, common.Big0))
+	require.ErrorIs(t, err, selectErr)
+}
@@ -1,23 +1,33 @@
 package cannon
 
 import (
-	"encoding/json"
 	"fmt"
+	"io"
 
 	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
 	"github.com/ethereum-optimism/optimism/op-service/ioutil"
 )
 
-func parseState(path string) (*mipsevm.State, error) {
+// parseState loads the state from path, which may be gzip or zstd compressed.
+// The state may be in the binary encoding or in JSON, and of either word size, see mipsevm.ReadAnyState.
+func parseState(path string) (mipsevm.FPVMState, error) {
+	return loadState(path, mipsevm.ReadAnyState)
+}
+
+// parseStateWithoutMemory loads the state from path without its memory, see mipsevm.ReadAnyStateWithoutMemory.
+func parseStateWithoutMemory(path string) (mipsevm.FPVMState, error) {
+	return loadState(path, mipsevm.ReadAnyStateWithoutMemory)
+}
+
+func loadState(path string, decode func(r io.Reader) (mipsevm.FPVMState, error)) (mipsevm.FPVMState, error) {
 	file, err := ioutil.OpenDecompressed(path)
 	if err != nil {
 		return nil, fmt.Errorf("cannot open state file (%v): %w", path, err)
 	}
 	defer file.Close()
-	var state mipsevm.State
-	err = json.NewDecoder(file).Decode(&state)
+	state, err := decode(file)
 	if err != nil {
 		return nil, fmt.Errorf("invalid mipsevm state (%v): %w", path, err)
 	}
-	return &state, nil
+	return state, nil
 }
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/require"
 )
 
@@ -47,4 +48,61 @@ func TestLoadState(t *testing.T) {
 		require.NoError(t, json.Unmarshal(testState, &expected))
 		require.Equal(t, &expected, state)
 	})
+
+	t.Run("Zstd", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "state.json.zst")
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0644)
+		require.NoError(t, err)
+		defer f.Close()
+		writer, err := zstd.NewWriter(f)
+		require.NoError(t, err)
+		_, err = writer.Write(testState)
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		state, err := parseState(path)
+		require.NoError(t, err)
+
+		var expected mipsevm.State
+		require.NoError(t, json.Unmarshal(testState, &expected))
+		require.Equal(t, &expected, state)
+	})
+
+	t.Run("Binary", func(t *testing.T) {
+		var expected mipsevm.State
+		require.NoError(t, json.Unmarshal(testState, &expected))
+		dir := t.TempDir()
+		path := filepath.Join(dir, "state.bin.gz")
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0644)
+		require.NoError(t, err)
+		defer f.Close()
+		writer := gzip.NewWriter(f)
+		require.NoError(t, mipsevm.EncodeStateBinary(writer, &expected))
+		require.NoError(t, writer.Close())
+
+		state, err := parseState(path)
+		require.NoError(t, err)
+		require.Equal(t, &expected, state)
+
+		state, err = parseStateWithoutMemory(path)
+		require.NoError(t, err)
+		require.Equal(t, expected.Step, state.GetStep())
+		require.Zero(t, state.(*mipsevm.State).Memory.PageCount())
+	})
+
+	t.Run("WithoutMemory", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "state.json")
+		require.NoError(t, os.WriteFile(path, testState, 0644))
+
+		state, err := parseStateWithoutMemory(path)
+		require.NoError(t, err)
+
+		var expected mipsevm.State
+		require.NoError(t, json.Unmarshal(testState, &expected))
+		require.Equal(t, expected.Step, state.GetStep())
+		require.Equal(t, expected.Exited, state.GetExited())
+		require.Zero(t, state.(*mipsevm.State).Memory.PageCount())
+	})
 }
@@ -0,0 +1,199 @@
+package cannon
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// GameClockSource provides the clock of a game, to derive the deadline of its cannon executions from.
+type GameClockSource interface {
+	CreatedAt(opts *bind.CallOpts) (uint64, error)
+	GAMEDURATION(opts *bind.CallOpts) (uint64, error)
+}
+
+// FetchGameDeadline returns the time by which the game can be resolved at the latest, when the clocks of both sides
+// have run out.
+func FetchGameDeadline(ctx context.Context, caller GameClockSource) (time.Time, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	createdAt, err := caller.CreatedAt(opts)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("fetch game creation time: %w", err)
+	}
+	duration, err := caller.GAMEDURATION(opts)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("fetch game duration: %w", err)
+	}
+	return time.Unix(int64(createdAt+duration), 0), nil
+}
+
+// ExecutionScheduler limits the number of cannon executions that run in parallel across all games.
+// Executions wait for a free slot in the order of the deadlines of their games, so that the games with the least time
+// left on their clocks are served first. When an execution with an earlier deadline is waiting while all slots are
+// used, the running execution with the latest deadline is preempted and run again once a slot is free.
+// Preempted executions lose little work, since cannon resumes from the last snapshot.
+type ExecutionScheduler struct {
+	logger      log.Logger
+	maxParallel int
+
+	lock    sync.Mutex
+	running map[*execution]struct{}
+	waiting []*execution
+}
+
+type execution struct {
+	// deadline is the deadline of the game, the zero time if the game has no deadline.
+	deadline time.Time
+	// ready is closed when the execution is given a slot.
+	ready chan struct{}
+	// cancel cancels the running execution, nil until it is started.
+	cancel    context.CancelFunc
+	preempted bool
+}
+
+// before returns true if e has a higher priority than other.
+// Executions without a deadline have the lowest priority.
+func (e *execution) before(other *execution) bool {
+	if e.deadline.IsZero() {
+		return false
+	}
+	return other.deadline.IsZero() || e.deadline.Before(other.deadline)
+}
+
+func NewExecutionScheduler(logger log.Logger, maxParallel int) *ExecutionScheduler {
+	return &ExecutionScheduler{
+		logger:      logger,
+		maxParallel: maxParallel,
+		running:     make(map[*execution]struct{}),
+	}
+}
+
+// Run runs exec once a slot is free, with the priority of a game with the given deadline.
+// If exec is preempted, the context it is run with is cancelled and, unless it completed regardless, it is run again
+// once a slot is free. So exec must be safe to interrupt.
+func (s *ExecutionScheduler) Run(ctx context.Context, deadline time.Time, exec func(ctx context.Context) error) error {
+	for {
+		job := &execution{deadline: deadline, ready: make(chan struct{})}
+		s.enqueue(job)
+		select {
+		case <-job.ready:
+		case <-ctx.Done():
+			s.abandon(job)
+			return ctx.Err()
+		}
+		execCtx, cancel := context.WithCancel(ctx)
+		s.start(job, cancel)
+		err := exec(execCtx)
+		cancel()
+		if preempted := s.finish(job); !preempted || err == nil || ctx.Err() != nil {
+			return err
+		}
+		s.logger.Info("Running preempted cannon execution again", "deadline", deadline)
+	}
+}
+
+// Running returns the number of executions that are running.
+func (s *ExecutionScheduler) Running() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.running)
+}
+
+// Waiting returns the number of executions that are waiting for a slot.
+func (s *ExecutionScheduler) Waiting() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.waiting)
+}
+
+func (s *ExecutionScheduler) enqueue(job *execution) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.running) < s.maxParallel {
+		s.grant(job)
+		return
+	}
+	s.waiting = append(s.waiting, job)
+	s.preemptFor(job)
+}
+
+// preemptFor preempts the running execution with the lowest priority, if job has a higher priority than it.
+// Must be called with the lock held.
+func (s *ExecutionScheduler) preemptFor(job *execution) {
+	var lowest *execution
+	for running := range s.running {
+		if running.preempted {
+			// Already making room for another execution
+			continue
+		}
+		if lowest == nil || lowest.before(running) {
+			lowest = running
+		}
+	}
+	if lowest == nil || !job.before(lowest) {
+		return
+	}
+	s.logger.Info("Preempting cannon execution", "deadline", lowest.deadline, "for", job.deadline)
+	lowest.preempted = true
+	if lowest.cancel != nil {
+		lowest.cancel()
+	}
+}
+
+func (s *ExecutionScheduler) start(job *execution, cancel context.CancelFunc) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	job.cancel = cancel
+	if job.preempted {
+		cancel()
+	}
+}
+
+// finish frees the slot of job and gives it to the waiting execution with the highest priority.
+// Returns true if job was preempted.
+func (s *ExecutionScheduler) finish(job *execution) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.running, job)
+	s.grantNext()
+	return job.preempted
+}
+
+// abandon removes job, that is no longer needed, from the queue, or frees its slot if it was given one already.
+func (s *ExecutionScheduler) abandon(job *execution) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i, waiting := range s.waiting {
+		if waiting == job {
+			s.waiting = append(s.waiting[:i], s.waiting[i+1:]...)
+			return
+		}
+	}
+	delete(s.running, job)
+	s.grantNext()
+}
+
+// grantNext gives free slots to the waiting executions with the highest priority. Must be called with the lock held.
+func (s *ExecutionScheduler) grantNext() {
+	for len(s.running) < s.maxParallel && len(s.waiting) > 0 {
+		next := 0
+		for i, waiting := range s.waiting {
+			if waiting.before(s.waiting[next]) {
+				next = i
+			}
+		}
+		job := s.waiting[next]
+		s.waiting = append(s.waiting[:next], s.waiting[next+1:]...)
+		s.grant(job)
+	}
+}
+
+// grant gives a slot to job. Must be called with the lock held.
+func (s *ExecutionScheduler) grant(job *execution) {
+	s.running[job] = struct{}{}
+	close(job.ready)
+}
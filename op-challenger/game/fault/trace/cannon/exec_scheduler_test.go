@@ -0,0 +1,182 @@
+package cannon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchGameDeadline(t *testing.T) {
+	deadline, err := FetchGameDeadline(context.Background(), &stubGameClock{createdAt: 1000, duration: 500})
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1500, 0), deadline)
+
+	_, err = FetchGameDeadline(context.Background(), &stubGameClock{err: errors.New("boom")})
+	require.Error(t, err)
+}
+
+func TestExecutionScheduler(t *testing.T) {
+	// blockingExec runs until it is released or its context is cancelled, and reports when it starts
+	type blockingExec struct {
+		started chan struct{}
+		release chan struct{}
+		runs    int
+	}
+	newExec := func() *blockingExec {
+		return &blockingExec{started: make(chan struct{}, 10), release: make(chan struct{})}
+	}
+	run := func(s *ExecutionScheduler, deadline time.Time, e *blockingExec) chan error {
+		result := make(chan error, 1)
+		go func() {
+			result <- s.Run(context.Background(), deadline, func(ctx context.Context) error {
+				e.runs++
+				e.started <- struct{}{}
+				select {
+				case <-e.release:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			})
+		}()
+		return result
+	}
+	waitFor := func(t *testing.T, ch chan struct{}) {
+		select {
+		case <-ch:
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for execution to start")
+		}
+	}
+	requireNotStarted := func(t *testing.T, e *blockingExec) {
+		select {
+		case <-e.started:
+			t.Fatal("execution should not have started")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	now := time.Unix(10_000, 0)
+
+	t.Run("LimitParallelExecutions", func(t *testing.T) {
+		s := NewExecutionScheduler(testlog.Logger(t, log.LvlInfo), 1)
+		first := newExec()
+		firstResult := run(s, now, first)
+		waitFor(t, first.started)
+
+		second := newExec()
+		secondResult := run(s, now, second)
+		requireNotStarted(t, second)
+		require.Equal(t, 1, s.Running())
+		require.Equal(t, 1, s.Waiting())
+
+		close(first.release)
+		require.NoError(t, <-firstResult)
+		waitFor(t, second.started)
+		close(second.release)
+		require.NoError(t, <-secondResult)
+		require.Zero(t, s.Running())
+	})
+
+	t.Run("PrioritiseEarliestDeadline", func(t *testing.T) {
+		s := NewExecutionScheduler(testlog.Logger(t, log.LvlInfo), 1)
+		first := newExec()
+		firstResult := run(s, now, first)
+		waitFor(t, first.started)
+
+		late := newExec()
+		lateResult := run(s, now.Add(2*time.Hour), late)
+		noDeadline := newExec()
+		noDeadlineResult := run(s, time.Time{}, noDeadline)
+		early := newExec()
+		earlyResult := run(s, now.Add(time.Hour), early)
+		require.Eventually(t, func() bool { return s.Waiting() == 3 }, 10*time.Second, time.Millisecond)
+
+		close(first.release)
+		require.NoError(t, <-firstResult)
+		waitFor(t, early.started)
+		close(early.release)
+		require.NoError(t, <-earlyResult)
+		waitFor(t, late.started)
+		close(late.release)
+		require.NoError(t, <-lateResult)
+		waitFor(t, noDeadline.started)
+		close(noDeadline.release)
+		require.NoError(t, <-noDeadlineResult)
+	})
+
+	t.Run("PreemptLowerPriority", func(t *testing.T) {
+		s := NewExecutionScheduler(testlog.Logger(t, log.LvlInfo), 1)
+		low := newExec()
+		lowResult := run(s, now.Add(time.Hour), low)
+		waitFor(t, low.started)
+
+		high := newExec()
+		highResult := run(s, now, high)
+		waitFor(t, high.started)
+		close(high.release)
+		require.NoError(t, <-highResult)
+
+		// The preempted execution is run again
+		waitFor(t, low.started)
+		close(low.release)
+		require.NoError(t, <-lowResult)
+		require.Equal(t, 2, low.runs)
+	})
+
+	t.Run("DoNotPreemptHigherPriority", func(t *testing.T) {
+		s := NewExecutionScheduler(testlog.Logger(t, log.LvlInfo), 1)
+		high := newExec()
+		highResult := run(s, now, high)
+		waitFor(t, high.started)
+
+		low := newExec()
+		lowResult := run(s, now.Add(time.Hour), low)
+		requireNotStarted(t, low)
+		close(high.release)
+		require.NoError(t, <-highResult)
+		require.Equal(t, 1, high.runs)
+		waitFor(t, low.started)
+		close(low.release)
+		require.NoError(t, <-lowResult)
+	})
+
+	t.Run("AbandonWhenContextDone", func(t *testing.T) {
+		s := NewExecutionScheduler(testlog.Logger(t, log.LvlInfo), 1)
+		first := newExec()
+		firstResult := run(s, now, first)
+		waitFor(t, first.started)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := s.Run(ctx, now, func(ctx context.Context) error {
+			t.Fatal("should not run")
+			return nil
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		require.Zero(t, s.Waiting())
+
+		close(first.release)
+		require.NoError(t, <-firstResult)
+		require.Zero(t, s.Running())
+	})
+}
+
+type stubGameClock struct {
+	createdAt uint64
+	duration  uint64
+	err       error
+}
+
+func (s *stubGameClock) CreatedAt(_ *bind.CallOpts) (uint64, error) {
+	return s.createdAt, s.err
+}
+
+func (s *stubGameClock) GAMEDURATION(_ *bind.CallOpts) (uint64, error) {
+	return s.duration, s.err
+}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
@@ -21,14 +22,31 @@ import (
 const (
 	snapsDir     = "snapshots"
 	preimagesDir = "preimages"
-	finalState   = "final.json.gz"
+	finalState   = "final.bin.gz"
 )
 
-var snapshotNameRegexp = regexp.MustCompile(`^[0-9]+\.json.gz$`)
+// snapshotNameRegexp matches the snapshots in the binary encoding, and in the JSON encoding that was used before.
+var snapshotNameRegexp = regexp.MustCompile(`^([0-9]+)\.(bin|json)\.gz$`)
 
 type snapshotSelect func(logger log.Logger, dir string, absolutePreState string, i uint64) (string, error)
 type cmdExecutor func(ctx context.Context, l log.Logger, binary string, args ...string) error
 
+// ExecutionStatus is the status of the executions of cannon that generate the proofs of a game.
+type ExecutionStatus struct {
+	// Running is true while cannon is executed.
+	Running bool
+	// Executions is the number of executions that completed.
+	Executions uint64
+	// TraceIndex is the trace index of the proof of the running execution, or of the last execution.
+	TraceIndex uint64
+	// StartedAt is the time that the running execution, or the last execution, started at.
+	StartedAt time.Time
+	// Duration is the duration of the last execution that completed.
+	Duration time.Duration
+	// Err is the error of the last execution that completed, if it failed.
+	Err error
+}
+
 type Executor struct {
 	logger           log.Logger
 	metrics          CannonMetricer
@@ -43,11 +61,26 @@ type Executor struct {
 	absolutePreState string
 	snapshotFreq     uint
 	infoFreq         uint
+	prefetch         bool
 	selectSnapshot   snapshotSelect
 	cmdExecutor      cmdExecutor
+
+	// scheduler limits the executions of cannon that run in parallel with other games, nil if not limited.
+	scheduler *ExecutionScheduler
+	// deadline is the deadline of the game, that its executions are prioritised by.
+	deadline time.Time
+	// diskQuota is the maximum size of the game dir while cannon is executed, 0 if not limited.
+	diskQuota     uint64
+	quotaInterval time.Duration
+
+	statusLock sync.Mutex
+	status     ExecutionStatus
 }
 
-func NewExecutor(logger log.Logger, m CannonMetricer, cfg *config.Config, inputs LocalGameInputs) *Executor {
+// NewExecutor creates the executor of cannon for the game with the given local inputs.
+// The executions are scheduled with the executions of other games by scheduler, if it is not nil, with the priority of
+// the deadline of the game.
+func NewExecutor(logger log.Logger, m CannonMetricer, cfg *config.Config, inputs LocalGameInputs, scheduler *ExecutionScheduler, deadline time.Time) *Executor {
 	return &Executor{
 		logger:           logger,
 		metrics:          m,
@@ -62,8 +95,13 @@ func NewExecutor(logger log.Logger, m CannonMetricer, cfg *config.Config, inputs
 		absolutePreState: cfg.CannonAbsolutePreState,
 		snapshotFreq:     cfg.CannonSnapshotFreq,
 		infoFreq:         cfg.CannonInfoFreq,
+		prefetch:         cfg.CannonPrefetch,
 		selectSnapshot:   findStartingSnapshot,
-		cmdExecutor:      runCmd,
+		cmdExecutor:      newCmdRunner(cfg.CannonMemoryQuota, quotaCheckInterval),
+		scheduler:        scheduler,
+		deadline:         deadline,
+		diskQuota:        cfg.CannonDiskQuota,
+		quotaInterval:    quotaCheckInterval,
 	}
 }
 
@@ -85,7 +123,7 @@ func (e *Executor) GenerateProof(ctx context.Context, dir string, i uint64) erro
 		"--proof-at", "=" + strconv.FormatUint(i, 10),
 		"--proof-fmt", filepath.Join(proofDir, "%d.json.gz"),
 		"--snapshot-at", "%" + strconv.FormatUint(uint64(e.snapshotFreq), 10),
-		"--snapshot-fmt", filepath.Join(snapshotDir, "%d.json.gz"),
+		"--snapshot-fmt", filepath.Join(snapshotDir, "%d.bin.gz"),
 	}
 	if i < math.MaxUint64 {
 		args = append(args, "--stop-at", "="+strconv.FormatUint(i+1, 10))
@@ -111,6 +149,9 @@ func (e *Executor) GenerateProof(ctx context.Context, dir string, i uint64) erro
 	if e.l2Genesis != "" {
 		args = append(args, "--l2.genesis", e.l2Genesis)
 	}
+	if e.prefetch {
+		args = append(args, "--prefetch")
+	}
 
 	if err := os.MkdirAll(snapshotDir, 0755); err != nil {
 		return fmt.Errorf("could not create snapshot directory %v: %w", snapshotDir, err)
@@ -123,21 +164,83 @@ func (e *Executor) GenerateProof(ctx context.Context, dir string, i uint64) erro
 	}
 	e.logger.Info("Generating trace", "proof", i, "cmd", e.cannon, "args", strings.Join(args, ", "))
 	execStart := time.Now()
-	err = e.cmdExecutor(ctx, e.logger.New("proof", i), e.cannon, args...)
-	e.metrics.RecordCannonExecutionTime(time.Since(execStart).Seconds())
+	e.statusLock.Lock()
+	e.status.Running = true
+	e.status.TraceIndex = i
+	e.status.StartedAt = execStart
+	e.statusLock.Unlock()
+	logger := e.logger.New("proof", i)
+	run := func(ctx context.Context) error {
+		return e.runWithDiskQuota(ctx, logger, dir, args)
+	}
+	if e.scheduler != nil {
+		err = e.scheduler.Run(ctx, e.deadline, run)
+	} else {
+		err = run(ctx)
+	}
+	duration := time.Since(execStart)
+	e.metrics.RecordCannonExecutionTime(duration.Seconds())
+	e.statusLock.Lock()
+	e.status.Running = false
+	e.status.Executions++
+	e.status.Duration = duration
+	e.status.Err = err
+	e.statusLock.Unlock()
+	return err
+}
+
+// Status returns the status of the executions of cannon.
+func (e *Executor) Status() ExecutionStatus {
+	e.statusLock.Lock()
+	defer e.statusLock.Unlock()
+	return e.status
+}
+
+// runWithDiskQuota executes cannon, and stops it once the size of the game dir is over the disk quota.
+func (e *Executor) runWithDiskQuota(ctx context.Context, logger log.Logger, dir string, args []string) error {
+	if e.diskQuota == 0 {
+		return e.cmdExecutor(ctx, logger, e.cannon, args...)
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	go watchQuota(ctx, cancel, logger, e.quotaInterval, e.diskQuota, func() (uint64, error) {
+		return dirSize(dir)
+	}, ErrDiskQuotaExceeded)
+	err := e.cmdExecutor(ctx, logger, e.cannon, args...)
+	if cause := context.Cause(ctx); errors.Is(cause, ErrDiskQuotaExceeded) {
+		return cause
+	}
 	return err
 }
 
-func runCmd(ctx context.Context, l log.Logger, binary string, args ...string) error {
-	cmd := exec.CommandContext(ctx, binary, args...)
-	stdOut := oplog.NewWriter(l, log.LvlInfo)
-	defer stdOut.Close()
-	// Keep stdErr at info level because cannon uses stderr for progress messages
-	stdErr := oplog.NewWriter(l, log.LvlInfo)
-	defer stdErr.Close()
-	cmd.Stdout = stdOut
-	cmd.Stderr = stdErr
-	return cmd.Run()
+// newCmdRunner returns a cmdExecutor that runs the binary, and kills it once the resident memory of the process and
+// its children is over memoryQuota, unless memoryQuota is 0.
+func newCmdRunner(memoryQuota uint64, interval time.Duration) cmdExecutor {
+	return func(ctx context.Context, l log.Logger, binary string, args ...string) error {
+		ctx, cancel := context.WithCancelCause(ctx)
+		defer cancel(nil)
+		cmd := exec.CommandContext(ctx, binary, args...)
+		stdOut := oplog.NewWriter(l, log.LvlInfo)
+		defer stdOut.Close()
+		// Keep stdErr at info level because cannon uses stderr for progress messages
+		stdErr := oplog.NewWriter(l, log.LvlInfo)
+		defer stdErr.Close()
+		cmd.Stdout = stdOut
+		cmd.Stderr = stdErr
+		if err := cmd.Start(); err != nil {
+			return err
+		}
+		if memoryQuota != 0 {
+			go watchQuota(ctx, cancel, l, interval, memoryQuota, func() (uint64, error) {
+				return processTreeMemory(cmd.Process.Pid)
+			}, ErrMemoryQuotaExceeded)
+		}
+		err := cmd.Wait()
+		if cause := context.Cause(ctx); errors.Is(cause, ErrMemoryQuotaExceeded) {
+			return cause
+		}
+		return err
+	}
 }
 
 // findStartingSnapshot finds the closest snapshot before the specified traceIndex in snapDir.
@@ -152,29 +255,32 @@ func findStartingSnapshot(logger log.Logger, snapDir string, absolutePreState st
 		return "", fmt.Errorf("list snapshots in %v: %w", snapDir, err)
 	}
 	bestSnap := uint64(0)
+	bestSnapName := ""
 	for _, entry := range entries {
 		if entry.IsDir() {
 			logger.Warn("Unexpected directory in snapshots dir", "parent", snapDir, "child", entry.Name())
 			continue
 		}
 		name := entry.Name()
-		if !snapshotNameRegexp.MatchString(name) {
+		match := snapshotNameRegexp.FindStringSubmatch(name)
+		if match == nil {
 			logger.Warn("Unexpected file in snapshots dir", "parent", snapDir, "child", entry.Name())
 			continue
 		}
-		index, err := strconv.ParseUint(name[0:len(name)-len(".json.gz")], 10, 64)
+		index, err := strconv.ParseUint(match[1], 10, 64)
 		if err != nil {
 			logger.Error("Unable to parse trace index of snapshot file", "parent", snapDir, "child", entry.Name())
 			continue
 		}
 		if index > bestSnap && index < traceIndex {
 			bestSnap = index
+			bestSnapName = name
 		}
 	}
 	if bestSnap == 0 {
 		return absolutePreState, nil
 	}
-	startFrom := fmt.Sprintf("%v/%v.json.gz", snapDir, bestSnap)
+	startFrom := fmt.Sprintf("%v/%v", snapDir, bestSnapName)
 
 	return startFrom, nil
 }
@@ -2,6 +2,7 @@ package cannon
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -41,7 +42,7 @@ func TestGenerateProof(t *testing.T) {
 	}
 	captureExec := func(t *testing.T, cfg config.Config, proofAt uint64) (string, string, map[string]string) {
 		m := &cannonDurationMetrics{}
-		executor := NewExecutor(testlog.Logger(t, log.LvlInfo), m, &cfg, inputs)
+		executor := NewExecutor(testlog.Logger(t, log.LvlInfo), m, &cfg, inputs, nil, time.Time{})
 		executor.selectSnapshot = func(logger log.Logger, dir string, absolutePreState string, i uint64) (string, error) {
 			return input, nil
 		}
@@ -57,6 +58,12 @@ func TestGenerateProof(t *testing.T) {
 					i += 1
 					continue
 				}
+				if a[i] == "--prefetch" {
+					// The only flag without a value
+					args[a[i]] = ""
+					i += 1
+					continue
+				}
 				args[a[i]] = a[i+1]
 				i += 2
 			}
@@ -94,10 +101,11 @@ func TestGenerateProof(t *testing.T) {
 		require.Equal(t, cfg.CannonL2, args["--l2"])
 		require.Equal(t, filepath.Join(dir, preimagesDir), args["--datadir"])
 		require.Equal(t, filepath.Join(dir, proofsDir, "%d.json.gz"), args["--proof-fmt"])
-		require.Equal(t, filepath.Join(dir, snapsDir, "%d.json.gz"), args["--snapshot-fmt"])
+		require.Equal(t, filepath.Join(dir, snapsDir, "%d.bin.gz"), args["--snapshot-fmt"])
 		require.Equal(t, cfg.CannonNetwork, args["--network"])
 		require.NotContains(t, args, "--rollup.config")
 		require.NotContains(t, args, "--l2.genesis")
+		require.NotContains(t, args, "--prefetch")
 
 		// Local game inputs
 		require.Equal(t, inputs.L1Head.Hex(), args["--l1.head"])
@@ -117,6 +125,14 @@ func TestGenerateProof(t *testing.T) {
 		require.Equal(t, cfg.CannonL2GenesisPath, args["--l2.genesis"])
 	})
 
+	t.Run("Prefetch", func(t *testing.T) {
+		cfg.CannonPrefetch = true
+		_, _, args := captureExec(t, cfg, 150_000_000)
+		require.Contains(t, args, "--prefetch")
+		require.Equal(t, filepath.Join(dir, preimagesDir), args["--datadir"])
+		cfg.CannonPrefetch = false
+	})
+
 	t.Run("NoStopAtWhenProofIsMaxUInt", func(t *testing.T) {
 		cfg.CannonNetwork = "mainnet"
 		cfg.CannonRollupConfigPath = "rollup.json"
@@ -128,6 +144,33 @@ func TestGenerateProof(t *testing.T) {
 	})
 }
 
+func TestExecutionStatus(t *testing.T) {
+	tempDir := t.TempDir()
+	cfg := config.NewConfig(common.Address{0xbb}, "http://localhost:8888", true, tempDir, config.TraceTypeCannon)
+	executor := NewExecutor(testlog.Logger(t, log.LvlInfo), &cannonDurationMetrics{}, &cfg, LocalGameInputs{L2BlockNumber: big.NewInt(1)}, nil, time.Time{})
+	executor.selectSnapshot = func(logger log.Logger, dir string, absolutePreState string, i uint64) (string, error) {
+		return "starting.json", nil
+	}
+	require.Equal(t, ExecutionStatus{}, executor.Status())
+
+	execErr := errors.New("boom")
+	executor.cmdExecutor = func(ctx context.Context, l log.Logger, b string, a ...string) error {
+		status := executor.Status()
+		require.True(t, status.Running, "should be running while cannon executes")
+		require.Equal(t, uint64(42), status.TraceIndex)
+		return execErr
+	}
+	err := executor.GenerateProof(context.Background(), filepath.Join(tempDir, "gameDir"), 42)
+	require.ErrorIs(t, err, execErr)
+
+	status := executor.Status()
+	require.False(t, status.Running)
+	require.Equal(t, uint64(1), status.Executions)
+	require.Equal(t, uint64(42), status.TraceIndex)
+	require.ErrorIs(t, status.Err, execErr)
+	require.False(t, status.StartedAt.IsZero())
+}
+
 func TestRunCmdLogsOutput(t *testing.T) {
 	bin := "/bin/echo"
 	if _, err := os.Stat(bin); err != nil {
@@ -137,11 +180,57 @@ func TestRunCmdLogsOutput(t *testing.T) {
 	defer cancel()
 	logger := testlog.Logger(t, log.LvlInfo)
 	logs := testlog.Capture(logger)
-	err := runCmd(ctx, logger, bin, "Hello World")
+	err := newCmdRunner(0, quotaCheckInterval)(ctx, logger, bin, "Hello World")
 	require.NoError(t, err)
 	require.NotNil(t, logs.FindLog(log.LvlInfo, "Hello World"))
 }
 
+func TestRunCmdMemoryQuota(t *testing.T) {
+	bin := "/bin/sleep"
+	if _, err := os.Stat(bin); err != nil {
+		t.Skip(bin, " not available", err)
+	}
+	if _, err := os.Stat("/proc/self/status"); err != nil {
+		t.Skip("procfs not available", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err := newCmdRunner(1, time.Millisecond)(ctx, testlog.Logger(t, log.LvlInfo), bin, "30")
+	require.ErrorIs(t, err, ErrMemoryQuotaExceeded)
+	require.NoError(t, ctx.Err(), "should stop before the timeout")
+}
+
+func TestDiskQuota(t *testing.T) {
+	tempDir := t.TempDir()
+	cfg := config.NewConfig(common.Address{0xbb}, "http://localhost:8888", true, tempDir, config.TraceTypeCannon)
+	cfg.CannonDiskQuota = 100
+	executor := NewExecutor(testlog.Logger(t, log.LvlInfo), &cannonDurationMetrics{}, &cfg, LocalGameInputs{L2BlockNumber: big.NewInt(1)}, nil, time.Time{})
+	executor.quotaInterval = time.Millisecond
+	executor.selectSnapshot = func(logger log.Logger, dir string, absolutePreState string, i uint64) (string, error) {
+		return "starting.json", nil
+	}
+	dir := filepath.Join(tempDir, "gameDir")
+
+	t.Run("WithinQuota", func(t *testing.T) {
+		executor.cmdExecutor = func(ctx context.Context, l log.Logger, b string, a ...string) error {
+			return os.WriteFile(filepath.Join(dir, preimagesDir, "small"), make([]byte, 10), 0o644)
+		}
+		require.NoError(t, executor.GenerateProof(context.Background(), dir, 42))
+	})
+
+	t.Run("OverQuota", func(t *testing.T) {
+		executor.cmdExecutor = func(ctx context.Context, l log.Logger, b string, a ...string) error {
+			if err := os.WriteFile(filepath.Join(dir, preimagesDir, "large"), make([]byte, 200), 0o644); err != nil {
+				return err
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		err := executor.GenerateProof(context.Background(), dir, 42)
+		require.ErrorIs(t, err, ErrDiskQuotaExceeded)
+	})
+}
+
 func TestFindStartingSnapshot(t *testing.T) {
 	logger := testlog.Logger(t, log.LvlInfo)
 
@@ -198,6 +287,18 @@ func TestFindStartingSnapshot(t *testing.T) {
 		require.Equal(t, filepath.Join(dir, "250.json.gz"), snapshot)
 	})
 
+	t.Run("UseBinaryAndJsonSnapshots", func(t *testing.T) {
+		dir := withSnapshots(t, "100.json.gz", "123.bin.gz", "250.bin.gz")
+
+		snapshot, err := findStartingSnapshot(logger, dir, execTestCannonPrestate, 123)
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(dir, "100.json.gz"), snapshot)
+
+		snapshot, err = findStartingSnapshot(logger, dir, execTestCannonPrestate, 124)
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(dir, "123.bin.gz"), snapshot)
+	})
+
 	t.Run("IgnoreDirectories", func(t *testing.T) {
 		dir := withSnapshots(t, "100.json.gz")
 		require.NoError(t, os.Mkdir(filepath.Join(dir, "120.json.gz"), 0o777))
@@ -207,7 +308,7 @@ func TestFindStartingSnapshot(t *testing.T) {
 	})
 
 	t.Run("IgnoreUnexpectedFiles", func(t *testing.T) {
-		dir := withSnapshots(t, ".file", "100.json.gz", "foo", "bar.json.gz")
+		dir := withSnapshots(t, ".file", "100.json.gz", "foo", "bar.json.gz", "120.txt.gz")
 		snapshot, err := findStartingSnapshot(logger, dir, execTestCannonPrestate, 150)
 		require.NoError(t, err)
 		require.Equal(t, filepath.Join(dir, "100.json.gz"), snapshot)
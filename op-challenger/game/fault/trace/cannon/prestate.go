@@ -0,0 +1,42 @@
+package cannon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var _ types.PrestateProvider = (*CannonPrestateProvider)(nil)
+
+// CannonPrestateProvider provides the absolute pre-state of the cannon traces that are loaded from the prestate file,
+// which is the same for every game.
+type CannonPrestateProvider struct {
+	prestate string
+}
+
+func NewPrestateProvider(prestate string) *CannonPrestateProvider {
+	return &CannonPrestateProvider{prestate: prestate}
+}
+
+func (p *CannonPrestateProvider) AbsolutePreState(ctx context.Context) ([]byte, error) {
+	state, err := parseState(p.prestate)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load absolute pre-state: %w", err)
+	}
+	return state.EncodeWitness(), nil
+}
+
+func (p *CannonPrestateProvider) AbsolutePreStateCommitment(ctx context.Context) (common.Hash, error) {
+	state, err := p.AbsolutePreState(ctx)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("cannot load absolute pre-state: %w", err)
+	}
+	hash, err := mipsevm.StateWitness(state).StateHash()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("cannot hash absolute pre-state: %w", err)
+	}
+	return hash, nil
+}
@@ -0,0 +1,222 @@
+package cannon
+
+import (
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/hashicorp/golang-lru/v2/simplelru"
+
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+)
+
+const (
+	proofCacheExt    = ".json.gz"
+	proofCacheTmpExt = proofCacheExt + ioutil.AtomicTempExt
+)
+
+// ProofCacheKey returns the key of the proof at traceIndex of the cannon trace that starts from the absolute prestate
+// with the given commitment and runs the program with localInputs.
+// The key covers all the local inputs and not only the L2 claim, since the trace also depends on the L1 head and
+// the agreed L2 output, that may differ between games that dispute the same claim.
+func ProofCacheKey(prestate common.Hash, localInputs LocalGameInputs, traceIndex uint64) common.Hash {
+	var blockNumber [32]byte
+	if localInputs.L2BlockNumber != nil {
+		localInputs.L2BlockNumber.FillBytes(blockNumber[:])
+	}
+	var index [8]byte
+	binary.BigEndian.PutUint64(index[:], traceIndex)
+	return crypto.Keccak256Hash(
+		prestate[:],
+		localInputs.L1Head[:],
+		localInputs.L2Head[:],
+		localInputs.L2OutputRoot[:],
+		localInputs.L2Claim[:],
+		blockNumber[:],
+		index[:])
+}
+
+// ProofCache is a content-addressed cache of cannon proofs on disk, that is shared by all games so that the proofs of
+// games that dispute the same claim are only generated once.
+// The total size of the cached proofs is bound by a maximum, over which the least recently used proofs are evicted.
+type ProofCache struct {
+	logger  log.Logger
+	dir     string
+	maxSize uint64
+
+	lock    sync.Mutex
+	size    uint64
+	entries *simplelru.LRU[common.Hash, uint64]
+}
+
+// NewProofCache creates a proof cache that stores the proofs in dir, up to maxSize bytes.
+// Proofs that were cached in dir before are loaded, with the most recently written proofs evicted last.
+func NewProofCache(logger log.Logger, dir string, maxSize uint64) (*ProofCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create proof cache dir %v: %w", dir, err)
+	}
+	c := &ProofCache{
+		logger:  logger,
+		dir:     dir,
+		maxSize: maxSize,
+	}
+	entries, err := simplelru.NewLRU[common.Hash, uint64](math.MaxInt, c.onEvict)
+	if err != nil {
+		return nil, err
+	}
+	c.entries = entries
+	if err := c.loadEntries(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *ProofCache) loadEntries() error {
+	files, err := os.ReadDir(c.dir)
+	if err != nil {
+		return fmt.Errorf("list proof cache dir %v: %w", c.dir, err)
+	}
+	type entry struct {
+		key  common.Hash
+		info os.FileInfo
+	}
+	var existing []entry
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() {
+			continue
+		}
+		if strings.HasSuffix(name, proofCacheTmpExt) {
+			// Left over from a write that didn't complete
+			if err := os.Remove(filepath.Join(c.dir, name)); err != nil {
+				c.logger.Warn("Failed to remove incomplete cached proof", "file", name, "err", err)
+			}
+			continue
+		}
+		if !strings.HasSuffix(name, proofCacheExt) {
+			continue
+		}
+		var key common.Hash
+		if err := key.UnmarshalText([]byte(strings.TrimSuffix(name, proofCacheExt))); err != nil {
+			c.logger.Warn("Unexpected file in proof cache dir", "dir", c.dir, "file", name)
+			continue
+		}
+		info, err := file.Info()
+		if err != nil {
+			return fmt.Errorf("read info of cached proof %v: %w", name, err)
+		}
+		existing = append(existing, entry{key: key, info: info})
+	}
+	sort.Slice(existing, func(i, j int) bool {
+		return existing[i].info.ModTime().Before(existing[j].info.ModTime())
+	})
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, e := range existing {
+		c.add(e.key, uint64(e.info.Size()))
+	}
+	return nil
+}
+
+// Get returns the cached proof with the key, see ProofCacheKey.
+func (c *ProofCache) Get(key common.Hash) (*proofData, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.entries.Get(key); !ok {
+		return nil, false
+	}
+	proof, err := c.read(key)
+	if err != nil {
+		c.logger.Warn("Failed to read cached proof", "key", key, "err", err)
+		c.entries.Remove(key)
+		return nil, false
+	}
+	return proof, true
+}
+
+// Put adds the proof to the cache with the key, see ProofCacheKey, and evicts the least recently used proofs while
+// the size of the cache is over its maximum.
+func (c *ProofCache) Put(key common.Hash, proof *proofData) error {
+	path := c.path(key)
+	file, err := ioutil.CreateAtomic(path, 0644)
+	if err != nil {
+		return fmt.Errorf("create cached proof: %w", err)
+	}
+	defer file.Abort()
+	out := gzip.NewWriter(file)
+	if err := json.NewEncoder(out).Encode(proof); err != nil {
+		return fmt.Errorf("write cached proof: %w", err)
+	}
+	if err := out.Close(); err != nil {
+		return fmt.Errorf("write cached proof: %w", err)
+	}
+	// Move the proof into place with the lock held, so that it cannot be evicted before its entry is added
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err := file.Commit(); err != nil {
+		return fmt.Errorf("move cached proof into place: %w", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("read info of cached proof: %w", err)
+	}
+	c.add(key, uint64(info.Size()))
+	return nil
+}
+
+// Size returns the total size in bytes of the cached proofs.
+func (c *ProofCache) Size() uint64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.size
+}
+
+// add records the entry of a proof of the given size, that is already on disk, and evicts the least recently used
+// entries while the cache is too big. Must be called with the lock held.
+func (c *ProofCache) add(key common.Hash, size uint64) {
+	if prev, ok := c.entries.Peek(key); ok {
+		c.size -= prev
+	}
+	c.entries.Add(key, size)
+	c.size += size
+	for c.size > c.maxSize {
+		if _, _, ok := c.entries.RemoveOldest(); !ok {
+			break
+		}
+	}
+}
+
+func (c *ProofCache) onEvict(key common.Hash, size uint64) {
+	c.size -= size
+	if err := os.Remove(c.path(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		c.logger.Warn("Failed to remove evicted proof", "key", key, "err", err)
+	}
+}
+
+func (c *ProofCache) read(key common.Hash) (*proofData, error) {
+	file, err := ioutil.OpenDecompressed(c.path(key))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var proof proofData
+	if err := json.NewDecoder(file).Decode(&proof); err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
+
+func (c *ProofCache) path(key common.Hash) string {
+	return filepath.Join(c.dir, key.Hex()+proofCacheExt)
+}
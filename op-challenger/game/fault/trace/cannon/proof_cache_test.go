@@ -0,0 +1,158 @@
+package cannon
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProofCacheKey(t *testing.T) {
+	inputs := LocalGameInputs{
+		L1Head:        common.Hash{0x11},
+		L2Head:        common.Hash{0x22},
+		L2OutputRoot:  common.Hash{0x33},
+		L2Claim:       common.Hash{0x44},
+		L2BlockNumber: big.NewInt(5),
+	}
+	prestate := common.Hash{0xaa}
+	key := ProofCacheKey(prestate, inputs, 10)
+	require.Equal(t, key, ProofCacheKey(prestate, inputs, 10))
+	require.NotEqual(t, key, ProofCacheKey(prestate, inputs, 11))
+	require.NotEqual(t, key, ProofCacheKey(common.Hash{0xbb}, inputs, 10))
+
+	otherClaim := inputs
+	otherClaim.L2Claim = common.Hash{0x55}
+	require.NotEqual(t, key, ProofCacheKey(prestate, otherClaim, 10))
+
+	otherL1Head := inputs
+	otherL1Head.L1Head = common.Hash{0x66}
+	require.NotEqual(t, key, ProofCacheKey(prestate, otherL1Head, 10))
+}
+
+func TestProofCache(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	proof := &proofData{ClaimValue: common.Hash{0x01}, StateData: []byte{1, 2, 3}, ProofData: []byte{4, 5, 6}}
+
+	t.Run("GetMissing", func(t *testing.T) {
+		cache, err := NewProofCache(logger, t.TempDir(), 1<<20)
+		require.NoError(t, err)
+		_, ok := cache.Get(common.Hash{0xaa})
+		require.False(t, ok)
+	})
+
+	t.Run("PutAndGet", func(t *testing.T) {
+		cache, err := NewProofCache(logger, t.TempDir(), 1<<20)
+		require.NoError(t, err)
+		require.NoError(t, cache.Put(common.Hash{0xaa}, proof))
+		actual, ok := cache.Get(common.Hash{0xaa})
+		require.True(t, ok)
+		require.Equal(t, proof, actual)
+		require.NotZero(t, cache.Size())
+	})
+
+	t.Run("EvictLeastRecentlyUsed", func(t *testing.T) {
+		dir := t.TempDir()
+		cache, err := NewProofCache(logger, dir, 1<<20)
+		require.NoError(t, err)
+		require.NoError(t, cache.Put(common.Hash{0xaa}, proof))
+		entrySize := cache.Size()
+		cache.maxSize = 2 * entrySize
+		require.NoError(t, cache.Put(common.Hash{0xbb}, proof))
+		// Use the first entry so that the second one is the least recently used
+		_, ok := cache.Get(common.Hash{0xaa})
+		require.True(t, ok)
+
+		require.NoError(t, cache.Put(common.Hash{0xcc}, proof))
+		require.Equal(t, 2*entrySize, cache.Size())
+		_, ok = cache.Get(common.Hash{0xbb})
+		require.False(t, ok)
+		require.NoFileExists(t, cache.path(common.Hash{0xbb}))
+		_, ok = cache.Get(common.Hash{0xaa})
+		require.True(t, ok)
+		_, ok = cache.Get(common.Hash{0xcc})
+		require.True(t, ok)
+	})
+
+	t.Run("LoadExistingEntries", func(t *testing.T) {
+		dir := t.TempDir()
+		cache, err := NewProofCache(logger, dir, 1<<20)
+		require.NoError(t, err)
+		require.NoError(t, cache.Put(common.Hash{0xaa}, proof))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "foo.txt"), []byte("foo"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, common.Hash{0xbb}.Hex()+proofCacheTmpExt), []byte("foo"), 0o644))
+
+		reopened, err := NewProofCache(logger, dir, 1<<20)
+		require.NoError(t, err)
+		require.Equal(t, cache.Size(), reopened.Size())
+		actual, ok := reopened.Get(common.Hash{0xaa})
+		require.True(t, ok)
+		require.Equal(t, proof, actual)
+		require.NoFileExists(t, filepath.Join(dir, common.Hash{0xbb}.Hex()+proofCacheTmpExt))
+		require.FileExists(t, filepath.Join(dir, "foo.txt"))
+	})
+
+	t.Run("EvictWhenReopenedWithSmallerSize", func(t *testing.T) {
+		dir := t.TempDir()
+		cache, err := NewProofCache(logger, dir, 1<<20)
+		require.NoError(t, err)
+		require.NoError(t, cache.Put(common.Hash{0xaa}, proof))
+		require.NoError(t, cache.Put(common.Hash{0xbb}, proof))
+
+		reopened, err := NewProofCache(logger, dir, cache.Size()-1)
+		require.NoError(t, err)
+		require.Less(t, reopened.Size(), cache.Size())
+	})
+
+	t.Run("RemoveUnreadableEntry", func(t *testing.T) {
+		cache, err := NewProofCache(logger, t.TempDir(), 1<<20)
+		require.NoError(t, err)
+		require.NoError(t, cache.Put(common.Hash{0xaa}, proof))
+		require.NoError(t, os.WriteFile(cache.path(common.Hash{0xaa}), []byte("invalid"), 0o644))
+		_, ok := cache.Get(common.Hash{0xaa})
+		require.False(t, ok)
+		require.Zero(t, cache.Size())
+	})
+}
+
+func TestShareProofsBetweenGames(t *testing.T) {
+	cache, err := NewProofCache(testlog.Logger(t, log.LvlInfo), t.TempDir(), 1<<20)
+	require.NoError(t, err)
+	inputs := LocalGameInputs{L2Claim: common.Hash{0x44}, L2BlockNumber: big.NewInt(5)}
+	proof := &proofData{ClaimValue: common.Hash{0x01}, StateData: []byte{1}, ProofData: []byte{2}}
+	setupProvider := func(inputs LocalGameInputs) (*CannonTraceProvider, *stubGenerator) {
+		dataDir := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(dataDir, proofsDir), 0o777))
+		setupPreState(t, dataDir, "state.json")
+		provider, generator := setupWithTestData(t, dataDir, "state.json")
+		provider.cache = cache
+		provider.localInputs = inputs
+		generator.proof = proof
+		return provider, generator
+	}
+
+	first, firstGenerator := setupProvider(inputs)
+	value, err := first.Get(context.Background(), PositionFromTraceIndex(first, big.NewInt(42)))
+	require.NoError(t, err)
+	require.Equal(t, proof.ClaimValue, value)
+	require.Equal(t, []int{42}, firstGenerator.generated)
+
+	second, secondGenerator := setupProvider(inputs)
+	value, err = second.Get(context.Background(), PositionFromTraceIndex(second, big.NewInt(42)))
+	require.NoError(t, err)
+	require.Equal(t, proof.ClaimValue, value)
+	require.Empty(t, secondGenerator.generated, "should use the cached proof")
+
+	otherInputs := inputs
+	otherInputs.L2Claim = common.Hash{0x55}
+	other, otherGenerator := setupProvider(otherInputs)
+	_, err = other.Get(context.Background(), PositionFromTraceIndex(other, big.NewInt(42)))
+	require.NoError(t, err)
+	require.Equal(t, []int{42}, otherGenerator.generated, "should not share proofs of other claims")
+}
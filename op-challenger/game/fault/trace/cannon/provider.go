@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
@@ -44,6 +45,15 @@ type ProofGenerator interface {
 	GenerateProof(ctx context.Context, dataDir string, proofAt uint64) error
 }
 
+// Shared holds the resources that are shared by the cannon trace providers of all games. Resources that are nil are
+// not shared.
+type Shared struct {
+	// ProofCache shares the proofs between games that dispute the same claim.
+	ProofCache *ProofCache
+	// Executions limits the executions of cannon of all games that run in parallel.
+	Executions *ExecutionScheduler
+}
+
 type CannonTraceProvider struct {
 	logger    log.Logger
 	dir       string
@@ -51,12 +61,18 @@ type CannonTraceProvider struct {
 	generator ProofGenerator
 	gameDepth uint64
 
+	// cache is the proof cache shared with other games, nil if proofs are not shared.
+	cache       *ProofCache
+	localInputs LocalGameInputs
+	// prestateCommitment is the commitment of the absolute prestate, loaded when the first proof cache key is needed
+	prestateCommitment common.Hash
+
 	// lastStep stores the last step in the actual trace if known. 0 indicates unknown.
 	// Cached as an optimisation to avoid repeatedly attempting to execute beyond the end of the trace.
 	lastStep uint64
 }
 
-func NewTraceProvider(ctx context.Context, logger log.Logger, m CannonMetricer, cfg *config.Config, l1Client bind.ContractCaller, dir string, gameAddr common.Address, gameDepth uint64) (*CannonTraceProvider, error) {
+func NewTraceProvider(ctx context.Context, logger log.Logger, m CannonMetricer, cfg *config.Config, l1Client bind.ContractCaller, shared Shared, dir string, gameAddr common.Address, gameDepth uint64) (*CannonTraceProvider, error) {
 	l2Client, err := ethclient.DialContext(ctx, cfg.CannonL2)
 	if err != nil {
 		return nil, fmt.Errorf("dial l2 client %v: %w", cfg.CannonL2, err)
@@ -70,19 +86,39 @@ func NewTraceProvider(ctx context.Context, logger log.Logger, m CannonMetricer,
 	if err != nil {
 		return nil, fmt.Errorf("fetch local game inputs: %w", err)
 	}
-	return NewTraceProviderFromInputs(logger, m, cfg, localInputs, dir, gameDepth), nil
+	var deadline time.Time
+	if shared.Executions != nil {
+		deadline, err = FetchGameDeadline(ctx, gameCaller)
+		if err != nil {
+			return nil, fmt.Errorf("fetch deadline of game %v: %w", gameAddr, err)
+		}
+	}
+	return NewTraceProviderFromInputs(logger, m, cfg, localInputs, shared, deadline, dir, gameDepth), nil
 }
 
-func NewTraceProviderFromInputs(logger log.Logger, m CannonMetricer, cfg *config.Config, localInputs LocalGameInputs, dir string, gameDepth uint64) *CannonTraceProvider {
+// NewTraceProviderFromInputs creates the cannon trace provider of the game with the given local inputs.
+// The proofs and executions are shared with other games through shared. The executions are prioritised by the
+// deadline of the game, where the zero time gives the lowest priority.
+func NewTraceProviderFromInputs(logger log.Logger, m CannonMetricer, cfg *config.Config, localInputs LocalGameInputs, shared Shared, deadline time.Time, dir string, gameDepth uint64) *CannonTraceProvider {
 	return &CannonTraceProvider{
-		logger:    logger,
-		dir:       dir,
-		prestate:  cfg.CannonAbsolutePreState,
-		generator: NewExecutor(logger, m, cfg, localInputs),
-		gameDepth: gameDepth,
+		logger:      logger,
+		dir:         dir,
+		prestate:    cfg.CannonAbsolutePreState,
+		generator:   NewExecutor(logger, m, cfg, localInputs, shared.Executions, deadline),
+		gameDepth:   gameDepth,
+		cache:       shared.ProofCache,
+		localInputs: localInputs,
 	}
 }
 
+// ExecutionStatus returns the status of the executions of cannon that generate the proofs of the game.
+func (p *CannonTraceProvider) ExecutionStatus() ExecutionStatus {
+	if e, ok := p.generator.(*Executor); ok {
+		return e.Status()
+	}
+	return ExecutionStatus{}
+}
+
 func (p *CannonTraceProvider) SetMaxDepth(gameDepth uint64) {
 	p.gameDepth = gameDepth
 }
@@ -130,28 +166,43 @@ func (p *CannonTraceProvider) GetStepData(ctx context.Context, pos types.Positio
 }
 
 func (p *CannonTraceProvider) AbsolutePreState(ctx context.Context) ([]byte, error) {
-	state, err := parseState(p.prestate)
-	if err != nil {
-		return nil, fmt.Errorf("cannot load absolute pre-state: %w", err)
-	}
-	return state.EncodeWitness(), nil
+	return NewPrestateProvider(p.prestate).AbsolutePreState(ctx)
 }
 
 func (p *CannonTraceProvider) AbsolutePreStateCommitment(ctx context.Context) (common.Hash, error) {
-	state, err := p.AbsolutePreState(ctx)
-	if err != nil {
-		return common.Hash{}, fmt.Errorf("cannot load absolute pre-state: %w", err)
+	return NewPrestateProvider(p.prestate).AbsolutePreStateCommitment(ctx)
+}
+
+// loadProof will attempt to load the proof data at the specified index from the shared proof cache, and otherwise
+// loads or generates it for the game, see loadGameProof.
+func (p *CannonTraceProvider) loadProof(ctx context.Context, i uint64) (*proofData, error) {
+	if p.cache == nil {
+		return p.loadGameProof(ctx, i)
+	}
+	if p.prestateCommitment == (common.Hash{}) {
+		commitment, err := p.AbsolutePreStateCommitment(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load absolute prestate commitment: %w", err)
+		}
+		p.prestateCommitment = commitment
 	}
-	hash, err := mipsevm.StateWitness(state).StateHash()
+	key := ProofCacheKey(p.prestateCommitment, p.localInputs, i)
+	if proof, ok := p.cache.Get(key); ok {
+		return proof, nil
+	}
+	proof, err := p.loadGameProof(ctx, i)
 	if err != nil {
-		return common.Hash{}, fmt.Errorf("cannot hash absolute pre-state: %w", err)
+		return nil, err
+	}
+	if err := p.cache.Put(key, proof); err != nil {
+		p.logger.Warn("Failed to add proof to the proof cache", "proof", i, "err", err)
 	}
-	return hash, nil
+	return proof, nil
 }
 
-// loadProof will attempt to load or generate the proof data at the specified index
+// loadGameProof will attempt to load or generate the proof data at the specified index in the data dir of the game.
 // If the requested index is beyond the end of the actual trace it is extended with no-op instructions.
-func (p *CannonTraceProvider) loadProof(ctx context.Context, i uint64) (*proofData, error) {
+func (p *CannonTraceProvider) loadGameProof(ctx context.Context, i uint64) (*proofData, error) {
 	// Attempt to read the last step from disk cache
 	if p.lastStep == 0 {
 		step, err := readLastStep(p.dir)
@@ -174,16 +225,21 @@ func (p *CannonTraceProvider) loadProof(ctx context.Context, i uint64) (*proofDa
 		// Try opening the file again now and it should exist.
 		file, err = ioutil.OpenDecompressed(path)
 		if errors.Is(err, os.ErrNotExist) {
-			// Expected proof wasn't generated, check if we reached the end of execution
-			state, err := parseState(filepath.Join(p.dir, finalState))
+			// Expected proof wasn't generated, check if we reached the end of execution.
+			// The memory of the final state is only loaded if the witness of the state is required.
+			state, err := parseStateWithoutMemory(filepath.Join(p.dir, finalState))
 			if err != nil {
 				return nil, fmt.Errorf("cannot read final state: %w", err)
 			}
-			if state.Exited && state.Step <= i {
-				p.logger.Warn("Requested proof was after the program exited", "proof", i, "last", state.Step)
+			if state.GetExited() && state.GetStep() <= i {
+				p.logger.Warn("Requested proof was after the program exited", "proof", i, "last", state.GetStep())
+				state, err = parseState(filepath.Join(p.dir, finalState))
+				if err != nil {
+					return nil, fmt.Errorf("cannot read final state: %w", err)
+				}
 				// The final instruction has already been applied to this state, so the last step we can execute
 				// is one before its Step value.
-				p.lastStep = state.Step - 1
+				p.lastStep = state.GetStep() - 1
 				// Extend the trace out to the full length using a no-op instruction that doesn't change any state
 				// No execution is done, so no proof-data or oracle values are required.
 				witness := state.EncodeWitness()
@@ -204,7 +260,7 @@ func (p *CannonTraceProvider) loadProof(ctx context.Context, i uint64) (*proofDa
 				}
 				return proof, nil
 			} else {
-				return nil, fmt.Errorf("expected proof not generated but final state was not exited, requested step %v, final state at step %v", i, state.Step)
+				return nil, fmt.Errorf("expected proof not generated but final state was not exited, requested step %v, final state at step %v", i, state.GetStep())
 			}
 		}
 	}
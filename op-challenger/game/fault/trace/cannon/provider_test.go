@@ -255,6 +255,29 @@ func TestAbsolutePreState(t *testing.T) {
 		}
 		require.Equal(t, []byte(state.EncodeWitness()), preState)
 	})
+
+	t.Run("ExpectedAbsolutePreState64", func(t *testing.T) {
+		state := &mipsevm.State64{
+			Memory: mipsevm.NewMemory64(),
+			PC:     0x1_0000_0000,
+			NextPC: 0x1_0000_0004,
+		}
+		state.Memory.SetDoubleword(0x1_0000_0000, 0x1234)
+		data, err := json.Marshal(state)
+		require.NoError(t, err)
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, prestate), data, 0o644))
+		provider, _ := setupWithTestData(t, dir, prestate)
+
+		preState, err := provider.AbsolutePreState(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []byte(state.EncodeWitness()), preState)
+		commitment, err := provider.AbsolutePreStateCommitment(context.Background())
+		require.NoError(t, err)
+		expected, err := state.EncodeWitness().StateHash()
+		require.NoError(t, err)
+		require.Equal(t, expected, commitment)
+	})
 }
 
 func setupPreState(t *testing.T, dataDir string, filename string) {
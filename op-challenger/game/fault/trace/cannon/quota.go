@@ -0,0 +1,132 @@
+package cannon
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const quotaCheckInterval = 10 * time.Second
+
+var (
+	ErrMemoryQuotaExceeded = errors.New("cannon memory quota exceeded")
+	ErrDiskQuotaExceeded   = errors.New("cannon disk quota exceeded")
+)
+
+// watchQuota checks the usage of a resource every interval until ctx is done, and cancels ctx with errExceeded as the
+// cause once the usage is over quota.
+func watchQuota(ctx context.Context, cancel context.CancelCauseFunc, l log.Logger, interval time.Duration, quota uint64, usage func() (uint64, error), errExceeded error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		used, err := usage()
+		if err != nil {
+			l.Warn("Failed to check resource usage of cannon", "quota", errExceeded, "err", err)
+			continue
+		}
+		if used > quota {
+			cancel(fmt.Errorf("%w: using %v of %v bytes", errExceeded, used, quota))
+			return
+		}
+	}
+}
+
+// dirSize returns the total size of the files in dir.
+func dirSize(dir string) (uint64, error) {
+	var size uint64
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) {
+			// Removed while walking, for example a temporary file that was renamed
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		size += uint64(info.Size())
+		return nil
+	})
+	return size, err
+}
+
+// processTreeMemory returns the resident memory of the process with the given pid and of all its descendants, which
+// for cannon includes the op-program server. Only supported on Linux, since it reads the memory from procfs.
+func processTreeMemory(pid int) (uint64, error) {
+	total, err := processMemory(pid)
+	if err != nil {
+		return 0, err
+	}
+	for _, child := range processChildren(pid) {
+		// Children that exited since they were listed are skipped
+		if mem, err := processTreeMemory(child); err == nil {
+			total += mem
+		}
+	}
+	return total, nil
+}
+
+func processMemory(pid int) (uint64, error) {
+	file, err := os.Open(fmt.Sprintf("/proc/%d/status", pid))
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || fields[0] != "VmRSS:" || fields[2] != "kB" {
+			continue
+		}
+		kb, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parse resident memory of process %v: %w", pid, err)
+		}
+		return kb * 1024, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	// Zombie processes have no memory
+	return 0, nil
+}
+
+func processChildren(pid int) []int {
+	threads, err := os.ReadDir(fmt.Sprintf("/proc/%d/task", pid))
+	if err != nil {
+		return nil
+	}
+	var children []int
+	for _, thread := range threads {
+		data, err := os.ReadFile(fmt.Sprintf("/proc/%d/task/%s/children", pid, thread.Name()))
+		if err != nil {
+			continue
+		}
+		for _, field := range strings.Fields(string(data)) {
+			if child, err := strconv.Atoi(field); err == nil {
+				children = append(children, child)
+			}
+		}
+	}
+	return children
+}
@@ -2,11 +2,13 @@ package cannon
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 
@@ -16,6 +18,19 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 )
 
+// MaxDirectPreimageSize is the largest preimage that is loaded into the pre-image oracle with a single
+// loadKeccak256PreimagePart transaction. Geth's transaction pool rejects transactions larger than 128KiB,
+// and the remainder is left for the ABI encoding of the call and the other fields of the transaction.
+const MaxDirectPreimageSize = 126 * 1024
+
+// ErrPreimageTooLarge is returned for preimages larger than MaxDirectPreimageSize. The pre-image oracle does not
+// support loading a preimage over multiple transactions, so such preimages cannot be loaded onchain.
+var ErrPreimageTooLarge = errors.New("preimage too large to load into the oracle")
+
+// ErrUnsupportedKeyType is returned for global preimages of other key types than keccak256, such as the sha256 and
+// blob field element preimages of blobs. The pre-image oracle has no function to load these.
+var ErrUnsupportedKeyType = errors.New("unsupported preimage key type")
+
 // cannonUpdater is a [types.OracleUpdater] that exposes a method
 // to update onchain cannon oracles with required data.
 type cannonUpdater struct {
@@ -104,6 +119,9 @@ func (u *cannonUpdater) sendLocalOracleData(ctx context.Context, data *types.Pre
 
 // sendGlobalOracleData sends the global oracle data to the [txmgr].
 func (u *cannonUpdater) sendGlobalOracleData(ctx context.Context, data *types.PreimageOracleData) error {
+	if size := len(data.GetPreimageWithoutSize()); size > MaxDirectPreimageSize {
+		return fmt.Errorf("%w: key %x has %d bytes, the limit is %d bytes", ErrPreimageTooLarge, data.OracleKey, size, MaxDirectPreimageSize)
+	}
 	txData, err := u.BuildGlobalOracleData(data)
 	if err != nil {
 		return fmt.Errorf("global oracle tx data build: %w", err)
@@ -127,6 +145,9 @@ func (u *cannonUpdater) BuildLocalOracleData(data *types.PreimageOracleData) ([]
 // and creates tx data to load the key, data pair into the
 // PreimageOracle contract.
 func (u *cannonUpdater) BuildGlobalOracleData(data *types.PreimageOracleData) ([]byte, error) {
+	if len(data.OracleKey) == 0 || data.OracleKey[0] != byte(preimage.Keccak256KeyType) {
+		return nil, fmt.Errorf("%w: key %x", ErrUnsupportedKeyType, data.OracleKey)
+	}
 	return u.preimageOracleAbi.Pack(
 		"loadKeccak256PreimagePart",
 		big.NewInt(int64(data.OracleOffset)),
@@ -3,11 +3,13 @@ package cannon
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/big"
 	"testing"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
@@ -76,7 +78,7 @@ func TestCannonUpdater_UpdateOracle(t *testing.T) {
 	t.Run("succeeds", func(t *testing.T) {
 		updater, mockTxMgr := newTestCannonUpdater(t, false)
 		require.NoError(t, updater.UpdateOracle(context.Background(), &types.PreimageOracleData{
-			OracleKey:  common.Hash{0xaa}.Bytes(),
+			OracleKey:  common.Hash{byte(preimage.Keccak256KeyType), 0xaa}.Bytes(),
 			OracleData: common.Hex2Bytes("cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
 		}))
 		require.Equal(t, 1, mockTxMgr.sends)
@@ -85,11 +87,34 @@ func TestCannonUpdater_UpdateOracle(t *testing.T) {
 	t.Run("send fails", func(t *testing.T) {
 		updater, mockTxMgr := newTestCannonUpdater(t, true)
 		require.Error(t, updater.UpdateOracle(context.Background(), &types.PreimageOracleData{
-			OracleKey:  common.Hash{0xaa}.Bytes(),
+			OracleKey:  common.Hash{byte(preimage.Keccak256KeyType), 0xaa}.Bytes(),
 			OracleData: common.Hex2Bytes("cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
 		}))
 		require.Equal(t, 1, mockTxMgr.failedSends)
 	})
+
+	t.Run("preimage too large", func(t *testing.T) {
+		updater, mockTxMgr := newTestCannonUpdater(t, false)
+		err := updater.UpdateOracle(context.Background(), &types.PreimageOracleData{
+			OracleKey:  common.Hash{byte(preimage.Keccak256KeyType), 0xaa}.Bytes(),
+			OracleData: make([]byte, 8+MaxDirectPreimageSize+1),
+		})
+		require.ErrorIs(t, err, ErrPreimageTooLarge)
+		require.Zero(t, mockTxMgr.sends)
+	})
+
+	for _, keyType := range []preimage.KeyType{preimage.Sha256KeyType, preimage.BlobKeyType} {
+		keyType := keyType
+		t.Run(fmt.Sprintf("unsupported key type %d", keyType), func(t *testing.T) {
+			updater, mockTxMgr := newTestCannonUpdater(t, false)
+			err := updater.UpdateOracle(context.Background(), &types.PreimageOracleData{
+				OracleKey:  common.Hash{byte(keyType), 0xaa}.Bytes(),
+				OracleData: common.Hex2Bytes("0000000000000020cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
+			})
+			require.ErrorIs(t, err, ErrUnsupportedKeyType)
+			require.Zero(t, mockTxMgr.sends)
+		})
+	}
 }
 
 // TestCannonUpdater_BuildLocalOracleData tests the [cannonUpdater]
@@ -124,7 +149,7 @@ func TestCannonUpdater_BuildLocalOracleData(t *testing.T) {
 func TestCannonUpdater_BuildGlobalOracleData(t *testing.T) {
 	updater, _ := newTestCannonUpdater(t, false)
 	oracleData := &types.PreimageOracleData{
-		OracleKey:    common.Hex2Bytes("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+		OracleKey:    common.Hex2Bytes("02aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
 		OracleData:   common.Hex2Bytes("cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"),
 		OracleOffset: 7,
 	}
@@ -0,0 +1,152 @@
+package outputs
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/split"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// OutputCannonTraceAccessor is the [types.TraceAccessor] of games that bisect the output roots of the L2 blocks down
+// to the split depth, and then bisect the cannon execution trace of the single block that the output roots were
+// disputed down to.
+type OutputCannonTraceAccessor struct {
+	*trace.Accessor
+	cannon *cannonProviderCache
+}
+
+func NewOutputCannonTraceAccessor(ctx context.Context, logger log.Logger, m cannon.CannonMetricer, cfg *config.Config, shared cannon.Shared, deadline time.Time, l1Head common.Hash, dir string, gameDepth, splitDepth, prestateBlock, poststateBlock uint64) (*OutputCannonTraceAccessor, error) {
+	if splitDepth >= gameDepth {
+		return nil, fmt.Errorf("split depth %v must be less than the game depth %v", splitDepth, gameDepth)
+	}
+	rollupClient, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, logger, cfg.RollupRpc)
+	if err != nil {
+		return nil, err
+	}
+	return NewOutputCannonTraceAccessorFromInputs(logger, m, cfg, shared, deadline, rollupClient, l1Head, dir, splitDepth, prestateBlock, poststateBlock), nil
+}
+
+func NewOutputCannonTraceAccessorFromInputs(logger log.Logger, m cannon.CannonMetricer, cfg *config.Config, shared cannon.Shared, deadline time.Time, rollupClient OutputRollupClient, l1Head common.Hash, dir string, splitDepth, prestateBlock, poststateBlock uint64) *OutputCannonTraceAccessor {
+	outputProvider := NewTraceProviderFromInputs(logger, rollupClient, splitDepth, prestateBlock, poststateBlock)
+	cannonCreator := func(ctx context.Context, localContext common.Hash, depth uint64, pre types.Claim, post types.Claim) (*cannon.CannonTraceProvider, error) {
+		localInputs, err := outputProvider.localInputs(ctx, l1Head, pre, post)
+		if err != nil {
+			return nil, fmt.Errorf("fetch local game inputs: %w", err)
+		}
+		logger := logger.New("pre", localInputs.L2OutputRoot, "post", localInputs.L2Claim, "localContext", localContext)
+		subdir := filepath.Join(dir, localContext.Hex())
+		return cannon.NewTraceProviderFromInputs(logger, m, cfg, localInputs, shared, deadline, subdir, depth), nil
+	}
+	cache := newCannonProviderCache(cannonCreator)
+	return &OutputCannonTraceAccessor{
+		Accessor: trace.NewAccessor(split.NewSplitProviderSelector(outputProvider, int(splitDepth), cache.GetOrCreate)),
+		cannon:   cache,
+	}
+}
+
+// ExecutionStatus returns the status of the executions of cannon of the bottom game that was played last.
+func (a *OutputCannonTraceAccessor) ExecutionStatus() cannon.ExecutionStatus {
+	return a.cannon.ExecutionStatus()
+}
+
+// localInputs returns the inputs of the cannon trace that transitions from the output root of the pre claim to the
+// output root of the post claim. The pre claim is empty if the transition starts at the prestate block.
+func (o *OutputTraceProvider) localInputs(ctx context.Context, l1Head common.Hash, pre types.Claim, post types.Claim) (cannon.LocalGameInputs, error) {
+	agreedBlock := o.prestateBlock
+	if pre != (types.Claim{}) {
+		block, err := o.BlockNumber(pre.Position)
+		if err != nil {
+			return cannon.LocalGameInputs{}, err
+		}
+		agreedBlock = block
+	}
+	agreedOutput, err := o.fetchOutput(ctx, agreedBlock)
+	if err != nil {
+		return cannon.LocalGameInputs{}, err
+	}
+	agreedRoot := common.Hash(agreedOutput.OutputRoot)
+	if pre != (types.Claim{}) {
+		agreedRoot = pre.Value
+	}
+	claimedBlock, err := o.BlockNumber(post.Position)
+	if err != nil {
+		return cannon.LocalGameInputs{}, err
+	}
+	return cannon.LocalGameInputs{
+		L1Head:        l1Head,
+		L2Head:        agreedOutput.BlockRef.Hash,
+		L2OutputRoot:  agreedRoot,
+		L2Claim:       post.Value,
+		L2BlockNumber: new(big.Int).SetUint64(claimedBlock),
+	}, nil
+}
+
+type cannonCreator func(ctx context.Context, localContext common.Hash, depth uint64, pre types.Claim, post types.Claim) (*cannon.CannonTraceProvider, error)
+
+// cannonProviderCache holds the cannon trace provider of every bottom game, so that the proofs of a bottom game are
+// only generated once, no matter how many claims of the bottom game are evaluated.
+type cannonProviderCache struct {
+	create cannonCreator
+
+	lock      sync.Mutex
+	providers map[common.Hash]*cannon.CannonTraceProvider
+	last      *cannon.CannonTraceProvider
+}
+
+func newCannonProviderCache(create cannonCreator) *cannonProviderCache {
+	return &cannonProviderCache{
+		create:    create,
+		providers: make(map[common.Hash]*cannon.CannonTraceProvider),
+	}
+}
+
+// GetOrCreate implements [split.ProviderCreator].
+func (c *cannonProviderCache) GetOrCreate(ctx context.Context, depth uint64, pre types.Claim, post types.Claim) (types.TraceProvider, error) {
+	localContext := bottomGameContext(pre, post)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	provider, ok := c.providers[localContext]
+	if !ok {
+		var err error
+		provider, err = c.create(ctx, localContext, depth, pre, post)
+		if err != nil {
+			return nil, err
+		}
+		c.providers[localContext] = provider
+	}
+	c.last = provider
+	return provider, nil
+}
+
+// bottomGameContext identifies the bottom game between the pre and post claims by their values and positions.
+// Claims with the same output roots at different positions are the roots of different bottom games.
+func bottomGameContext(pre types.Claim, post types.Claim) common.Hash {
+	encode := func(c types.Claim) []byte {
+		data := make([]byte, 64)
+		copy(data[:32], c.Value[:])
+		c.Position.ToGIndex().FillBytes(data[32:])
+		return data
+	}
+	return crypto.Keccak256Hash(encode(pre), encode(post))
+}
+
+func (c *cannonProviderCache) ExecutionStatus() cannon.ExecutionStatus {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.last == nil {
+		return cannon.ExecutionStatus{}
+	}
+	return c.last.ExecutionStatus()
+}
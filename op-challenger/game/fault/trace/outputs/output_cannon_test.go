@@ -0,0 +1,80 @@
+package outputs
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var l1Head = common.Hash{0x11}
+
+func TestLocalInputs(t *testing.T) {
+	t.Run("FromPrestateBlock", func(t *testing.T) {
+		provider, rollupClient := setupWithTestData(t, prestateBlock, poststateBlock)
+		rollupClient.outputs[prestateBlock].BlockRef.Hash = common.Hash{0x22}
+		post := types.Claim{ClaimData: types.ClaimData{Value: common.Hash{0x33}, Position: types.NewPosition(int(gameDepth), common.Big0)}}
+		inputs, err := provider.localInputs(context.Background(), l1Head, types.Claim{}, post)
+		require.NoError(t, err)
+		require.Equal(t, cannon.LocalGameInputs{
+			L1Head:        l1Head,
+			L2Head:        common.Hash{0x22},
+			L2OutputRoot:  prestateOutputRoot,
+			L2Claim:       common.Hash{0x33},
+			L2BlockNumber: new(big.Int).SetUint64(prestateBlock + 1),
+		}, inputs)
+	})
+
+	t.Run("FromPreClaim", func(t *testing.T) {
+		provider, rollupClient := setupWithTestData(t, prestateBlock, poststateBlock)
+		rollupClient.outputs[101].BlockRef.Hash = common.Hash{0x22}
+		pre := types.Claim{ClaimData: types.ClaimData{Value: common.Hash{0x44}, Position: types.NewPosition(int(gameDepth), common.Big0)}}
+		post := types.Claim{ClaimData: types.ClaimData{Value: common.Hash{0x33}, Position: types.NewPosition(0, common.Big0)}}
+		inputs, err := provider.localInputs(context.Background(), l1Head, pre, post)
+		require.NoError(t, err)
+		require.Equal(t, cannon.LocalGameInputs{
+			L1Head:        l1Head,
+			L2Head:        common.Hash{0x22},
+			L2OutputRoot:  common.Hash{0x44},
+			L2Claim:       common.Hash{0x33},
+			L2BlockNumber: new(big.Int).SetUint64(poststateBlock),
+		}, inputs)
+	})
+}
+
+func TestCannonProviderCache(t *testing.T) {
+	created := 0
+	cache := newCannonProviderCache(func(ctx context.Context, localContext common.Hash, depth uint64, pre types.Claim, post types.Claim) (*cannon.CannonTraceProvider, error) {
+		created++
+		return &cannon.CannonTraceProvider{}, nil
+	})
+	pre := types.Claim{ClaimData: types.ClaimData{Value: common.Hash{0x01}}}
+	post := types.Claim{ClaimData: types.ClaimData{Value: common.Hash{0x02}}}
+	other := types.Claim{ClaimData: types.ClaimData{Value: common.Hash{0x03}}}
+
+	first, err := cache.GetOrCreate(context.Background(), 10, pre, post)
+	require.NoError(t, err)
+	again, err := cache.GetOrCreate(context.Background(), 10, pre, post)
+	require.NoError(t, err)
+	require.Same(t, first, again)
+	require.Equal(t, 1, created)
+
+	third, err := cache.GetOrCreate(context.Background(), 10, post, other)
+	require.NoError(t, err)
+	require.NotSame(t, first, third)
+	require.Equal(t, 2, created)
+	require.Same(t, third, cache.last)
+
+	// the same output roots at other positions are the roots of another bottom game
+	movedPre := types.Claim{ClaimData: types.ClaimData{Value: pre.Value, Position: types.NewPosition(2, common.Big1)}}
+	movedPost := types.Claim{ClaimData: types.ClaimData{Value: post.Value, Position: types.NewPosition(2, common.Big2)}}
+	moved, err := cache.GetOrCreate(context.Background(), 10, movedPre, movedPost)
+	require.NoError(t, err)
+	require.NotSame(t, first, moved)
+	require.Equal(t, 3, created)
+	require.NotEqual(t, bottomGameContext(pre, post), bottomGameContext(movedPre, movedPost))
+}
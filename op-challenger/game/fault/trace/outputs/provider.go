@@ -2,11 +2,13 @@ package outputs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/rpcerrors"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
@@ -15,6 +17,10 @@ import (
 var (
 	GetStepDataErr      = fmt.Errorf("GetStepData not supported")
 	AbsolutePreStateErr = fmt.Errorf("AbsolutePreState not supported")
+
+	// ErrOutputUnavailable is returned when the rollup node cannot provide the output yet,
+	// e.g. because it has not synced the block, or the block is being reorged. The request can be retried later.
+	ErrOutputUnavailable = errors.New("output unavailable")
 )
 
 var _ types.TraceProvider = (*OutputTraceProvider)(nil)
@@ -52,30 +58,29 @@ func NewTraceProviderFromInputs(logger log.Logger, rollupClient OutputRollupClie
 }
 
 func (o *OutputTraceProvider) Get(ctx context.Context, pos types.Position) (common.Hash, error) {
+	outputBlock, err := o.BlockNumber(pos)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return o.outputAtBlock(ctx, outputBlock)
+}
+
+// BlockNumber returns the L2 block number of the output root at the position.
+func (o *OutputTraceProvider) BlockNumber(pos types.Position) (uint64, error) {
 	traceIndex := pos.TraceIndex(int(o.gameDepth))
 	if !traceIndex.IsUint64() {
-		return common.Hash{}, fmt.Errorf("trace index %v is greater than max uint64", traceIndex)
+		return 0, fmt.Errorf("trace index %v is greater than max uint64", traceIndex)
 	}
 	outputBlock := traceIndex.Uint64() + o.prestateBlock + 1
 	if outputBlock > o.poststateBlock {
 		outputBlock = o.poststateBlock
 	}
-	output, err := o.rollupClient.OutputAtBlock(ctx, outputBlock)
-	if err != nil {
-		o.logger.Error("Failed to fetch output", "blockNumber", outputBlock, "err", err)
-		return common.Hash{}, err
-	}
-	return common.Hash(output.OutputRoot), nil
+	return outputBlock, nil
 }
 
 // AbsolutePreStateCommitment returns the absolute prestate at the configured prestateBlock.
 func (o *OutputTraceProvider) AbsolutePreStateCommitment(ctx context.Context) (hash common.Hash, err error) {
-	output, err := o.rollupClient.OutputAtBlock(ctx, o.prestateBlock)
-	if err != nil {
-		o.logger.Error("Failed to fetch output", "blockNumber", o.prestateBlock, "err", err)
-		return common.Hash{}, err
-	}
-	return common.Hash(output.OutputRoot), nil
+	return o.outputAtBlock(ctx, o.prestateBlock)
 }
 
 // AbsolutePreState is not supported in the [OutputTraceProvider].
@@ -87,3 +92,23 @@ func (o *OutputTraceProvider) AbsolutePreState(ctx context.Context) (preimage []
 func (o *OutputTraceProvider) GetStepData(ctx context.Context, pos types.Position) (prestate []byte, proofData []byte, preimageData *types.PreimageOracleData, err error) {
 	return nil, nil, nil, GetStepDataErr
 }
+
+func (o *OutputTraceProvider) outputAtBlock(ctx context.Context, blockNum uint64) (common.Hash, error) {
+	output, err := o.fetchOutput(ctx, blockNum)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.Hash(output.OutputRoot), nil
+}
+
+func (o *OutputTraceProvider) fetchOutput(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	output, err := o.rollupClient.OutputAtBlock(ctx, blockNum)
+	if rpcerrors.IsNotFound(err) || rpcerrors.IsReorged(err) || rpcerrors.IsTemporarilyUnavailable(err) {
+		o.logger.Warn("Output is not available yet", "blockNumber", blockNum, "err", err)
+		return nil, fmt.Errorf("%w at block %d: %w", ErrOutputUnavailable, blockNum, err)
+	} else if err != nil {
+		o.logger.Error("Failed to fetch output", "blockNumber", blockNum, "err", err)
+		return nil, err
+	}
+	return output, nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/rpcerrors"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -58,6 +59,21 @@ func TestGet(t *testing.T) {
 		require.ErrorAs(t, fmt.Errorf("no output at block %d", prestateBlock+2), &err)
 	})
 
+	t.Run("OutputNotSyncedYet", func(t *testing.T) {
+		provider, rollupClient := setupWithTestData(t, prestateBlock, poststateBlock)
+		rollupClient.err = rpcerrors.NotFound("not found")
+		_, err := provider.Get(context.Background(), types.NewPositionFromGIndex(big.NewInt(128)))
+		require.ErrorIs(t, err, ErrOutputUnavailable)
+		require.True(t, rpcerrors.IsNotFound(err))
+	})
+
+	t.Run("OutputReorged", func(t *testing.T) {
+		provider, rollupClient := setupWithTestData(t, prestateBlock, poststateBlock)
+		rollupClient.err = rpcerrors.Reorged("reorged")
+		_, err := provider.Get(context.Background(), types.NewPositionFromGIndex(big.NewInt(128)))
+		require.ErrorIs(t, err, ErrOutputUnavailable)
+	})
+
 	t.Run("PostStateBlock", func(t *testing.T) {
 		provider, _ := setupWithTestData(t, prestateBlock, poststateBlock)
 		value, err := provider.Get(context.Background(), types.NewPositionFromGIndex(big.NewInt(228)))
@@ -130,10 +146,14 @@ func setupWithTestData(t *testing.T, prestateBlock, poststateBlock uint64, custo
 
 type stubRollupClient struct {
 	errorsOnPrestateFetch bool
+	err                   error
 	outputs               map[uint64]*eth.OutputResponse
 }
 
 func (s *stubRollupClient) OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
 	output, ok := s.outputs[blockNum]
 	if !ok || s.errorsOnPrestateFetch {
 		return nil, fmt.Errorf("no output at block %d", blockNum)
@@ -0,0 +1,104 @@
+package split
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+)
+
+var (
+	errRefClaimNotDeepEnough = errors.New("reference claim is not deep enough")
+)
+
+// ProviderCreator creates the [types.TraceProvider] of the bottom game that disputes the transition from the output
+// root of the pre claim to the output root of the post claim, with a trace of the depth.
+// The pre claim is empty if the post claim is the first output root of the top game, which transitions from the
+// output root that the game starts from.
+type ProviderCreator func(ctx context.Context, depth uint64, pre types.Claim, post types.Claim) (types.TraceProvider, error)
+
+// NewSplitProviderSelector creates a [trace.ProviderSelector] for games that are split at topDepth.
+// The positions up to and including topDepth are served by the topProvider.
+// The positions below topDepth are served by the provider of the bottom game that they are in, which is created with
+// the bottomProviderCreator from the claims of the top game that the bottom game disputes the transition between.
+func NewSplitProviderSelector(topProvider types.TraceProvider, topDepth int, bottomProviderCreator ProviderCreator) trace.ProviderSelector {
+	return func(ctx context.Context, game types.Game, ref types.Claim, pos types.Position) (types.Position, types.TraceProvider, error) {
+		if pos.Depth() <= topDepth {
+			return pos, topProvider, nil
+		}
+		if ref.Depth() < topDepth {
+			return types.Position{}, nil, fmt.Errorf("%w, claim depth: %v, depth required: %v", errRefClaimNotDeepEnough, ref.Depth(), topDepth)
+		}
+
+		// Find the ancestor claim at the leaf level of the top game.
+		topLeaf, err := findAncestorAtDepth(game, ref, topDepth)
+		if err != nil {
+			return types.Position{}, nil, err
+		}
+
+		var pre, post types.Claim
+		// If pos is to the right of the leaf of the top game, the leaf is defended, otherwise it is attacked.
+		if pos.TraceIndex(pos.Depth()).Cmp(topLeaf.TraceIndex(pos.Depth())) > 0 {
+			// Defending the top leaf, so it is the pre claim and the post claim is further up the tree
+			pre = topLeaf
+			postTraceIdx := new(big.Int).Add(pre.TraceIndex(topDepth), big.NewInt(1))
+			post, err = findAncestorWithTraceIndex(game, topLeaf, topDepth, postTraceIdx)
+			if err != nil {
+				return types.Position{}, nil, fmt.Errorf("failed to find post claim: %w", err)
+			}
+		} else {
+			// Attacking the top leaf, so it is the post claim and the pre claim is further up the tree
+			post = topLeaf
+			preTraceIdx := new(big.Int).Sub(post.TraceIndex(topDepth), big.NewInt(1))
+			if preTraceIdx.Sign() >= 0 {
+				pre, err = findAncestorWithTraceIndex(game, topLeaf, topDepth, preTraceIdx)
+				if err != nil {
+					return types.Position{}, nil, fmt.Errorf("failed to find pre claim: %w", err)
+				}
+			}
+		}
+		// The top game includes topDepth, so the bottom game starts one level below it.
+		bottomDepth := game.MaxDepth() - uint64(topDepth) - 1
+		provider, err := bottomProviderCreator(ctx, bottomDepth, pre, post)
+		if err != nil {
+			return types.Position{}, nil, err
+		}
+		relativePos, err := pos.RelativeToAncestorAtDepth(uint64(topDepth + 1))
+		if err != nil {
+			return types.Position{}, nil, err
+		}
+		return relativePos, provider, nil
+	}
+}
+
+// findAncestorAtDepth returns the claim at depth that claim descends from, or claim itself if it is at depth.
+func findAncestorAtDepth(game types.Game, claim types.Claim, depth int) (types.Claim, error) {
+	for claim.Depth() > depth {
+		parent, err := game.GetParent(claim)
+		if err != nil {
+			return types.Claim{}, fmt.Errorf("failed to find ancestor at depth %v: %w", depth, err)
+		}
+		claim = parent
+	}
+	return claim, nil
+}
+
+// findAncestorWithTraceIndex returns the first claim in the path from claim to the root of the game whose trace index
+// at depth is traceIdx, including claim itself.
+func findAncestorWithTraceIndex(game types.Game, claim types.Claim, depth int, traceIdx *big.Int) (types.Claim, error) {
+	candidate := claim
+	for candidate.TraceIndex(depth).Cmp(traceIdx) != 0 {
+		if candidate.IsRoot() {
+			return types.Claim{}, fmt.Errorf("no ancestor found with trace index %v", traceIdx)
+		}
+		parent, err := game.GetParent(candidate)
+		if err != nil {
+			return types.Claim{}, fmt.Errorf("failed to get parent of claim %v: %w", candidate.ContractIndex, err)
+		}
+		candidate = parent
+	}
+	return candidate, nil
+}
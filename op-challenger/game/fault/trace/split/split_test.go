@@ -0,0 +1,134 @@
+package split
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	gameDepth  = 6
+	splitDepth = 2
+)
+
+func TestUseTopProvider(t *testing.T) {
+	top, selector, _ := setupSplitSelector()
+	game, claims := createSplitGame()
+	pos := types.NewPosition(splitDepth, big.NewInt(1))
+	relativePos, provider, err := selector(context.Background(), game, claims.root, pos)
+	require.NoError(t, err)
+	require.Same(t, top, provider)
+	require.Equal(t, pos, relativePos)
+}
+
+func TestErrorWhenRefNotDeepEnough(t *testing.T) {
+	_, selector, _ := setupSplitSelector()
+	game, claims := createSplitGame()
+	_, _, err := selector(context.Background(), game, claims.root, types.NewPosition(splitDepth+1, big.NewInt(0)))
+	require.ErrorIs(t, err, errRefClaimNotDeepEnough)
+}
+
+func TestAttackTopLeaf(t *testing.T) {
+	_, selector, creator := setupSplitSelector()
+	game, claims := createSplitGame()
+	pos := claims.topLeaf.Attack()
+	relativePos, provider, err := selector(context.Background(), game, claims.topLeaf, pos)
+	require.NoError(t, err)
+	require.Same(t, creator.provider, provider)
+	require.Equal(t, types.NewPosition(0, big.NewInt(0)), relativePos)
+	require.Equal(t, uint64(gameDepth-splitDepth-1), creator.depth)
+	require.Equal(t, claims.preLeaf, creator.pre)
+	require.Equal(t, claims.topLeaf, creator.post)
+}
+
+func TestDefendTopLeaf(t *testing.T) {
+	_, selector, creator := setupSplitSelector()
+	game, claims := createSplitGame()
+	pos := claims.topLeaf.Defend()
+	relativePos, _, err := selector(context.Background(), game, claims.topLeaf, pos)
+	require.NoError(t, err)
+	require.Equal(t, types.NewPosition(0, big.NewInt(0)), relativePos)
+	require.Equal(t, claims.topLeaf, creator.pre)
+	require.Equal(t, claims.root, creator.post)
+}
+
+func TestDeepBottomPosition(t *testing.T) {
+	_, selector, creator := setupSplitSelector()
+	game, claims := createSplitGame()
+	pos := claims.bottomRoot.Attack().Attack()
+	relativePos, _, err := selector(context.Background(), game, claims.bottomRoot, pos)
+	require.NoError(t, err)
+	require.Equal(t, types.NewPosition(2, big.NewInt(0)), relativePos)
+	require.Equal(t, claims.preLeaf, creator.pre)
+	require.Equal(t, claims.topLeaf, creator.post)
+}
+
+func TestAttackFirstTopLeaf(t *testing.T) {
+	_, selector, creator := setupSplitSelector()
+	game, claims := createSplitGame()
+	pos := claims.firstLeaf.Attack()
+	_, _, err := selector(context.Background(), game, claims.firstLeaf, pos)
+	require.NoError(t, err)
+	require.Equal(t, types.Claim{}, creator.pre, "should start from the prestate")
+	require.Equal(t, claims.firstLeaf, creator.post)
+}
+
+type splitClaims struct {
+	root       types.Claim
+	preLeaf    types.Claim
+	topLeaf    types.Claim
+	bottomRoot types.Claim
+	firstLeaf  types.Claim
+}
+
+// createSplitGame creates a game with the top leaf at trace index 2, which defends the claim at trace index 1,
+// and the first top leaf at trace index 0.
+func createSplitGame() (types.Game, splitClaims) {
+	root := newClaim(0, types.NewPosition(0, common.Big0), 0)
+	preLeaf := newClaim(1, root.Attack(), root.ContractIndex)
+	topLeaf := newClaim(2, preLeaf.Defend(), preLeaf.ContractIndex)
+	bottomRoot := newClaim(3, topLeaf.Attack(), topLeaf.ContractIndex)
+	firstLeaf := newClaim(4, preLeaf.Attack(), preLeaf.ContractIndex)
+	claims := []types.Claim{root, preLeaf, topLeaf, bottomRoot, firstLeaf}
+	return types.NewGameState(false, claims, gameDepth), splitClaims{
+		root:       root,
+		preLeaf:    preLeaf,
+		topLeaf:    topLeaf,
+		bottomRoot: bottomRoot,
+		firstLeaf:  firstLeaf,
+	}
+}
+
+func newClaim(idx int, pos types.Position, parentIdx int) types.Claim {
+	return types.Claim{
+		ClaimData:           types.ClaimData{Value: common.Hash{byte(idx + 1)}, Position: pos},
+		ContractIndex:       idx,
+		ParentContractIndex: parentIdx,
+	}
+}
+
+func setupSplitSelector() (*mockTraceProvider, trace.ProviderSelector, *capturingCreator) {
+	top := &mockTraceProvider{}
+	creator := &capturingCreator{provider: &mockTraceProvider{}}
+	selector := NewSplitProviderSelector(top, splitDepth, creator.Create)
+	return top, selector, creator
+}
+
+type capturingCreator struct {
+	provider *mockTraceProvider
+	depth    uint64
+	pre      types.Claim
+	post     types.Claim
+}
+
+func (c *capturingCreator) Create(_ context.Context, depth uint64, pre types.Claim, post types.Claim) (types.TraceProvider, error) {
+	c.depth = depth
+	c.pre = pre
+	c.post = post
+	return c.provider, nil
+}
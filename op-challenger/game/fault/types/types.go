@@ -76,6 +76,22 @@ type TraceProvider interface {
 	AbsolutePreStateCommitment(ctx context.Context) (hash common.Hash, err error)
 }
 
+// TraceAccessor provides the trace of a game, that can be played with a different [TraceProvider] in parts of the game.
+// The ref claim is the claim that the requested position is evaluated for, which selects the [TraceProvider] to use.
+type TraceAccessor interface {
+	// Get returns the claim value at the requested position.
+	Get(ctx context.Context, game Game, ref Claim, pos Position) (common.Hash, error)
+
+	// GetStepData returns the data required to execute the step at the requested position, see [TraceProvider].
+	GetStepData(ctx context.Context, game Game, ref Claim, pos Position) (prestate []byte, proofData []byte, preimageData *PreimageOracleData, err error)
+}
+
+// PrestateProvider provides the commitment of the absolute pre-state that the trace of a game starts from,
+// which must match the absolute prestate of the game contract.
+type PrestateProvider interface {
+	AbsolutePreStateCommitment(ctx context.Context) (hash common.Hash, err error)
+}
+
 // ClaimData is the core of a claim. It must be unique inside a specific game.
 type ClaimData struct {
 	Value common.Hash
@@ -100,7 +116,10 @@ type Claim struct {
 	//       When caching is implemented for the Challenger, this will need
 	//       to be changed/removed to avoid invalid/stale contract state.
 	Countered bool
-	Clock     uint64
+	// Clock is the timestamp of the claim, and Duration the time that the team of the claim had used on its chess
+	// clock when the claim was made. The contract packs both into the clock of the claim.
+	Clock    uint64
+	Duration uint64
 	// Location of the claim & it's parent inside the contract. Does not exist
 	// for claims that have not made it to the contract.
 	ContractIndex       int
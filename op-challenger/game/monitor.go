@@ -30,6 +30,11 @@ type gameScheduler interface {
 	Schedule([]types.GameMetadata) error
 }
 
+// gameBlacklist reports the games that must not be played, see store.Store.
+type gameBlacklist interface {
+	IsBlacklisted(addr common.Address) (bool, error)
+}
+
 type gameMonitor struct {
 	logger           log.Logger
 	clock            clock.Clock
@@ -38,6 +43,7 @@ type gameMonitor struct {
 	gameWindow       time.Duration
 	fetchBlockNumber blockNumberFetcher
 	allowedGames     []common.Address
+	blacklist        gameBlacklist
 	l1HeadsSub       ethereum.Subscription
 	l1Source         *headSource
 }
@@ -62,6 +68,7 @@ func newGameMonitor(
 	gameWindow time.Duration,
 	fetchBlockNumber blockNumberFetcher,
 	allowedGames []common.Address,
+	blacklist gameBlacklist,
 	l1Source MinimalSubscriber,
 ) *gameMonitor {
 	return &gameMonitor{
@@ -72,6 +79,7 @@ func newGameMonitor(
 		gameWindow:       gameWindow,
 		fetchBlockNumber: fetchBlockNumber,
 		allowedGames:     allowedGames,
+		blacklist:        blacklist,
 		l1Source:         &headSource{inner: l1Source},
 	}
 }
@@ -88,6 +96,18 @@ func (m *gameMonitor) allowedGame(game common.Address) bool {
 	return false
 }
 
+func (m *gameMonitor) blacklistedGame(game common.Address) bool {
+	if m.blacklist == nil {
+		return false
+	}
+	blacklisted, err := m.blacklist.IsBlacklisted(game)
+	if err != nil {
+		m.logger.Error("Failed to check if game is blacklisted", "game", game, "err", err)
+		return false
+	}
+	return blacklisted
+}
+
 func (m *gameMonitor) minGameTimestamp() uint64 {
 	if m.gameWindow.Seconds() == 0 {
 		return 0
@@ -111,6 +131,10 @@ func (m *gameMonitor) progressGames(ctx context.Context, blockNum uint64) error
 			m.logger.Debug("Skipping game not on allow list", "game", game.Proxy)
 			continue
 		}
+		if m.blacklistedGame(game.Proxy) {
+			m.logger.Debug("Skipping blacklisted game", "game", game.Proxy)
+			continue
+		}
 		gamesToPlay = append(gamesToPlay, game)
 	}
 	if err := m.scheduler.Schedule(gamesToPlay); errors.Is(err, scheduler.ErrBusy) {
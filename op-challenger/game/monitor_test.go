@@ -167,6 +167,25 @@ func newFDG(proxy common.Address, timestamp uint64) types.GameMetadata {
 	}
 }
 
+func TestMonitorSkipBlacklistedGames(t *testing.T) {
+	addr1 := common.Address{0xaa}
+	addr2 := common.Address{0xbb}
+	monitor, source, sched, _ := setupMonitorTest(t, []common.Address{})
+	monitor.blacklist = stubBlacklist{addr1: true}
+	source.games = []types.GameMetadata{newFDG(addr1, 9999), newFDG(addr2, 9999)}
+
+	require.NoError(t, monitor.progressGames(context.Background(), uint64(1)))
+
+	require.Len(t, sched.scheduled, 1)
+	require.Equal(t, []common.Address{addr2}, sched.scheduled[0])
+}
+
+type stubBlacklist map[common.Address]bool
+
+func (s stubBlacklist) IsBlacklisted(addr common.Address) (bool, error) {
+	return s[addr], nil
+}
+
 func setupMonitorTest(
 	t *testing.T,
 	allowedGames []common.Address,
@@ -188,6 +207,7 @@ func setupMonitorTest(
 		time.Duration(0),
 		fetchBlockNum,
 		allowedGames,
+		nil,
 		mockHeadSource,
 	)
 	return monitor, source, sched, mockHeadSource
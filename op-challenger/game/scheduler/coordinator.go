@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
 
@@ -20,11 +21,13 @@ type gameState struct {
 	player   GamePlayer
 	inflight bool
 	status   types.GameStatus
+	// archivePending is true if the game is resolved, but the player failed to archive it yet
+	archivePending bool
 }
 
 // coordinator manages the set of current games, queues games to be played (on separate worker threads) and
-// cleans up data files once a game is resolved.
-// All function calls must be made on the same thread.
+// cleans up data files once a game is resolved and archived.
+// All function calls must be made on the same thread, except games, pause and resume, which may be called from any thread.
 type coordinator struct {
 	// jobQueue is the outgoing queue for jobs being sent to workers for progression
 	jobQueue chan<- job
@@ -35,8 +38,13 @@ type coordinator struct {
 	logger       log.Logger
 	m            SchedulerMetricer
 	createPlayer PlayerCreator
-	states       map[common.Address]*gameState
 	disk         DiskManager
+
+	// mu guards states and paused, which are only modified on the coordinator's thread with mu held,
+	// so that they can be read on other threads.
+	mu     sync.Mutex
+	states map[common.Address]*gameState
+	paused map[common.Address]bool
 }
 
 // schedule takes the current list of games to attempt to progress, filters out games that have previous
@@ -46,6 +54,7 @@ type coordinator struct {
 // all games even if an error occurs with one game.
 func (c *coordinator) schedule(ctx context.Context, games []types.GameMetadata) error {
 	// First remove any game states we no longer require
+	c.mu.Lock()
 	for addr, state := range c.states {
 		if !state.inflight && !slices.ContainsFunc(games, func(candidate types.GameMetadata) bool {
 			return candidate.Proxy == addr
@@ -53,6 +62,7 @@ func (c *coordinator) schedule(ctx context.Context, games []types.GameMetadata)
 			delete(c.states, addr)
 		}
 	}
+	c.mu.Unlock()
 
 	var gamesInProgress int
 	var gamesChallengerWon int
@@ -97,6 +107,8 @@ func (c *coordinator) schedule(ctx context.Context, games []types.GameMetadata)
 // createJob updates the state for the specified game and returns the job to enqueue for it, if any
 // Returns (nil, nil) when there is no error and no job to enqueue
 func (c *coordinator) createJob(game types.GameMetadata) (*job, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	state, ok := c.states[game.Proxy]
 	if !ok {
 		state = &gameState{}
@@ -115,8 +127,12 @@ func (c *coordinator) createJob(game types.GameMetadata) (*job, error) {
 		state.player = player
 		state.status = player.Status()
 	}
+	if c.paused[game.Proxy] {
+		c.logger.Debug("Not scheduling paused game", "game", game)
+		return nil, nil
+	}
 	state.inflight = true
-	if state.status != types.GameStatusInProgress {
+	if state.status != types.GameStatusInProgress && !state.archivePending {
 		c.logger.Debug("Not rescheduling resolved game", "game", game, "status", state.status)
 		return nil, nil
 	}
@@ -139,12 +155,16 @@ func (c *coordinator) enqueueJob(ctx context.Context, j job) error {
 }
 
 func (c *coordinator) processResult(j job) error {
+	c.mu.Lock()
 	state, ok := c.states[j.addr]
 	if !ok {
+		c.mu.Unlock()
 		return fmt.Errorf("game %v received unexpected result: %w", j.addr, errUnknownGame)
 	}
 	state.inflight = false
 	state.status = j.status
+	state.archivePending = j.archivePending
+	c.mu.Unlock()
 	c.deleteResolvedGameFiles()
 	c.m.RecordGameUpdateCompleted()
 	return nil
@@ -153,7 +173,7 @@ func (c *coordinator) processResult(j job) error {
 func (c *coordinator) deleteResolvedGameFiles() {
 	var keepGames []common.Address
 	for addr, state := range c.states {
-		if state.status == types.GameStatusInProgress || state.inflight {
+		if state.status == types.GameStatusInProgress || state.inflight || state.archivePending {
 			keepGames = append(keepGames, addr)
 		}
 	}
@@ -162,6 +182,41 @@ func (c *coordinator) deleteResolvedGameFiles() {
 	}
 }
 
+// games returns the state of the games that the coordinator tracks, sorted by address.
+func (c *coordinator) games() []GameState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	games := make([]GameState, 0, len(c.states))
+	for addr, state := range c.states {
+		games = append(games, GameState{
+			Proxy:    addr,
+			Status:   state.status,
+			Inflight: state.inflight,
+			Paused:   c.paused[addr],
+			Player:   state.player,
+		})
+	}
+	slices.SortFunc(games, func(a, b GameState) int {
+		return a.Proxy.Cmp(b.Proxy)
+	})
+	return games
+}
+
+// pause stops scheduling the game until it is resumed. The progression of the game that is in flight completes.
+// Games that are not tracked yet can be paused, so that they are not played once they are discovered.
+func (c *coordinator) pause(addr common.Address) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.paused[addr] = true
+}
+
+// resume schedules the paused game again.
+func (c *coordinator) resume(addr common.Address) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.paused, addr)
+}
+
 func newCoordinator(logger log.Logger, m SchedulerMetricer, jobQueue chan<- job, resultQueue <-chan job, createPlayer PlayerCreator, disk DiskManager) *coordinator {
 	return &coordinator{
 		logger:       logger,
@@ -171,5 +226,6 @@ func newCoordinator(logger log.Logger, m SchedulerMetricer, jobQueue chan<- job,
 		createPlayer: createPlayer,
 		disk:         disk,
 		states:       make(map[common.Address]*gameState),
+		paused:       make(map[common.Address]bool),
 	}
 }
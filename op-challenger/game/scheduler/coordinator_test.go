@@ -50,6 +50,37 @@ func TestSkipSchedulingInflightGames(t *testing.T) {
 	require.Len(t, workQueue, 1, "should not reschedule in-flight game")
 }
 
+func TestSkipSchedulingPausedGames(t *testing.T) {
+	c, workQueue, _, games, _ := setupCoordinatorTest(t, 10)
+	gameAddr1 := common.Address{0xaa}
+	ctx := context.Background()
+
+	c.pause(gameAddr1)
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1)))
+	require.Len(t, games.created, 1, "should create player for paused game")
+	require.Empty(t, workQueue, "should not schedule paused game")
+
+	c.resume(gameAddr1)
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1)))
+	require.Len(t, workQueue, 1, "should schedule resumed game")
+}
+
+func TestListGames(t *testing.T) {
+	c, workQueue, _, games, _ := setupCoordinatorTest(t, 10)
+	gameAddr1 := common.Address{0xaa}
+	gameAddr2 := common.Address{0xbb}
+	ctx := context.Background()
+
+	c.pause(gameAddr1)
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr2, gameAddr1)))
+	require.Len(t, workQueue, 1)
+
+	require.Equal(t, []GameState{
+		{Proxy: gameAddr1, Status: types.GameStatusInProgress, Paused: true, Player: games.created[gameAddr1]},
+		{Proxy: gameAddr2, Status: types.GameStatusInProgress, Inflight: true, Player: games.created[gameAddr2]},
+	}, c.games())
+}
+
 func TestExitWhenContextDoneWhileSchedulingJob(t *testing.T) {
 	// No space in buffer to schedule a job
 	c, workQueue, _, _, _ := setupCoordinatorTest(t, 0)
@@ -172,6 +203,31 @@ func TestDeleteDataForResolvedGames(t *testing.T) {
 	require.True(t, disk.gameDirExists[gameAddr3], "game 3 data should be preserved (inflight)")
 }
 
+func TestRescheduleResolvedGameUntilArchived(t *testing.T) {
+	c, workQueue, _, _, disk := setupCoordinatorTest(t, 10)
+	gameAddr1 := common.Address{0xaa}
+	ctx := context.Background()
+
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1)))
+	require.Len(t, workQueue, 1)
+	j := <-workQueue
+	// The game resolved, but archiving it failed
+	j.status = types.GameStatusDefenderWon
+	j.archivePending = true
+	require.NoError(t, c.processResult(j))
+	require.True(t, disk.gameDirExists[gameAddr1], "game data should be preserved until archived")
+
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1)))
+	require.Len(t, workQueue, 1, "should reschedule the game to retry archiving it")
+	j = <-workQueue
+	j.archivePending = false
+	require.NoError(t, c.processResult(j))
+	require.False(t, disk.gameDirExists[gameAddr1], "game data should be deleted once archived")
+
+	require.NoError(t, c.schedule(ctx, asGames(gameAddr1)))
+	require.Empty(t, workQueue, "should not reschedule the archived game")
+}
+
 func TestDoNotDeleteDataForGameThatFailedToCreatePlayer(t *testing.T) {
 	c, workQueue, _, games, disk := setupCoordinatorTest(t, 10)
 	gameAddr1 := common.Address{0xaa}
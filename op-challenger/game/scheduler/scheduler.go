@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -93,6 +94,21 @@ func (s *Scheduler) Schedule(games []types.GameMetadata) error {
 	}
 }
 
+// Games returns the state of the games that are tracked, sorted by address.
+func (s *Scheduler) Games() []GameState {
+	return s.coordinator.games()
+}
+
+// PauseGame stops progressing the game until ResumeGame is called.
+func (s *Scheduler) PauseGame(addr common.Address) {
+	s.coordinator.pause(addr)
+}
+
+// ResumeGame progresses the paused game again on the next schedule.
+func (s *Scheduler) ResumeGame(addr common.Address) {
+	s.coordinator.resume(addr)
+}
+
 func (s *Scheduler) loop(ctx context.Context) {
 	defer s.wg.Done()
 	for {
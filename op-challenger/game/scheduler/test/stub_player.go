@@ -8,10 +8,11 @@ import (
 )
 
 type StubGamePlayer struct {
-	Addr          common.Address
-	ProgressCount int
-	StatusValue   types.GameStatus
-	Dir           string
+	Addr                common.Address
+	ProgressCount       int
+	StatusValue         types.GameStatus
+	ArchivePendingValue bool
+	Dir                 string
 }
 
 func (g *StubGamePlayer) ProgressGame(_ context.Context) types.GameStatus {
@@ -22,3 +23,7 @@ func (g *StubGamePlayer) ProgressGame(_ context.Context) types.GameStatus {
 func (g *StubGamePlayer) Status() types.GameStatus {
 	return g.StatusValue
 }
+
+func (g *StubGamePlayer) ArchivePending() bool {
+	return g.ArchivePendingValue
+}
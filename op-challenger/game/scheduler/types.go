@@ -13,13 +13,32 @@ type GamePlayer interface {
 	Status() types.GameStatus
 }
 
+// archivingPlayer is implemented by players that archive the data of a game once it is resolved.
+// Resolved games are progressed until they are archived, and their data is kept until then.
+type archivingPlayer interface {
+	ArchivePending() bool
+}
+
 type DiskManager interface {
 	DirForGame(addr common.Address) string
 	RemoveAllExcept(addrs []common.Address) error
 }
 
+// GameState is the state of a game that the scheduler tracks.
+type GameState struct {
+	Proxy  common.Address
+	Status types.GameStatus
+	// Inflight is true while the game is being progressed, and stays true once it is resolved.
+	Inflight bool
+	// Paused is true if the game is not scheduled to be progressed, see Scheduler.PauseGame.
+	Paused bool
+	// Player is nil if the player of the game could not be created yet.
+	Player GamePlayer
+}
+
 type job struct {
-	addr   common.Address
-	player GamePlayer
-	status types.GameStatus
+	addr           common.Address
+	player         GamePlayer
+	status         types.GameStatus
+	archivePending bool
 }
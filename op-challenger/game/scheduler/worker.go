@@ -6,7 +6,7 @@ import (
 )
 
 // progressGames accepts jobs from in channel, calls ProgressGame on the job.player and returns the job
-// with updated job.resolved via the out channel.
+// with updated job.status and job.archivePending via the out channel.
 // The loop exits when the ctx is done.  wg.Done() is called when the function returns.
 func progressGames(ctx context.Context, in <-chan job, out chan<- job, wg *sync.WaitGroup, threadActive, threadIdle func()) {
 	defer wg.Done()
@@ -17,6 +17,9 @@ func progressGames(ctx context.Context, in <-chan job, out chan<- job, wg *sync.
 		case j := <-in:
 			threadActive()
 			j.status = j.player.ProgressGame(ctx)
+			if p, ok := j.player.(archivingPlayer); ok {
+				j.archivePending = p.ArchivePending()
+			}
 			out <- j
 			threadIdle()
 		}
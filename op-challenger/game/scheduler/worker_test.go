@@ -35,7 +35,7 @@ func TestWorkerShouldProcessJobsUntilContextDone(t *testing.T) {
 	require.Equal(t, ms.idleCalls, 1)
 
 	in <- job{
-		player: &test.StubGamePlayer{StatusValue: types.GameStatusDefenderWon},
+		player: &test.StubGamePlayer{StatusValue: types.GameStatusDefenderWon, ArchivePendingValue: true},
 	}
 	waitErr = wait.For(context.Background(), 100*time.Millisecond, func() (bool, error) {
 		return ms.activeCalls >= 2, nil
@@ -49,6 +49,8 @@ func TestWorkerShouldProcessJobsUntilContextDone(t *testing.T) {
 
 	require.Equal(t, result1.status, types.GameStatusInProgress)
 	require.Equal(t, result2.status, types.GameStatusDefenderWon)
+	require.False(t, result1.archivePending)
+	require.True(t, result2.archivePending)
 
 	// Cancel the context which should exit the worker
 	cancel()
@@ -4,20 +4,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/archive"
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/fault"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/loader"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/registry"
 	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/store"
 	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-challenger/notify"
+	"github.com/ethereum-optimism/optimism/op-challenger/rpc"
 	"github.com/ethereum-optimism/optimism/op-challenger/version"
 	opClient "github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	optracing "github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -27,28 +35,73 @@ type Service struct {
 	metrics metrics.Metricer
 	monitor *gameMonitor
 	sched   *scheduler.Scheduler
+	store   *store.Store
+	// notifier is nil if no notification sinks are configured
+	notifier *notify.Dispatcher
 
-	pprofSrv   *httputil.HTTPServer
-	metricsSrv *httputil.HTTPServer
+	pprofSrv    *httputil.HTTPServer
+	pprofPusher *oppprof.Pusher
+	metricsSrv  *httputil.HTTPServer
+	rpcServer   *oprpc.Server
+	tracing     *optracing.Provider
 }
 
 func (s *Service) Stop(ctx context.Context) error {
 	var result error
+	if s.rpcServer != nil {
+		result = errors.Join(result, s.rpcServer.Stop())
+	}
 	if s.sched != nil {
 		result = errors.Join(result, s.sched.Close())
 	}
+	if s.store != nil {
+		result = errors.Join(result, s.store.Close())
+	}
+	if s.notifier != nil {
+		result = errors.Join(result, s.notifier.Close())
+	}
+	if s.pprofPusher != nil {
+		result = errors.Join(result, s.pprofPusher.Stop(ctx))
+	}
 	if s.pprofSrv != nil {
 		result = errors.Join(result, s.pprofSrv.Stop(ctx))
 	}
 	if s.metricsSrv != nil {
 		result = errors.Join(result, s.metricsSrv.Stop(ctx))
 	}
+	if s.tracing != nil {
+		result = errors.Join(result, s.tracing.Stop(ctx))
+	}
 	return result
 }
 
+// Option customises the Service.
+type Option func(o *options)
+
+type options struct {
+	traceProviders map[uint8]fault.TraceProviderFactory
+}
+
+// WithTraceProvider plays the games of the game type with the trace provider of the factory,
+// in addition to the trace providers of the trace types that are enabled in the config.
+// The game type must not be one of the game types of the enabled trace types.
+func WithTraceProvider(gameType uint8, factory fault.TraceProviderFactory) Option {
+	return func(o *options) {
+		o.traceProviders[gameType] = factory
+	}
+}
+
 // NewService creates a new Service.
-func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*Service, error) {
-	cl := clock.SystemClock
+func NewService(ctx context.Context, logger log.Logger, cfg *config.Config, opts ...Option) (*Service, error) {
+	o := &options{traceProviders: make(map[uint8]fault.TraceProviderFactory)}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	cl := cfg.Clock
+	if cl == nil {
+		cl = clock.SystemClock
+	}
 	m := metrics.NewMetrics()
 	txMgr, err := txmgr.NewSimpleTxManager("challenger", logger, &m.TxMetrics, cfg.TxMgrConfig)
 	if err != nil {
@@ -65,7 +118,13 @@ func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*Se
 		metrics: m,
 	}
 
+	s.tracing, err = optracing.StartTracing(ctx, logger, cfg.TracingConfig, "op-challenger", version.SimpleWithMeta)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to start tracing: %w", err), s.Stop(ctx))
+	}
+
 	pprofConfig := cfg.PprofConfig
+	s.pprofPusher = oppprof.StartPusher(logger, pprofConfig, "op-challenger", version.SimpleWithMeta)
 	if pprofConfig.Enabled {
 		logger.Debug("starting pprof", "addr", pprofConfig.ListenAddr, "port", pprofConfig.ListenPort)
 		pprofSrv, err := oppprof.StartServer(pprofConfig.ListenAddr, pprofConfig.ListenPort)
@@ -85,7 +144,7 @@ func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*Se
 		}
 		logger.Info("started metrics server", "addr", metricsSrv.Addr())
 		s.metricsSrv = metricsSrv
-		m.StartBalanceMetrics(ctx, logger, l1Client, txMgr.From())
+		m.StartBalanceMetrics(ctx, logger, l1Client, txMgr.From(), metricsCfg.BalanceThresholds())
 	}
 
 	factoryContract, err := bindings.NewDisputeGameFactory(cfg.GameFactoryAddress, l1Client)
@@ -94,10 +153,55 @@ func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*Se
 	}
 	loader := loader.NewGameLoader(factoryContract)
 
+	traceProviders := fault.NewTraceProviderRegistry()
+	fault.RegisterTraceProviders(traceProviders, cfg)
+	for gameType, factory := range o.traceProviders {
+		traceProviders.RegisterTraceProvider(gameType, factory)
+	}
+	gameStore, err := store.Open(cfg.Datadir, cl)
+	if err != nil {
+		return nil, errors.Join(err, s.Stop(ctx))
+	}
+	s.store = gameStore
+	if err := logRecoveredGames(logger, gameStore); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to recover games: %w", err), s.Stop(ctx))
+	}
+
+	var cannonShared cannon.Shared
+	if cfg.TraceTypeEnabled(config.TraceTypeCannon) || cfg.TraceTypeEnabled(config.TraceTypeOutputCannon) {
+		if cfg.CannonProofCacheSize > 0 {
+			cannonShared.ProofCache, err = cannon.NewProofCache(logger, filepath.Join(cfg.Datadir, proofCacheDir), cfg.CannonProofCacheSize)
+			if err != nil {
+				return nil, errors.Join(fmt.Errorf("failed to open the proof cache: %w", err), s.Stop(ctx))
+			}
+		}
+		if cfg.CannonMaxParallel > 0 {
+			cannonShared.Executions = cannon.NewExecutionScheduler(logger, int(cfg.CannonMaxParallel))
+		}
+	}
+
+	var archiver fault.GameArchiver
+	if cfg.ArchiveDest != "" {
+		archiveStore, err := archive.NewStore(cfg.ArchiveDest, cfg.ArchiveEndpoint, cfg.ArchiveRegion, archive.Credentials{
+			AccessKeyID:     cfg.ArchiveAccessKeyID,
+			SecretAccessKey: cfg.ArchiveSecretAccessKey,
+		})
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to open the game archive: %w", err), s.Stop(ctx))
+		}
+		archiver = archive.NewArchiver(logger, archiveStore, cfg.ArchiveRetention)
+	}
+
+	var notifier notify.Notifier = notify.NoopNotifier
+	if sinks := notifySinks(cfg); len(sinks) > 0 {
+		s.notifier = notify.NewDispatcher(logger, sinks...)
+		notifier = s.notifier
+	}
+
 	gameTypeRegistry := registry.NewGameTypeRegistry()
-	fault.RegisterGameTypes(gameTypeRegistry, ctx, logger, m, cfg, txMgr, l1Client)
+	fault.RegisterGameTypes(gameTypeRegistry, ctx, logger, m, cfg, txMgr, l1Client, traceProviders, gameStore, cannonShared, notifier, archiver)
 
-	disk := newDiskManager(cfg.Datadir)
+	disk := &persistentDiskManager{diskManager: newDiskManager(cfg.Datadir), store: gameStore}
 	s.sched = scheduler.NewScheduler(
 		logger,
 		m,
@@ -109,7 +213,13 @@ func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*Se
 	if err != nil {
 		return nil, errors.Join(fmt.Errorf("failed to create RPC client: %w", err), s.Stop(ctx))
 	}
-	s.monitor = newGameMonitor(logger, cl, loader, s.sched, cfg.GameWindow, l1Client.BlockNumber, cfg.GameAllowlist, pollClient)
+	s.monitor = newGameMonitor(logger, cl, loader, s.sched, cfg.GameWindow, l1Client.BlockNumber, cfg.GameAllowlist, gameStore, pollClient)
+
+	if cfg.RPCEnabled {
+		if err := s.startRPCServer(logger, m, cfg.RPCConfig, gameStore); err != nil {
+			return nil, errors.Join(err, s.Stop(ctx))
+		}
+	}
 
 	m.RecordInfo(version.SimpleWithMeta)
 	m.RecordUp()
@@ -117,6 +227,47 @@ func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*Se
 	return s, nil
 }
 
+func (s *Service) startRPCServer(logger log.Logger, m metrics.Metricer, cfg oprpc.CLIConfig, gameStore *store.Store) error {
+	tlsConfig, err := cfg.ServerTLSConfig(logger)
+	if err != nil {
+		return err
+	}
+	server := oprpc.NewServer(
+		cfg.ListenAddr,
+		cfg.ListenPort,
+		version.SimpleWithMeta,
+		oprpc.WithLogger(logger),
+		oprpc.WithTLSConfig(tlsConfig),
+	)
+	server.AddAPI(rpc.GetChallengerAPI(rpc.NewChallengerAPI(s.sched, m)))
+	if cfg.EnableAdmin {
+		server.AddAPI(rpc.GetAdminAPI(rpc.NewAdminAPI(s.sched, gameStore, m, logger)))
+		logger.Info("Admin RPC enabled")
+	}
+	logger.Debug("starting RPC server", "addr", cfg.ListenAddr, "port", cfg.ListenPort)
+	if err := server.Start(); err != nil {
+		return fmt.Errorf("failed to start RPC server: %w", err)
+	}
+	s.rpcServer = server
+	logger.Info("started RPC server", "endpoint", server.Endpoint())
+	return nil
+}
+
+// logRecoveredGames logs the games that were played before the challenger restarted.
+// Their pending actions are not sent again until they time out, see store.PendingActionTimeout.
+// The games are not scheduled from the store: the monitor discovers them again from the factory,
+// and their players read the claims from the game contracts.
+func logRecoveredGames(logger log.Logger, gameStore *store.Store) error {
+	games, err := gameStore.Games()
+	if err != nil {
+		return err
+	}
+	for _, game := range games {
+		logger.Info("Recovered game", "game", game.Proxy, "status", game.Status)
+	}
+	return nil
+}
+
 // MonitorGame monitors the fault dispute game and attempts to progress it.
 func (s *Service) MonitorGame(ctx context.Context) error {
 	s.sched.Start(ctx)
@@ -126,3 +277,18 @@ func (s *Service) MonitorGame(ctx context.Context) error {
 	err = errors.Join(err, s.Stop(context.Background()))
 	return err
 }
+
+// notifySinks creates the notification sinks that are configured.
+func notifySinks(cfg *config.Config) []notify.Sink {
+	var sinks []notify.Sink
+	if cfg.NotifyWebhookURL != "" {
+		sinks = append(sinks, notify.NewWebhookSink(cfg.NotifyWebhookURL))
+	}
+	if cfg.NotifySlackWebhookURL != "" {
+		sinks = append(sinks, notify.NewSlackSink(cfg.NotifySlackWebhookURL))
+	}
+	if cfg.NotifyPagerDutyRoutingKey != "" {
+		sinks = append(sinks, notify.NewPagerDutySink(notify.DefaultPagerDutyURL, cfg.NotifyPagerDutyRoutingKey))
+	}
+	return sinks
+}
@@ -0,0 +1,207 @@
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/leveldb"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// dbDir is the directory in the datadir that the store is kept in.
+	// It does not have the prefix of the game directories, so it is never removed with the data of a game.
+	dbDir = "state"
+
+	// PendingActionTimeout is how long an action that was sent before a restart is considered to be in flight.
+	// After the timeout the transaction is assumed to be dropped, and the action is sent again.
+	PendingActionTimeout = 10 * time.Minute
+)
+
+var (
+	gamePrefix      = []byte("g")
+	actionPrefix    = []byte("a")
+	blacklistPrefix = []byte("b")
+)
+
+// GameRecord is the state of a game that the challenger plays.
+type GameRecord struct {
+	Proxy  common.Address   `json:"proxy"`
+	Status types.GameStatus `json:"status"`
+}
+
+// Store persists the state of the games that the challenger plays, and the actions that it has in flight,
+// so that the challenger recovers them when it restarts, instead of sending the same actions again.
+type Store struct {
+	db    ethdb.KeyValueStore
+	clock clock.Clock
+}
+
+// Open opens the store in the datadir, creating it if it does not exist.
+func Open(datadir string, cl clock.Clock) (*Store, error) {
+	db, err := leveldb.New(filepath.Join(datadir, dbDir), 16, 16, "op-challenger/store", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open store: %w", err)
+	}
+	return NewStore(db, cl), nil
+}
+
+func NewStore(db ethdb.KeyValueStore, cl clock.Clock) *Store {
+	return &Store{db: db, clock: cl}
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func gameKey(addr common.Address) []byte {
+	return append(slices.Clone(gamePrefix), addr.Bytes()...)
+}
+
+func blacklistKey(addr common.Address) []byte {
+	return append(slices.Clone(blacklistPrefix), addr.Bytes()...)
+}
+
+func actionKey(addr common.Address, id common.Hash) []byte {
+	return append(append(slices.Clone(actionPrefix), addr.Bytes()...), id.Bytes()...)
+}
+
+// RecordGame records the current state of a game.
+func (s *Store) RecordGame(game GameRecord) error {
+	data, err := json.Marshal(game)
+	if err != nil {
+		return err
+	}
+	return s.db.Put(gameKey(game.Proxy), data)
+}
+
+// Games returns the records of all games, ordered by address.
+func (s *Store) Games() ([]GameRecord, error) {
+	it := s.db.NewIterator(gamePrefix, nil)
+	defer it.Release()
+	var games []GameRecord
+	for it.Next() {
+		var game GameRecord
+		if err := json.Unmarshal(it.Value(), &game); err != nil {
+			return nil, fmt.Errorf("invalid record of game %x: %w", it.Key()[len(gamePrefix):], err)
+		}
+		games = append(games, game)
+	}
+	return games, it.Error()
+}
+
+// RemoveAllExcept removes the records and pending actions of all games, except the games to keep.
+func (s *Store) RemoveAllExcept(keep []common.Address) error {
+	batch := s.db.NewBatch()
+	for _, prefix := range [][]byte{gamePrefix, actionPrefix} {
+		it := s.db.NewIterator(prefix, nil)
+		for it.Next() {
+			key := it.Key()
+			if len(key) < len(prefix)+common.AddressLength {
+				continue
+			}
+			addr := common.BytesToAddress(key[len(prefix) : len(prefix)+common.AddressLength])
+			if slices.Contains(keep, addr) {
+				continue
+			}
+			if err := batch.Delete(slices.Clone(key)); err != nil {
+				it.Release()
+				return err
+			}
+		}
+		it.Release()
+		if err := it.Error(); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// BlacklistGame records that the game must not be played. The blacklist is kept when the records of games are removed.
+func (s *Store) BlacklistGame(addr common.Address) error {
+	return s.db.Put(blacklistKey(addr), []byte{1})
+}
+
+// RemoveFromBlacklist plays the game again, if it was blacklisted.
+func (s *Store) RemoveFromBlacklist(addr common.Address) error {
+	return s.db.Delete(blacklistKey(addr))
+}
+
+// IsBlacklisted returns true if the game is blacklisted.
+func (s *Store) IsBlacklisted(addr common.Address) (bool, error) {
+	return s.db.Has(blacklistKey(addr))
+}
+
+// BlacklistedGames returns the addresses of the blacklisted games, in order.
+func (s *Store) BlacklistedGames() ([]common.Address, error) {
+	it := s.db.NewIterator(blacklistPrefix, nil)
+	defer it.Release()
+	var games []common.Address
+	for it.Next() {
+		games = append(games, common.BytesToAddress(it.Key()[len(blacklistPrefix):]))
+	}
+	return games, it.Error()
+}
+
+// PendingActions returns the pending actions of the game.
+func (s *Store) PendingActions(addr common.Address) *PendingActions {
+	return &PendingActions{store: s, game: addr}
+}
+
+// PendingActions records the actions of a game that were sent, until their transactions are confirmed.
+type PendingActions struct {
+	store *Store
+	game  common.Address
+}
+
+// ActionID identifies an action by its content. The same claim against the same parent has the same ID.
+func ActionID(action faultTypes.Action) common.Hash {
+	var parent [8]byte
+	binary.BigEndian.PutUint64(parent[:], uint64(action.ParentIdx))
+	isAttack := []byte{0}
+	if action.IsAttack {
+		isAttack[0] = 1
+	}
+	return crypto.Keccak256Hash([]byte(action.Type), parent[:], isAttack, action.Value.Bytes(), action.PreState, action.ProofData)
+}
+
+// IsPending returns true if the action was sent less than PendingActionTimeout ago, and has not completed yet.
+func (p *PendingActions) IsPending(action faultTypes.Action) (bool, error) {
+	key := actionKey(p.game, ActionID(action))
+	// the databases return different errors for missing keys
+	if ok, err := p.store.db.Has(key); err != nil {
+		return false, err
+	} else if !ok {
+		return false, nil
+	}
+	data, err := p.store.db.Get(key)
+	if err != nil {
+		return false, err
+	}
+	if len(data) != 8 {
+		return false, fmt.Errorf("invalid pending action record of game %v", p.game)
+	}
+	sentAt := time.Unix(int64(binary.BigEndian.Uint64(data)), 0)
+	return p.store.clock.Now().Before(sentAt.Add(PendingActionTimeout)), nil
+}
+
+// Record records that the action is being sent.
+func (p *PendingActions) Record(action faultTypes.Action) error {
+	var sentAt [8]byte
+	binary.BigEndian.PutUint64(sentAt[:], uint64(p.store.clock.Now().Unix()))
+	return p.store.db.Put(actionKey(p.game, ActionID(action)), sentAt[:])
+}
+
+// Complete removes the action, once its transaction is confirmed or failed.
+func (p *PendingActions) Complete(action faultTypes.Action) error {
+	return p.store.db.Delete(actionKey(p.game, ActionID(action)))
+}
@@ -0,0 +1,135 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	game1 = common.Address{0xaa}
+	game2 = common.Address{0xbb}
+)
+
+func TestGames(t *testing.T) {
+	s := NewStore(memorydb.New(), clock.NewDeterministicClock(time.Unix(1000, 0)))
+	games, err := s.Games()
+	require.NoError(t, err)
+	require.Empty(t, games)
+
+	require.NoError(t, s.RecordGame(GameRecord{Proxy: game2, Status: types.GameStatusInProgress}))
+	require.NoError(t, s.RecordGame(GameRecord{Proxy: game1, Status: types.GameStatusInProgress}))
+	require.NoError(t, s.RecordGame(GameRecord{Proxy: game2, Status: types.GameStatusDefenderWon}))
+
+	games, err = s.Games()
+	require.NoError(t, err)
+	require.Equal(t, []GameRecord{
+		{Proxy: game1, Status: types.GameStatusInProgress},
+		{Proxy: game2, Status: types.GameStatusDefenderWon},
+	}, games)
+}
+
+func TestPendingActions(t *testing.T) {
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	s := NewStore(memorydb.New(), cl)
+	move := faultTypes.Action{Type: faultTypes.ActionTypeMove, ParentIdx: 1, IsAttack: true, Value: common.Hash{0x01}}
+	defend := faultTypes.Action{Type: faultTypes.ActionTypeMove, ParentIdx: 1, IsAttack: false, Value: common.Hash{0x01}}
+	pending := s.PendingActions(game1)
+
+	isPending, err := pending.IsPending(move)
+	require.NoError(t, err)
+	require.False(t, isPending)
+
+	require.NoError(t, pending.Record(move))
+	isPending, err = pending.IsPending(move)
+	require.NoError(t, err)
+	require.True(t, isPending)
+
+	isPending, err = pending.IsPending(defend)
+	require.NoError(t, err)
+	require.False(t, isPending, "other actions are not pending")
+	isPending, err = s.PendingActions(game2).IsPending(move)
+	require.NoError(t, err)
+	require.False(t, isPending, "the actions of other games are not pending")
+
+	t.Run("Timeout", func(t *testing.T) {
+		cl.AdvanceTime(PendingActionTimeout)
+		isPending, err := pending.IsPending(move)
+		require.NoError(t, err)
+		require.False(t, isPending)
+	})
+
+	t.Run("Complete", func(t *testing.T) {
+		require.NoError(t, pending.Record(move))
+		require.NoError(t, pending.Complete(move))
+		isPending, err := pending.IsPending(move)
+		require.NoError(t, err)
+		require.False(t, isPending)
+	})
+}
+
+func TestRemoveAllExcept(t *testing.T) {
+	s := NewStore(memorydb.New(), clock.NewDeterministicClock(time.Unix(1000, 0)))
+	move := faultTypes.Action{Type: faultTypes.ActionTypeMove, ParentIdx: 0, IsAttack: true, Value: common.Hash{0x01}}
+	for _, game := range []common.Address{game1, game2} {
+		require.NoError(t, s.RecordGame(GameRecord{Proxy: game, Status: types.GameStatusInProgress}))
+		require.NoError(t, s.PendingActions(game).Record(move))
+	}
+
+	require.NoError(t, s.RemoveAllExcept([]common.Address{game2}))
+
+	games, err := s.Games()
+	require.NoError(t, err)
+	require.Equal(t, []GameRecord{{Proxy: game2, Status: types.GameStatusInProgress}}, games)
+	isPending, err := s.PendingActions(game1).IsPending(move)
+	require.NoError(t, err)
+	require.False(t, isPending)
+	isPending, err = s.PendingActions(game2).IsPending(move)
+	require.NoError(t, err)
+	require.True(t, isPending)
+}
+
+func TestBlacklist(t *testing.T) {
+	s := NewStore(memorydb.New(), clock.NewDeterministicClock(time.Unix(1000, 0)))
+	require.NoError(t, s.BlacklistGame(game2))
+	require.NoError(t, s.BlacklistGame(game1))
+
+	blacklisted, err := s.IsBlacklisted(game1)
+	require.NoError(t, err)
+	require.True(t, blacklisted)
+	games, err := s.BlacklistedGames()
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{game1, game2}, games)
+
+	require.NoError(t, s.RemoveAllExcept(nil))
+	games, err = s.BlacklistedGames()
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{game1, game2}, games, "should keep blacklist when removing games")
+
+	require.NoError(t, s.RemoveFromBlacklist(game1))
+	blacklisted, err = s.IsBlacklisted(game1)
+	require.NoError(t, err)
+	require.False(t, blacklisted)
+}
+
+func TestOpen(t *testing.T) {
+	dir := t.TempDir()
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	s, err := Open(dir, cl)
+	require.NoError(t, err)
+	require.NoError(t, s.RecordGame(GameRecord{Proxy: game1, Status: types.GameStatusInProgress}))
+	require.NoError(t, s.Close())
+
+	s, err = Open(dir, cl)
+	require.NoError(t, err)
+	defer s.Close()
+	games, err := s.Games()
+	require.NoError(t, err)
+	require.Equal(t, []GameRecord{{Proxy: game1, Status: types.GameStatusInProgress}}, games)
+}
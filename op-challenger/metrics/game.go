@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GameMetricer records the metrics of a single game, in addition to the metrics of all games.
+type GameMetricer interface {
+	Metricer
+
+	RecordResponseLatency(t float64)
+	RecordMaxDepth(depth int)
+	RecordClockRemaining(t float64)
+	Forget()
+}
+
+// ForGame returns a GameMetricer that labels the metrics of a single game with the game address.
+// The cannon executions are recorded both for all games, and for the game.
+func ForGame(m Metricer, game common.Address) GameMetricer {
+	return &gameMetrics{Metricer: m, game: game}
+}
+
+type gameMetrics struct {
+	Metricer
+	game common.Address
+}
+
+func (g *gameMetrics) RecordCannonExecutionTime(t float64) {
+	g.Metricer.RecordCannonExecutionTime(t)
+	g.Metricer.RecordGameCannonExecutionTime(g.game, t)
+}
+
+func (g *gameMetrics) RecordResponseLatency(t float64) {
+	g.Metricer.RecordGameResponseLatency(g.game, t)
+}
+
+func (g *gameMetrics) RecordMaxDepth(depth int) {
+	g.Metricer.RecordGameMaxDepth(g.game, depth)
+}
+
+func (g *gameMetrics) RecordClockRemaining(t float64) {
+	g.Metricer.RecordGameClockRemaining(g.game, t)
+}
+
+func (g *gameMetrics) Forget() {
+	g.Metricer.ForgetGame(g.game)
+}
@@ -22,10 +22,20 @@ type Metricer interface {
 	// Record Tx metrics
 	txmetrics.TxMetricer
 
+	opmetrics.RPCMetricer
+
 	RecordGameStep()
 	RecordGameMove()
 	RecordCannonExecutionTime(t float64)
 
+	// Record metrics of a single game, labelled by the game address
+	RecordGameResponseLatency(game common.Address, t float64)
+	RecordGameMaxDepth(game common.Address, depth int)
+	RecordGameClockRemaining(game common.Address, t float64)
+	RecordGameCannonExecutionTime(game common.Address, t float64)
+	// ForgetGame removes the metrics of a resolved game.
+	ForgetGame(game common.Address)
+
 	RecordGamesStatus(inProgress, defenderWon, challengerWon int)
 
 	RecordGameUpdateScheduled()
@@ -43,6 +53,7 @@ type Metrics struct {
 	factory  opmetrics.Factory
 
 	txmetrics.TxMetrics
+	opmetrics.RPCMetrics
 
 	info prometheus.GaugeVec
 	up   prometheus.Gauge
@@ -54,6 +65,11 @@ type Metrics struct {
 
 	cannonExecutionTime prometheus.Histogram
 
+	gameResponseLatency     prometheus.HistogramVec
+	gameMaxDepth            prometheus.GaugeVec
+	gameClockRemaining      prometheus.GaugeVec
+	gameCannonExecutionTime prometheus.HistogramVec
+
 	trackedGames  prometheus.GaugeVec
 	inflightGames prometheus.Gauge
 }
@@ -69,7 +85,8 @@ func NewMetrics() *Metrics {
 		registry: registry,
 		factory:  factory,
 
-		TxMetrics: txmetrics.MakeTxMetrics(Namespace, factory),
+		TxMetrics:  txmetrics.MakeTxMetrics(Namespace, factory),
+		RPCMetrics: opmetrics.MakeRPCMetrics(Namespace, factory),
 
 		info: *factory.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: Namespace,
@@ -108,6 +125,38 @@ func NewMetrics() *Metrics {
 				[]float64{1.0, 10.0},
 				prometheus.ExponentialBuckets(30.0, 2.0, 14)...),
 		}),
+		gameResponseLatency: *factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "game_response_latency",
+			Help:      "Time (in seconds) from observing a claim to the counter claim of the challenge agent being included",
+			Buckets:   prometheus.ExponentialBuckets(10.0, 2.0, 14),
+		}, []string{
+			"game",
+		}),
+		gameMaxDepth: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "game_max_depth",
+			Help:      "Depth of the deepest claim of the game",
+		}, []string{
+			"game",
+		}),
+		gameClockRemaining: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "game_clock_remaining",
+			Help:      "Time (in seconds) left on the chess clock of the challenge agent for the claims it last countered",
+		}, []string{
+			"game",
+		}),
+		gameCannonExecutionTime: *factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "game_cannon_execution_time",
+			Help:      "Time (in seconds) to execute cannon for the game",
+			Buckets: append(
+				[]float64{1.0, 10.0},
+				prometheus.ExponentialBuckets(30.0, 2.0, 14)...),
+		}, []string{
+			"game",
+		}),
 		trackedGames: *factory.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: Namespace,
 			Name:      "tracked_games",
@@ -132,9 +181,14 @@ func (m *Metrics) StartBalanceMetrics(
 	l log.Logger,
 	client *ethclient.Client,
 	account common.Address,
+	thresholds opmetrics.BalanceThresholds,
 ) {
 	// TODO(7684): util was refactored to close, but ctx is still being used by caller for shutdown
-	balanceMetric := opmetrics.LaunchBalanceMetrics(l, m.registry, m.ns, client, account)
+	balanceMetric := opmetrics.LaunchBalanceMetrics(l, m.registry, m.ns, client, opmetrics.MonitoredAccount{
+		Name:       "challenger",
+		Address:    account,
+		Thresholds: thresholds,
+	})
 	go func() {
 		<-ctx.Done()
 		_ = balanceMetric.Close()
@@ -169,6 +223,29 @@ func (m *Metrics) RecordCannonExecutionTime(t float64) {
 	m.cannonExecutionTime.Observe(t)
 }
 
+func (m *Metrics) RecordGameResponseLatency(game common.Address, t float64) {
+	m.gameResponseLatency.WithLabelValues(game.Hex()).Observe(t)
+}
+
+func (m *Metrics) RecordGameMaxDepth(game common.Address, depth int) {
+	m.gameMaxDepth.WithLabelValues(game.Hex()).Set(float64(depth))
+}
+
+func (m *Metrics) RecordGameClockRemaining(game common.Address, t float64) {
+	m.gameClockRemaining.WithLabelValues(game.Hex()).Set(t)
+}
+
+func (m *Metrics) RecordGameCannonExecutionTime(game common.Address, t float64) {
+	m.gameCannonExecutionTime.WithLabelValues(game.Hex()).Observe(t)
+}
+
+func (m *Metrics) ForgetGame(game common.Address) {
+	m.gameResponseLatency.DeleteLabelValues(game.Hex())
+	m.gameMaxDepth.DeleteLabelValues(game.Hex())
+	m.gameClockRemaining.DeleteLabelValues(game.Hex())
+	m.gameCannonExecutionTime.DeleteLabelValues(game.Hex())
+}
+
 func (m *Metrics) IncActiveExecutors() {
 	m.executors.WithLabelValues("active").Inc()
 }
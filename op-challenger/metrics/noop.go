@@ -1,11 +1,15 @@
 package metrics
 
 import (
+	"github.com/ethereum/go-ethereum/common"
+
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	txmetrics "github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
 )
 
 type NoopMetricsImpl struct {
 	txmetrics.NoopTxMetrics
+	opmetrics.NoopRPCMetrics
 }
 
 var NoopMetrics Metricer = new(NoopMetricsImpl)
@@ -18,6 +22,12 @@ func (*NoopMetricsImpl) RecordGameStep() {}
 
 func (*NoopMetricsImpl) RecordCannonExecutionTime(t float64) {}
 
+func (*NoopMetricsImpl) RecordGameResponseLatency(game common.Address, t float64)     {}
+func (*NoopMetricsImpl) RecordGameMaxDepth(game common.Address, depth int)            {}
+func (*NoopMetricsImpl) RecordGameClockRemaining(game common.Address, t float64)      {}
+func (*NoopMetricsImpl) RecordGameCannonExecutionTime(game common.Address, t float64) {}
+func (*NoopMetricsImpl) ForgetGame(game common.Address)                               {}
+
 func (*NoopMetricsImpl) RecordGamesStatus(inProgress, defenderWon, challengerWon int) {}
 
 func (*NoopMetricsImpl) RecordGameUpdateScheduled() {}
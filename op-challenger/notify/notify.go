@@ -0,0 +1,172 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const sendTimeout = 10 * time.Second
+
+type EventType string
+
+const (
+	// EventInvalidRootClaim is fired when the challenger disagrees with the root claim of a game, and so with the
+	// validity of the output proposal that the root claim asserts, and counters it.
+	EventInvalidRootClaim EventType = "invalid_root_claim"
+	// EventClaimCountered is fired when a claim at the max depth of a game that the challenger agrees with is
+	// countered by a step, which indicates that the trace of the challenger is wrong.
+	EventClaimCountered EventType = "claim_countered"
+	// EventGameLost is fired when a game resolves against the challenger.
+	EventGameLost EventType = "game_lost"
+)
+
+// Event is an event that operators are notified of.
+type Event struct {
+	Type EventType      `json:"type"`
+	Game common.Address `json:"game"`
+	// ClaimIndex is the index of the claim in the game that the event is about, if any.
+	ClaimIndex int    `json:"claimIndex"`
+	Message    string `json:"message"`
+}
+
+func (e Event) String() string {
+	return fmt.Sprintf("%v: %v (game %v, claim %v)", e.Type, e.Message, e.Game, e.ClaimIndex)
+}
+
+// Sink delivers events to a notification service.
+type Sink interface {
+	Name() string
+	Send(ctx context.Context, event Event) error
+}
+
+// Notifier notifies operators of events. Notify does not return errors, since failing to notify must not stop the
+// games from being played.
+type Notifier interface {
+	Notify(ctx context.Context, event Event)
+}
+
+// NoopNotifier drops all events.
+var NoopNotifier Notifier = noopNotifier{}
+
+type noopNotifier struct{}
+
+func (noopNotifier) Notify(ctx context.Context, event Event) {}
+
+// queueSize is the number of events that are queued to be sent, before further events are dropped.
+const queueSize = 100
+
+// Dispatcher sends every event to all sinks. The events are queued and sent in the background, so that slow sinks
+// do not delay the games. If the queue is full, events are dropped.
+type Dispatcher struct {
+	logger log.Logger
+	sinks  []Sink
+	queue  chan Event
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewDispatcher creates a Dispatcher, and starts sending the events it is notified of. Close stops it.
+func NewDispatcher(logger log.Logger, sinks ...Sink) *Dispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	d := &Dispatcher{
+		logger: logger,
+		sinks:  sinks,
+		queue:  make(chan Event, queueSize),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	d.wg.Add(1)
+	go d.run()
+	return d
+}
+
+// Notify queues the event to be sent. It never blocks.
+func (d *Dispatcher) Notify(ctx context.Context, event Event) {
+	d.logger.Warn("Sending notification", "type", event.Type, "game", event.Game, "claim", event.ClaimIndex, "message", event.Message)
+	select {
+	case d.queue <- event:
+	default:
+		d.logger.Error("Notification queue is full, dropping notification", "type", event.Type, "game", event.Game, "claim", event.ClaimIndex)
+	}
+}
+
+func (d *Dispatcher) run() {
+	defer d.wg.Done()
+	for {
+		select {
+		case <-d.ctx.Done():
+			return
+		case event := <-d.queue:
+			d.send(event)
+		}
+	}
+}
+
+func (d *Dispatcher) send(event Event) {
+	for _, sink := range d.sinks {
+		sendCtx, cancel := context.WithTimeout(d.ctx, sendTimeout)
+		if err := sink.Send(sendCtx, event); err != nil {
+			d.logger.Error("Failed to send notification", "sink", sink.Name(), "type", event.Type, "game", event.Game, "err", err)
+		}
+		cancel()
+	}
+}
+
+// Close stops sending events. Events that are not sent yet are dropped.
+func (d *Dispatcher) Close() error {
+	d.cancel()
+	d.wg.Wait()
+	return nil
+}
+
+// GameNotifier notifies operators of the events of a single game.
+type GameNotifier interface {
+	Notifier
+	// Resolved drops the record of the events of the moves in the game, since no more moves are made once it is resolved.
+	Resolved()
+}
+
+// ForGame returns a GameNotifier that attributes all events to the game at addr. Events that are fired again for
+// the same claim of the game, for example every time the game is progressed, are dropped.
+// The events are recorded for as long as the GameNotifier is used, so for as long as the game is played.
+func ForGame(n Notifier, addr common.Address) GameNotifier {
+	return &gameNotifier{notifier: n, addr: addr, sent: make(map[Event]bool)}
+}
+
+type gameNotifier struct {
+	notifier Notifier
+	addr     common.Address
+
+	lock sync.Mutex
+	sent map[Event]bool
+}
+
+func (g *gameNotifier) Notify(ctx context.Context, event Event) {
+	event.Game = g.addr
+	g.lock.Lock()
+	if g.sent[event] {
+		g.lock.Unlock()
+		return
+	}
+	g.sent[event] = true
+	g.lock.Unlock()
+	g.notifier.Notify(ctx, event)
+}
+
+func (g *gameNotifier) Resolved() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	for event := range g.sent {
+		// the resolution is reported every time the resolved game is progressed, so keep dropping it
+		if event.Type != EventGameLost {
+			delete(g.sent, event)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatcher(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	event := Event{Type: EventGameLost, Game: common.Address{0xaa}, Message: "lost"}
+
+	t.Run("SendToAllSinks", func(t *testing.T) {
+		first := newStubSink(nil)
+		second := newStubSink(nil)
+		dispatcher := NewDispatcher(logger, first, second)
+		defer dispatcher.Close()
+		dispatcher.Notify(context.Background(), event)
+		require.Equal(t, event, first.next(t))
+		require.Equal(t, event, second.next(t))
+	})
+
+	t.Run("ContinueAfterSinkError", func(t *testing.T) {
+		failing := newStubSink(errors.New("boom"))
+		sink := newStubSink(nil)
+		dispatcher := NewDispatcher(logger, failing, sink)
+		defer dispatcher.Close()
+		dispatcher.Notify(context.Background(), event)
+		require.Equal(t, event, sink.next(t))
+	})
+
+	t.Run("DoNotBlockOnSlowSinks", func(t *testing.T) {
+		sink := &blockingSink{sent: make(chan Event, queueSize+2)}
+		dispatcher := NewDispatcher(logger, sink)
+		// the first event blocks the sink, the queue fills up with the next ones, and the rest is dropped
+		for i := 0; i < queueSize+10; i++ {
+			dispatcher.Notify(context.Background(), Event{Type: EventClaimCountered, ClaimIndex: i})
+		}
+		require.NoError(t, dispatcher.Close())
+		require.LessOrEqual(t, len(sink.sent), queueSize+1)
+	})
+}
+
+func TestForGame(t *testing.T) {
+	game := common.Address{0xbb}
+	invalid := Event{Type: EventInvalidRootClaim, Message: "invalid"}
+	countered := Event{Type: EventClaimCountered, ClaimIndex: 3, Message: "countered"}
+	lost := Event{Type: EventGameLost, Message: "lost"}
+	withGame := func(e Event) Event {
+		e.Game = game
+		return e
+	}
+
+	t.Run("AttributeToGame", func(t *testing.T) {
+		recorder := &recordingNotifier{}
+		ForGame(recorder, game).Notify(context.Background(), Event{Type: EventGameLost})
+		require.Equal(t, []Event{{Type: EventGameLost, Game: game}}, recorder.events)
+	})
+
+	t.Run("SendEventOnce", func(t *testing.T) {
+		recorder := &recordingNotifier{}
+		notifier := ForGame(recorder, game)
+		notifier.Notify(context.Background(), invalid)
+		notifier.Notify(context.Background(), invalid)
+		notifier.Notify(context.Background(), countered)
+		otherClaim := countered
+		otherClaim.ClaimIndex = 4
+		notifier.Notify(context.Background(), otherClaim)
+		notifier.Notify(context.Background(), countered)
+		require.Equal(t, []Event{withGame(invalid), withGame(countered), withGame(otherClaim)}, recorder.events)
+	})
+
+	t.Run("SendResolutionOnce", func(t *testing.T) {
+		recorder := &recordingNotifier{}
+		notifier := ForGame(recorder, game)
+		notifier.Notify(context.Background(), invalid)
+		notifier.Resolved()
+		notifier.Notify(context.Background(), lost)
+		// a resolved game is progressed again, for example while it is not archived yet
+		notifier.Resolved()
+		notifier.Notify(context.Background(), lost)
+		require.Equal(t, []Event{withGame(invalid), withGame(lost)}, recorder.events)
+		require.Equal(t, map[Event]bool{withGame(lost): true}, notifier.(*gameNotifier).sent, "must drop the events of the moves")
+	})
+}
+
+func TestSinks(t *testing.T) {
+	event := Event{Type: EventClaimCountered, Game: common.Address{0xaa}, ClaimIndex: 4, Message: "countered"}
+
+	t.Run("Webhook", func(t *testing.T) {
+		body, url := captureRequest(t, http.StatusOK)
+		require.NoError(t, NewWebhookSink(url).Send(context.Background(), event))
+		var actual Event
+		require.NoError(t, json.Unmarshal(<-body, &actual))
+		require.Equal(t, event, actual)
+	})
+
+	t.Run("Slack", func(t *testing.T) {
+		body, url := captureRequest(t, http.StatusOK)
+		require.NoError(t, NewSlackSink(url).Send(context.Background(), event))
+		var actual slackMessage
+		require.NoError(t, json.Unmarshal(<-body, &actual))
+		require.Contains(t, actual.Text, "countered")
+		require.Contains(t, actual.Text, event.Game.Hex())
+	})
+
+	t.Run("PagerDuty", func(t *testing.T) {
+		body, url := captureRequest(t, http.StatusAccepted)
+		require.NoError(t, NewPagerDutySink(url, "key").Send(context.Background(), event))
+		var actual pagerDutyEvent
+		require.NoError(t, json.Unmarshal(<-body, &actual))
+		require.Equal(t, "key", actual.RoutingKey)
+		require.Equal(t, "trigger", actual.EventAction)
+		require.Equal(t, event, actual.Payload.CustomDetails)
+		require.NotEmpty(t, actual.DedupKey)
+	})
+
+	t.Run("ErrorStatus", func(t *testing.T) {
+		_, url := captureRequest(t, http.StatusInternalServerError)
+		require.ErrorContains(t, NewWebhookSink(url).Send(context.Background(), event), "500")
+	})
+}
+
+func captureRequest(t *testing.T, status int) (chan []byte, string) {
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		bodies <- body
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return bodies, server.URL
+}
+
+type stubSink struct {
+	sent chan Event
+	err  error
+}
+
+func newStubSink(err error) *stubSink {
+	return &stubSink{sent: make(chan Event, 10), err: err}
+}
+
+func (s *stubSink) Name() string {
+	return "stub"
+}
+
+func (s *stubSink) Send(ctx context.Context, event Event) error {
+	s.sent <- event
+	return s.err
+}
+
+func (s *stubSink) next(t *testing.T) Event {
+	select {
+	case event := <-s.sent:
+		return event
+	case <-time.After(10 * time.Second):
+		t.Fatal("no event sent")
+		return Event{}
+	}
+}
+
+// blockingSink blocks sending events until the send is canceled.
+type blockingSink struct {
+	sent chan Event
+}
+
+func (s *blockingSink) Name() string {
+	return "blocking"
+}
+
+func (s *blockingSink) Send(ctx context.Context, event Event) error {
+	s.sent <- event
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+type recordingNotifier struct {
+	events []Event
+}
+
+func (r *recordingNotifier) Notify(ctx context.Context, event Event) {
+	r.events = append(r.events, event)
+}
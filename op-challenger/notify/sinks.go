@@ -0,0 +1,116 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// DefaultPagerDutyURL is the endpoint of the PagerDuty Events API v2.
+const DefaultPagerDutyURL = "https://events.pagerduty.com/v2/enqueue"
+
+// WebhookSink posts every event as JSON to a URL.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{url: url, client: &http.Client{Timeout: sendTimeout}}
+}
+
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *WebhookSink) Send(ctx context.Context, event Event) error {
+	return postJSON(ctx, s.client, s.url, event)
+}
+
+// SlackSink posts every event as a message to a Slack incoming webhook.
+type SlackSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewSlackSink(webhookURL string) *SlackSink {
+	return &SlackSink{url: webhookURL, client: &http.Client{Timeout: sendTimeout}}
+}
+
+func (s *SlackSink) Name() string {
+	return "slack"
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+func (s *SlackSink) Send(ctx context.Context, event Event) error {
+	return postJSON(ctx, s.client, s.url, slackMessage{Text: "op-challenger " + event.String()})
+}
+
+// PagerDutySink triggers a PagerDuty alert for every event, through the Events API v2.
+type PagerDutySink struct {
+	url        string
+	routingKey string
+	client     *http.Client
+}
+
+func NewPagerDutySink(url string, routingKey string) *PagerDutySink {
+	return &PagerDutySink{url: url, routingKey: routingKey, client: &http.Client{Timeout: sendTimeout}}
+}
+
+func (s *PagerDutySink) Name() string {
+	return "pagerduty"
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string           `json:"routing_key"`
+	EventAction string           `json:"event_action"`
+	DedupKey    string           `json:"dedup_key"`
+	Payload     pagerDutyPayload `json:"payload"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string `json:"summary"`
+	Source        string `json:"source"`
+	Severity      string `json:"severity"`
+	CustomDetails Event  `json:"custom_details"`
+}
+
+func (s *PagerDutySink) Send(ctx context.Context, event Event) error {
+	return postJSON(ctx, s.client, s.url, pagerDutyEvent{
+		RoutingKey:  s.routingKey,
+		EventAction: "trigger",
+		DedupKey:    fmt.Sprintf("%v-%v-%v", event.Type, event.Game, event.ClaimIndex),
+		Payload: pagerDutyPayload{
+			Summary:       event.String(),
+			Source:        "op-challenger",
+			Severity:      "critical",
+			CustomDetails: event,
+		},
+	})
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %v", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,308 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
+	"github.com/ethereum-optimism/optimism/op-service/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/rpc"
+)
+
+var (
+	ErrUnknownGame    = errors.New("unknown game")
+	ErrNotInspectable = errors.New("game cannot be inspected")
+)
+
+// GamePlayer is the player of a game that can be inspected, see fault.GamePlayer.
+type GamePlayer interface {
+	Claims(ctx context.Context) ([]faultTypes.Claim, error)
+	PlannedActions() []faultTypes.Action
+	Resolve(ctx context.Context) error
+	CannonStatus() (cannon.ExecutionStatus, bool)
+}
+
+// Games tracks the games that are played, see scheduler.Scheduler.
+type Games interface {
+	Games() []scheduler.GameState
+	PauseGame(addr common.Address)
+	ResumeGame(addr common.Address)
+}
+
+// Blacklist records the games that must not be played, see store.Store.
+type Blacklist interface {
+	BlacklistGame(addr common.Address) error
+	RemoveFromBlacklist(addr common.Address) error
+	BlacklistedGames() ([]common.Address, error)
+}
+
+type Game struct {
+	Proxy    common.Address `json:"proxy"`
+	Status   string         `json:"status"`
+	Inflight bool           `json:"inflight"`
+	Paused   bool           `json:"paused"`
+}
+
+type Claim struct {
+	Index        int          `json:"index"`
+	ParentIndex  int          `json:"parentIndex"`
+	Value        common.Hash  `json:"value"`
+	Depth        int          `json:"depth"`
+	IndexAtDepth *hexutil.Big `json:"indexAtDepth"`
+	Countered    bool         `json:"countered"`
+	Clock        uint64       `json:"clock"`
+}
+
+type Action struct {
+	Type      string        `json:"type"`
+	ParentIdx int           `json:"parentIndex"`
+	IsAttack  bool          `json:"isAttack"`
+	Value     *common.Hash  `json:"value,omitempty"`
+	PreState  hexutil.Bytes `json:"preState,omitempty"`
+	ProofData hexutil.Bytes `json:"proofData,omitempty"`
+}
+
+type CannonStatus struct {
+	Running    bool           `json:"running"`
+	Executions uint64         `json:"executions"`
+	TraceIndex hexutil.Uint64 `json:"traceIndex"`
+	StartedAt  uint64         `json:"startedAt"`
+	Duration   float64        `json:"duration"`
+	Error      string         `json:"error,omitempty"`
+}
+
+type challengerAPI struct {
+	m     metrics.RPCMetricer
+	games Games
+}
+
+func NewChallengerAPI(games Games, m metrics.RPCMetricer) *challengerAPI {
+	return &challengerAPI{
+		m:     m,
+		games: games,
+	}
+}
+
+func GetChallengerAPI(api *challengerAPI) gethrpc.API {
+	return gethrpc.API{
+		Namespace: "challenger",
+		Service:   api,
+	}
+}
+
+// ListGames returns the games that are tracked, sorted by address.
+func (a *challengerAPI) ListGames(_ context.Context) ([]Game, error) {
+	recordDur := a.m.RecordRPCServerRequest("challenger_listGames")
+	defer recordDur()
+	states := a.games.Games()
+	games := make([]Game, 0, len(states))
+	for _, state := range states {
+		games = append(games, Game{
+			Proxy:    state.Proxy,
+			Status:   state.Status.String(),
+			Inflight: state.Inflight,
+			Paused:   state.Paused,
+		})
+	}
+	return games, nil
+}
+
+// Claims returns the current claims of the game.
+func (a *challengerAPI) Claims(ctx context.Context, addr common.Address) ([]Claim, error) {
+	recordDur := a.m.RecordRPCServerRequest("challenger_claims")
+	defer recordDur()
+	player, err := findPlayer(a.games, addr)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := player.Claims(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch claims of game %v: %w", addr, err)
+	}
+	result := make([]Claim, 0, len(claims))
+	for _, claim := range claims {
+		result = append(result, Claim{
+			Index:        claim.ContractIndex,
+			ParentIndex:  claim.ParentContractIndex,
+			Value:        claim.Value,
+			Depth:        claim.Depth(),
+			IndexAtDepth: (*hexutil.Big)(claim.IndexAtDepth()),
+			Countered:    claim.Countered,
+			Clock:        claim.Clock,
+		})
+	}
+	return result, nil
+}
+
+// PlannedActions returns the actions that were calculated for the game the last time it was progressed,
+// including the actions whose transactions are still in flight.
+func (a *challengerAPI) PlannedActions(_ context.Context, addr common.Address) ([]Action, error) {
+	recordDur := a.m.RecordRPCServerRequest("challenger_plannedActions")
+	defer recordDur()
+	player, err := findPlayer(a.games, addr)
+	if err != nil {
+		return nil, err
+	}
+	actions := player.PlannedActions()
+	result := make([]Action, 0, len(actions))
+	for _, action := range actions {
+		r := Action{
+			Type:      action.Type.String(),
+			ParentIdx: action.ParentIdx,
+			IsAttack:  action.IsAttack,
+		}
+		if action.Type == faultTypes.ActionTypeMove {
+			value := action.Value
+			r.Value = &value
+		} else {
+			r.PreState = action.PreState
+			r.ProofData = action.ProofData
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// CannonStatus returns the status of the executions of cannon of the game.
+// Returns nil if the game is not played with cannon.
+func (a *challengerAPI) CannonStatus(_ context.Context, addr common.Address) (*CannonStatus, error) {
+	recordDur := a.m.RecordRPCServerRequest("challenger_cannonStatus")
+	defer recordDur()
+	player, err := findPlayer(a.games, addr)
+	if err != nil {
+		return nil, err
+	}
+	status, ok := player.CannonStatus()
+	if !ok {
+		return nil, nil
+	}
+	result := &CannonStatus{
+		Running:    status.Running,
+		Executions: status.Executions,
+		TraceIndex: hexutil.Uint64(status.TraceIndex),
+		Duration:   status.Duration.Seconds(),
+	}
+	if !status.StartedAt.IsZero() {
+		result.StartedAt = uint64(status.StartedAt.Unix())
+	}
+	if status.Err != nil {
+		result.Error = status.Err.Error()
+	}
+	return result, nil
+}
+
+type adminAPI struct {
+	*rpc.CommonAdminAPI
+	log       log.Logger
+	games     Games
+	blacklist Blacklist
+}
+
+func NewAdminAPI(games Games, blacklist Blacklist, m metrics.RPCMetricer, log log.Logger) *adminAPI {
+	return &adminAPI{
+		CommonAdminAPI: rpc.NewCommonAdminAPI(m, log),
+		log:            log,
+		games:          games,
+		blacklist:      blacklist,
+	}
+}
+
+func GetAdminAPI(api *adminAPI) gethrpc.API {
+	return gethrpc.API{
+		Namespace: "admin",
+		Service:   api,
+	}
+}
+
+// PauseGame stops progressing the game until it is resumed.
+// Games that are not tracked yet can be paused, so that they are not played once they are created.
+func (a *adminAPI) PauseGame(_ context.Context, addr common.Address) error {
+	recordDur := a.M.RecordRPCServerRequest("admin_pauseGame")
+	defer recordDur()
+	a.games.PauseGame(addr)
+	a.log.Warn("Paused game", "game", addr)
+	return nil
+}
+
+// ResumeGame progresses the paused game again.
+func (a *adminAPI) ResumeGame(_ context.Context, addr common.Address) error {
+	recordDur := a.M.RecordRPCServerRequest("admin_resumeGame")
+	defer recordDur()
+	a.games.ResumeGame(addr)
+	a.log.Warn("Resumed game", "game", addr)
+	return nil
+}
+
+// ResolveGame resolves the game, even if the challenger loses it.
+func (a *adminAPI) ResolveGame(ctx context.Context, addr common.Address) error {
+	recordDur := a.M.RecordRPCServerRequest("admin_resolveGame")
+	defer recordDur()
+	player, err := findPlayer(a.games, addr)
+	if err != nil {
+		return err
+	}
+	a.log.Warn("Resolving game", "game", addr)
+	if err := player.Resolve(ctx); err != nil {
+		return fmt.Errorf("failed to resolve game %v: %w", addr, err)
+	}
+	return nil
+}
+
+// BlacklistGame stops playing the game. The game stays blacklisted after a restart.
+func (a *adminAPI) BlacklistGame(_ context.Context, addr common.Address) error {
+	recordDur := a.M.RecordRPCServerRequest("admin_blacklistGame")
+	defer recordDur()
+	if err := a.blacklist.BlacklistGame(addr); err != nil {
+		return fmt.Errorf("failed to blacklist game %v: %w", addr, err)
+	}
+	a.log.Warn("Blacklisted game", "game", addr)
+	return nil
+}
+
+// RemoveFromBlacklist plays the blacklisted game again.
+func (a *adminAPI) RemoveFromBlacklist(_ context.Context, addr common.Address) error {
+	recordDur := a.M.RecordRPCServerRequest("admin_removeFromBlacklist")
+	defer recordDur()
+	if err := a.blacklist.RemoveFromBlacklist(addr); err != nil {
+		return fmt.Errorf("failed to remove game %v from blacklist: %w", addr, err)
+	}
+	a.log.Warn("Removed game from blacklist", "game", addr)
+	return nil
+}
+
+// BlacklistedGames returns the addresses of the blacklisted games.
+func (a *adminAPI) BlacklistedGames(_ context.Context) ([]common.Address, error) {
+	recordDur := a.M.RecordRPCServerRequest("admin_blacklistedGames")
+	defer recordDur()
+	games, err := a.blacklist.BlacklistedGames()
+	if err != nil {
+		return nil, err
+	}
+	if games == nil {
+		games = []common.Address{}
+	}
+	return games, nil
+}
+
+func findPlayer(games Games, addr common.Address) (GamePlayer, error) {
+	for _, game := range games.Games() {
+		if game.Proxy != addr {
+			continue
+		}
+		// the player is nil if it could not be created yet
+		player, ok := game.Player.(GamePlayer)
+		if !ok {
+			return nil, fmt.Errorf("%w: %v", ErrNotInspectable, addr)
+		}
+		return player, nil
+	}
+	return nil, fmt.Errorf("%w: %v", ErrUnknownGame, addr)
+}
@@ -0,0 +1,222 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/trace/cannon"
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/scheduler"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/types"
+	"github.com/ethereum-optimism/optimism/op-challenger/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+var _ GamePlayer = (*fault.GamePlayer)(nil)
+
+var (
+	game1 = common.Address{0xaa}
+	game2 = common.Address{0xbb}
+)
+
+func TestListGames(t *testing.T) {
+	api, games, _ := setupAPITest(t)
+	games.states = []scheduler.GameState{
+		{Proxy: game1, Status: types.GameStatusInProgress, Inflight: true},
+		{Proxy: game2, Status: types.GameStatusDefenderWon, Paused: true},
+	}
+
+	result, err := api.ListGames(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []Game{
+		{Proxy: game1, Status: "In Progress", Inflight: true},
+		{Proxy: game2, Status: "Defender Won", Paused: true},
+	}, result)
+}
+
+func TestClaims(t *testing.T) {
+	api, games, player := setupAPITest(t)
+	player.claims = []faultTypes.Claim{
+		{
+			ClaimData:     faultTypes.ClaimData{Value: common.Hash{0x01}, Position: faultTypes.NewPosition(0, big.NewInt(0))},
+			ContractIndex: 0,
+		},
+		{
+			ClaimData:           faultTypes.ClaimData{Value: common.Hash{0x02}, Position: faultTypes.NewPosition(1, big.NewInt(1))},
+			Countered:           true,
+			Clock:               5,
+			ContractIndex:       1,
+			ParentContractIndex: 0,
+		},
+	}
+
+	result, err := api.Claims(context.Background(), game1)
+	require.NoError(t, err)
+	require.Equal(t, []Claim{
+		{Index: 0, ParentIndex: 0, Value: common.Hash{0x01}, Depth: 0, IndexAtDepth: (*hexutil.Big)(big.NewInt(0))},
+		{Index: 1, ParentIndex: 0, Value: common.Hash{0x02}, Depth: 1, IndexAtDepth: (*hexutil.Big)(big.NewInt(1)), Countered: true, Clock: 5},
+	}, result)
+
+	_, err = api.Claims(context.Background(), game2)
+	require.ErrorIs(t, err, ErrUnknownGame)
+
+	games.states = append(games.states, scheduler.GameState{Proxy: game2})
+	_, err = api.Claims(context.Background(), game2)
+	require.ErrorIs(t, err, ErrNotInspectable, "should not inspect game without player")
+}
+
+func TestPlannedActions(t *testing.T) {
+	api, _, player := setupAPITest(t)
+	player.actions = []faultTypes.Action{
+		{Type: faultTypes.ActionTypeMove, ParentIdx: 1, IsAttack: true, Value: common.Hash{0x03}},
+		{Type: faultTypes.ActionTypeStep, ParentIdx: 2, PreState: []byte{0x04}, ProofData: []byte{0x05}},
+	}
+
+	result, err := api.PlannedActions(context.Background(), game1)
+	require.NoError(t, err)
+	value := common.Hash{0x03}
+	require.Equal(t, []Action{
+		{Type: "move", ParentIdx: 1, IsAttack: true, Value: &value},
+		{Type: "step", ParentIdx: 2, PreState: []byte{0x04}, ProofData: []byte{0x05}},
+	}, result)
+}
+
+func TestCannonStatus(t *testing.T) {
+	api, _, player := setupAPITest(t)
+	result, err := api.CannonStatus(context.Background(), game1)
+	require.NoError(t, err)
+	require.Nil(t, result, "should not report status of game without cannon")
+
+	player.cannon = &cannon.ExecutionStatus{
+		Executions: 2,
+		TraceIndex: 10,
+		StartedAt:  time.Unix(1000, 0),
+		Duration:   3 * time.Second,
+		Err:        errors.New("boom"),
+	}
+	result, err = api.CannonStatus(context.Background(), game1)
+	require.NoError(t, err)
+	require.Equal(t, &CannonStatus{Executions: 2, TraceIndex: 10, StartedAt: 1000, Duration: 3, Error: "boom"}, result)
+}
+
+func TestAdmin(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	_, games, player := setupAPITest(t)
+	blacklist := &stubBlacklist{}
+	api := NewAdminAPI(games, blacklist, metrics.NoopMetrics, logger)
+	ctx := context.Background()
+
+	require.NoError(t, api.PauseGame(ctx, game1))
+	require.True(t, games.paused[game1])
+	require.NoError(t, api.ResumeGame(ctx, game1))
+	require.False(t, games.paused[game1])
+
+	require.NoError(t, api.ResolveGame(ctx, game1))
+	require.Equal(t, 1, player.resolveCount)
+	player.resolveErr = errors.New("not resolvable")
+	require.ErrorIs(t, api.ResolveGame(ctx, game1), player.resolveErr)
+	require.ErrorIs(t, api.ResolveGame(ctx, game2), ErrUnknownGame)
+
+	require.NoError(t, api.BlacklistGame(ctx, game2))
+	blacklisted, err := api.BlacklistedGames(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{game2}, blacklisted)
+	require.NoError(t, api.RemoveFromBlacklist(ctx, game2))
+	blacklisted, err = api.BlacklistedGames(ctx)
+	require.NoError(t, err)
+	require.Empty(t, blacklisted)
+}
+
+func setupAPITest(t *testing.T) (*challengerAPI, *stubGames, *stubPlayer) {
+	player := &stubPlayer{}
+	games := &stubGames{
+		states: []scheduler.GameState{{Proxy: game1, Status: types.GameStatusInProgress, Player: player}},
+		paused: make(map[common.Address]bool),
+	}
+	return NewChallengerAPI(games, metrics.NoopMetrics), games, player
+}
+
+type stubGames struct {
+	states []scheduler.GameState
+	paused map[common.Address]bool
+}
+
+func (s *stubGames) Games() []scheduler.GameState {
+	return s.states
+}
+
+func (s *stubGames) PauseGame(addr common.Address) {
+	s.paused[addr] = true
+}
+
+func (s *stubGames) ResumeGame(addr common.Address) {
+	delete(s.paused, addr)
+}
+
+type stubPlayer struct {
+	claims       []faultTypes.Claim
+	actions      []faultTypes.Action
+	cannon       *cannon.ExecutionStatus
+	resolveCount int
+	resolveErr   error
+}
+
+func (s *stubPlayer) ProgressGame(ctx context.Context) types.GameStatus {
+	return types.GameStatusInProgress
+}
+
+func (s *stubPlayer) Status() types.GameStatus {
+	return types.GameStatusInProgress
+}
+
+func (s *stubPlayer) Claims(ctx context.Context) ([]faultTypes.Claim, error) {
+	return s.claims, nil
+}
+
+func (s *stubPlayer) PlannedActions() []faultTypes.Action {
+	return s.actions
+}
+
+func (s *stubPlayer) Resolve(ctx context.Context) error {
+	s.resolveCount++
+	return s.resolveErr
+}
+
+func (s *stubPlayer) CannonStatus() (cannon.ExecutionStatus, bool) {
+	if s.cannon == nil {
+		return cannon.ExecutionStatus{}, false
+	}
+	return *s.cannon, true
+}
+
+type stubBlacklist struct {
+	games []common.Address
+}
+
+func (s *stubBlacklist) BlacklistGame(addr common.Address) error {
+	s.games = append(s.games, addr)
+	return nil
+}
+
+func (s *stubBlacklist) RemoveFromBlacklist(addr common.Address) error {
+	for i, game := range s.games {
+		if game == addr {
+			s.games = append(s.games[:i], s.games[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (s *stubBlacklist) BlacklistedGames() ([]common.Address, error) {
+	return s.games, nil
+}
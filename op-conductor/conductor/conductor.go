@@ -0,0 +1,401 @@
+package conductor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-conductor/health"
+	"github.com/ethereum-optimism/optimism/op-conductor/metrics"
+	conductorrpc "github.com/ethereum-optimism/optimism/op-conductor/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/dial"
+	"github.com/ethereum-optimism/optimism/op-service/httputil"
+	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+)
+
+var ErrAlreadyStopped = errors.New("conductor is already stopped")
+
+// SequencerControl is the part of the rollup node API that the sequencer is monitored and controlled with.
+type SequencerControl interface {
+	health.NodeClient
+	StartSequencer(ctx context.Context, unsafeHead common.Hash) error
+	StopSequencer(ctx context.Context) (common.Hash, error)
+}
+
+// OpConductor runs alongside a sequencer op-node, and ensures that only the sequencer of the leader of the
+// conductor cluster is active. The leader is elected with raft. If the sequencer of the leader becomes
+// unhealthy, the leader stops it and transfers the leadership to another conductor, which starts its sequencer.
+//
+// The leader commits the unsafe head of its sequencer to the cluster, so the next leader can wait for its
+// sequencer to catch up with the previous leader, bounding the number of unsafe blocks lost on a handover.
+type OpConductor struct {
+	log     log.Logger
+	cfg     *Config
+	metrics metrics.Metricer
+	version string
+	clock   clock.Clock
+
+	cons consensus.Consensus
+	node SequencerControl
+	hmon health.Monitor
+
+	leader      atomic.Bool
+	healthy     atomic.Bool
+	paused      atomic.Bool
+	leaderSince time.Time
+	// healthChecked is set once the health monitor reported the first result, the health is unknown until then.
+	healthChecked atomic.Bool
+
+	rpcServer   *oprpc.Server
+	metricsSrv  *httputil.HTTPServer
+	pprofSrv    *httputil.HTTPServer
+	pprofPusher *oppprof.Pusher
+
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	stopped atomic.Bool
+}
+
+var _ conductorrpc.Conductor = (*OpConductor)(nil)
+
+// New creates an OpConductor as configured: it dials the op-node, and joins the raft cluster.
+func New(ctx context.Context, cfg *Config, log log.Logger, version string) (*OpConductor, error) {
+	m := metrics.NewMetrics("default")
+	nodeOpts, err := dial.TLSOptions(log, cfg.NodeRPCTLS)
+	if err != nil {
+		return nil, err
+	}
+	node, err := dial.DialRollupClientWithTimeout(ctx, dial.DefaultDialTimeout, log, cfg.NodeRPC, nodeOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial op-node: %w", err)
+	}
+	cons, err := consensus.NewRaftConsensus(log, &consensus.RaftConsensusConfig{
+		ServerID:       cfg.RaftServerID,
+		ListenAddr:     cfg.ConsensusAddr,
+		AdvertisedAddr: cfg.ConsensusAdvertisedAddr,
+		StorageDir:     cfg.RaftStorageDir,
+		Bootstrap:      cfg.RaftBootstrap,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start raft consensus: %w", err)
+	}
+	hmon := health.NewSequencerMonitor(log, node, clock.SystemClock,
+		cfg.HealthCheck.Interval, cfg.HealthCheck.UnsafeInterval, cfg.HealthCheck.MaxL1Lag)
+
+	c := newOpConductor(log, cfg, m, version, clock.SystemClock, cons, node, hmon)
+	if err := c.initServers(cfg); err != nil {
+		return nil, errors.Join(err, c.Stop(ctx))
+	}
+	return c, nil
+}
+
+func newOpConductor(log log.Logger, cfg *Config, m metrics.Metricer, version string, cl clock.Clock,
+	cons consensus.Consensus, node SequencerControl, hmon health.Monitor) *OpConductor {
+	c := &OpConductor{
+		log:     log,
+		cfg:     cfg,
+		metrics: m,
+		version: version,
+		clock:   cl,
+		cons:    cons,
+		node:    node,
+		hmon:    hmon,
+	}
+	c.paused.Store(cfg.Paused)
+	return c
+}
+
+func (c *OpConductor) initServers(cfg *Config) error {
+	if cfg.MetricsConfig.Enabled {
+		c.log.Debug("Starting metrics server", "addr", cfg.MetricsConfig.ListenAddr, "port", cfg.MetricsConfig.ListenPort)
+		m, ok := c.metrics.(*metrics.Metrics)
+		if !ok {
+			return errors.New("metrics were enabled, but metricer is not a registry-metricer")
+		}
+		srv, err := m.Start(cfg.MetricsConfig.ListenAddr, cfg.MetricsConfig.ListenPort)
+		if err != nil {
+			return fmt.Errorf("failed to start metrics server: %w", err)
+		}
+		c.metricsSrv = srv
+		c.log.Info("Started metrics server", "addr", srv.Addr())
+	}
+	c.pprofPusher = oppprof.StartPusher(c.log, cfg.PprofConfig, "op-conductor", c.version)
+	if cfg.PprofConfig.Enabled {
+		c.log.Debug("Starting pprof server", "addr", net.JoinHostPort(cfg.PprofConfig.ListenAddr, strconv.Itoa(cfg.PprofConfig.ListenPort)))
+		srv, err := oppprof.StartServer(cfg.PprofConfig.ListenAddr, cfg.PprofConfig.ListenPort)
+		if err != nil {
+			return fmt.Errorf("failed to start pprof server: %w", err)
+		}
+		c.pprofSrv = srv
+		c.log.Info("Started pprof server", "addr", srv.Addr())
+	}
+	tlsConfig, err := cfg.RPC.ServerTLSConfig(c.log)
+	if err != nil {
+		return err
+	}
+	server := oprpc.NewServer(
+		cfg.RPC.ListenAddr,
+		cfg.RPC.ListenPort,
+		c.version,
+		oprpc.WithLogger(c.log),
+		oprpc.WithTLSConfig(tlsConfig),
+	)
+	server.AddAPI(conductorrpc.GetConductorAPI(conductorrpc.NewConductorAPI(c, c.metrics, c.log)))
+	c.log.Info("Starting JSON-RPC server")
+	if err := server.Start(); err != nil {
+		return fmt.Errorf("unable to start RPC server: %w", err)
+	}
+	c.rpcServer = server
+	return nil
+}
+
+func (c *OpConductor) Start(_ context.Context) error {
+	c.log.Info("Starting conductor", "server_id", c.cons.ServerID(), "paused", c.paused.Load())
+	if err := c.hmon.Start(); err != nil {
+		return fmt.Errorf("failed to start health monitor: %w", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.wg.Add(1)
+	go c.loop(ctx)
+	c.metrics.RecordInfo(c.version)
+	c.metrics.RecordUp()
+	return nil
+}
+
+// Stop stops the conductor. The sequencer is left as is: stopping the conductor of the active sequencer
+// does not stop the sequencer, but the leadership is lost, and the next leader stops it if it is still reachable.
+func (c *OpConductor) Stop(ctx context.Context) error {
+	if c.stopped.Load() {
+		return ErrAlreadyStopped
+	}
+	c.log.Info("Stopping conductor")
+	var result error
+	if c.cancel != nil {
+		c.cancel()
+		c.wg.Wait()
+		result = errors.Join(result, c.hmon.Stop())
+	}
+	if c.rpcServer != nil {
+		result = errors.Join(result, c.rpcServer.Stop())
+	}
+	if err := c.cons.Shutdown(); err != nil {
+		result = errors.Join(result, err)
+	}
+	if c.pprofSrv != nil {
+		result = errors.Join(result, c.pprofSrv.Stop(ctx))
+	}
+	if c.pprofPusher != nil {
+		result = errors.Join(result, c.pprofPusher.Stop(ctx))
+	}
+	if c.metricsSrv != nil {
+		result = errors.Join(result, c.metricsSrv.Stop(ctx))
+	}
+	if result == nil {
+		c.stopped.Store(true)
+		c.log.Info("Conductor stopped")
+	}
+	return result
+}
+
+func (c *OpConductor) Stopped() bool {
+	return c.stopped.Load()
+}
+
+func (c *OpConductor) Pause(_ context.Context) error {
+	if !c.paused.CompareAndSwap(false, true) {
+		return errors.New("conductor is already paused")
+	}
+	c.log.Info("Paused conductor")
+	return nil
+}
+
+func (c *OpConductor) Resume(_ context.Context) error {
+	if !c.paused.CompareAndSwap(true, false) {
+		return errors.New("conductor is not paused")
+	}
+	c.log.Info("Resumed conductor")
+	return nil
+}
+
+func (c *OpConductor) Paused() bool {
+	return c.paused.Load()
+}
+
+func (c *OpConductor) SequencerHealthy() bool {
+	return c.healthy.Load()
+}
+
+func (c *OpConductor) Leader() bool {
+	return c.cons.Leader()
+}
+
+func (c *OpConductor) LeaderWithID() *consensus.ServerInfo {
+	return c.cons.LeaderWithID()
+}
+
+func (c *OpConductor) TransferLeader() error {
+	return c.cons.TransferLeader()
+}
+
+func (c *OpConductor) TransferLeaderToServer(id string, addr string) error {
+	return c.cons.TransferLeaderTo(id, addr)
+}
+
+func (c *OpConductor) AddServerAsVoter(id string, addr string) error {
+	return c.cons.AddVoter(id, addr)
+}
+
+func (c *OpConductor) AddServerAsNonvoter(id string, addr string) error {
+	return c.cons.AddNonVoter(id, addr)
+}
+
+func (c *OpConductor) RemoveServer(id string) error {
+	return c.cons.RemoveServer(id)
+}
+
+func (c *OpConductor) ClusterMembership() ([]*consensus.ServerInfo, error) {
+	return c.cons.ClusterMembership()
+}
+
+func (c *OpConductor) loop(ctx context.Context) {
+	defer c.wg.Done()
+	c.setLeader(c.cons.Leader())
+	// Actions that failed are retried, and the unsafe head of the leader is committed, every health check interval.
+	ticker := c.clock.NewTicker(c.cfg.HealthCheck.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case leader := <-c.cons.LeaderCh():
+			c.setLeader(leader)
+		case err := <-c.hmon.Subscribe():
+			c.setHealthy(err == nil)
+		case <-ticker.Ch():
+		case <-ctx.Done():
+			return
+		}
+		c.act(ctx)
+	}
+}
+
+func (c *OpConductor) setLeader(leader bool) {
+	if c.leader.Swap(leader) == leader {
+		return
+	}
+	if leader {
+		c.leaderSince = c.clock.Now()
+	}
+	c.log.Info("Leadership changed", "leader", leader)
+}
+
+func (c *OpConductor) setHealthy(healthy bool) {
+	c.healthy.Store(healthy)
+	if !c.healthChecked.Swap(true) {
+		c.log.Info("First sequencer health check", "healthy", healthy)
+	}
+}
+
+// act brings the sequencer in line with the leadership of the conductor and the health of the sequencer.
+func (c *OpConductor) act(ctx context.Context) {
+	if c.paused.Load() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.HealthCheck.Interval)
+	defer cancel()
+	leader, healthy := c.leader.Load(), c.healthy.Load()
+	if leader && !c.healthChecked.Load() {
+		// The sequencer is not known to be unhealthy yet, the leadership must not be transferred because of it.
+		c.log.Debug("Waiting for the first sequencer health check")
+		return
+	}
+	active, err := c.node.SequencerActive(ctx)
+	if err != nil {
+		c.log.Warn("Failed to fetch sequencer status", "err", err)
+		c.metrics.RecordState(leader, false, false)
+		// The sequencer of the leader must be reachable.
+		if leader {
+			c.transferLeader()
+		}
+		return
+	}
+	c.metrics.RecordState(leader, healthy, active)
+
+	switch {
+	case leader && healthy && !active:
+		c.startSequencer(ctx)
+	case leader && healthy && active:
+		c.commitUnsafeHead(ctx)
+	case leader && !healthy:
+		if active {
+			c.stopSequencer(ctx)
+		}
+		c.transferLeader()
+	case !leader && active:
+		c.stopSequencer(ctx)
+	}
+}
+
+func (c *OpConductor) startSequencer(ctx context.Context) {
+	status, err := c.node.SyncStatus(ctx)
+	if err != nil {
+		c.log.Warn("Failed to fetch sync status", "err", err)
+		return
+	}
+	unsafeHead := status.UnsafeL2.ID()
+	committed := c.cons.LatestUnsafeHead()
+	if unsafeHead.Number+c.cfg.HandoverMaxUnsafeLag < committed.Number {
+		if c.clock.Now().Sub(c.leaderSince) < c.cfg.HandoverTimeout {
+			c.log.Info("Waiting for the sequencer to catch up with the previous leader", "unsafe", unsafeHead, "committed", committed)
+			return
+		}
+		lost := committed.Number - unsafeHead.Number
+		c.log.Warn("Sequencer did not catch up with the previous leader, dropping its unsafe blocks", "unsafe", unsafeHead, "committed", committed, "lost", lost)
+		c.metrics.RecordHandoverLostBlocks(lost)
+	}
+	if err := c.node.StartSequencer(ctx, unsafeHead.Hash); err != nil {
+		c.log.Error("Failed to start sequencer", "unsafe", unsafeHead, "err", err)
+		return
+	}
+	c.log.Info("Started sequencer", "unsafe", unsafeHead)
+}
+
+func (c *OpConductor) stopSequencer(ctx context.Context) {
+	head, err := c.node.StopSequencer(ctx)
+	if err != nil {
+		c.log.Error("Failed to stop sequencer", "err", err)
+		return
+	}
+	c.log.Info("Stopped sequencer", "unsafe", head)
+}
+
+func (c *OpConductor) commitUnsafeHead(ctx context.Context) {
+	status, err := c.node.SyncStatus(ctx)
+	if err != nil {
+		c.log.Warn("Failed to fetch sync status", "err", err)
+		return
+	}
+	if head := status.UnsafeL2.ID(); head != c.cons.LatestUnsafeHead() {
+		if err := c.cons.CommitUnsafeHead(head); err != nil {
+			c.log.Warn("Failed to commit unsafe head", "unsafe", head, "err", err)
+		}
+	}
+}
+
+func (c *OpConductor) transferLeader() {
+	if err := c.cons.TransferLeader(); err != nil {
+		c.log.Error("Failed to transfer leadership", "err", err)
+		return
+	}
+	c.log.Info("Transferred leadership")
+}
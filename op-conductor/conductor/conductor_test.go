@@ -0,0 +1,191 @@
+package conductor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-conductor/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type mockConsensus struct {
+	consensus.Consensus
+	leader      bool
+	transferred int
+	committed   eth.BlockID
+}
+
+func (m *mockConsensus) Leader() bool { return m.leader }
+
+func (m *mockConsensus) TransferLeader() error {
+	m.transferred++
+	m.leader = false
+	return nil
+}
+
+func (m *mockConsensus) CommitUnsafeHead(head eth.BlockID) error {
+	m.committed = head
+	return nil
+}
+
+func (m *mockConsensus) LatestUnsafeHead() eth.BlockID { return m.committed }
+
+type mockNode struct {
+	active    bool
+	activeErr error
+	unsafe    eth.L2BlockRef
+	startedAt common.Hash
+}
+
+func (m *mockNode) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
+	return &eth.SyncStatus{UnsafeL2: m.unsafe}, nil
+}
+
+func (m *mockNode) SequencerActive(ctx context.Context) (bool, error) {
+	return m.active, m.activeErr
+}
+
+func (m *mockNode) StartSequencer(ctx context.Context, unsafeHead common.Hash) error {
+	m.active = true
+	m.startedAt = unsafeHead
+	return nil
+}
+
+func (m *mockNode) StopSequencer(ctx context.Context) (common.Hash, error) {
+	m.active = false
+	return m.unsafe.Hash, nil
+}
+
+type testSetup struct {
+	c     *OpConductor
+	cons  *mockConsensus
+	node  *mockNode
+	clock *clock.DeterministicClock
+}
+
+func setup(t *testing.T, leader, healthy, active bool) *testSetup {
+	cfg := &Config{
+		HealthCheck:          HealthCheckConfig{Interval: time.Second},
+		HandoverMaxUnsafeLag: 2,
+		HandoverTimeout:      10 * time.Second,
+	}
+	cl := clock.NewDeterministicClock(time.Unix(1000, 0))
+	cons := &mockConsensus{}
+	node := &mockNode{
+		active: active,
+		unsafe: eth.L2BlockRef{Hash: common.Hash{0xaa}, Number: 100},
+	}
+	c := newOpConductor(testlog.Logger(t, log.LvlCrit), cfg, metrics.NoopMetrics, "test", cl, cons, node, nil)
+	cons.leader = leader
+	c.setLeader(leader)
+	c.setHealthy(healthy)
+	return &testSetup{c: c, cons: cons, node: node, clock: cl}
+}
+
+func TestActLeaderStartsSequencer(t *testing.T) {
+	s := setup(t, true, true, false)
+	s.c.act(context.Background())
+	require.True(t, s.node.active)
+	require.Equal(t, common.Hash{0xaa}, s.node.startedAt)
+}
+
+func TestActLeaderCommitsUnsafeHead(t *testing.T) {
+	s := setup(t, true, true, true)
+	s.c.act(context.Background())
+	require.Equal(t, s.node.unsafe.ID(), s.cons.committed)
+}
+
+func TestActUnhealthyLeaderStepsDown(t *testing.T) {
+	s := setup(t, true, false, true)
+	s.c.act(context.Background())
+	require.False(t, s.node.active)
+	require.Equal(t, 1, s.cons.transferred)
+}
+
+func TestActUnreachableLeaderStepsDown(t *testing.T) {
+	s := setup(t, true, true, true)
+	s.node.activeErr = errors.New("unreachable")
+	s.c.act(context.Background())
+	require.Equal(t, 1, s.cons.transferred)
+}
+
+func TestActFollowerStopsSequencer(t *testing.T) {
+	s := setup(t, false, true, true)
+	s.c.act(context.Background())
+	require.False(t, s.node.active)
+	require.Zero(t, s.cons.transferred)
+}
+
+func TestActFollowerStaysInactive(t *testing.T) {
+	s := setup(t, false, false, false)
+	s.c.act(context.Background())
+	require.False(t, s.node.active)
+	require.Zero(t, s.cons.transferred)
+}
+
+func TestActLeaderWaitsForHealthCheck(t *testing.T) {
+	s := setup(t, true, false, false)
+	s.c.healthChecked.Store(false)
+	s.c.act(context.Background())
+	require.False(t, s.node.active, "must not start before the sequencer is known to be healthy")
+	require.Zero(t, s.cons.transferred, "must not step down before the sequencer is known to be unhealthy")
+
+	s.c.setHealthy(true)
+	s.c.act(context.Background())
+	require.True(t, s.node.active)
+	require.Zero(t, s.cons.transferred)
+}
+
+func TestActFollowerStopsSequencerBeforeHealthCheck(t *testing.T) {
+	s := setup(t, false, false, true)
+	s.c.healthChecked.Store(false)
+	s.c.act(context.Background())
+	require.False(t, s.node.active)
+}
+
+func TestActPaused(t *testing.T) {
+	s := setup(t, true, false, true)
+	require.NoError(t, s.c.Pause(context.Background()))
+	s.c.act(context.Background())
+	require.True(t, s.node.active)
+	require.Zero(t, s.cons.transferred)
+
+	require.NoError(t, s.c.Resume(context.Background()))
+	s.c.act(context.Background())
+	require.False(t, s.node.active)
+	require.Equal(t, 1, s.cons.transferred)
+}
+
+func TestActWaitsForHandover(t *testing.T) {
+	s := setup(t, true, true, false)
+	// The previous leader committed blocks beyond the max unsafe lag.
+	s.cons.committed = eth.BlockID{Hash: common.Hash{0xbb}, Number: 105}
+	s.c.act(context.Background())
+	require.False(t, s.node.active, "must wait for the sequencer to catch up")
+
+	s.node.unsafe = eth.L2BlockRef{Hash: common.Hash{0xcc}, Number: 103}
+	s.c.act(context.Background())
+	require.True(t, s.node.active, "starts once within the max unsafe lag")
+	require.Equal(t, common.Hash{0xcc}, s.node.startedAt)
+}
+
+func TestActHandoverTimeout(t *testing.T) {
+	s := setup(t, true, true, false)
+	s.cons.committed = eth.BlockID{Hash: common.Hash{0xbb}, Number: 105}
+	s.c.act(context.Background())
+	require.False(t, s.node.active)
+
+	s.clock.AdvanceTime(10 * time.Second)
+	s.c.act(context.Background())
+	require.True(t, s.node.active, "starts after the handover timeout")
+	require.Equal(t, common.Hash{0xaa}, s.node.startedAt)
+}
@@ -0,0 +1,120 @@
+package conductor
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-conductor/flags"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+)
+
+type Config struct {
+	// ConsensusAddr is the host:port that the raft consensus listens on.
+	ConsensusAddr string
+	// ConsensusAdvertisedAddr is the host:port that the other conductors reach the raft consensus on.
+	// The ConsensusAddr is advertised if empty.
+	ConsensusAdvertisedAddr string
+
+	// RaftServerID is the unique ID of the conductor in the raft cluster.
+	RaftServerID string
+	// RaftStorageDir is the directory that the raft log and snapshots are persisted in.
+	RaftStorageDir string
+	// RaftBootstrap bootstraps a new raft cluster with this conductor as the only voter.
+	RaftBootstrap bool
+
+	// NodeRPC is the HTTP provider URL of the sequencer op-node.
+	NodeRPC string
+	// NodeRPCTLS authenticates with the op-node over mutual TLS, if set.
+	NodeRPCTLS optls.CLIConfig
+
+	HealthCheck HealthCheckConfig
+
+	// HandoverMaxUnsafeLag is the number of blocks that the unsafe head of a new leader may be behind
+	// the unsafe head committed by the previous leader, before it waits for its sequencer to catch up.
+	HandoverMaxUnsafeLag uint64
+	// HandoverTimeout is the maximum time that a new leader waits for its sequencer to catch up.
+	HandoverTimeout time.Duration
+
+	// Paused starts the conductor without controlling the sequencer.
+	Paused bool
+
+	LogConfig     oplog.CLIConfig
+	MetricsConfig opmetrics.CLIConfig
+	PprofConfig   oppprof.CLIConfig
+	RPC           oprpc.CLIConfig
+}
+
+type HealthCheckConfig struct {
+	// Interval is the interval between the health checks of the sequencer.
+	Interval time.Duration
+	// UnsafeInterval is the maximum age of the unsafe head of an active sequencer.
+	UnsafeInterval time.Duration
+	// MaxL1Lag is the maximum age of the L1 head of the sequencer.
+	MaxL1Lag time.Duration
+}
+
+func (c *Config) Check() error {
+	if c.ConsensusAddr == "" {
+		return errors.New("missing consensus address")
+	}
+	if c.RaftServerID == "" {
+		return errors.New("missing raft server ID")
+	}
+	if c.RaftStorageDir == "" {
+		return errors.New("missing raft storage dir")
+	}
+	if c.NodeRPC == "" {
+		return errors.New("missing node RPC")
+	}
+	if err := c.NodeRPCTLS.Check(); err != nil {
+		return fmt.Errorf("invalid node RPC tls config: %w", err)
+	}
+	if c.HealthCheck.Interval == 0 {
+		return errors.New("health check interval must not be 0")
+	}
+	if c.HealthCheck.UnsafeInterval < c.HealthCheck.Interval {
+		return fmt.Errorf("health check unsafe interval %v must not be less than the interval %v", c.HealthCheck.UnsafeInterval, c.HealthCheck.Interval)
+	}
+	if err := c.MetricsConfig.Check(); err != nil {
+		return err
+	}
+	if err := c.PprofConfig.Check(); err != nil {
+		return err
+	}
+	if err := c.RPC.Check(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// NewConfig parses the Config from the provided flags or environment variables.
+func NewConfig(ctx *cli.Context) *Config {
+	return &Config{
+		ConsensusAddr:           ctx.String(flags.ConsensusAddrFlag.Name),
+		ConsensusAdvertisedAddr: ctx.String(flags.ConsensusAdvertisedAddrFlag.Name),
+		RaftServerID:            ctx.String(flags.RaftServerIDFlag.Name),
+		RaftStorageDir:          ctx.String(flags.RaftStorageDirFlag.Name),
+		RaftBootstrap:           ctx.Bool(flags.RaftBootstrapFlag.Name),
+		NodeRPC:                 ctx.String(flags.NodeRPCFlag.Name),
+		NodeRPCTLS:              optls.ReadCLIConfigWithPrefix(ctx, flags.NodeRPCFlag.Name),
+		HealthCheck: HealthCheckConfig{
+			Interval:       ctx.Duration(flags.HealthCheckIntervalFlag.Name),
+			UnsafeInterval: ctx.Duration(flags.HealthCheckUnsafeIntervalFlag.Name),
+			MaxL1Lag:       ctx.Duration(flags.HealthCheckMaxL1LagFlag.Name),
+		},
+		HandoverMaxUnsafeLag: ctx.Uint64(flags.HandoverMaxUnsafeLagFlag.Name),
+		HandoverTimeout:      ctx.Duration(flags.HandoverTimeoutFlag.Name),
+		Paused:               ctx.Bool(flags.PausedFlag.Name),
+		LogConfig:            oplog.ReadCLIConfig(ctx),
+		MetricsConfig:        opmetrics.ReadCLIConfig(ctx),
+		PprofConfig:          oppprof.ReadCLIConfig(ctx),
+		RPC:                  oprpc.ReadCLIConfig(ctx),
+	}
+}
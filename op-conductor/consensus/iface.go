@@ -0,0 +1,63 @@
+package consensus
+
+import (
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// ServerSuffrage determines whether a server in the cluster votes in leader elections.
+type ServerSuffrage int
+
+const (
+	Voter ServerSuffrage = iota
+	Nonvoter
+)
+
+func (s ServerSuffrage) String() string {
+	switch s {
+	case Voter:
+		return "Voter"
+	case Nonvoter:
+		return "Nonvoter"
+	}
+	return "ServerSuffrage"
+}
+
+// ServerInfo identifies a server of the consensus cluster.
+type ServerInfo struct {
+	ID       string         `json:"id"`
+	Addr     string         `json:"addr"`
+	Suffrage ServerSuffrage `json:"suffrage"`
+}
+
+// Consensus elects the leader of a cluster of conductors, the sequencer of which is the only active sequencer.
+// The leader replicates the unsafe head of its sequencer to the cluster, so the next leader knows where it left off.
+type Consensus interface {
+	// LeaderCh notifies when this server gains (true) or loses (false) leadership.
+	LeaderCh() <-chan bool
+	// Leader returns true if this server is the leader of the cluster.
+	Leader() bool
+	// LeaderWithID returns the current leader of the cluster, if there is one.
+	LeaderWithID() *ServerInfo
+	// ServerID returns the ID of this server.
+	ServerID() string
+	// TransferLeader transfers the leadership to another voter of the cluster.
+	TransferLeader() error
+	// TransferLeaderTo transfers the leadership to the given voter of the cluster.
+	TransferLeaderTo(id string, addr string) error
+	// AddVoter adds a voting server to the cluster. Can only be called on the leader.
+	AddVoter(id string, addr string) error
+	// AddNonVoter adds a server that replicates the cluster state without voting. Can only be called on the leader.
+	AddNonVoter(id string, addr string) error
+	// RemoveServer removes a server from the cluster. Can only be called on the leader.
+	RemoveServer(id string) error
+	// ClusterMembership returns the servers of the cluster.
+	ClusterMembership() ([]*ServerInfo, error)
+
+	// CommitUnsafeHead replicates the unsafe head of the sequencer of the leader to the cluster.
+	CommitUnsafeHead(head eth.BlockID) error
+	// LatestUnsafeHead returns the latest unsafe head that was committed to the cluster.
+	LatestUnsafeHead() eth.BlockID
+
+	// Shutdown leaves the cluster and stops the consensus.
+	Shutdown() error
+}
@@ -0,0 +1,252 @@
+package consensus
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/raft"
+	boltdb "github.com/hashicorp/raft-boltdb/v2"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+const (
+	defaultTimeout         = 5 * time.Second
+	maxTransportPoolSize   = 5
+	retainedSnapshotsCount = 2
+	raftLogStoreFileName   = "raft.db"
+	raftSnapshotsDirName   = "snapshots"
+	raftLogLevel           = hclog.Warn
+)
+
+// RaftConsensusConfig configures a RaftConsensus.
+type RaftConsensusConfig struct {
+	// ServerID is the unique ID of the server in the cluster.
+	ServerID string
+	// ListenAddr is the host:port that the raft transport listens on.
+	ListenAddr string
+	// AdvertisedAddr is the host:port that the other servers reach this server on, the ListenAddr if empty.
+	AdvertisedAddr string
+	// StorageDir is the directory that the raft log and snapshots are persisted in.
+	StorageDir string
+	// Bootstrap bootstraps a new cluster with this server as the only voter, if the server has no state yet.
+	Bootstrap bool
+}
+
+// RaftConsensus implements Consensus with the hashicorp raft implementation.
+type RaftConsensus struct {
+	serverID raft.ServerID
+	r        *raft.Raft
+	fsm      *unsafeHeadFSM
+	stores   io.Closer
+}
+
+var _ Consensus = (*RaftConsensus)(nil)
+
+// NewRaftConsensus starts a raft server as configured.
+func NewRaftConsensus(lgr log.Logger, cfg *RaftConsensusConfig) (*RaftConsensus, error) {
+	if err := os.MkdirAll(cfg.StorageDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create raft storage dir: %w", err)
+	}
+	rc := raft.DefaultConfig()
+	rc.LocalID = raft.ServerID(cfg.ServerID)
+	rc.Logger = hclog.New(&hclog.LoggerOptions{Name: "raft", Level: raftLogLevel})
+
+	store, err := boltdb.NewBoltStore(filepath.Join(cfg.StorageDir, raftLogStoreFileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open raft log store: %w", err)
+	}
+	snapshots, err := raft.NewFileSnapshotStoreWithLogger(filepath.Join(cfg.StorageDir, raftSnapshotsDirName), retainedSnapshotsCount, rc.Logger)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to open raft snapshot store: %w", err), store.Close())
+	}
+
+	advertised := cfg.AdvertisedAddr
+	if advertised == "" {
+		advertised = cfg.ListenAddr
+	}
+	advertisedAddr, err := net.ResolveTCPAddr("tcp", advertised)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("invalid advertised raft address: %w", err), store.Close())
+	}
+	transport, err := raft.NewTCPTransportWithLogger(cfg.ListenAddr, advertisedAddr, maxTransportPoolSize, defaultTimeout, rc.Logger)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to create raft transport: %w", err), store.Close())
+	}
+
+	if cfg.Bootstrap {
+		existing, err := raft.HasExistingState(store, store, snapshots)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to check raft state: %w", err), transport.Close(), store.Close())
+		}
+		if !existing {
+			lgr.Info("Bootstrapping new raft cluster", "server_id", cfg.ServerID, "addr", transport.LocalAddr())
+			boot := raft.Configuration{Servers: []raft.Server{{
+				Suffrage: raft.Voter,
+				ID:       rc.LocalID,
+				Address:  transport.LocalAddr(),
+			}}}
+			if err := raft.BootstrapCluster(rc, store, store, snapshots, transport, boot); err != nil {
+				return nil, errors.Join(fmt.Errorf("failed to bootstrap raft cluster: %w", err), transport.Close(), store.Close())
+			}
+		}
+	}
+
+	fsm := &unsafeHeadFSM{}
+	r, err := raft.NewRaft(rc, fsm, store, store, snapshots, transport)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to start raft: %w", err), transport.Close(), store.Close())
+	}
+	return &RaftConsensus{
+		serverID: rc.LocalID,
+		r:        r,
+		fsm:      fsm,
+		stores:   store,
+	}, nil
+}
+
+func (rc *RaftConsensus) LeaderCh() <-chan bool {
+	return rc.r.LeaderCh()
+}
+
+func (rc *RaftConsensus) Leader() bool {
+	return rc.r.State() == raft.Leader
+}
+
+func (rc *RaftConsensus) LeaderWithID() *ServerInfo {
+	addr, id := rc.r.LeaderWithID()
+	if id == "" {
+		return nil
+	}
+	return &ServerInfo{ID: string(id), Addr: string(addr), Suffrage: Voter}
+}
+
+func (rc *RaftConsensus) ServerID() string {
+	return string(rc.serverID)
+}
+
+func (rc *RaftConsensus) TransferLeader() error {
+	if err := rc.r.LeadershipTransfer().Error(); err != nil {
+		return fmt.Errorf("failed to transfer leadership: %w", err)
+	}
+	return nil
+}
+
+func (rc *RaftConsensus) TransferLeaderTo(id string, addr string) error {
+	if err := rc.r.LeadershipTransferToServer(raft.ServerID(id), raft.ServerAddress(addr)).Error(); err != nil {
+		return fmt.Errorf("failed to transfer leadership to %s: %w", id, err)
+	}
+	return nil
+}
+
+func (rc *RaftConsensus) AddVoter(id string, addr string) error {
+	return rc.r.AddVoter(raft.ServerID(id), raft.ServerAddress(addr), 0, defaultTimeout).Error()
+}
+
+func (rc *RaftConsensus) AddNonVoter(id string, addr string) error {
+	return rc.r.AddNonvoter(raft.ServerID(id), raft.ServerAddress(addr), 0, defaultTimeout).Error()
+}
+
+func (rc *RaftConsensus) RemoveServer(id string) error {
+	return rc.r.RemoveServer(raft.ServerID(id), 0, defaultTimeout).Error()
+}
+
+func (rc *RaftConsensus) ClusterMembership() ([]*ServerInfo, error) {
+	future := rc.r.GetConfiguration()
+	if err := future.Error(); err != nil {
+		return nil, err
+	}
+	var servers []*ServerInfo
+	for _, s := range future.Configuration().Servers {
+		suffrage := Voter
+		if s.Suffrage != raft.Voter {
+			suffrage = Nonvoter
+		}
+		servers = append(servers, &ServerInfo{ID: string(s.ID), Addr: string(s.Address), Suffrage: suffrage})
+	}
+	return servers, nil
+}
+
+func (rc *RaftConsensus) CommitUnsafeHead(head eth.BlockID) error {
+	data, err := json.Marshal(head)
+	if err != nil {
+		return err
+	}
+	if err := rc.r.Apply(data, defaultTimeout).Error(); err != nil {
+		return fmt.Errorf("failed to commit unsafe head: %w", err)
+	}
+	return nil
+}
+
+func (rc *RaftConsensus) LatestUnsafeHead() eth.BlockID {
+	return rc.fsm.Head()
+}
+
+func (rc *RaftConsensus) Shutdown() error {
+	if err := rc.r.Shutdown().Error(); err != nil {
+		return fmt.Errorf("failed to shut down raft: %w", err)
+	}
+	return rc.stores.Close()
+}
+
+// unsafeHeadFSM is the replicated state of the cluster: the latest unsafe head of the sequencer of the leader.
+type unsafeHeadFSM struct {
+	mu   sync.Mutex
+	head eth.BlockID
+}
+
+func (f *unsafeHeadFSM) Apply(l *raft.Log) any {
+	var head eth.BlockID
+	if err := json.Unmarshal(l.Data, &head); err != nil {
+		return fmt.Errorf("invalid unsafe head log entry: %w", err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	// Heads may go back on a reorg of the unsafe chain, so the latest committed head is kept.
+	f.head = head
+	return nil
+}
+
+func (f *unsafeHeadFSM) Head() eth.BlockID {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.head
+}
+
+func (f *unsafeHeadFSM) Snapshot() (raft.FSMSnapshot, error) {
+	return &unsafeHeadSnapshot{head: f.Head()}, nil
+}
+
+func (f *unsafeHeadFSM) Restore(snapshot io.ReadCloser) error {
+	defer snapshot.Close()
+	var head eth.BlockID
+	if err := json.NewDecoder(snapshot).Decode(&head); err != nil {
+		return fmt.Errorf("invalid unsafe head snapshot: %w", err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.head = head
+	return nil
+}
+
+type unsafeHeadSnapshot struct {
+	head eth.BlockID
+}
+
+func (s *unsafeHeadSnapshot) Persist(sink raft.SnapshotSink) error {
+	if err := json.NewEncoder(sink).Encode(s.head); err != nil {
+		return errors.Join(err, sink.Cancel())
+	}
+	return sink.Close()
+}
+
+func (s *unsafeHeadSnapshot) Release() {}
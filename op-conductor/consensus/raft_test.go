@@ -0,0 +1,56 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestRaftConsensusBootstrap(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &RaftConsensusConfig{
+		ServerID:   "server-0",
+		ListenAddr: "127.0.0.1:0",
+		StorageDir: dir,
+		Bootstrap:  true,
+	}
+	cons, err := NewRaftConsensus(testlog.Logger(t, log.LvlCrit), cfg)
+	require.NoError(t, err)
+
+	select {
+	case leader := <-cons.LeaderCh():
+		require.True(t, leader)
+	case <-time.After(10 * time.Second):
+		t.Fatal("server did not become the leader of the bootstrapped cluster")
+	}
+	require.True(t, cons.Leader())
+	require.Equal(t, "server-0", cons.ServerID())
+	require.Equal(t, "server-0", cons.LeaderWithID().ID)
+
+	members, err := cons.ClusterMembership()
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, Voter, members[0].Suffrage)
+
+	head := eth.BlockID{Hash: common.Hash{0x01}, Number: 10}
+	require.NoError(t, cons.CommitUnsafeHead(head))
+	require.Equal(t, head, cons.LatestUnsafeHead())
+
+	// a single server cluster has no other voter to transfer the leadership to
+	require.Error(t, cons.TransferLeader())
+	require.NoError(t, cons.Shutdown())
+
+	// the committed state is restored from the raft log on restart, and the cluster is not bootstrapped again
+	cons, err = NewRaftConsensus(testlog.Logger(t, log.LvlCrit), cfg)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return cons.LatestUnsafeHead() == head
+	}, 10*time.Second, 10*time.Millisecond)
+	require.NoError(t, cons.Shutdown())
+}
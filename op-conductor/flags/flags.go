@@ -0,0 +1,132 @@
+package flags
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+)
+
+const EnvVarPrefix = "OP_CONDUCTOR"
+
+func prefixEnvVars(name string) []string {
+	return opservice.PrefixEnvVar(EnvVarPrefix, name)
+}
+
+var (
+	// Required flags
+	ConsensusAddrFlag = &cli.StringFlag{
+		Name:    "consensus.addr",
+		Usage:   "Address (host:port) to listen on for the raft consensus of the conductor cluster",
+		EnvVars: prefixEnvVars("CONSENSUS_ADDR"),
+	}
+	RaftServerIDFlag = &cli.StringFlag{
+		Name:    "raft.server.id",
+		Usage:   "Unique ID of the conductor in the raft cluster",
+		EnvVars: prefixEnvVars("RAFT_SERVER_ID"),
+	}
+	RaftStorageDirFlag = &cli.StringFlag{
+		Name:    "raft.storage.dir",
+		Usage:   "Directory to persist the raft log and snapshots in",
+		EnvVars: prefixEnvVars("RAFT_STORAGE_DIR"),
+	}
+	NodeRPCFlag = &cli.StringFlag{
+		Name:    "node.rpc",
+		Usage:   "HTTP provider URL for the sequencer op-node, with the admin API enabled",
+		EnvVars: prefixEnvVars("NODE_RPC"),
+	}
+	// Optional flags
+	ConsensusAdvertisedAddrFlag = &cli.StringFlag{
+		Name:    "consensus.advertised",
+		Usage:   "Address (host:port) that the other conductors reach the raft consensus of this conductor on. Defaults to the consensus address.",
+		EnvVars: prefixEnvVars("CONSENSUS_ADVERTISED"),
+	}
+	RaftBootstrapFlag = &cli.BoolFlag{
+		Name:    "raft.bootstrap",
+		Usage:   "Bootstrap a new raft cluster with this conductor as the only voter, if it has no raft state yet. Other conductors are added with the conductor_addServerAsVoter RPC.",
+		EnvVars: prefixEnvVars("RAFT_BOOTSTRAP"),
+	}
+	HealthCheckIntervalFlag = &cli.DurationFlag{
+		Name:    "healthcheck.interval",
+		Usage:   "Interval between the health checks of the sequencer",
+		Value:   2 * time.Second,
+		EnvVars: prefixEnvVars("HEALTHCHECK_INTERVAL"),
+	}
+	HealthCheckUnsafeIntervalFlag = &cli.DurationFlag{
+		Name:    "healthcheck.unsafe-interval",
+		Usage:   "Maximum age of the unsafe head of an active sequencer, before the sequencer is unhealthy",
+		Value:   10 * time.Second,
+		EnvVars: prefixEnvVars("HEALTHCHECK_UNSAFE_INTERVAL"),
+	}
+	HealthCheckMaxL1LagFlag = &cli.DurationFlag{
+		Name:    "healthcheck.max-l1-lag",
+		Usage:   "Maximum age of the L1 head of the sequencer, before the sequencer is unhealthy",
+		Value:   2 * time.Minute,
+		EnvVars: prefixEnvVars("HEALTHCHECK_MAX_L1_LAG"),
+	}
+	HandoverMaxUnsafeLagFlag = &cli.Uint64Flag{
+		Name: "handover.max-unsafe-lag",
+		Usage: "Maximum number of blocks that the unsafe head of a new leader may be behind the last unsafe head committed by the previous leader, " +
+			"before the new leader waits for its sequencer to catch up before sequencing",
+		Value:   0,
+		EnvVars: prefixEnvVars("HANDOVER_MAX_UNSAFE_LAG"),
+	}
+	HandoverTimeoutFlag = &cli.DurationFlag{
+		Name:    "handover.timeout",
+		Usage:   "Maximum time that a new leader waits for its sequencer to catch up, before it sequences from its own unsafe head, dropping the blocks it is missing",
+		Value:   30 * time.Second,
+		EnvVars: prefixEnvVars("HANDOVER_TIMEOUT"),
+	}
+	PausedFlag = &cli.BoolFlag{
+		Name:    "paused",
+		Usage:   "Start the conductor paused, without controlling the sequencer until it is resumed with the conductor_resume RPC",
+		EnvVars: prefixEnvVars("PAUSED"),
+	}
+)
+
+var requiredFlags = []cli.Flag{
+	ConsensusAddrFlag,
+	RaftServerIDFlag,
+	RaftStorageDirFlag,
+	NodeRPCFlag,
+}
+
+var optionalFlags = []cli.Flag{
+	ConsensusAdvertisedAddrFlag,
+	RaftBootstrapFlag,
+	HealthCheckIntervalFlag,
+	HealthCheckUnsafeIntervalFlag,
+	HealthCheckMaxL1LagFlag,
+	HandoverMaxUnsafeLagFlag,
+	HandoverTimeoutFlag,
+	PausedFlag,
+}
+
+func init() {
+	optionalFlags = append(optionalFlags, oprpc.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, optls.OptionalCLIFlags(EnvVarPrefix, NodeRPCFlag.Name)...)
+	optionalFlags = append(optionalFlags, oplog.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, oppprof.CLIFlags(EnvVarPrefix)...)
+
+	Flags = append(requiredFlags, optionalFlags...)
+}
+
+// Flags contains the list of configuration options available to the binary.
+var Flags []cli.Flag
+
+func CheckRequired(ctx *cli.Context) error {
+	for _, f := range requiredFlags {
+		if !ctx.IsSet(f.Names()[0]) {
+			return fmt.Errorf("flag %s is required", f.Names()[0])
+		}
+	}
+	return nil
+}
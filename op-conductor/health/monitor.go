@@ -0,0 +1,139 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+var (
+	ErrSequencerNotProgressing = errors.New("sequencer is not producing unsafe blocks")
+	ErrL1Stale                 = errors.New("L1 head of the sequencer is stale")
+)
+
+// NodeClient is the part of the rollup node API that the health of the sequencer is monitored with.
+type NodeClient interface {
+	SyncStatus(ctx context.Context) (*eth.SyncStatus, error)
+	SequencerActive(ctx context.Context) (bool, error)
+}
+
+// Monitor periodically checks the health of the sequencer of a rollup node, and publishes the results.
+// A nil result is healthy.
+type Monitor interface {
+	Start() error
+	Stop() error
+	// Subscribe returns the channel that the results of the health checks are published on.
+	Subscribe() <-chan error
+}
+
+// SequencerMonitor checks that the rollup node is reachable, that it is connected to L1,
+// and that the sequencer, if it is active, produces unsafe blocks.
+type SequencerMonitor struct {
+	log    log.Logger
+	node   NodeClient
+	clock  clock.Clock
+	health chan error
+
+	interval       time.Duration
+	unsafeInterval time.Duration
+	maxL1Lag       time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ Monitor = (*SequencerMonitor)(nil)
+
+// NewSequencerMonitor creates a monitor that checks the health every interval.
+// The sequencer is unhealthy if it is active, but did not produce an unsafe block within the unsafe interval,
+// or if the latest L1 head of the node is older than the max L1 lag.
+func NewSequencerMonitor(lgr log.Logger, node NodeClient, cl clock.Clock, interval, unsafeInterval, maxL1Lag time.Duration) *SequencerMonitor {
+	return &SequencerMonitor{
+		log:            lgr,
+		node:           node,
+		clock:          cl,
+		health:         make(chan error, 1),
+		interval:       interval,
+		unsafeInterval: unsafeInterval,
+		maxL1Lag:       maxL1Lag,
+	}
+}
+
+func (m *SequencerMonitor) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	m.wg.Add(1)
+	go m.loop(ctx)
+	return nil
+}
+
+func (m *SequencerMonitor) Stop() error {
+	m.cancel()
+	m.wg.Wait()
+	return nil
+}
+
+func (m *SequencerMonitor) Subscribe() <-chan error {
+	return m.health
+}
+
+func (m *SequencerMonitor) loop(ctx context.Context) {
+	defer m.wg.Done()
+	ticker := m.clock.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.Ch():
+			err := m.check(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				m.log.Warn("Sequencer is unhealthy", "err", err)
+			}
+			select {
+			case m.health <- err:
+			case <-ctx.Done():
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (m *SequencerMonitor) check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, m.interval)
+	defer cancel()
+	status, err := m.node.SyncStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch sync status: %w", err)
+	}
+	active, err := m.node.SequencerActive(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch sequencer status: %w", err)
+	}
+	now := uint64(m.clock.Now().Unix())
+	age := func(timestamp uint64) time.Duration {
+		if timestamp >= now {
+			return 0
+		}
+		return time.Duration(now-timestamp) * time.Second
+	}
+	if lag := age(status.HeadL1.Time); lag > m.maxL1Lag {
+		return fmt.Errorf("%w: %v behind", ErrL1Stale, lag)
+	}
+	// The unsafe head of an inactive sequencer only progresses if another sequencer is active,
+	// so it is not an indication of the health of the sequencer.
+	if lag := age(status.UnsafeL2.Time); active && lag > m.unsafeInterval {
+		return fmt.Errorf("%w: unsafe head %v is %v behind", ErrSequencerNotProgressing, status.UnsafeL2.ID(), lag)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type mockNode struct {
+	status *eth.SyncStatus
+	active bool
+	err    error
+}
+
+func (m *mockNode) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
+	return m.status, m.err
+}
+
+func (m *mockNode) SequencerActive(ctx context.Context) (bool, error) {
+	return m.active, m.err
+}
+
+func TestSequencerMonitorCheck(t *testing.T) {
+	now := time.Unix(1000, 0)
+	tests := []struct {
+		name   string
+		l1Age  uint64
+		l2Age  uint64
+		active bool
+		err    error
+		expect string
+	}{
+		{name: "Healthy", l1Age: 12, l2Age: 2, active: true},
+		{name: "NodeUnreachable", err: errors.New("connection refused"), expect: "connection refused"},
+		{name: "L1Stale", l1Age: 121, l2Age: 2, active: true, expect: ErrL1Stale.Error()},
+		{name: "NotProgressing", l1Age: 12, l2Age: 11, active: true, expect: ErrSequencerNotProgressing.Error()},
+		{name: "InactiveNotProgressing", l1Age: 12, l2Age: 100, active: false},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			node := &mockNode{
+				status: &eth.SyncStatus{
+					HeadL1:   eth.L1BlockRef{Time: uint64(now.Unix()) - test.l1Age},
+					UnsafeL2: eth.L2BlockRef{Time: uint64(now.Unix()) - test.l2Age},
+				},
+				active: test.active,
+				err:    test.err,
+			}
+			m := NewSequencerMonitor(testlog.Logger(t, log.LvlCrit), node, clock.NewDeterministicClock(now), time.Second, 10*time.Second, 2*time.Minute)
+			err := m.check(context.Background())
+			if test.expect == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.expect)
+			}
+		})
+	}
+}
+
+func TestSequencerMonitorPublishes(t *testing.T) {
+	now := time.Unix(1000, 0)
+	cl := clock.NewDeterministicClock(now)
+	node := &mockNode{status: &eth.SyncStatus{
+		HeadL1:   eth.L1BlockRef{Time: uint64(now.Unix())},
+		UnsafeL2: eth.L2BlockRef{Time: uint64(now.Unix())},
+	}}
+	m := NewSequencerMonitor(testlog.Logger(t, log.LvlCrit), node, cl, time.Second, 10*time.Second, 2*time.Minute)
+	require.NoError(t, m.Start())
+	defer func() { require.NoError(t, m.Stop()) }()
+
+	require.True(t, cl.WaitForNewPendingTaskWithTimeout(10*time.Second))
+	cl.AdvanceTime(time.Second)
+	select {
+	case err := <-m.Subscribe():
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("no health check result published")
+	}
+}
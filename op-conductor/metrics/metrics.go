@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ethereum-optimism/optimism/op-service/httputil"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+)
+
+const Namespace = "op_conductor"
+
+type Metricer interface {
+	RecordInfo(version string)
+	RecordUp()
+
+	opmetrics.RPCMetricer
+
+	RecordState(leader, healthy, active bool)
+	RecordHandoverLostBlocks(blocks uint64)
+}
+
+type Metrics struct {
+	ns       string
+	registry *prometheus.Registry
+	factory  opmetrics.Factory
+
+	opmetrics.RPCMetrics
+
+	info prometheus.GaugeVec
+	up   prometheus.Gauge
+
+	leader  prometheus.Gauge
+	healthy prometheus.Gauge
+	active  prometheus.Gauge
+
+	handoverLostBlocks prometheus.Counter
+}
+
+var _ Metricer = (*Metrics)(nil)
+
+func NewMetrics(procName string) *Metrics {
+	if procName == "" {
+		procName = "default"
+	}
+	ns := Namespace + "_" + procName
+
+	registry := opmetrics.NewRegistry()
+	factory := opmetrics.With(registry)
+
+	return &Metrics{
+		ns:       ns,
+		registry: registry,
+		factory:  factory,
+
+		RPCMetrics: opmetrics.MakeRPCMetrics(ns, factory),
+
+		info: *factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "info",
+			Help:      "Pseudo-metric tracking version and config info",
+		}, []string{
+			"version",
+		}),
+		up: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "up",
+			Help:      "1 if the op-conductor has finished starting up",
+		}),
+		leader: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "leader",
+			Help:      "1 if the conductor is the leader of the conductor cluster",
+		}),
+		healthy: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "healthy",
+			Help:      "1 if the sequencer of the conductor is healthy",
+		}),
+		active: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "active",
+			Help:      "1 if the sequencer of the conductor is actively sequencing",
+		}),
+		handoverLostBlocks: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "handover_lost_blocks_total",
+			Help:      "Number of unsafe blocks of a previous leader that were dropped, because the sequencer did not catch up before taking over",
+		}),
+	}
+}
+
+func (m *Metrics) Start(host string, port int) (*httputil.HTTPServer, error) {
+	return opmetrics.StartServer(m.registry, host, port)
+}
+
+// RecordInfo sets a pseudo-metric that contains versioning and
+// config info for the op-conductor.
+func (m *Metrics) RecordInfo(version string) {
+	m.info.WithLabelValues(version).Set(1)
+}
+
+// RecordUp sets the up metric to 1.
+func (m *Metrics) RecordUp() {
+	m.up.Set(1)
+}
+
+// RecordState records the leadership of the conductor, and the health and activity of its sequencer.
+func (m *Metrics) RecordState(leader, healthy, active bool) {
+	m.leader.Set(boolToFloat(leader))
+	m.healthy.Set(boolToFloat(healthy))
+	m.active.Set(boolToFloat(active))
+}
+
+// RecordHandoverLostBlocks records the unsafe blocks that were dropped when the sequencer took over.
+func (m *Metrics) RecordHandoverLostBlocks(blocks uint64) {
+	m.handoverLostBlocks.Add(float64(blocks))
+}
+
+func (m *Metrics) Document() []opmetrics.DocumentedMetric {
+	return m.factory.Document()
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,17 @@
+package metrics
+
+import (
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+)
+
+type noopMetrics struct {
+	opmetrics.NoopRPCMetrics
+}
+
+var NoopMetrics Metricer = new(noopMetrics)
+
+func (*noopMetrics) RecordInfo(version string) {}
+func (*noopMetrics) RecordUp()                 {}
+
+func (*noopMetrics) RecordState(leader, healthy, active bool) {}
+func (*noopMetrics) RecordHandoverLostBlocks(blocks uint64)   {}
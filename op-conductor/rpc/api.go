@@ -0,0 +1,99 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-conductor/consensus"
+	"github.com/ethereum-optimism/optimism/op-service/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/rpc"
+)
+
+// Conductor is the part of the conductor that is exposed over RPC.
+type Conductor interface {
+	Pause(ctx context.Context) error
+	Resume(ctx context.Context) error
+	Paused() bool
+	SequencerHealthy() bool
+
+	Leader() bool
+	LeaderWithID() *consensus.ServerInfo
+	TransferLeader() error
+	TransferLeaderToServer(id string, addr string) error
+	AddServerAsVoter(id string, addr string) error
+	AddServerAsNonvoter(id string, addr string) error
+	RemoveServer(id string) error
+	ClusterMembership() ([]*consensus.ServerInfo, error)
+}
+
+type conductorAPI struct {
+	*rpc.CommonAdminAPI
+	c Conductor
+}
+
+func NewConductorAPI(c Conductor, m metrics.RPCMetricer, log log.Logger) *conductorAPI {
+	return &conductorAPI{
+		CommonAdminAPI: rpc.NewCommonAdminAPI(m, log),
+		c:              c,
+	}
+}
+
+func GetConductorAPI(api *conductorAPI) gethrpc.API {
+	return gethrpc.API{
+		Namespace: "conductor",
+		Service:   api,
+	}
+}
+
+// Pause stops the conductor from controlling the sequencer, e.g. during maintenance of the cluster.
+func (a *conductorAPI) Pause(ctx context.Context) error {
+	return a.c.Pause(ctx)
+}
+
+func (a *conductorAPI) Resume(ctx context.Context) error {
+	return a.c.Resume(ctx)
+}
+
+func (a *conductorAPI) Paused(_ context.Context) bool {
+	return a.c.Paused()
+}
+
+func (a *conductorAPI) SequencerHealthy(_ context.Context) bool {
+	return a.c.SequencerHealthy()
+}
+
+func (a *conductorAPI) Leader(_ context.Context) bool {
+	return a.c.Leader()
+}
+
+func (a *conductorAPI) LeaderWithID(_ context.Context) *consensus.ServerInfo {
+	return a.c.LeaderWithID()
+}
+
+// TransferLeader transfers the leadership to another voter. Can only be called on the leader.
+func (a *conductorAPI) TransferLeader(_ context.Context) error {
+	return a.c.TransferLeader()
+}
+
+func (a *conductorAPI) TransferLeaderToServer(_ context.Context, id string, addr string) error {
+	return a.c.TransferLeaderToServer(id, addr)
+}
+
+// AddServerAsVoter adds a conductor to the cluster. Can only be called on the leader.
+func (a *conductorAPI) AddServerAsVoter(_ context.Context, id string, addr string) error {
+	return a.c.AddServerAsVoter(id, addr)
+}
+
+func (a *conductorAPI) AddServerAsNonvoter(_ context.Context, id string, addr string) error {
+	return a.c.AddServerAsNonvoter(id, addr)
+}
+
+func (a *conductorAPI) RemoveServer(_ context.Context, id string) error {
+	return a.c.RemoveServer(id)
+}
+
+func (a *conductorAPI) ClusterMembership(_ context.Context) ([]*consensus.ServerInfo, error) {
+	return a.c.ClusterMembership()
+}
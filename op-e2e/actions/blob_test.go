@@ -0,0 +1,82 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/fakebeacon"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestBatcherBlob(gt *testing.T) {
+	t := NewDefaultTesting(gt)
+	dp := e2eutils.MakeDeployParams(t, defaultRollupTestParams)
+	cancunOffset := hexutil.Uint64(0)
+	dp.DeployConfig.L1CancunTimeOffset = &cancunOffset
+	sd := e2eutils.Setup(t, dp, defaultAlloc)
+	blobsTime := uint64(0)
+	sd.RollupCfg.BlobsEnabledL1Timestamp = &blobsTime
+	log := testlog.Logger(t, log.LvlDebug)
+	miner, seqEngine, sequencer := setupSequencerTest(t, sd, log)
+
+	beacon := fakebeacon.NewBeacon(log, sd.L1Cfg.Timestamp, dp.DeployConfig.L1BlockTime)
+	require.NoError(t, beacon.Start("127.0.0.1:0"))
+	t.Cleanup(func() {
+		_ = beacon.Close()
+	})
+	miner.SetBeacon(beacon)
+
+	batcher := NewL2Batcher(log, sd.RollupCfg, &BatcherCfg{
+		MinL1TxSize: 0,
+		MaxL1TxSize: 128_000,
+		BatcherKey:  dp.Secrets.Batcher,
+	}, sequencer.RollupClient(), miner.EthClient(), seqEngine.EthClient())
+
+	sequencer.ActL2PipelineFull(t)
+	sequencer.ActL2StartBlock(t)
+	sequencer.ActL2EndBlock(t)
+
+	// submit the batch as blob, directly into the L1 block
+	batcher.ActL2BatchBuffer(t)
+	batcher.ActL2ChannelClose(t)
+	miner.ActL1StartBlock(12)(t)
+	batcher.ActL2BatchSubmitBlob(t, miner)
+	miner.ActL1EndBlock(t)
+
+	// the blob tx is included without its sidecar
+	block := miner.l1Chain.GetBlockByHash(miner.l1Chain.CurrentBlock().Hash())
+	require.Len(t, block.Transactions(), 1)
+	tx := block.Transactions()[0]
+	require.Equal(t, uint8(types.BlobTxType), tx.Type())
+	require.Nil(t, tx.BlobTxSidecar())
+	require.Equal(t, tx.BlobGas(), *block.BlobGasUsed())
+
+	// the blob is served by the beacon API, and decodes to the batcher frame
+	cl := sources.NewL1BeaconClient(sources.NewBeaconHTTPClient(beacon.BeaconAddr(), nil))
+	blobs, err := cl.GetBlobs(t.Ctx(), eth.InfoToL1BlockRef(eth.BlockToInfo(block)), []eth.IndexedBlobHash{
+		{Index: 0, Hash: tx.BlobHashes()[0]},
+	})
+	require.NoError(t, err)
+	require.Len(t, blobs, 1)
+	data, err := blobs[0].ToData()
+	require.NoError(t, err)
+	frames, err := derive.ParseFrames(data)
+	require.NoError(t, err)
+	require.Len(t, frames, 1)
+	require.True(t, frames[0].IsLast)
+
+	// a verifier that fetches the blobs from the beacon API derives the safe head from the blob batch
+	_, verifier := setupVerifier(t, sd, log, miner.L1Client(t, sd.RollupCfg), cl, &sync.Config{})
+	verifier.ActL1HeadSignal(t)
+	verifier.ActL2PipelineFull(t)
+	require.Equal(t, sequencer.L2Unsafe(), verifier.L2Safe(), "verifier derives the blob batch")
+}
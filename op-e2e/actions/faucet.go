@@ -0,0 +1,179 @@
+package actions
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"math/rand"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-service/secrets"
+)
+
+// Layer is the chain that a faucet funds accounts on.
+type Layer string
+
+const (
+	LayerL1 Layer = "L1"
+	LayerL2 Layer = "L2"
+)
+
+// Wallet is a test account that is handed out by a Faucet.
+type Wallet struct {
+	Key     *ecdsa.PrivateKey
+	Address common.Address
+}
+
+// faucetEnv is the connection of a faucet to a single layer.
+type faucetEnv struct {
+	cl     *ethclient.Client
+	signer types.Signer
+	nonces map[common.Address]uint64
+}
+
+// Faucet hands out test accounts, and funds them on L1 and L2.
+// The accounts are the generic test accounts of the test mnemonic, in order,
+// so tests do not need to pick secret indices and can create as many actors as they need.
+//
+// Accounts that are created before the genesis is built can be funded in the genesis with Prefund.
+// Accounts that are created later are funded with transfers from the funder account of the faucet,
+// which tests include in blocks like any other transaction, e.g. with ActL1IncludeTx(faucet.Funder()).
+//
+// The faucet tracks the nonces of the accounts that it signs transactions for, per layer,
+// so that multiple transactions of an actor can be prepared before any of them is included.
+type Faucet struct {
+	secrets *secrets.Manager
+	funder  *Wallet
+	next    uint64
+	wallets []*Wallet
+	envs    map[Layer]*faucetEnv
+}
+
+// NewFaucet creates a faucet of the test accounts of the mnemonic.
+// The first test account is the funder of the faucet.
+func NewFaucet(t require.TestingT, mnemonic *e2eutils.MnemonicConfig) *Faucet {
+	manager, err := secrets.NewManager(mnemonic.Mnemonic)
+	require.NoError(t, err)
+	f := &Faucet{
+		secrets: manager,
+		envs:    make(map[Layer]*faucetEnv),
+	}
+	f.funder = f.derive(t)
+	return f
+}
+
+func (f *Faucet) derive(t require.TestingT) *Wallet {
+	key, err := f.secrets.TestAccount(f.next)
+	require.NoError(t, err)
+	f.next++
+	return &Wallet{Key: key, Address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+// Funder returns the address of the account that the faucet funds other accounts from.
+func (f *Faucet) Funder() common.Address {
+	return f.funder.Address
+}
+
+// NewWallet returns the next unused test account.
+func (f *Faucet) NewWallet(t require.TestingT) *Wallet {
+	w := f.derive(t)
+	f.wallets = append(f.wallets, w)
+	return w
+}
+
+// Prefund adds the funder, and every wallet that has been handed out so far, to the genesis allocations
+// of both layers. The wallets are funded with the given balance.
+func (f *Faucet) Prefund(alloc *e2eutils.AllocParams, balance *big.Int) {
+	if alloc.L1Alloc == nil {
+		alloc.L1Alloc = make(core.GenesisAlloc)
+	}
+	if alloc.L2Alloc == nil {
+		alloc.L2Alloc = make(core.GenesisAlloc)
+	}
+	for _, genesisAlloc := range []core.GenesisAlloc{alloc.L1Alloc, alloc.L2Alloc} {
+		genesisAlloc[f.funder.Address] = core.GenesisAccount{Balance: e2eutils.Ether(1e12)}
+		for _, w := range f.wallets {
+			genesisAlloc[w.Address] = core.GenesisAccount{Balance: balance}
+		}
+	}
+}
+
+// SetEnv connects the faucet to a layer, to fund accounts and sign transactions on it.
+func (f *Faucet) SetEnv(layer Layer, cl *ethclient.Client, signer types.Signer) {
+	f.envs[layer] = &faucetEnv{
+		cl:     cl,
+		signer: signer,
+		nonces: make(map[common.Address]uint64),
+	}
+}
+
+func (f *Faucet) env(t Testing, layer Layer) *faucetEnv {
+	env, ok := f.envs[layer]
+	require.True(t, ok, "faucet is not connected to %s", layer)
+	return env
+}
+
+// NextNonce returns the nonce of the next transaction of the account on the layer, and reserves it.
+// The nonce is initialized from the pending state the first time it is used.
+func (f *Faucet) NextNonce(t Testing, layer Layer, addr common.Address) uint64 {
+	env := f.env(t, layer)
+	nonce, ok := env.nonces[addr]
+	if !ok {
+		var err error
+		nonce, err = env.cl.PendingNonceAt(t.Ctx(), addr)
+		require.NoError(t, err, "failed to get %s nonce for account %s", layer, addr)
+	}
+	env.nonces[addr] = nonce + 1
+	return nonce
+}
+
+// SignTx signs the transaction with the key of the wallet, with the chain ID of the layer,
+// and the next tracked nonce of the wallet. Fee caps that are not set default to a 2 gwei tip.
+func (f *Faucet) SignTx(t Testing, layer Layer, w *Wallet, tx *types.DynamicFeeTx) *types.Transaction {
+	env := f.env(t, layer)
+	if tx.GasTipCap == nil {
+		tx.GasTipCap = big.NewInt(2 * params.GWei)
+	}
+	if tx.GasFeeCap == nil {
+		header, err := env.cl.HeaderByNumber(t.Ctx(), nil)
+		require.NoError(t, err, "need %s latest header for accurate basefee info", layer)
+		tx.GasFeeCap = new(big.Int).Add(tx.GasTipCap, new(big.Int).Mul(header.BaseFee, big.NewInt(2)))
+	}
+	tx.ChainID = env.signer.ChainID()
+	tx.Nonce = f.NextNonce(t, layer, w.Address)
+	return types.MustSignNewTx(w.Key, env.signer, tx)
+}
+
+// Fund sends a transfer of the amount from the funder to the account, on the layer.
+// The transfer is sent to the transaction pool, and has to be included by the test.
+func (f *Faucet) Fund(t Testing, layer Layer, to common.Address, amount *big.Int) *types.Transaction {
+	tx := f.SignTx(t, layer, f.funder, &types.DynamicFeeTx{
+		Gas:   params.TxGas,
+		To:    &to,
+		Value: amount,
+	})
+	require.NoError(t, f.env(t, layer).cl.SendTransaction(t.Ctx(), tx), "must send faucet tx")
+	return tx
+}
+
+// ActFund sends a transfer of the amount from the funder to the account, on the layer.
+func (f *Faucet) ActFund(layer Layer, to common.Address, amount *big.Int) Action {
+	return func(t Testing) {
+		f.Fund(t, layer, to, amount)
+	}
+}
+
+// NewCrossLayerUser creates a user with the next unused test account.
+func (f *Faucet) NewCrossLayerUser(t require.TestingT, log log.Logger, rng *rand.Rand) *CrossLayerUser {
+	w := f.NewWallet(t)
+	return NewCrossLayerUser(log.New("user", w.Address), w.Key, rng)
+}
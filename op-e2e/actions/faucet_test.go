@@ -0,0 +1,83 @@
+package actions
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestFaucet(gt *testing.T) {
+	t := NewDefaultTesting(gt)
+	dp := e2eutils.MakeDeployParams(t, defaultRollupTestParams)
+	faucet := NewFaucet(t, dp.MnemonicConfig)
+	// an account that is handed out before the genesis is funded in the genesis
+	alice := faucet.NewWallet(t)
+	alloc := &e2eutils.AllocParams{PrefundTestUsers: true}
+	faucet.Prefund(alloc, e2eutils.Ether(10))
+	sd := e2eutils.Setup(t, dp, alloc)
+	log := testlog.Logger(t, log.LvlDebug)
+	miner, seqEngine, seq := setupSequencerTest(t, sd, log)
+	t.Cleanup(func() {
+		_ = miner.Close()
+		_ = seqEngine.Close()
+	})
+	faucet.SetEnv(LayerL1, miner.EthClient(), types.LatestSigner(sd.L1Cfg.Config))
+	faucet.SetEnv(LayerL2, seqEngine.EthClient(), types.LatestSigner(sd.L2Cfg.Config))
+
+	balance, err := miner.EthClient().BalanceAt(t.Ctx(), alice.Address, nil)
+	require.NoError(t, err)
+	require.Equal(t, e2eutils.Ether(10), balance)
+
+	// accounts are unique, and do not collide with the standard test accounts
+	bob := faucet.NewWallet(t)
+	require.NotEqual(t, alice.Address, bob.Address)
+	require.NotContains(t, dp.Addresses.All(), bob.Address)
+
+	// an account that is handed out later is funded with transfers from the funder
+	miner.ActL1StartBlock(12)(t)
+	faucet.ActFund(LayerL1, bob.Address, e2eutils.Ether(2))(t)
+	faucet.ActFund(LayerL1, bob.Address, e2eutils.Ether(3))(t)
+	miner.ActL1IncludeTx(faucet.Funder())(t)
+	miner.ActL1IncludeTx(faucet.Funder())(t)
+	miner.ActL1EndBlock(t)
+	balance, err = miner.EthClient().BalanceAt(t.Ctx(), bob.Address, nil)
+	require.NoError(t, err)
+	require.Equal(t, e2eutils.Ether(5), balance)
+
+	seq.ActL2PipelineFull(t)
+	seq.ActL2StartBlock(t)
+	faucet.ActFund(LayerL2, bob.Address, e2eutils.Ether(1))(t)
+	seqEngine.ActL2IncludeTx(faucet.Funder())(t)
+	seq.ActL2EndBlock(t)
+	balance, err = seqEngine.EthClient().BalanceAt(t.Ctx(), bob.Address, nil)
+	require.NoError(t, err)
+	require.Equal(t, e2eutils.Ether(1), balance)
+
+	// the nonces of an account are tracked, so multiple txs can be prepared ahead of inclusion
+	tx0 := faucet.SignTx(t, LayerL2, bob, &types.DynamicFeeTx{Gas: params.TxGas, To: &alice.Address, Value: big.NewInt(1)})
+	tx1 := faucet.SignTx(t, LayerL2, bob, &types.DynamicFeeTx{Gas: params.TxGas, To: &alice.Address, Value: big.NewInt(1)})
+	require.Equal(t, uint64(0), tx0.Nonce())
+	require.Equal(t, uint64(1), tx1.Nonce())
+	require.NoError(t, seqEngine.EthClient().SendTransaction(t.Ctx(), tx1))
+	require.NoError(t, seqEngine.EthClient().SendTransaction(t.Ctx(), tx0))
+	seq.ActL2StartBlock(t)
+	seqEngine.ActL2IncludeTx(bob.Address)(t)
+	seqEngine.ActL2IncludeTx(bob.Address)(t)
+	seq.ActL2EndBlock(t)
+	nonce, err := seqEngine.EthClient().NonceAt(t.Ctx(), bob.Address, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), nonce)
+
+	// faucet accounts can be used by the action-test actors
+	carol := faucet.NewCrossLayerUser(t, log, rand.New(rand.NewSource(1234)))
+	require.NotEqual(t, bob.Address, carol.Address())
+}
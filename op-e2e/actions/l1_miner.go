@@ -5,12 +5,16 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/fakebeacon"
 )
 
 // L1Miner wraps a L1Replica with instrumented block building ability.
@@ -28,7 +32,11 @@ type L1Miner struct {
 	l1Transactions   []*types.Transaction      // collects txs that were successfully included into current block build
 	l1Receipts       []*types.Receipt          // collect receipts of ongoing building
 	l1Building       bool
-	l1TxFailed       []*types.Transaction // log of failed transactions which could not be included
+	l1TxFailed       []*types.Transaction   // log of failed transactions which could not be included
+	l1BlobSidecars   []*types.BlobTxSidecar // collects the blobs of the blob txs of the current block build
+
+	// optional beacon API, that serves the blobs of the mined blocks
+	beacon *fakebeacon.FakeBeacon
 }
 
 // NewL1Miner creates a new L1Replica that can also build blocks.
@@ -39,6 +47,11 @@ func NewL1Miner(t Testing, log log.Logger, genesis *core.Genesis) *L1Miner {
 	}
 }
 
+// SetBeacon attaches a beacon API to the miner, which the blobs of the blocks that are mined afterwards are stored in.
+func (s *L1Miner) SetBeacon(beacon *fakebeacon.FakeBeacon) {
+	s.beacon = beacon
+}
+
 // ActL1StartBlock returns an action to build a new L1 block on top of the head block,
 // with timeDelta added to the head block time.
 func (s *L1Miner) ActL1StartBlock(timeDelta uint64) Action {
@@ -76,12 +89,22 @@ func (s *L1Miner) ActL1StartBlock(timeDelta uint64) Action {
 		if s.l1Cfg.Config.IsShanghai(header.Number, header.Time) {
 			header.WithdrawalsHash = &types.EmptyWithdrawalsHash
 		}
+		if s.l1Cfg.Config.IsCancun(header.Number, header.Time) {
+			var excessBlobGas uint64
+			if s.l1Cfg.Config.IsCancun(parent.Number, parent.Time) {
+				excessBlobGas = eip4844.CalcExcessBlobGas(*parent.ExcessBlobGas, *parent.BlobGasUsed)
+			}
+			header.ExcessBlobGas = &excessBlobGas
+			header.BlobGasUsed = new(uint64)
+			header.ParentBeaconRoot = new(common.Hash)
+		}
 
 		s.l1Building = true
 		s.l1BuildingHeader = header
 		s.l1BuildingState = statedb
 		s.l1Receipts = make([]*types.Receipt, 0)
 		s.l1Transactions = make([]*types.Transaction, 0)
+		s.l1BlobSidecars = make([]*types.BlobTxSidecar, 0)
 		s.pendingIndices = make(map[common.Address]uint64)
 
 		s.l1GasPool = new(core.GasPool).AddGas(header.GasLimit)
@@ -106,6 +129,8 @@ func (s *L1Miner) ActL1IncludeTx(from common.Address) Action {
 	}
 }
 
+// IncludeTx includes the tx in the L1 block that is being built.
+// The sidecar of a blob tx is kept out of the block, and stored in the beacon API when the block is finished, if any.
 func (s *L1Miner) IncludeTx(t Testing, tx *types.Transaction) {
 	from, err := s.l1Signer.Sender(tx)
 	require.NoError(t, err)
@@ -117,6 +142,20 @@ func (s *L1Miner) IncludeTx(t Testing, tx *types.Transaction) {
 		t.InvalidAction("action takes too much gas: %d, only have %d", tx.Gas(), uint64(*s.l1GasPool))
 		return
 	}
+	if tx.Type() == types.BlobTxType {
+		if s.l1BuildingHeader.BlobGasUsed == nil {
+			t.InvalidAction("cannot include blob tx in L1 block %d before Cancun", s.l1BuildingHeader.Number)
+			return
+		}
+		if *s.l1BuildingHeader.BlobGasUsed+tx.BlobGas() > params.MaxBlobGasPerBlock {
+			t.InvalidAction("blob tx consumes %d blob gas, only have %d", tx.BlobGas(), params.MaxBlobGasPerBlock-*s.l1BuildingHeader.BlobGasUsed)
+			return
+		}
+		sidecar := tx.BlobTxSidecar()
+		require.NotNil(t, sidecar, "blob tx must have a sidecar to be included")
+		s.l1BlobSidecars = append(s.l1BlobSidecars, sidecar)
+		tx = tx.WithoutBlobTxSidecar()
+	}
 	s.l1BuildingState.SetTxContext(tx.Hash(), len(s.l1Transactions))
 	receipt, err := core.ApplyTransaction(s.l1Cfg.Config, s.l1Chain, &s.l1BuildingHeader.Coinbase,
 		s.l1GasPool, s.l1BuildingState, s.l1BuildingHeader, tx, &s.l1BuildingHeader.GasUsed, *s.l1Chain.GetVMConfig())
@@ -124,6 +163,9 @@ func (s *L1Miner) IncludeTx(t Testing, tx *types.Transaction) {
 		s.l1TxFailed = append(s.l1TxFailed, tx)
 		t.Fatalf("failed to apply transaction to L1 block (tx %d): %v", len(s.l1Transactions), err)
 	}
+	if tx.Type() == types.BlobTxType {
+		*s.l1BuildingHeader.BlobGasUsed += receipt.BlobGasUsed
+	}
 	s.l1Receipts = append(s.l1Receipts, receipt)
 	s.l1Transactions = append(s.l1Transactions, tx)
 }
@@ -163,6 +205,11 @@ func (s *L1Miner) ActL1EndBlock(t Testing) {
 	if err != nil {
 		t.Fatalf("failed to insert block into l1 chain")
 	}
+	if s.beacon != nil && len(s.l1BlobSidecars) > 0 {
+		if err := s.beacon.StoreBlobsBundle(block.Time(), block.Hash(), s.l1BlobSidecars); err != nil {
+			t.Fatalf("failed to store blobs of L1 block %s in beacon API: %v", block.Hash(), err)
+		}
+	}
 }
 
 func (s *L1Miner) ActEmptyBlock(t Testing) {
@@ -9,11 +9,14 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
@@ -218,6 +221,78 @@ func (s *L2Batcher) ActL2BatchSubmit(t Testing, txOpts ...func(tx *types.Dynamic
 	require.NoError(t, err, "need to send tx")
 }
 
+// L1BlobTxAPI includes blob txs into the L1 block that is being built.
+// Blob txs are handed to the L1 miner directly, since the L1 blob pool does not expose the pending txs of an account.
+type L1BlobTxAPI interface {
+	IncludeTx(t Testing, tx *types.Transaction)
+}
+
+// ActL2BatchSubmitBlob constructs a blob tx from previous buffered L2 blocks, and includes it in the L1 block
+// that is being built. The frame is encoded into a single blob.
+func (s *L2Batcher) ActL2BatchSubmitBlob(t Testing, l1 L1BlobTxAPI, txOpts ...func(tx *types.BlobTx)) {
+	// Don't run this action if there's no data to submit
+	if s.l2ChannelOut == nil {
+		t.InvalidAction("need to buffer data first, cannot batch submit with empty buffer")
+		return
+	}
+	// Collect the output frame
+	data := new(bytes.Buffer)
+	data.WriteByte(derive.DerivationVersion0)
+	// subtract one, to account for the version byte
+	if _, err := s.l2ChannelOut.OutputFrame(data, eth.MaxBlobDataSize-1); err == io.EOF {
+		s.l2ChannelOut = nil
+		s.l2Submitting = false
+	} else if err != nil {
+		s.l2Submitting = false
+		t.Fatalf("failed to output channel data to frame: %v", err)
+	}
+
+	var blob eth.Blob
+	require.NoError(t, blob.FromData(data.Bytes()), "must encode frame into blob")
+	commitment, err := blob.ComputeKZGCommitment()
+	require.NoError(t, err, "need blob commitment")
+	proof, err := kzg4844.ComputeBlobProof(*blob.KZGBlob(), commitment)
+	require.NoError(t, err, "need blob proof")
+
+	nonce, err := s.l1.PendingNonceAt(t.Ctx(), s.batcherAddr)
+	require.NoError(t, err, "need batcher nonce")
+
+	gasTipCap := big.NewInt(2 * params.GWei)
+	pendingHeader, err := s.l1.HeaderByNumber(t.Ctx(), big.NewInt(-1))
+	require.NoError(t, err, "need l1 pending header for gas price estimation")
+	gasFeeCap := new(big.Int).Add(gasTipCap, new(big.Int).Mul(pendingHeader.BaseFee, big.NewInt(2)))
+	blobFeeCap := big.NewInt(params.GWei)
+	if pendingHeader.ExcessBlobGas != nil {
+		blobFeeCap.Add(blobFeeCap, new(big.Int).Mul(eip4844.CalcBlobFee(*pendingHeader.ExcessBlobGas), big.NewInt(2)))
+	}
+
+	rawTx := &types.BlobTx{
+		ChainID:    uint256.MustFromBig(s.rollupCfg.L1ChainID),
+		Nonce:      nonce,
+		To:         s.rollupCfg.BatchInboxAddress,
+		GasTipCap:  uint256.MustFromBig(gasTipCap),
+		GasFeeCap:  uint256.MustFromBig(gasFeeCap),
+		BlobFeeCap: uint256.MustFromBig(blobFeeCap),
+		BlobHashes: []common.Hash{eth.KZGToVersionedHash(commitment)},
+		Sidecar: &types.BlobTxSidecar{
+			Blobs:       []kzg4844.Blob{*blob.KZGBlob()},
+			Commitments: []kzg4844.Commitment{commitment},
+			Proofs:      []kzg4844.Proof{proof},
+		},
+	}
+	for _, opt := range txOpts {
+		opt(rawTx)
+	}
+	gas, err := core.IntrinsicGas(rawTx.Data, nil, false, true, true, false)
+	require.NoError(t, err, "need to compute intrinsic gas")
+	rawTx.Gas = gas
+
+	tx, err := types.SignNewTx(s.l2BatcherCfg.BatcherKey, s.l1Signer, rawTx)
+	require.NoError(t, err, "need to sign tx")
+
+	l1.IncludeTx(t, tx)
+}
+
 // ActL2BatchSubmitGarbage constructs a malformed channel frame and submits it to the
 // batch inbox. This *should* cause the batch inbox to reject the blocks
 // encoded within the frame, even if the blocks themselves are valid.
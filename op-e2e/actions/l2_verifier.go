@@ -57,9 +57,9 @@ type L2API interface {
 	OutputV0AtBlock(ctx context.Context, blockHash common.Hash) (*eth.OutputV0, error)
 }
 
-func NewL2Verifier(t Testing, log log.Logger, l1 derive.L1Fetcher, eng L2API, cfg *rollup.Config, syncCfg *sync.Config) *L2Verifier {
+func NewL2Verifier(t Testing, log log.Logger, l1 derive.L1Fetcher, blobsSrc derive.L1BlobsFetcher, eng L2API, cfg *rollup.Config, syncCfg *sync.Config) *L2Verifier {
 	metrics := &testutils.TestDerivationMetrics{}
-	pipeline := derive.NewDerivationPipeline(log, cfg, l1, eng, metrics, syncCfg)
+	pipeline := derive.NewDerivationPipeline(log, cfg, l1, blobsSrc, nil, nil, eng, metrics, syncCfg)
 	pipeline.Reset()
 
 	rollupNode := &L2Verifier{
@@ -115,6 +115,10 @@ func (s *l2VerifierBackend) ResetDerivationPipeline(ctx context.Context) error {
 	return nil
 }
 
+func (s *l2VerifierBackend) SetUnsafeHead(ctx context.Context, num uint64) error {
+	return errors.New("rewinding the L2Verifier unsafe head is not supported")
+}
+
 func (s *l2VerifierBackend) StartSequencer(ctx context.Context, blockHash common.Hash) error {
 	return nil
 }
@@ -127,6 +131,14 @@ func (s *l2VerifierBackend) SequencerActive(ctx context.Context) (bool, error) {
 	return false, nil
 }
 
+func (s *l2VerifierBackend) SequencerPolicy(ctx context.Context) (driver.SequencerPolicy, error) {
+	return driver.SequencerPolicy{}, nil
+}
+
+func (s *l2VerifierBackend) SetSequencerPolicy(ctx context.Context, policy driver.SequencerPolicy) error {
+	return errors.New("the L2Verifier does not support a sequencer policy")
+}
+
 func (s *L2Verifier) L2Finalized() eth.L2BlockRef {
 	return s.derivation.Finalized()
 }
@@ -155,6 +167,8 @@ func (s *L2Verifier) SyncStatus() *eth.SyncStatus {
 		FinalizedL2:        s.L2Finalized(),
 		UnsafeL2SyncTarget: s.derivation.UnsafeL2SyncTarget(),
 		EngineSyncTarget:   s.EngineSyncTarget(),
+		CrossUnsafeL2:      s.derivation.CrossUnsafeL2Head(),
+		CrossSafeL2:        s.derivation.CrossSafeL2Head(),
 	}
 }
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -35,6 +36,9 @@ var (
 	// ExternalL2Shim is the shim to use if external ethereum client testing is
 	// enabled
 	ExternalL2Shim string
+	// ExternalL2Shims maps L2 node names to the shim to use for that node,
+	// overriding ExternalL2Shim. It allows a system to mix execution clients.
+	ExternalL2Shims map[string]string
 	// ExternalL2TestParms is additional metadata for executing external L2
 	// tests.
 	ExternalL2TestParms external.TestParms
@@ -43,7 +47,7 @@ var (
 )
 
 func init() {
-	var l1AllocsPath, l1DeploymentsPath, deployConfigPath, externalL2 string
+	var l1AllocsPath, l1DeploymentsPath, deployConfigPath, externalL2, externalL2Nodes string
 
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -62,6 +66,7 @@ func init() {
 	flag.StringVar(&l1DeploymentsPath, "l1-deployments", defaultL1DeploymentsPath, "")
 	flag.StringVar(&deployConfigPath, "deploy-config", defaultDeployConfigPath, "")
 	flag.StringVar(&externalL2, "externalL2", "", "Enable tests with external L2")
+	flag.StringVar(&externalL2Nodes, "externalL2Nodes", "", "Enable tests with external L2 for the given nodes only, as comma separated name=path pairs")
 	flag.IntVar(&EthNodeVerbosity, "ethLogVerbosity", int(log.LvlInfo), "The level of verbosity to use for the eth node logs")
 	testing.Init() // Register test flags before parsing
 	flag.Parse()
@@ -119,34 +124,73 @@ func init() {
 			panic(fmt.Errorf("could not initialize external L2: %w", err))
 		}
 	}
+	if externalL2Nodes != "" {
+		if err := initExternalL2Nodes(externalL2Nodes); err != nil {
+			panic(fmt.Errorf("could not initialize external L2 nodes: %w", err))
+		}
+	}
 }
 
 func initExternalL2(externalL2 string) error {
 	var err error
-	ExternalL2Shim, err = filepath.Abs(filepath.Join(externalL2, "shim"))
+	ExternalL2Shim, err = loadExternalL2(externalL2)
+	return err
+}
+
+// initExternalL2Nodes configures the shims of individual nodes, from name=path pairs.
+func initExternalL2Nodes(externalL2Nodes string) error {
+	ExternalL2Shims = make(map[string]string)
+	for _, pair := range strings.Split(externalL2Nodes, ",") {
+		name, path, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || name == "" || path == "" {
+			return fmt.Errorf("invalid external L2 node %q, expected name=path", pair)
+		}
+		if _, ok := ExternalL2Shims[name]; ok {
+			return fmt.Errorf("duplicate external L2 node %q", name)
+		}
+		shim, err := loadExternalL2(path)
+		if err != nil {
+			return fmt.Errorf("external L2 node %s: %w", name, err)
+		}
+		ExternalL2Shims[name] = shim
+	}
+	return nil
+}
+
+// loadExternalL2 returns the path of the shim in the externalL2 directory,
+// and adds the test parms of the directory to ExternalL2TestParms.
+func loadExternalL2(externalL2 string) (string, error) {
+	shim, err := filepath.Abs(filepath.Join(externalL2, "shim"))
 	if err != nil {
-		return fmt.Errorf("could not compute abs of externalL2Nodes shim: %w", err)
+		return "", fmt.Errorf("could not compute abs of externalL2Nodes shim: %w", err)
 	}
 
-	_, err = os.Stat(ExternalL2Shim)
+	_, err = os.Stat(shim)
 	if err != nil {
-		return fmt.Errorf("failed to stat externalL2Nodes path: %w", err)
+		return "", fmt.Errorf("failed to stat externalL2Nodes path: %w", err)
 	}
 
 	file, err := os.Open(filepath.Join(externalL2, "test_parms.json"))
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
-			return nil
+			return shim, nil
 		}
-		return fmt.Errorf("could not open external L2 test parms: %w", err)
+		return "", fmt.Errorf("could not open external L2 test parms: %w", err)
 	}
 	defer file.Close()
 
-	if err := json.NewDecoder(file).Decode(&ExternalL2TestParms); err != nil {
-		return fmt.Errorf("could not decode external L2 test parms: %w", err)
+	var parms external.TestParms
+	if err := json.NewDecoder(file).Decode(&parms); err != nil {
+		return "", fmt.Errorf("could not decode external L2 test parms: %w", err)
+	}
+	if ExternalL2TestParms.SkipTests == nil {
+		ExternalL2TestParms.SkipTests = make(map[string]string)
+	}
+	for name, msg := range parms.SkipTests {
+		ExternalL2TestParms.SkipTests[name] = msg
 	}
 
-	return nil
+	return shim, nil
 }
 
 func allExist(filenames ...string) error {
@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/wait"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -52,6 +53,13 @@ func WithPrivKey(key *ecdsa.PrivateKey) Option {
 	}
 }
 
+// WithClock sets the clock that the challenger monitors the games with.
+func WithClock(cl clock.Clock) Option {
+	return func(c *config.Config) {
+		c.Clock = cl
+	}
+}
+
 func WithAgreeProposedOutput(agree bool) Option {
 	return func(c *config.Config) {
 		c.AgreeWithProposedOutput = agree
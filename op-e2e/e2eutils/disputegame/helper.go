@@ -192,6 +192,8 @@ func (h *FactoryHelper) StartCannonGameWithCorrectRoot(ctx context.Context, roll
 		metrics.NoopMetrics,
 		cfg,
 		inputs,
+		cannon.Shared{},
+		time.Time{},
 		cfg.Datadir,
 		maxDepth.Uint64(),
 	)
@@ -0,0 +1,228 @@
+package disputegame
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/game/fault/types"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/wait"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// ScriptedMove is a move that a ScriptedActor makes against a claim.
+type ScriptedMove struct {
+	IsAttack bool
+	Value    common.Hash
+}
+
+// Behaviour decides the moves that a ScriptedActor makes in response to a claim in the game.
+// It is called once for every claim, and returns no moves if the actor does not respond to the claim.
+type Behaviour func(ctx context.Context, a *ScriptedActor, claimIdx int64, claim ContractClaim) []ScriptedMove
+
+// AttackWith attacks the claims of the opposing side with the claims of the trace.
+// With the correct trace the actor plays honestly, with any other trace it is a malicious claimant.
+func AttackWith(trace types.TraceProvider) Behaviour {
+	return func(ctx context.Context, a *ScriptedActor, claimIdx int64, claim ContractClaim) []ScriptedMove {
+		pos := types.NewPositionFromGIndex(claim.Position)
+		if !a.Disagrees(pos) || int64(pos.Depth()) >= a.maxDepth {
+			return nil
+		}
+		value, err := trace.Get(ctx, pos.Attack())
+		a.require.NoErrorf(err, "%s failed to get claim at position %v", a.name, pos.Attack())
+		return []ScriptedMove{{IsAttack: true, Value: value}}
+	}
+}
+
+// AttackWrong attacks the claims of the opposing side with claims that are not part of any trace.
+func AttackWrong() Behaviour {
+	return func(ctx context.Context, a *ScriptedActor, claimIdx int64, claim ContractClaim) []ScriptedMove {
+		pos := types.NewPositionFromGIndex(claim.Position)
+		if !a.Disagrees(pos) || int64(pos.Depth()) >= a.maxDepth {
+			return nil
+		}
+		return []ScriptedMove{{IsAttack: true, Value: a.wrongClaim(pos.Attack())}}
+	}
+}
+
+// Stall never responds to any claim, so that the clock of the actor runs out.
+func Stall() Behaviour {
+	return func(ctx context.Context, a *ScriptedActor, claimIdx int64, claim ContractClaim) []ScriptedMove {
+		return nil
+	}
+}
+
+// Selective responds with the behaviour to the claims that match, and ignores all other claims.
+func Selective(match func(claimIdx int64, claim ContractClaim) bool, b Behaviour) Behaviour {
+	return func(ctx context.Context, a *ScriptedActor, claimIdx int64, claim ContractClaim) []ScriptedMove {
+		if !match(claimIdx, claim) {
+			return nil
+		}
+		return b(ctx, a, claimIdx, claim)
+	}
+}
+
+// UpToDepth matches the claims up to and including the given depth, see Selective.
+func UpToDepth(depth int) func(claimIdx int64, claim ContractClaim) bool {
+	return func(claimIdx int64, claim ContractClaim) bool {
+		return types.NewPositionFromGIndex(claim.Position).Depth() <= depth
+	}
+}
+
+// ScriptedActor is a participant of a dispute game that moves according to its Behaviour,
+// from its own account, and posts a bond with every move.
+type ScriptedActor struct {
+	game      *FaultGameHelper
+	require   *require.Assertions
+	name      string
+	contract  *bindings.FaultDisputeGame
+	opts      *bind.TransactOpts
+	defender  bool
+	behaviour Behaviour
+	bond      *big.Int
+	posted    *big.Int
+	seen      int64
+	maxDepth  int64
+}
+
+// Name returns the name of the actor.
+func (a *ScriptedActor) Name() string {
+	return a.name
+}
+
+// Address returns the account that the actor makes its moves from.
+func (a *ScriptedActor) Address() common.Address {
+	return a.opts.From
+}
+
+// BondsPosted returns the total value of the bonds that the actor posted with its moves.
+func (a *ScriptedActor) BondsPosted() *big.Int {
+	return new(big.Int).Set(a.posted)
+}
+
+// Disagrees returns true if the claim at the position is made by the opposing side of the actor.
+// The defender of the root claim agrees with the claims at even depths, the challenger with the claims at odd depths.
+func (a *ScriptedActor) Disagrees(pos types.Position) bool {
+	return a.defender != (pos.Depth()%2 == 0)
+}
+
+// wrongClaim returns a claim at the position that is unique to the actor, and not part of any trace.
+func (a *ScriptedActor) wrongClaim(pos types.Position) common.Hash {
+	var gindex [8]byte
+	binary.BigEndian.PutUint64(gindex[:], pos.ToGIndex().Uint64())
+	return crypto.Keccak256Hash([]byte(a.name), gindex[:])
+}
+
+func (a *ScriptedActor) move(ctx context.Context, claimIdx int64, m ScriptedMove) {
+	opts := *a.opts
+	opts.Context = ctx
+	opts.Value = a.bond
+	a.game.t.Logf("%s moving against claim %d, attack: %v, value: %v, bond: %v", a.name, claimIdx, m.IsAttack, m.Value, a.bond)
+	tx, err := a.contract.Move(&opts, big.NewInt(claimIdx), m.Value, m.IsAttack)
+	a.require.NoErrorf(err, "%s move transaction did not send", a.name)
+	_, err = wait.ForReceiptOK(ctx, a.game.client, tx.Hash())
+	a.require.NoErrorf(err, "%s move transaction was not OK", a.name)
+	a.posted.Add(a.posted, a.bond)
+}
+
+// respond lets the actor respond to the claims that were added since it last responded.
+func (a *ScriptedActor) respond(ctx context.Context, claimCount int64) {
+	for ; a.seen < claimCount; a.seen++ {
+		claim := a.game.getClaim(ctx, a.seen)
+		for _, m := range a.behaviour(ctx, a, a.seen, claim) {
+			a.move(ctx, a.seen, m)
+		}
+	}
+}
+
+// Scenario plays scripted actors in a dispute game, alongside the real op-challenger.
+type Scenario struct {
+	game   *FaultGameHelper
+	actors []*ScriptedActor
+}
+
+// NewScenario creates a scenario for the game.
+func (g *FaultGameHelper) NewScenario() *Scenario {
+	return &Scenario{game: g}
+}
+
+// AddActor adds an actor that moves from the account of the key, posting the bond with every move.
+// A defender agrees with the root claim, otherwise the actor challenges it.
+func (s *Scenario) AddActor(ctx context.Context, name string, key *ecdsa.PrivateKey, defender bool, behaviour Behaviour, bond *big.Int) *ScriptedActor {
+	chainID, err := s.game.client.ChainID(ctx)
+	s.game.require.NoError(err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	s.game.require.NoError(err)
+	contract, err := bindings.NewFaultDisputeGame(s.game.addr, s.game.client)
+	s.game.require.NoError(err)
+	if bond == nil {
+		bond = new(big.Int)
+	}
+	a := &ScriptedActor{
+		game:      s.game,
+		require:   s.game.require,
+		name:      name,
+		contract:  contract,
+		opts:      opts,
+		defender:  defender,
+		behaviour: behaviour,
+		bond:      bond,
+		posted:    new(big.Int),
+		maxDepth:  s.game.MaxDepth(ctx),
+	}
+	s.actors = append(s.actors, a)
+	return a
+}
+
+// Run lets the actors respond to the claims in the game, in the order that they were added,
+// until no new claims were made for the idle duration, or the game is no longer in progress.
+func (s *Scenario) Run(ctx context.Context, idle time.Duration) {
+	lastActive := time.Now()
+	var lastCount int64
+	for time.Since(lastActive) < idle && s.game.Status(ctx) == StatusInProgress {
+		count, err := s.game.game.ClaimDataLen(&bind.CallOpts{Context: ctx})
+		s.game.require.NoError(err, "failed to get claim count")
+		for _, a := range s.actors {
+			a.respond(ctx, count.Int64())
+		}
+		newCount, err := s.game.game.ClaimDataLen(&bind.CallOpts{Context: ctx})
+		s.game.require.NoError(err, "failed to get claim count")
+		if newCount.Int64() != lastCount {
+			lastCount = newCount.Int64()
+			lastActive = time.Now()
+		}
+		select {
+		case <-ctx.Done():
+			s.game.require.NoError(ctx.Err(), "scenario did not finish")
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// GameBalance returns the ETH balance of the game contract, which holds the bonds of all moves.
+func (s *Scenario) GameBalance(ctx context.Context) *big.Int {
+	balance, err := s.game.client.BalanceAt(ctx, s.game.addr, nil)
+	s.game.require.NoError(err, "failed to get game balance")
+	return balance
+}
+
+// RequireOutcome waits for the game to be resolved, and checks that it has the expected status.
+func (s *Scenario) RequireOutcome(ctx context.Context, expected Status) {
+	s.game.WaitForGameStatus(ctx, expected)
+}
+
+// RequireBondsHeld checks that the game holds the bonds that the actors posted, on top of the given other bonds.
+func (s *Scenario) RequireBondsHeld(ctx context.Context, others *big.Int) {
+	total := new(big.Int).Set(others)
+	for _, a := range s.actors {
+		total.Add(total, a.posted)
+	}
+	balance := s.GameBalance(ctx)
+	s.game.require.Zerof(total.Cmp(balance), "game %v holds %v, expected bonds of %v", s.game.addr, balance, total)
+}
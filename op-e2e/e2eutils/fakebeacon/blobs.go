@@ -0,0 +1,169 @@
+package fakebeacon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+)
+
+// FakeBeacon serves the blob sidecars of L1 blocks over the beacon API, as a beacon node would.
+// The blobs are stored by the L1 miner when it builds blocks, so that blob derivation can be
+// tested deterministically, without a consensus client.
+// Every L1 block is assumed to be in its own slot, counted from the genesis time.
+type FakeBeacon struct {
+	log log.Logger
+
+	genesisTime uint64
+	blockTime   uint64
+
+	mu       sync.Mutex
+	sidecars map[uint64][]*eth.BlobSidecar // by slot
+
+	listener net.Listener
+	srv      *http.Server
+}
+
+// NewBeacon creates a fake beacon node for the L1 chain with the given genesis time and block time.
+func NewBeacon(log log.Logger, genesisTime uint64, blockTime uint64) *FakeBeacon {
+	return &FakeBeacon{
+		log:         log,
+		genesisTime: genesisTime,
+		blockTime:   blockTime,
+		sidecars:    make(map[uint64][]*eth.BlobSidecar),
+	}
+}
+
+// Slot returns the slot of the L1 block with the given timestamp.
+func (f *FakeBeacon) Slot(timestamp uint64) (uint64, error) {
+	if timestamp < f.genesisTime {
+		return 0, fmt.Errorf("timestamp %d is before genesis %d", timestamp, f.genesisTime)
+	}
+	if (timestamp-f.genesisTime)%f.blockTime != 0 {
+		return 0, fmt.Errorf("timestamp %d is not at a slot boundary, genesis %d, block time %d", timestamp, f.genesisTime, f.blockTime)
+	}
+	return (timestamp - f.genesisTime) / f.blockTime, nil
+}
+
+// StoreBlobsBundle stores the blobs of the block, in the order of the blob transactions
+// that they belong to. The blobs of a block are indexed across all of its blob transactions.
+func (f *FakeBeacon) StoreBlobsBundle(blockTime uint64, blockHash common.Hash, sidecars []*types.BlobTxSidecar) error {
+	slot, err := f.Slot(blockTime)
+	if err != nil {
+		return err
+	}
+	var out []*eth.BlobSidecar
+	for _, sc := range sidecars {
+		for i := range sc.Blobs {
+			out = append(out, &eth.BlobSidecar{
+				BlockRoot:     eth.Bytes32(blockHash),
+				Slot:          eth.Uint64String(slot),
+				Index:         eth.Uint64String(len(out)),
+				Blob:          eth.Blob(sc.Blobs[i]),
+				KZGCommitment: eth.Bytes48(sc.Commitments[i]),
+				KZGProof:      eth.Bytes48(sc.Proofs[i]),
+			})
+		}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sidecars[slot] = out
+	return nil
+}
+
+// LoadBlobsBundle returns the blob sidecars of the block at the slot.
+func (f *FakeBeacon) LoadBlobsBundle(slot uint64) []*eth.BlobSidecar {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.sidecars[slot]
+}
+
+// Start serves the beacon API at the given address, e.g. "127.0.0.1:0".
+func (f *FakeBeacon) Start(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to open tcp listener for http beacon api server: %w", err)
+	}
+	f.listener = listener
+
+	mux := new(http.ServeMux)
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, r *http.Request) {
+		f.writeJSON(w, &sources.APIGenesisResponse{Data: sources.ReducedGenesisData{GenesisTime: eth.Uint64String(f.genesisTime)}})
+	})
+	mux.HandleFunc("/eth/v1/config/spec", func(w http.ResponseWriter, r *http.Request) {
+		f.writeJSON(w, &sources.APIConfigResponse{Data: sources.ReducedConfigData{SecondsPerSlot: eth.Uint64String(f.blockTime)}})
+	})
+	mux.HandleFunc("/eth/v1/beacon/blob_sidecars/", f.handleBlobSidecars)
+	f.srv = &http.Server{
+		Handler:           mux,
+		ReadTimeout:       time.Second * 20,
+		ReadHeaderTimeout: time.Second * 20,
+		WriteTimeout:      time.Second * 20,
+	}
+	go func() {
+		if err := f.srv.Serve(f.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			f.log.Error("failed to start fake-pos beacon server for blobs testing", "err", err)
+		}
+	}()
+	return nil
+}
+
+func (f *FakeBeacon) handleBlobSidecars(w http.ResponseWriter, r *http.Request) {
+	slot, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, "/eth/v1/beacon/blob_sidecars/"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid slot", http.StatusBadRequest)
+		return
+	}
+	sidecars := f.LoadBlobsBundle(slot)
+	query := r.URL.Query()["indices"]
+	if len(query) == 0 {
+		f.writeJSON(w, &sources.APIGetBlobSidecarsResponse{Data: sidecars})
+		return
+	}
+	resp := &sources.APIGetBlobSidecarsResponse{}
+	for _, q := range query {
+		index, err := strconv.ParseUint(q, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid blob index", http.StatusBadRequest)
+			return
+		}
+		if index >= uint64(len(sidecars)) {
+			http.Error(w, fmt.Sprintf("no blob %d in slot %d", index, slot), http.StatusNotFound)
+			return
+		}
+		resp.Data = append(resp.Data, sidecars[index])
+	}
+	f.writeJSON(w, resp)
+}
+
+func (f *FakeBeacon) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		f.log.Error("failed to write beacon API response", "err", err)
+	}
+}
+
+// BeaconAddr returns the base URL of the beacon API.
+func (f *FakeBeacon) BeaconAddr() string {
+	return "http://" + f.listener.Addr().String()
+}
+
+// Close stops serving the beacon API.
+func (f *FakeBeacon) Close() error {
+	if f.srv == nil {
+		return nil
+	}
+	return f.srv.Close()
+}
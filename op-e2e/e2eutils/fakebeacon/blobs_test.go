@@ -0,0 +1,62 @@
+package fakebeacon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func blobSidecar(t *testing.T, data string) (*types.BlobTxSidecar, common.Hash) {
+	var blob eth.Blob
+	require.NoError(t, blob.FromData(eth.Data(data)))
+	commitment, err := blob.ComputeKZGCommitment()
+	require.NoError(t, err)
+	proof, err := kzg4844.ComputeBlobProof(*blob.KZGBlob(), commitment)
+	require.NoError(t, err)
+	return &types.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{*blob.KZGBlob()},
+		Commitments: []kzg4844.Commitment{commitment},
+		Proofs:      []kzg4844.Proof{proof},
+	}, eth.KZGToVersionedHash(commitment)
+}
+
+func TestFakeBeacon(t *testing.T) {
+	beacon := NewBeacon(testlog.Logger(t, log.LvlInfo), 1000, 12)
+	require.NoError(t, beacon.Start("127.0.0.1:0"))
+	t.Cleanup(func() {
+		_ = beacon.Close()
+	})
+
+	_, err := beacon.Slot(1001)
+	require.ErrorContains(t, err, "not at a slot boundary")
+	require.ErrorContains(t, beacon.StoreBlobsBundle(988, common.Hash{}, nil), "before genesis")
+
+	sidecarA, hashA := blobSidecar(t, "hello")
+	sidecarB, hashB := blobSidecar(t, "world")
+	ref := eth.L1BlockRef{Hash: common.Hash{1}, Time: 1036}
+	require.NoError(t, beacon.StoreBlobsBundle(ref.Time, ref.Hash, []*types.BlobTxSidecar{sidecarA, sidecarB}))
+
+	cl := sources.NewL1BeaconClient(sources.NewBeaconHTTPClient(beacon.BeaconAddr(), nil))
+	blobs, err := cl.GetBlobs(context.Background(), ref, []eth.IndexedBlobHash{{Index: 1, Hash: hashB}, {Index: 0, Hash: hashA}})
+	require.NoError(t, err)
+	require.Len(t, blobs, 2)
+	data, err := blobs[0].ToData()
+	require.NoError(t, err)
+	require.Equal(t, eth.Data("world"), data)
+	data, err = blobs[1].ToData()
+	require.NoError(t, err)
+	require.Equal(t, eth.Data("hello"), data)
+
+	_, err = cl.GetBlobs(context.Background(), ref, []eth.IndexedBlobHash{{Index: 2, Hash: hashA}})
+	require.Error(t, err)
+}
@@ -0,0 +1,373 @@
+package faults
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// RPCError is a JSON-RPC error that a fault answers calls with.
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("%d: %s", e.Code, e.Message)
+}
+
+// Fault is a failure that the proxy injects into the RPC calls that it applies to.
+type Fault struct {
+	// Methods that the fault applies to. The fault applies to all calls if empty.
+	// A batch of calls is faulted as a whole if the fault applies to any call in it.
+	Methods []string
+	// Delay of the calls, before they are forwarded or answered.
+	Delay time.Duration
+	// Drop drops the calls. Over HTTP the connection is closed without a response,
+	// over websocket the calls are never answered.
+	Drop bool
+	// Err answers the calls with the error, instead of forwarding them.
+	Err *RPCError
+	// Count limits the fault to the next Count calls that it applies to. The fault is permanent if zero.
+	Count int
+}
+
+func (f *Fault) applies(methods []string) bool {
+	if len(f.Methods) == 0 {
+		return true
+	}
+	for _, m := range methods {
+		for _, fm := range f.Methods {
+			if m == fm {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type rpcCall struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+type rpcErrorResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   *RPCError       `json:"error"`
+}
+
+// parseCalls parses a single JSON-RPC call, or a batch of calls.
+func parseCalls(msg []byte) (calls []rpcCall, batch bool, err error) {
+	msg = bytes.TrimSpace(msg)
+	if len(msg) > 0 && msg[0] == '[' {
+		err = json.Unmarshal(msg, &calls)
+		return calls, true, err
+	}
+	var call rpcCall
+	if err := json.Unmarshal(msg, &call); err != nil {
+		return nil, false, err
+	}
+	return []rpcCall{call}, false, nil
+}
+
+// errorResponse encodes the answer to the calls with the error.
+func errorResponse(calls []rpcCall, batch bool, rpcErr *RPCError) ([]byte, error) {
+	responses := make([]rpcErrorResponse, 0, len(calls))
+	for _, call := range calls {
+		if len(call.ID) == 0 { // notifications are not answered
+			continue
+		}
+		responses = append(responses, rpcErrorResponse{Version: "2.0", ID: call.ID, Error: rpcErr})
+	}
+	if batch {
+		return json.Marshal(responses)
+	}
+	if len(responses) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(responses[0])
+}
+
+// Proxy forwards JSON-RPC calls to an upstream endpoint, over HTTP or websocket,
+// and injects faults into the calls that they apply to.
+// The proxy serves the same protocol as the upstream endpoint, see Endpoint.
+type Proxy struct {
+	log      log.Logger
+	upstream string
+
+	mu     sync.Mutex
+	faults []*Fault
+	conns  map[*websocket.Conn]struct{}
+
+	listener net.Listener
+	srv      *http.Server
+	upgrader websocket.Upgrader
+}
+
+// NewProxy creates a proxy to the upstream http(s) or ws(s) endpoint.
+func NewProxy(log log.Logger, upstream string) *Proxy {
+	return &Proxy{
+		log:      log,
+		upstream: upstream,
+		conns:    make(map[*websocket.Conn]struct{}),
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+	}
+}
+
+// Start serves the proxy on a local port.
+func (p *Proxy) Start() error {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return fmt.Errorf("failed to open tcp listener for rpc proxy: %w", err)
+	}
+	p.listener = listener
+	p.srv = &http.Server{
+		Handler:           p,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := p.srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			p.log.Error("rpc proxy failed", "err", err)
+		}
+	}()
+	return nil
+}
+
+// Endpoint returns the endpoint of the proxy, with the scheme of the upstream endpoint.
+func (p *Proxy) Endpoint() string {
+	scheme, _, _ := strings.Cut(p.upstream, "://")
+	return scheme + "://" + p.listener.Addr().String()
+}
+
+// Inject adds a fault. The faults are checked in the order that they are injected,
+// and only the first fault that applies to a call is injected into it.
+func (p *Proxy) Inject(f Fault) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.faults = append(p.faults, &f)
+}
+
+// Clear removes all faults, so calls are forwarded as usual again.
+func (p *Proxy) Clear() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.faults = nil
+}
+
+// Disconnect closes all open websocket connections, forcing the clients to reconnect.
+func (p *Proxy) Disconnect() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for conn := range p.conns {
+		_ = conn.Close()
+	}
+}
+
+// fault returns the fault to inject into the calls, if any.
+func (p *Proxy) fault(calls []rpcCall) *Fault {
+	methods := make([]string, len(calls))
+	for i, call := range calls {
+		methods[i] = call.Method
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, f := range p.faults {
+		if !f.applies(methods) {
+			continue
+		}
+		out := *f
+		if f.Count > 0 {
+			f.Count--
+			if f.Count == 0 {
+				p.faults = append(p.faults[:i:i], p.faults[i+1:]...)
+			}
+		}
+		p.log.Debug("injecting rpc fault", "methods", methods, "delay", out.Delay, "drop", out.Drop, "err", out.Err)
+		return &out
+	}
+	return nil
+}
+
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		p.serveWS(w, r)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// calls that cannot be parsed are forwarded as they are, for the upstream to answer
+	if calls, batch, err := parseCalls(body); err == nil {
+		if f := p.fault(calls); f != nil {
+			if f.Delay > 0 {
+				select {
+				case <-time.After(f.Delay):
+				case <-r.Context().Done():
+					return
+				}
+			}
+			if f.Drop {
+				p.dropHTTP(w)
+				return
+			}
+			if f.Err != nil {
+				resp, err := errorResponse(calls, batch, f.Err)
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(resp)
+				return
+			}
+		}
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, p.upstream, bytes.NewReader(body))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	req.Header = r.Header.Clone()
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	for k, vs := range resp.Header {
+		for _, v := range vs {
+			w.Header().Add(k, v)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, resp.Body)
+}
+
+// dropHTTP closes the connection of the request, without a response.
+func (p *Proxy) dropHTTP(w http.ResponseWriter) {
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "dropped", http.StatusServiceUnavailable)
+		return
+	}
+	conn, _, err := hj.Hijack()
+	if err != nil {
+		p.log.Warn("failed to drop rpc call", "err", err)
+		return
+	}
+	_ = conn.Close()
+}
+
+// wsConn serializes the writes to a websocket connection.
+type wsConn struct {
+	*websocket.Conn
+	mu sync.Mutex
+}
+
+func (c *wsConn) write(msgType int, msg []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.WriteMessage(msgType, msg)
+}
+
+func (p *Proxy) serveWS(w http.ResponseWriter, r *http.Request) {
+	header := make(http.Header)
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		header.Set("Authorization", auth)
+	}
+	upstreamConn, _, err := websocket.DefaultDialer.DialContext(r.Context(), p.upstream, header)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	clientConn, err := p.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		_ = upstreamConn.Close()
+		p.log.Warn("failed to upgrade rpc proxy connection", "err", err)
+		return
+	}
+	p.mu.Lock()
+	p.conns[clientConn] = struct{}{}
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		delete(p.conns, clientConn)
+		p.mu.Unlock()
+		_ = clientConn.Close()
+		_ = upstreamConn.Close()
+	}()
+
+	client := &wsConn{Conn: clientConn}
+	upstream := &wsConn{Conn: upstreamConn}
+	go func() {
+		defer clientConn.Close()
+		for {
+			msgType, msg, err := upstream.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := client.write(msgType, msg); err != nil {
+				return
+			}
+		}
+	}()
+	for {
+		msgType, msg, err := client.ReadMessage()
+		if err != nil {
+			return
+		}
+		calls, batch, err := parseCalls(msg)
+		var f *Fault
+		if err == nil {
+			f = p.fault(calls)
+		}
+		if f == nil {
+			if err := upstream.write(msgType, msg); err != nil {
+				return
+			}
+			continue
+		}
+		if f.Drop {
+			continue
+		}
+		// the faulty calls are handled in the background, so that a delay does not block the other calls
+		go func() {
+			time.Sleep(f.Delay)
+			if f.Err == nil {
+				_ = upstream.write(msgType, msg)
+				return
+			}
+			resp, err := errorResponse(calls, batch, f.Err)
+			if err != nil || resp == nil {
+				return
+			}
+			_ = client.write(websocket.TextMessage, resp)
+		}()
+	}
+}
+
+// Close stops the proxy, and closes all open connections.
+func (p *Proxy) Close() error {
+	if p.srv == nil {
+		return nil
+	}
+	p.Disconnect()
+	return p.srv.Close()
+}
@@ -0,0 +1,131 @@
+package faults
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type testAPI struct{}
+
+func (testAPI) Echo(s string) string {
+	return s
+}
+
+func (testAPI) Ping() string {
+	return "pong"
+}
+
+func startUpstream(t *testing.T) (httpURL string, wsURL string) {
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("test", testAPI{}))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	mux := http.NewServeMux()
+	mux.Handle("/", srv)
+	mux.Handle("/ws", srv.WebsocketHandler([]string{"*"}))
+	httpSrv := &http.Server{Handler: mux}
+	go func() {
+		_ = httpSrv.Serve(listener)
+	}()
+	t.Cleanup(func() {
+		_ = httpSrv.Close()
+		srv.Stop()
+	})
+	return "http://" + listener.Addr().String(), "ws://" + listener.Addr().String() + "/ws"
+}
+
+func TestProxy(t *testing.T) {
+	httpURL, wsURL := startUpstream(t)
+	for _, upstream := range []string{httpURL, wsURL} {
+		upstream := upstream
+		t.Run(upstream[:2], func(t *testing.T) {
+			proxy := NewProxy(testlog.Logger(t, log.LvlInfo), upstream)
+			require.NoError(t, proxy.Start())
+			t.Cleanup(func() {
+				_ = proxy.Close()
+			})
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			cl, err := rpc.DialContext(ctx, proxy.Endpoint())
+			require.NoError(t, err)
+			t.Cleanup(cl.Close)
+
+			var out string
+			require.NoError(t, cl.CallContext(ctx, &out, "test_echo", "hello"))
+			require.Equal(t, "hello", out)
+
+			// errors apply to the configured methods only, for the configured number of calls
+			proxy.Inject(Fault{Methods: []string{"test_echo"}, Err: &RPCError{Code: -32000, Message: "injected"}, Count: 1})
+			require.NoError(t, cl.CallContext(ctx, &out, "test_ping"))
+			require.Equal(t, "pong", out)
+			err = cl.CallContext(ctx, &out, "test_echo", "hello")
+			var rpcErr rpc.Error
+			require.ErrorAs(t, err, &rpcErr)
+			require.Equal(t, -32000, rpcErr.ErrorCode())
+			require.Equal(t, "injected", rpcErr.Error())
+			require.NoError(t, cl.CallContext(ctx, &out, "test_echo", "again"))
+			require.Equal(t, "again", out)
+
+			// errors are returned per call in a batch
+			proxy.Inject(Fault{Methods: []string{"test_ping"}, Err: &RPCError{Code: -32001, Message: "batch"}, Count: 1})
+			batch := []rpc.BatchElem{{Method: "test_echo", Args: []any{"x"}, Result: new(string)}, {Method: "test_ping", Result: new(string)}}
+			require.NoError(t, cl.BatchCallContext(ctx, batch))
+			require.ErrorContains(t, batch[0].Error, "batch")
+			require.ErrorContains(t, batch[1].Error, "batch")
+
+			// delayed calls are still answered
+			proxy.Inject(Fault{Delay: 200 * time.Millisecond, Count: 1})
+			start := time.Now()
+			require.NoError(t, cl.CallContext(ctx, &out, "test_ping"))
+			require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+
+			// dropped calls are not answered
+			proxy.Inject(Fault{Drop: true})
+			dropCtx, dropCancel := context.WithTimeout(ctx, 500*time.Millisecond)
+			defer dropCancel()
+			require.Error(t, cl.CallContext(dropCtx, &out, "test_ping"))
+
+			proxy.Clear()
+			if upstream == wsURL {
+				// the websocket connection is kept open when calls are dropped
+				require.NoError(t, cl.CallContext(ctx, &out, "test_ping"))
+				require.Equal(t, "pong", out)
+			}
+			// a new client can connect after all faults are cleared
+			cl2, err := rpc.DialContext(ctx, proxy.Endpoint())
+			require.NoError(t, err)
+			defer cl2.Close()
+			require.NoError(t, cl2.CallContext(ctx, &out, "test_echo", "back"))
+			require.Equal(t, "back", out)
+		})
+	}
+}
+
+func TestProxyDisconnect(t *testing.T) {
+	_, wsURL := startUpstream(t)
+	proxy := NewProxy(testlog.Logger(t, log.LvlInfo), wsURL)
+	require.NoError(t, proxy.Start())
+	t.Cleanup(func() {
+		_ = proxy.Close()
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	cl, err := rpc.DialContext(ctx, proxy.Endpoint())
+	require.NoError(t, err)
+	defer cl.Close()
+
+	var out string
+	require.NoError(t, cl.CallContext(ctx, &out, "test_ping"))
+	proxy.Disconnect()
+	require.Error(t, cl.CallContext(ctx, &out, "test_ping"))
+}
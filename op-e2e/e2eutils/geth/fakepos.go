@@ -1,6 +1,9 @@
 package geth
 
 import (
+	"context"
+	"fmt"
+	"math/big"
 	"math/rand"
 	"time"
 
@@ -17,9 +20,9 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/testutils"
 )
 
-// fakePoS is a testing-only utility to attach to Geth,
+// FakePoS is a testing-only utility to attach to Geth,
 // to build a fake proof-of-stake L1 chain with fixed block time and basic lagging safe/finalized blocks.
-type fakePoS struct {
+type FakePoS struct {
 	clock     clock.Clock
 	eth       *eth.Ethereum
 	log       log.Logger
@@ -32,9 +35,51 @@ type fakePoS struct {
 
 	engineAPI *catalyst.ConsensusAPI
 	sub       ethereum.Subscription
+
+	// reorgs counts the reorgs, to build different blocks in place of the reorged blocks
+	reorgs        uint64
+	reorgRequests chan reorgRequest
+}
+
+type reorgRequest struct {
+	depth  uint64
+	result chan error
+}
+
+// Reorg rewinds the L1 chain by depth blocks. The blocks are rebuilt right away,
+// with the same timestamps, but with a different prev-randao value, so they have different hashes.
+// The transactions of the reorged blocks return to the transaction pool.
+// Safe and finalized blocks may be reorged too, if the depth is large enough.
+func (f *FakePoS) Reorg(ctx context.Context, depth uint64) error {
+	req := reorgRequest{depth: depth, result: make(chan error, 1)}
+	select {
+	case f.reorgRequests <- req:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err := <-req.result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (f *FakePoS) reorg(depth uint64) error {
+	chain := f.eth.BlockChain()
+	head := chain.CurrentBlock()
+	if depth == 0 || depth > head.Number.Uint64() {
+		return fmt.Errorf("cannot reorg %d blocks of L1 chain with head %d", depth, head.Number)
+	}
+	if err := chain.SetHead(head.Number.Uint64() - depth); err != nil {
+		return fmt.Errorf("failed to rewind L1 chain by %d blocks: %w", depth, err)
+	}
+	f.reorgs++
+	f.log.Info("Reorged L1 chain", "depth", depth, "old_head", head.Number, "new_head", chain.CurrentBlock().Number)
+	return nil
 }
 
-func (f *fakePoS) Start() error {
+func (f *FakePoS) Start() error {
 	if advancing, ok := f.clock.(*clock.AdvancingClock); ok {
 		advancing.Start()
 	}
@@ -44,6 +89,8 @@ func (f *fakePoS) Start() error {
 		t := f.clock.NewTicker(time.Second / 2)
 		for {
 			select {
+			case req := <-f.reorgRequests:
+				req.result <- f.reorg(req.depth)
 			case now := <-t.Ch():
 				chain := f.eth.BlockChain()
 				head := chain.CurrentBlock()
@@ -87,7 +134,7 @@ func (f *fakePoS) Start() error {
 					FinalizedBlockHash: finalized.Hash(),
 				}, &engine.PayloadAttributes{
 					Timestamp:             newBlockTime,
-					Random:                common.Hash{},
+					Random:                common.BigToHash(new(big.Int).SetUint64(f.reorgs)),
 					SuggestedFeeRecipient: head.Coinbase,
 					Withdrawals:           withdrawals,
 				})
@@ -138,7 +185,7 @@ func (f *fakePoS) Start() error {
 	return nil
 }
 
-func (f *fakePoS) Stop() error {
+func (f *FakePoS) Stop() error {
 	f.sub.Unsubscribe()
 	if advancing, ok := f.clock.(*clock.AdvancingClock); ok {
 		advancing.Stop()
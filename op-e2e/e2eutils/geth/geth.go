@@ -21,7 +21,8 @@ import (
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 )
 
-func InitL1(chainID uint64, blockTime uint64, genesis *core.Genesis, c clock.Clock, opts ...GethOption) (*node.Node, *eth.Ethereum, error) {
+// InitL1 inits a L1 geth node, with a FakePoS sidecar that builds the L1 blocks.
+func InitL1(chainID uint64, blockTime uint64, genesis *core.Genesis, c clock.Clock, opts ...GethOption) (*node.Node, *eth.Ethereum, *FakePoS, error) {
 	ethConfig := &ethconfig.Config{
 		NetworkId: chainID,
 		Genesis:   genesis,
@@ -38,13 +39,13 @@ func InitL1(chainID uint64, blockTime uint64, genesis *core.Genesis, c clock.Clo
 
 	l1Node, l1Eth, err := createGethNode(false, nodeConfig, ethConfig, opts...)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	// Activate merge
 	l1Eth.Merger().FinalizePoS()
 
 	// Instead of running a whole beacon node, we run this fake-proof-of-stake sidecar that sequences L1 blocks using the Engine API.
-	l1Node.RegisterLifecycle(&fakePoS{
+	fakePoS := &FakePoS{
 		clock:     c,
 		eth:       l1Eth,
 		log:       log.Root(), // geth logger is global anyway. Would be nice to replace with a local logger though.
@@ -53,9 +54,11 @@ func InitL1(chainID uint64, blockTime uint64, genesis *core.Genesis, c clock.Clo
 		finalizedDistance: 8,
 		safeDistance:      4,
 		engineAPI:         catalyst.NewConsensusAPI(l1Eth),
-	})
+		reorgRequests:     make(chan reorgRequest),
+	}
+	l1Node.RegisterLifecycle(fakePoS)
 
-	return l1Node, l1Eth, nil
+	return l1Node, l1Eth, fakePoS, nil
 }
 
 func defaultNodeConfig(name string, jwtPath string) *node.Config {
@@ -2,13 +2,13 @@ package e2eutils
 
 import (
 	"crypto/ecdsa"
-	"fmt"
 
 	hdwallet "github.com/ethereum-optimism/go-ethereum-hdwallet"
-	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ethereum-optimism/optimism/op-service/secrets"
 )
 
 // DefaultMnemonicConfig is the default mnemonic used in testing.
@@ -17,16 +17,24 @@ import (
 // If these values are changed, it is subject to breaking tests. They
 // must be in sync with the values in the DeployConfig used to create the system.
 var DefaultMnemonicConfig = &MnemonicConfig{
-	Mnemonic:     "test test test test test test test test test test test junk",
-	CliqueSigner: "m/44'/60'/0'/0/0",
-	Proposer:     "m/44'/60'/0'/0/1",
-	Batcher:      "m/44'/60'/0'/0/2",
-	Deployer:     "m/44'/60'/0'/0/3",
-	Alice:        "m/44'/60'/0'/0/4",
-	SequencerP2P: "m/44'/60'/0'/0/5",
-	Bob:          "m/44'/60'/0'/0/7",
-	Mallory:      "m/44'/60'/0'/0/8",
-	SysCfgOwner:  "m/44'/60'/0'/0/9",
+	Mnemonic:     secrets.TestMnemonic,
+	CliqueSigner: mustStandardPath(secrets.RoleCliqueSigner),
+	Proposer:     mustStandardPath(secrets.RoleProposer),
+	Batcher:      mustStandardPath(secrets.RoleBatcher),
+	Deployer:     mustStandardPath(secrets.RoleDeployer),
+	Alice:        mustStandardPath(secrets.RoleAlice),
+	SequencerP2P: mustStandardPath(secrets.RoleSequencerP2P),
+	Bob:          mustStandardPath(secrets.RoleBob),
+	Mallory:      mustStandardPath(secrets.RoleMallory),
+	SysCfgOwner:  mustStandardPath(secrets.RoleSysCfgOwner),
+}
+
+func mustStandardPath(role secrets.Role) string {
+	path, err := secrets.StandardPath(role)
+	if err != nil {
+		panic(err)
+	}
+	return path
 }
 
 // MnemonicConfig configures the private keys for the hive testnet.
@@ -52,47 +60,44 @@ type MnemonicConfig struct {
 // Secrets computes the private keys for all mnemonic paths,
 // which can then be kept around for fast precomputed private key access.
 func (m *MnemonicConfig) Secrets() (*Secrets, error) {
-	wallet, err := hdwallet.NewFromMnemonic(m.Mnemonic)
+	manager, err := secrets.NewManager(m.Mnemonic)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create wallet: %w", err)
-	}
-	account := func(path string) accounts.Account {
-		return accounts.Account{URL: accounts.URL{Path: path}}
+		return nil, err
 	}
 
-	deployer, err := wallet.PrivateKey(account(m.Deployer))
+	deployer, err := manager.PathKey(m.Deployer)
 	if err != nil {
 		return nil, err
 	}
-	cliqueSigner, err := wallet.PrivateKey(account(m.CliqueSigner))
+	cliqueSigner, err := manager.PathKey(m.CliqueSigner)
 	if err != nil {
 		return nil, err
 	}
-	sysCfgOwner, err := wallet.PrivateKey(account(m.SysCfgOwner))
+	sysCfgOwner, err := manager.PathKey(m.SysCfgOwner)
 	if err != nil {
 		return nil, err
 	}
-	proposer, err := wallet.PrivateKey(account(m.Proposer))
+	proposer, err := manager.PathKey(m.Proposer)
 	if err != nil {
 		return nil, err
 	}
-	batcher, err := wallet.PrivateKey(account(m.Batcher))
+	batcher, err := manager.PathKey(m.Batcher)
 	if err != nil {
 		return nil, err
 	}
-	sequencerP2P, err := wallet.PrivateKey(account(m.SequencerP2P))
+	sequencerP2P, err := manager.PathKey(m.SequencerP2P)
 	if err != nil {
 		return nil, err
 	}
-	alice, err := wallet.PrivateKey(account(m.Alice))
+	alice, err := manager.PathKey(m.Alice)
 	if err != nil {
 		return nil, err
 	}
-	bob, err := wallet.PrivateKey(account(m.Bob))
+	bob, err := manager.PathKey(m.Bob)
 	if err != nil {
 		return nil, err
 	}
-	mallory, err := wallet.PrivateKey(account(m.Mallory))
+	mallory, err := manager.PathKey(m.Mallory)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +112,7 @@ func (m *MnemonicConfig) Secrets() (*Secrets, error) {
 		Alice:        alice,
 		Bob:          bob,
 		Mallory:      mallory,
-		Wallet:       wallet,
+		Wallet:       manager.Wallet(),
 	}, nil
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/wait"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
 )
 
@@ -593,6 +594,40 @@ func TestCannonChallengeWithCorrectRoot(t *testing.T) {
 	require.EqualValues(t, disputegame.StatusChallengerWins, game.Status(ctx))
 }
 
+// TestCannonScriptedDefender plays a malicious defender of an invalid root claim that stalls after a few moves
+// against the honest challenger, and checks that the challenger wins and that the game holds the bonds of the defender.
+func TestCannonScriptedDefender(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys, l1Client := startFaultDisputeSystem(t)
+	t.Cleanup(sys.Close)
+
+	disputeGameFactory := disputegame.NewFactoryHelper(t, ctx, sys.cfg.L1Deployments, l1Client)
+	game := disputeGameFactory.StartCannonGame(ctx, common.Hash{0x01, 0xaa})
+	require.NotNil(t, game)
+
+	game.StartChallenger(ctx, sys.RollupConfig, sys.L2GenesisCfg, sys.NodeEndpoint("l1"), sys.NodeEndpoint("sequencer"), "Challenger",
+		challenger.WithAgreeProposedOutput(true),
+		challenger.WithPrivKey(sys.cfg.Secrets.Alice),
+	)
+
+	scenario := game.NewScenario()
+	bond := big.NewInt(params.GWei)
+	defender := scenario.AddActor(ctx, "Defender", sys.cfg.Secrets.Mallory, true, disputegame.Selective(disputegame.UpToDepth(4), disputegame.AttackWrong()), bond)
+	scenario.Run(ctx, 30*time.Second)
+	require.Positive(t, defender.BondsPosted().Cmp(common.Big0), "defender should have moved")
+	game.WaitForClaimAtDepth(ctx, 5)
+	game.LogGameData(ctx)
+
+	sys.TimeTravelClock.AdvanceTime(game.GameDuration(ctx))
+	require.NoError(t, wait.ForNextBlock(ctx, l1Client))
+
+	game.WaitForInactivity(ctx, 10, true)
+	scenario.RequireOutcome(ctx, disputegame.StatusChallengerWins)
+	scenario.RequireBondsHeld(ctx, common.Big0)
+}
+
 func startFaultDisputeSystem(t *testing.T) (*System, *ethclient.Client) {
 	cfg := DefaultSystemConfig(t)
 	delete(cfg.Nodes, "verifier")
@@ -0,0 +1,138 @@
+package op_e2e
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/faults"
+)
+
+// p2pLink is a mocknet link between two rollup nodes, that was removed by a P2P partition.
+type p2pLink struct {
+	a, b      peer.ID
+	connected bool
+}
+
+// startL1Proxies starts a fault-injection proxy in front of the L1 node,
+// for every rollup node, the batcher and the proposer.
+func (sys *System) startL1Proxies() error {
+	l1 := sys.EthInstances["l1"]
+	upstreams := map[string]string{
+		"batcher":  l1.WSEndpoint(),
+		"proposer": l1.WSEndpoint(),
+	}
+	for name := range sys.cfg.Nodes {
+		upstreams[name] = selectEndpoint(l1)
+	}
+	for name, upstream := range upstreams {
+		logger := sys.cfg.Loggers[name]
+		if logger == nil {
+			logger = log.Root()
+		}
+		proxy := faults.NewProxy(logger.New("proxy", "l1"), upstream)
+		if err := proxy.Start(); err != nil {
+			return fmt.Errorf("failed to start L1 proxy for %s: %w", name, err)
+		}
+		sys.L1Proxies[name] = proxy
+	}
+	return nil
+}
+
+// l1Endpoint returns the endpoint that the component connects to L1 with:
+// the endpoint of its fault-injection proxy, if any, or else the given L1 endpoint.
+func (sys *System) l1Endpoint(name string, endpoint string) string {
+	if proxy, ok := sys.L1Proxies[name]; ok {
+		return proxy.Endpoint()
+	}
+	return endpoint
+}
+
+// ReorgL1 reorgs the last depth blocks of the L1 chain. The reorged blocks are replaced with
+// different blocks right away, so the L1 chain keeps its length.
+func (sys *System) ReorgL1(ctx context.Context, depth uint64) error {
+	if sys.l1FakePoS == nil {
+		return errors.New("L1 chain does not support reorgs")
+	}
+	return sys.l1FakePoS.Reorg(ctx, depth)
+}
+
+// PauseBatcher stops the batcher from submitting batches, until ResumeBatcher is called.
+func (sys *System) PauseBatcher(ctx context.Context) error {
+	if sys.BatchSubmitter == nil {
+		return errors.New("system has no batcher")
+	}
+	return sys.BatchSubmitter.Driver().StopBatchSubmitting(ctx)
+}
+
+// ResumeBatcher starts the batch submission again, after PauseBatcher.
+func (sys *System) ResumeBatcher() error {
+	if sys.BatchSubmitter == nil {
+		return errors.New("system has no batcher")
+	}
+	return sys.BatchSubmitter.Driver().StartBatchSubmitting()
+}
+
+func (sys *System) p2pPeerID(name string) (peer.ID, error) {
+	node, ok := sys.RollupNodes[name]
+	if !ok {
+		return "", fmt.Errorf("unknown rollup node %s", name)
+	}
+	if node.P2P() == nil || node.P2P().Host() == nil {
+		return "", fmt.Errorf("rollup node %s has no p2p", name)
+	}
+	return node.P2P().Host().ID(), nil
+}
+
+// PartitionP2P splits the P2P network between the two groups of rollup nodes:
+// the nodes of one group cannot connect to the nodes of the other group, until HealP2P is called.
+// The connections within each group are unaffected.
+func (sys *System) PartitionP2P(groupA []string, groupB []string) error {
+	for _, nameA := range groupA {
+		a, err := sys.p2pPeerID(nameA)
+		if err != nil {
+			return err
+		}
+		for _, nameB := range groupB {
+			b, err := sys.p2pPeerID(nameB)
+			if err != nil {
+				return err
+			}
+			if len(sys.Mocknet.LinksBetweenPeers(a, b)) == 0 {
+				continue
+			}
+			link := p2pLink{a: a, b: b, connected: len(sys.Mocknet.Net(a).ConnsToPeer(b)) > 0}
+			if link.connected {
+				if err := sys.Mocknet.DisconnectPeers(a, b); err != nil {
+					return fmt.Errorf("failed to disconnect %s and %s: %w", nameA, nameB, err)
+				}
+			}
+			if err := sys.Mocknet.UnlinkPeers(a, b); err != nil {
+				return fmt.Errorf("failed to unlink %s and %s: %w", nameA, nameB, err)
+			}
+			sys.p2pPartitionedLinks = append(sys.p2pPartitionedLinks, link)
+		}
+	}
+	return nil
+}
+
+// HealP2P restores the P2P links that were removed by PartitionP2P,
+// and reconnects the nodes that were connected before the partition.
+func (sys *System) HealP2P() error {
+	for _, link := range sys.p2pPartitionedLinks {
+		if _, err := sys.Mocknet.LinkPeers(link.a, link.b); err != nil {
+			return fmt.Errorf("failed to link %s and %s: %w", link.a, link.b, err)
+		}
+		if link.connected {
+			if _, err := sys.Mocknet.ConnectPeers(link.a, link.b); err != nil {
+				return fmt.Errorf("failed to connect %s and %s: %w", link.a, link.b, err)
+			}
+		}
+	}
+	sys.p2pPartitionedLinks = nil
+	return nil
+}
@@ -0,0 +1,209 @@
+package op_e2e
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/wait"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+// AddStandbySequencer adds a rollup node that runs with the same configuration as the sequencer,
+// but with the sequencer stopped, so it can take over sequencing from the sequencer, see SequencerGroup.
+// The standby is connected over P2P to the sequencer, the verifier and the other standby sequencers,
+// and syncs the unsafe chain from them.
+func (cfg *SystemConfig) AddStandbySequencer(t *testing.T, name string) {
+	require.NotContains(t, cfg.Nodes, name, "node %s already exists", name)
+	seqCfg := *cfg.Nodes["sequencer"] // copy
+	seqCfg.Driver.SequencerStopped = true
+	cfg.Nodes[name] = &seqCfg
+	cfg.Loggers[name] = testlog.Logger(t, log.LvlInfo).New("role", name)
+
+	if cfg.P2PTopology == nil {
+		cfg.P2PTopology = make(map[string][]string)
+	}
+	if !slices.Contains(cfg.P2PTopology["verifier"], "sequencer") && !slices.Contains(cfg.P2PTopology["sequencer"], "verifier") {
+		cfg.P2PTopology["verifier"] = append(cfg.P2PTopology["verifier"], "sequencer")
+	}
+	peers := []string{"sequencer", "verifier"}
+	for other, otherCfg := range cfg.Nodes {
+		if other != name && other != "sequencer" && otherCfg.Driver.SequencerEnabled {
+			peers = append(peers, other)
+		}
+	}
+	// the peers of the standby are sorted, so the topology does not depend on the map order
+	slices.Sort(peers)
+	cfg.P2PTopology[name] = peers
+	// standby sequencers fill the gaps in the unsafe chain that they may miss during a handoff
+	cfg.P2PReqRespSync = true
+}
+
+// SequencerGroup is a set of sequencer rollup nodes of a System, of which one sequencer is active at a time.
+// Leadership is handed off between the sequencers through their admin RPC, as a conductor would do.
+type SequencerGroup struct {
+	sys     *System
+	active  string
+	clients map[string]*sources.RollupClient
+	crashed map[string]bool
+}
+
+// NewSequencerGroup creates a group of the sequencer nodes of the System.
+// The first node is the active sequencer, the others must be stopped standby sequencers.
+func NewSequencerGroup(t *testing.T, sys *System, names ...string) *SequencerGroup {
+	require.NotEmpty(t, names, "need at least one sequencer")
+	g := &SequencerGroup{
+		sys:     sys,
+		active:  names[0],
+		clients: make(map[string]*sources.RollupClient),
+		crashed: make(map[string]bool),
+	}
+	for _, name := range names {
+		node, ok := sys.RollupNodes[name]
+		require.True(t, ok, "unknown rollup node %s", name)
+		rpcClient, err := rpc.DialContext(context.Background(), node.HTTPEndpoint())
+		require.NoError(t, err)
+		t.Cleanup(rpcClient.Close)
+		g.clients[name] = sources.NewRollupClient(client.NewBaseRPCClient(rpcClient))
+	}
+	return g
+}
+
+// Active returns the name of the active sequencer.
+func (g *SequencerGroup) Active() string {
+	return g.active
+}
+
+// RollupClient returns the rollup RPC client of a sequencer of the group.
+func (g *SequencerGroup) RollupClient(name string) *sources.RollupClient {
+	return g.clients[name]
+}
+
+// Handoff gracefully hands off sequencing from the active sequencer to the given standby.
+// The active sequencer is stopped, the standby waits until it has synced the last block of the
+// active sequencer, and then continues sequencing on top of it.
+func (g *SequencerGroup) Handoff(ctx context.Context, to string) error {
+	if g.active == "" {
+		return errors.New("no active sequencer to hand off from, promote a standby instead")
+	}
+	if to == g.active {
+		return fmt.Errorf("sequencer %s is already active", to)
+	}
+	if _, ok := g.clients[to]; !ok {
+		return fmt.Errorf("sequencer %s is not in the group", to)
+	}
+	head, err := g.clients[g.active].StopSequencer(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to stop active sequencer %s: %w", g.active, err)
+	}
+	if err := g.waitForUnsafeHead(ctx, to, head); err != nil {
+		return err
+	}
+	if err := g.clients[to].StartSequencer(ctx, head); err != nil {
+		return fmt.Errorf("failed to start sequencer %s at %s: %w", to, head, err)
+	}
+	g.sys.cfg.Loggers[to].Info("Sequencer took over", "from", g.active, "head", head)
+	g.active = to
+	return nil
+}
+
+// CrashActive stops the rollup node of the active sequencer, without stopping its sequencer first.
+// The group has no active sequencer until Promote is called.
+func (g *SequencerGroup) CrashActive(ctx context.Context) error {
+	if g.active == "" {
+		return errors.New("no active sequencer")
+	}
+	if err := g.sys.RollupNodes[g.active].Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop rollup node %s: %w", g.active, err)
+	}
+	g.crashed[g.active] = true
+	g.active = ""
+	return nil
+}
+
+// Promote starts sequencing on the given standby, on top of its own unsafe head,
+// after the active sequencer crashed.
+func (g *SequencerGroup) Promote(ctx context.Context, to string) error {
+	if g.active != "" {
+		return fmt.Errorf("sequencer %s is still active, hand off instead", g.active)
+	}
+	if g.crashed[to] {
+		return fmt.Errorf("sequencer %s crashed", to)
+	}
+	cl, ok := g.clients[to]
+	if !ok {
+		return fmt.Errorf("sequencer %s is not in the group", to)
+	}
+	status, err := cl.SyncStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sync status of %s: %w", to, err)
+	}
+	if err := cl.StartSequencer(ctx, status.UnsafeL2.Hash); err != nil {
+		return fmt.Errorf("failed to start sequencer %s at %s: %w", to, status.UnsafeL2, err)
+	}
+	g.sys.cfg.Loggers[to].Info("Sequencer promoted", "head", status.UnsafeL2)
+	g.active = to
+	return nil
+}
+
+func (g *SequencerGroup) waitForUnsafeHead(ctx context.Context, name string, head common.Hash) error {
+	err := wait.For(ctx, 100*time.Millisecond, func() (bool, error) {
+		status, err := g.clients[name].SyncStatus(ctx)
+		if err != nil {
+			return false, err
+		}
+		return status.UnsafeL2.Hash == head, nil
+	})
+	if err != nil {
+		return fmt.Errorf("sequencer %s did not sync to unsafe head %s: %w", name, head, err)
+	}
+	return nil
+}
+
+// RequireConsistentUnsafeChain asserts that the L2 execution engines of the rollup nodes have the same unsafe chain,
+// up to the lowest head of the nodes: there is no fork between the nodes, every block builds on the previous block,
+// and no L2 block time slot is skipped. It returns the number of the last block that was checked.
+func (sys *System) RequireConsistentUnsafeChain(t *testing.T, ctx context.Context, names ...string) uint64 {
+	require.NotEmpty(t, names)
+	head := uint64(0)
+	for i, name := range names {
+		n, err := sys.Clients[name].BlockNumber(ctx)
+		require.NoError(t, err, "failed to get head of %s", name)
+		if i == 0 || n < head {
+			head = n
+		}
+	}
+	blockTime := sys.RollupConfig.BlockTime
+	var parent *types.Header
+	for num := uint64(0); num <= head; num++ {
+		var header *types.Header
+		for _, name := range names {
+			h, err := sys.Clients[name].HeaderByNumber(ctx, new(big.Int).SetUint64(num))
+			require.NoError(t, err, "failed to get block %d of %s", num, name)
+			if header == nil {
+				header = h
+				continue
+			}
+			require.Equal(t, header.Hash(), h.Hash(), "fork at block %d: %s of %s differs from %s of %s", num, h.Hash(), name, header.Hash(), names[0])
+		}
+		if parent != nil {
+			require.Equal(t, parent.Hash(), header.ParentHash, "block %d does not build on block %d", num, num-1)
+			require.Equal(t, parent.Time+blockTime, header.Time, "gap in the L2 chain between block %d and %d", num-1, num)
+		}
+		parent = header
+	}
+	return head
+}
@@ -0,0 +1,78 @@
+package op_e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+func setupSequencerFailoverTest(t *testing.T) (*System, *SequencerGroup) {
+	cfg := DefaultSystemConfig(t)
+	cfg.AddStandbySequencer(t, "sequencer2")
+	sys, err := cfg.Start(t)
+	require.NoError(t, err, "Error starting up system")
+	t.Cleanup(sys.Close)
+	return sys, NewSequencerGroup(t, sys, "sequencer", "sequencer2")
+}
+
+// waitForUnsafeBlocks waits until the sequencer built more unsafe blocks on top of its current unsafe head.
+func waitForUnsafeBlocks(t *testing.T, ctx context.Context, g *SequencerGroup, name string, count uint64) *eth.SyncStatus {
+	status, err := g.RollupClient(name).SyncStatus(ctx)
+	require.NoError(t, err)
+	return waitForSyncStatus(t, ctx, g.RollupClient(name), func(s *eth.SyncStatus) bool {
+		return s.UnsafeL2.Number >= status.UnsafeL2.Number+count
+	})
+}
+
+func TestSequencerHandoff(t *testing.T) {
+	InitParallel(t)
+	sys, g := setupSequencerFailoverTest(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	waitForUnsafeBlocks(t, ctx, g, "sequencer", 3)
+	require.NoError(t, g.Handoff(ctx, "sequencer2"))
+	require.Equal(t, "sequencer2", g.Active())
+	active, err := g.RollupClient("sequencer2").SequencerActive(ctx)
+	require.NoError(t, err)
+	require.True(t, active)
+	active, err = g.RollupClient("sequencer").SequencerActive(ctx)
+	require.NoError(t, err)
+	require.False(t, active)
+	waitForUnsafeBlocks(t, ctx, g, "sequencer2", 3)
+
+	// and back again
+	require.NoError(t, g.Handoff(ctx, "sequencer"))
+	status := waitForUnsafeBlocks(t, ctx, g, "sequencer", 3)
+
+	// every node follows the same unsafe chain, without gaps at the handoffs
+	waitForSyncStatus(t, ctx, rollupClientFor(t, sys, "verifier"), func(s *eth.SyncStatus) bool {
+		return s.UnsafeL2.Number >= status.UnsafeL2.Number
+	})
+	head := sys.RequireConsistentUnsafeChain(t, ctx, "sequencer", "sequencer2", "verifier")
+	require.GreaterOrEqual(t, head, status.UnsafeL2.Number)
+}
+
+func TestSequencerCrashFailover(t *testing.T) {
+	InitParallel(t)
+	sys, g := setupSequencerFailoverTest(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	waitForUnsafeBlocks(t, ctx, g, "sequencer", 3)
+	require.NoError(t, g.CrashActive(ctx))
+	require.Error(t, g.Handoff(ctx, "sequencer2"), "cannot hand off from a crashed sequencer")
+	require.NoError(t, g.Promote(ctx, "sequencer2"))
+	require.Error(t, g.Promote(ctx, "sequencer"), "a sequencer is active already")
+	status := waitForUnsafeBlocks(t, ctx, g, "sequencer2", 3)
+
+	// the verifier follows the new sequencer, and the chain has no gap at the failover
+	waitForSyncStatus(t, ctx, rollupClientFor(t, sys, "verifier"), func(s *eth.SyncStatus) bool {
+		return s.UnsafeL2.Number >= status.UnsafeL2.Number
+	})
+	sys.RequireConsistentUnsafeChain(t, ctx, "sequencer2", "verifier")
+}
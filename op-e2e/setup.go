@@ -6,6 +6,7 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"maps"
 	"math/big"
 	"net"
 	"os"
@@ -38,10 +39,12 @@ import (
 
 	bss "github.com/ethereum-optimism/optimism/op-batcher/batcher"
 	"github.com/ethereum-optimism/optimism/op-batcher/compressor"
+	batcherFlags "github.com/ethereum-optimism/optimism/op-batcher/flags"
 	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
 	"github.com/ethereum-optimism/optimism/op-e2e/config"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/faults"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/geth"
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
@@ -148,6 +151,7 @@ func DefaultSystemConfig(t *testing.T) SystemConfig {
 		P2PTopology:                nil, // no P2P connectivity by default
 		NonFinalizedProposals:      false,
 		ExternalL2Shim:             config.ExternalL2Shim,
+		ExternalL2Shims:            maps.Clone(config.ExternalL2Shims),
 		BatcherTargetL1TxSizeBytes: 100_000,
 	}
 }
@@ -184,6 +188,9 @@ type SystemConfig struct {
 	BatcherLogger  log.Logger
 
 	ExternalL2Shim string
+	// ExternalL2Shims overrides ExternalL2Shim for individual nodes, to run a mix of execution clients.
+	// An empty shim runs the node with the in-process geth, even if ExternalL2Shim is set.
+	ExternalL2Shims map[string]string
 
 	// map of outbound connections to other nodes. Node names prefixed with "~" are unconnected but linked.
 	// A nil map disables P2P completely.
@@ -204,6 +211,19 @@ type SystemConfig struct {
 
 	// SupportL1TimeTravel determines if the L1 node supports quickly skipping forward in time
 	SupportL1TimeTravel bool
+
+	// SupportTimeTravel shares the time travel clock of the L1 node with the rollup nodes, the batcher and the proposer,
+	// so that the whole system skips forward in time together. Implies SupportL1TimeTravel.
+	// Challengers can join in with challenger.WithClock(sys.TimeTravelClock).
+	SupportTimeTravel bool
+
+	// FaultProxies routes the L1 RPC connections of the rollup nodes, the batcher and the proposer
+	// through fault-injection proxies, see System.L1Proxies.
+	FaultProxies bool
+
+	// Snapshot restores the L1 and L2 chains from a snapshot of a system with the same configuration,
+	// instead of starting from genesis. See System.Snapshot.
+	Snapshot *SystemSnapshot
 }
 
 type GethInstance struct {
@@ -241,6 +261,14 @@ type EthInstance interface {
 	Close() error
 }
 
+// externalL2Shim returns the shim to run the L2 node with, or an empty string to run the in-process geth.
+func (cfg *SystemConfig) externalL2Shim(name string) string {
+	if shim, ok := cfg.ExternalL2Shims[name]; ok {
+		return shim
+	}
+	return cfg.ExternalL2Shim
+}
+
 type System struct {
 	cfg SystemConfig
 
@@ -257,12 +285,20 @@ type System struct {
 	BatchSubmitter    *bss.BatcherService
 	Mocknet           mocknet.Mocknet
 
-	// TimeTravelClock is nil unless SystemConfig.SupportL1TimeTravel was set to true
+	// TimeTravelClock is nil unless SystemConfig.SupportL1TimeTravel or SystemConfig.SupportTimeTravel was set to true
 	// It provides access to the clock instance used by the L1 node. Calling TimeTravelClock.AdvanceBy
 	// allows tests to quickly time travel L1 into the future.
+	// With SupportTimeTravel the rollup nodes, the batcher and the proposer use the same clock.
 	// Note that this time travel may occur in a single block, creating a very large difference in the Time
 	// on sequential blocks.
 	TimeTravelClock *clock.AdvancingClock
+
+	// L1Proxies are the fault-injection proxies of the L1 RPC connections, by the name of the rollup node,
+	// or "batcher" or "proposer". The proxies are only set up if SystemConfig.FaultProxies is true.
+	L1Proxies map[string]*faults.Proxy
+
+	l1FakePoS           *geth.FakePoS
+	p2pPartitionedLinks []p2pLink
 }
 
 func (sys *System) NodeEndpoint(name string) string {
@@ -286,6 +322,9 @@ func (sys *System) Close() {
 	for _, ei := range sys.EthInstances {
 		ei.Close()
 	}
+	for _, proxy := range sys.L1Proxies {
+		_ = proxy.Close()
+	}
 	sys.Mocknet.Close()
 }
 
@@ -332,6 +371,7 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 		Clients:      make(map[string]*ethclient.Client),
 		RawClients:   make(map[string]*rpc.Client),
 		RollupNodes:  make(map[string]*rollupNode.OpNode),
+		L1Proxies:    make(map[string]*faults.Proxy),
 	}
 	didErrAfterStart := false
 	defer func() {
@@ -344,15 +384,23 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 			for _, ei := range sys.EthInstances {
 				ei.Close()
 			}
+			for _, proxy := range sys.L1Proxies {
+				_ = proxy.Close()
+			}
 		}
 	}()
 
 	c := clock.SystemClock
-	if cfg.SupportL1TimeTravel {
+	if cfg.SupportL1TimeTravel || cfg.SupportTimeTravel {
 		sys.TimeTravelClock = clock.NewAdvancingClock(100 * time.Millisecond)
 		c = sys.TimeTravelClock
 	}
 
+	if cfg.Snapshot != nil {
+		// the genesis of the snapshot is recreated, which is checked when the chains are restored
+		cfg.DeployConfig.L1GenesisBlockTimestamp = hexutil.Uint64(cfg.Snapshot.genesisTime)
+	}
+
 	if err := cfg.DeployConfig.Check(); err != nil {
 		return nil, err
 	}
@@ -436,7 +484,7 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 	sys.RollupConfig = &defaultConfig
 
 	// Initialize nodes
-	l1Node, l1Backend, err := geth.InitL1(cfg.DeployConfig.L1ChainID, cfg.DeployConfig.L1BlockTime, l1Genesis, c, cfg.GethOptions["l1"]...)
+	l1Node, l1Backend, l1FakePoS, err := geth.InitL1(cfg.DeployConfig.L1ChainID, cfg.DeployConfig.L1BlockTime, l1Genesis, c, cfg.GethOptions["l1"]...)
 	if err != nil {
 		return nil, err
 	}
@@ -444,15 +492,29 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 		Backend: l1Backend,
 		Node:    l1Node,
 	}
+	sys.l1FakePoS = l1FakePoS
+	if cfg.Snapshot != nil {
+		if err := cfg.Snapshot.restoreL1(l1Backend.BlockChain()); err != nil {
+			didErrAfterStart = true
+			return nil, err
+		}
+	}
 	err = l1Node.Start()
 	if err != nil {
 		didErrAfterStart = true
 		return nil, err
 	}
+	if cfg.FaultProxies {
+		if err := sys.startL1Proxies(); err != nil {
+			didErrAfterStart = true
+			return nil, err
+		}
+	}
 
 	for name := range cfg.Nodes {
 		var ethClient EthInstance
-		if cfg.ExternalL2Shim == "" {
+		shim := cfg.externalL2Shim(name)
+		if shim == "" {
 			node, backend, err := geth.InitL2(name, big.NewInt(int64(cfg.DeployConfig.L2ChainID)), l2Genesis, cfg.JWTFilePath, cfg.GethOptions[name]...)
 			if err != nil {
 				return nil, err
@@ -461,6 +523,13 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 				Backend: backend,
 				Node:    node,
 			}
+			if cfg.Snapshot != nil {
+				if err := cfg.Snapshot.restoreL2(backend.BlockChain()); err != nil {
+					node.Close()
+					didErrAfterStart = true
+					return nil, fmt.Errorf("failed to restore snapshot of %s: %w", name, err)
+				}
+			}
 			err = gethInst.Node.Start()
 			if err != nil {
 				didErrAfterStart = true
@@ -471,9 +540,12 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 			if len(cfg.GethOptions[name]) > 0 {
 				t.Skip("External L2 nodes do not support configuration through GethOptions")
 			}
+			if cfg.Snapshot != nil {
+				t.Skip("External L2 nodes do not support restoring from a snapshot")
+			}
 			ethClient = (&ExternalRunner{
 				Name:    name,
-				BinPath: cfg.ExternalL2Shim,
+				BinPath: shim,
 				Genesis: l2Genesis,
 				JWTPath: cfg.JWTFilePath,
 			}).Run(t)
@@ -485,7 +557,7 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 	// TODO: refactor testing to allow use of in-process rpc connections instead
 	// of only websockets (which are required for external eth client tests).
 	for name, rollupCfg := range cfg.Nodes {
-		configureL1(rollupCfg, sys.EthInstances["l1"])
+		configureL1(rollupCfg, sys.l1Endpoint(name, selectEndpoint(sys.EthInstances["l1"])))
 		configureL2(rollupCfg, sys.EthInstances[name], cfg.JWTSecret)
 
 		rollupCfg.L2Sync = &rollupNode.PreparedL2SyncEndpoint{
@@ -614,6 +686,9 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 				l.Warn("closed op-node!")
 			}()
 		}
+		if cfg.SupportTimeTravel {
+			c.Clock = sys.TimeTravelClock
+		}
 		node, err := rollupNode.New(context.Background(), &c, l, snapLog, "", metrics.NewMetrics(""))
 		if err != nil {
 			didErrAfterStart = true
@@ -659,18 +734,25 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 	}
 
 	// L2Output Submitter
-	sys.L2OutputSubmitter, err = l2os.NewL2OutputSubmitterFromCLIConfig(l2os.CLIConfig{
-		L1EthRpc:          sys.EthInstances["l1"].WSEndpoint(),
+	proposerCfg, err := l2os.NewL2OutputSubmitterConfigFromCLIConfig(l2os.CLIConfig{
+		L1EthRpc:          sys.l1Endpoint("proposer", sys.EthInstances["l1"].WSEndpoint()),
 		RollupRpc:         sys.RollupNodes["sequencer"].HTTPEndpoint(),
 		L2OOAddress:       config.L1Deployments.L2OutputOracleProxy.Hex(),
 		PollInterval:      50 * time.Millisecond,
-		TxMgrConfig:       newTxMgrConfig(sys.EthInstances["l1"].WSEndpoint(), cfg.Secrets.Proposer),
+		TxMgrConfig:       newTxMgrConfig(sys.l1Endpoint("proposer", sys.EthInstances["l1"].WSEndpoint()), cfg.Secrets.Proposer),
 		AllowNonFinalized: cfg.NonFinalizedProposals,
 		LogConfig: oplog.CLIConfig{
 			Level:  log.LvlInfo,
 			Format: oplog.FormatText,
 		},
 	}, sys.cfg.Loggers["proposer"], proposermetrics.NoopMetrics)
+	if err != nil {
+		return nil, fmt.Errorf("unable to setup l2 output submitter config: %w", err)
+	}
+	if cfg.SupportTimeTravel {
+		proposerCfg.Clock = sys.TimeTravelClock
+	}
+	sys.L2OutputSubmitter, err = l2os.NewL2OutputSubmitter(*proposerCfg, sys.cfg.Loggers["proposer"], proposermetrics.NoopMetrics)
 	if err != nil {
 		return nil, fmt.Errorf("unable to setup l2 output submitter: %w", err)
 	}
@@ -680,7 +762,7 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 	}
 
 	batcherCLIConfig := &bss.CLIConfig{
-		L1EthRpc:               sys.EthInstances["l1"].WSEndpoint(),
+		L1EthRpc:               sys.l1Endpoint("batcher", sys.EthInstances["l1"].WSEndpoint()),
 		L2EthRpc:               sys.EthInstances["sequencer"].WSEndpoint(),
 		RollupRpc:              sys.RollupNodes["sequencer"].HTTPEndpoint(),
 		MaxPendingTransactions: 0,
@@ -693,15 +775,20 @@ func (cfg SystemConfig) Start(t *testing.T, _opts ...SystemConfigOption) (*Syste
 		},
 		SubSafetyMargin: 4,
 		PollInterval:    50 * time.Millisecond,
-		TxMgrConfig:     newTxMgrConfig(sys.EthInstances["l1"].WSEndpoint(), cfg.Secrets.Batcher),
+		TxMgrConfig:     newTxMgrConfig(sys.l1Endpoint("batcher", sys.EthInstances["l1"].WSEndpoint()), cfg.Secrets.Batcher),
 		LogConfig: oplog.CLIConfig{
 			Level:  log.LvlInfo,
 			Format: oplog.FormatText,
 		},
-		Stopped: sys.cfg.DisableBatcher, // Batch submitter may be enabled later
+		Stopped:              sys.cfg.DisableBatcher, // Batch submitter may be enabled later
+		DataAvailabilityType: batcherFlags.CalldataType,
 	}
 	// Batch Submitter
-	batcher, err := bss.BatcherServiceFromCLIConfig(context.Background(), "0.0.1", batcherCLIConfig, sys.cfg.Loggers["batcher"])
+	var batcherOpts []bss.BatcherServiceOption
+	if cfg.SupportTimeTravel {
+		batcherOpts = append(batcherOpts, bss.WithClock(sys.TimeTravelClock))
+	}
+	batcher, err := bss.BatcherServiceFromCLIConfig(context.Background(), "0.0.1", batcherCLIConfig, sys.cfg.Loggers["batcher"], batcherOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup batch submitter: %w", err)
 	}
@@ -767,8 +854,7 @@ func selectEndpoint(node EthInstance) string {
 	return node.WSEndpoint()
 }
 
-func configureL1(rollupNodeCfg *rollupNode.Config, l1Node EthInstance) {
-	l1EndpointConfig := selectEndpoint(l1Node)
+func configureL1(rollupNodeCfg *rollupNode.Config, l1EndpointConfig string) {
 	rollupNodeCfg.L1 = &rollupNode.L1EndpointConfig{
 		L1NodeAddr:       l1EndpointConfig,
 		L1TrustRPC:       false,
@@ -0,0 +1,124 @@
+package op_e2e
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// SystemSnapshot is the state of the L1 and L2 chains of a System, including all contracts deployed after genesis.
+// A system can be started from a snapshot with SystemConfig.Snapshot, to skip an expensive setup that tests share.
+// The system has to be started with the same configuration as the system that the snapshot was taken of,
+// except for the L1 genesis time, which is taken from the snapshot.
+type SystemSnapshot struct {
+	genesisTime uint64
+	l1Genesis   common.Hash
+	l2Genesis   common.Hash
+
+	l1Blocks []*types.Block
+	l2Blocks []*types.Block
+
+	l2Safe      common.Hash
+	l2Finalized common.Hash
+}
+
+// L1Head returns the head of the L1 chain in the snapshot.
+func (s *SystemSnapshot) L1Head() *types.Header {
+	return headOf(s.l1Blocks)
+}
+
+// L2Head returns the unsafe head of the L2 chain in the snapshot.
+func (s *SystemSnapshot) L2Head() *types.Header {
+	return headOf(s.l2Blocks)
+}
+
+func headOf(blocks []*types.Block) *types.Header {
+	if len(blocks) == 0 {
+		return nil
+	}
+	return blocks[len(blocks)-1].Header()
+}
+
+// Snapshot takes a snapshot of the L1 chain, and of the L2 chain of the sequencer.
+// The snapshot can be taken while the system is running, but to not miss recent L2 blocks
+// it is best taken when the verifiers are in sync with the sequencer.
+func (sys *System) Snapshot() (*SystemSnapshot, error) {
+	seq, ok := sys.EthInstances["sequencer"].(*GethInstance)
+	if !ok {
+		return nil, errors.New("snapshots require an in-process L2 geth for the sequencer")
+	}
+	l2Chain := seq.Backend.BlockChain()
+	snap := &SystemSnapshot{
+		genesisTime: uint64(sys.cfg.DeployConfig.L1GenesisBlockTimestamp),
+		l1Genesis:   sys.RollupConfig.Genesis.L1.Hash,
+		l2Genesis:   sys.RollupConfig.Genesis.L2.Hash,
+	}
+	// The L2 chain is read before the L1 chain, so that the L1 chain contains all the origins and batches of the L2 chain.
+	if safe := l2Chain.CurrentSafeBlock(); safe != nil {
+		snap.l2Safe = safe.Hash()
+	}
+	if finalized := l2Chain.CurrentFinalBlock(); finalized != nil {
+		snap.l2Finalized = finalized.Hash()
+	}
+	l2Blocks, err := readChain(l2Chain, snap.l2Genesis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read L2 chain: %w", err)
+	}
+	snap.l2Blocks = l2Blocks
+	l1Blocks, err := readChain(sys.EthInstances["l1"].(*GethInstance).Backend.BlockChain(), snap.l1Genesis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read L1 chain: %w", err)
+	}
+	snap.l1Blocks = l1Blocks
+	return snap, nil
+}
+
+// readChain reads all blocks after genesis, up to the current head of the chain.
+func readChain(chain *core.BlockChain, genesis common.Hash) ([]*types.Block, error) {
+	head := chain.CurrentBlock()
+	blocks := make([]*types.Block, 0, head.Number.Uint64())
+	parent := genesis
+	for num := uint64(1); num <= head.Number.Uint64(); num++ {
+		block := chain.GetBlockByNumber(num)
+		if block == nil {
+			return nil, fmt.Errorf("missing block %d", num)
+		}
+		if block.ParentHash() != parent {
+			return nil, fmt.Errorf("chain reorged while reading block %d", num)
+		}
+		blocks = append(blocks, block)
+		parent = block.Hash()
+	}
+	return blocks, nil
+}
+
+// restoreL1 imports the L1 chain of the snapshot, before the L1 node is started.
+func (s *SystemSnapshot) restoreL1(chain *core.BlockChain) error {
+	if chain.Genesis().Hash() != s.l1Genesis {
+		return fmt.Errorf("L1 genesis %s does not match snapshot L1 genesis %s, the system configuration differs", chain.Genesis().Hash(), s.l1Genesis)
+	}
+	if _, err := chain.InsertChain(s.l1Blocks); err != nil {
+		return fmt.Errorf("failed to import L1 chain: %w", err)
+	}
+	return nil
+}
+
+// restoreL2 imports the L2 chain of the snapshot, and its safe and finalized blocks, before the L2 node is started.
+func (s *SystemSnapshot) restoreL2(chain *core.BlockChain) error {
+	if chain.Genesis().Hash() != s.l2Genesis {
+		return fmt.Errorf("L2 genesis %s does not match snapshot L2 genesis %s, the system configuration differs", chain.Genesis().Hash(), s.l2Genesis)
+	}
+	if _, err := chain.InsertChain(s.l2Blocks); err != nil {
+		return fmt.Errorf("failed to import L2 chain: %w", err)
+	}
+	if safe := chain.GetHeaderByHash(s.l2Safe); safe != nil {
+		chain.SetSafe(safe)
+	}
+	if finalized := chain.GetHeaderByHash(s.l2Finalized); finalized != nil {
+		chain.SetFinalized(finalized)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package op_e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/faults"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/wait"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
+)
+
+func rollupClientFor(t *testing.T, sys *System, name string) *sources.RollupClient {
+	rpcClient, err := rpc.DialContext(context.Background(), sys.RollupNodes[name].HTTPEndpoint())
+	require.NoError(t, err)
+	t.Cleanup(rpcClient.Close)
+	return sources.NewRollupClient(client.NewBaseRPCClient(rpcClient))
+}
+
+func waitForSyncStatus(t *testing.T, ctx context.Context, cl *sources.RollupClient, predicate func(status *eth.SyncStatus) bool) *eth.SyncStatus {
+	status, err := wait.AndGet(ctx, 500*time.Millisecond, func() (*eth.SyncStatus, error) {
+		return cl.SyncStatus(ctx)
+	}, predicate)
+	require.NoError(t, err)
+	return status
+}
+
+// TestL1RPCFaults checks that the verifier recovers from an L1 RPC that fails, and catches up again.
+func TestL1RPCFaults(t *testing.T) {
+	InitParallel(t)
+
+	cfg := DefaultSystemConfig(t)
+	cfg.FaultProxies = true
+	sys, err := cfg.Start(t)
+	require.NoError(t, err, "Error starting up system")
+	defer sys.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	seqRollup := rollupClientFor(t, sys, "sequencer")
+	verifRollup := rollupClientFor(t, sys, "verifier")
+	l1BlockTime := time.Duration(cfg.DeployConfig.L1BlockTime) * time.Second
+
+	// the L1 RPC of the verifier answers every call with an error
+	proxy := sys.L1Proxies["verifier"]
+	proxy.Inject(faults.Fault{Err: &faults.RPCError{Code: -32000, Message: "injected L1 outage"}})
+	stalled, err := verifRollup.SyncStatus(ctx)
+	require.NoError(t, err)
+	time.Sleep(3 * l1BlockTime)
+	status, err := verifRollup.SyncStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, stalled.HeadL1, status.HeadL1, "verifier must not see new L1 blocks during the outage")
+
+	// the verifier catches up with the safe chain of the sequencer once the L1 RPC is back, even if it is slow
+	proxy.Clear()
+	proxy.Inject(faults.Fault{Delay: 200 * time.Millisecond})
+	seqStatus := waitForSyncStatus(t, ctx, seqRollup, func(s *eth.SyncStatus) bool {
+		return s.SafeL2.Number > stalled.SafeL2.Number
+	})
+	waitForSyncStatus(t, ctx, verifRollup, func(s *eth.SyncStatus) bool {
+		return s.SafeL2.Number >= seqStatus.SafeL2.Number
+	})
+}
+
+// TestL1Reorg checks that the rollup nodes follow a reorg of the L1 chain, and keep deriving the safe chain.
+func TestL1Reorg(t *testing.T) {
+	InitParallel(t)
+
+	cfg := DefaultSystemConfig(t)
+	sys, err := cfg.Start(t)
+	require.NoError(t, err, "Error starting up system")
+	defer sys.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	l1Client := sys.Clients["l1"]
+	verifRollup := rollupClientFor(t, sys, "verifier")
+
+	require.NoError(t, wait.ForBlock(ctx, l1Client, 6))
+	before, err := l1Client.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	// the safe L1 blocks are 4 blocks behind the head, so they are not affected by the reorg
+	require.NoError(t, sys.ReorgL1(ctx, 3))
+	reorged, err := l1Client.HeaderByNumber(ctx, before.Number)
+	require.NoError(t, err)
+	require.NotEqual(t, before.Hash(), reorged.Hash(), "L1 block must be replaced by the reorg")
+
+	// the verifier moves to the new L1 chain, and its safe chain keeps on advancing
+	prev, err := verifRollup.SyncStatus(ctx)
+	require.NoError(t, err)
+	status := waitForSyncStatus(t, ctx, verifRollup, func(s *eth.SyncStatus) bool {
+		return s.HeadL1.Number > before.Number.Uint64() && s.SafeL2.Number > prev.SafeL2.Number
+	})
+	canonical, err := l1Client.HeaderByNumber(ctx, reorged.Number)
+	require.NoError(t, err)
+	require.Equal(t, reorged.Hash(), canonical.Hash())
+	head, err := l1Client.HeaderByHash(ctx, status.HeadL1.Hash)
+	require.NoError(t, err, "verifier L1 head must be canonical")
+	require.Equal(t, status.HeadL1.Number, head.Number.Uint64())
+}
+
+// TestPausedBatcher checks that the safe chain stalls while the batcher is paused, and catches up after.
+func TestPausedBatcher(t *testing.T) {
+	InitParallel(t)
+
+	cfg := DefaultSystemConfig(t)
+	sys, err := cfg.Start(t)
+	require.NoError(t, err, "Error starting up system")
+	defer sys.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	verifRollup := rollupClientFor(t, sys, "verifier")
+	l1BlockTime := time.Duration(cfg.DeployConfig.L1BlockTime) * time.Second
+
+	require.NoError(t, sys.PauseBatcher(ctx))
+	// wait for the batches that were in flight to be derived
+	time.Sleep(6 * l1BlockTime)
+	paused, err := verifRollup.SyncStatus(ctx)
+	require.NoError(t, err)
+	time.Sleep(3 * l1BlockTime)
+	status, err := verifRollup.SyncStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, paused.SafeL2, status.SafeL2, "safe chain must not advance while the batcher is paused")
+
+	require.NoError(t, sys.ResumeBatcher())
+	waitForSyncStatus(t, ctx, verifRollup, func(s *eth.SyncStatus) bool {
+		return s.SafeL2.Number > paused.SafeL2.Number
+	})
+}
+
+// TestP2PPartition checks that the verifier stops receiving unsafe blocks while it is partitioned from the sequencer,
+// and catches up with the unsafe chain once the partition heals.
+func TestP2PPartition(t *testing.T) {
+	InitParallel(t)
+
+	cfg := DefaultSystemConfig(t)
+	// only sync the unsafe chain over P2P
+	cfg.DisableBatcher = true
+	cfg.DeployConfig.SequencerWindowSize = 100_000
+	cfg.DeployConfig.MaxSequencerDrift = 100_000
+	cfg.P2PTopology = map[string][]string{
+		"verifier": {"sequencer"},
+	}
+	cfg.P2PReqRespSync = true
+	sys, err := cfg.Start(t)
+	require.NoError(t, err, "Error starting up system")
+	defer sys.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	seqRollup := rollupClientFor(t, sys, "sequencer")
+	verifRollup := rollupClientFor(t, sys, "verifier")
+	l2BlockTime := time.Duration(cfg.DeployConfig.L2BlockTime) * time.Second
+
+	waitForSyncStatus(t, ctx, verifRollup, func(s *eth.SyncStatus) bool {
+		return s.UnsafeL2.Number > 2
+	})
+
+	require.NoError(t, sys.PartitionP2P([]string{"verifier"}, []string{"sequencer"}))
+	// wait for the blocks that were in flight to arrive
+	time.Sleep(2 * l2BlockTime)
+	partitioned, err := verifRollup.SyncStatus(ctx)
+	require.NoError(t, err)
+	time.Sleep(5 * l2BlockTime)
+	status, err := verifRollup.SyncStatus(ctx)
+	require.NoError(t, err)
+	require.Equal(t, partitioned.UnsafeL2, status.UnsafeL2, "verifier must not receive unsafe blocks while partitioned")
+
+	require.NoError(t, sys.HealP2P())
+	seqStatus, err := seqRollup.SyncStatus(ctx)
+	require.NoError(t, err)
+	require.Greater(t, seqStatus.UnsafeL2.Number, partitioned.UnsafeL2.Number)
+	waitForSyncStatus(t, ctx, verifRollup, func(s *eth.SyncStatus) bool {
+		return s.UnsafeL2.Number >= seqStatus.UnsafeL2.Number
+	})
+}
@@ -0,0 +1,52 @@
+package op_e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// TestSystemSnapshot checks that a system that is restored from a snapshot continues the chains of the snapshot.
+func TestSystemSnapshot(t *testing.T) {
+	InitParallel(t)
+
+	cfg := DefaultSystemConfig(t)
+	sys, err := cfg.Start(t)
+	require.NoError(t, err, "Error starting up system")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	waitForSyncStatus(t, ctx, rollupClientFor(t, sys, "verifier"), func(status *eth.SyncStatus) bool {
+		return status.SafeL2.Number > 5
+	})
+	snap, err := sys.Snapshot()
+	require.NoError(t, err)
+	sys.Close()
+	require.NotNil(t, snap.L2Head())
+
+	cfg = DefaultSystemConfig(t)
+	cfg.Snapshot = snap
+	restored, err := cfg.Start(t)
+	require.NoError(t, err, "Error restoring system")
+	defer restored.Close()
+
+	for _, name := range []string{"l1", "sequencer", "verifier"} {
+		head := snap.L2Head()
+		if name == "l1" {
+			head = snap.L1Head()
+		}
+		header, err := restored.Clients[name].HeaderByNumber(ctx, head.Number)
+		require.NoError(t, err)
+		require.Equal(t, head.Hash(), header.Hash(), "%s did not restore the snapshot", name)
+	}
+
+	// the verifier derives the chain past the snapshot
+	waitForSyncStatus(t, ctx, rollupClientFor(t, restored, "verifier"), func(status *eth.SyncStatus) bool {
+		return status.SafeL2.Number > snap.L2Head().Number.Uint64()
+	})
+	restored.RequireConsistentUnsafeChain(t, ctx, "sequencer", "verifier")
+}
@@ -46,8 +46,13 @@ import (
 )
 
 func TestMain(m *testing.M) {
-	if config.ExternalL2Shim != "" {
-		fmt.Println("Running tests with external L2 process adapter at ", config.ExternalL2Shim)
+	if config.ExternalL2Shim != "" || len(config.ExternalL2Shims) > 0 {
+		if config.ExternalL2Shim != "" {
+			fmt.Println("Running tests with external L2 process adapter at ", config.ExternalL2Shim)
+		}
+		for name, shim := range config.ExternalL2Shims {
+			fmt.Println("Running", name, "with external L2 process adapter at ", shim)
+		}
 		// As these are integration tests which launch many other processes, the
 		// default parallelism makes the tests flaky.  This change aims to
 		// reduce the flakiness of these tests.
@@ -706,7 +711,7 @@ func TestSystemP2PAltSync(t *testing.T) {
 			},
 		},
 	}
-	configureL1(syncNodeCfg, sys.EthInstances["l1"])
+	configureL1(syncNodeCfg, selectEndpoint(sys.EthInstances["l1"]))
 	syncerL2Engine, _, err := geth.InitL2("syncer", big.NewInt(int64(cfg.DeployConfig.L2ChainID)), sys.L2GenesisCfg, cfg.JWTFilePath)
 	require.NoError(t, err)
 	require.NoError(t, syncerL2Engine.Start())
@@ -0,0 +1,47 @@
+package op_e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// TestSystemTimeTravel checks that the sequencer, the batcher and the verifier follow the shared clock
+// of the system when it skips forward in time, instead of waiting for the time to pass.
+func TestSystemTimeTravel(t *testing.T) {
+	InitParallel(t)
+
+	cfg := DefaultSystemConfig(t)
+	cfg.SupportTimeTravel = true
+	sys, err := cfg.Start(t)
+	require.NoError(t, err, "Error starting up system")
+	defer sys.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	seqClient := rollupClientFor(t, sys, "sequencer")
+	verifClient := rollupClientFor(t, sys, "verifier")
+
+	start := waitForSyncStatus(t, ctx, seqClient, func(status *eth.SyncStatus) bool {
+		return status.UnsafeL2.Number > 0
+	})
+
+	// skip forward by more than a sequencer window, which would take far longer than the test timeout in real time
+	skip := time.Duration(cfg.DeployConfig.SequencerWindowSize*cfg.DeployConfig.L1BlockTime)*time.Second + time.Minute
+	sys.TimeTravelClock.AdvanceTime(skip)
+	target := start.UnsafeL2.Time + uint64(skip/time.Second)
+
+	// the sequencer catches up with the clock: it builds the skipped L2 blocks right away
+	waitForSyncStatus(t, ctx, seqClient, func(status *eth.SyncStatus) bool {
+		return status.UnsafeL2.Time >= target && status.HeadL1.Time >= target
+	})
+	// and the batcher submits them, so the verifier derives them as safe blocks
+	waitForSyncStatus(t, ctx, verifClient, func(status *eth.SyncStatus) bool {
+		return status.SafeL2.Time >= target
+	})
+	sys.RequireConsistentUnsafeChain(t, ctx, "sequencer", "verifier")
+}
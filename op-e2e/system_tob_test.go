@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/geth"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/wait"
 	"github.com/ethereum-optimism/optimism/op-node/withdrawals"
+	"github.com/ethereum-optimism/optimism/op-service/secrets"
 	"github.com/ethereum-optimism/optimism/op-service/testutils/fuzzerutils"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -177,7 +178,7 @@ func startConfigWithTestAccounts(t *testing.T, cfg *SystemConfig, accountsToGene
 	for i := 0; i < accountsToGenerate; i++ {
 		// Create our test account and add it to our list
 		testAccount := &TestAccount{
-			HDPath: fmt.Sprintf("m/44'/60'/0'/0/%d", 1000+i), // offset by 1000 to avoid collisions.
+			HDPath: secrets.TestAccountPath(uint64(i)),
 			Key:    nil,
 			L1Opts: nil,
 			L2Opts: nil,
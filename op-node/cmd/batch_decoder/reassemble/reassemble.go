@@ -113,8 +113,10 @@ func processFrames(cfg *rollup.Config, id derive.ChannelID, frames []FrameWithMe
 				if err != nil {
 					fmt.Printf("Error reading batch for channel %v. Err: %v\n", id.String(), err)
 					invalidBatches = true
+				} else if batch.BatchType != derive.SingularBatchType {
+					fmt.Printf("Skipping batch of type %d in channel %v, only singular batches are decoded\n", batch.BatchType, id.String())
 				} else {
-					batches = append(batches, batch.Batch.SingularBatch)
+					batches = append(batches, batch.SingularBatch)
 				}
 			}
 		} else {
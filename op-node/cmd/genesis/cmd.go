@@ -11,7 +11,7 @@ import (
 
 	"github.com/urfave/cli/v2"
 
-	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
@@ -39,6 +39,10 @@ var Subcommands = cli.Commands{
 				Name:  "l1-deployments",
 				Usage: "Path to L1 deployments file",
 			},
+			&cli.StringSliceFlag{
+				Name:  "allocs-overlay",
+				Usage: "Path to a JSON file of accounts to add or override in the L1 genesis allocs, applied on top of the standard allocs. Can be repeated, later overlays take precedence",
+			},
 			&cli.StringFlag{
 				Name:  "outfile.l1",
 				Usage: "Path to L1 genesis output file",
@@ -72,18 +76,18 @@ var Subcommands = cli.Commands{
 				return fmt.Errorf("deploy config at %s invalid: %w", deployConfig, err)
 			}
 
-			var dump *state.Dump
+			var l1Genesis *core.Genesis
 			if l1Allocs := ctx.String("l1-allocs"); l1Allocs != "" {
-				dump, err = genesis.NewStateDump(l1Allocs)
-				if err != nil {
-					return err
-				}
+				l1Genesis, err = genesis.BuildL1DeveloperGenesisFromDump(config, l1Allocs, deployments, true)
+			} else {
+				l1Genesis, err = genesis.BuildL1DeveloperGenesis(config, nil, deployments, true)
 			}
-
-			l1Genesis, err := genesis.BuildL1DeveloperGenesis(config, dump, deployments, true)
 			if err != nil {
 				return err
 			}
+			if err := genesis.ApplyAllocsOverlays(l1Genesis, ctx.StringSlice("allocs-overlay")...); err != nil {
+				return fmt.Errorf("error applying allocs overlay: %w", err)
+			}
 
 			return writeGenesisFile(ctx.String("outfile.l1"), l1Genesis)
 		},
@@ -104,6 +108,10 @@ var Subcommands = cli.Commands{
 				Name:  "deployment-dir",
 				Usage: "Path to network deployment directory",
 			},
+			&cli.StringSliceFlag{
+				Name:  "allocs-overlay",
+				Usage: "Path to a JSON file of accounts to add or override in the L2 genesis allocs, applied on top of the standard allocs. Can be repeated, later overlays take precedence",
+			},
 			&cli.StringFlag{
 				Name:  "outfile.l2",
 				Usage: "Path to L2 genesis output file",
@@ -170,6 +178,9 @@ var Subcommands = cli.Commands{
 			if err != nil {
 				return fmt.Errorf("error creating l2 genesis: %w", err)
 			}
+			if err := genesis.ApplyAllocsOverlays(l2Genesis, ctx.StringSlice("allocs-overlay")...); err != nil {
+				return fmt.Errorf("error applying allocs overlay: %w", err)
+			}
 
 			l2GenesisBlock := l2Genesis.ToBlock()
 			rollupConfig, err := config.RollupConfig(l1StartBlock, l2GenesisBlock.Hash(), l2GenesisBlock.Number().Uint64())
@@ -6,9 +6,12 @@ import (
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 	openum "github.com/ethereum-optimism/optimism/op-service/enum"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+	optracing "github.com/ethereum-optimism/optimism/op-service/tracing"
 
 	"github.com/urfave/cli/v2"
 )
@@ -17,6 +20,9 @@ import (
 
 const EnvVarPrefix = "OP_NODE"
 
+// RPCTLSFlagPrefix prefixes the TLS flags of the RPC server, e.g. rpc.tls.cert
+const RPCTLSFlagPrefix = "rpc"
+
 func prefixEnvVars(name string) []string {
 	return []string{EnvVarPrefix + "_" + name}
 }
@@ -25,10 +31,20 @@ var (
 	/* Required Flags */
 	L1NodeAddr = &cli.StringFlag{
 		Name:    "l1",
-		Usage:   "Address of L1 User JSON-RPC endpoint to use (eth namespace required)",
+		Usage:   "Address of L1 User JSON-RPC endpoint to use (eth namespace required). Multiple comma-separated addresses are failed over between.",
 		Value:   "http://127.0.0.1:8545",
 		EnvVars: prefixEnvVars("L1_ETH_RPC"),
 	}
+	BeaconAddr = &cli.StringFlag{
+		Name:    "l1.beacon",
+		Usage:   "Address of L1 Beacon-node HTTP endpoint to fetch blobs from, required once blobs are enabled in the rollup config",
+		EnvVars: prefixEnvVars("L1_BEACON"),
+	}
+	BeaconArchiverAddrs = &cli.StringSliceFlag{
+		Name:    "l1.beacon-archiver",
+		Usage:   "Addresses of beacon API endpoints, e.g. blob archivers, to fetch blobs from when the L1 Beacon-node does not have them anymore",
+		EnvVars: prefixEnvVars("L1_BEACON_ARCHIVERS"),
+	}
 	L2EngineAddr = &cli.StringFlag{
 		Name:    "l2",
 		Usage:   "Address of L2 Engine JSON-RPC endpoints to use (engine and eth namespace required)",
@@ -94,6 +110,13 @@ var (
 		EnvVars: prefixEnvVars("L1_RPC_MAX_BATCH_SIZE"),
 		Value:   20,
 	}
+	L1CacheSize = &cli.UintFlag{
+		Name: "l1.cache-size",
+		Usage: "Number of L1 blocks to cache headers, receipts and transactions of, to not re-fetch them after derivation pipeline resets. " +
+			"Defaults to 3/2 of the sequencing window (capped to 1000 blocks for receipts and transactions) if set to 0.",
+		EnvVars: prefixEnvVars("L1_CACHE_SIZE"),
+		Value:   0,
+	}
 	L1HTTPPollInterval = &cli.DurationFlag{
 		Name:    "l1.http-poll-interval",
 		Usage:   "Polling interval for latest-block subscription when using an HTTP RPC provider. Ignored for other types of RPC endpoints.",
@@ -132,6 +155,26 @@ var (
 		Required: false,
 		Value:    0,
 	}
+	SequencerMaxBlockGasFlag = &cli.Uint64Flag{
+		Name:     "sequencer.max-block-gas",
+		Usage:    "Maximum total gas of the transactions in the blocks that the sequencer builds, below the L2 gas limit. Disabled if 0.",
+		EnvVars:  prefixEnvVars("SEQUENCER_MAX_BLOCK_GAS"),
+		Required: false,
+		Value:    0,
+	}
+	SequencerMaxBlockDAFlag = &cli.Uint64Flag{
+		Name:     "sequencer.max-block-da",
+		Usage:    "Maximum total size in bytes of the transactions in the blocks that the sequencer builds, to throttle data-availability usage. Disabled if 0.",
+		EnvVars:  prefixEnvVars("SEQUENCER_MAX_BLOCK_DA"),
+		Required: false,
+		Value:    0,
+	}
+	SequencerDenylistFlag = &cli.StringSliceFlag{
+		Name:     "sequencer.denylist",
+		Usage:    "Addresses that the sequencer does not include transactions from or to in the blocks that it builds.",
+		EnvVars:  prefixEnvVars("SEQUENCER_DENYLIST"),
+		Required: false,
+	}
 	SequencerL1Confs = &cli.Uint64Flag{
 		Name:     "sequencer.l1-confs",
 		Usage:    "Number of L1 blocks to keep distance from the L1 head as a sequencer for picking an L1 origin.",
@@ -187,6 +230,17 @@ var (
 		Value:   6060,
 		EnvVars: prefixEnvVars("PPROF_PORT"),
 	}
+	PprofPushEndpointFlag = &cli.StringFlag{
+		Name:    "pprof.push.endpoint",
+		Usage:   "Pyroscope compatible endpoint to periodically push CPU, heap and goroutine profiles to. Disabled if empty.",
+		EnvVars: prefixEnvVars("PPROF_PUSH_ENDPOINT"),
+	}
+	PprofPushIntervalFlag = &cli.DurationFlag{
+		Name:    "pprof.push.interval",
+		Usage:   "Interval of the profiles pushed to the pprof push endpoint",
+		Value:   time.Minute,
+		EnvVars: prefixEnvVars("PPROF_PUSH_INTERVAL"),
+	}
 	SnapshotLog = &cli.StringFlag{
 		Name:    "snapshotlog.file",
 		Usage:   "Path to the snapshot log file",
@@ -221,17 +275,27 @@ var (
 		EnvVars:  prefixEnvVars("L2_BACKUP_UNSAFE_SYNC_RPC_TRUST_RPC"),
 		Required: false,
 	}
+	SyncModeFlag = &cli.GenericFlag{
+		Name:    "syncmode",
+		Usage:   fmt.Sprintf("Blockchain sync mode. Options are: %s", strings.Join(sync.ModeStrings, ", ")),
+		EnvVars: prefixEnvVars("SYNCMODE"),
+		Value: func() *sync.Mode {
+			out := sync.CLSync
+			return &out
+		}(),
+	}
 	L2EngineSyncEnabled = &cli.BoolFlag{
 		Name:     "l2.engine-sync",
-		Usage:    "Enables or disables execution engine P2P sync",
+		Usage:    "Deprecated: use --syncmode=execution-layer instead. Enables execution engine P2P sync",
 		EnvVars:  prefixEnvVars("L2_ENGINE_SYNC_ENABLED"),
 		Required: false,
 		Value:    false,
+		Hidden:   true,
 	}
 	SkipSyncStartCheck = &cli.BoolFlag{
 		Name: "l2.skip-sync-start-check",
 		Usage: "Skip sanity check of consistency of L1 origins of the unsafe L2 blocks when determining the sync-starting point. " +
-			"This defers the L1-origin verification, and is recommended to use in when utilizing l2.engine-sync",
+			"This defers the L1-origin verification, and is recommended to use in when utilizing --syncmode=execution-layer",
 		EnvVars:  prefixEnvVars("L2_SKIP_SYNC_START_CHECK"),
 		Required: false,
 		Value:    false,
@@ -258,6 +322,22 @@ var (
 		Usage:  "Manually specify the Canyon fork timestamp, overriding the bundled setting",
 		Hidden: true,
 	}
+	AltDAServerFlag = &cli.StringFlag{
+		Name:    "altda.da-server",
+		Usage:   "HTTP address of the DA server that the batch data of chains with an alt-DA config is fetched from, e.g. a Celestia or EigenDA DA server",
+		EnvVars: prefixEnvVars("ALTDA_DA_SERVER"),
+	}
+	AltDAServerTimeoutFlag = &cli.DurationFlag{
+		Name:    "altda.da-server-timeout",
+		Usage:   "Timeout of requests to the DA server",
+		EnvVars: prefixEnvVars("ALTDA_DA_SERVER_TIMEOUT"),
+		Value:   30 * time.Second,
+	}
+	InteropSupervisorFlag = &cli.StringFlag{
+		Name:    "interop.supervisor",
+		Usage:   "RPC address of the interop supervisor, that verifies the executing messages of L2 blocks once interop is active",
+		EnvVars: prefixEnvVars("INTEROP_SUPERVISOR"),
+	}
 )
 
 var requiredFlags = []cli.Flag{
@@ -270,16 +350,22 @@ var optionalFlags = []cli.Flag{
 	RPCListenPort,
 	RollupConfig,
 	Network,
+	BeaconAddr,
+	BeaconArchiverAddrs,
 	L1TrustRPC,
 	L1RPCProviderKind,
 	L1RPCRateLimit,
 	L1RPCMaxBatchSize,
+	L1CacheSize,
 	L1HTTPPollInterval,
 	L2EngineJWTSecret,
 	VerifierL1Confs,
 	SequencerEnabledFlag,
 	SequencerStoppedFlag,
 	SequencerMaxSafeLagFlag,
+	SequencerMaxBlockGasFlag,
+	SequencerMaxBlockDAFlag,
+	SequencerDenylistFlag,
 	SequencerL1Confs,
 	L1EpochPollIntervalFlag,
 	RuntimeConfigReloadIntervalFlag,
@@ -291,18 +377,24 @@ var optionalFlags = []cli.Flag{
 	PprofEnabledFlag,
 	PprofAddrFlag,
 	PprofPortFlag,
+	PprofPushEndpointFlag,
+	PprofPushIntervalFlag,
 	SnapshotLog,
 	HeartbeatEnabledFlag,
 	HeartbeatMonikerFlag,
 	HeartbeatURLFlag,
 	BackupL2UnsafeSyncRPC,
 	BackupL2UnsafeSyncRPCTrustRPC,
+	SyncModeFlag,
 	L2EngineSyncEnabled,
 	SkipSyncStartCheck,
 	BetaExtraNetworks,
 	RollupHalt,
 	RollupLoadProtocolVersions,
 	CanyonOverrideFlag,
+	AltDAServerFlag,
+	AltDAServerTimeoutFlag,
+	InteropSupervisorFlag,
 }
 
 // Flags contains the list of configuration options available to the binary.
@@ -311,6 +403,8 @@ var Flags []cli.Flag
 func init() {
 	optionalFlags = append(optionalFlags, P2PFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, oplog.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, optracing.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, optls.OptionalCLIFlags(EnvVarPrefix, RPCTLSFlagPrefix)...)
 	Flags = append(requiredFlags, optionalFlags...)
 }
 
@@ -18,6 +18,7 @@ var (
 	NoDiscoveryName        = "p2p.no-discovery"
 	ScoringName            = "p2p.scoring"
 	PeerScoringName        = "p2p.scoring.peers"
+	BlocksTopicWeightName  = "p2p.scoring.blocks-topic-weight"
 	PeerScoreBandsName     = "p2p.score.bands"
 	BanningName            = "p2p.ban.peers"
 	BanningThresholdName   = "p2p.ban.threshold"
@@ -84,6 +85,13 @@ func P2PFlags(envPrefix string) []cli.Flag {
 			Required: false,
 			Hidden:   true,
 		},
+		&cli.Float64Flag{
+			Name: BlocksTopicWeightName,
+			Usage: "The weight of the blocks topics in the gossip peer score. The topic score rewards timely block deliveries, " +
+				"and penalizes invalid payloads and too few deliveries in the mesh. Defaults to the weight of the scoring strategy.",
+			Required: false,
+			EnvVars:  p2pEnv(envPrefix, "PEER_SCORING_BLOCKS_TOPIC_WEIGHT"),
+		},
 		&cli.StringFlag{
 			Name:     PeerScoreBandsName,
 			Usage:    "Deprecated. This option is ignored and is only present for backwards compatibility.",
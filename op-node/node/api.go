@@ -2,17 +2,22 @@ package node
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
 	"github.com/ethereum-optimism/optimism/op-node/version"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/metrics"
 	"github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/rpcerrors"
 )
 
 type l2EthClient interface {
@@ -30,6 +35,9 @@ type driverClient interface {
 	StartSequencer(ctx context.Context, blockHash common.Hash) error
 	StopSequencer(context.Context) (common.Hash, error)
 	SequencerActive(context.Context) (bool, error)
+	SetUnsafeHead(ctx context.Context, num uint64) error
+	SequencerPolicy(context.Context) (driver.SequencerPolicy, error)
+	SetSequencerPolicy(ctx context.Context, policy driver.SequencerPolicy) error
 }
 
 type adminAPI struct {
@@ -50,6 +58,14 @@ func (n *adminAPI) ResetDerivationPipeline(ctx context.Context) error {
 	return n.dr.ResetDerivationPipeline(ctx)
 }
 
+// SetUnsafeHead rewinds the unsafe head to the given block, to recover from a bad unsafe chain.
+// The sequencer must be stopped, and the block must not be older than the finalized head.
+func (n *adminAPI) SetUnsafeHead(ctx context.Context, number hexutil.Uint64) error {
+	recordDur := n.M.RecordRPCServerRequest("admin_setUnsafeHead")
+	defer recordDur()
+	return n.dr.SetUnsafeHead(ctx, uint64(number))
+}
+
 func (n *adminAPI) StartSequencer(ctx context.Context, blockHash common.Hash) error {
 	recordDur := n.M.RecordRPCServerRequest("admin_startSequencer")
 	defer recordDur()
@@ -68,6 +84,20 @@ func (n *adminAPI) SequencerActive(ctx context.Context) (bool, error) {
 	return n.dr.SequencerActive(ctx)
 }
 
+func (n *adminAPI) SequencerPolicy(ctx context.Context) (driver.SequencerPolicy, error) {
+	recordDur := n.M.RecordRPCServerRequest("admin_sequencerPolicy")
+	defer recordDur()
+	return n.dr.SequencerPolicy(ctx)
+}
+
+// SetSequencerPolicy changes the gas, data-availability and address restrictions
+// of the blocks that the sequencer builds, from the next block onwards.
+func (n *adminAPI) SetSequencerPolicy(ctx context.Context, policy driver.SequencerPolicy) error {
+	recordDur := n.M.RecordRPCServerRequest("admin_setSequencerPolicy")
+	defer recordDur()
+	return n.dr.SetSequencerPolicy(ctx, policy)
+}
+
 type nodeAPI struct {
 	config *rollup.Config
 	client l2EthClient
@@ -92,12 +122,15 @@ func (n *nodeAPI) OutputAtBlock(ctx context.Context, number hexutil.Uint64) (*et
 
 	ref, status, err := n.dr.BlockRefWithStatus(ctx, uint64(number))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get L2 block ref with sync status: %w", err)
+		return nil, rpcerrors.Classify(fmt.Errorf("failed to get L2 block ref with sync status: %w", err))
 	}
 
 	output, err := n.client.OutputV0AtBlock(ctx, ref.Hash)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get L2 output at block %s: %w", ref, err)
+	if errors.Is(err, ethereum.NotFound) {
+		// The block was found by number, but is gone by hash: it was reorged out in the meantime.
+		return nil, rpcerrors.Reorged("L2 block %s was reorged while retrieving the output: %w", ref, err)
+	} else if err != nil {
+		return nil, rpcerrors.Classify(fmt.Errorf("failed to get L2 output at block %s: %w", ref, err))
 	}
 	return &eth.OutputResponse{
 		Version:               output.Version(),
@@ -112,7 +145,11 @@ func (n *nodeAPI) OutputAtBlock(ctx context.Context, number hexutil.Uint64) (*et
 func (n *nodeAPI) SyncStatus(ctx context.Context) (*eth.SyncStatus, error) {
 	recordDur := n.m.RecordRPCServerRequest("optimism_syncStatus")
 	defer recordDur()
-	return n.dr.SyncStatus(ctx)
+	status, err := n.dr.SyncStatus(ctx)
+	if err != nil {
+		return nil, rpcerrors.Classify(err)
+	}
+	return status, nil
 }
 
 func (n *nodeAPI) RollupConfig(_ context.Context) (*rollup.Config, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
@@ -134,7 +135,9 @@ func (cfg *PreparedL2SyncEndpoint) Check() error {
 }
 
 type L1EndpointConfig struct {
-	L1NodeAddr string // Address of L1 User JSON-RPC endpoint to use (eth namespace required)
+	// Address of L1 User JSON-RPC endpoint to use (eth namespace required).
+	// Multiple comma-separated addresses are failed over between, routing requests to the healthiest endpoint.
+	L1NodeAddr string
 
 	// L1TrustRPC: if we trust the L1 RPC we do not have to validate L1 response contents like headers
 	// against block hashes, or cached transaction sender addresses.
@@ -151,6 +154,10 @@ type L1EndpointConfig struct {
 	// BatchSize specifies the maximum batch-size, which also applies as L1 rate-limit burst amount (if set).
 	BatchSize int
 
+	// CacheSize specifies the number of L1 blocks to cache data of.
+	// 0 sizes the cache based on the sequencing window of the rollup.
+	CacheSize uint
+
 	// HttpPollInterval specifies the interval between polling for the latest L1 block,
 	// when the RPC is detected to be an HTTP type.
 	// It is recommended to use websockets or IPC for efficient following of the changing block.
@@ -167,6 +174,9 @@ func (cfg *L1EndpointConfig) Check() error {
 	if cfg.RateLimit < 0 {
 		return fmt.Errorf("rate limit cannot be negative")
 	}
+	if cfg.CacheSize > 1_000_000 {
+		return fmt.Errorf("cache size is unreasonable: %d", cfg.CacheSize)
+	}
 	return nil
 }
 
@@ -178,13 +188,23 @@ func (cfg *L1EndpointConfig) Setup(ctx context.Context, log log.Logger, rollupCf
 	if cfg.RateLimit != 0 {
 		opts = append(opts, client.WithRateLimit(cfg.RateLimit, cfg.BatchSize))
 	}
+	addrs := strings.Split(cfg.L1NodeAddr, ",")
+	// A single endpoint resumes the L1 heads subscription when the connection drops, without missing heads.
+	// With multiple endpoints the subscription fails instead, so that it is resubscribed to a healthy endpoint.
+	if len(addrs) == 1 {
+		opts = append(opts, client.WithResubscribe())
+	}
 
-	l1Node, err := client.NewRPC(ctx, log, cfg.L1NodeAddr, opts...)
+	// The rate-limit applies to each of the endpoints.
+	l1Node, err := client.DialFailoverRPC(ctx, log, addrs, nil, opts...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to dial L1 address (%s): %w", cfg.L1NodeAddr, err)
 	}
 	rpcCfg := sources.L1ClientDefaultConfig(rollupCfg, cfg.L1TrustRPC, cfg.L1RPCKind)
 	rpcCfg.MaxRequestsPerBatch = cfg.BatchSize
+	if cfg.CacheSize > 0 {
+		rpcCfg.SetCacheSize(int(cfg.CacheSize))
+	}
 	return l1Node, rpcCfg, nil
 }
 
@@ -0,0 +1,44 @@
+package node
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestL1EndpointSetupResubscribes(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlError)
+	wsURL := func() string {
+		srv := rpc.NewServer()
+		t.Cleanup(srv.Stop)
+		httpSrv := httptest.NewServer(srv.WebsocketHandler([]string{"*"}))
+		t.Cleanup(httpSrv.Close)
+		return "ws" + strings.TrimPrefix(httpSrv.URL, "http")
+	}
+
+	t.Run("SingleEndpoint", func(t *testing.T) {
+		cfg := &L1EndpointConfig{L1NodeAddr: wsURL(), BatchSize: 20}
+		l1Node, _, err := cfg.Setup(context.Background(), logger, &rollup.Config{})
+		require.NoError(t, err)
+		defer l1Node.Close()
+		require.IsType(t, &client.ResubscribingClient{}, l1Node)
+	})
+
+	t.Run("FailoverEndpoints", func(t *testing.T) {
+		// the subscription must fail over to another endpoint, instead of resubscribing to the same one
+		cfg := &L1EndpointConfig{L1NodeAddr: wsURL() + "," + wsURL(), BatchSize: 20}
+		l1Node, _, err := cfg.Setup(context.Background(), logger, &rollup.Config{})
+		require.NoError(t, err)
+		defer l1Node.Close()
+		require.IsType(t, &client.FailoverRPC{}, l1Node)
+	})
+}
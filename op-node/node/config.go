@@ -12,7 +12,10 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+	optracing "github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -37,6 +40,8 @@ type Config struct {
 
 	Pprof oppprof.CLIConfig
 
+	Tracing optracing.CLIConfig
+
 	// Used to poll the L1 for new finalized or safe blocks
 	L1EpochPollInterval time.Duration
 
@@ -60,15 +65,33 @@ type Config struct {
 
 	// Cancel to request a premature shutdown of the node itself, e.g. when halting. This may be nil.
 	Cancel context.CancelCauseFunc
+
+	// AltDA configures the DA server that the batch data of chains with an alt-DA rollup config is fetched from.
+	AltDA AltDAConfig
+
+	// Interop configures the supervisor that verifies the executing messages of blocks, once interop is active.
+	Interop InteropConfig
+
+	// Beacon configures the beacon API endpoints that blobs are fetched from, once blobs are enabled.
+	Beacon L1BeaconConfig
+
+	// Clock that the driver schedules the sequencer and derivation work with.
+	// This is the system clock if nil, tests may set a controllable clock.
+	Clock clock.Clock
 }
 
 type RPCConfig struct {
 	ListenAddr  string
 	ListenPort  int
 	EnableAdmin bool
+	// TLS serves the RPC over HTTPS if set, and requires clients to present a certificate signed by the CA.
+	TLS optls.CLIConfig
 }
 
 func (cfg *RPCConfig) HttpEndpoint() string {
+	if cfg.TLS.TLSEnabled() {
+		return fmt.Sprintf("https://%s:%d", cfg.ListenAddr, cfg.ListenPort)
+	}
 	return fmt.Sprintf("http://%s:%d", cfg.ListenAddr, cfg.ListenPort)
 }
 
@@ -90,6 +113,22 @@ func (m MetricsConfig) Check() error {
 	return nil
 }
 
+type AltDAConfig struct {
+	DAServerURL string
+	Timeout     time.Duration
+}
+
+type InteropConfig struct {
+	SupervisorAddr string
+}
+
+// L1BeaconConfig configures the beacon API endpoint to fetch blob sidecars from,
+// and the fallback endpoints, e.g. blob archivers, that are tried in order when the endpoint fails.
+type L1BeaconConfig struct {
+	Endpoint  string
+	Fallbacks []string
+}
+
 type HeartbeatConfig struct {
 	Enabled bool
 	Moniker string
@@ -125,17 +164,32 @@ func (cfg *Config) Check() error {
 	if err := cfg.Rollup.Check(); err != nil {
 		return fmt.Errorf("rollup config error: %w", err)
 	}
+	if err := cfg.RPC.TLS.Check(); err != nil {
+		return fmt.Errorf("rpc tls config error: %w", err)
+	}
 	if err := cfg.Metrics.Check(); err != nil {
 		return fmt.Errorf("metrics config error: %w", err)
 	}
 	if err := cfg.Pprof.Check(); err != nil {
 		return fmt.Errorf("pprof config error: %w", err)
 	}
+	if err := cfg.Tracing.Check(); err != nil {
+		return fmt.Errorf("tracing config error: %w", err)
+	}
 	if cfg.P2P != nil {
 		if err := cfg.P2P.Check(); err != nil {
 			return fmt.Errorf("p2p config error: %w", err)
 		}
 	}
+	if cfg.Rollup.AltDA != nil && cfg.AltDA.DAServerURL == "" {
+		return fmt.Errorf("the rollup config enables alt-DA, which requires the %s flag", flags.AltDAServerFlag.Name)
+	}
+	if cfg.Rollup.InteropTime != nil && cfg.Interop.SupervisorAddr == "" {
+		return fmt.Errorf("the rollup config schedules interop, which requires the %s flag", flags.InteropSupervisorFlag.Name)
+	}
+	if cfg.Rollup.BlobsEnabledL1Timestamp != nil && cfg.Beacon.Endpoint == "" {
+		return fmt.Errorf("the rollup config enables blobs, which requires the %s flag", flags.BeaconAddr.Name)
+	}
 	if !(cfg.RollupHalt == "" || cfg.RollupHalt == "major" || cfg.RollupHalt == "minor" || cfg.RollupHalt == "patch") {
 		return fmt.Errorf("invalid rollup halting option: %q", cfg.RollupHalt)
 	}
@@ -21,13 +21,18 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/heartbeat"
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/p2p"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
 	"github.com/ethereum-optimism/optimism/op-node/version"
+	"github.com/ethereum-optimism/optimism/op-service/altda"
 	"github.com/ethereum-optimism/optimism/op-service/client"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
 	"github.com/ethereum-optimism/optimism/op-service/retry"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
+	optracing "github.com/ethereum-optimism/optimism/op-service/tracing"
 )
 
 type OpNode struct {
@@ -39,20 +44,23 @@ type OpNode struct {
 	l1SafeSub      ethereum.Subscription // Subscription to get L1 safe blocks, a.k.a. justified data (polling)
 	l1FinalizedSub ethereum.Subscription // Subscription to get L1 safe blocks, a.k.a. justified data (polling)
 
-	l1Source  *sources.L1Client     // L1 Client to fetch data from
-	l2Driver  *driver.Driver        // L2 Engine to Sync
-	l2Source  *sources.EngineClient // L2 Execution Engine RPC bindings
-	rpcSync   *sources.SyncClient   // Alt-sync RPC client, optional (may be nil)
-	server    *rpcServer            // RPC server hosting the rollup-node API
-	p2pNode   *p2p.NodeP2P          // P2P node functionality
-	p2pSigner p2p.Signer            // p2p gogssip application messages will be signed with this signer
-	tracer    Tracer                // tracer to get events for testing/debugging
-	runCfg    *RuntimeConfig        // runtime configurables
+	l1Source   *sources.L1Client         // L1 Client to fetch data from
+	l2Driver   *driver.Driver            // L2 Engine to Sync
+	l2Source   *sources.EngineClient     // L2 Execution Engine RPC bindings
+	rpcSync    *sources.SyncClient       // Alt-sync RPC client, optional (may be nil)
+	supervisor *sources.SupervisorClient // Interop supervisor RPC client, optional (may be nil)
+	server     *rpcServer                // RPC server hosting the rollup-node API
+	p2pNode    *p2p.NodeP2P              // P2P node functionality
+	p2pSigner  p2p.Signer                // p2p gogssip application messages will be signed with this signer
+	tracer     Tracer                    // tracer to get events for testing/debugging
+	runCfg     *RuntimeConfig            // runtime configurables
 
 	rollupHalt string // when to halt the rollup, disabled if empty
 
-	pprofSrv   *httputil.HTTPServer
-	metricsSrv *httputil.HTTPServer
+	pprofSrv    *httputil.HTTPServer
+	pprofPusher *oppprof.Pusher
+	metricsSrv  *httputil.HTTPServer
+	tracing     *optracing.Provider
 
 	// some resources cannot be stopped directly, like the p2p gossipsub router (not our design),
 	// and depend on this ctx to be closed.
@@ -107,6 +115,10 @@ func (n *OpNode) init(ctx context.Context, cfg *Config, snapshotLog log.Logger)
 	if err := n.initTracer(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to init the trace: %w", err)
 	}
+	// Tracing is started first, so the spans of all subsystems are exported.
+	if err := n.initTracing(ctx, cfg); err != nil {
+		return fmt.Errorf("failed to init tracing: %w", err)
+	}
 	if err := n.initL1(ctx, cfg); err != nil {
 		return fmt.Errorf("failed to init L1: %w", err)
 	}
@@ -157,7 +169,7 @@ func (n *OpNode) initL1(ctx context.Context, cfg *Config) error {
 	}
 
 	n.l1Source, err = sources.NewL1Client(
-		client.NewInstrumentedRPC(l1Node, n.metrics), n.log, n.metrics.L1SourceCache, rpcCfg)
+		client.NewInstrumentedRPC(l1Node, n.metrics), oplog.Module(n.log, "l1"), n.metrics.L1SourceCache, rpcCfg)
 	if err != nil {
 		return fmt.Errorf("failed to create L1 source: %w", err)
 	}
@@ -291,7 +303,7 @@ func (n *OpNode) initL2(ctx context.Context, cfg *Config, snapshotLog log.Logger
 	}
 
 	n.l2Source, err = sources.NewEngineClient(
-		client.NewInstrumentedRPC(rpcClient, n.metrics), n.log, n.metrics.L2SourceCache, rpcCfg,
+		client.NewInstrumentedRPC(rpcClient, n.metrics), oplog.Module(n.log, "l2"), n.metrics.L2SourceCache, rpcCfg,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create Engine client: %w", err)
@@ -301,7 +313,32 @@ func (n *OpNode) initL2(ctx context.Context, cfg *Config, snapshotLog log.Logger
 		return err
 	}
 
-	n.l2Driver = driver.NewDriver(&cfg.Driver, &cfg.Rollup, n.l2Source, n.l1Source, n, n, n.log, snapshotLog, n.metrics, cfg.ConfigPersistence, &cfg.Sync)
+	cl := cfg.Clock
+	if cl == nil {
+		cl = clock.SystemClock
+	}
+	var altDA derive.AltDAFetcher
+	if cfg.AltDA.DAServerURL != "" {
+		altDA = altda.NewDAClient(cfg.AltDA.DAServerURL, cfg.AltDA.Timeout)
+	}
+	var supervisor derive.Supervisor
+	if cfg.Interop.SupervisorAddr != "" {
+		rpcClient, err := client.NewRPC(ctx, n.log, cfg.Interop.SupervisorAddr)
+		if err != nil {
+			return fmt.Errorf("failed to setup interop supervisor RPC client: %w", err)
+		}
+		n.supervisor = sources.NewSupervisorClient(client.NewInstrumentedRPC(rpcClient, n.metrics))
+		supervisor = n.supervisor
+	}
+	var l1Blobs derive.L1BlobsFetcher
+	if cfg.Beacon.Endpoint != "" {
+		fallbacks := make([]sources.BlobSideCarsFetcher, 0, len(cfg.Beacon.Fallbacks))
+		for _, addr := range cfg.Beacon.Fallbacks {
+			fallbacks = append(fallbacks, sources.NewBeaconHTTPClient(addr, nil))
+		}
+		l1Blobs = sources.NewL1BeaconClient(sources.NewBeaconHTTPClient(cfg.Beacon.Endpoint, nil), fallbacks...)
+	}
+	n.l2Driver = driver.NewDriver(&cfg.Driver, &cfg.Rollup, n.l2Source, n.l1Source, l1Blobs, altDA, supervisor, n, n, oplog.Module(n.log, "driver"), snapshotLog, n.metrics, cfg.ConfigPersistence, &cfg.Sync, cl)
 
 	return nil
 }
@@ -323,7 +360,7 @@ func (n *OpNode) initRPCSync(ctx context.Context, cfg *Config) error {
 }
 
 func (n *OpNode) initRPCServer(ctx context.Context, cfg *Config) error {
-	server, err := newRPCServer(ctx, &cfg.RPC, &cfg.Rollup, n.l2Source.L2Client, n.l2Driver, n.log, n.appVersion, n.metrics)
+	server, err := newRPCServer(ctx, &cfg.RPC, &cfg.Rollup, n.l2Source.L2Client, n.l2Driver, oplog.Module(n.log, "rpc"), n.appVersion, n.metrics)
 	if err != nil {
 		return err
 	}
@@ -331,6 +368,7 @@ func (n *OpNode) initRPCServer(ctx context.Context, cfg *Config) error {
 		server.EnableP2P(p2p.NewP2PAPIBackend(n.p2pNode, n.log, n.metrics))
 	}
 	if cfg.RPC.EnableAdmin {
+		// The admin API changes the log levels of the root log handler, not those of the rpc module.
 		server.EnableAdminAPI(NewAdminAPI(n.l2Driver, n.metrics, n.log))
 		n.log.Info("Admin RPC enabled")
 	}
@@ -383,7 +421,17 @@ func (n *OpNode) initHeartbeat(cfg *Config) {
 	}(cfg.Heartbeat.URL)
 }
 
+func (n *OpNode) initTracing(ctx context.Context, cfg *Config) error {
+	provider, err := optracing.StartTracing(ctx, n.log, cfg.Tracing, "op-node", n.appVersion)
+	if err != nil {
+		return err
+	}
+	n.tracing = provider
+	return nil
+}
+
 func (n *OpNode) initPProf(cfg *Config) error {
+	n.pprofPusher = oppprof.StartPusher(n.log, cfg.Pprof, "op-node", n.appVersion)
 	if !cfg.Pprof.Enabled {
 		return nil
 	}
@@ -399,7 +447,7 @@ func (n *OpNode) initPProf(cfg *Config) error {
 
 func (n *OpNode) initP2P(ctx context.Context, cfg *Config) error {
 	if cfg.P2P != nil {
-		p2pNode, err := p2p.NewNodeP2P(n.resourcesCtx, &cfg.Rollup, n.log, cfg.P2P, n, n.l2Source, n.runCfg, n.metrics)
+		p2pNode, err := p2p.NewNodeP2P(n.resourcesCtx, &cfg.Rollup, oplog.Module(n.log, "p2p"), cfg.P2P, n, n.l2Source, n.runCfg, n.metrics)
 		if err != nil || p2pNode == nil {
 			return err
 		}
@@ -599,6 +647,11 @@ func (n *OpNode) Stop(ctx context.Context) error {
 		<-n.runtimeConfigReloaderDone
 	}
 
+	// close interop supervisor RPC client
+	if n.supervisor != nil {
+		n.supervisor.Close()
+	}
+
 	// close L2 engine RPC client
 	if n.l2Source != nil {
 		n.l2Source.Close()
@@ -624,7 +677,17 @@ func (n *OpNode) Stop(ctx context.Context) error {
 		}
 	}
 
-	// Close metrics and pprof only after we are done idling
+	// Close metrics, pprof and tracing only after we are done idling
+	if n.tracing != nil {
+		if err := n.tracing.Stop(ctx); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	if n.pprofPusher != nil {
+		if err := n.pprofPusher.Stop(ctx); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to stop pprof pusher: %w", err))
+		}
+	}
 	if n.pprofSrv != nil {
 		if err := n.pprofSrv.Stop(ctx); err != nil {
 			result = multierror.Append(result, fmt.Errorf("failed to close pprof server: %w", err))
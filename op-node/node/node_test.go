@@ -1,13 +1,56 @@
 package node
 
 import (
+	"bytes"
+	"context"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	rpcclient "github.com/ethereum-optimism/optimism/op-service/client"
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/sources"
 )
 
 func TestUnixTimeStale(t *testing.T) {
 	require.True(t, unixTimeStale(1_600_000_000, 1*time.Hour))
 	require.False(t, unixTimeStale(uint64(time.Now().Unix()), 1*time.Hour))
 }
+
+func TestAdminSetLogLevel(t *testing.T) {
+	var out bytes.Buffer
+	logger := oplog.NewLogger(&out, oplog.CLIConfig{Level: log.LvlInfo, Format: oplog.FormatLogFmt})
+	n := &OpNode{
+		log:        logger,
+		appVersion: "test",
+		metrics:    metrics.NewMetrics(""),
+		l2Source:   &sources.EngineClient{},
+	}
+	cfg := &Config{RPC: RPCConfig{ListenAddr: "127.0.0.1", EnableAdmin: true}}
+	require.NoError(t, n.initRPCServer(context.Background(), cfg))
+	defer func() {
+		require.NoError(t, n.server.Stop(context.Background()))
+	}()
+
+	client, err := rpcclient.NewRPC(context.Background(), logger, n.HTTPEndpoint(), rpcclient.WithDialBackoff(3))
+	require.NoError(t, err)
+	defer client.Close()
+
+	p2pLog := oplog.Module(logger, "p2p")
+	p2pLog.Debug("hidden")
+	require.NoError(t, client.CallContext(context.Background(), nil, "admin_setLogLevel", "debug"))
+	p2pLog.Debug("shown")
+	require.NoError(t, client.CallContext(context.Background(), nil, "admin_setModuleLogLevel", "p2p", "error"))
+	p2pLog.Info("muted")
+	var levels map[string]string
+	require.NoError(t, client.CallContext(context.Background(), &levels, "admin_moduleLogLevels"))
+	require.Equal(t, map[string]string{"p2p": "eror"}, levels)
+
+	require.NotContains(t, out.String(), "hidden")
+	require.Contains(t, out.String(), "shown")
+	require.NotContains(t, out.String(), "muted")
+}
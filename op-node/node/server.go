@@ -2,12 +2,15 @@ package node
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	"strconv"
+	"time"
 
 	ophttp "github.com/ethereum-optimism/optimism/op-service/httputil"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -18,10 +21,14 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 )
 
+// rpcDrainTimeout is the time that in-flight RPC requests are allowed to complete in when the server stops
+const rpcDrainTimeout = 5 * time.Second
+
 type rpcServer struct {
 	endpoint   string
 	apis       []rpc.API
 	httpServer *ophttp.HTTPServer
+	tlsConfig  *tls.Config
 	appVersion string
 	log        log.Logger
 	sources.L2Client
@@ -41,6 +48,13 @@ func newRPCServer(ctx context.Context, rpcCfg *RPCConfig, rollupCfg *rollup.Conf
 		appVersion: appVersion,
 		log:        log,
 	}
+	if rpcCfg.TLS.TLSEnabled() {
+		tlsConfig, err := optls.NewServerTLSConfig(log, rpcCfg.TLS, optls.RequireAndVerifyClientCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create RPC server tls config: %w", err)
+		}
+		r.tlsConfig = tlsConfig
+	}
 	return r, nil
 }
 
@@ -78,7 +92,13 @@ func (s *rpcServer) Start() error {
 	mux.Handle("/", nodeHandler)
 	mux.HandleFunc("/healthz", healthzHandler(s.appVersion))
 
-	hs, err := ophttp.StartHTTPServer(s.endpoint, mux)
+	var handler http.Handler = mux
+	opts := []ophttp.HTTPOption{ophttp.WithDrainTimeout(rpcDrainTimeout)}
+	if s.tlsConfig != nil {
+		handler = optls.NewPeerTLSMiddleware(mux)
+		opts = append(opts, ophttp.WithTLSConfig(s.tlsConfig))
+	}
+	hs, err := ophttp.StartHTTPServer(s.endpoint, handler, opts...)
 	if err != nil {
 		return fmt.Errorf("failed to start HTTP RPC server: %w", err)
 	}
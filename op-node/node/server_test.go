@@ -3,24 +3,32 @@ package node
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"math/rand"
 	"testing"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/ethereum-optimism/optimism/op-node/metrics"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
 	"github.com/ethereum-optimism/optimism/op-node/version"
 	rpcclient "github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/rpcerrors"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum-optimism/optimism/op-service/testutils"
+	"github.com/ethereum-optimism/optimism/op-service/testutils/tlstest"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
 )
 
 func TestOutputAtBlock(t *testing.T) {
@@ -151,6 +159,40 @@ func TestVersion(t *testing.T) {
 	assert.Equal(t, version.Version+"-"+version.Meta, out)
 }
 
+func TestVersionMutualTLS(t *testing.T) {
+	// certman keeps watching the certificates after the test completes, so do not log to the test
+	logger := log.New()
+	logger.SetHandler(log.DiscardHandler())
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t, dir)
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+		TLS:        ca.Issue(t, dir, "server", 2),
+	}
+	server, err := newRPCServer(context.Background(), rpcCfg, &rollup.Config{}, &testutils.MockL2Client{}, &mockDriverClient{}, logger, "0.0", metrics.NoopMetrics)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+	url := "https://" + server.Addr().String()
+
+	clientTLS, err := optls.NewClientTLSConfig(logger, ca.Issue(t, dir, "client", 3))
+	require.NoError(t, err)
+	client, err := gethrpc.DialOptions(context.Background(), url, rpcclient.TLSClientOptions(clientTLS)...)
+	require.NoError(t, err)
+	defer client.Close()
+	var out string
+	require.NoError(t, client.CallContext(context.Background(), &out, "optimism_version"))
+	require.Equal(t, version.Version+"-"+version.Meta, out)
+
+	unauthenticated, err := gethrpc.DialContext(context.Background(), url)
+	require.NoError(t, err)
+	defer unauthenticated.Close()
+	require.Error(t, unauthenticated.CallContext(context.Background(), &out, "optimism_version"))
+}
+
 func randomSyncStatus(rng *rand.Rand) *eth.SyncStatus {
 	return &eth.SyncStatus{
 		CurrentL1:          testutils.RandomBlockRef(rng),
@@ -197,6 +239,35 @@ func TestSyncStatus(t *testing.T) {
 	assert.Equal(t, status, out)
 }
 
+func TestSetUnsafeHead(t *testing.T) {
+	log := testlog.Logger(t, log.LvlError)
+	l2Client := &testutils.MockL2Client{}
+	drClient := &mockDriverClient{}
+	drClient.On("SetUnsafeHead", uint64(42)).Return(nil)
+
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+	}
+	rollupCfg := &rollup.Config{
+		// ignore other rollup config info in this test
+	}
+	server, err := newRPCServer(context.Background(), rpcCfg, rollupCfg, l2Client, drClient, log, "0.0", metrics.NoopMetrics)
+	assert.NoError(t, err)
+	server.EnableAdminAPI(NewAdminAPI(drClient, metrics.NoopMetrics, log))
+	assert.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	client, err := rpcclient.NewRPC(context.Background(), log, "http://"+server.Addr().String(), rpcclient.WithDialBackoff(3))
+	assert.NoError(t, err)
+
+	err = client.CallContext(context.Background(), nil, "admin_setUnsafeHead", hexutil.Uint64(42))
+	assert.NoError(t, err)
+	drClient.AssertExpectations(t)
+}
+
 type mockDriverClient struct {
 	mock.Mock
 }
@@ -218,6 +289,11 @@ func (c *mockDriverClient) ResetDerivationPipeline(ctx context.Context) error {
 	return c.Mock.MethodCalled("ResetDerivationPipeline").Get(0).(error)
 }
 
+func (c *mockDriverClient) SetUnsafeHead(ctx context.Context, num uint64) error {
+	err, _ := c.Mock.MethodCalled("SetUnsafeHead", num).Get(0).(error)
+	return err
+}
+
 func (c *mockDriverClient) StartSequencer(ctx context.Context, blockHash common.Hash) error {
 	return c.Mock.MethodCalled("StartSequencer").Get(0).(error)
 }
@@ -229,3 +305,44 @@ func (c *mockDriverClient) StopSequencer(ctx context.Context) (common.Hash, erro
 func (c *mockDriverClient) SequencerActive(ctx context.Context) (bool, error) {
 	return c.Mock.MethodCalled("SequencerActive").Get(0).(bool), nil
 }
+
+func (c *mockDriverClient) SequencerPolicy(ctx context.Context) (driver.SequencerPolicy, error) {
+	return c.Mock.MethodCalled("SequencerPolicy").Get(0).(driver.SequencerPolicy), nil
+}
+
+func (c *mockDriverClient) SetSequencerPolicy(ctx context.Context, policy driver.SequencerPolicy) error {
+	err, _ := c.Mock.MethodCalled("SetSequencerPolicy", policy).Get(0).(error)
+	return err
+}
+
+func TestOutputAtBlockErrorCodes(t *testing.T) {
+	log := testlog.Logger(t, log.LvlError)
+	rpcCfg := &RPCConfig{
+		ListenAddr: "localhost",
+		ListenPort: 0,
+	}
+	ref := eth.L2BlockRef{Hash: common.Hash{0xaa}, Number: 10}
+	status := randomSyncStatus(rand.New(rand.NewSource(123)))
+
+	l2Client := &testutils.MockL2Client{}
+	l2Client.ExpectOutputV0AtBlock(ref.Hash, nil, ethereum.NotFound)
+	drClient := &mockDriverClient{}
+	drClient.ExpectBlockRefWithStatus(10, ref, status, nil)
+	drClient.ExpectBlockRefWithStatus(11, eth.L2BlockRef{}, status, fmt.Errorf("block 11: %w", ethereum.NotFound))
+
+	server, err := newRPCServer(context.Background(), rpcCfg, &rollup.Config{}, l2Client, drClient, log, "0.0", metrics.NoopMetrics)
+	require.NoError(t, err)
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop(context.Background()))
+	}()
+
+	client, err := rpcclient.NewRPC(context.Background(), log, "http://"+server.Addr().String(), rpcclient.WithDialBackoff(3))
+	require.NoError(t, err)
+
+	var out *eth.OutputResponse
+	err = client.CallContext(context.Background(), &out, "optimism_outputAtBlock", hexutil.Uint64(10))
+	require.True(t, rpcerrors.IsReorged(err), "unexpected error: %v", err)
+	err = client.CallContext(context.Background(), &out, "optimism_outputAtBlock", hexutil.Uint64(11))
+	require.True(t, rpcerrors.IsNotFound(err), "unexpected error: %v", err)
+}
@@ -99,6 +99,16 @@ func loadScoringParams(conf *p2p.Config, ctx *cli.Context, rollupCfg *rollup.Con
 		}
 		conf.ScoringParams = params
 	}
+	if conf.ScoringParams != nil && ctx.IsSet(flags.BlocksTopicWeightName) {
+		weight := ctx.Float64(flags.BlocksTopicWeightName)
+		if weight < 0 {
+			return fmt.Errorf("blocks topic weight must not be negative, got %v", weight)
+		}
+		// all scored topics are blocks topics
+		for _, topicParams := range conf.ScoringParams.PeerScoring.Topics {
+			topicParams.TopicWeight = weight
+		}
+	}
 
 	return nil
 }
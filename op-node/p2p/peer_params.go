@@ -42,28 +42,10 @@ func LightPeerScoreParams(cfg *rollup.Config) pubsub.PeerScoreParams {
 	epoch := 6 * slot
 	tenEpochs := 10 * epoch
 	oneHundredEpochs := 100 * epoch
-	invalidDecayPeriod := 50 * epoch
 	return pubsub.PeerScoreParams{
 		Topics: map[string]*pubsub.TopicScoreParams{
-			blocksTopicV1(cfg): {
-				TopicWeight:                     0.8,
-				TimeInMeshWeight:                MaxInMeshScore / inMeshCap(slot),
-				TimeInMeshQuantum:               slot,
-				TimeInMeshCap:                   inMeshCap(slot),
-				FirstMessageDeliveriesWeight:    1,
-				FirstMessageDeliveriesDecay:     ScoreDecay(20*epoch, slot),
-				FirstMessageDeliveriesCap:       23,
-				MeshMessageDeliveriesWeight:     MeshWeight,
-				MeshMessageDeliveriesDecay:      ScoreDecay(DecayEpoch*epoch, slot),
-				MeshMessageDeliveriesCap:        float64(uint64(epoch/slot) * uint64(DecayEpoch)),
-				MeshMessageDeliveriesThreshold:  float64(uint64(epoch/slot) * uint64(DecayEpoch) / 10),
-				MeshMessageDeliveriesWindow:     2 * time.Second,
-				MeshMessageDeliveriesActivation: 4 * epoch,
-				MeshFailurePenaltyWeight:        MeshWeight,
-				MeshFailurePenaltyDecay:         ScoreDecay(DecayEpoch*epoch, slot),
-				InvalidMessageDeliveriesWeight:  -140.4475,
-				InvalidMessageDeliveriesDecay:   ScoreDecay(invalidDecayPeriod, slot),
-			},
+			blocksTopicV1(cfg): blocksTopicScoreParams(slot),
+			blocksTopicV2(cfg): blocksTopicScoreParams(slot),
 		},
 		TopicScoreCap: 34,
 		AppSpecificScore: func(p peer.ID) float64 {
@@ -82,6 +64,33 @@ func LightPeerScoreParams(cfg *rollup.Config) pubsub.PeerScoreParams {
 	}
 }
 
+// blocksTopicScoreParams returns the [pubsub.TopicScoreParams] of a blocks topic.
+// Peers are rewarded for the time in the mesh and for timely first deliveries of blocks,
+// and penalized for too few mesh deliveries and for invalid payloads.
+func blocksTopicScoreParams(slot time.Duration) *pubsub.TopicScoreParams {
+	epoch := 6 * slot
+	invalidDecayPeriod := 50 * epoch
+	return &pubsub.TopicScoreParams{
+		TopicWeight:                     0.8,
+		TimeInMeshWeight:                MaxInMeshScore / inMeshCap(slot),
+		TimeInMeshQuantum:               slot,
+		TimeInMeshCap:                   inMeshCap(slot),
+		FirstMessageDeliveriesWeight:    1,
+		FirstMessageDeliveriesDecay:     ScoreDecay(20*epoch, slot),
+		FirstMessageDeliveriesCap:       23,
+		MeshMessageDeliveriesWeight:     MeshWeight,
+		MeshMessageDeliveriesDecay:      ScoreDecay(DecayEpoch*epoch, slot),
+		MeshMessageDeliveriesCap:        float64(uint64(epoch/slot) * uint64(DecayEpoch)),
+		MeshMessageDeliveriesThreshold:  float64(uint64(epoch/slot) * uint64(DecayEpoch) / 10),
+		MeshMessageDeliveriesWindow:     2 * time.Second,
+		MeshMessageDeliveriesActivation: 4 * epoch,
+		MeshFailurePenaltyWeight:        MeshWeight,
+		MeshFailurePenaltyDecay:         ScoreDecay(DecayEpoch*epoch, slot),
+		InvalidMessageDeliveriesWeight:  -140.4475,
+		InvalidMessageDeliveriesDecay:   ScoreDecay(invalidDecayPeriod, slot),
+	}
+}
+
 // the cap for `inMesh` time scoring.
 func inMeshCap(slot time.Duration) float64 {
 	return float64((3600 * time.Second) / slot)
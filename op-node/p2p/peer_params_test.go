@@ -65,11 +65,14 @@ func (testSuite *PeerParamsTestSuite) TestGetPeerScoreParams_Light() {
 	scoringParams, err := GetScoringParams("light", cfg)
 	peerParams := scoringParams.PeerScoring
 	testSuite.NoError(err)
-	// Topics should contain options for block topic
-	testSuite.Len(peerParams.Topics, 1)
+	// Topics should contain options for both block topics
+	testSuite.Len(peerParams.Topics, 2)
 	topicParams, ok := peerParams.Topics[blocksTopicV1(cfg)]
 	testSuite.True(ok, "should have block topic params")
 	testSuite.NotZero(topicParams.TimeInMeshQuantum)
+	topicParamsV2, ok := peerParams.Topics[blocksTopicV2(cfg)]
+	testSuite.True(ok, "should have block topic v2 params")
+	testSuite.Equal(topicParams, topicParamsV2)
 	testSuite.Equal(peerParams.TopicScoreCap, float64(34))
 	testSuite.Equal(peerParams.AppSpecificWeight, float64(1))
 	testSuite.Equal(peerParams.IPColocationFactorWeight, float64(-35))
@@ -3,6 +3,7 @@ package p2p_test
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	peer "github.com/libp2p/go-libp2p/core/peer"
@@ -78,3 +79,46 @@ func (testSuite *PeerScorerTestSuite) TestScorer_SnapshotHook() {
 	}
 	inspectFn(snapshotMap)
 }
+
+// TestScorer_SnapshotHookBlocksTopics tests that the scores of both blocks topics are combined.
+func (testSuite *PeerScorerTestSuite) TestScorer_SnapshotHookBlocksTopics() {
+	scorer := p2p.NewScorer(
+		&rollup.Config{L2ChainID: big.NewInt(123)},
+		testSuite.mockStore,
+		testSuite.mockMetricer,
+		&p2p.NoopApplicationScorer{},
+		testSuite.logger,
+	)
+	inspectFn := scorer.SnapshotHook()
+
+	scores := store.PeerScores{Gossip: store.GossipScores{Total: 3}}
+	testSuite.mockStore.On("SetScore", peer.ID("peer1"), &store.GossipScores{
+		Total: 3,
+		Blocks: store.TopicScores{
+			TimeInMesh:               20,
+			FirstMessageDeliveries:   5,
+			MeshMessageDeliveries:    7,
+			InvalidMessageDeliveries: 1,
+		},
+	}).Return(scores, nil).Once()
+	testSuite.mockMetricer.On("SetPeerScores", []store.PeerScores{scores}).Return(nil).Once()
+
+	inspectFn(map[peer.ID]*pubsub.PeerScoreSnapshot{
+		peer.ID("peer1"): {
+			Score: 3,
+			Topics: map[string]*pubsub.TopicScoreSnapshot{
+				"/optimism/123/0/blocks": {
+					TimeInMesh:             20 * time.Second,
+					FirstMessageDeliveries: 2,
+					MeshMessageDeliveries:  3,
+				},
+				"/optimism/123/1/blocks": {
+					TimeInMesh:               10 * time.Second,
+					FirstMessageDeliveries:   3,
+					MeshMessageDeliveries:    4,
+					InvalidMessageDeliveries: 1,
+				},
+			},
+		},
+	})
+}
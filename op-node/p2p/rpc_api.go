@@ -55,6 +55,7 @@ type API interface {
 	BlockSubnet(ctx context.Context, ipnet *net.IPNet) error
 	UnblockSubnet(ctx context.Context, ipnet *net.IPNet) error
 	ListBlockedSubnets(ctx context.Context) ([]*net.IPNet, error)
+	ListPeerScores(ctx context.Context) (map[string]store.PeerScores, error)
 	ProtectPeer(ctx context.Context, p peer.ID) error
 	UnprotectPeer(ctx context.Context, p peer.ID) error
 	ConnectPeer(ctx context.Context, addr string) error
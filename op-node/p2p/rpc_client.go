@@ -6,6 +6,7 @@ import (
 
 	"github.com/libp2p/go-libp2p/core/peer"
 
+	"github.com/ethereum-optimism/optimism/op-node/p2p/store"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/rpc"
 )
@@ -92,6 +93,12 @@ func (c *Client) ListBlockedSubnets(ctx context.Context) ([]*net.IPNet, error) {
 	return out, err
 }
 
+func (c *Client) ListPeerScores(ctx context.Context) (map[string]store.PeerScores, error) {
+	var out map[string]store.PeerScores
+	err := c.c.CallContext(ctx, &out, prefixRPC("listPeerScores"))
+	return out, err
+}
+
 func (c *Client) ProtectPeer(ctx context.Context, p peer.ID) error {
 	return c.c.CallContext(ctx, nil, prefixRPC("protectPeer"), p)
 }
@@ -344,6 +344,25 @@ func (s *APIBackend) ListBlockedSubnets(_ context.Context) ([]*net.IPNet, error)
 	}
 }
 
+// ListPeerScores lists the scores of all peers in the peerstore that have been scored,
+// including peers that are no longer connected, since scores are persisted.
+func (s *APIBackend) ListPeerScores(_ context.Context) (map[string]store.PeerScores, error) {
+	recordDur := s.m.RecordRPCServerRequest("opp2p_listPeerScores")
+	defer recordDur()
+	eps, ok := s.node.Host().Peerstore().(store.ExtendedPeerstore)
+	if !ok {
+		return nil, errors.New("peerstore does not track peer scores")
+	}
+	scores := make(map[string]store.PeerScores)
+	for _, id := range eps.Peers() {
+		if dat, err := eps.GetPeerScores(id); err == nil {
+			// Like in the peer dump, the peer.ID type is not used as key, for the sake of JSON decoding.
+			scores[id.String()] = dat
+		}
+	}
+	return scores, nil
+}
+
 func (s *APIBackend) ProtectPeer(_ context.Context, p peer.ID) error {
 	recordDur := s.m.RecordRPCServerRequest("opp2p_protectPeer")
 	defer recordDur()
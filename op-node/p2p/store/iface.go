@@ -19,7 +19,7 @@ type TopicScores struct {
 
 type GossipScores struct {
 	Total              float64     `json:"total"`
-	Blocks             TopicScores `json:"blocks"` // fully zeroed if the peer has not been in the mesh on the topic
+	Blocks             TopicScores `json:"blocks"` // combined over the blocks topics, fully zeroed if the peer has not been in the mesh on any of them
 	IPColocationFactor float64     `json:"IPColocationFactor"`
 	BehavioralPenalty  float64     `json:"behavioralPenalty"`
 }
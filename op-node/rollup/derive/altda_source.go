@@ -0,0 +1,84 @@
+package derive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/altda"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// AltDAFetcher fetches the data of commitments from an alternative data-availability layer.
+type AltDAFetcher interface {
+	GetInput(ctx context.Context, comm altda.Commitment) ([]byte, error)
+}
+
+var ErrAltDANotConfigured = errors.New("alt-DA data source not configured")
+
+// noAltDA is the AltDAFetcher of nodes that do not have a DA server configured.
+type noAltDA struct{}
+
+func (noAltDA) GetInput(ctx context.Context, comm altda.Commitment) ([]byte, error) {
+	return nil, ErrAltDANotConfigured
+}
+
+// AltDADataSource resolves the commitments in the data of batcher transactions to the batch data on an
+// alternative DA layer. Data that is not prefixed with altda.TxDataVersion1 is batch data posted to L1,
+// which is returned as it is, so that the batcher can fall back to L1 when the DA layer is unavailable.
+type AltDADataSource struct {
+	log      log.Logger
+	src      DataIter
+	fetcher  AltDAFetcher
+	commType altda.CommitmentType
+
+	// comm is the commitment that is being resolved, while fetching its data fails
+	comm altda.Commitment
+}
+
+func NewAltDADataSource(log log.Logger, src DataIter, fetcher AltDAFetcher, commType altda.CommitmentType) *AltDADataSource {
+	return &AltDADataSource{log: log, src: src, fetcher: fetcher, commType: commType}
+}
+
+// Next returns the next batch data. Commitments that are invalid, of a different type than the chain uses,
+// or that do not match the data are skipped, like invalid batcher transactions.
+// A temporary error is returned if the data of a commitment cannot be fetched, and the commitment is retried
+// on the next call, since skipping data that is unavailable to this node would make it diverge.
+func (s *AltDADataSource) Next(ctx context.Context) (eth.Data, error) {
+	for {
+		if s.comm == nil {
+			data, err := s.src.Next(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if len(data) == 0 || data[0] != altda.TxDataVersion1 {
+				return data, nil
+			}
+			comm, err := altda.DecodeCommitment(data[1:])
+			if err != nil {
+				s.log.Warn("Ignoring invalid alt-DA commitment", "err", err)
+				continue
+			}
+			if comm.Type() != s.commType {
+				s.log.Warn("Ignoring alt-DA commitment of unexpected type", "type", comm.Type(), "expected", s.commType)
+				continue
+			}
+			s.comm = comm
+		}
+		input, err := s.fetcher.GetInput(ctx, s.comm)
+		if errors.Is(err, altda.ErrNotFound) {
+			return nil, NewTemporaryError(fmt.Errorf("alt-DA data of commitment %x not found: %w", []byte(s.comm), err))
+		} else if err != nil {
+			return nil, NewTemporaryError(fmt.Errorf("failed to fetch alt-DA data of commitment %x: %w", []byte(s.comm), err))
+		}
+		comm := s.comm
+		s.comm = nil
+		if err := comm.Verify(input); err != nil {
+			s.log.Warn("Ignoring alt-DA data that does not match commitment", "commitment", comm, "err", err)
+			continue
+		}
+		return input, nil
+	}
+}
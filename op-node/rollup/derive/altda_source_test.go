@@ -0,0 +1,109 @@
+package derive
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/altda"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type stubDataIter struct {
+	data []eth.Data
+}
+
+func (s *stubDataIter) Next(ctx context.Context) (eth.Data, error) {
+	if len(s.data) == 0 {
+		return nil, io.EOF
+	}
+	data := s.data[0]
+	s.data = s.data[1:]
+	return data, nil
+}
+
+type stubAltDAFetcher struct {
+	inputs map[string][]byte
+	err    error
+}
+
+func (s *stubAltDAFetcher) GetInput(ctx context.Context, comm altda.Commitment) ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	input, ok := s.inputs[string(comm)]
+	if !ok {
+		return nil, altda.ErrNotFound
+	}
+	return input, nil
+}
+
+func TestAltDADataSource(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	frames := eth.Data{DerivationVersion0, 0xaa}
+	input := []byte{DerivationVersion0, 0xbb}
+	keccakComm := altda.NewKeccak256Commitment(input)
+	genericComm := altda.NewGenericCommitment([]byte{0xcc})
+
+	t.Run("ResolveCommitments", func(t *testing.T) {
+		src := &stubDataIter{data: []eth.Data{
+			keccakComm.TxData(),
+			frames,
+			{altda.TxDataVersion1, 0x05}, // invalid commitment
+			genericComm.TxData(),         // other commitment type
+			keccakComm.TxData(),
+		}}
+		fetcher := &stubAltDAFetcher{inputs: map[string][]byte{string(keccakComm): input, string(genericComm): input}}
+		ds := NewAltDADataSource(logger, src, fetcher, altda.Keccak256CommitmentType)
+
+		for _, expected := range []eth.Data{input, frames, input} {
+			data, err := ds.Next(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, expected, data)
+		}
+		_, err := ds.Next(context.Background())
+		require.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("SkipMismatchedData", func(t *testing.T) {
+		src := &stubDataIter{data: []eth.Data{keccakComm.TxData(), frames}}
+		fetcher := &stubAltDAFetcher{inputs: map[string][]byte{string(keccakComm): {0xff}}}
+		ds := NewAltDADataSource(logger, src, fetcher, altda.Keccak256CommitmentType)
+
+		data, err := ds.Next(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, frames, data)
+	})
+
+	t.Run("RetryUnavailableData", func(t *testing.T) {
+		src := &stubDataIter{data: []eth.Data{genericComm.TxData()}}
+		fetcher := &stubAltDAFetcher{inputs: map[string][]byte{}}
+		ds := NewAltDADataSource(logger, src, fetcher, altda.GenericCommitmentType)
+
+		_, err := ds.Next(context.Background())
+		require.ErrorIs(t, err, ErrTemporary)
+		require.ErrorIs(t, err, altda.ErrNotFound)
+
+		fetcher.err = errors.New("connection refused")
+		_, err = ds.Next(context.Background())
+		require.ErrorIs(t, err, ErrTemporary)
+
+		fetcher.err = nil
+		fetcher.inputs[string(genericComm)] = input
+		data, err := ds.Next(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, eth.Data(input), data)
+	})
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		src := &stubDataIter{data: []eth.Data{genericComm.TxData()}}
+		ds := NewAltDADataSource(logger, src, noAltDA{}, altda.GenericCommitmentType)
+		_, err := ds.Next(context.Background())
+		require.ErrorIs(t, err, ErrAltDANotConfigured)
+	})
+}
@@ -1,6 +1,8 @@
 package derive
 
 import (
+	"context"
+	"math/big"
 	"math/rand"
 	"testing"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum-optimism/optimism/op-service/testutils"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -174,13 +177,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A0,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1B,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash:   l2A1.ParentHash,
 					EpochNum:     rollup.Epoch(l2A1.L1Origin.Number),
 					EpochHash:    l2A1.L1Origin.Hash,
 					Timestamp:    l2A1.Time,
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchUndecided,
 		},
@@ -190,13 +193,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A0,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1B,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash:   l2A1.ParentHash,
 					EpochNum:     rollup.Epoch(l2A1.L1Origin.Number),
 					EpochHash:    l2A1.L1Origin.Hash,
 					Timestamp:    l2A1.Time + 1, // 1 too high
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchFuture,
 		},
@@ -206,13 +209,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A0,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1B,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash:   l2A1.ParentHash,
 					EpochNum:     rollup.Epoch(l2A1.L1Origin.Number),
 					EpochHash:    l2A1.L1Origin.Hash,
 					Timestamp:    l2A0.Time, // repeating the same time
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchDrop,
 		},
@@ -222,13 +225,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A0,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1B,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash:   l2A1.ParentHash,
 					EpochNum:     rollup.Epoch(l2A1.L1Origin.Number),
 					EpochHash:    l2A1.L1Origin.Hash,
 					Timestamp:    l2A1.Time - 1, // block time is 2, so this is 1 too low
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchDrop,
 		},
@@ -238,13 +241,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A0,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1B,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash:   testutils.RandomHash(rng),
 					EpochNum:     rollup.Epoch(l2A1.L1Origin.Number),
 					EpochHash:    l2A1.L1Origin.Hash,
 					Timestamp:    l2A1.Time,
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchDrop,
 		},
@@ -254,13 +257,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A0,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1F, // included in 5th block after epoch of batch, while seq window is 4
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash:   l2A1.ParentHash,
 					EpochNum:     rollup.Epoch(l2A1.L1Origin.Number),
 					EpochHash:    l2A1.L1Origin.Hash,
 					Timestamp:    l2A1.Time,
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchDrop,
 		},
@@ -270,13 +273,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2B0, // we already moved on to B
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1C,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash:   l2B0.Hash,                          // build on top of safe head to continue
 					EpochNum:     rollup.Epoch(l2A3.L1Origin.Number), // epoch A is no longer valid
 					EpochHash:    l2A3.L1Origin.Hash,
 					Timestamp:    l2B0.Time + conf.BlockTime, // pass the timestamp check to get too epoch check
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchDrop,
 		},
@@ -286,13 +289,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A3,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1C,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash:   l2B0.ParentHash,
 					EpochNum:     rollup.Epoch(l2B0.L1Origin.Number),
 					EpochHash:    l2B0.L1Origin.Hash,
 					Timestamp:    l2B0.Time,
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchUndecided,
 		},
@@ -302,13 +305,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A3,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1D,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash:   l2B0.ParentHash,
 					EpochNum:     rollup.Epoch(l1C.Number), // invalid, we need to adopt epoch B before C
 					EpochHash:    l1C.Hash,
 					Timestamp:    l2B0.Time,
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchDrop,
 		},
@@ -318,13 +321,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A3,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1C,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash:   l2B0.ParentHash,
 					EpochNum:     rollup.Epoch(l2B0.L1Origin.Number),
 					EpochHash:    l1A.Hash, // invalid, epoch hash should be l1B
 					Timestamp:    l2B0.Time,
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchDrop,
 		},
@@ -334,13 +337,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A3,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1B,
-				Batch: NewSingularBatchData(SingularBatch{ // we build l2A4, which has a timestamp of 2*4 = 8 higher than l2A0
+				Batch: &SingularBatch{ // we build l2A4, which has a timestamp of 2*4 = 8 higher than l2A0
 					ParentHash:   l2A4.ParentHash,
 					EpochNum:     rollup.Epoch(l2A4.L1Origin.Number),
 					EpochHash:    l2A4.L1Origin.Hash,
 					Timestamp:    l2A4.Time,
 					Transactions: []hexutil.Bytes{[]byte("sequencer should not include this tx")},
-				}),
+				},
 			},
 			Expected: BatchDrop,
 		},
@@ -350,13 +353,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2X0,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1Z,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash:   l2Y0.ParentHash,
 					EpochNum:     rollup.Epoch(l2Y0.L1Origin.Number),
 					EpochHash:    l2Y0.L1Origin.Hash,
 					Timestamp:    l2Y0.Time, // valid, but more than 6 ahead of l1Y.Time
 					Transactions: []hexutil.Bytes{[]byte("sequencer should not include this tx")},
-				}),
+				},
 			},
 			Expected: BatchDrop,
 		},
@@ -366,13 +369,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A3,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1BLate,
-				Batch: NewSingularBatchData(SingularBatch{ // l2A4 time < l1BLate time, so we cannot adopt origin B yet
+				Batch: &SingularBatch{ // l2A4 time < l1BLate time, so we cannot adopt origin B yet
 					ParentHash:   l2A4.ParentHash,
 					EpochNum:     rollup.Epoch(l2A4.L1Origin.Number),
 					EpochHash:    l2A4.L1Origin.Hash,
 					Timestamp:    l2A4.Time,
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchAccept, // accepted because empty & preserving L2 time invariant
 		},
@@ -382,13 +385,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2X0,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1Z,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash:   l2Y0.ParentHash,
 					EpochNum:     rollup.Epoch(l2Y0.L1Origin.Number),
 					EpochHash:    l2Y0.L1Origin.Hash,
 					Timestamp:    l2Y0.Time, // valid, but more than 6 ahead of l1Y.Time
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchAccept, // accepted because empty & still advancing epoch
 		},
@@ -398,13 +401,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A3,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1B,
-				Batch: NewSingularBatchData(SingularBatch{ // we build l2A4, which has a timestamp of 2*4 = 8 higher than l2A0
+				Batch: &SingularBatch{ // we build l2A4, which has a timestamp of 2*4 = 8 higher than l2A0
 					ParentHash:   l2A4.ParentHash,
 					EpochNum:     rollup.Epoch(l2A4.L1Origin.Number),
 					EpochHash:    l2A4.L1Origin.Hash,
 					Timestamp:    l2A4.Time,
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchUndecided, // we have to wait till the next epoch is in sight to check the time
 		},
@@ -414,13 +417,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A3,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1C,
-				Batch: NewSingularBatchData(SingularBatch{ // we build l2A4, which has a timestamp of 2*4 = 8 higher than l2A0
+				Batch: &SingularBatch{ // we build l2A4, which has a timestamp of 2*4 = 8 higher than l2A0
 					ParentHash:   l2A4.ParentHash,
 					EpochNum:     rollup.Epoch(l2A4.L1Origin.Number),
 					EpochHash:    l2A4.L1Origin.Hash,
 					Timestamp:    l2A4.Time,
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchDrop, // dropped because it could have advanced the epoch to B
 		},
@@ -430,7 +433,7 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A0,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1B,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash: l2A1.ParentHash,
 					EpochNum:   rollup.Epoch(l2A1.L1Origin.Number),
 					EpochHash:  l2A1.L1Origin.Hash,
@@ -438,7 +441,7 @@ func TestValidBatch(t *testing.T) {
 					Transactions: []hexutil.Bytes{
 						[]byte{}, // empty tx data
 					},
-				}),
+				},
 			},
 			Expected: BatchDrop,
 		},
@@ -448,7 +451,7 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A0,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1B,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash: l2A1.ParentHash,
 					EpochNum:   rollup.Epoch(l2A1.L1Origin.Number),
 					EpochHash:  l2A1.L1Origin.Hash,
@@ -456,7 +459,7 @@ func TestValidBatch(t *testing.T) {
 					Transactions: []hexutil.Bytes{
 						[]byte{types.DepositTxType, 0}, // piece of data alike to a deposit
 					},
-				}),
+				},
 			},
 			Expected: BatchDrop,
 		},
@@ -466,7 +469,7 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A0,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1B,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash: l2A1.ParentHash,
 					EpochNum:   rollup.Epoch(l2A1.L1Origin.Number),
 					EpochHash:  l2A1.L1Origin.Hash,
@@ -475,7 +478,7 @@ func TestValidBatch(t *testing.T) {
 						[]byte{0x02, 0x42, 0x13, 0x37},
 						[]byte{0x02, 0xde, 0xad, 0xbe, 0xef},
 					},
-				}),
+				},
 			},
 			Expected: BatchAccept,
 		},
@@ -485,7 +488,7 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A3,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1C,
-				Batch: NewSingularBatchData(SingularBatch{
+				Batch: &SingularBatch{
 					ParentHash: l2B0.ParentHash,
 					EpochNum:   rollup.Epoch(l2B0.L1Origin.Number),
 					EpochHash:  l2B0.L1Origin.Hash,
@@ -494,7 +497,7 @@ func TestValidBatch(t *testing.T) {
 						[]byte{0x02, 0x42, 0x13, 0x37},
 						[]byte{0x02, 0xde, 0xad, 0xbe, 0xef},
 					},
-				}),
+				},
 			},
 			Expected: BatchAccept,
 		},
@@ -504,13 +507,13 @@ func TestValidBatch(t *testing.T) {
 			L2SafeHead: l2A2,
 			Batch: BatchWithL1InclusionBlock{
 				L1InclusionBlock: l1B,
-				Batch: NewSingularBatchData(SingularBatch{ // we build l2B0', which starts a new epoch too early
+				Batch: &SingularBatch{ // we build l2B0', which starts a new epoch too early
 					ParentHash:   l2A2.Hash,
 					EpochNum:     rollup.Epoch(l2B0.L1Origin.Number),
 					EpochHash:    l2B0.L1Origin.Hash,
 					Timestamp:    l2A2.Time + conf.BlockTime,
 					Transactions: nil,
-				}),
+				},
 			},
 			Expected: BatchDrop,
 		},
@@ -521,8 +524,266 @@ func TestValidBatch(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			validity := CheckBatch(&conf, logger, testCase.L1Blocks, testCase.L2SafeHead, &testCase.Batch)
+			ctx := context.Background()
+			validity := CheckBatch(ctx, &conf, logger, testCase.L1Blocks, testCase.L2SafeHead, &testCase.Batch, nil)
 			require.Equal(t, testCase.Expected, validity, "batch check must return expected validity level")
 		})
 	}
 }
+
+type ValidSpanBatchTestCase struct {
+	Name       string
+	L1Blocks   []eth.L1BlockRef
+	L2SafeHead eth.L2BlockRef
+	Batch      BatchWithL1InclusionBlock
+	Expected   BatchValidity
+	// ExpectL2 registers the safe chain lookups the check is expected to make, if any.
+	ExpectL2 func(l2 *testutils.MockL2Client)
+}
+
+func TestValidSpanBatch(t *testing.T) {
+	rng := rand.New(rand.NewSource(5678))
+	l1A := testutils.RandomBlockRef(rng)
+	l1B := eth.L1BlockRef{
+		Hash:       testutils.RandomHash(rng),
+		Number:     l1A.Number + 1,
+		ParentHash: l1A.Hash,
+		Time:       l1A.Time + 7,
+	}
+	l1C := eth.L1BlockRef{
+		Hash:       testutils.RandomHash(rng),
+		Number:     l1B.Number + 1,
+		ParentHash: l1B.Hash,
+		Time:       l1B.Time + 7,
+	}
+
+	conf := rollup.Config{
+		Genesis: rollup.Genesis{
+			L2Time: 31,
+		},
+		BlockTime:         2,
+		SeqWindowSize:     4,
+		MaxSequencerDrift: 6,
+		SpanBatchTime:     &l1B.Time,
+	}
+
+	l2A0 := eth.L2BlockRef{
+		Hash:           testutils.RandomHash(rng),
+		Number:         100,
+		ParentHash:     testutils.RandomHash(rng),
+		Time:           l1A.Time,
+		L1Origin:       l1A.ID(),
+		SequenceNumber: 0,
+	}
+	l2A1 := eth.L2BlockRef{
+		Hash:           testutils.RandomHash(rng),
+		Number:         l2A0.Number + 1,
+		ParentHash:     l2A0.Hash,
+		Time:           l2A0.Time + conf.BlockTime,
+		L1Origin:       l1A.ID(),
+		SequenceNumber: 1,
+	}
+
+	// singular batches building l2A1, l2A2, l2A3 and l2B0 on top of l2A0
+	txA1 := hexutil.Bytes{0x02, 0xa1}
+	batchA1 := &SingularBatch{ParentHash: l2A0.Hash, EpochNum: rollup.Epoch(l1A.Number), EpochHash: l1A.Hash, Timestamp: l2A0.Time + 2, Transactions: []hexutil.Bytes{txA1}}
+	batchA2 := &SingularBatch{EpochNum: rollup.Epoch(l1A.Number), EpochHash: l1A.Hash, Timestamp: l2A0.Time + 4}
+	batchA3 := &SingularBatch{EpochNum: rollup.Epoch(l1A.Number), EpochHash: l1A.Hash, Timestamp: l2A0.Time + 6}
+	batchB0 := &SingularBatch{EpochNum: rollup.Epoch(l1B.Number), EpochHash: l1B.Hash, Timestamp: l2A0.Time + 8, Transactions: []hexutil.Bytes{{0x02, 0xb0}}}
+
+	withParent := func(parent common.Hash, b *SingularBatch) *SingularBatch {
+		cpy := *b
+		cpy.ParentHash = parent
+		return &cpy
+	}
+	withEpochHash := func(hash common.Hash, b *SingularBatch) *SingularBatch {
+		cpy := *b
+		cpy.EpochHash = hash
+		return &cpy
+	}
+
+	// payload of the safe block l2A1, as it is returned by the execution engine
+	infoTx, err := L1InfoDepositBytes(l2A1.SequenceNumber, &testutils.MockBlockInfo{
+		InfoHash:    l1A.Hash,
+		InfoNum:     l1A.Number,
+		InfoTime:    l1A.Time,
+		InfoBaseFee: big.NewInt(7),
+	}, eth.SystemConfig{}, false)
+	require.NoError(t, err)
+	payloadA1 := &eth.ExecutionPayload{
+		ParentHash:   l2A1.ParentHash,
+		BlockNumber:  hexutil.Uint64(l2A1.Number),
+		Timestamp:    hexutil.Uint64(l2A1.Time),
+		BlockHash:    l2A1.Hash,
+		Transactions: []eth.Data{infoTx, eth.Data(txA1)},
+	}
+	payloadA1Mismatch := &eth.ExecutionPayload{
+		ParentHash:   l2A1.ParentHash,
+		BlockNumber:  hexutil.Uint64(l2A1.Number),
+		Timestamp:    hexutil.Uint64(l2A1.Time),
+		BlockHash:    l2A1.Hash,
+		Transactions: []eth.Data{infoTx, eth.Data{0x02, 0xff}},
+	}
+
+	testCases := []ValidSpanBatchTestCase{
+		{
+			Name:       "missing L1 info",
+			L1Blocks:   []eth.L1BlockRef{},
+			L2SafeHead: l2A0,
+			Batch: BatchWithL1InclusionBlock{
+				L1InclusionBlock: l1B,
+				Batch:            NewSpanBatch([]*SingularBatch{batchA1}),
+			},
+			Expected: BatchUndecided,
+		},
+		{
+			Name:       "empty span batch",
+			L1Blocks:   []eth.L1BlockRef{l1A, l1B},
+			L2SafeHead: l2A0,
+			Batch: BatchWithL1InclusionBlock{
+				L1InclusionBlock: l1B,
+				Batch:            NewSpanBatch(nil),
+			},
+			Expected: BatchDrop,
+		},
+		{
+			Name:       "span batch before hard fork",
+			L1Blocks:   []eth.L1BlockRef{l1A, l1B},
+			L2SafeHead: l2A0,
+			Batch: BatchWithL1InclusionBlock{
+				L1InclusionBlock: l1A,
+				Batch:            NewSpanBatch([]*SingularBatch{batchA1}),
+			},
+			Expected: BatchDrop,
+		},
+		{
+			Name:       "valid span batch",
+			L1Blocks:   []eth.L1BlockRef{l1A, l1B, l1C},
+			L2SafeHead: l2A0,
+			Batch: BatchWithL1InclusionBlock{
+				L1InclusionBlock: l1B,
+				Batch:            NewSpanBatch([]*SingularBatch{batchA1, batchA2, batchA3, batchB0}),
+			},
+			Expected: BatchAccept,
+		},
+		{
+			Name:       "future span batch",
+			L1Blocks:   []eth.L1BlockRef{l1A, l1B, l1C},
+			L2SafeHead: l2A0,
+			Batch: BatchWithL1InclusionBlock{
+				L1InclusionBlock: l1B,
+				Batch:            NewSpanBatch([]*SingularBatch{withParent(l2A1.Hash, batchA2), batchA3}),
+			},
+			Expected: BatchFuture,
+		},
+		{
+			Name:       "span batch with mismatching parent hash",
+			L1Blocks:   []eth.L1BlockRef{l1A, l1B, l1C},
+			L2SafeHead: l2A0,
+			Batch: BatchWithL1InclusionBlock{
+				L1InclusionBlock: l1B,
+				Batch:            NewSpanBatch([]*SingularBatch{withParent(testutils.RandomHash(rng), batchA1), batchA2}),
+			},
+			Expected: BatchDrop,
+		},
+		{
+			Name:       "span batch with missing L1 origin",
+			L1Blocks:   []eth.L1BlockRef{l1A},
+			L2SafeHead: l2A0,
+			Batch: BatchWithL1InclusionBlock{
+				L1InclusionBlock: l1B,
+				Batch:            NewSpanBatch([]*SingularBatch{batchA1, batchA2, batchA3, batchB0}),
+			},
+			Expected: BatchUndecided,
+		},
+		{
+			Name:       "span batch with mismatching L1 origin hash",
+			L1Blocks:   []eth.L1BlockRef{l1A, l1B, l1C},
+			L2SafeHead: l2A0,
+			Batch: BatchWithL1InclusionBlock{
+				L1InclusionBlock: l1B,
+				Batch:            NewSpanBatch([]*SingularBatch{batchA1, batchA2, batchA3, withEpochHash(testutils.RandomHash(rng), batchB0)}),
+			},
+			Expected: BatchDrop,
+		},
+		{
+			Name:       "span batch exceeding sequencer time drift",
+			L1Blocks:   []eth.L1BlockRef{l1A, l1B, l1C},
+			L2SafeHead: l2A0,
+			Batch: BatchWithL1InclusionBlock{
+				L1InclusionBlock: l1B,
+				Batch: NewSpanBatch([]*SingularBatch{batchA1, batchA2, batchA3,
+					{EpochNum: rollup.Epoch(l1A.Number), EpochHash: l1A.Hash, Timestamp: l2A0.Time + 8, Transactions: []hexutil.Bytes{{0x02, 0xa4}}}}),
+			},
+			Expected: BatchDrop,
+		},
+		{
+			Name:       "span batch with deposit tx",
+			L1Blocks:   []eth.L1BlockRef{l1A, l1B, l1C},
+			L2SafeHead: l2A0,
+			Batch: BatchWithL1InclusionBlock{
+				L1InclusionBlock: l1B,
+				Batch: NewSpanBatch([]*SingularBatch{batchA1,
+					{EpochNum: rollup.Epoch(l1A.Number), EpochHash: l1A.Hash, Timestamp: l2A0.Time + 4, Transactions: []hexutil.Bytes{{types.DepositTxType, 0x01}}}}),
+			},
+			Expected: BatchDrop,
+		},
+		{
+			Name:       "overlapping span batch",
+			L1Blocks:   []eth.L1BlockRef{l1A, l1B, l1C},
+			L2SafeHead: l2A1,
+			Batch: BatchWithL1InclusionBlock{
+				L1InclusionBlock: l1B,
+				Batch:            NewSpanBatch([]*SingularBatch{batchA1, batchA2, batchA3}),
+			},
+			ExpectL2: func(l2 *testutils.MockL2Client) {
+				l2.ExpectL2BlockRefByNumber(l2A0.Number, l2A0, nil)
+				l2.ExpectPayloadByNumber(l2A1.Number, payloadA1, nil)
+			},
+			Expected: BatchAccept,
+		},
+		{
+			Name:       "overlapping span batch with mismatching txs",
+			L1Blocks:   []eth.L1BlockRef{l1A, l1B, l1C},
+			L2SafeHead: l2A1,
+			Batch: BatchWithL1InclusionBlock{
+				L1InclusionBlock: l1B,
+				Batch:            NewSpanBatch([]*SingularBatch{batchA1, batchA2, batchA3}),
+			},
+			ExpectL2: func(l2 *testutils.MockL2Client) {
+				l2.ExpectL2BlockRefByNumber(l2A0.Number, l2A0, nil)
+				l2.ExpectPayloadByNumber(l2A1.Number, payloadA1Mismatch, nil)
+			},
+			Expected: BatchDrop,
+		},
+		{
+			Name:       "overlapping span batch with unavailable safe chain",
+			L1Blocks:   []eth.L1BlockRef{l1A, l1B, l1C},
+			L2SafeHead: l2A1,
+			Batch: BatchWithL1InclusionBlock{
+				L1InclusionBlock: l1B,
+				Batch:            NewSpanBatch([]*SingularBatch{batchA1, batchA2, batchA3}),
+			},
+			ExpectL2: func(l2 *testutils.MockL2Client) {
+				l2.ExpectL2BlockRefByNumber(l2A0.Number, eth.L2BlockRef{}, ethereum.NotFound)
+			},
+			Expected: BatchUndecided,
+		},
+	}
+
+	// Log level can be increased for debugging purposes
+	logger := testlog.Logger(t, log.LvlError)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ctx := context.Background()
+			l2Client := &testutils.MockL2Client{}
+			if testCase.ExpectL2 != nil {
+				testCase.ExpectL2(l2Client)
+			}
+			validity := CheckBatch(ctx, &conf, logger, testCase.L1Blocks, testCase.L2SafeHead, &testCase.Batch, l2Client)
+			require.Equal(t, testCase.Expected, validity, "batch check must return expected validity level")
+			l2Client.Mock.AssertExpectations(t)
+		})
+	}
+}
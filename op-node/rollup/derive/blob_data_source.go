@@ -0,0 +1,172 @@
+package derive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// L1BlobsFetcher fetches the blobs of an L1 block, and verifies them against their versioned hashes,
+// see sources.L1BeaconClient.
+type L1BlobsFetcher interface {
+	GetBlobs(ctx context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.Blob, error)
+}
+
+var ErrBlobsNotConfigured = errors.New("blobs fetcher not configured")
+
+// noBlobs is the L1BlobsFetcher of nodes that do not have a beacon endpoint configured.
+type noBlobs struct{}
+
+func (noBlobs) GetBlobs(ctx context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.Blob, error) {
+	return nil, ErrBlobsNotConfigured
+}
+
+// blobOrCalldata is the data of a batcher transaction, which is either calldata, or a blob that is not fetched yet.
+type blobOrCalldata struct {
+	calldata eth.Data
+	blob     *eth.Blob
+	hash     *eth.IndexedBlobHash
+}
+
+// BlobDataSource fetches the batch data of an L1 block once blobs are enabled: the blobs of blob transactions,
+// and the calldata of other transactions, from the batcher to the batch inbox.
+// Like the DataSource, the constructor never fails, and fetching is retried on the next call to Next.
+type BlobDataSource struct {
+	data []eth.Data
+	open bool
+
+	ref          eth.L1BlockRef
+	cfg          *rollup.Config
+	fetcher      L1TransactionFetcher
+	blobsFetcher L1BlobsFetcher
+	log          log.Logger
+	batcherAddr  common.Address
+}
+
+func NewBlobDataSource(ctx context.Context, log log.Logger, cfg *rollup.Config, fetcher L1TransactionFetcher, blobsFetcher L1BlobsFetcher, ref eth.L1BlockRef, batcherAddr common.Address) DataIter {
+	ds := &BlobDataSource{
+		ref:          ref,
+		cfg:          cfg,
+		fetcher:      fetcher,
+		blobsFetcher: blobsFetcher,
+		log:          log.New("origin", ref),
+		batcherAddr:  batcherAddr,
+	}
+	if data, err := ds.fetchData(ctx); err == nil {
+		ds.open = true
+		ds.data = data
+	}
+	return ds
+}
+
+// Next returns the next piece of data. It returns a ResetError if the L1 block cannot be found,
+// and a temporary error if the block or its blobs cannot be fetched.
+func (ds *BlobDataSource) Next(ctx context.Context) (eth.Data, error) {
+	if !ds.open {
+		data, err := ds.fetchData(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ds.open = true
+		ds.data = data
+	}
+	if len(ds.data) == 0 {
+		return nil, io.EOF
+	}
+	data := ds.data[0]
+	ds.data = ds.data[1:]
+	return data, nil
+}
+
+// fetchData fetches the transactions of the L1 block, and the blobs of the batcher transactions.
+// Blobs that do not decode are skipped, like invalid calldata.
+func (ds *BlobDataSource) fetchData(ctx context.Context) ([]eth.Data, error) {
+	_, txs, err := ds.fetcher.InfoAndTxsByHash(ctx, ds.ref.Hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, NewResetError(fmt.Errorf("failed to open blob data source: %w", err))
+	} else if err != nil {
+		return nil, NewTemporaryError(fmt.Errorf("failed to open blob data source: %w", err))
+	}
+	txData, hashes := blobDataFromEVMTransactions(ds.cfg, ds.batcherAddr, txs, ds.log)
+	if len(hashes) > 0 {
+		// The blobs of a block that was found must be available, so a missing blob is not a reason to reset.
+		blobs, err := ds.blobsFetcher.GetBlobs(ctx, ds.ref, hashes)
+		if err != nil {
+			return nil, NewTemporaryError(fmt.Errorf("failed to fetch blobs: %w", err))
+		}
+		if len(blobs) != len(hashes) {
+			return nil, NewTemporaryError(fmt.Errorf("expected %d blobs, got %d", len(hashes), len(blobs)))
+		}
+		next := 0
+		for i := range txData {
+			if txData[i].hash != nil {
+				txData[i].blob = blobs[next]
+				next++
+			}
+		}
+	}
+
+	var out []eth.Data
+	for _, d := range txData {
+		if d.blob == nil {
+			out = append(out, d.calldata)
+			continue
+		}
+		data, err := d.blob.ToData()
+		if err != nil {
+			ds.log.Warn("Ignoring blob that does not decode", "index", d.hash.Index, "hash", d.hash.Hash, "err", err)
+			continue
+		}
+		out = append(out, data)
+	}
+	return out, nil
+}
+
+// blobDataFromEVMTransactions returns the data of the batcher transactions to the batch inbox, in order.
+// The blobs of blob transactions are referenced by their versioned hashes, and their index in the block,
+// which counts the blobs of all blob transactions in the block. The calldata of blob transactions is ignored.
+func blobDataFromEVMTransactions(config *rollup.Config, batcherAddr common.Address, txs types.Transactions, log log.Logger) ([]blobOrCalldata, []eth.IndexedBlobHash) {
+	var out []blobOrCalldata
+	var hashes []eth.IndexedBlobHash
+	l1Signer := types.NewCancunSigner(config.L1ChainID)
+	blobIndex := uint64(0)
+	for j, tx := range txs {
+		// the blob index counts the blobs of all transactions, not just of the batcher transactions
+		txBlobIndex := blobIndex
+		blobIndex += uint64(len(tx.BlobHashes()))
+		if to := tx.To(); to == nil || *to != config.BatchInboxAddress {
+			continue
+		}
+		seqDataSubmitter, err := l1Signer.Sender(tx) // optimization: only derive sender if To is correct
+		if err != nil {
+			log.Warn("tx in inbox with invalid signature", "index", j, "err", err)
+			continue // bad signature, ignore
+		}
+		if seqDataSubmitter != batcherAddr {
+			log.Warn("tx in inbox with unauthorized submitter", "index", j)
+			continue // not an authorized batch submitter, ignore
+		}
+		if tx.Type() != types.BlobTxType {
+			out = append(out, blobOrCalldata{calldata: tx.Data()})
+			continue
+		}
+		if len(tx.Data()) > 0 {
+			log.Warn("blob tx has calldata, which is ignored", "index", j)
+		}
+		for i, h := range tx.BlobHashes() {
+			hash := eth.IndexedBlobHash{Index: txBlobIndex + uint64(i), Hash: h}
+			hashes = append(hashes, hash)
+			out = append(out, blobOrCalldata{hash: &hash})
+		}
+	}
+	return out, hashes
+}
@@ -0,0 +1,165 @@
+package derive
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"io"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+// stubBlobsFetcher returns the blobs by versioned hash, and records the requested hashes.
+type stubBlobsFetcher struct {
+	blobs     map[common.Hash]*eth.Blob
+	err       error
+	requested []eth.IndexedBlobHash
+}
+
+func (s *stubBlobsFetcher) GetBlobs(ctx context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.Blob, error) {
+	s.requested = append(s.requested, hashes...)
+	if s.err != nil {
+		return nil, s.err
+	}
+	out := make([]*eth.Blob, 0, len(hashes))
+	for _, h := range hashes {
+		out = append(out, s.blobs[h.Hash])
+	}
+	return out, nil
+}
+
+func blobTx(t *testing.T, signer types.Signer, key *ecdsa.PrivateKey, to common.Address, hashes []common.Hash, data []byte) *types.Transaction {
+	t.Helper()
+	tx, err := types.SignNewTx(key, signer, &types.BlobTx{
+		ChainID:    uint256.MustFromBig(signer.ChainID()),
+		GasTipCap:  uint256.NewInt(2 * params.GWei),
+		GasFeeCap:  uint256.NewInt(30 * params.GWei),
+		Gas:        100_000,
+		To:         to,
+		Data:       data,
+		BlobFeeCap: uint256.NewInt(params.GWei),
+		BlobHashes: hashes,
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+func TestBlobDataSource(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	batcherPriv := testutils.RandomKey()
+	otherPriv := testutils.RandomKey()
+	cfg := &rollup.Config{
+		L1ChainID:         big.NewInt(100),
+		BatchInboxAddress: testutils.RandomAddress(rng),
+	}
+	batcherAddr := crypto.PubkeyToAddress(batcherPriv.PublicKey)
+	signer := types.NewCancunSigner(cfg.L1ChainID)
+	info := testutils.RandomBlockInfo(rng)
+	ref := eth.InfoToL1BlockRef(info)
+
+	blobData := []eth.Data{testutils.RandomData(rng, 1000), testutils.RandomData(rng, 2000)}
+	blobs := make(map[common.Hash]*eth.Blob)
+	hashes := make([]common.Hash, len(blobData))
+	for i, d := range blobData {
+		var b eth.Blob
+		require.NoError(t, b.FromData(d))
+		hashes[i] = testutils.RandomHash(rng)
+		blobs[hashes[i]] = &b
+	}
+	calldata := testutils.RandomData(rng, 500)
+	calldataTx := (&testTx{to: &cfg.BatchInboxAddress, dataLen: len(calldata), author: batcherPriv}).Create(t, signer, rng)
+
+	txs := types.Transactions{
+		// blobs of other transactions count towards the blob index
+		blobTx(t, signer, otherPriv, cfg.BatchInboxAddress, []common.Hash{testutils.RandomHash(rng)}, nil),
+		blobTx(t, signer, batcherPriv, cfg.BatchInboxAddress, hashes[:1], []byte{0x01, 0x02}),
+		calldataTx,
+		blobTx(t, signer, batcherPriv, cfg.BatchInboxAddress, hashes[1:], nil),
+	}
+
+	t.Run("BlobsAndCalldata", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		l1.ExpectInfoAndTxsByHash(ref.Hash, info, txs, nil)
+		fetcher := &stubBlobsFetcher{blobs: blobs}
+		src := NewBlobDataSource(context.Background(), testlog.Logger(t, log.LvlInfo), cfg, l1, fetcher, ref, batcherAddr)
+
+		require.Equal(t, []eth.IndexedBlobHash{{Index: 1, Hash: hashes[0]}, {Index: 2, Hash: hashes[1]}}, fetcher.requested)
+		for _, expected := range []eth.Data{blobData[0], calldataTx.Data(), blobData[1]} {
+			data, err := src.Next(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, expected, data)
+		}
+		_, err := src.Next(context.Background())
+		require.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("SkipUndecodableBlob", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		l1.ExpectInfoAndTxsByHash(ref.Hash, info, txs, nil)
+		bad := eth.Blob{0xff}
+		fetcher := &stubBlobsFetcher{blobs: map[common.Hash]*eth.Blob{hashes[0]: &bad, hashes[1]: blobs[hashes[1]]}}
+		src := NewBlobDataSource(context.Background(), testlog.Logger(t, log.LvlInfo), cfg, l1, fetcher, ref, batcherAddr)
+
+		for _, expected := range []eth.Data{calldataTx.Data(), blobData[1]} {
+			data, err := src.Next(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, expected, data)
+		}
+		_, err := src.Next(context.Background())
+		require.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("RetryBlobsFetch", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		// fetched when the source is created, and on each retry
+		for i := 0; i < 3; i++ {
+			l1.ExpectInfoAndTxsByHash(ref.Hash, info, txs, nil)
+		}
+		fetcher := &stubBlobsFetcher{blobs: blobs, err: errors.New("beacon unavailable")}
+		src := NewBlobDataSource(context.Background(), testlog.Logger(t, log.LvlInfo), cfg, l1, fetcher, ref, batcherAddr)
+
+		_, err := src.Next(context.Background())
+		require.ErrorIs(t, err, ErrTemporary)
+
+		fetcher.err = nil
+		data, err := src.Next(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, blobData[0], data)
+	})
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		l1.ExpectInfoAndTxsByHash(ref.Hash, info, txs, nil)
+		l1.ExpectInfoAndTxsByHash(ref.Hash, info, txs, nil)
+		src := NewBlobDataSource(context.Background(), testlog.Logger(t, log.LvlInfo), cfg, l1, noBlobs{}, ref, batcherAddr)
+
+		_, err := src.Next(context.Background())
+		require.ErrorIs(t, err, ErrTemporary)
+		require.ErrorIs(t, err, ErrBlobsNotConfigured)
+	})
+
+	t.Run("NoBlobs", func(t *testing.T) {
+		l1 := &testutils.MockL1Source{}
+		l1.ExpectInfoAndTxsByHash(ref.Hash, info, types.Transactions{calldataTx}, nil)
+		src := NewBlobDataSource(context.Background(), testlog.Logger(t, log.LvlInfo), cfg, l1, noBlobs{}, ref, batcherAddr)
+
+		data, err := src.Next(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, eth.Data(calldataTx.Data()), data)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/altda"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
@@ -27,18 +28,39 @@ type L1TransactionFetcher interface {
 // batch submitter transactions.
 // This is not a stage in the pipeline, but a wrapper for another stage in the pipeline
 type DataSourceFactory struct {
-	log     log.Logger
-	cfg     *rollup.Config
-	fetcher L1TransactionFetcher
+	log          log.Logger
+	cfg          *rollup.Config
+	fetcher      L1TransactionFetcher
+	blobsFetcher L1BlobsFetcher
+	altDA        AltDAFetcher
 }
 
-func NewDataSourceFactory(log log.Logger, cfg *rollup.Config, fetcher L1TransactionFetcher) *DataSourceFactory {
-	return &DataSourceFactory{log: log, cfg: cfg, fetcher: fetcher}
+// NewDataSourceFactory creates the data source of the pipeline. The blobs fetcher is used once blobs are enabled,
+// and the alt-DA fetcher resolves the commitments of chains with an alt-DA config. Both may be nil if unused.
+func NewDataSourceFactory(log log.Logger, cfg *rollup.Config, fetcher L1TransactionFetcher, blobsFetcher L1BlobsFetcher, altDA AltDAFetcher) *DataSourceFactory {
+	if blobsFetcher == nil {
+		blobsFetcher = noBlobs{}
+	}
+	if altDA == nil {
+		altDA = noAltDA{}
+	}
+	return &DataSourceFactory{log: log, cfg: cfg, fetcher: fetcher, blobsFetcher: blobsFetcher, altDA: altDA}
 }
 
 // OpenData returns a DataIter. This struct implements the `Next` function.
-func (ds *DataSourceFactory) OpenData(ctx context.Context, id eth.BlockID, batcherAddr common.Address) DataIter {
-	return NewDataSource(ctx, ds.log, ds.cfg, ds.fetcher, id, batcherAddr)
+func (ds *DataSourceFactory) OpenData(ctx context.Context, ref eth.L1BlockRef, batcherAddr common.Address) DataIter {
+	var src DataIter
+	if ds.cfg.IsBlobsEnabled(ref.Time) {
+		src = NewBlobDataSource(ctx, ds.log, ds.cfg, ds.fetcher, ds.blobsFetcher, ref, batcherAddr)
+	} else {
+		src = NewDataSource(ctx, ds.log, ds.cfg, ds.fetcher, ref.ID(), batcherAddr)
+	}
+	if ds.cfg.AltDA != nil {
+		// the commitment type is checked when the rollup config is loaded
+		commType, _ := altda.ParseCommitmentType(ds.cfg.AltDA.CommitmentType)
+		return NewAltDADataSource(ds.log.New("origin", ref), src, ds.altDA, commType)
+	}
+	return src
 }
 
 // DataSource is a fault tolerant approach to fetching data.
@@ -2,7 +2,6 @@ package derive
 
 import (
 	"bytes"
-	"compress/zlib"
 	"fmt"
 	"io"
 
@@ -146,25 +145,24 @@ func (ch *Channel) Reader() io.Reader {
 
 // BatchReader provides a function that iteratively consumes batches from the reader.
 // The L1Inclusion block is also provided at creation time.
-func BatchReader(cfg *rollup.Config, r io.Reader, l1InclusionBlock eth.L1BlockRef) (func() (BatchWithL1InclusionBlock, error), error) {
+// The channel is decompressed with zlib, or, once channel compression is active at the L1 inclusion block,
+// with the algorithm that the first byte of the channel identifies.
+func BatchReader(cfg *rollup.Config, r io.Reader, l1InclusionBlock eth.L1BlockRef) (func() (*BatchData, error), error) {
 	// Setup decompressor stage + RLP reader
-	zr, err := zlib.NewReader(r)
+	zr, err := newChannelDecompressor(r, cfg.IsChannelCompression(l1InclusionBlock.Time))
 	if err != nil {
 		return nil, err
 	}
 	rlpReader := rlp.NewStream(zr, MaxRLPBytesPerChannel)
 	// Read each batch iteratively
-	return func() (BatchWithL1InclusionBlock, error) {
-		ret := BatchWithL1InclusionBlock{
-			L1InclusionBlock: l1InclusionBlock,
+	return func() (*BatchData, error) {
+		var batchData BatchData
+		if err := rlpReader.Decode(&batchData); err != nil {
+			return nil, err
 		}
-		err := rlpReader.Decode(&ret.Batch)
-		if err != nil {
-			return ret, err
+		if batchData.BatchType == SpanBatchType && !cfg.IsSpanBatch(l1InclusionBlock.Time) {
+			return nil, fmt.Errorf("cannot accept span-batch in L1 block with time %d", l1InclusionBlock.Time)
 		}
-		if ret.Batch.BatchType == SpanBatchType && !cfg.IsSpanBatch(ret.L1InclusionBlock.Time) {
-			return ret, fmt.Errorf("cannot accept span-batch in L1 block with time %d", ret.L1InclusionBlock.Time)
-		}
-		return ret, nil
+		return &batchData, nil
 	}, nil
 }
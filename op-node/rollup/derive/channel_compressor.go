@@ -0,0 +1,204 @@
+package derive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+)
+
+// CompressionAlgo is the algorithm that the data of a channel is compressed with.
+type CompressionAlgo string
+
+const (
+	Zlib   CompressionAlgo = "zlib"
+	Brotli CompressionAlgo = "brotli"
+	Zstd   CompressionAlgo = "zstd"
+)
+
+var CompressionAlgos = []CompressionAlgo{Zlib, Brotli, Zstd}
+
+func (a CompressionAlgo) String() string {
+	return string(a)
+}
+
+// ValidCompressionAlgo returns true if the algorithm is one of the CompressionAlgos.
+func ValidCompressionAlgo(algo CompressionAlgo) bool {
+	for _, a := range CompressionAlgos {
+		if a == algo {
+			return true
+		}
+	}
+	return false
+}
+
+// Channels that are not compressed with zlib start with a version byte that identifies the algorithm.
+// The first byte of zlib data is the CMF byte, of which the low nibble is the compression method 8 (or the
+// reserved 15), so it never collides with these.
+const (
+	ChannelVersionBrotli byte = 0x01
+	ChannelVersionZstd   byte = 0x02
+)
+
+const (
+	zlibCM8  = 8
+	zlibCM15 = 15
+
+	zstdMaxWindow = 8 << 20
+)
+
+// CheckCompressionLevel returns an error if the level is not valid for the algorithm.
+// Level 0 selects the default level of the algorithm.
+func CheckCompressionLevel(algo CompressionAlgo, level int) error {
+	if level == 0 {
+		return nil
+	}
+	var min, max int
+	switch algo {
+	case Zlib:
+		min, max = zlib.BestSpeed, zlib.BestCompression
+	case Brotli:
+		min, max = brotli.BestSpeed, brotli.BestCompression
+	case Zstd:
+		min, max = 1, 22
+	default:
+		return fmt.Errorf("unknown compression algorithm: %q", algo)
+	}
+	if level < min || level > max {
+		return fmt.Errorf("invalid %s compression level %d, must be in [%d, %d]", algo, level, min, max)
+	}
+	return nil
+}
+
+// ChannelCompressor compresses the data of a channel into a buffer, of which the compressed data is read.
+type ChannelCompressor interface {
+	io.Writer
+	io.Closer
+	io.Reader
+	// Flush flushes any buffered data to the compressed buffer.
+	Flush() error
+	// Reset discards the compressed data, and starts compressing a new channel.
+	Reset()
+	// Len returns the length of the compressed data, including the version byte, if any.
+	Len() int
+}
+
+type compressWriter interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+type channelCompressor struct {
+	buf      bytes.Buffer
+	compress compressWriter
+	// version is written before the compressed data, unless the algorithm is zlib
+	version []byte
+}
+
+// NewChannelCompressor creates a ChannelCompressor for the algorithm, at the given level, or at the default
+// level of the algorithm if the level is 0. The default zlib level is zlib.BestCompression, which is the
+// level that channels were always compressed with.
+func NewChannelCompressor(algo CompressionAlgo, level int) (ChannelCompressor, error) {
+	if err := CheckCompressionLevel(algo, level); err != nil {
+		return nil, err
+	}
+	c := &channelCompressor{}
+	switch algo {
+	case Zlib:
+		if level == 0 {
+			level = zlib.BestCompression
+		}
+		w, err := zlib.NewWriterLevel(&c.buf, level)
+		if err != nil {
+			return nil, err
+		}
+		c.compress = w
+	case Brotli:
+		if level == 0 {
+			level = 10
+		}
+		c.version = []byte{ChannelVersionBrotli}
+		c.buf.Write(c.version)
+		c.compress = brotli.NewWriterLevel(&c.buf, level)
+	case Zstd:
+		encLevel := zstd.SpeedBestCompression
+		if level != 0 {
+			encLevel = zstd.EncoderLevelFromZstd(level)
+		}
+		c.version = []byte{ChannelVersionZstd}
+		c.buf.Write(c.version)
+		w, err := zstd.NewWriter(&c.buf, zstd.WithEncoderLevel(encLevel), zstd.WithEncoderConcurrency(1),
+			zstd.WithWindowSize(zstdMaxWindow))
+		if err != nil {
+			return nil, err
+		}
+		c.compress = w
+	}
+	return c, nil
+}
+
+func (c *channelCompressor) Write(p []byte) (int, error) {
+	return c.compress.Write(p)
+}
+
+func (c *channelCompressor) Flush() error {
+	return c.compress.Flush()
+}
+
+func (c *channelCompressor) Close() error {
+	return c.compress.Close()
+}
+
+func (c *channelCompressor) Read(p []byte) (int, error) {
+	return c.buf.Read(p)
+}
+
+func (c *channelCompressor) Reset() {
+	c.buf.Reset()
+	c.buf.Write(c.version)
+	c.compress.Reset(&c.buf)
+}
+
+func (c *channelCompressor) Len() int {
+	return c.buf.Len()
+}
+
+// newChannelDecompressor returns a reader of the decompressed data of a channel, of which the algorithm is
+// identified by the first byte. Only zlib is accepted, unless allowAlgos is set.
+func newChannelDecompressor(r io.Reader, allowAlgos bool) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	first, err := br.Peek(1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read channel version: %w", err)
+	}
+	if cm := first[0] & 0x0F; cm == zlibCM8 || cm == zlibCM15 {
+		return zlib.NewReader(br)
+	}
+	if !allowAlgos {
+		return nil, fmt.Errorf("cannot accept channel with version byte %d before channel compression is active", first[0])
+	}
+	if _, err := br.Discard(1); err != nil {
+		return nil, err
+	}
+	switch first[0] {
+	case ChannelVersionBrotli:
+		return brotli.NewReader(br), nil
+	case ChannelVersionZstd:
+		// Decoding with a single goroutine decodes synchronously, so the decoder does not need to be closed.
+		// The window is limited to the window of the channel compressor, so a channel cannot make the decoder
+		// allocate more memory than that.
+		d, err := zstd.NewReader(br, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxWindow(zstdMaxWindow))
+		if err != nil {
+			return nil, err
+		}
+		return d, nil
+	default:
+		return nil, errors.New("unknown channel compression version")
+	}
+}
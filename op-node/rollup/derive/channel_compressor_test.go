@@ -0,0 +1,111 @@
+package derive
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+func compressBatches(t *testing.T, algo CompressionAlgo, level int, batches []*BatchData) []byte {
+	t.Helper()
+	c, err := NewChannelCompressor(algo, level)
+	require.NoError(t, err)
+	for _, b := range batches {
+		require.NoError(t, rlp.Encode(c, b))
+		// flushes, like the shadow compressor, must not affect the decompressed data
+		require.NoError(t, c.Flush())
+	}
+	require.NoError(t, c.Close())
+	data, err := io.ReadAll(c)
+	require.NoError(t, err)
+	return data
+}
+
+func TestChannelCompressorRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(0x5a5a))
+	chainID := big.NewInt(901)
+	batches := []*BatchData{
+		NewSingularBatchData(*RandomSingularBatch(rng, 5, chainID)),
+		NewSingularBatchData(*RandomSingularBatch(rng, 7, chainID)),
+	}
+	compressionTime := uint64(10)
+	cfg := &rollup.Config{ChannelCompressionL1Timestamp: &compressionTime}
+	active := eth.L1BlockRef{Time: 10}
+
+	for _, algo := range CompressionAlgos {
+		for _, level := range []int{0, 1, 9} {
+			data := compressBatches(t, algo, level, batches)
+			next, err := BatchReader(cfg, bytes.NewReader(data), active)
+			require.NoError(t, err, "%s level %d", algo, level)
+			for _, expected := range batches {
+				b, err := next()
+				require.NoError(t, err)
+				require.Equal(t, expected.SingularBatch, b.SingularBatch)
+			}
+			_, err = next()
+			require.ErrorIs(t, err, io.EOF)
+		}
+	}
+}
+
+func TestChannelCompressorReset(t *testing.T) {
+	for _, algo := range CompressionAlgos {
+		c, err := NewChannelCompressor(algo, 0)
+		require.NoError(t, err)
+		_, err = c.Write([]byte("some data"))
+		require.NoError(t, err)
+		require.NoError(t, c.Flush())
+		c.Reset()
+
+		_, err = c.Write([]byte("other data"))
+		require.NoError(t, err)
+		require.NoError(t, c.Close())
+		var buf bytes.Buffer
+		_, err = buf.ReadFrom(c)
+		require.NoError(t, err)
+
+		r, err := newChannelDecompressor(&buf, true)
+		require.NoError(t, err)
+		out, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "other data", string(out), algo)
+	}
+}
+
+func TestBatchReaderChannelCompressionInactive(t *testing.T) {
+	rng := rand.New(rand.NewSource(0x5a5b))
+	batches := []*BatchData{NewSingularBatchData(*RandomSingularBatch(rng, 3, big.NewInt(901)))}
+	compressionTime := uint64(10)
+	cfg := &rollup.Config{ChannelCompressionL1Timestamp: &compressionTime}
+	inactive := eth.L1BlockRef{Time: 9}
+
+	_, err := BatchReader(cfg, bytes.NewReader(compressBatches(t, Zlib, 0, batches)), inactive)
+	require.NoError(t, err)
+	for _, algo := range []CompressionAlgo{Brotli, Zstd} {
+		_, err := BatchReader(cfg, bytes.NewReader(compressBatches(t, algo, 0, batches)), inactive)
+		require.ErrorContains(t, err, "before channel compression is active", algo)
+	}
+	_, err = BatchReader(cfg, bytes.NewReader([]byte{0x03, 0x00}), eth.L1BlockRef{Time: 10})
+	require.ErrorContains(t, err, "unknown channel compression version")
+}
+
+func TestCheckCompressionLevel(t *testing.T) {
+	require.NoError(t, CheckCompressionLevel(Zlib, 0))
+	require.NoError(t, CheckCompressionLevel(Zlib, 9))
+	require.Error(t, CheckCompressionLevel(Zlib, 10))
+	require.NoError(t, CheckCompressionLevel(Brotli, 11))
+	require.Error(t, CheckCompressionLevel(Brotli, 12))
+	require.NoError(t, CheckCompressionLevel(Zstd, 22))
+	require.Error(t, CheckCompressionLevel(Zstd, 23))
+	require.Error(t, CheckCompressionLevel("lz4", 1))
+	_, err := NewChannelCompressor(Zstd, -1)
+	require.Error(t, err)
+}
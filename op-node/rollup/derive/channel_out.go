@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -59,6 +60,16 @@ type ChannelOut struct {
 	compress Compressor
 
 	closed bool
+
+	// spanBatch is set if the channel encodes its blocks as a single span batch
+	spanBatch bool
+	// Span batch parameters, see NewSpanChannelOut
+	genesisTimestamp uint64
+	chainID          *big.Int
+	// spanBatches are the singular batches of the span batch added so far
+	spanBatches []*SingularBatch
+	// spanBatchParentEpoch is the L1 origin number of the parent of the first block of the span batch
+	spanBatchParentEpoch uint64
 }
 
 func (co *ChannelOut) ID() ChannelID {
@@ -80,12 +91,27 @@ func NewChannelOut(compress Compressor) (*ChannelOut, error) {
 	return c, nil
 }
 
+// NewSpanChannelOut creates a channel out that encodes all blocks added to it as a single span batch.
+// The whole span batch is re-encoded into the compressor whenever a block is added, so no frames can
+// be output before the channel is closed.
+func NewSpanChannelOut(compress Compressor, genesisTimestamp uint64, chainID *big.Int) (*ChannelOut, error) {
+	c, err := NewChannelOut(compress)
+	if err != nil {
+		return nil, err
+	}
+	c.spanBatch = true
+	c.genesisTimestamp = genesisTimestamp
+	c.chainID = chainID
+	return c, nil
+}
+
 // TODO: reuse ChannelOut for performance
 func (co *ChannelOut) Reset() error {
 	co.frame = 0
 	co.rlpLength = 0
 	co.compress.Reset()
 	co.closed = false
+	co.spanBatches = co.spanBatches[:0]
 	_, err := rand.Read(co.id[:])
 	return err
 }
@@ -99,11 +125,64 @@ func (co *ChannelOut) AddBlock(block *types.Block) (uint64, error) {
 		return 0, errors.New("already closed")
 	}
 
-	batch, _, err := BlockToBatch(block)
+	batch, l1Info, err := BlockToBatch(block)
 	if err != nil {
 		return 0, err
 	}
-	return co.AddBatch(batch)
+	return co.AddSingularBatch(&batch.SingularBatch, l1Info.SequenceNumber)
+}
+
+// AddSingularBatch adds a singular batch to the channel, given the sequence number of its block
+// within its epoch. It returns the RLP encoded byte size and an error if there is a problem adding
+// the batch. Like AddBatch, the only sentinel error that it returns is ErrTooManyRLPBytes.
+//
+// If the channel encodes a span batch, the batch is appended to the span batch and the whole span
+// batch is written to the reset compressor again.
+func (co *ChannelOut) AddSingularBatch(batch *SingularBatch, seqNum uint64) (uint64, error) {
+	if !co.spanBatch {
+		return co.AddBatch(NewSingularBatchData(*batch))
+	}
+	if co.closed {
+		return 0, errors.New("already closed")
+	}
+
+	if len(co.spanBatches) == 0 {
+		// The parent of the first block shares its epoch, unless the block starts a new epoch.
+		co.spanBatchParentEpoch = uint64(batch.EpochNum)
+		if seqNum == 0 && co.spanBatchParentEpoch > 0 {
+			co.spanBatchParentEpoch--
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := co.encodeSpanBatch(&buf, append(co.spanBatches, batch)); err != nil {
+		return 0, err
+	}
+	if buf.Len() > MaxRLPBytesPerChannel {
+		return 0, fmt.Errorf("could not encode span batch of %d bytes to channel, max is %d. err: %w",
+			buf.Len(), MaxRLPBytesPerChannel, ErrTooManyRLPBytes)
+	}
+	co.spanBatches = append(co.spanBatches, batch)
+	co.rlpLength = buf.Len()
+
+	// The compressor only contains the previous encoding of the span batch,
+	// so the full span batch always replaces it.
+	co.compress.Reset()
+	written, err := co.compress.Write(buf.Bytes())
+	return uint64(written), err
+}
+
+// encodeSpanBatch RLP encodes the given singular batches as a single span batch.
+func (co *ChannelOut) encodeSpanBatch(w io.Writer, batches []*SingularBatch) error {
+	builder := NewSpanBatchBuilder(co.spanBatchParentEpoch, co.genesisTimestamp, co.chainID)
+	for _, batch := range batches {
+		builder.AppendSingularBatch(batch)
+	}
+	rawSpanBatch, err := builder.GetRawSpanBatch()
+	if err != nil {
+		return fmt.Errorf("could not create raw span batch: %w", err)
+	}
+	return rlp.Encode(w, NewSpanBatchData(*rawSpanBatch))
 }
 
 // AddBatch adds a batch to the channel. It returns the RLP encoded byte size
@@ -118,6 +197,9 @@ func (co *ChannelOut) AddBatch(batch *BatchData) (uint64, error) {
 	if co.closed {
 		return 0, errors.New("already closed")
 	}
+	if co.spanBatch {
+		return 0, errors.New("cannot add batch data to a span batch channel, use AddSingularBatch")
+	}
 
 	// We encode to a temporary buffer to determine the encoded length to
 	// ensure that the total size of all RLP elements is less than or equal to MAX_RLP_BYTES_PER_CHANNEL
@@ -144,13 +226,22 @@ func (co *ChannelOut) InputBytes() int {
 // ReadyBytes returns the number of bytes that the channel out can immediately output into a frame.
 // Use `Flush` or `Close` to move data from the compression buffer into the ready buffer if more bytes
 // are needed. Add blocks may add to the ready buffer, but it is not guaranteed due to the compression stage.
+// A span batch channel has no ready bytes before it is closed, because its compressed data is replaced
+// whenever a block is added.
 func (co *ChannelOut) ReadyBytes() int {
+	if co.spanBatch && !co.closed {
+		return 0
+	}
 	return co.compress.Len()
 }
 
 // Flush flushes the internal compression stage to the ready buffer. It enables pulling a larger & more
 // complete frame. It reduces the compression efficiency.
 func (co *ChannelOut) Flush() error {
+	if co.spanBatch {
+		// nothing can be output before the span batch channel is closed
+		return nil
+	}
 	return co.compress.Flush()
 }
 
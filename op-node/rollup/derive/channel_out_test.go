@@ -3,6 +3,7 @@ package derive
 import (
 	"bytes"
 	"math/big"
+	"math/rand"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -46,6 +47,33 @@ func TestChannelOutAddBlock(t *testing.T) {
 	})
 }
 
+// TestSpanChannelOut tests that a span batch channel out encodes all added
+// singular batches as a single span batch, which is only ready once closed.
+func TestSpanChannelOut(t *testing.T) {
+	rng := rand.New(rand.NewSource(0xab))
+	chainID := big.NewInt(rng.Int63n(1000))
+	batches := RandomValidConsecutiveSingularBatches(rng, chainID)
+
+	cout, err := NewSpanChannelOut(&nonCompressor{}, 0, chainID)
+	require.NoError(t, err)
+	for i, batch := range batches {
+		_, err := cout.AddSingularBatch(batch, uint64(i+1))
+		require.NoError(t, err)
+		require.Zero(t, cout.ReadyBytes(), "span batch channel must not output frames before it is closed")
+	}
+	_, err = cout.AddBatch(NewSingularBatchData(*batches[0]))
+	require.Error(t, err, "span batch channel must not accept batch data")
+	require.NoError(t, cout.Close())
+	require.Equal(t, cout.InputBytes(), cout.ReadyBytes())
+
+	var batchData BatchData
+	require.NoError(t, rlp.Decode(cout.compress, &batchData))
+	require.Equal(t, SpanBatchType, int(batchData.BatchType))
+	spanBatch, err := batchData.RawSpanBatch.derive(2, 0, chainID)
+	require.NoError(t, err)
+	require.Equal(t, NewSpanBatch(batches), spanBatch)
+}
+
 // TestOutputFrameSmallMaxSize tests that calling [OutputFrame] with a small
 // max size that is below the fixed frame size overhead of 23, will return
 // an error.
@@ -0,0 +1,16 @@
+package derive
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUpgradeDepositSource checks the source hash against a known upgrade-deposit vector.
+func TestUpgradeDepositSource(t *testing.T) {
+	source := UpgradeDepositSource{Intent: "Ecotone: L1 Block Deployment"}
+	actual := source.SourceHash()
+	expected := common.HexToHash("0x877a6077205782ea15a6dc8699fa5ebcec5e0f4389f09cb8eda09488231346f8")
+	require.Equal(t, expected, actual)
+}
@@ -35,6 +35,7 @@ type Engine interface {
 	PayloadByNumber(context.Context, uint64) (*eth.ExecutionPayload, error)
 	L2BlockRefByLabel(ctx context.Context, label eth.BlockLabel) (eth.L2BlockRef, error)
 	L2BlockRefByHash(ctx context.Context, l2Hash common.Hash) (eth.L2BlockRef, error)
+	L2BlockRefByNumber(ctx context.Context, num uint64) (eth.L2BlockRef, error)
 	SystemConfigL2Fetcher
 }
 
@@ -56,6 +57,9 @@ type EngineControl interface {
 	StartPayload(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes, updateSafe bool) (errType BlockInsertionErrType, err error)
 	// ConfirmPayload requests the engine to complete the current block. If no block is being built, or if it fails, an error is returned.
 	ConfirmPayload(ctx context.Context) (out *eth.ExecutionPayload, errTyp BlockInsertionErrType, err error)
+	// PeekPayload retrieves the current block from the engine, without making it canonical.
+	// The engine stops adding transactions to the block, but it can still be confirmed or cancelled.
+	PeekPayload(ctx context.Context) (*eth.ExecutionPayload, error)
 	// CancelPayload requests the engine to stop building the current block without making it canonical.
 	// This is optional, as the engine expires building jobs that are left uncompleted, but can still save resources.
 	CancelPayload(ctx context.Context, force bool) error
@@ -93,6 +97,17 @@ type FinalityData struct {
 	L1Block eth.BlockID
 }
 
+type elSyncStatus int
+
+const (
+	// elSyncWillStart means the engine queue will check on the next reset whether the engine still needs to EL sync.
+	elSyncWillStart elSyncStatus = iota
+	// elSyncStarted means the engine is syncing towards the gossiped unsafe chain, and derivation is paused.
+	elSyncStarted
+	// elSyncFinished means the engine is not EL syncing (anymore), and regular derivation applies.
+	elSyncFinished
+)
+
 // EngineQueue queues up payload attributes to consolidate or process with the provided Engine
 type EngineQueue struct {
 	log log.Logger
@@ -102,10 +117,18 @@ type EngineQueue struct {
 	safeHead   eth.L2BlockRef
 	unsafeHead eth.L2BlockRef
 
+	// Once interop is active, the local safe and unsafe blocks are only cross-safe and cross-unsafe
+	// after the supervisor verified their executing messages. Finalization is limited to cross-safe blocks.
+	crossSafeHead   eth.L2BlockRef
+	crossUnsafeHead eth.L2BlockRef
+
 	// Target L2 block the engine is currently syncing to.
 	// If the engine p2p sync is enabled, it can be different with unsafeHead. Otherwise, it must be same with unsafeHead.
 	engineSyncTarget eth.L2BlockRef
 
+	// elSyncStatus tracks the progress of execution-layer sync, see sync.ELSync.
+	elSyncStatus elSyncStatus
+
 	buildingOnto eth.L2BlockRef
 	buildingID   eth.PayloadID
 	buildingSafe bool
@@ -139,12 +162,22 @@ type EngineQueue struct {
 	l1Fetcher L1Fetcher
 
 	syncCfg *sync.Config
+
+	supervisor Supervisor
 }
 
 var _ EngineControl = (*EngineQueue)(nil)
 
 // NewEngineQueue creates a new EngineQueue, which should be Reset(origin) before use.
-func NewEngineQueue(log log.Logger, cfg *rollup.Config, engine Engine, metrics Metrics, prev NextAttributesProvider, l1Fetcher L1Fetcher, syncCfg *sync.Config) *EngineQueue {
+// The supervisor is only used once interop is active, and may be nil.
+func NewEngineQueue(log log.Logger, cfg *rollup.Config, engine Engine, metrics Metrics, prev NextAttributesProvider, l1Fetcher L1Fetcher, syncCfg *sync.Config, supervisor Supervisor) *EngineQueue {
+	if supervisor == nil {
+		supervisor = noSupervisor{}
+	}
+	status := elSyncFinished
+	if syncCfg.SyncMode == sync.ELSync {
+		status = elSyncWillStart
+	}
 	return &EngineQueue{
 		log:            log,
 		cfg:            cfg,
@@ -155,6 +188,8 @@ func NewEngineQueue(log log.Logger, cfg *rollup.Config, engine Engine, metrics M
 		prev:           prev,
 		l1Fetcher:      l1Fetcher,
 		syncCfg:        syncCfg,
+		elSyncStatus:   status,
+		supervisor:     supervisor,
 	}
 }
 
@@ -238,9 +273,17 @@ func (eq *EngineQueue) EngineSyncTarget() eth.L2BlockRef {
 	return eq.engineSyncTarget
 }
 
+func (eq *EngineQueue) CrossUnsafeL2Head() eth.L2BlockRef {
+	return eq.crossUnsafeHead
+}
+
+func (eq *EngineQueue) CrossSafeL2Head() eth.L2BlockRef {
+	return eq.crossSafeHead
+}
+
 // Determine if the engine is syncing to the target block
 func (eq *EngineQueue) isEngineSyncing() bool {
-	return eq.unsafeHead.Hash != eq.engineSyncTarget.Hash
+	return eq.elSyncStatus == elSyncStarted || eq.unsafeHead.Hash != eq.engineSyncTarget.Hash
 }
 
 func (eq *EngineQueue) Step(ctx context.Context) error {
@@ -259,6 +302,9 @@ func (eq *EngineQueue) Step(ctx context.Context) error {
 		// Make pipeline first focus to sync unsafe blocks to engineSyncTarget
 		return EngineP2PSyncing
 	}
+	if err := eq.tryPromoteCross(ctx); err != io.EOF {
+		return err
+	}
 	if eq.safeAttributes != nil {
 		return eq.tryNextSafeAttributes(ctx)
 	}
@@ -370,6 +416,10 @@ func (eq *EngineQueue) tryFinalizeL2() {
 	finalizedL2 := eq.finalized
 	// go through the latest inclusion data, and find the last L2 block that was derived from a finalized L1 block
 	for _, fd := range eq.finalityData {
+		// once interop is active, only cross-safe blocks can be finalized
+		if eq.cfg.IsInterop(fd.L2Block.Time) && fd.L2Block.Number > eq.crossSafeHead.Number {
+			break
+		}
 		if fd.L2Block.Number > finalizedL2.Number && fd.L1Block.Number <= eq.finalizedL1.Number {
 			finalizedL2 = fd.L2Block
 			eq.needForkchoiceUpdate = true
@@ -449,8 +499,8 @@ func (eq *EngineQueue) tryUpdateEngine(ctx context.Context) error {
 // checkNewPayloadStatus checks returned status of engine_newPayloadV1 request for next unsafe payload.
 // It returns true if the status is acceptable.
 func (eq *EngineQueue) checkNewPayloadStatus(status eth.ExecutePayloadStatus) bool {
-	if eq.syncCfg.EngineSync {
-		// Allow SYNCING and ACCEPTED if engine P2P sync is enabled
+	if eq.syncCfg.SyncMode == sync.ELSync {
+		// Allow SYNCING and ACCEPTED if engine EL sync is enabled
 		return status == eth.ExecutionValid || status == eth.ExecutionSyncing || status == eth.ExecutionAccepted
 	}
 	return status == eth.ExecutionValid
@@ -459,8 +509,8 @@ func (eq *EngineQueue) checkNewPayloadStatus(status eth.ExecutePayloadStatus) bo
 // checkForkchoiceUpdatedStatus checks returned status of engine_forkchoiceUpdatedV1 request for next unsafe payload.
 // It returns true if the status is acceptable.
 func (eq *EngineQueue) checkForkchoiceUpdatedStatus(status eth.ExecutePayloadStatus) bool {
-	if eq.syncCfg.EngineSync {
-		// Allow SYNCING if engine P2P sync is enabled
+	if eq.syncCfg.SyncMode == sync.ELSync {
+		// Allow SYNCING if engine EL sync is enabled
 		return status == eth.ExecutionValid || status == eth.ExecutionSyncing
 	}
 	return status == eth.ExecutionValid
@@ -481,7 +531,7 @@ func (eq *EngineQueue) tryNextUnsafePayload(ctx context.Context) error {
 	}
 
 	// Ensure that the unsafe payload builds upon the current unsafe head
-	if !eq.syncCfg.EngineSync && first.ParentHash != eq.unsafeHead.Hash {
+	if eq.syncCfg.SyncMode != sync.ELSync && first.ParentHash != eq.unsafeHead.Hash {
 		if uint64(first.BlockNumber) == eq.unsafeHead.Number+1 {
 			eq.log.Info("skipping unsafe payload, since it does not build onto the existing unsafe chain", "safe", eq.safeHead.ID(), "unsafe", first.ID(), "payload", first.ID())
 			eq.unsafePayloads.Pop()
@@ -543,9 +593,33 @@ func (eq *EngineQueue) tryNextUnsafePayload(ctx context.Context) error {
 	eq.log.Trace("Executed unsafe payload", "hash", ref.Hash, "number", ref.Number, "timestamp", ref.Time, "l1Origin", ref.L1Origin)
 	eq.logSyncProgress("unsafe payload from sequencer")
 
+	if eq.elSyncStatus == elSyncStarted && fcRes.PayloadStatus.Status == eth.ExecutionValid {
+		return eq.finishELSync(ctx, ref)
+	}
 	return nil
 }
 
+// finishELSync marks the block the engine synced to as safe and finalized, and resets the pipeline,
+// to continue with regular derivation from there. The block was not derived from L1,
+// the unsafe chain that was synced to is trusted up to this point.
+func (eq *EngineQueue) finishELSync(ctx context.Context, ref eth.L2BlockRef) error {
+	fc := eth.ForkchoiceState{
+		HeadBlockHash:      ref.Hash,
+		SafeBlockHash:      ref.Hash,
+		FinalizedBlockHash: ref.Hash,
+	}
+	if _, err := eq.engine.ForkchoiceUpdate(ctx, &fc, nil); err != nil {
+		return NewTemporaryError(fmt.Errorf("failed to mark EL synced block %s as safe and finalized: %w", ref, err))
+	}
+	eq.safeHead = ref
+	eq.finalized = ref
+	eq.metrics.RecordL2Ref("l2_safe", ref)
+	eq.metrics.RecordL2Ref("l2_finalized", ref)
+	eq.elSyncStatus = elSyncFinished
+	eq.log.Info("Finished EL sync", "head", ref)
+	return NewResetError(fmt.Errorf("finished EL sync at %s, restarting derivation from there", ref))
+}
+
 func (eq *EngineQueue) tryNextSafeAttributes(ctx context.Context) error {
 	if eq.safeAttributes == nil { // sanity check the attributes are there
 		return nil
@@ -725,6 +799,17 @@ func (eq *EngineQueue) ConfirmPayload(ctx context.Context) (out *eth.ExecutionPa
 	return payload, BlockInsertOK, nil
 }
 
+func (eq *EngineQueue) PeekPayload(ctx context.Context) (*eth.ExecutionPayload, error) {
+	if eq.buildingID == (eth.PayloadID{}) {
+		return nil, fmt.Errorf("cannot peek payload: not currently building a payload")
+	}
+	payload, err := eq.engine.GetPayload(ctx, eq.buildingID)
+	if err != nil {
+		return nil, NewTemporaryError(fmt.Errorf("failed to get execution payload: %w", err))
+	}
+	return payload, nil
+}
+
 func (eq *EngineQueue) CancelPayload(ctx context.Context, force bool) error {
 	if eq.buildingID == (eth.PayloadID{}) { // only cancel if there is something to cancel.
 		return nil
@@ -755,6 +840,11 @@ func (eq *EngineQueue) resetBuildingState() {
 // Reset walks the L2 chain backwards until it finds an L2 block whose L1 origin is canonical.
 // The unsafe head is set to the head of the L2 chain, unless the existing safe head is not canonical.
 func (eq *EngineQueue) Reset(ctx context.Context, _ eth.L1BlockRef, _ eth.SystemConfig) error {
+	if eq.elSyncStatus == elSyncWillStart {
+		if err := eq.checkELSyncStart(ctx); err != nil {
+			return err
+		}
+	}
 	result, err := sync.FindL2Heads(ctx, eq.cfg, eq.l1Fetcher, eq.engine, eq.log, eq.syncCfg)
 	if err != nil {
 		return NewTemporaryError(fmt.Errorf("failed to find the L2 Heads to start from: %w", err))
@@ -799,6 +889,9 @@ func (eq *EngineQueue) Reset(ctx context.Context, _ eth.L1BlockRef, _ eth.System
 	eq.safeHead = safe
 	eq.safeAttributes = nil
 	eq.finalized = finalized
+	// the cross heads are verified again from the last finalized block, which is always cross-safe
+	eq.setCrossSafeHead(finalized)
+	eq.setCrossUnsafeHead(finalized)
 	eq.resetBuildingState()
 	eq.needForkchoiceUpdate = true
 	eq.finalityData = eq.finalityData[:0]
@@ -814,6 +907,22 @@ func (eq *EngineQueue) Reset(ctx context.Context, _ eth.L1BlockRef, _ eth.System
 	return io.EOF
 }
 
+// checkELSyncStart determines if the engine needs to EL sync: only an engine without a finalized block,
+// other than the genesis block, is synced by the execution layer. Otherwise the node continues with regular derivation.
+func (eq *EngineQueue) checkELSyncStart(ctx context.Context) error {
+	finalized, err := eq.engine.L2BlockRefByLabel(ctx, eth.Finalized)
+	if errors.Is(err, ethereum.NotFound) || (err == nil && finalized.Number == eq.cfg.Genesis.L2.Number) {
+		eq.log.Info("Starting EL sync, the engine has no finalized blocks")
+		eq.elSyncStatus = elSyncStarted
+	} else if err != nil {
+		return NewTemporaryError(fmt.Errorf("failed to check the finalized block of the engine to determine EL sync: %w", err))
+	} else {
+		eq.log.Info("Skipping EL sync, the engine already has a finalized block", "finalized", finalized)
+		eq.elSyncStatus = elSyncFinished
+	}
+	return nil
+}
+
 // UnsafeL2SyncTarget retrieves the first queued-up L2 unsafe payload, or a zeroed reference if there is none.
 func (eq *EngineQueue) UnsafeL2SyncTarget() eth.L2BlockRef {
 	if first := eq.unsafePayloads.Peek(); first != nil {
@@ -247,7 +247,7 @@ func TestEngineQueue_Finalize(t *testing.T) {
 
 	prev := &fakeAttributesQueue{}
 
-	eq := NewEngineQueue(logger, cfg, eng, metrics, prev, l1F, &sync.Config{})
+	eq := NewEngineQueue(logger, cfg, eng, metrics, prev, l1F, &sync.Config{}, nil)
 	require.ErrorIs(t, eq.Reset(context.Background(), eth.L1BlockRef{}, eth.SystemConfig{}), io.EOF)
 
 	require.Equal(t, refB1, eq.SafeL2Head(), "L2 reset should go back to sequence window ago: blocks with origin E and D are not safe until we reconcile, C is extra, and B1 is the end we look for")
@@ -481,7 +481,7 @@ func TestEngineQueue_ResetWhenUnsafeOriginNotCanonical(t *testing.T) {
 
 	prev := &fakeAttributesQueue{origin: refE}
 
-	eq := NewEngineQueue(logger, cfg, eng, metrics, prev, l1F, &sync.Config{})
+	eq := NewEngineQueue(logger, cfg, eng, metrics, prev, l1F, &sync.Config{}, nil)
 	require.ErrorIs(t, eq.Reset(context.Background(), eth.L1BlockRef{}, eth.SystemConfig{}), io.EOF)
 
 	require.Equal(t, refB1, eq.SafeL2Head(), "L2 reset should go back to sequence window ago: blocks with origin E and D are not safe until we reconcile, C is extra, and B1 is the end we look for")
@@ -812,7 +812,7 @@ func TestVerifyNewL1Origin(t *testing.T) {
 			}, nil)
 
 			prev := &fakeAttributesQueue{origin: refE}
-			eq := NewEngineQueue(logger, cfg, eng, metrics, prev, l1F, &sync.Config{})
+			eq := NewEngineQueue(logger, cfg, eng, metrics, prev, l1F, &sync.Config{}, nil)
 			require.ErrorIs(t, eq.Reset(context.Background(), eth.L1BlockRef{}, eth.SystemConfig{}), io.EOF)
 
 			require.Equal(t, refB1, eq.SafeL2Head(), "L2 reset should go back to sequence window ago: blocks with origin E and D are not safe until we reconcile, C is extra, and B1 is the end we look for")
@@ -910,7 +910,7 @@ func TestBlockBuildingRace(t *testing.T) {
 	}
 
 	prev := &fakeAttributesQueue{origin: refA, attrs: attrs}
-	eq := NewEngineQueue(logger, cfg, eng, metrics, prev, l1F, &sync.Config{})
+	eq := NewEngineQueue(logger, cfg, eng, metrics, prev, l1F, &sync.Config{}, nil)
 	require.ErrorIs(t, eq.Reset(context.Background(), eth.L1BlockRef{}, eth.SystemConfig{}), io.EOF)
 
 	id := eth.PayloadID{0xff}
@@ -1080,7 +1080,7 @@ func TestResetLoop(t *testing.T) {
 
 	prev := &fakeAttributesQueue{origin: refA, attrs: attrs}
 
-	eq := NewEngineQueue(logger, cfg, eng, metrics.NoopMetrics, prev, l1F, &sync.Config{})
+	eq := NewEngineQueue(logger, cfg, eng, metrics.NoopMetrics, prev, l1F, &sync.Config{}, nil)
 	eq.unsafeHead = refA2
 	eq.engineSyncTarget = refA2
 	eq.safeHead = refA1
@@ -1178,7 +1178,7 @@ func TestEngineQueue_StepPopOlderUnsafe(t *testing.T) {
 
 	prev := &fakeAttributesQueue{origin: refA}
 
-	eq := NewEngineQueue(logger, cfg, eng, metrics.NoopMetrics, prev, l1F, &sync.Config{})
+	eq := NewEngineQueue(logger, cfg, eng, metrics.NoopMetrics, prev, l1F, &sync.Config{}, nil)
 	eq.unsafeHead = refA2
 	eq.safeHead = refA0
 	eq.finalized = refA0
@@ -1194,3 +1194,119 @@ func TestEngineQueue_StepPopOlderUnsafe(t *testing.T) {
 	l1F.AssertExpectations(t)
 	eng.AssertExpectations(t)
 }
+
+func TestEngineQueue_ELSync(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	rng := rand.New(rand.NewSource(1234))
+
+	refA := testutils.RandomBlockRef(rng)
+	refA0 := eth.L2BlockRef{
+		Hash:           testutils.RandomHash(rng),
+		Number:         0,
+		ParentHash:     common.Hash{},
+		Time:           refA.Time,
+		L1Origin:       refA.ID(),
+		SequenceNumber: 0,
+	}
+	cfg := &rollup.Config{
+		Genesis: rollup.Genesis{
+			L1:     refA.ID(),
+			L2:     refA0.ID(),
+			L2Time: refA0.Time,
+		},
+		BlockTime:     1,
+		SeqWindowSize: 2,
+	}
+	l1Info := testutils.RandomBlockInfo(rng)
+	l1InfoTx, err := L1InfoDepositBytes(1, l1Info, eth.SystemConfig{}, false)
+	require.NoError(t, err)
+	refA1 := eth.L2BlockRef{
+		Hash:           testutils.RandomHash(rng),
+		Number:         refA0.Number + 1,
+		ParentHash:     refA0.Hash,
+		Time:           refA0.Time + cfg.BlockTime,
+		L1Origin:       l1Info.ID(),
+		SequenceNumber: 1,
+	}
+	payloadA1 := &eth.ExecutionPayload{
+		ParentHash:    refA1.ParentHash,
+		BlockNumber:   eth.Uint64Quantity(refA1.Number),
+		GasLimit:      eth.Uint64Quantity(20_000_000),
+		Timestamp:     eth.Uint64Quantity(refA1.Time),
+		BaseFeePerGas: *uint256.NewInt(7),
+		BlockHash:     refA1.Hash,
+		Transactions:  []eth.Data{l1InfoTx},
+	}
+
+	newQueue := func(eng *testutils.MockEngine) *EngineQueue {
+		eq := NewEngineQueue(logger, cfg, eng, metrics.NoopMetrics, &fakeAttributesQueue{origin: refA}, &testutils.MockL1Source{}, &sync.Config{SyncMode: sync.ELSync}, nil)
+		eq.unsafeHead = refA0
+		eq.engineSyncTarget = refA0
+		eq.safeHead = refA0
+		eq.finalized = refA0
+		return eq
+	}
+
+	t.Run("start on empty engine", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		eq := newQueue(eng)
+		require.Equal(t, elSyncWillStart, eq.elSyncStatus)
+		eng.ExpectL2BlockRefByLabel(eth.Finalized, refA0, nil)
+		require.NoError(t, eq.checkELSyncStart(context.Background()))
+		require.Equal(t, elSyncStarted, eq.elSyncStatus)
+		require.ErrorIs(t, eq.Step(context.Background()), EngineP2PSyncing, "no derivation while EL syncing")
+		eng.AssertExpectations(t)
+	})
+
+	t.Run("skip on synced engine", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		eq := newQueue(eng)
+		eng.ExpectL2BlockRefByLabel(eth.Finalized, refA1, nil)
+		require.NoError(t, eq.checkELSyncStart(context.Background()))
+		require.Equal(t, elSyncFinished, eq.elSyncStatus)
+		eng.AssertExpectations(t)
+	})
+
+	t.Run("syncing", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		eq := newQueue(eng)
+		eq.elSyncStatus = elSyncStarted
+		eq.AddUnsafePayload(payloadA1)
+		eng.ExpectNewPayload(payloadA1, &eth.PayloadStatusV1{Status: eth.ExecutionSyncing}, nil)
+		eng.ExpectForkchoiceUpdate(&eth.ForkchoiceState{
+			HeadBlockHash:      refA1.Hash,
+			SafeBlockHash:      refA0.Hash,
+			FinalizedBlockHash: refA0.Hash,
+		}, nil, &eth.ForkchoiceUpdatedResult{PayloadStatus: eth.PayloadStatusV1{Status: eth.ExecutionSyncing}}, nil)
+		require.NoError(t, eq.Step(context.Background()))
+		require.Equal(t, elSyncStarted, eq.elSyncStatus)
+		require.Equal(t, refA0, eq.UnsafeL2Head())
+		require.Equal(t, refA1, eq.EngineSyncTarget())
+		require.ErrorIs(t, eq.Step(context.Background()), EngineP2PSyncing)
+		eng.AssertExpectations(t)
+	})
+
+	t.Run("finish", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		eq := newQueue(eng)
+		eq.elSyncStatus = elSyncStarted
+		eq.AddUnsafePayload(payloadA1)
+		eng.ExpectNewPayload(payloadA1, &eth.PayloadStatusV1{Status: eth.ExecutionValid}, nil)
+		eng.ExpectForkchoiceUpdate(&eth.ForkchoiceState{
+			HeadBlockHash:      refA1.Hash,
+			SafeBlockHash:      refA0.Hash,
+			FinalizedBlockHash: refA0.Hash,
+		}, nil, &eth.ForkchoiceUpdatedResult{PayloadStatus: eth.PayloadStatusV1{Status: eth.ExecutionValid}}, nil)
+		eng.ExpectForkchoiceUpdate(&eth.ForkchoiceState{
+			HeadBlockHash:      refA1.Hash,
+			SafeBlockHash:      refA1.Hash,
+			FinalizedBlockHash: refA1.Hash,
+		}, nil, &eth.ForkchoiceUpdatedResult{PayloadStatus: eth.PayloadStatusV1{Status: eth.ExecutionValid}}, nil)
+		require.ErrorIs(t, eq.Step(context.Background()), ErrReset, "derivation restarts from the synced block")
+		require.Equal(t, elSyncFinished, eq.elSyncStatus)
+		require.Equal(t, refA1, eq.UnsafeL2Head())
+		require.Equal(t, refA1, eq.SafeL2Head())
+		require.Equal(t, refA1, eq.Finalized())
+		eng.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,139 @@
+package derive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/interop"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// Supervisor verifies the executing messages of L2 blocks against the initiating messages of the dependency set.
+type Supervisor interface {
+	CheckBlock(ctx context.Context, chainID *big.Int, blockHash common.Hash, blockNumber uint64) (interop.SafetyLevel, error)
+}
+
+var ErrSupervisorNotConfigured = errors.New("interop supervisor not configured")
+
+// noSupervisor is the Supervisor of nodes that do not have an interop supervisor configured.
+type noSupervisor struct{}
+
+func (noSupervisor) CheckBlock(ctx context.Context, chainID *big.Int, blockHash common.Hash, blockNumber uint64) (interop.SafetyLevel, error) {
+	return "", ErrSupervisorNotConfigured
+}
+
+// tryPromoteCross promotes the next local-safe block to cross-safe, or otherwise the next local-unsafe block
+// to cross-unsafe, once the supervisor verified the executing messages of the block.
+// Blocks before the interop activation have no executing messages, and are promoted without any checks.
+// It returns io.EOF if there is no block to promote yet, or if the supervisor cannot be reached.
+func (eq *EngineQueue) tryPromoteCross(ctx context.Context) error {
+	// Resets and the forced inclusion of derived blocks may reorg the local chain below the cross heads.
+	if eq.crossSafeHead.Number > eq.safeHead.Number {
+		eq.setCrossSafeHead(eq.finalized)
+	}
+	if eq.crossUnsafeHead.Number > eq.unsafeHead.Number || eq.crossUnsafeHead.Number < eq.crossSafeHead.Number {
+		eq.setCrossUnsafeHead(eq.crossSafeHead)
+	}
+	// Without interop, the cross heads simply follow the local heads.
+	if !eq.cfg.IsInterop(eq.safeHead.Time) && eq.crossSafeHead != eq.safeHead {
+		eq.setCrossSafeHead(eq.safeHead)
+	}
+	if !eq.cfg.IsInterop(eq.unsafeHead.Time) && eq.crossUnsafeHead != eq.unsafeHead {
+		eq.setCrossUnsafeHead(eq.unsafeHead)
+	}
+	if eq.crossSafeHead.Number < eq.safeHead.Number {
+		if err := eq.promoteCross(ctx, eq.crossSafeHead, eq.safeHead, interop.CrossSafe); err != io.EOF {
+			return err
+		}
+	}
+	if eq.crossUnsafeHead.Number < eq.unsafeHead.Number {
+		return eq.promoteCross(ctx, eq.crossUnsafeHead, eq.unsafeHead, interop.CrossUnsafe)
+	}
+	return io.EOF
+}
+
+// promoteCross checks the block after the given cross head, towards the given local head, with the supervisor.
+func (eq *EngineQueue) promoteCross(ctx context.Context, crossHead, localHead eth.L2BlockRef, target interop.SafetyLevel) error {
+	next := localHead
+	if next.Number != crossHead.Number+1 {
+		ref, err := eq.engine.L2BlockRefByNumber(ctx, crossHead.Number+1)
+		if err != nil {
+			return NewTemporaryError(fmt.Errorf("failed to fetch L2 block %d to promote to %s: %w", crossHead.Number+1, target, err))
+		}
+		next = ref
+	}
+	if next.ParentHash != crossHead.Hash {
+		// the cross head is not canonical anymore, continue from the last cross-safe block instead
+		eq.log.Warn("Cross head is not canonical", "target", target, "cross_head", crossHead, "next", next)
+		if target == interop.CrossSafe {
+			eq.setCrossSafeHead(eq.finalized)
+		}
+		eq.setCrossUnsafeHead(eq.crossSafeHead)
+		return nil
+	}
+	if !eq.cfg.IsInterop(next.Time) {
+		eq.promoteCrossHead(next, target)
+		return nil
+	}
+	lvl, err := eq.supervisor.CheckBlock(ctx, eq.cfg.L2ChainID, next.Hash, next.Number)
+	if err != nil {
+		// the local chain does not depend on the supervisor, keep deriving it while the supervisor is unavailable
+		eq.log.Warn("Failed to check L2 block with the interop supervisor", "block", next, "target", target, "err", err)
+		return io.EOF
+	}
+	if lvl == interop.Invalid {
+		return eq.onInvalidBlock(next)
+	}
+	if !lvl.AtLeast(target) {
+		eq.log.Debug("Waiting for the supervisor to promote block", "block", next, "safety", lvl, "target", target)
+		return io.EOF
+	}
+	eq.promoteCrossHead(next, target)
+	if target == interop.CrossSafe {
+		// finalization of L2 blocks waits for them to be cross-safe
+		eq.tryFinalizeL2()
+	}
+	return nil
+}
+
+func (eq *EngineQueue) promoteCrossHead(ref eth.L2BlockRef, target interop.SafetyLevel) {
+	if target == interop.CrossSafe {
+		eq.setCrossSafeHead(ref)
+		// a cross-safe block is also cross-unsafe
+		if eq.crossUnsafeHead.Number < ref.Number {
+			eq.setCrossUnsafeHead(ref)
+		}
+	} else {
+		eq.setCrossUnsafeHead(ref)
+	}
+}
+
+// onInvalidBlock drops the unsafe chain from the given invalid block onwards.
+// Derived blocks cannot be dropped: these are replaced by the derivation process.
+func (eq *EngineQueue) onInvalidBlock(ref eth.L2BlockRef) error {
+	if ref.Number <= eq.safeHead.Number {
+		return NewCriticalError(fmt.Errorf("derived L2 block %s executes invalid messages", ref))
+	}
+	eq.log.Warn("Dropping unsafe blocks that execute invalid messages", "invalid", ref, "unsafe", eq.unsafeHead)
+	eq.unsafeHead = eq.crossUnsafeHead
+	eq.engineSyncTarget = eq.crossUnsafeHead
+	eq.needForkchoiceUpdate = true
+	eq.metrics.RecordL2Ref("l2_unsafe", eq.unsafeHead)
+	eq.metrics.RecordL2Ref("l2_engineSyncTarget", eq.unsafeHead)
+	return nil
+}
+
+func (eq *EngineQueue) setCrossUnsafeHead(ref eth.L2BlockRef) {
+	eq.crossUnsafeHead = ref
+	eq.metrics.RecordL2Ref("l2_cross_unsafe", ref)
+}
+
+func (eq *EngineQueue) setCrossSafeHead(ref eth.L2BlockRef) {
+	eq.crossSafeHead = ref
+	eq.metrics.RecordL2Ref("l2_cross_safe", ref)
+}
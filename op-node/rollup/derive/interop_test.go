@@ -0,0 +1,173 @@
+package derive
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-node/metrics"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/interop"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+type fakeSupervisor struct {
+	levels map[common.Hash]interop.SafetyLevel
+	err    error
+}
+
+func (f *fakeSupervisor) CheckBlock(ctx context.Context, chainID *big.Int, blockHash common.Hash, blockNumber uint64) (interop.SafetyLevel, error) {
+	return f.levels[blockHash], f.err
+}
+
+func TestEngineQueue_PromoteCross(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlInfo)
+	rng := rand.New(rand.NewSource(1234))
+
+	refA := testutils.RandomBlockRef(rng)
+	refA0 := eth.L2BlockRef{
+		Hash:     testutils.RandomHash(rng),
+		Number:   0,
+		Time:     refA.Time,
+		L1Origin: refA.ID(),
+	}
+	refA1 := eth.L2BlockRef{
+		Hash:           testutils.RandomHash(rng),
+		Number:         1,
+		ParentHash:     refA0.Hash,
+		Time:           refA0.Time + 2,
+		L1Origin:       refA.ID(),
+		SequenceNumber: 1,
+	}
+	refA2 := eth.L2BlockRef{
+		Hash:           testutils.RandomHash(rng),
+		Number:         2,
+		ParentHash:     refA1.Hash,
+		Time:           refA1.Time + 2,
+		L1Origin:       refA.ID(),
+		SequenceNumber: 2,
+	}
+
+	newQueue := func(interopTime *uint64, eng *testutils.MockEngine, sup Supervisor) *EngineQueue {
+		cfg := &rollup.Config{
+			Genesis:     rollup.Genesis{L1: refA.ID(), L2: refA0.ID(), L2Time: refA0.Time},
+			BlockTime:   2,
+			L2ChainID:   big.NewInt(901),
+			InteropTime: interopTime,
+		}
+		eq := NewEngineQueue(logger, cfg, eng, metrics.NoopMetrics, &fakeAttributesQueue{origin: refA}, &testutils.MockL1Source{}, &sync.Config{}, sup)
+		eq.finalized = refA0
+		eq.safeHead = refA1
+		eq.unsafeHead = refA2
+		eq.engineSyncTarget = refA2
+		eq.crossSafeHead = refA0
+		eq.crossUnsafeHead = refA0
+		return eq
+	}
+
+	t.Run("before interop", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		eq := newQueue(nil, eng, nil)
+		require.ErrorIs(t, eq.tryPromoteCross(context.Background()), io.EOF)
+		require.Equal(t, refA1, eq.CrossSafeL2Head())
+		require.Equal(t, refA2, eq.CrossUnsafeL2Head())
+		eng.AssertExpectations(t)
+	})
+
+	t.Run("promote", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		sup := &fakeSupervisor{levels: map[common.Hash]interop.SafetyLevel{
+			refA1.Hash: interop.CrossSafe,
+			refA2.Hash: interop.CrossUnsafe,
+		}}
+		eq := newQueue(new(uint64), eng, sup)
+		require.NoError(t, eq.tryPromoteCross(context.Background()))
+		require.Equal(t, refA1, eq.CrossSafeL2Head())
+		require.Equal(t, refA1, eq.CrossUnsafeL2Head(), "cross-safe blocks are cross-unsafe")
+		require.NoError(t, eq.tryPromoteCross(context.Background()))
+		require.Equal(t, refA1, eq.CrossSafeL2Head())
+		require.Equal(t, refA2, eq.CrossUnsafeL2Head())
+		require.ErrorIs(t, eq.tryPromoteCross(context.Background()), io.EOF)
+		eng.AssertExpectations(t)
+	})
+
+	t.Run("wait", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		sup := &fakeSupervisor{levels: map[common.Hash]interop.SafetyLevel{
+			refA1.Hash: interop.Unsafe,
+		}}
+		eq := newQueue(new(uint64), eng, sup)
+		eng.ExpectL2BlockRefByNumber(1, refA1, nil)
+		require.ErrorIs(t, eq.tryPromoteCross(context.Background()), io.EOF)
+		require.Equal(t, refA0, eq.CrossSafeL2Head())
+		require.Equal(t, refA0, eq.CrossUnsafeL2Head())
+		eng.AssertExpectations(t)
+	})
+
+	t.Run("supervisor unavailable", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		sup := &fakeSupervisor{err: errors.New("connection refused")}
+		eq := newQueue(new(uint64), eng, sup)
+		eng.ExpectL2BlockRefByNumber(1, refA1, nil)
+		require.ErrorIs(t, eq.tryPromoteCross(context.Background()), io.EOF, "must not stop the local derivation")
+		require.Equal(t, refA0, eq.CrossSafeL2Head())
+		require.Equal(t, refA0, eq.CrossUnsafeL2Head())
+		require.Equal(t, refA1, eq.SafeL2Head())
+		require.Equal(t, refA2, eq.UnsafeL2Head())
+		eng.AssertExpectations(t)
+	})
+
+	t.Run("no supervisor", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		eq := newQueue(new(uint64), eng, nil)
+		eng.ExpectL2BlockRefByNumber(1, refA1, nil)
+		require.ErrorIs(t, eq.tryPromoteCross(context.Background()), io.EOF)
+		require.Equal(t, refA0, eq.CrossSafeL2Head())
+		eng.AssertExpectations(t)
+	})
+
+	t.Run("no finality before cross-safe", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		eq := newQueue(new(uint64), eng, &fakeSupervisor{})
+		eq.finalizedL1 = refA
+		eq.finalityData = []FinalityData{{L2Block: refA1, L1Block: refA.ID()}}
+		eq.tryFinalizeL2()
+		require.Equal(t, refA0, eq.Finalized())
+		eq.crossSafeHead = refA1
+		eq.tryFinalizeL2()
+		require.Equal(t, refA1, eq.Finalized())
+	})
+
+	t.Run("drop invalid unsafe block", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		sup := &fakeSupervisor{levels: map[common.Hash]interop.SafetyLevel{
+			refA1.Hash: interop.CrossSafe,
+			refA2.Hash: interop.Invalid,
+		}}
+		eq := newQueue(new(uint64), eng, sup)
+		require.NoError(t, eq.tryPromoteCross(context.Background()))
+		require.NoError(t, eq.tryPromoteCross(context.Background()))
+		require.Equal(t, refA1, eq.UnsafeL2Head())
+		require.Equal(t, refA1, eq.EngineSyncTarget())
+		require.True(t, eq.needForkchoiceUpdate)
+	})
+
+	t.Run("invalid derived block", func(t *testing.T) {
+		eng := &testutils.MockEngine{}
+		sup := &fakeSupervisor{levels: map[common.Hash]interop.SafetyLevel{
+			refA1.Hash: interop.Invalid,
+		}}
+		eq := newQueue(new(uint64), eng, sup)
+		require.ErrorIs(t, eq.tryPromoteCross(context.Background()), ErrCritical)
+	})
+}
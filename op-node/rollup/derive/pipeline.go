@@ -7,12 +7,17 @@ import (
 	"io"
 
 	"github.com/ethereum/go-ethereum/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/sync"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
 )
 
+const tracerName = "github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+
 type Metrics interface {
 	RecordL1Ref(name string, ref eth.L1BlockRef)
 	RecordL2Ref(name string, ref eth.L2BlockRef)
@@ -52,6 +57,8 @@ type EngineQueueStage interface {
 	UnsafeL2Head() eth.L2BlockRef
 	SafeL2Head() eth.L2BlockRef
 	EngineSyncTarget() eth.L2BlockRef
+	CrossUnsafeL2Head() eth.L2BlockRef
+	CrossSafeL2Head() eth.L2BlockRef
 	Origin() eth.L1BlockRef
 	SystemConfig() eth.SystemConfig
 	SetUnsafeHead(head eth.L2BlockRef)
@@ -81,21 +88,23 @@ type DerivationPipeline struct {
 }
 
 // NewDerivationPipeline creates a derivation pipeline, which should be reset before use.
-func NewDerivationPipeline(log log.Logger, cfg *rollup.Config, l1Fetcher L1Fetcher, engine Engine, metrics Metrics, syncCfg *sync.Config) *DerivationPipeline {
+// The blobs fetcher is only used once blobs are enabled, the alt-DA fetcher only by chains with an alt-DA config,
+// and the supervisor only once interop is active. All may be nil.
+func NewDerivationPipeline(log log.Logger, cfg *rollup.Config, l1Fetcher L1Fetcher, l1Blobs L1BlobsFetcher, altDA AltDAFetcher, supervisor Supervisor, engine Engine, metrics Metrics, syncCfg *sync.Config) *DerivationPipeline {
 
 	// Pull stages
 	l1Traversal := NewL1Traversal(log, cfg, l1Fetcher)
-	dataSrc := NewDataSourceFactory(log, cfg, l1Fetcher) // auxiliary stage for L1Retrieval
+	dataSrc := NewDataSourceFactory(log, cfg, l1Fetcher, l1Blobs, altDA) // auxiliary stage for L1Retrieval
 	l1Src := NewL1Retrieval(log, dataSrc, l1Traversal)
 	frameQueue := NewFrameQueue(log, l1Src)
 	bank := NewChannelBank(log, cfg, frameQueue, l1Fetcher, metrics)
 	chInReader := NewChannelInReader(cfg, log, bank, metrics)
-	batchQueue := NewBatchQueue(log, cfg, chInReader)
+	batchQueue := NewBatchQueue(log, cfg, chInReader, engine)
 	attrBuilder := NewFetchingAttributesBuilder(cfg, l1Fetcher, engine)
 	attributesQueue := NewAttributesQueue(log, cfg, attrBuilder, batchQueue)
 
 	// Step stages
-	eng := NewEngineQueue(log, cfg, engine, metrics, attributesQueue, l1Fetcher, syncCfg)
+	eng := NewEngineQueue(log, cfg, engine, metrics, attributesQueue, l1Fetcher, syncCfg, supervisor)
 
 	// Reset from engine queue then up from L1 Traversal. The stages do not talk to each other during
 	// the reset, but after the engine queue, this is the order in which the stages could talk to each other.
@@ -157,6 +166,16 @@ func (dp *DerivationPipeline) EngineSyncTarget() eth.L2BlockRef {
 	return dp.eng.EngineSyncTarget()
 }
 
+// CrossUnsafeL2Head returns the last unsafe L2 block of which the executing messages were verified
+func (dp *DerivationPipeline) CrossUnsafeL2Head() eth.L2BlockRef {
+	return dp.eng.CrossUnsafeL2Head()
+}
+
+// CrossSafeL2Head returns the last safe L2 block of which the executing messages were verified
+func (dp *DerivationPipeline) CrossSafeL2Head() eth.L2BlockRef {
+	return dp.eng.CrossSafeL2Head()
+}
+
 func (dp *DerivationPipeline) StartPayload(ctx context.Context, parent eth.L2BlockRef, attrs *eth.PayloadAttributes, updateSafe bool) (errType BlockInsertionErrType, err error) {
 	return dp.eng.StartPayload(ctx, parent, attrs, updateSafe)
 }
@@ -165,6 +184,10 @@ func (dp *DerivationPipeline) ConfirmPayload(ctx context.Context) (out *eth.Exec
 	return dp.eng.ConfirmPayload(ctx)
 }
 
+func (dp *DerivationPipeline) PeekPayload(ctx context.Context) (*eth.ExecutionPayload, error) {
+	return dp.eng.PeekPayload(ctx)
+}
+
 func (dp *DerivationPipeline) CancelPayload(ctx context.Context, force bool) error {
 	return dp.eng.CancelPayload(ctx, force)
 }
@@ -189,11 +212,23 @@ func (dp *DerivationPipeline) UnsafeL2SyncTarget() eth.L2BlockRef {
 // Any other error is critical and the derivation pipeline should be reset.
 // An error is expected when the underlying source closes.
 // When Step returns nil, it should be called again, to continue the derivation process.
-func (dp *DerivationPipeline) Step(ctx context.Context) error {
+func (dp *DerivationPipeline) Step(ctx context.Context) (err error) {
 	defer dp.metrics.RecordL1Ref("l1_derived", dp.Origin())
+	ctx, span := tracing.Tracer(tracerName).Start(ctx, "derivation.step", trace.WithAttributes(
+		attribute.Int64("l1_origin", int64(dp.Origin().Number)),
+		attribute.Bool("resetting", dp.resetting < len(dp.stages))))
+	defer func() {
+		if err == io.EOF {
+			// Waiting for new L1 data is not a failure of the step.
+			tracing.EndSpan(span, nil)
+		} else {
+			tracing.EndSpan(span, err)
+		}
+	}()
 
 	// if any stages need to be reset, do that first.
 	if dp.resetting < len(dp.stages) {
+		span.SetAttributes(attribute.Int("reset_stage", dp.resetting))
 		if err := dp.stages[dp.resetting].Reset(ctx, dp.eng.Origin(), dp.eng.SystemConfig()); err == io.EOF {
 			dp.log.Debug("reset of stage completed", "stage", dp.resetting, "origin", dp.eng.Origin())
 			dp.resetting += 1
@@ -20,4 +20,8 @@ type Config struct {
 	// SequencerMaxSafeLag is the maximum number of L2 blocks for restricting the distance between L2 safe and unsafe.
 	// Disabled if 0.
 	SequencerMaxSafeLag uint64 `json:"sequencer_max_safe_lag"`
+
+	// SequencerPolicy is the initial policy for the transactions of the blocks that the sequencer builds.
+	// It can be changed at runtime with the admin RPC.
+	SequencerPolicy SequencerPolicy `json:"sequencer_policy"`
 }
@@ -86,6 +86,10 @@ func (m *MeteredEngine) ConfirmPayload(ctx context.Context) (out *eth.ExecutionP
 	return payload, errType, err
 }
 
+func (m *MeteredEngine) PeekPayload(ctx context.Context) (*eth.ExecutionPayload, error) {
+	return m.inner.PeekPayload(ctx)
+}
+
 func (m *MeteredEngine) CancelPayload(ctx context.Context, force bool) error {
 	return m.inner.CancelPayload(ctx, force)
 }
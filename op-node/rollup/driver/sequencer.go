@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -41,6 +42,13 @@ type Sequencer struct {
 
 	metrics SequencerMetrics
 
+	// policy restricts the transactions of the blocks that the sequencer builds, and can be changed at runtime.
+	policy atomic.Pointer[SequencerPolicy]
+	signer types.Signer
+
+	// attrs of the block that is being built, to rebuild the block if it does not meet the policy.
+	attrs *eth.PayloadAttributes
+
 	// timeNow enables sequencer testing to mock the time
 	timeNow func() time.Time
 
@@ -56,7 +64,21 @@ func NewSequencer(log log.Logger, cfg *rollup.Config, engine derive.ResettableEn
 		attrBuilder:      attributesBuilder,
 		l1OriginSelector: l1OriginSelector,
 		metrics:          metrics,
+		signer:           types.LatestSignerForChainID(cfg.L2ChainID),
+	}
+}
+
+// SetPolicy changes the policy that applies to the blocks that the sequencer builds next.
+func (d *Sequencer) SetPolicy(policy SequencerPolicy) {
+	d.policy.Store(&policy)
+}
+
+// Policy returns the current sequencer policy.
+func (d *Sequencer) Policy() SequencerPolicy {
+	if p := d.policy.Load(); p != nil {
+		return *p
 	}
+	return SequencerPolicy{}
 }
 
 // StartBuildingBlock initiates a block building job on top of the given L2 head, safe and finalized blocks, and using the provided l1Origin.
@@ -100,6 +122,7 @@ func (d *Sequencer) StartBuildingBlock(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to start building on top of L2 chain %s, error (%d): %w", l2Head, errTyp, err)
 	}
+	d.attrs = attrs
 	return nil
 }
 
@@ -107,18 +130,59 @@ func (d *Sequencer) StartBuildingBlock(ctx context.Context) error {
 // Warning: the safe and finalized L2 blocks as viewed during the initiation of the block building are reused for completion of the block building.
 // The Execution engine should not change the safe and finalized blocks between start and completion of block building.
 func (d *Sequencer) CompleteBuildingBlock(ctx context.Context) (*eth.ExecutionPayload, error) {
+	if err := d.applyPolicy(ctx); err != nil {
+		return nil, err
+	}
 	payload, errTyp, err := d.engine.ConfirmPayload(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to complete building block: error (%d): %w", errTyp, err)
 	}
+	d.attrs = nil
 	return payload, nil
 }
 
+// applyPolicy checks the transactions of the block that is being built against the sequencer policy.
+// If any transactions are not allowed, the block is rebuilt with only the allowed transactions,
+// since the transactions of the engine tx-pool cannot be filtered before they are included in the block.
+func (d *Sequencer) applyPolicy(ctx context.Context) error {
+	policy := d.policy.Load()
+	if policy == nil || !policy.Enabled() || d.attrs == nil || d.attrs.NoTxPool {
+		return nil
+	}
+	onto, id, _ := d.engine.BuildingPayload()
+	if id == (eth.PayloadID{}) {
+		return nil // ConfirmPayload reports that there is no block to complete
+	}
+	payload, err := d.engine.PeekPayload(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check block against sequencer policy: %w", err)
+	}
+	txs, filtered, err := policy.Filter(d.signer, payload)
+	if err != nil {
+		return fmt.Errorf("failed to apply sequencer policy: %w", err)
+	}
+	if !filtered {
+		return nil
+	}
+	d.log.Info("Rebuilding block to meet sequencer policy", "parent", onto,
+		"txs", len(payload.Transactions), "allowed", len(txs))
+	attrs := *d.attrs
+	attrs.NoTxPool = true
+	attrs.Transactions = append(append(make([]eth.Data, 0, len(d.attrs.Transactions)+len(txs)), d.attrs.Transactions...), txs...)
+	d.CancelBuildingBlock(ctx)
+	if errTyp, err := d.engine.StartPayload(ctx, onto, &attrs, false); err != nil {
+		return fmt.Errorf("failed to rebuild block on top of L2 chain %s to meet sequencer policy, error (%d): %w", onto, errTyp, err)
+	}
+	d.attrs = &attrs
+	return nil
+}
+
 // CancelBuildingBlock cancels the current open block building job.
 // This sequencer only maintains one block building job at a time.
 func (d *Sequencer) CancelBuildingBlock(ctx context.Context) {
 	// force-cancel, we can always continue block building, and any error is logged by the engine state
 	_ = d.engine.CancelPayload(ctx, true)
+	d.attrs = nil
 }
 
 // PlanNextSequencerAction returns a desired delay till the RunNextSequencerAction call.
@@ -0,0 +1,89 @@
+package driver
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// SequencerPolicy restricts the transactions that the sequencer includes in the blocks that it builds,
+// on top of the rules of the execution engine. Deposits are never restricted.
+type SequencerPolicy struct {
+	// MaxBlockGas caps the gas of the transactions in a block, below the L2 block gas limit.
+	// The gas limits of the transactions are counted, since the gas used is unknown when selecting them.
+	// Disabled if 0.
+	MaxBlockGas uint64 `json:"max_block_gas"`
+	// MaxBlockDA caps the total size in bytes of the transactions in a block, to throttle the
+	// data-availability usage of the chain. Disabled if 0.
+	MaxBlockDA uint64 `json:"max_block_da"`
+	// Denylist holds the addresses that transactions may not be sent from or to.
+	Denylist []common.Address `json:"denylist"`
+}
+
+// Enabled returns true if the policy restricts any transactions.
+func (p *SequencerPolicy) Enabled() bool {
+	return p.MaxBlockGas != 0 || p.MaxBlockDA != 0 || len(p.Denylist) != 0
+}
+
+// Filter returns the non-deposit transactions of the payload that the policy allows,
+// and whether any transactions were filtered out.
+// Once a transaction is filtered out, all later transactions of the same sender are too,
+// as these would not have valid nonces anymore.
+func (p *SequencerPolicy) Filter(signer types.Signer, payload *eth.ExecutionPayload) (out []eth.Data, filtered bool, err error) {
+	denied := make(map[common.Address]struct{}, len(p.Denylist))
+	for _, addr := range p.Denylist {
+		denied[addr] = struct{}{}
+	}
+	// The gas cap only applies when the block uses more gas, e.g. to not filter out transactions
+	// that have a large gas limit but only use a fraction of it.
+	capGas := p.MaxBlockGas != 0 && uint64(payload.GasUsed) > p.MaxBlockGas
+	dropped := make(map[common.Address]struct{})
+	var gas, size uint64
+	for i, otx := range payload.Transactions {
+		if len(otx) > 0 && otx[0] == types.DepositTxType {
+			continue
+		}
+		var tx types.Transaction
+		if err := tx.UnmarshalBinary(otx); err != nil {
+			return nil, false, fmt.Errorf("failed to decode transaction %d: %w", i, err)
+		}
+		from, err := types.Sender(signer, &tx)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to recover sender of transaction %d: %w", i, err)
+		}
+		allowed := p.allowed(denied, from, tx.To())
+		if _, ok := dropped[from]; ok {
+			allowed = false
+		}
+		if allowed && capGas && gas+tx.Gas() > p.MaxBlockGas {
+			allowed = false
+		}
+		if allowed && p.MaxBlockDA != 0 && size+uint64(len(otx)) > p.MaxBlockDA {
+			allowed = false
+		}
+		if !allowed {
+			dropped[from] = struct{}{}
+			filtered = true
+			continue
+		}
+		gas += tx.Gas()
+		size += uint64(len(otx))
+		out = append(out, otx)
+	}
+	return out, filtered, nil
+}
+
+func (p *SequencerPolicy) allowed(denied map[common.Address]struct{}, from common.Address, to *common.Address) bool {
+	if _, ok := denied[from]; ok {
+		return false
+	}
+	if to != nil {
+		if _, ok := denied[*to]; ok {
+			return false
+		}
+	}
+	return true
+}
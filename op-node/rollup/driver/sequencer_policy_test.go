@@ -0,0 +1,119 @@
+package driver
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+type policyTestTxs struct {
+	t      *testing.T
+	signer types.Signer
+	nonces map[common.Address]uint64
+}
+
+func (p *policyTestTxs) tx(key *ecdsa.PrivateKey, to common.Address, gas uint64, dataSize int) eth.Data {
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	tx, err := types.SignNewTx(key, p.signer, &types.DynamicFeeTx{
+		ChainID:   p.signer.ChainID(),
+		Nonce:     p.nonces[from],
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+		Gas:       gas,
+		To:        &to,
+		Data:      make([]byte, dataSize),
+	})
+	require.NoError(p.t, err)
+	p.nonces[from]++
+	data, err := tx.MarshalBinary()
+	require.NoError(p.t, err)
+	return data
+}
+
+func TestSequencerPolicyFilter(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	signer := types.LatestSignerForChainID(big.NewInt(901))
+	txs := &policyTestTxs{t: t, signer: signer, nonces: make(map[common.Address]uint64)}
+	alice, bob := testutils.InsecureRandomKey(rng), testutils.InsecureRandomKey(rng)
+	aliceAddr, bobAddr := crypto.PubkeyToAddress(alice.PublicKey), crypto.PubkeyToAddress(bob.PublicKey)
+	denied := testutils.RandomAddress(rng)
+	other := testutils.RandomAddress(rng)
+	deposit := eth.Data{types.DepositTxType, 0xaa}
+
+	t.Run("disabled", func(t *testing.T) {
+		require.False(t, (&SequencerPolicy{}).Enabled())
+		require.True(t, (&SequencerPolicy{MaxBlockDA: 1}).Enabled())
+	})
+
+	t.Run("denylist", func(t *testing.T) {
+		a0 := txs.tx(alice, other, 21000, 0)
+		a1 := txs.tx(alice, denied, 21000, 0)
+		a2 := txs.tx(alice, other, 21000, 0)
+		b0 := txs.tx(bob, other, 21000, 0)
+		payload := &eth.ExecutionPayload{Transactions: []eth.Data{deposit, a0, a1, b0, a2}}
+		policy := &SequencerPolicy{Denylist: []common.Address{denied}}
+		out, filtered, err := policy.Filter(signer, payload)
+		require.NoError(t, err)
+		require.True(t, filtered)
+		// a2 depends on the nonce of the dropped a1
+		require.Equal(t, []eth.Data{a0, b0}, out)
+
+		policy = &SequencerPolicy{Denylist: []common.Address{bobAddr}}
+		out, filtered, err = policy.Filter(signer, payload)
+		require.NoError(t, err)
+		require.True(t, filtered)
+		require.Equal(t, []eth.Data{a0, a1, a2}, out)
+
+		policy = &SequencerPolicy{Denylist: []common.Address{aliceAddr, bobAddr}}
+		out, filtered, err = policy.Filter(signer, &eth.ExecutionPayload{Transactions: []eth.Data{deposit}})
+		require.NoError(t, err)
+		require.False(t, filtered)
+		require.Empty(t, out)
+	})
+
+	t.Run("max block gas", func(t *testing.T) {
+		a0 := txs.tx(alice, other, 50_000, 0)
+		b0 := txs.tx(bob, other, 60_000, 0)
+		b1 := txs.tx(bob, other, 30_000, 0)
+		payload := &eth.ExecutionPayload{Transactions: []eth.Data{deposit, a0, b0, b1}, GasUsed: hexutil.Uint64(100_000)}
+		policy := &SequencerPolicy{MaxBlockGas: 100_000}
+		out, filtered, err := policy.Filter(signer, payload)
+		require.NoError(t, err)
+		require.False(t, filtered, "block gas used is within the cap")
+		require.Equal(t, []eth.Data{a0, b0, b1}, out)
+
+		policy = &SequencerPolicy{MaxBlockGas: 90_000}
+		out, filtered, err = policy.Filter(signer, payload)
+		require.NoError(t, err)
+		require.True(t, filtered)
+		require.Equal(t, []eth.Data{a0}, out)
+	})
+
+	t.Run("max block DA", func(t *testing.T) {
+		a0 := txs.tx(alice, other, 100_000, 200)
+		b0 := txs.tx(bob, other, 100_000, 500)
+		a1 := txs.tx(alice, other, 100_000, 100)
+		payload := &eth.ExecutionPayload{Transactions: []eth.Data{deposit, a0, b0, a1}}
+		policy := &SequencerPolicy{MaxBlockDA: uint64(len(a0) + len(a1))}
+		out, filtered, err := policy.Filter(signer, payload)
+		require.NoError(t, err)
+		require.True(t, filtered)
+		require.Equal(t, []eth.Data{a0, a1}, out)
+	})
+
+	t.Run("invalid tx", func(t *testing.T) {
+		policy := &SequencerPolicy{MaxBlockDA: 1000}
+		_, _, err := policy.Filter(signer, &eth.ExecutionPayload{Transactions: []eth.Data{{0x02, 0x01}}})
+		require.ErrorContains(t, err, "failed to decode transaction 0")
+	})
+}
@@ -95,6 +95,13 @@ func (m *FakeEngineControl) ConfirmPayload(ctx context.Context) (out *eth.Execut
 	return payload, derive.BlockInsertOK, nil
 }
 
+func (m *FakeEngineControl) PeekPayload(ctx context.Context) (*eth.ExecutionPayload, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.makePayload(m.buildingOnto, m.buildingAttrs), nil
+}
+
 func (m *FakeEngineControl) CancelPayload(ctx context.Context, force bool) error {
 	if force {
 		m.resetBuildingState()
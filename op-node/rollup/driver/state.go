@@ -15,6 +15,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/retry"
 )
@@ -52,6 +53,10 @@ type Driver struct {
 	// true when the sequencer is active, false when it is not.
 	sequencerActive chan chan bool
 
+	// Upon receiving a block number in this channel, the unsafe head is rewound to the given block,
+	// and the derivation pipeline is reset. It tells the caller the result by returning an error (or nil).
+	setUnsafeHead chan numberAndErrorChannel
+
 	// sequencerNotifs is notified when the sequencer is started or stopped
 	sequencerNotifs SequencerStateListener
 
@@ -88,6 +93,9 @@ type Driver struct {
 	snapshotLog log.Logger
 	done        chan struct{}
 
+	// clock schedules the sequencer actions, derivation step re-attempts and sync checks
+	clock clock.Clock
+
 	wg gosync.WaitGroup
 }
 
@@ -197,7 +205,7 @@ func (s *Driver) eventLoop() {
 			if delayedStepReq == nil {
 				delay := bOffStrategy.Duration(stepAttempts)
 				s.log.Debug("scheduling re-attempt with delay", "attempts", stepAttempts, "delay", delay)
-				delayedStepReq = time.After(delay)
+				delayedStepReq = s.clock.After(delay)
 			} else {
 				s.log.Debug("ignoring step request, already scheduled re-attempt after previous failure", "attempts", stepAttempts)
 			}
@@ -211,11 +219,11 @@ func (s *Driver) eventLoop() {
 	// L1 chain that we need to handle.
 	reqStep()
 
-	sequencerTimer := time.NewTimer(0)
+	sequencerTimer := s.clock.NewTimer(0)
 	var sequencerCh <-chan time.Time
 	planSequencerAction := func() {
 		delay := s.sequencer.PlanNextSequencerAction()
-		sequencerCh = sequencerTimer.C
+		sequencerCh = sequencerTimer.Ch()
 		if len(sequencerCh) > 0 { // empty if not already drained before resetting
 			<-sequencerCh
 		}
@@ -225,7 +233,7 @@ func (s *Driver) eventLoop() {
 	// Create a ticker to check if there is a gap in the engine queue. Whenever
 	// there is, we send requests to sync source to retrieve the missing payloads.
 	syncCheckInterval := time.Duration(s.config.BlockTime) * time.Second * 2
-	altSyncTicker := time.NewTicker(syncCheckInterval)
+	altSyncTicker := s.clock.NewTicker(syncCheckInterval)
 	defer altSyncTicker.Stop()
 	lastUnsafeL2 := s.derivation.UnsafeL2Head()
 
@@ -280,7 +288,7 @@ func (s *Driver) eventLoop() {
 				}
 			}
 			planSequencerAction() // schedule the next sequencer action to keep the sequencing looping
-		case <-altSyncTicker.C:
+		case <-altSyncTicker.Ch():
 			// Check if there is a gap in the current unsafe payload queue.
 			ctx, cancel := context.WithTimeout(ctx, time.Second*2)
 			err := s.checkForGapInUnsafeQueue(ctx)
@@ -388,6 +396,8 @@ func (s *Driver) eventLoop() {
 			}
 		case respCh := <-s.sequencerActive:
 			respCh <- !s.driverConfig.SequencerStopped
+		case req := <-s.setUnsafeHead:
+			req.err <- s.rewindUnsafeHead(ctx, req.number)
 		case <-s.done:
 			return
 		}
@@ -469,6 +479,89 @@ func (s *Driver) SequencerActive(ctx context.Context) (bool, error) {
 	}
 }
 
+// SequencerPolicy returns the policy for the transactions of the blocks that the sequencer builds.
+func (s *Driver) SequencerPolicy(ctx context.Context) (SequencerPolicy, error) {
+	if !s.driverConfig.SequencerEnabled {
+		return SequencerPolicy{}, errors.New("sequencer is not enabled")
+	}
+	return s.sequencer.Policy(), nil
+}
+
+// SetSequencerPolicy changes the policy for the transactions of the blocks that the sequencer builds.
+// The policy applies from the next block that the sequencer completes.
+func (s *Driver) SetSequencerPolicy(ctx context.Context, policy SequencerPolicy) error {
+	if !s.driverConfig.SequencerEnabled {
+		return errors.New("sequencer is not enabled")
+	}
+	s.sequencer.SetPolicy(policy)
+	s.log.Info("Updated sequencer policy", "max_block_gas", policy.MaxBlockGas,
+		"max_block_da", policy.MaxBlockDA, "denylist", len(policy.Denylist))
+	return nil
+}
+
+// SetUnsafeHead rewinds the unsafe head to the given block number, discarding the unsafe blocks above it.
+// The discarded blocks are re-derived from L1 or re-synced from the network.
+// The sequencer must be stopped, and the block must not be older than the finalized head.
+func (s *Driver) SetUnsafeHead(ctx context.Context, num uint64) error {
+	req := numberAndErrorChannel{
+		number: num,
+		err:    make(chan error, 1),
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case s.setUnsafeHead <- req:
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-req.err:
+			return err
+		}
+	}
+}
+
+// rewindUnsafeHead points the forkchoice of the engine at the block with the given number, and resets the
+// derivation pipeline to continue from there. It must only be called from the driver event loop.
+func (s *Driver) rewindUnsafeHead(ctx context.Context, num uint64) error {
+	if s.driverConfig.SequencerEnabled && !s.driverConfig.SequencerStopped {
+		return errors.New("sequencer must be stopped before rewinding the unsafe head")
+	}
+	finalized := s.derivation.Finalized()
+	if num < finalized.Number {
+		return fmt.Errorf("cannot rewind unsafe head to block %d, older than finalized head %s", num, finalized)
+	}
+	unsafe := s.derivation.UnsafeL2Head()
+	if num >= unsafe.Number {
+		return fmt.Errorf("cannot rewind unsafe head to block %d, not older than unsafe head %s", num, unsafe)
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+	ref, err := s.l2.L2BlockRefByNumber(ctx, num)
+	if err != nil {
+		return fmt.Errorf("failed to fetch L2 block %d: %w", num, err)
+	}
+	safe := s.derivation.SafeL2Head()
+	if safe.Number > ref.Number {
+		safe = ref
+	}
+	fc := eth.ForkchoiceState{
+		HeadBlockHash:      ref.Hash,
+		SafeBlockHash:      safe.Hash,
+		FinalizedBlockHash: finalized.Hash,
+	}
+	res, err := s.l2.ForkchoiceUpdate(ctx, &fc, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update forkchoice to %s: %w", ref, err)
+	}
+	if res.PayloadStatus.Status != eth.ExecutionValid {
+		return fmt.Errorf("forkchoice update to %s was not accepted: %w", ref, eth.ForkchoiceUpdateErr(res.PayloadStatus))
+	}
+	s.log.Warn("Unsafe head is manually rewound", "unsafe", ref, "prev_unsafe", unsafe, "safe", safe)
+	s.derivation.Reset()
+	s.metrics.RecordPipelineReset()
+	return nil
+}
+
 // syncStatus returns the current sync status, and should only be called synchronously with
 // the driver event loop to avoid retrieval of an inconsistent status.
 func (s *Driver) syncStatus() *eth.SyncStatus {
@@ -483,6 +576,8 @@ func (s *Driver) syncStatus() *eth.SyncStatus {
 		FinalizedL2:        s.derivation.Finalized(),
 		UnsafeL2SyncTarget: s.derivation.UnsafeL2SyncTarget(),
 		EngineSyncTarget:   s.derivation.EngineSyncTarget(),
+		CrossUnsafeL2:      s.derivation.CrossUnsafeL2Head(),
+		CrossSafeL2:        s.derivation.CrossSafeL2Head(),
 	}
 }
 
@@ -546,6 +641,11 @@ type hashAndErrorChannel struct {
 	err  chan error
 }
 
+type numberAndErrorChannel struct {
+	number uint64
+	err    chan error
+}
+
 // checkForGapInUnsafeQueue checks if there is a gap in the unsafe queue and attempts to retrieve the missing payloads from an alt-sync method.
 // WARNING: This is only an outgoing signal, the blocks are not guaranteed to be retrieved.
 // Results are received through OnUnsafeL2Payload.
@@ -0,0 +1,176 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+type fakeDerivation struct {
+	DerivationPipeline
+	finalized eth.L2BlockRef
+	safe      eth.L2BlockRef
+	unsafe    eth.L2BlockRef
+	resets    int
+}
+
+func (f *fakeDerivation) Reset() {
+	f.resets++
+}
+
+func (f *fakeDerivation) Finalized() eth.L2BlockRef {
+	return f.finalized
+}
+
+func (f *fakeDerivation) SafeL2Head() eth.L2BlockRef {
+	return f.safe
+}
+
+func (f *fakeDerivation) UnsafeL2Head() eth.L2BlockRef {
+	return f.unsafe
+}
+
+type resetMetrics struct {
+	Metrics
+	resets int
+}
+
+func (m *resetMetrics) RecordPipelineReset() {
+	m.resets++
+}
+
+func TestRewindUnsafeHead(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	refs := make([]eth.L2BlockRef, 10)
+	for i := range refs {
+		refs[i] = eth.L2BlockRef{Hash: testutils.RandomHash(rng), Number: uint64(i)}
+		if i > 0 {
+			refs[i].ParentHash = refs[i-1].Hash
+		}
+	}
+	valid := &eth.ForkchoiceUpdatedResult{PayloadStatus: eth.PayloadStatusV1{Status: eth.ExecutionValid}}
+	invalid := &eth.ForkchoiceUpdatedResult{PayloadStatus: eth.PayloadStatusV1{Status: eth.ExecutionInvalid}}
+
+	tests := []struct {
+		name      string
+		sequencer *Config
+		num       uint64
+		// expect sets up the expected engine calls
+		expect func(eng *testutils.MockEngine)
+		err    string
+	}{
+		{
+			name:      "SequencerActive",
+			sequencer: &Config{SequencerEnabled: true},
+			num:       5,
+			err:       "sequencer must be stopped",
+		},
+		{
+			name: "BelowFinalized",
+			num:  1,
+			err:  "older than finalized head",
+		},
+		{
+			name: "AtUnsafeHead",
+			num:  8,
+			err:  "not older than unsafe head",
+		},
+		{
+			name: "AboveUnsafeHead",
+			num:  9,
+			err:  "not older than unsafe head",
+		},
+		{
+			name: "FetchFailed",
+			num:  5,
+			expect: func(eng *testutils.MockEngine) {
+				eng.ExpectL2BlockRefByNumber(5, eth.L2BlockRef{}, errors.New("not found"))
+			},
+			err: "failed to fetch L2 block 5",
+		},
+		{
+			name: "ForkchoiceUpdateFailed",
+			num:  5,
+			expect: func(eng *testutils.MockEngine) {
+				eng.ExpectL2BlockRefByNumber(5, refs[5], nil)
+				eng.ExpectForkchoiceUpdate(&eth.ForkchoiceState{HeadBlockHash: refs[5].Hash, SafeBlockHash: refs[5].Hash, FinalizedBlockHash: refs[2].Hash}, nil, nil, errors.New("offline"))
+			},
+			err: "failed to update forkchoice",
+		},
+		{
+			name: "ForkchoiceUpdateInvalid",
+			num:  5,
+			expect: func(eng *testutils.MockEngine) {
+				eng.ExpectL2BlockRefByNumber(5, refs[5], nil)
+				eng.ExpectForkchoiceUpdate(&eth.ForkchoiceState{HeadBlockHash: refs[5].Hash, SafeBlockHash: refs[5].Hash, FinalizedBlockHash: refs[2].Hash}, nil, invalid, nil)
+			},
+			err: "was not accepted",
+		},
+		{
+			name: "RewindBelowSafeHead",
+			num:  5,
+			expect: func(eng *testutils.MockEngine) {
+				eng.ExpectL2BlockRefByNumber(5, refs[5], nil)
+				eng.ExpectForkchoiceUpdate(&eth.ForkchoiceState{HeadBlockHash: refs[5].Hash, SafeBlockHash: refs[5].Hash, FinalizedBlockHash: refs[2].Hash}, nil, valid, nil)
+			},
+		},
+		{
+			name:      "RewindAboveSafeHead",
+			sequencer: &Config{SequencerEnabled: true, SequencerStopped: true},
+			num:       7,
+			expect: func(eng *testutils.MockEngine) {
+				eng.ExpectL2BlockRefByNumber(7, refs[7], nil)
+				eng.ExpectForkchoiceUpdate(&eth.ForkchoiceState{HeadBlockHash: refs[7].Hash, SafeBlockHash: refs[6].Hash, FinalizedBlockHash: refs[2].Hash}, nil, valid, nil)
+			},
+		},
+		{
+			name: "RewindToFinalized",
+			num:  2,
+			expect: func(eng *testutils.MockEngine) {
+				eng.ExpectL2BlockRefByNumber(2, refs[2], nil)
+				eng.ExpectForkchoiceUpdate(&eth.ForkchoiceState{HeadBlockHash: refs[2].Hash, SafeBlockHash: refs[2].Hash, FinalizedBlockHash: refs[2].Hash}, nil, valid, nil)
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			eng := &testutils.MockEngine{}
+			if test.expect != nil {
+				test.expect(eng)
+			}
+			derivation := &fakeDerivation{finalized: refs[2], safe: refs[6], unsafe: refs[8]}
+			driverConfig := test.sequencer
+			if driverConfig == nil {
+				driverConfig = &Config{}
+			}
+			m := &resetMetrics{}
+			s := &Driver{
+				log:          testlog.Logger(t, log.LvlInfo),
+				derivation:   derivation,
+				driverConfig: driverConfig,
+				l2:           eng,
+				metrics:      m,
+			}
+			err := s.rewindUnsafeHead(context.Background(), test.num)
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				require.Zero(t, derivation.resets, "must not reset the derivation pipeline")
+				require.Zero(t, m.resets)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 1, derivation.resets, "must reset the derivation pipeline to the new unsafe head")
+				require.Equal(t, 1, m.resets)
+			}
+			eng.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package interop
+
+import "fmt"
+
+// SafetyLevel is the safety of an L2 block w.r.t. the executing messages that it contains.
+// An executing message is only valid if the initiating message exists on the source chain of the dependency set,
+// and is at least as safe as the executing block is considered to be.
+type SafetyLevel string
+
+const (
+	// Invalid blocks execute a message that was never initiated, or that conflicts with the source chain.
+	Invalid SafetyLevel = "invalid"
+	// Unsafe blocks have not been cross-verified yet, only the block itself is locally valid.
+	Unsafe SafetyLevel = "unsafe"
+	// CrossUnsafe blocks only execute messages that were initiated in unsafe, or safer, blocks of the source chains.
+	CrossUnsafe SafetyLevel = "cross-unsafe"
+	// CrossSafe blocks only execute messages that were initiated in cross-safe blocks of the source chains.
+	CrossSafe SafetyLevel = "cross-safe"
+)
+
+var SafetyLevels = []SafetyLevel{Invalid, Unsafe, CrossUnsafe, CrossSafe}
+
+func (lvl SafetyLevel) String() string {
+	return string(lvl)
+}
+
+func (lvl *SafetyLevel) UnmarshalText(text []byte) error {
+	for _, v := range SafetyLevels {
+		if string(text) == string(v) {
+			*lvl = v
+			return nil
+		}
+	}
+	return fmt.Errorf("unrecognized safety level: %q", text)
+}
+
+// AtLeast returns true if the safety level is the same as, or safer than, the given minimum safety level.
+// Invalid is never at least any level.
+func (lvl SafetyLevel) AtLeast(min SafetyLevel) bool {
+	if lvl == Invalid {
+		return false
+	}
+	return lvl.rank() >= min.rank()
+}
+
+func (lvl SafetyLevel) rank() int {
+	switch lvl {
+	case Unsafe:
+		return 1
+	case CrossUnsafe:
+		return 2
+	case CrossSafe:
+		return 3
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,27 @@
+package interop
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSafetyLevelAtLeast(t *testing.T) {
+	require.True(t, CrossSafe.AtLeast(CrossSafe))
+	require.True(t, CrossSafe.AtLeast(CrossUnsafe))
+	require.True(t, CrossUnsafe.AtLeast(Unsafe))
+	require.False(t, CrossUnsafe.AtLeast(CrossSafe))
+	require.False(t, Unsafe.AtLeast(CrossUnsafe))
+	require.False(t, Invalid.AtLeast(Unsafe))
+	require.False(t, Invalid.AtLeast(Invalid))
+}
+
+func TestSafetyLevelUnmarshalText(t *testing.T) {
+	for _, lvl := range SafetyLevels {
+		var out SafetyLevel
+		require.NoError(t, out.UnmarshalText([]byte(lvl.String())))
+		require.Equal(t, lvl, out)
+	}
+	var out SafetyLevel
+	require.Error(t, out.UnmarshalText([]byte("finalized")))
+}
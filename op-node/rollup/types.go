@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 
+	"github.com/ethereum-optimism/optimism/op-service/altda"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
 
@@ -34,6 +35,7 @@ var (
 	ErrChainIDsSame                  = errors.New("L1 and L2 chain IDs must be different")
 	ErrL1ChainIDNotPositive          = errors.New("L1 chain ID must be non-zero and positive")
 	ErrL2ChainIDNotPositive          = errors.New("L2 chain ID must be non-zero and positive")
+	ErrInvalidAltDACommitmentType    = errors.New("invalid alt-DA commitment type")
 )
 
 type Genesis struct {
@@ -79,8 +81,28 @@ type Config struct {
 	// Active if CanyonTime != nil && L2 block timestamp >= *CanyonTime, inactive otherwise.
 	CanyonTime *uint64 `json:"canyon_time,omitempty"`
 
+	// SpanBatchTime sets the activation time of span batches, which encode a span of consecutive L2 blocks
+	// in a single batch. Like BlobsEnabledL1Timestamp, it is compared with the time of the L1 block that
+	// includes the batch. Active if SpanBatchTime != nil && L1 block timestamp >= *SpanBatchTime.
 	SpanBatchTime *uint64 `json:"span_batch_time,omitempty"`
 
+	// InteropTime sets the activation time of interop: L2 blocks may execute messages that were initiated on
+	// other L2 chains of the dependency set, and are only cross-safe once the interop supervisor verified them.
+	// Active if InteropTime != nil && L2 block timestamp >= *InteropTime, inactive otherwise.
+	InteropTime *uint64 `json:"interop_time,omitempty"`
+
+	// BlobsEnabledL1Timestamp sets the L1 block timestamp from which the batch data is also read from the blobs
+	// of blob transactions to the batch inbox. Unlike the other forks, this is compared with the time of the L1
+	// block that is read, not of the L2 block. Active if BlobsEnabledL1Timestamp != nil && L1 block timestamp >=
+	// *BlobsEnabledL1Timestamp, inactive otherwise.
+	BlobsEnabledL1Timestamp *uint64 `json:"blobs_data,omitempty"`
+
+	// ChannelCompressionL1Timestamp sets the L1 block timestamp from which channels may also be compressed with
+	// brotli or zstd, instead of zlib. Like BlobsEnabledL1Timestamp, this is compared with the time of the L1 block
+	// that includes the channel. Active if ChannelCompressionL1Timestamp != nil && L1 block timestamp >=
+	// *ChannelCompressionL1Timestamp, inactive otherwise.
+	ChannelCompressionL1Timestamp *uint64 `json:"channel_compression_l1_time,omitempty"`
+
 	// Note: below addresses are part of the block-derivation process,
 	// and required to be the same network-wide to stay in consensus.
 
@@ -93,6 +115,18 @@ type Config struct {
 
 	// L1 address that declares the protocol versions, optional (Beta feature)
 	ProtocolVersionsAddress common.Address `json:"protocol_versions_address,omitempty"`
+
+	// AltDA is set if the batcher posts the batch data to an alternative data-availability layer,
+	// and only commitments to the data to the batch inbox. Optional (Beta feature)
+	AltDA *AltDAConfig `json:"alt_da,omitempty"`
+}
+
+// AltDAConfig configures the derivation of batch data from an alternative data-availability layer.
+type AltDAConfig struct {
+	// CommitmentType is the type of the commitments that the batcher posts: "keccak256" for commitments that are
+	// verified against the data, or "generic" for commitments that only the DA layer can resolve.
+	// Commitments of other types are ignored.
+	CommitmentType string `json:"commitment_type"`
 }
 
 // ValidateL1Config checks L1 config variables for errors.
@@ -257,6 +291,11 @@ func (cfg *Config) Check() error {
 	if cfg.L2ChainID.Sign() < 1 {
 		return ErrL2ChainIDNotPositive
 	}
+	if cfg.AltDA != nil {
+		if _, err := altda.ParseCommitmentType(cfg.AltDA.CommitmentType); err != nil {
+			return ErrInvalidAltDACommitmentType
+		}
+	}
 	return nil
 }
 
@@ -278,6 +317,22 @@ func (c *Config) IsSpanBatch(timestamp uint64) bool {
 	return c.SpanBatchTime != nil && timestamp >= *c.SpanBatchTime
 }
 
+// IsInterop returns true if the Interop hardfork is active at or past the given timestamp.
+func (c *Config) IsInterop(timestamp uint64) bool {
+	return c.InteropTime != nil && timestamp >= *c.InteropTime
+}
+
+// IsBlobsEnabled returns true if the batch data of the L1 block with the given timestamp is read from blobs.
+func (c *Config) IsBlobsEnabled(l1Timestamp uint64) bool {
+	return c.BlobsEnabledL1Timestamp != nil && l1Timestamp >= *c.BlobsEnabledL1Timestamp
+}
+
+// IsChannelCompression returns true if channels that are included in the L1 block with the given timestamp
+// may be compressed with any of the channel compression algorithms.
+func (c *Config) IsChannelCompression(l1Timestamp uint64) bool {
+	return c.ChannelCompressionL1Timestamp != nil && l1Timestamp >= *c.ChannelCompressionL1Timestamp
+}
+
 // Description outputs a banner describing the important parts of rollup configuration in a human-readable form.
 // Optionally provide a mapping of L2 chain IDs to network names to label the L2 chain with if not unknown.
 // The config should be config.Check()-ed before creating a description.
@@ -307,6 +362,12 @@ func (c *Config) Description(l2Chains map[string]string) string {
 	banner += fmt.Sprintf("  - Regolith: %s\n", fmtForkTimeOrUnset(c.RegolithTime))
 	banner += fmt.Sprintf("  - Canyon: %s\n", fmtForkTimeOrUnset(c.CanyonTime))
 	banner += fmt.Sprintf("  - SpanBatch: %s\n", fmtForkTimeOrUnset(c.SpanBatchTime))
+	banner += fmt.Sprintf("  - Interop: %s\n", fmtForkTimeOrUnset(c.InteropTime))
+	banner += fmt.Sprintf("  - Blobs (L1 timestamp): %s\n", fmtForkTimeOrUnset(c.BlobsEnabledL1Timestamp))
+	banner += fmt.Sprintf("  - Channel compression (L1 timestamp): %s\n", fmtForkTimeOrUnset(c.ChannelCompressionL1Timestamp))
+	if c.AltDA != nil {
+		banner += fmt.Sprintf("Alt-DA: %s commitments\n", c.AltDA.CommitmentType)
+	}
 	// Report the protocol version
 	banner += fmt.Sprintf("Node supports up to OP-Stack Protocol Version: %s\n", OPStackSupport)
 	return banner
@@ -334,6 +395,9 @@ func (c *Config) LogDescription(log log.Logger, l2Chains map[string]string) {
 		"l1_block_number", c.Genesis.L1.Number, "regolith_time", fmtForkTimeOrUnset(c.RegolithTime),
 		"canyon_time", fmtForkTimeOrUnset(c.CanyonTime),
 		"span_batch_time", fmtForkTimeOrUnset(c.SpanBatchTime),
+		"interop_time", fmtForkTimeOrUnset(c.InteropTime),
+		"blobs_l1_time", fmtForkTimeOrUnset(c.BlobsEnabledL1Timestamp),
+		"channel_compression_l1_time", fmtForkTimeOrUnset(c.ChannelCompressionL1Timestamp),
 	)
 }
 
@@ -11,6 +11,8 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+	optracing "github.com/ethereum-optimism/optimism/op-service/tracing"
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -43,7 +45,10 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 
 	configPersistence := NewConfigPersistence(ctx)
 
-	driverConfig := NewDriverConfig(ctx)
+	driverConfig, err := NewDriverConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load driver config: %w", err)
+	}
 
 	p2pSignerSetup, err := p2pcli.LoadSignerSetup(ctx)
 	if err != nil {
@@ -64,7 +69,7 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 
 	l2SyncEndpoint := NewL2SyncEndpointConfig(ctx)
 
-	syncConfig := NewSyncConfig(ctx)
+	syncConfig := NewSyncConfig(ctx, log)
 
 	haltOption := ctx.String(flags.RollupHalt.Name)
 	if haltOption == "none" {
@@ -81,6 +86,7 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 			ListenAddr:  ctx.String(flags.RPCListenAddr.Name),
 			ListenPort:  ctx.Int(flags.RPCListenPort.Name),
 			EnableAdmin: ctx.Bool(flags.RPCEnableAdmin.Name),
+			TLS:         optls.ReadCLIConfigWithPrefix(ctx, flags.RPCTLSFlagPrefix),
 		},
 		Metrics: node.MetricsConfig{
 			Enabled:    ctx.Bool(flags.MetricsEnabledFlag.Name),
@@ -88,10 +94,13 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 			ListenPort: ctx.Int(flags.MetricsPortFlag.Name),
 		},
 		Pprof: oppprof.CLIConfig{
-			Enabled:    ctx.Bool(flags.PprofEnabledFlag.Name),
-			ListenAddr: ctx.String(flags.PprofAddrFlag.Name),
-			ListenPort: ctx.Int(flags.PprofPortFlag.Name),
+			Enabled:      ctx.Bool(flags.PprofEnabledFlag.Name),
+			ListenAddr:   ctx.String(flags.PprofAddrFlag.Name),
+			ListenPort:   ctx.Int(flags.PprofPortFlag.Name),
+			PushEndpoint: ctx.String(flags.PprofPushEndpointFlag.Name),
+			PushInterval: ctx.Duration(flags.PprofPushIntervalFlag.Name),
 		},
+		Tracing:                     optracing.ReadCLIConfig(ctx),
 		P2P:                         p2pConfig,
 		P2PSigner:                   p2pSignerSetup,
 		L1EpochPollInterval:         ctx.Duration(flags.L1EpochPollIntervalFlag.Name),
@@ -104,6 +113,17 @@ func NewConfig(ctx *cli.Context, log log.Logger) (*node.Config, error) {
 		ConfigPersistence: configPersistence,
 		Sync:              *syncConfig,
 		RollupHalt:        haltOption,
+		AltDA: node.AltDAConfig{
+			DAServerURL: ctx.String(flags.AltDAServerFlag.Name),
+			Timeout:     ctx.Duration(flags.AltDAServerTimeoutFlag.Name),
+		},
+		Interop: node.InteropConfig{
+			SupervisorAddr: ctx.String(flags.InteropSupervisorFlag.Name),
+		},
+		Beacon: node.L1BeaconConfig{
+			Endpoint:  ctx.String(flags.BeaconAddr.Name),
+			Fallbacks: ctx.StringSlice(flags.BeaconArchiverAddrs.Name),
+		},
 	}
 
 	if err := cfg.LoadPersisted(log); err != nil {
@@ -123,6 +143,7 @@ func NewL1EndpointConfig(ctx *cli.Context) *node.L1EndpointConfig {
 		L1RPCKind:        sources.RPCProviderKind(strings.ToLower(ctx.String(flags.L1RPCProviderKind.Name))),
 		RateLimit:        ctx.Float64(flags.L1RPCRateLimit.Name),
 		BatchSize:        ctx.Int(flags.L1RPCMaxBatchSize.Name),
+		CacheSize:        ctx.Uint(flags.L1CacheSize.Name),
 		HttpPollInterval: ctx.Duration(flags.L1HTTPPollInterval.Name),
 	}
 }
@@ -174,14 +195,25 @@ func NewConfigPersistence(ctx *cli.Context) node.ConfigPersistence {
 	return node.NewConfigPersistence(stateFile)
 }
 
-func NewDriverConfig(ctx *cli.Context) *driver.Config {
+func NewDriverConfig(ctx *cli.Context) (*driver.Config, error) {
+	policy := driver.SequencerPolicy{
+		MaxBlockGas: ctx.Uint64(flags.SequencerMaxBlockGasFlag.Name),
+		MaxBlockDA:  ctx.Uint64(flags.SequencerMaxBlockDAFlag.Name),
+	}
+	for _, addr := range ctx.StringSlice(flags.SequencerDenylistFlag.Name) {
+		if !common.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid sequencer denylist address: %q", addr)
+		}
+		policy.Denylist = append(policy.Denylist, common.HexToAddress(addr))
+	}
 	return &driver.Config{
 		VerifierConfDepth:   ctx.Uint64(flags.VerifierL1Confs.Name),
 		SequencerConfDepth:  ctx.Uint64(flags.SequencerL1Confs.Name),
 		SequencerEnabled:    ctx.Bool(flags.SequencerEnabledFlag.Name),
 		SequencerStopped:    ctx.Bool(flags.SequencerStoppedFlag.Name),
 		SequencerMaxSafeLag: ctx.Uint64(flags.SequencerMaxSafeLagFlag.Name),
-	}
+		SequencerPolicy:     policy,
+	}, nil
 }
 
 func NewRollupConfig(log log.Logger, ctx *cli.Context) (*rollup.Config, error) {
@@ -243,9 +275,14 @@ func NewSnapshotLogger(ctx *cli.Context) (log.Logger, error) {
 	return logger, nil
 }
 
-func NewSyncConfig(ctx *cli.Context) *sync.Config {
-	return &sync.Config{
-		EngineSync:         ctx.Bool(flags.L2EngineSyncEnabled.Name),
+func NewSyncConfig(ctx *cli.Context, log log.Logger) *sync.Config {
+	cfg := &sync.Config{
+		SyncMode:           *ctx.Generic(flags.SyncModeFlag.Name).(*sync.Mode),
 		SkipSyncStartCheck: ctx.Bool(flags.SkipSyncStartCheck.Name),
 	}
+	if ctx.Bool(flags.L2EngineSyncEnabled.Name) {
+		log.Warn("l2.engine-sync is deprecated, use --syncmode=execution-layer instead")
+		cfg.SyncMode = sync.ELSync
+	}
+	return cfg
 }
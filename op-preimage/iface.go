@@ -34,6 +34,11 @@ const (
 	LocalKeyType KeyType = 1
 	// Keccak256KeyType is for keccak256 pre-images, for any global shared pre-images.
 	Keccak256KeyType KeyType = 2
+	// Sha256KeyType is for sha256 pre-images, for any global shared pre-images.
+	Sha256KeyType KeyType = 4
+	// BlobKeyType is for blob field elements, identified by the keccak256 hash of the KZG commitment of the blob
+	// and the evaluation point of the field element, such that the pre-image is verifiable with KZG point evaluation.
+	BlobKeyType KeyType = 5
 )
 
 // LocalIndexKey is a key local to the program, indexing a special program input.
@@ -62,6 +67,41 @@ func (k Keccak256Key) TerminalString() string {
 	return "0x" + hex.EncodeToString(k[:])
 }
 
+// Sha256Key wraps a sha256 hash to use it as a typed pre-image key.
+type Sha256Key [32]byte
+
+func (k Sha256Key) PreimageKey() (out [32]byte) {
+	out = k                      // copy the sha256 hash
+	out[0] = byte(Sha256KeyType) // apply prefix
+	return
+}
+
+func (k Sha256Key) String() string {
+	return "0x" + hex.EncodeToString(k[:])
+}
+
+func (k Sha256Key) TerminalString() string {
+	return "0x" + hex.EncodeToString(k[:])
+}
+
+// BlobKey is the keccak256 hash of a KZG commitment and a field element evaluation point of the blob,
+// to use it as a typed pre-image key for the field element.
+type BlobKey [32]byte
+
+func (k BlobKey) PreimageKey() (out [32]byte) {
+	out = k                    // copy the keccak hash
+	out[0] = byte(BlobKeyType) // apply prefix
+	return
+}
+
+func (k BlobKey) String() string {
+	return "0x" + hex.EncodeToString(k[:])
+}
+
+func (k BlobKey) TerminalString() string {
+	return "0x" + hex.EncodeToString(k[:])
+}
+
 // Hint is an interface to enable any program type to function as a hint,
 // when passed to the Hinter interface, returning a string representation
 // of what data the host should prepare pre-images for.
@@ -0,0 +1,42 @@
+package l1
+
+import (
+	"math/big"
+	"math/bits"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// blsModulus is the order of the BLS12-381 scalar field, that blob field elements are elements of.
+var blsModulus, _ = new(big.Int).SetString("52435875175126190479447740508185965837690552500527637822603658699938581184513", 10)
+
+// primitiveRootOfUnity generates the multiplicative group of the BLS12-381 scalar field, see EIP-4844.
+const primitiveRootOfUnity = 7
+
+// RootsOfUnity are the evaluation points of the field elements of a blob: the roots of unity of order
+// params.BlobTxFieldElementsPerBlob, in bit-reversed order, as 32-byte big-endian numbers.
+var RootsOfUnity = computeRootsOfUnity()
+
+func computeRootsOfUnity() [][32]byte {
+	n := params.BlobTxFieldElementsPerBlob
+	exp := new(big.Int).Div(new(big.Int).Sub(blsModulus, big.NewInt(1)), big.NewInt(int64(n)))
+	root := new(big.Int).Exp(big.NewInt(primitiveRootOfUnity), exp, blsModulus)
+	logN := bits.Len(uint(n)) - 1
+	out := make([][32]byte, n)
+	v := big.NewInt(1)
+	for i := 0; i < n; i++ {
+		j := bits.Reverse(uint(i)) >> (bits.UintSize - logN)
+		v.FillBytes(out[j][:])
+		v.Mul(v, root).Mod(v, blsModulus)
+	}
+	return out
+}
+
+// BlobFieldElementKey returns the keccak256 pre-image of the key of the field element with the given index,
+// in the blob with the given KZG commitment: the commitment, followed by the evaluation point of the field element.
+// The keccak256 hash of it is the preimage.BlobKey of the field element.
+func BlobFieldElementKey(commitment []byte, index int) []byte {
+	key := make([]byte, 0, len(commitment)+32)
+	key = append(key, commitment...)
+	return append(key, RootsOfUnity[index][:]...)
+}
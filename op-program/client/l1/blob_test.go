@@ -0,0 +1,38 @@
+package l1
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+func randomBlob(rng *rand.Rand) *eth.Blob {
+	var blob eth.Blob
+	for i := 0; i < params.BlobTxFieldElementsPerBlob; i++ {
+		// keep the first byte of each field element zero, to stay below the BLS modulus
+		rng.Read(blob[i*32+1 : (i+1)*32])
+	}
+	return &blob
+}
+
+func TestRootsOfUnity(t *testing.T) {
+	require.Len(t, RootsOfUnity, params.BlobTxFieldElementsPerBlob)
+	require.Equal(t, [32]byte{31: 1}, RootsOfUnity[0], "first root must be one")
+
+	rng := rand.New(rand.NewSource(1234))
+	blob := randomBlob(rng)
+	commitment, err := kzg4844.BlobToCommitment(*blob.KZGBlob())
+	require.NoError(t, err)
+	for _, i := range []int{0, 1, 2, 1000, params.BlobTxFieldElementsPerBlob - 1} {
+		// The field element must be the evaluation of the blob polynomial at its root of unity.
+		proof, claim, err := kzg4844.ComputeProof(*blob.KZGBlob(), RootsOfUnity[i])
+		require.NoError(t, err)
+		require.Equal(t, blob[i*32:(i+1)*32], claim[:], "field element %d", i)
+		require.NoError(t, kzg4844.VerifyProof(commitment, RootsOfUnity[i], claim, proof))
+	}
+}
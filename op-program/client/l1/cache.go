@@ -53,6 +53,11 @@ func (o *CachingOracle) TransactionsByBlockHash(blockHash common.Hash) (eth.Bloc
 	return block, txs
 }
 
+// GetBlob is not cached: every blob is only read once by the derivation, and blobs are large.
+func (o *CachingOracle) GetBlob(ref eth.L1BlockRef, blobHash eth.IndexedBlobHash) *eth.Blob {
+	return o.oracle.GetBlob(ref, blobHash)
+}
+
 func (o *CachingOracle) ReceiptsByBlockHash(blockHash common.Hash) (eth.BlockInfo, types.Receipts) {
 	rcpts, ok := o.rcpts.Get(blockHash)
 	if ok {
@@ -81,3 +81,13 @@ func (o *OracleL1Client) InfoAndTxsByHash(ctx context.Context, hash common.Hash)
 	info, txs := o.oracle.TransactionsByBlockHash(hash)
 	return info, txs, nil
 }
+
+// GetBlobs retrieves the blobs with the given hashes of the L1 block, in the order of the hashes.
+// The KZG commitment of every blob is checked against its versioned hash.
+func (o *OracleL1Client) GetBlobs(ctx context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.Blob, error) {
+	blobs := make([]*eth.Blob, len(hashes))
+	for i, h := range hashes {
+		blobs[i] = o.oracle.GetBlob(ref, h)
+	}
+	return blobs, nil
+}
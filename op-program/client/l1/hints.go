@@ -2,6 +2,7 @@ package l1
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
 )
@@ -10,6 +11,7 @@ const (
 	HintL1BlockHeader  = "l1-block-header"
 	HintL1Transactions = "l1-transactions"
 	HintL1Receipts     = "l1-receipts"
+	HintL1Blob         = "l1-blob"
 )
 
 type BlockHeaderHint common.Hash
@@ -35,3 +37,13 @@ var _ preimage.Hint = ReceiptsHint{}
 func (l ReceiptsHint) Hint() string {
 	return HintL1Receipts + " " + (common.Hash)(l).String()
 }
+
+// BlobHint is the versioned hash of a blob, followed by the index of the blob in its L1 block,
+// and the timestamp of the L1 block, both as 8-byte big-endian numbers.
+type BlobHint []byte
+
+var _ preimage.Hint = BlobHint{}
+
+func (l BlobHint) Hint() string {
+	return HintL1Blob + " " + hexutil.Encode(l)
+}
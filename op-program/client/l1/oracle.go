@@ -1,10 +1,14 @@
 package l1
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 
 	preimage "github.com/ethereum-optimism/optimism/op-preimage"
@@ -21,6 +25,9 @@ type Oracle interface {
 
 	// ReceiptsByBlockHash retrieves the receipts from the block with the given hash.
 	ReceiptsByBlockHash(blockHash common.Hash) (eth.BlockInfo, types.Receipts)
+
+	// GetBlob retrieves the blob with the given hash, of the given L1 block.
+	GetBlob(ref eth.L1BlockRef, blobHash eth.IndexedBlobHash) *eth.Blob
 }
 
 // PreimageOracle implements Oracle using by interfacing with the pure preimage.Oracle
@@ -86,3 +93,31 @@ func (p *PreimageOracle) ReceiptsByBlockHash(blockHash common.Hash) (eth.BlockIn
 
 	return info, receipts
 }
+
+func (p *PreimageOracle) GetBlob(ref eth.L1BlockRef, blobHash eth.IndexedBlobHash) *eth.Blob {
+	hint := make(BlobHint, 0, 32+8+8)
+	hint = append(hint, blobHash.Hash[:]...)
+	hint = binary.BigEndian.AppendUint64(hint, blobHash.Index)
+	hint = binary.BigEndian.AppendUint64(hint, ref.Time)
+	p.hint.Hint(hint)
+
+	commitment := p.oracle.Get(preimage.Sha256Key(blobHash.Hash))
+	if len(commitment) != len(kzg4844.Commitment{}) {
+		panic(fmt.Errorf("invalid KZG commitment of blob %s: %x", blobHash.Hash, commitment))
+	}
+	if h := eth.KZGToVersionedHash(kzg4844.Commitment(commitment)); h != blobHash.Hash {
+		panic(fmt.Errorf("KZG commitment of blob %s has versioned hash %s", blobHash.Hash, h))
+	}
+	// The field elements are served by the evaluation point & commitment of the blob,
+	// so the pre-image oracle can verify each of them with a KZG point evaluation.
+	var blob eth.Blob
+	for i := 0; i < params.BlobTxFieldElementsPerBlob; i++ {
+		key := crypto.Keccak256Hash(BlobFieldElementKey(commitment, i))
+		elem := p.oracle.Get(preimage.BlobKey(key))
+		if len(elem) != 32 {
+			panic(fmt.Errorf("invalid field element %d of blob %s: %x", i, blobHash.Hash, elem))
+		}
+		copy(blob[i*32:(i+1)*32], elem)
+	}
+	return &blob
+}
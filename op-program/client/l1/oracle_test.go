@@ -9,6 +9,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -103,3 +105,42 @@ func TestPreimageOracleBlockByHash(t *testing.T) {
 		})
 	}
 }
+
+func TestPreimageOracleGetBlob(t *testing.T) {
+	rng := rand.New(rand.NewSource(123))
+	blob := randomBlob(rng)
+	commitment, err := kzg4844.BlobToCommitment(*blob.KZGBlob())
+	require.NoError(t, err)
+	blobHash := eth.IndexedBlobHash{Index: 3, Hash: eth.KZGToVersionedHash(commitment)}
+	ref := eth.L1BlockRef{Number: 10, Time: 1234}
+
+	preimages := make(map[common.Hash][]byte)
+	preimages[preimage.Sha256Key(blobHash.Hash).PreimageKey()] = commitment[:]
+	for i := 0; i < params.BlobTxFieldElementsPerBlob; i++ {
+		key := crypto.Keccak256Hash(BlobFieldElementKey(commitment[:], i))
+		preimages[preimage.BlobKey(key).PreimageKey()] = blob[i*32 : (i+1)*32]
+	}
+	var hints mock.Mock
+	po := &PreimageOracle{
+		oracle: preimage.OracleFn(func(key preimage.Key) []byte {
+			v, ok := preimages[key.PreimageKey()]
+			require.True(t, ok, "preimage must exist")
+			return v
+		}),
+		hint: preimage.HinterFn(func(v preimage.Hint) {
+			hints.MethodCalled("hint", v.Hint())
+		}),
+	}
+
+	expectedHint := fmt.Sprintf("l1-blob %s%016x%016x", blobHash.Hash, blobHash.Index, ref.Time)
+	hints.On("hint", expectedHint).Once().Return()
+	require.Equal(t, blob, po.GetBlob(ref, blobHash))
+	hints.AssertExpectations(t)
+
+	t.Run("invalid commitment", func(t *testing.T) {
+		otherHash := eth.IndexedBlobHash{Index: 3, Hash: common.Hash{0x01, 0xaa}}
+		preimages[preimage.Sha256Key(otherHash.Hash).PreimageKey()] = commitment[:]
+		hints.On("hint", mock.Anything).Return()
+		require.Panics(t, func() { po.GetBlob(ref, otherHash) })
+	})
+}
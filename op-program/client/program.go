@@ -63,6 +63,15 @@ func RunProgram(logger log.Logger, preimageOracle io.ReadWriter, preimageHinter
 
 // runDerivation executes the L2 state transition, given a minimal interface to retrieve data.
 func runDerivation(logger log.Logger, cfg *rollup.Config, l2Cfg *params.ChainConfig, l1Head common.Hash, l2OutputRoot common.Hash, l2Claim common.Hash, l2ClaimBlockNum uint64, l1Oracle l1.Oracle, l2Oracle l2.Oracle) error {
+	if cfg.AltDA != nil {
+		// The data of alt-DA commitments is not available through the preimage oracle.
+		return errors.New("chains with an alt-DA config are not supported")
+	}
+	if cfg.BlobsEnabledL1Timestamp != nil {
+		// Blobs are read through the preimage oracle, but the PreimageOracle contract cannot load the
+		// sha256 and blob field element pre-images yet, so a step over blob data cannot be proven on-chain.
+		return errors.New("chains with blobs enabled are not supported")
+	}
 	l1Source := l1.NewOracleL1Client(logger, l1Oracle, l1Head)
 	engineBackend, err := l2.NewOracleBackedL2Chain(logger, l2Oracle, l2Cfg, l2OutputRoot)
 	if err != nil {
@@ -71,7 +80,7 @@ func runDerivation(logger log.Logger, cfg *rollup.Config, l2Cfg *params.ChainCon
 	l2Source := l2.NewOracleEngine(cfg, logger, engineBackend)
 
 	logger.Info("Starting derivation")
-	d := cldr.NewDriver(logger, cfg, l1Source, l2Source, l2ClaimBlockNum)
+	d := cldr.NewDriver(logger, cfg, l1Source, l1Source, l2Source, l2ClaimBlockNum)
 	for {
 		if err = d.Step(context.Background()); errors.Is(err, io.EOF) {
 			break
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-program/chainconfig"
 	"github.com/ethereum-optimism/optimism/op-program/host/config"
+	"github.com/ethereum-optimism/optimism/op-program/host/types"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -112,6 +113,32 @@ func TestDataDir(t *testing.T) {
 	require.Equal(t, expected, cfg.DataDir)
 }
 
+func TestDataFormat(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.Equal(t, types.DataFormatDirectory, cfg.DataFormat)
+	})
+	for _, format := range types.SupportedDataFormats {
+		format := format
+		t.Run(string(format), func(t *testing.T) {
+			cfg := configForArgs(t, addRequiredArgs("--data.format", string(format)))
+			require.Equal(t, format, cfg.DataFormat)
+		})
+	}
+}
+
+func TestDataRemote(t *testing.T) {
+	expected := "http://localhost:8080/preimages"
+	cfg := configForArgs(t, addRequiredArgs("--data.remote", expected))
+	require.Equal(t, expected, cfg.DataRemote)
+}
+
+func TestL1Beacon(t *testing.T) {
+	expected := "https://example.com:3500"
+	cfg := configForArgs(t, addRequiredArgs("--l1.beacon", expected))
+	require.Equal(t, expected, cfg.L1BeaconURL)
+}
+
 func TestL2(t *testing.T) {
 	expected := "https://example.com:8545"
 	cfg := configForArgs(t, addRequiredArgs("--l2", expected))
@@ -268,6 +295,17 @@ func TestExec(t *testing.T) {
 	})
 }
 
+func TestPrefetch(t *testing.T) {
+	t.Run("DefaultFalse", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs())
+		require.False(t, cfg.Prefetch)
+	})
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs("--prefetch"))
+		require.True(t, cfg.Prefetch)
+	})
+}
+
 func TestServerMode(t *testing.T) {
 	t.Run("DefaultFalse", func(t *testing.T) {
 		cfg := configForArgs(t, addRequiredArgs())
@@ -5,12 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 
 	opnode "github.com/ethereum-optimism/optimism/op-node"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-program/host/flags"
+	"github.com/ethereum-optimism/optimism/op-program/host/types"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -26,10 +28,14 @@ var (
 	ErrInvalidL2Head       = errors.New("invalid l2 head")
 	ErrInvalidL2OutputRoot = errors.New("invalid l2 output root")
 	ErrL1AndL2Inconsistent = errors.New("l1 and l2 options must be specified together or both omitted")
+	ErrMissingL1Beacon     = errors.New("l1 beacon endpoint must be specified to fetch blobs")
 	ErrInvalidL2Claim      = errors.New("invalid l2 claim")
 	ErrInvalidL2ClaimBlock = errors.New("invalid l2 claim block number")
 	ErrDataDirRequired     = errors.New("datadir must be specified when in non-fetching mode")
+	ErrInvalidDataFormat   = errors.New("invalid data format")
 	ErrNoExecInServerMode  = errors.New("exec command must not be set when in server mode")
+	ErrPrefetchNoFetching  = errors.New("prefetch requires fetching to be enabled")
+	ErrPrefetchNoExec      = errors.New("prefetch requires an exec command or server mode")
 )
 
 type Config struct {
@@ -37,12 +43,19 @@ type Config struct {
 	// DataDir is the directory to read/write pre-image data from/to.
 	//If not set, an in-memory key-value store is used and fetching data must be enabled
 	DataDir string
+	// DataFormat specifies the format to use for the pre-image data in the DataDir.
+	DataFormat types.DataFormat
+	// DataRemote is the URL of a read-only HTTP pre-image store, to read pre-images from that are not in the DataDir.
+	// Pre-images read from it are stored in the DataDir.
+	DataRemote string
 
 	// L1Head is the block has of the L1 chain head block
 	L1Head     common.Hash
 	L1URL      string
 	L1TrustRPC bool
 	L1RPCKind  sources.RPCProviderKind
+	// L1BeaconURL is the L1 beacon API endpoint to fetch blobs from, required to fetch data of chains with blobs enabled.
+	L1BeaconURL string
 
 	// L2Head is the l2 block hash contained in the L2 Output referenced by the L2OutputRoot
 	// TODO(inphi): This can be made optional with hardcoded rollup configs and output oracle addresses by searching the oracle for the l2 output root
@@ -60,6 +73,10 @@ type Config struct {
 	// ExecCmd specifies the client program to execute in a separate process.
 	// If unset, the fault proof client is run in the same process.
 	ExecCmd string
+	// Prefetch indicates that the client program is first run natively in the host process, to fetch all
+	// required pre-images, before running ExecCmd or serving pre-images in ServerMode.
+	// ExecCmd or the client of the server is then served from the pre-image store only.
+	Prefetch bool
 
 	// ServerMode indicates that the program should run in pre-image server mode and wait for requests.
 	// No client program is run.
@@ -97,12 +114,24 @@ func (c *Config) Check() error {
 	if (c.L1URL != "") != (c.L2URL != "") {
 		return ErrL1AndL2Inconsistent
 	}
-	if !c.FetchingEnabled() && c.DataDir == "" {
+	if c.FetchingEnabled() && c.Rollup.BlobsEnabledL1Timestamp != nil && c.L1BeaconURL == "" {
+		return ErrMissingL1Beacon
+	}
+	if !c.FetchingEnabled() && c.DataDir == "" && c.DataRemote == "" {
 		return ErrDataDirRequired
 	}
+	if !slices.Contains(types.SupportedDataFormats, c.DataFormat) {
+		return fmt.Errorf("%w: %s", ErrInvalidDataFormat, c.DataFormat)
+	}
 	if c.ServerMode && c.ExecCmd != "" {
 		return ErrNoExecInServerMode
 	}
+	if c.Prefetch && !c.FetchingEnabled() {
+		return ErrPrefetchNoFetching
+	}
+	if c.Prefetch && c.ExecCmd == "" && !c.ServerMode {
+		return ErrPrefetchNoExec
+	}
 	return nil
 }
 
@@ -132,6 +161,7 @@ func NewConfig(
 		L2ClaimBlockNumber:  l2ClaimBlockNum,
 		L1RPCKind:           sources.RPCKindStandard,
 		IsCustomChainConfig: isCustomConfig,
+		DataFormat:          types.DataFormatDirectory,
 	}
 }
 
@@ -184,6 +214,8 @@ func NewConfigFromCLI(log log.Logger, ctx *cli.Context) (*Config, error) {
 	return &Config{
 		Rollup:              rollupCfg,
 		DataDir:             ctx.String(flags.DataDir.Name),
+		DataFormat:          types.DataFormat(ctx.String(flags.DataFormat.Name)),
+		DataRemote:          ctx.String(flags.DataRemote.Name),
 		L2URL:               ctx.String(flags.L2NodeAddr.Name),
 		L2ChainConfig:       l2ChainConfig,
 		L2Head:              l2Head,
@@ -193,8 +225,10 @@ func NewConfigFromCLI(log log.Logger, ctx *cli.Context) (*Config, error) {
 		L1Head:              l1Head,
 		L1URL:               ctx.String(flags.L1NodeAddr.Name),
 		L1TrustRPC:          ctx.Bool(flags.L1TrustRPC.Name),
+		L1BeaconURL:         ctx.String(flags.L1BeaconAddr.Name),
 		L1RPCKind:           sources.RPCProviderKind(ctx.String(flags.L1RPCProviderKind.Name)),
 		ExecCmd:             ctx.String(flags.Exec.Name),
+		Prefetch:            ctx.Bool(flags.Prefetch.Name),
 		ServerMode:          ctx.Bool(flags.Server.Name),
 		IsCustomChainConfig: isCustomConfig,
 	}, nil
@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	"github.com/ethereum-optimism/optimism/op-program/chainconfig"
+	"github.com/ethereum-optimism/optimism/op-program/host/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
@@ -152,6 +153,47 @@ func TestRequireDataDirInNonFetchingMode(t *testing.T) {
 	require.ErrorIs(t, err, ErrDataDirRequired)
 }
 
+func TestAllowRemoteDataInNonFetchingMode(t *testing.T) {
+	cfg := validConfig()
+	cfg.DataDir = ""
+	cfg.DataRemote = "http://localhost:8080"
+	cfg.L1URL = ""
+	cfg.L2URL = ""
+	require.NoError(t, cfg.Check())
+}
+
+func TestDataFormat(t *testing.T) {
+	for _, format := range types.SupportedDataFormats {
+		format := format
+		t.Run(string(format), func(t *testing.T) {
+			cfg := validConfig()
+			cfg.DataFormat = format
+			require.NoError(t, cfg.Check())
+		})
+	}
+	t.Run("Invalid", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.DataFormat = "foo"
+		require.ErrorIs(t, cfg.Check(), ErrInvalidDataFormat)
+	})
+}
+
+func TestRequireL1BeaconForBlobs(t *testing.T) {
+	cfg := validConfig()
+	cfg.L1URL = "https://example.com:1234"
+	cfg.L2URL = "https://example.com:5678"
+	require.NoError(t, cfg.Check(), "not required without blobs")
+
+	rollupCfg := *validRollupConfig
+	blobsTime := uint64(1000)
+	rollupCfg.BlobsEnabledL1Timestamp = &blobsTime
+	cfg.Rollup = &rollupCfg
+	require.ErrorIs(t, cfg.Check(), ErrMissingL1Beacon)
+
+	cfg.L1BeaconURL = "https://example.com:3500"
+	require.NoError(t, cfg.Check())
+}
+
 func TestRejectExecAndServerMode(t *testing.T) {
 	cfg := validConfig()
 	cfg.ServerMode = true
@@ -160,6 +202,38 @@ func TestRejectExecAndServerMode(t *testing.T) {
 	require.ErrorIs(t, err, ErrNoExecInServerMode)
 }
 
+func TestPrefetch(t *testing.T) {
+	t.Run("RequireFetching", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Prefetch = true
+		cfg.ExecCmd = "echo"
+		require.ErrorIs(t, cfg.Check(), ErrPrefetchNoFetching)
+	})
+	t.Run("RequireExec", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Prefetch = true
+		cfg.L1URL = "https://example.com:1234"
+		cfg.L2URL = "https://example.com:5678"
+		require.ErrorIs(t, cfg.Check(), ErrPrefetchNoExec)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Prefetch = true
+		cfg.ExecCmd = "echo"
+		cfg.L1URL = "https://example.com:1234"
+		cfg.L2URL = "https://example.com:5678"
+		require.NoError(t, cfg.Check())
+	})
+	t.Run("ValidInServerMode", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Prefetch = true
+		cfg.ServerMode = true
+		cfg.L1URL = "https://example.com:1234"
+		cfg.L2URL = "https://example.com:5678"
+		require.NoError(t, cfg.Check())
+	})
+}
+
 func TestIsCustomChainConfig(t *testing.T) {
 	t.Run("nonCustom", func(t *testing.T) {
 		cfg := validConfig()
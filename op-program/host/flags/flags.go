@@ -7,6 +7,7 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum-optimism/optimism/op-node/chaincfg"
+	"github.com/ethereum-optimism/optimism/op-program/host/types"
 	service "github.com/ethereum-optimism/optimism/op-service"
 	openum "github.com/ethereum-optimism/optimism/op-service/enum"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
@@ -35,6 +36,17 @@ var (
 		Usage:   "Directory to use for preimage data storage. Default uses in-memory storage",
 		EnvVars: prefixEnvVars("DATADIR"),
 	}
+	DataFormat = &cli.StringFlag{
+		Name:    "data.format",
+		Usage:   fmt.Sprintf("Format to use for preimage data storage in the datadir. Available formats: %s", openum.EnumString(types.SupportedDataFormats)),
+		EnvVars: prefixEnvVars("DATA_FORMAT"),
+		Value:   string(types.DataFormatDirectory),
+	}
+	DataRemote = &cli.StringFlag{
+		Name:    "data.remote",
+		Usage:   "URL of a read-only HTTP preimage store, e.g. a preimage cache shared between hosts, to read preimages from before fetching them",
+		EnvVars: prefixEnvVars("DATA_REMOTE"),
+	}
 	L2NodeAddr = &cli.StringFlag{
 		Name:    "l2",
 		Usage:   "Address of L2 JSON-RPC endpoint to use (eth and debug namespace required)",
@@ -75,6 +87,11 @@ var (
 		Usage:   "Address of L1 JSON-RPC endpoint to use (eth namespace required)",
 		EnvVars: prefixEnvVars("L1_RPC"),
 	}
+	L1BeaconAddr = &cli.StringFlag{
+		Name:    "l1.beacon",
+		Usage:   "Address of L1 Beacon API endpoint to use, to fetch blobs from. Required for chains with blobs enabled",
+		EnvVars: prefixEnvVars("L1_BEACON_API"),
+	}
 	L1TrustRPC = &cli.BoolFlag{
 		Name:    "l1.trustrpc",
 		Usage:   "Trust the L1 RPC, sync faster at risk of malicious/buggy RPC providing bad or inconsistent L1 data",
@@ -95,6 +112,11 @@ var (
 		Usage:   "Run the specified client program as a separate process detached from the host. Default is to run the client program in the host process.",
 		EnvVars: prefixEnvVars("EXEC"),
 	}
+	Prefetch = &cli.BoolFlag{
+		Name:    "prefetch",
+		Usage:   "Run the client program natively first to fetch all required pre-images, before running the exec client program or serving pre-images in server mode without any online fetching.",
+		EnvVars: prefixEnvVars("PREFETCH"),
+	}
 	Server = &cli.BoolFlag{
 		Name:    "server",
 		Usage:   "Run in pre-image server mode without executing any client program.",
@@ -116,12 +138,16 @@ var programFlags = []cli.Flag{
 	RollupConfig,
 	Network,
 	DataDir,
+	DataFormat,
+	DataRemote,
 	L2NodeAddr,
 	L2GenesisPath,
 	L1NodeAddr,
+	L1BeaconAddr,
 	L1TrustRPC,
 	L1RPCProviderKind,
 	Exec,
+	Prefetch,
 	Server,
 }
 
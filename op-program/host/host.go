@@ -17,6 +17,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-program/host/flags"
 	"github.com/ethereum-optimism/optimism/op-program/host/kvstore"
 	"github.com/ethereum-optimism/optimism/op-program/host/prefetcher"
+	"github.com/ethereum-optimism/optimism/op-program/host/types"
 	oppio "github.com/ethereum-optimism/optimism/op-program/io"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	"github.com/ethereum-optimism/optimism/op-service/client"
@@ -60,6 +61,7 @@ func FaultProofProgram(ctx context.Context, logger log.Logger, cfg *config.Confi
 		serverErr chan error
 		pClientRW oppio.FileChannel
 		hClientRW oppio.FileChannel
+		kv        kvstore.KV
 	)
 	defer func() {
 		if pClientRW != nil {
@@ -75,7 +77,21 @@ func FaultProofProgram(ctx context.Context, logger log.Logger, cfg *config.Confi
 			}
 			logger.Debug("Preimage server stopped")
 		}
+		if kv != nil {
+			closeKV(logger, kv)
+		}
 	}()
+	if cfg.Prefetch {
+		var err error
+		kv, err = newKV(logger, cfg)
+		if err != nil {
+			return err
+		}
+		if err := prefetchPreimages(ctx, logger, cfg, kv); err != nil {
+			return fmt.Errorf("failed to prefetch pre-images: %w", err)
+		}
+	}
+
 	// Setup client I/O for preimage oracle interaction
 	pClientRW, pHostRW, err := oppio.CreateBidirectionalChannel()
 	if err != nil {
@@ -92,7 +108,12 @@ func FaultProofProgram(ctx context.Context, logger log.Logger, cfg *config.Confi
 	serverErr = make(chan error)
 	go func() {
 		defer close(serverErr)
-		serverErr <- PreimageServer(ctx, logger, cfg, pHostRW, hHostRW)
+		if kv != nil {
+			// All pre-images were prefetched, so the client program is served offline.
+			serverErr <- preimageServer(ctx, logger, cfg, kv, false, pHostRW, hHostRW)
+		} else {
+			serverErr <- PreimageServer(ctx, logger, cfg, pHostRW, hHostRW)
+		}
 	}()
 
 	var cmd *exec.Cmd
@@ -120,11 +141,108 @@ func FaultProofProgram(ctx context.Context, logger log.Logger, cfg *config.Confi
 	}
 }
 
+// prefetchPreimages runs the client program natively, served by a fetching pre-image server,
+// so that the given kv store holds all the pre-images that the client program requires afterwards.
+func prefetchPreimages(ctx context.Context, logger log.Logger, cfg *config.Config, kv kvstore.KV) error {
+	logger = logger.New("phase", "prefetch")
+	pClientRW, pHostRW, err := oppio.CreateBidirectionalChannel()
+	if err != nil {
+		return fmt.Errorf("failed to create preimage pipe: %w", err)
+	}
+	hClientRW, hHostRW, err := oppio.CreateBidirectionalChannel()
+	if err != nil {
+		_ = pClientRW.Close()
+		_ = pHostRW.Close()
+		return fmt.Errorf("failed to create hints pipe: %w", err)
+	}
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- preimageServer(ctx, logger, cfg, kv, true, pHostRW, hHostRW)
+	}()
+	logger.Info("Prefetching pre-images")
+	err = cl.RunProgram(logger, pClientRW, hClientRW)
+	_ = pClientRW.Close()
+	_ = hClientRW.Close()
+	if srvErr := <-serverErr; srvErr != nil {
+		return fmt.Errorf("preimage server failed: %w", srvErr)
+	}
+	// An invalid claim still accessed all the pre-images that are needed to show that it is invalid.
+	if errors.Is(err, driver.ErrClaimNotValid) {
+		logger.Info("Prefetched pre-images, claim is invalid")
+		return nil
+	} else if err != nil {
+		return err
+	}
+	logger.Info("Prefetched pre-images")
+	return nil
+}
+
 // PreimageServer reads hints and preimage requests from the provided channels and processes those requests.
 // This method will block until both the hinter and preimage handlers complete.
 // If either returns an error both handlers are stopped.
 // The supplied preimageChannel and hintChannel will be closed before this function returns.
+// With prefetching enabled, all pre-images are fetched before the first request is served, and requests are
+// served offline, so that the client never waits on a fetch.
 func PreimageServer(ctx context.Context, logger log.Logger, cfg *config.Config, preimageChannel oppio.FileChannel, hintChannel oppio.FileChannel) error {
+	kv, err := newKV(logger, cfg)
+	if err != nil {
+		preimageChannel.Close()
+		hintChannel.Close()
+		return err
+	}
+	defer closeKV(logger, kv)
+	if cfg.Prefetch {
+		if err := prefetchPreimages(ctx, logger, cfg, kv); err != nil {
+			preimageChannel.Close()
+			hintChannel.Close()
+			return fmt.Errorf("failed to prefetch pre-images: %w", err)
+		}
+		return preimageServer(ctx, logger, cfg, kv, false, preimageChannel, hintChannel)
+	}
+	return preimageServer(ctx, logger, cfg, kv, cfg.FetchingEnabled(), preimageChannel, hintChannel)
+}
+
+func newKV(logger log.Logger, cfg *config.Config) (kvstore.KV, error) {
+	kv, err := newLocalKV(logger, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.DataRemote != "" {
+		logger.Info("Using remote storage", "remote", cfg.DataRemote)
+		kv = kvstore.NewLayeredKV(kv, kvstore.NewRemoteKV(cfg.DataRemote))
+	}
+	return kv, nil
+}
+
+func newLocalKV(logger log.Logger, cfg *config.Config) (kvstore.KV, error) {
+	if cfg.DataDir == "" {
+		logger.Info("Using in-memory storage")
+		return kvstore.NewMemKV(), nil
+	}
+	logger.Info("Creating disk storage", "datadir", cfg.DataDir, "format", cfg.DataFormat)
+	if err := os.MkdirAll(cfg.DataDir, 0755); err != nil {
+		return nil, fmt.Errorf("creating datadir: %w", err)
+	}
+	switch cfg.DataFormat {
+	case types.DataFormatDirectory:
+		return kvstore.NewDiskKV(cfg.DataDir), nil
+	case types.DataFormatPebble:
+		return kvstore.NewPebbleKV(cfg.DataDir)
+	default:
+		return nil, fmt.Errorf("invalid data format: %s", cfg.DataFormat)
+	}
+}
+
+// closeKV closes the KV store, if it holds any resources.
+func closeKV(logger log.Logger, kv kvstore.KV) {
+	if c, ok := kv.(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			logger.Error("Failed to close pre-image store", "err", err)
+		}
+	}
+}
+
+func preimageServer(ctx context.Context, logger log.Logger, cfg *config.Config, kv kvstore.KV, fetch bool, preimageChannel oppio.FileChannel, hintChannel oppio.FileChannel) error {
 	var serverDone chan error
 	var hinterDone chan error
 	defer func() {
@@ -140,23 +258,12 @@ func PreimageServer(ctx context.Context, logger log.Logger, cfg *config.Config,
 		}
 	}()
 	logger.Info("Starting preimage server")
-	var kv kvstore.KV
-	if cfg.DataDir == "" {
-		logger.Info("Using in-memory storage")
-		kv = kvstore.NewMemKV()
-	} else {
-		logger.Info("Creating disk storage", "datadir", cfg.DataDir)
-		if err := os.MkdirAll(cfg.DataDir, 0755); err != nil {
-			return fmt.Errorf("creating datadir: %w", err)
-		}
-		kv = kvstore.NewDiskKV(cfg.DataDir)
-	}
 
 	var (
 		getPreimage kvstore.PreimageSource
 		hinter      preimage.HintHandler
 	)
-	if cfg.FetchingEnabled() {
+	if fetch {
 		prefetch, err := makePrefetcher(ctx, logger, kv, cfg)
 		if err != nil {
 			return fmt.Errorf("failed to create prefetcher: %w", err)
@@ -210,7 +317,12 @@ func makePrefetcher(ctx context.Context, logger log.Logger, kv kvstore.KV, cfg *
 		return nil, fmt.Errorf("failed to create L2 client: %w", err)
 	}
 	l2DebugCl := &L2Source{L2Client: l2Cl, DebugClient: sources.NewDebugClient(l2RPC.CallContext)}
-	return prefetcher.NewPrefetcher(logger, l1Cl, l2DebugCl, kv), nil
+	var l1BlobFetcher prefetcher.L1BlobSource
+	if cfg.L1BeaconURL != "" {
+		logger.Info("Using L1 beacon API", "l1.beacon", cfg.L1BeaconURL)
+		l1BlobFetcher = sources.NewL1BeaconClient(sources.NewBeaconHTTPClient(cfg.L1BeaconURL, nil))
+	}
+	return prefetcher.NewPrefetcher(logger, l1Cl, l1BlobFetcher, l2DebugCl, kv), nil
 }
 
 func routeHints(logger log.Logger, hHostRW io.ReadWriter, hinter preimage.HintHandler) chan error {
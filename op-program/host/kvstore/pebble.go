@@ -0,0 +1,67 @@
+package kvstore
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PebbleKV is a disk-backed key-value store, with all key-value pairs stored in a pebble database.
+// Unlike DiskKV, it does not create a file per pre-image, and so scales to millions of pre-images.
+// PebbleKV is safe for concurrent use with a single PebbleKV instance.
+// The database is locked, and cannot be opened by another PebbleKV instance until it is closed.
+type PebbleKV struct {
+	sync.RWMutex
+	db *pebble.DB
+}
+
+// NewPebbleKV opens or creates a PebbleKV with the pebble database in the given directory path.
+func NewPebbleKV(path string) (*PebbleKV, error) {
+	opts := &pebble.Options{
+		Cache:        pebble.NewCache(int64(32 * 1024 * 1024)),
+		MaxOpenFiles: 1024,
+	}
+	defer opts.Cache.Unref()
+	db, err := pebble.Open(path, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open pebble db at %s: %w", path, err)
+	}
+	return &PebbleKV{db: db}, nil
+}
+
+func (d *PebbleKV) Put(k common.Hash, v []byte) error {
+	d.Lock()
+	defer d.Unlock()
+	// Pre-images can always be fetched again, so the write does not have to be synced to disk.
+	return d.db.Set(k.Bytes(), v, pebble.NoSync)
+}
+
+func (d *PebbleKV) Get(k common.Hash) ([]byte, error) {
+	d.RLock()
+	defer d.RUnlock()
+	dat, closer, err := d.db.Get(k.Bytes())
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to read pre-image %s: %w", k, err)
+	}
+	// the returned slice is only valid until the closer is closed
+	ret := make([]byte, len(dat))
+	copy(ret, dat)
+	if err := closer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to release pre-image %s: %w", k, err)
+	}
+	return ret, nil
+}
+
+func (d *PebbleKV) Close() error {
+	d.Lock()
+	defer d.Unlock()
+	return d.db.Close()
+}
+
+var _ KV = (*PebbleKV)(nil)
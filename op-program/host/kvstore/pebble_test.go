@@ -0,0 +1,33 @@
+package kvstore
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPebbleKV(t *testing.T) {
+	tmp := t.TempDir() // automatically removed by testing cleanup
+	kv, err := NewPebbleKV(tmp)
+	require.NoError(t, err)
+	t.Cleanup(func() { // Can't use defer because kvTest runs tests in parallel.
+		require.NoError(t, kv.Close())
+	})
+	kvTest(t, kv)
+}
+
+func TestPebbleKVReopen(t *testing.T) {
+	tmp := t.TempDir()
+	kv, err := NewPebbleKV(tmp)
+	require.NoError(t, err)
+	require.NoError(t, kv.Put(common.Hash{0xaa}, []byte("hello world")))
+	require.NoError(t, kv.Close())
+
+	kv, err = NewPebbleKV(tmp)
+	require.NoError(t, err)
+	defer kv.Close()
+	dat, err := kv.Get(common.Hash{0xaa})
+	require.NoError(t, err, "pre-image must persist")
+	require.Equal(t, "hello world", string(dat))
+}
@@ -0,0 +1,152 @@
+package kvstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+)
+
+// ErrReadOnly is returned when writing to a read-only KV store.
+var ErrReadOnly = errors.New("read-only KV store")
+
+// maxRemotePreimageSize limits the size of pre-images read from a remote KV store.
+const maxRemotePreimageSize = 128 * 1024 * 1024
+
+// RemoteKV is a read-only key-value store, that reads pre-images from a remote HTTP server,
+// e.g. a central pre-image cache shared by multiple hosts.
+// A pre-image is read with a GET request to the base URL joined with the hex-encoded key,
+// and the response body is the raw pre-image. The server must respond with 404 if the pre-image is unknown.
+// The remote server is not trusted: only keccak256 and sha256 pre-images are read, and checked against their key.
+// Other pre-images, such as blob field elements, cannot be verified without a proof, and are reported as not found,
+// so that the host fetches them from its own sources.
+// RemoteKV is safe for concurrent use.
+type RemoteKV struct {
+	baseURL string
+	client  *http.Client
+	timeout time.Duration
+}
+
+// NewRemoteKV creates a RemoteKV that reads pre-images from the HTTP server at the given base URL.
+func NewRemoteKV(baseURL string) *RemoteKV {
+	return &RemoteKV{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  &http.Client{},
+		timeout: 30 * time.Second,
+	}
+}
+
+func (r *RemoteKV) Put(k common.Hash, v []byte) error {
+	return ErrReadOnly
+}
+
+func (r *RemoteKV) Get(k common.Hash) ([]byte, error) {
+	if k[0] != byte(preimage.Keccak256KeyType) && k[0] != byte(preimage.Sha256KeyType) {
+		return nil, ErrNotFound
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+"/"+k.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for pre-image %s: %w", k, err)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request pre-image %s: %w", k, err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, fmt.Errorf("failed to request pre-image %s: unexpected status %s", k, resp.Status)
+	}
+	dat, err := io.ReadAll(io.LimitReader(resp.Body, maxRemotePreimageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pre-image %s: %w", k, err)
+	}
+	if len(dat) > maxRemotePreimageSize {
+		return nil, fmt.Errorf("pre-image %s exceeds max size of %d bytes", k, maxRemotePreimageSize)
+	}
+	var actual [32]byte
+	if k[0] == byte(preimage.Keccak256KeyType) {
+		actual = preimage.Keccak256Key(crypto.Keccak256Hash(dat)).PreimageKey()
+	} else {
+		actual = preimage.Sha256Key(sha256.Sum256(dat)).PreimageKey()
+	}
+	if !bytes.Equal(actual[:], k[:]) {
+		return nil, fmt.Errorf("remote pre-image %s does not match its key, got %s", k, common.Hash(actual))
+	}
+	return dat, nil
+}
+
+var _ KV = (*RemoteKV)(nil)
+
+// LayeredKV reads pre-images from a primary KV store, and falls back to a secondary KV store for
+// pre-images that the primary store does not have. These are copied into the primary store.
+// Pre-images are only written to the primary store, e.g. to use a read-only RemoteKV as secondary store.
+type LayeredKV struct {
+	primary   KV
+	secondary KV
+}
+
+func NewLayeredKV(primary KV, secondary KV) *LayeredKV {
+	return &LayeredKV{primary: primary, secondary: secondary}
+}
+
+func (l *LayeredKV) Put(k common.Hash, v []byte) error {
+	return l.primary.Put(k, v)
+}
+
+func (l *LayeredKV) Get(k common.Hash) ([]byte, error) {
+	dat, err := l.primary.Get(k)
+	if !errors.Is(err, ErrNotFound) {
+		return dat, err
+	}
+	dat, err = l.secondary.Get(k)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.primary.Put(k, dat); err != nil {
+		return nil, fmt.Errorf("failed to store pre-image %s: %w", k, err)
+	}
+	return dat, nil
+}
+
+var _ KV = (*LayeredKV)(nil)
+
+// NewHTTPHandler serves the pre-images of the given KV store to RemoteKV clients.
+func NewHTTPHandler(kv KV) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var k common.Hash
+		if err := k.UnmarshalText([]byte(strings.TrimPrefix(r.URL.Path, "/"))); err != nil {
+			http.Error(w, "invalid pre-image key", http.StatusBadRequest)
+			return
+		}
+		dat, err := kv.Get(k)
+		if errors.Is(err, ErrNotFound) {
+			http.Error(w, "pre-image not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			http.Error(w, "failed to read pre-image", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(dat)
+	})
+}
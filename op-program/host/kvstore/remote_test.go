@@ -0,0 +1,96 @@
+package kvstore
+
+import (
+	"crypto/sha256"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+)
+
+func TestRemoteKV(t *testing.T) {
+	source := NewMemKV()
+	srv := httptest.NewServer(NewHTTPHandler(source))
+	t.Cleanup(srv.Close)
+	kv := NewRemoteKV(srv.URL + "/")
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := kv.Get(common.Hash{byte(preimage.Keccak256KeyType), 0xaa})
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		require.ErrorIs(t, kv.Put(common.Hash{0xaa}, []byte{1}), ErrReadOnly)
+	})
+
+	t.Run("empty pre-image", func(t *testing.T) {
+		key := common.Hash(preimage.Keccak256Key(crypto.Keccak256Hash(nil)).PreimageKey())
+		require.NoError(t, source.Put(key, []byte{}))
+		dat, err := kv.Get(key)
+		require.NoError(t, err)
+		require.Empty(t, dat)
+	})
+
+	t.Run("sha256 pre-image", func(t *testing.T) {
+		val := []byte("commitment")
+		key := common.Hash(preimage.Sha256Key(sha256.Sum256(val)).PreimageKey())
+		require.NoError(t, source.Put(key, val))
+		dat, err := kv.Get(key)
+		require.NoError(t, err)
+		require.Equal(t, val, dat)
+
+		key = common.Hash(preimage.Sha256Key(sha256.Sum256([]byte("other"))).PreimageKey())
+		require.NoError(t, source.Put(key, val))
+		_, err = kv.Get(key)
+		require.ErrorContains(t, err, "does not match its key")
+	})
+
+	t.Run("unverifiable pre-image", func(t *testing.T) {
+		// blob field elements cannot be verified without a proof, so are not read from the remote store
+		key := common.Hash(preimage.BlobKey{0xbb}.PreimageKey())
+		require.NoError(t, source.Put(key, []byte{4, 2}))
+		_, err := kv.Get(key)
+		require.ErrorIs(t, err, ErrNotFound)
+
+		key = common.Hash{0xcc}
+		require.NoError(t, source.Put(key, []byte{4, 2}))
+		_, err = kv.Get(key)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("keccak256 pre-image", func(t *testing.T) {
+		val := []byte("hello world")
+		key := common.Hash(preimage.Keccak256Key(crypto.Keccak256Hash(val)).PreimageKey())
+		require.NoError(t, source.Put(key, val))
+		dat, err := kv.Get(key)
+		require.NoError(t, err)
+		require.Equal(t, val, dat)
+
+		key = common.Hash(preimage.Keccak256Key(crypto.Keccak256Hash([]byte("other"))).PreimageKey())
+		require.NoError(t, source.Put(key, val))
+		_, err = kv.Get(key)
+		require.ErrorContains(t, err, "does not match its key")
+	})
+}
+
+func TestLayeredKV(t *testing.T) {
+	primary := NewMemKV()
+	secondary := NewMemKV()
+	kv := NewLayeredKV(primary, secondary)
+	kvTest(t, kv)
+
+	t.Run("fallback", func(t *testing.T) {
+		require.NoError(t, secondary.Put(common.Hash{0xee}, []byte("remote")))
+		dat, err := kv.Get(common.Hash{0xee})
+		require.NoError(t, err)
+		require.Equal(t, "remote", string(dat))
+
+		dat, err = primary.Get(common.Hash{0xee})
+		require.NoError(t, err, "pre-image must be copied into the primary store")
+		require.Equal(t, "remote", string(dat))
+	})
+}
@@ -2,6 +2,7 @@ package prefetcher
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 type L1Source interface {
@@ -25,6 +27,13 @@ type L1Source interface {
 	FetchReceipts(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Receipts, error)
 }
 
+// L1BlobSource fetches blob sidecars from the L1 beacon chain, and verifies them against their versioned hashes.
+type L1BlobSource interface {
+	GetBlobSidecars(ctx context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.BlobSidecar, error)
+}
+
+var ErrNoL1BlobSource = errors.New("no L1 beacon endpoint configured to fetch blobs from")
+
 type L2Source interface {
 	InfoAndTxsByHash(ctx context.Context, blockHash common.Hash) (eth.BlockInfo, types.Transactions, error)
 	NodeByHash(ctx context.Context, hash common.Hash) ([]byte, error)
@@ -33,19 +42,25 @@ type L2Source interface {
 }
 
 type Prefetcher struct {
-	logger    log.Logger
-	l1Fetcher L1Source
-	l2Fetcher L2Source
-	lastHint  string
-	kvStore   kvstore.KV
+	logger        log.Logger
+	l1Fetcher     L1Source
+	l1BlobFetcher L1BlobSource
+	l2Fetcher     L2Source
+	lastHint      string
+	kvStore       kvstore.KV
 }
 
-func NewPrefetcher(logger log.Logger, l1Fetcher L1Source, l2Fetcher L2Source, kvStore kvstore.KV) *Prefetcher {
+// NewPrefetcher creates a Prefetcher. The l1BlobFetcher may be nil, if blobs do not have to be fetched.
+func NewPrefetcher(logger log.Logger, l1Fetcher L1Source, l1BlobFetcher L1BlobSource, l2Fetcher L2Source, kvStore kvstore.KV) *Prefetcher {
+	if l1BlobFetcher != nil {
+		l1BlobFetcher = NewRetryingL1BlobSource(logger, l1BlobFetcher)
+	}
 	return &Prefetcher{
-		logger:    logger,
-		l1Fetcher: NewRetryingL1Source(logger, l1Fetcher),
-		l2Fetcher: NewRetryingL2Source(logger, l2Fetcher),
-		kvStore:   kvStore,
+		logger:        logger,
+		l1Fetcher:     NewRetryingL1Source(logger, l1Fetcher),
+		l1BlobFetcher: l1BlobFetcher,
+		l2Fetcher:     NewRetryingL2Source(logger, l2Fetcher),
+		kvStore:       kvStore,
 	}
 }
 
@@ -75,10 +90,17 @@ func (p *Prefetcher) GetPreimage(ctx context.Context, key common.Hash) ([]byte,
 }
 
 func (p *Prefetcher) prefetch(ctx context.Context, hint string) error {
-	hintType, hash, err := parseHint(hint)
+	hintType, hintBytes, err := parseHint(hint)
 	if err != nil {
 		return err
 	}
+	if hintType == l1.HintL1Blob {
+		return p.prefetchBlob(ctx, hintBytes)
+	}
+	if len(hintBytes) != 32 || common.Hash(hintBytes) == (common.Hash{}) {
+		return fmt.Errorf("invalid hash: %x", hintBytes)
+	}
+	hash := common.Hash(hintBytes)
 	p.logger.Debug("Prefetching", "type", hintType, "hash", hash)
 	switch hintType {
 	case l1.HintL1BlockHeader:
@@ -139,6 +161,48 @@ func (p *Prefetcher) prefetch(ctx context.Context, hint string) error {
 	return fmt.Errorf("unknown hint type: %v", hintType)
 }
 
+// prefetchBlob fetches the blob of the hint, and stores the KZG commitment by the versioned hash of the blob,
+// and the field elements of the blob by their evaluation point and the commitment.
+func (p *Prefetcher) prefetchBlob(ctx context.Context, hintBytes []byte) error {
+	if len(hintBytes) != 32+8+8 {
+		return fmt.Errorf("invalid blob hint: %x", hintBytes)
+	}
+	blobHash := eth.IndexedBlobHash{
+		Hash:  common.Hash(hintBytes[:32]),
+		Index: binary.BigEndian.Uint64(hintBytes[32:40]),
+	}
+	// The blob sidecars are looked up by the slot of the L1 block, which only depends on the L1 block time.
+	ref := eth.L1BlockRef{Time: binary.BigEndian.Uint64(hintBytes[40:])}
+	p.logger.Debug("Prefetching", "type", l1.HintL1Blob, "hash", blobHash.Hash, "index", blobHash.Index, "timestamp", ref.Time)
+	if p.l1BlobFetcher == nil {
+		return ErrNoL1BlobSource
+	}
+	sidecars, err := p.l1BlobFetcher.GetBlobSidecars(ctx, ref, []eth.IndexedBlobHash{blobHash})
+	if err != nil {
+		return fmt.Errorf("failed to fetch blob %s: %w", blobHash.Hash, err)
+	}
+	if len(sidecars) != 1 {
+		return fmt.Errorf("expected 1 sidecar for blob %s, got %d", blobHash.Hash, len(sidecars))
+	}
+	sidecar := sidecars[0]
+	commitment := sidecar.KZGCommitment[:]
+	if err := p.kvStore.Put(preimage.Sha256Key(blobHash.Hash).PreimageKey(), commitment); err != nil {
+		return err
+	}
+	for i := 0; i < params.BlobTxFieldElementsPerBlob; i++ {
+		key := l1.BlobFieldElementKey(commitment, i)
+		keyHash := crypto.Keccak256Hash(key)
+		// the key is stored too, so the field element can be verified with KZG point evaluation
+		if err := p.kvStore.Put(preimage.Keccak256Key(keyHash).PreimageKey(), key); err != nil {
+			return err
+		}
+		if err := p.kvStore.Put(preimage.BlobKey(keyHash).PreimageKey(), sidecar.Blob[i*32:(i+1)*32]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (p *Prefetcher) storeReceipts(receipts types.Receipts) error {
 	opaqueReceipts, err := eth.EncodeReceipts(receipts)
 	if err != nil {
@@ -166,15 +230,15 @@ func (p *Prefetcher) storeTrieNodes(values []hexutil.Bytes) error {
 	return nil
 }
 
-// parseHint parses a hint string in wire protocol. Returns the hint type, requested hash and error (if any).
-func parseHint(hint string) (string, common.Hash, error) {
-	hintType, hashStr, found := strings.Cut(hint, " ")
+// parseHint parses a hint string in wire protocol. Returns the hint type, requested hint data and error (if any).
+func parseHint(hint string) (string, []byte, error) {
+	hintType, bytesStr, found := strings.Cut(hint, " ")
 	if !found {
-		return "", common.Hash{}, fmt.Errorf("unsupported hint: %s", hint)
+		return "", nil, fmt.Errorf("unsupported hint: %s", hint)
 	}
-	hash := common.HexToHash(hashStr)
-	if hash == (common.Hash{}) {
-		return "", common.Hash{}, fmt.Errorf("invalid hash: %s", hashStr)
+	hintBytes, err := hexutil.Decode(bytesStr)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid hash: %s: %w", bytesStr, err)
 	}
-	return hintType, hash, nil
+	return hintType, hintBytes, nil
 }
@@ -2,13 +2,16 @@ package prefetcher
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/require"
 
@@ -257,6 +260,67 @@ func TestFetchL2Code(t *testing.T) {
 	})
 }
 
+type stubBlobSource struct {
+	sidecars map[common.Hash]*eth.BlobSidecar
+	calls    int
+}
+
+func (s *stubBlobSource) GetBlobSidecars(ctx context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.BlobSidecar, error) {
+	s.calls++
+	out := make([]*eth.BlobSidecar, 0, len(hashes))
+	for _, h := range hashes {
+		sc, ok := s.sidecars[h.Hash]
+		if !ok {
+			return nil, fmt.Errorf("unknown blob %s", h.Hash)
+		}
+		out = append(out, sc)
+	}
+	return out, nil
+}
+
+func TestFetchL1Blob(t *testing.T) {
+	rng := rand.New(rand.NewSource(123))
+	var blob eth.Blob
+	for i := 0; i < params.BlobTxFieldElementsPerBlob; i++ {
+		rng.Read(blob[i*32+1 : (i+1)*32])
+	}
+	commitment, err := kzg4844.BlobToCommitment(*blob.KZGBlob())
+	require.NoError(t, err)
+	blobHash := eth.IndexedBlobHash{Index: 1, Hash: eth.KZGToVersionedHash(commitment)}
+	ref := eth.L1BlockRef{Time: 1000}
+
+	t.Run("Unknown", func(t *testing.T) {
+		blobs := &stubBlobSource{sidecars: map[common.Hash]*eth.BlobSidecar{
+			blobHash.Hash: {Index: 1, Blob: blob, KZGCommitment: eth.Bytes48(commitment)},
+		}}
+		_, l1Source, l2Source, kv := createPrefetcher(t)
+		prefetcher := NewPrefetcher(testlog.Logger(t, log.LvlInfo), l1Source, blobs, l2Source, kv)
+
+		oracle := l1.NewPreimageOracle(asOracleFn(t, prefetcher), asHinter(t, prefetcher))
+		require.Equal(t, &blob, oracle.GetBlob(ref, blobHash))
+		require.Equal(t, 1, blobs.calls, "blob must be fetched once")
+
+		key := l1.BlobFieldElementKey(commitment[:], 7)
+		stored, err := kv.Get(preimage.Keccak256Key(crypto.Keccak256Hash(key)).PreimageKey())
+		require.NoError(t, err)
+		require.Equal(t, key, stored, "field element key must be stored for point evaluation")
+	})
+
+	t.Run("NoBlobSource", func(t *testing.T) {
+		prefetcher, _, _, _ := createPrefetcher(t)
+		require.NoError(t, prefetcher.Hint(l1.BlobHint(append(blobHash.Hash.Bytes(), make([]byte, 16)...)).Hint()))
+		_, err := prefetcher.GetPreimage(context.Background(), preimage.Sha256Key(blobHash.Hash).PreimageKey())
+		require.ErrorIs(t, err, ErrNoL1BlobSource)
+	})
+
+	t.Run("InvalidHint", func(t *testing.T) {
+		prefetcher, _, _, _ := createPrefetcher(t)
+		require.NoError(t, prefetcher.Hint(l1.BlobHint(blobHash.Hash.Bytes()).Hint()))
+		_, err := prefetcher.GetPreimage(context.Background(), preimage.Sha256Key(blobHash.Hash).PreimageKey())
+		require.ErrorContains(t, err, "invalid blob hint")
+	})
+}
+
 func TestBadHints(t *testing.T) {
 	prefetcher, _, _, kv := createPrefetcher(t)
 	hash := common.Hash{0xad}
@@ -314,7 +378,7 @@ func TestRetryWhenNotAvailableAfterPrefetching(t *testing.T) {
 	_, l1Source, l2Cl, kv := createPrefetcher(t)
 	putsToIgnore := 2
 	kv = &unreliableKvStore{KV: kv, putsToIgnore: putsToIgnore}
-	prefetcher := NewPrefetcher(testlog.Logger(t, log.LvlInfo), l1Source, l2Cl, kv)
+	prefetcher := NewPrefetcher(testlog.Logger(t, log.LvlInfo), l1Source, nil, l2Cl, kv)
 
 	// Expect one call for each ignored put, plus one more request for when the put succeeds
 	for i := 0; i < putsToIgnore+1; i++ {
@@ -365,7 +429,7 @@ func createPrefetcher(t *testing.T) (*Prefetcher, *testutils.MockL1Source, *l2Cl
 		MockDebugClient: new(testutils.MockDebugClient),
 	}
 
-	prefetcher := NewPrefetcher(logger, l1Source, l2Source, kv)
+	prefetcher := NewPrefetcher(logger, l1Source, nil, l2Source, kv)
 	return prefetcher, l1Source, l2Source, kv
 }
 
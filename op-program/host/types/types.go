@@ -0,0 +1,13 @@
+package types
+
+// DataFormat is the on-disk format of the pre-image store of the host.
+type DataFormat string
+
+const (
+	// DataFormatDirectory stores every pre-image as a hex-encoded file in the data directory.
+	DataFormatDirectory DataFormat = "directory"
+	// DataFormatPebble stores all pre-images in a pebble database in the data directory.
+	DataFormatPebble DataFormat = "pebble"
+)
+
+var SupportedDataFormats = []DataFormat{DataFormatDirectory, DataFormatPebble}
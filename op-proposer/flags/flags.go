@@ -11,6 +11,7 @@ import (
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -29,16 +30,33 @@ var (
 	}
 	RollupRpcFlag = &cli.StringFlag{
 		Name:    "rollup-rpc",
-		Usage:   "HTTP provider URL for the rollup node",
+		Usage:   "HTTP provider URL for the rollup node. Multiple comma-separated URLs are failed over between.",
 		EnvVars: prefixEnvVars("ROLLUP_RPC"),
 	}
+
+	// Optional flags
 	L2OOAddressFlag = &cli.StringFlag{
 		Name:    "l2oo-address",
-		Usage:   "Address of the L2OutputOracle contract",
+		Usage:   "Address of the L2OutputOracle contract. Either it or the DisputeGameFactory address must be set.",
 		EnvVars: prefixEnvVars("L2OO_ADDRESS"),
 	}
-
-	// Optional flags
+	DisputeGameFactoryAddressFlag = &cli.StringFlag{
+		Name: "game-factory-address",
+		Usage: "Address of the DisputeGameFactory contract, to propose outputs as dispute games instead of to the L2OutputOracle. " +
+			"The implementation of the game type must accept output roots as root claims.",
+		EnvVars: prefixEnvVars("GAME_FACTORY_ADDRESS"),
+	}
+	ProposalIntervalFlag = &cli.DurationFlag{
+		Name:    "proposal-interval",
+		Usage:   "Minimum time between the dispute games that are created, when proposing to the DisputeGameFactory",
+		EnvVars: prefixEnvVars("PROPOSAL_INTERVAL"),
+	}
+	DisputeGameTypeFlag = &cli.UintFlag{
+		Name:    "game-type",
+		Usage:   "Type of the dispute games that are created, when proposing to the DisputeGameFactory",
+		Value:   0,
+		EnvVars: prefixEnvVars("GAME_TYPE"),
+	}
 	PollIntervalFlag = &cli.DurationFlag{
 		Name:    "poll-interval",
 		Usage:   "How frequently to poll L2 for new blocks",
@@ -50,6 +68,17 @@ var (
 		Usage:   "Allow the proposer to submit proposals for L2 blocks derived from non-finalized L1 blocks.",
 		EnvVars: prefixEnvVars("ALLOW_NON_FINALIZED"),
 	}
+	ValidationRollupRpcsFlag = &cli.StringSliceFlag{
+		Name:    "validation-rollup-rpcs",
+		Usage:   "HTTP provider URLs of other rollup nodes, which must agree with each output root before it is proposed",
+		EnvVars: prefixEnvVars("VALIDATION_ROLLUP_RPCS"),
+	}
+	ValidationL2EthRpcsFlag = &cli.StringSliceFlag{
+		Name: "validation-l2-eth-rpcs",
+		Usage: "HTTP provider URLs of L2 execution engines, from which each output root is computed, " +
+			"which must agree with it before it is proposed",
+		EnvVars: prefixEnvVars("VALIDATION_L2_ETH_RPCS"),
+	}
 	// Legacy Flags
 	L2OutputHDPathFlag = txmgr.L2OutputHDPathFlag
 )
@@ -57,17 +86,23 @@ var (
 var requiredFlags = []cli.Flag{
 	L1EthRpcFlag,
 	RollupRpcFlag,
-	L2OOAddressFlag,
 }
 
 var optionalFlags = []cli.Flag{
+	L2OOAddressFlag,
+	DisputeGameFactoryAddressFlag,
+	ProposalIntervalFlag,
+	DisputeGameTypeFlag,
 	PollIntervalFlag,
 	AllowNonFinalizedFlag,
+	ValidationRollupRpcsFlag,
+	ValidationL2EthRpcsFlag,
 	L2OutputHDPathFlag,
 }
 
 func init() {
 	optionalFlags = append(optionalFlags, oprpc.CLIFlags(EnvVarPrefix)...)
+	optionalFlags = append(optionalFlags, optls.OptionalCLIFlags(EnvVarPrefix, RollupRpcFlag.Name)...)
 	optionalFlags = append(optionalFlags, oplog.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, opmetrics.CLIFlags(EnvVarPrefix)...)
 	optionalFlags = append(optionalFlags, oppprof.CLIFlags(EnvVarPrefix)...)
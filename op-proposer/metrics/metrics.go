@@ -28,6 +28,7 @@ type Metricer interface {
 	txmetrics.TxMetricer
 
 	RecordL2BlocksProposed(l2ref eth.L2BlockRef)
+	RecordOutputMismatch()
 }
 
 type Metrics struct {
@@ -41,6 +42,8 @@ type Metrics struct {
 
 	info prometheus.GaugeVec
 	up   prometheus.Gauge
+
+	outputMismatches prometheus.Counter
 }
 
 var _ Metricer = (*Metrics)(nil)
@@ -75,6 +78,11 @@ func NewMetrics(procName string) *Metrics {
 			Name:      "up",
 			Help:      "1 if the op-proposer has finished starting up",
 		}),
+		outputMismatches: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: ns,
+			Name:      "output_mismatches_total",
+			Help:      "Number of outputs that were not proposed, because a validation source disagreed with the output root",
+		}),
 	}
 }
 
@@ -83,9 +91,13 @@ func (m *Metrics) Start(host string, port int) (*httputil.HTTPServer, error) {
 }
 
 func (m *Metrics) StartBalanceMetrics(ctx context.Context,
-	l log.Logger, client *ethclient.Client, account common.Address) {
+	l log.Logger, client *ethclient.Client, account common.Address, thresholds opmetrics.BalanceThresholds) {
 	// TODO(7684): util was refactored to close, but ctx is still being used by caller for shutdown
-	balanceMetric := opmetrics.LaunchBalanceMetrics(l, m.registry, m.ns, client, account)
+	balanceMetric := opmetrics.LaunchBalanceMetrics(l, m.registry, m.ns, client, opmetrics.MonitoredAccount{
+		Name:       "proposer",
+		Address:    account,
+		Thresholds: thresholds,
+	})
 	go func() {
 		<-ctx.Done()
 		_ = balanceMetric.Close()
@@ -113,6 +125,11 @@ func (m *Metrics) RecordL2BlocksProposed(l2ref eth.L2BlockRef) {
 	m.RecordL2Ref(BlockProposed, l2ref)
 }
 
+// RecordOutputMismatch should be called when a validation source disagrees with an output root
+func (m *Metrics) RecordOutputMismatch() {
+	m.outputMismatches.Inc()
+}
+
 func (m *Metrics) Document() []opmetrics.DocumentedMetric {
 	return m.factory.Document()
 }
@@ -1,6 +1,7 @@
 package proposer
 
 import (
+	"context"
 	"math/big"
 	"math/rand"
 	"testing"
@@ -10,9 +11,11 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/require"
 )
 
@@ -70,3 +73,127 @@ func TestManualABIPacking(t *testing.T) {
 
 	require.Equal(t, txData, tx.Data())
 }
+
+// TestManualDisputeGameABIPacking ensures that the manual ABI packing of the dispute game creation is the same
+// as going through the bound DisputeGameFactory contract.
+func TestManualDisputeGameABIPacking(t *testing.T) {
+	_, opts, backend, _, err := setupL2OutputOracle()
+	require.NoError(t, err)
+	rng := rand.New(rand.NewSource(1234))
+
+	abi, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	require.NoError(t, err)
+	factory, err := bindings.NewDisputeGameFactoryTransactor(testutils.RandomAddress(rng), backend)
+	require.NoError(t, err)
+
+	output := testutils.RandomOutputResponse(rng)
+	gameType := uint8(1)
+
+	txData, err := proposeDisputeGameTxData(abi, gameType, output)
+	require.NoError(t, err)
+
+	extraData := disputeGameExtraData(output)
+	require.Equal(t, new(big.Int).SetUint64(output.BlockRef.Number), new(big.Int).SetBytes(extraData[:32]))
+	require.Equal(t, new(big.Int).SetUint64(output.Status.CurrentL1.Number), new(big.Int).SetBytes(extraData[32:]))
+
+	opts.GasLimit = 100_000
+	opts.NoSend = true
+	tx, err := factory.Create(opts, gameType, output.OutputRoot, extraData)
+	require.NoError(t, err)
+
+	require.Equal(t, txData, tx.Data())
+}
+
+// setupDisputeGameFactory deploys the DisputeGameFactory contract behind a proxy to a simulated backend,
+// so that the deployer owns the factory and can set the game implementations.
+func setupDisputeGameFactory(t *testing.T) (common.Address, *bind.TransactOpts, *backends.SimulatedBackend, common.Address, *bindings.DisputeGameFactory) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(privateKey.PublicKey)
+	opts, err := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(1337))
+	require.NoError(t, err)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{from: {Balance: big.NewInt(params.Ether)}}, 50_000_000)
+
+	impl, _, _, err := bindings.DeployDisputeGameFactory(opts, backend)
+	require.NoError(t, err)
+	proxyAddr, _, proxy, err := bindings.DeployProxy(opts, backend, from)
+	require.NoError(t, err)
+	backend.Commit()
+
+	abi, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	require.NoError(t, err)
+	initData, err := abi.Pack("initialize", from)
+	require.NoError(t, err)
+	_, err = proxy.UpgradeToAndCall(opts, impl, initData)
+	require.NoError(t, err)
+	backend.Commit()
+
+	factory, err := bindings.NewDisputeGameFactory(proxyAddr, backend)
+	require.NoError(t, err)
+	return from, opts, backend, proxyAddr, factory
+}
+
+// TestCheckOutputRootClaim ensures that the check of the game implementation creates the game
+// of an output through the real DisputeGameFactory contract.
+func TestCheckOutputRootClaim(t *testing.T) {
+	abi, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	require.NoError(t, err)
+	rng := rand.New(rand.NewSource(1234))
+	gameType := uint8(0)
+
+	t.Run("FaultDisputeGame", func(t *testing.T) {
+		from, opts, backend, factoryAddr, factory := setupDisputeGameFactory(t)
+		blockOracle, _, _, err := bindings.DeployBlockOracle(opts, backend)
+		require.NoError(t, err)
+		l2oo, _, _, err := bindings.DeployL2OutputOracle(opts, backend, big.NewInt(10), big.NewInt(2), big.NewInt(100))
+		require.NoError(t, err)
+		game, _, _, err := bindings.DeployFaultDisputeGame(
+			opts,
+			backend,
+			gameType,
+			[32]byte{0x01},
+			big.NewInt(15),
+			uint64(604800),
+			common.Address{0xdd},
+			l2oo,
+			blockOracle,
+		)
+		require.NoError(t, err)
+		backend.Commit()
+		_, err = factory.SetImplementation(opts, gameType, game)
+		require.NoError(t, err)
+		backend.Commit()
+
+		// The first byte of a root claim of the game is the VM status
+		output := testutils.RandomOutputResponse(rng)
+		output.OutputRoot[0] = 0x00
+
+		gameABI, err := bindings.FaultDisputeGameMetaData.GetAbi()
+		require.NoError(t, err)
+		err = checkOutputRootClaim(context.Background(), backend, from, factoryAddr, abi, gameType, output)
+		require.ErrorIs(t, err, ErrOutputRootClaimRejected)
+		var dataErr rpc.DataError
+		require.ErrorAs(t, err, &dataErr)
+		require.Equal(t, hexutil.Encode(gameABI.Errors["UnexpectedRootClaim"].ID.Bytes()[:4]), dataErr.ErrorData().(string)[:10])
+	})
+
+	t.Run("AcceptedClaim", func(t *testing.T) {
+		from, opts, backend, factoryAddr, factory := setupDisputeGameFactory(t)
+		// The fallback function of WETH9 accepts the initialization of the game, whatever the root claim.
+		game, _, _, err := bindings.DeployWETH9(opts, backend)
+		require.NoError(t, err)
+		backend.Commit()
+		_, err = factory.SetImplementation(opts, gameType, game)
+		require.NoError(t, err)
+		backend.Commit()
+
+		output := testutils.RandomOutputResponse(rng)
+		require.NoError(t, checkOutputRootClaim(context.Background(), backend, from, factoryAddr, abi, gameType, output))
+
+		_, err = factory.Create(opts, gameType, output.OutputRoot, disputeGameExtraData(output))
+		require.NoError(t, err)
+		backend.Commit()
+		require.NoError(t, checkOutputRootClaim(context.Background(), backend, from, factoryAddr, abi, gameType, output),
+			"existing game was accepted when it was created")
+	})
+}
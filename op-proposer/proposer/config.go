@@ -1,6 +1,9 @@
 package proposer
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -8,12 +11,14 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/ethereum-optimism/optimism/op-proposer/flags"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
 
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	oppprof "github.com/ethereum-optimism/optimism/op-service/pprof"
 	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 )
 
@@ -27,6 +32,19 @@ type Config struct {
 	L1Client           *ethclient.Client
 	RollupClient       *sources.RollupClient
 	AllowNonFinalized  bool
+
+	// DisputeGameFactoryAddr is set to propose outputs as dispute games, instead of to the L2OutputOracle.
+	DisputeGameFactoryAddr *common.Address
+	// ProposalInterval is the minimum time between the dispute games that are created.
+	ProposalInterval time.Duration
+	// DisputeGameType is the type of the dispute games that are created.
+	DisputeGameType uint8
+
+	// OutputSources are the sources that each output is validated against before it is proposed.
+	OutputSources []OutputSource
+
+	// Clock that the polling of the submitter is scheduled with. The system clock is used if nil.
+	Clock clock.Clock
 }
 
 // CLIConfig is a well typed config that is parsed from the CLI params.
@@ -41,13 +59,34 @@ type CLIConfig struct {
 	// RollupRpc is the HTTP provider URL for the rollup node.
 	RollupRpc string
 
+	// RollupRpcTLS authenticates with the rollup nodes over mutual TLS, if set.
+	// It applies to the ValidationRollupRpcs as well.
+	RollupRpcTLS optls.CLIConfig
+
 	// L2OOAddress is the L2OutputOracle contract address.
 	L2OOAddress string
 
+	// DGFAddress is the DisputeGameFactory contract address. Either it or the L2OOAddress must be set.
+	DGFAddress string
+
+	// ProposalInterval is the minimum time between the dispute games that are created, if the DGFAddress is set.
+	ProposalInterval time.Duration
+
+	// DisputeGameType is the type of the dispute games that are created.
+	DisputeGameType uint
+
 	// PollInterval is the delay between querying L2 for more transaction
 	// and creating a new batch.
 	PollInterval time.Duration
 
+	// ValidationRollupRpcs are the HTTP provider URLs of other rollup nodes, which must agree with each output
+	// root before it is proposed.
+	ValidationRollupRpcs []string
+
+	// ValidationL2EthRpcs are the HTTP provider URLs of L2 execution engines, from which each output root is
+	// computed, which must agree with it before it is proposed.
+	ValidationL2EthRpcs []string
+
 	// AllowNonFinalized can be set to true to propose outputs
 	// for L2 blocks derived from non-finalized L1 data.
 	AllowNonFinalized bool
@@ -67,6 +106,9 @@ func (c CLIConfig) Check() error {
 	if err := c.RPCConfig.Check(); err != nil {
 		return err
 	}
+	if err := c.RollupRpcTLS.Check(); err != nil {
+		return fmt.Errorf("invalid rollup rpc tls config: %w", err)
+	}
 	if err := c.MetricsConfig.Check(); err != nil {
 		return err
 	}
@@ -76,6 +118,18 @@ func (c CLIConfig) Check() error {
 	if err := c.TxMgrConfig.Check(); err != nil {
 		return err
 	}
+	if c.L2OOAddress == "" && c.DGFAddress == "" {
+		return errors.New("either the L2OutputOracle or the DisputeGameFactory address must be set")
+	}
+	if c.L2OOAddress != "" && c.DGFAddress != "" {
+		return errors.New("only one of the L2OutputOracle and the DisputeGameFactory address can be set")
+	}
+	if c.DGFAddress != "" && c.ProposalInterval == 0 {
+		return errors.New("the proposal interval must be set when proposing to the DisputeGameFactory")
+	}
+	if c.DisputeGameType > math.MaxUint8 {
+		return fmt.Errorf("invalid dispute game type %d", c.DisputeGameType)
+	}
 	return nil
 }
 
@@ -85,14 +139,20 @@ func NewConfig(ctx *cli.Context) CLIConfig {
 		// Required Flags
 		L1EthRpc:     ctx.String(flags.L1EthRpcFlag.Name),
 		RollupRpc:    ctx.String(flags.RollupRpcFlag.Name),
+		RollupRpcTLS: optls.ReadCLIConfigWithPrefix(ctx, flags.RollupRpcFlag.Name),
 		L2OOAddress:  ctx.String(flags.L2OOAddressFlag.Name),
 		PollInterval: ctx.Duration(flags.PollIntervalFlag.Name),
 		TxMgrConfig:  txmgr.ReadCLIConfig(ctx),
 		// Optional Flags
-		AllowNonFinalized: ctx.Bool(flags.AllowNonFinalizedFlag.Name),
-		RPCConfig:         oprpc.ReadCLIConfig(ctx),
-		LogConfig:         oplog.ReadCLIConfig(ctx),
-		MetricsConfig:     opmetrics.ReadCLIConfig(ctx),
-		PprofConfig:       oppprof.ReadCLIConfig(ctx),
+		AllowNonFinalized:    ctx.Bool(flags.AllowNonFinalizedFlag.Name),
+		DGFAddress:           ctx.String(flags.DisputeGameFactoryAddressFlag.Name),
+		ValidationRollupRpcs: ctx.StringSlice(flags.ValidationRollupRpcsFlag.Name),
+		ValidationL2EthRpcs:  ctx.StringSlice(flags.ValidationL2EthRpcsFlag.Name),
+		ProposalInterval:     ctx.Duration(flags.ProposalIntervalFlag.Name),
+		DisputeGameType:      ctx.Uint(flags.DisputeGameTypeFlag.Name),
+		RPCConfig:            oprpc.ReadCLIConfig(ctx),
+		LogConfig:            oplog.ReadCLIConfig(ctx),
+		MetricsConfig:        opmetrics.ReadCLIConfig(ctx),
+		PprofConfig:          oppprof.ReadCLIConfig(ctx),
 	}
 }
@@ -6,21 +6,27 @@ import (
 	"fmt"
 	"math/big"
 	_ "net/http/pprof"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/urfave/cli/v2"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-proposer/flags"
 	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
 	"github.com/ethereum-optimism/optimism/op-service/dial"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
@@ -33,6 +39,17 @@ import (
 
 var supportedL2OutputVersion = eth.Bytes32{}
 
+// maxGameLookback is the number of most recent dispute games that are searched for the last game
+// of the game type, when the proposer starts proposing to the DisputeGameFactory.
+const maxGameLookback = 1000
+
+// ErrOutputRootClaimRejected is returned if the implementation of the game type cannot be created with
+// an output root as root claim.
+var ErrOutputRootClaimRejected = errors.New("dispute game implementation does not accept output root claims")
+
+// shutdownDrainTimeout is the time the proposer subsystems are given to stop after an interrupt.
+const shutdownDrainTimeout = 30 * time.Second
+
 // Main is the entrypoint into the L2 Output Submitter. This method executes the
 // service and blocks until the service exits.
 func Main(version string, cliCtx *cli.Context) error {
@@ -62,28 +79,32 @@ func Main(version string, cliCtx *cli.Context) error {
 		return err
 	}
 
+	shutdown := opio.NewShutdown(l)
+
 	l.Info("Starting L2 Output Submitter")
 	if err := l2OutputSubmitter.Start(); err != nil {
 		l.Error("Unable to start L2 Output Submitter", "error", err)
 		return err
 	}
-	defer l2OutputSubmitter.Stop()
+	shutdown.Register("proposer", func(ctx context.Context) error {
+		l2OutputSubmitter.Stop()
+		return nil
+	})
 
 	l.Info("L2 Output Submitter started")
 	pprofConfig := cfg.PprofConfig
+	if pusher := oppprof.StartPusher(l, pprofConfig, "op-proposer", version); pusher != nil {
+		shutdown.Register("pprof-pusher", pusher.Stop)
+	}
 	if pprofConfig.Enabled {
 		l.Debug("starting pprof", "addr", pprofConfig.ListenAddr, "port", pprofConfig.ListenPort)
 		pprofSrv, err := oppprof.StartServer(pprofConfig.ListenAddr, pprofConfig.ListenPort)
 		if err != nil {
 			l.Error("failed to start pprof server", "err", err)
-			return err
+			return errors.Join(err, shutdown.Stop(context.Background()))
 		}
 		l.Info("started pprof server", "addr", pprofSrv.Addr())
-		defer func() {
-			if err := pprofSrv.Stop(context.Background()); err != nil {
-				l.Error("failed to stop pprof server", "err", err)
-			}
-		}()
+		shutdown.Register("pprof", pprofSrv.Stop)
 	}
 
 	metricsCfg := cfg.MetricsConfig
@@ -91,35 +112,37 @@ func Main(version string, cliCtx *cli.Context) error {
 		l.Debug("starting metrics server", "addr", metricsCfg.ListenAddr, "port", metricsCfg.ListenPort)
 		metricsSrv, err := m.Start(metricsCfg.ListenAddr, metricsCfg.ListenPort)
 		if err != nil {
-			return fmt.Errorf("failed to start metrics server: %w", err)
+			return errors.Join(fmt.Errorf("failed to start metrics server: %w", err), shutdown.Stop(context.Background()))
 		}
 		l.Info("started metrics server", "addr", metricsSrv.Addr())
-		defer func() {
-			if err := metricsSrv.Stop(context.Background()); err != nil {
-				l.Error("failed to stop metrics server", "err", err)
-			}
-		}()
+		shutdown.Register("metrics", metricsSrv.Stop)
 		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-		m.StartBalanceMetrics(ctx, l, proposerConfig.L1Client, proposerConfig.TxManager.From())
+		shutdown.RegisterCloser("balance-metrics", func() error {
+			cancel()
+			return nil
+		})
+		m.StartBalanceMetrics(ctx, l, proposerConfig.L1Client, proposerConfig.TxManager.From(), metricsCfg.BalanceThresholds())
 	}
 
 	rpcCfg := cfg.RPCConfig
-	server := oprpc.NewServer(rpcCfg.ListenAddr, rpcCfg.ListenPort, version, oprpc.WithLogger(l))
+	tlsConfig, err := rpcCfg.ServerTLSConfig(l)
+	if err != nil {
+		return errors.Join(err, shutdown.Stop(context.Background()))
+	}
+	server := oprpc.NewServer(rpcCfg.ListenAddr, rpcCfg.ListenPort, version, oprpc.WithLogger(l), oprpc.WithTLSConfig(tlsConfig))
 	if rpcCfg.EnableAdmin {
 		server.AddAPI(oprpc.ToGethAdminAPI(oprpc.NewCommonAdminAPI(&m.RPCMetrics, l)))
 		l.Info("Admin RPC enabled")
 	}
 	if err := server.Start(); err != nil {
-		return fmt.Errorf("error starting RPC server: %w", err)
+		return errors.Join(fmt.Errorf("error starting RPC server: %w", err), shutdown.Stop(context.Background()))
 	}
+	shutdown.RegisterCloser("rpc", server.Stop)
 
 	m.RecordInfo(version)
 	m.RecordUp()
 
-	opio.BlockOnInterrupts()
-
-	return nil
+	return shutdown.BlockAndStop(context.Background(), shutdownDrainTimeout)
 }
 
 // L2OutputSubmitter is responsible for proposing outputs
@@ -135,11 +158,24 @@ type L2OutputSubmitter struct {
 
 	// RollupClient is used to retrieve output roots from
 	rollupClient *sources.RollupClient
+	l1Client     bind.ContractCaller
+	// outputSources are the sources that each output is validated against before it is proposed.
+	outputSources []OutputSource
 
 	l2ooContract     *bindings.L2OutputOracleCaller
 	l2ooContractAddr common.Address
 	l2ooABI          *abi.ABI
 
+	// dgfContract is set if outputs are proposed as dispute games, instead of to the L2OutputOracle.
+	dgfContract      *bindings.DisputeGameFactoryCaller
+	dgfContractAddr  common.Address
+	dgfABI           *abi.ABI
+	disputeGameType  uint8
+	proposalInterval time.Duration
+	// lastProposal is the last dispute game of the game type, which is loaded from the DisputeGameFactory
+	// once, so that no output is proposed again after a restart.
+	lastProposal *proposedGame
+
 	// AllowNonFinalized enables the proposal of safe, but non-finalized L2 blocks.
 	// The L1 block-hash embedded in the proposal TX is checked and should ensure the proposal
 	// is never valid on an alternative L1 chain that would produce different L2 data.
@@ -148,6 +184,8 @@ type L2OutputSubmitter struct {
 	// How frequently to poll L2 for new finalized outputs
 	pollInterval   time.Duration
 	networkTimeout time.Duration
+
+	clock clock.Clock
 }
 
 // NewL2OutputSubmitterFromCLIConfig creates a new L2 Output Submitter given the CLI Config
@@ -161,9 +199,13 @@ func NewL2OutputSubmitterFromCLIConfig(cfg CLIConfig, l log.Logger, m metrics.Me
 
 // NewL2OutputSubmitterConfigFromCLIConfig creates the proposer config from the CLI config.
 func NewL2OutputSubmitterConfigFromCLIConfig(cfg CLIConfig, l log.Logger, m metrics.Metricer) (*Config, error) {
-	l2ooAddress, err := opservice.ParseAddress(cfg.L2OOAddress)
-	if err != nil {
-		return nil, err
+	var l2ooAddress common.Address
+	if cfg.L2OOAddress != "" {
+		addr, err := opservice.ParseAddress(cfg.L2OOAddress)
+		if err != nil {
+			return nil, err
+		}
+		l2ooAddress = addr
 	}
 
 	txManager, err := txmgr.NewSimpleTxManager("proposer", l, m, cfg.TxMgrConfig)
@@ -177,19 +219,52 @@ func NewL2OutputSubmitterConfigFromCLIConfig(cfg CLIConfig, l log.Logger, m metr
 		return nil, err
 	}
 
-	rollupClient, err := dial.DialRollupClientWithTimeout(context.Background(), dial.DefaultDialTimeout, l, cfg.RollupRpc)
+	rollupOpts, err := dial.TLSOptions(l, cfg.RollupRpcTLS)
+	if err != nil {
+		return nil, err
+	}
+	rollupClient, err := dial.DialRollupClientWithTimeout(context.Background(), dial.DefaultDialTimeout, l, cfg.RollupRpc, rollupOpts...)
 	if err != nil {
 		return nil, err
 	}
 
+	var dgfAddress *common.Address
+	if cfg.DGFAddress != "" {
+		addr, err := opservice.ParseAddress(cfg.DGFAddress)
+		if err != nil {
+			return nil, err
+		}
+		dgfAddress = &addr
+	}
+
+	var outputSources []OutputSource
+	for i, url := range cfg.ValidationRollupRpcs {
+		client, err := dial.DialRollupClientWithTimeout(context.Background(), dial.DefaultDialTimeout, l, url, rollupOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial validation rollup rpc %d: %w", i, err)
+		}
+		outputSources = append(outputSources, NewRollupOutputSource(client, strconv.Itoa(i)))
+	}
+	for i, url := range cfg.ValidationL2EthRpcs {
+		client, err := dial.DialEthClientWithTimeout(context.Background(), dial.DefaultDialTimeout, l, url)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial validation L2 eth rpc %d: %w", i, err)
+		}
+		outputSources = append(outputSources, NewL2EthOutputSource(client, strconv.Itoa(i)))
+	}
+
 	return &Config{
-		L2OutputOracleAddr: l2ooAddress,
-		PollInterval:       cfg.PollInterval,
-		NetworkTimeout:     cfg.TxMgrConfig.NetworkTimeout,
-		L1Client:           l1Client,
-		RollupClient:       rollupClient,
-		AllowNonFinalized:  cfg.AllowNonFinalized,
-		TxManager:          txManager,
+		L2OutputOracleAddr:     l2ooAddress,
+		DisputeGameFactoryAddr: dgfAddress,
+		ProposalInterval:       cfg.ProposalInterval,
+		DisputeGameType:        uint8(cfg.DisputeGameType),
+		PollInterval:           cfg.PollInterval,
+		NetworkTimeout:         cfg.TxMgrConfig.NetworkTimeout,
+		L1Client:               l1Client,
+		RollupClient:           rollupClient,
+		AllowNonFinalized:      cfg.AllowNonFinalized,
+		TxManager:              txManager,
+		OutputSources:          outputSources,
 	}, nil
 
 }
@@ -198,45 +273,151 @@ func NewL2OutputSubmitterConfigFromCLIConfig(cfg CLIConfig, l log.Logger, m metr
 func NewL2OutputSubmitter(cfg Config, l log.Logger, m metrics.Metricer) (*L2OutputSubmitter, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
-	l2ooContract, err := bindings.NewL2OutputOracleCaller(cfg.L2OutputOracleAddr, cfg.L1Client)
+	cl := cfg.Clock
+	if cl == nil {
+		cl = clock.SystemClock
+	}
+
+	submitter := &L2OutputSubmitter{
+		txMgr:  cfg.TxManager,
+		done:   make(chan struct{}),
+		log:    l,
+		ctx:    ctx,
+		cancel: cancel,
+		metr:   m,
+
+		rollupClient:  cfg.RollupClient,
+		l1Client:      cfg.L1Client,
+		outputSources: cfg.OutputSources,
+
+		allowNonFinalized: cfg.AllowNonFinalized,
+		pollInterval:      cfg.PollInterval,
+		networkTimeout:    cfg.NetworkTimeout,
+		clock:             cl,
+	}
+
+	var err error
+	if cfg.DisputeGameFactoryAddr != nil {
+		err = submitter.initDGF(ctx, cfg)
+	} else {
+		err = submitter.initL2OO(ctx, cfg)
+	}
 	if err != nil {
 		cancel()
-		return nil, fmt.Errorf("failed to create L2OO at address %s: %w", cfg.L2OutputOracleAddr, err)
+		return nil, err
+	}
+	return submitter, nil
+}
+
+func (l *L2OutputSubmitter) initL2OO(ctx context.Context, cfg Config) error {
+	l2ooContract, err := bindings.NewL2OutputOracleCaller(cfg.L2OutputOracleAddr, cfg.L1Client)
+	if err != nil {
+		return fmt.Errorf("failed to create L2OO at address %s: %w", cfg.L2OutputOracleAddr, err)
 	}
 
 	cCtx, cCancel := context.WithTimeout(ctx, cfg.NetworkTimeout)
 	defer cCancel()
 	version, err := l2ooContract.Version(&bind.CallOpts{Context: cCtx})
 	if err != nil {
-		cancel()
-		return nil, err
+		return err
 	}
 	log.Info("Connected to L2OutputOracle", "address", cfg.L2OutputOracleAddr, "version", version)
 
 	parsed, err := bindings.L2OutputOracleMetaData.GetAbi()
 	if err != nil {
-		cancel()
-		return nil, err
+		return err
 	}
 
-	return &L2OutputSubmitter{
-		txMgr:  cfg.TxManager,
-		done:   make(chan struct{}),
-		log:    l,
-		ctx:    ctx,
-		cancel: cancel,
-		metr:   m,
+	l.l2ooContract = l2ooContract
+	l.l2ooContractAddr = cfg.L2OutputOracleAddr
+	l.l2ooABI = parsed
+	return nil
+}
 
-		rollupClient: cfg.RollupClient,
+func (l *L2OutputSubmitter) initDGF(ctx context.Context, cfg Config) error {
+	dgfContract, err := bindings.NewDisputeGameFactoryCaller(*cfg.DisputeGameFactoryAddr, cfg.L1Client)
+	if err != nil {
+		return fmt.Errorf("failed to create DGF at address %s: %w", cfg.DisputeGameFactoryAddr, err)
+	}
 
-		l2ooContract:     l2ooContract,
-		l2ooContractAddr: cfg.L2OutputOracleAddr,
-		l2ooABI:          parsed,
+	cCtx, cCancel := context.WithTimeout(ctx, cfg.NetworkTimeout)
+	defer cCancel()
+	version, err := dgfContract.Version(&bind.CallOpts{Context: cCtx})
+	if err != nil {
+		return err
+	}
+	impl, err := dgfContract.GameImpls(&bind.CallOpts{Context: cCtx}, cfg.DisputeGameType)
+	if err != nil {
+		return fmt.Errorf("failed to get implementation of game type %d: %w", cfg.DisputeGameType, err)
+	}
+	if impl == (common.Address{}) {
+		return fmt.Errorf("no implementation of game type %d in DGF at address %s", cfg.DisputeGameType, cfg.DisputeGameFactoryAddr)
+	}
+	log.Info("Connected to DisputeGameFactory", "address", cfg.DisputeGameFactoryAddr, "version", version,
+		"game_type", cfg.DisputeGameType, "impl", impl)
 
-		allowNonFinalized: cfg.AllowNonFinalized,
-		pollInterval:      cfg.PollInterval,
-		networkTimeout:    cfg.NetworkTimeout,
-	}, nil
+	parsed, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+
+	l.dgfContract = dgfContract
+	l.dgfContractAddr = *cfg.DisputeGameFactoryAddr
+	l.dgfABI = parsed
+	l.disputeGameType = cfg.DisputeGameType
+	l.proposalInterval = cfg.ProposalInterval
+
+	// The proposer creates games with output roots as root claims, which not every game implementation accepts.
+	output, err := l.fetchCurrentOutput(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch output to check game type %d: %w", cfg.DisputeGameType, err)
+	}
+	cCtx, cCancel = context.WithTimeout(ctx, cfg.NetworkTimeout)
+	defer cCancel()
+	return checkOutputRootClaim(cCtx, cfg.L1Client, l.txMgr.From(), l.dgfContractAddr, parsed, cfg.DisputeGameType, output)
+}
+
+// fetchCurrentOutput gets the output of the current finalized head, or of the safe head if
+// non-finalized proposals are allowed.
+func (l *L2OutputSubmitter) fetchCurrentOutput(ctx context.Context) (*eth.OutputResponse, error) {
+	cCtx, cancel := context.WithTimeout(ctx, l.networkTimeout)
+	defer cancel()
+	status, err := l.rollupClient.SyncStatus(cCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sync status: %w", err)
+	}
+	blockNumber := status.FinalizedL2.Number
+	if l.allowNonFinalized {
+		blockNumber = status.SafeL2.Number
+	}
+	cCtx, cancel = context.WithTimeout(ctx, l.networkTimeout)
+	defer cancel()
+	return l.rollupClient.OutputAtBlock(cCtx, blockNumber)
+}
+
+// checkOutputRootClaim simulates the creation of the dispute game of the output, to check that the game
+// implementation accepts the output root as root claim. The FaultDisputeGame does not: it only accepts
+// root claims that commit to an invalid VM status, and disputes the outputs of the L2OutputOracle instead.
+// A game that already exists for the output was accepted when it was created.
+func checkOutputRootClaim(ctx context.Context, caller bind.ContractCaller, from common.Address, dgfAddr common.Address,
+	dgfABI *abi.ABI, gameType uint8, output *eth.OutputResponse) error {
+	data, err := proposeDisputeGameTxData(dgfABI, gameType, output)
+	if err != nil {
+		return err
+	}
+	_, err = caller.CallContract(ctx, ethereum.CallMsg{From: from, To: &dgfAddr, Data: data}, nil)
+	if err == nil {
+		return nil
+	}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		gameExists := hexutil.Encode(dgfABI.Errors["GameAlreadyExists"].ID.Bytes()[:4])
+		if revert, ok := dataErr.ErrorData().(string); ok && strings.HasPrefix(revert, gameExists) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: game type %d, output root %s of L2 block %d: %w",
+		ErrOutputRootClaimRejected, gameType, output.OutputRoot, output.BlockRef.Number, err)
 }
 
 func (l *L2OutputSubmitter) Start() error {
@@ -254,6 +435,9 @@ func (l *L2OutputSubmitter) Stop() {
 // FetchNextOutputInfo gets the block number of the next proposal.
 // It returns: the next block number, if the proposal should be made, error
 func (l *L2OutputSubmitter) FetchNextOutputInfo(ctx context.Context) (*eth.OutputResponse, bool, error) {
+	if l.dgfContract != nil {
+		return l.fetchNextDGFOutputInfo(ctx)
+	}
 	cCtx, cancel := context.WithTimeout(ctx, l.networkTimeout)
 	defer cancel()
 	callOpts := &bind.CallOpts{
@@ -316,9 +500,129 @@ func (l *L2OutputSubmitter) fetchOutput(ctx context.Context, block *big.Int) (*e
 			"allow_non_finalized", l.allowNonFinalized)
 		return nil, false, nil
 	}
+	if err := l.validateOutput(ctx, output); err != nil {
+		return nil, false, err
+	}
+	return output, true, nil
+}
+
+// validateOutput checks that all output sources agree with the output root of the output. An output is not
+// proposed if any of the sources fails to provide the output root, or provides a different one.
+func (l *L2OutputSubmitter) validateOutput(ctx context.Context, output *eth.OutputResponse) error {
+	for _, source := range l.outputSources {
+		cCtx, cancel := context.WithTimeout(ctx, l.networkTimeout)
+		root, err := source.OutputRootAtBlock(cCtx, output.BlockRef.Number)
+		cancel()
+		if err != nil {
+			l.log.Error("failed to fetch output root to validate output", "source", source, "l2_block", output.BlockRef, "err", err)
+			return fmt.Errorf("failed to fetch output root from %v: %w", source, err)
+		}
+		if root != output.OutputRoot {
+			l.metr.RecordOutputMismatch()
+			l.log.Error("output root mismatch, not proposing output", "source", source, "l2_block", output.BlockRef,
+				"output_root", output.OutputRoot, "source_output_root", root)
+			return fmt.Errorf("output root mismatch at block %d: %v has %v instead of %v",
+				output.BlockRef.Number, source, root, output.OutputRoot)
+		}
+	}
+	return nil
+}
+
+// fetchNextDGFOutputInfo gets the output of the current finalized or safe head, if the proposal interval
+// elapsed since the last dispute game that the proposer created, and the head advanced since.
+func (l *L2OutputSubmitter) fetchNextDGFOutputInfo(ctx context.Context) (*eth.OutputResponse, bool, error) {
+	if l.lastProposal == nil {
+		last, err := l.loadLastProposal(ctx)
+		if err != nil {
+			l.log.Error("proposer unable to load last dispute game", "err", err)
+			return nil, false, err
+		}
+		l.lastProposal = last
+	}
+	if elapsed := l.clock.Now().Sub(l.lastProposal.timestamp); elapsed < l.proposalInterval {
+		l.log.Debug("proposer proposal interval has not elapsed", "elapsed", elapsed, "interval", l.proposalInterval)
+		return nil, false, nil
+	}
+
+	cCtx, cancel := context.WithTimeout(ctx, l.networkTimeout)
+	defer cancel()
+	status, err := l.rollupClient.SyncStatus(cCtx)
+	if err != nil {
+		l.log.Error("proposer unable to get sync status", "err", err)
+		return nil, false, err
+	}
+	currentBlockNumber := status.FinalizedL2.Number
+	if l.allowNonFinalized {
+		currentBlockNumber = status.SafeL2.Number
+	}
+	if currentBlockNumber <= l.lastProposal.l2BlockNumber {
+		l.log.Debug("proposer has already proposed the current head", "currentBlockNumber", currentBlockNumber,
+			"lastProposedBlockNumber", l.lastProposal.l2BlockNumber)
+		return nil, false, nil
+	}
+
+	output, shouldPropose, err := l.fetchOutput(ctx, new(big.Int).SetUint64(currentBlockNumber))
+	if err != nil || !shouldPropose {
+		return nil, false, err
+	}
+
+	// A game with the same root claim and extra data cannot be created twice, e.g. after a reorg of the
+	// proposal tx.
+	cCtx, cancel = context.WithTimeout(ctx, l.networkTimeout)
+	defer cancel()
+	game, err := l.dgfContract.Games(&bind.CallOpts{Context: cCtx}, l.disputeGameType, output.OutputRoot, disputeGameExtraData(output))
+	if err != nil {
+		l.log.Error("proposer unable to check for existing dispute game", "err", err)
+		return nil, false, err
+	}
+	if game.Proxy != (common.Address{}) {
+		l.log.Info("dispute game for output already exists", "game", game.Proxy, "l2_block", output.BlockRef)
+		l.lastProposal = &proposedGame{l2BlockNumber: output.BlockRef.Number, timestamp: time.Unix(int64(game.Timestamp), 0)}
+		return nil, false, nil
+	}
 	return output, true, nil
 }
 
+// proposedGame is a dispute game of the game type that the proposer creates.
+type proposedGame struct {
+	l2BlockNumber uint64
+	timestamp     time.Time
+}
+
+// loadLastProposal searches the most recent dispute games for the last game of the game type of the proposer.
+// Games do not record their creator, so the last game may have been created by another proposer, which
+// proposed an output that the proposer does not have to propose again.
+// It returns an empty proposedGame if no such game is found.
+func (l *L2OutputSubmitter) loadLastProposal(ctx context.Context) (*proposedGame, error) {
+	cCtx, cancel := context.WithTimeout(ctx, l.networkTimeout)
+	defer cancel()
+	callOpts := &bind.CallOpts{Context: cCtx}
+	count, err := l.dgfContract.GameCount(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game count: %w", err)
+	}
+	for i, n := count.Uint64(), 0; i > 0 && n < maxGameLookback; i, n = i-1, n+1 {
+		game, err := l.dgfContract.GameAtIndex(callOpts, new(big.Int).SetUint64(i-1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get game %d: %w", i-1, err)
+		}
+		if game.GameType != l.disputeGameType {
+			continue
+		}
+		caller, err := bindings.NewFaultDisputeGameCaller(game.Proxy, l.l1Client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind game %s: %w", game.Proxy, err)
+		}
+		l2BlockNumber, err := caller.L2BlockNumber(callOpts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get L2 block number of game %s: %w", game.Proxy, err)
+		}
+		l.log.Info("Found last dispute game", "game", game.Proxy, "l2_block", l2BlockNumber)
+		return &proposedGame{l2BlockNumber: l2BlockNumber.Uint64(), timestamp: time.Unix(int64(game.Timestamp), 0)}, nil
+	}
+	return &proposedGame{}, nil
+}
+
 // ProposeL2OutputTxData creates the transaction data for the ProposeL2Output function
 func (l *L2OutputSubmitter) ProposeL2OutputTxData(output *eth.OutputResponse) ([]byte, error) {
 	return proposeL2OutputTxData(l.l2ooABI, output)
@@ -334,6 +638,30 @@ func proposeL2OutputTxData(abi *abi.ABI, output *eth.OutputResponse) ([]byte, er
 		new(big.Int).SetUint64(output.Status.CurrentL1.Number))
 }
 
+// ProposeDisputeGameTxData creates the transaction data for the create function of the DisputeGameFactory
+func (l *L2OutputSubmitter) ProposeDisputeGameTxData(output *eth.OutputResponse) ([]byte, error) {
+	return proposeDisputeGameTxData(l.dgfABI, l.disputeGameType, output)
+}
+
+// proposeDisputeGameTxData creates the transaction data for the create function of the DisputeGameFactory,
+// with the output root as the root claim of the game.
+func proposeDisputeGameTxData(abi *abi.ABI, gameType uint8, output *eth.OutputResponse) ([]byte, error) {
+	return abi.Pack(
+		"create",
+		gameType,
+		output.OutputRoot,
+		disputeGameExtraData(output))
+}
+
+// disputeGameExtraData is the extra data of the dispute game of the output: the L2 block number of the output,
+// and the L1 block number that the output was derived from, as two ABI encoded uint256 values.
+func disputeGameExtraData(output *eth.OutputResponse) []byte {
+	extraData := make([]byte, 64)
+	new(big.Int).SetUint64(output.BlockRef.Number).FillBytes(extraData[:32])
+	new(big.Int).SetUint64(output.Status.CurrentL1.Number).FillBytes(extraData[32:])
+	return extraData
+}
+
 // We wait until l1head advances beyond blocknum. This is used to make sure proposal tx won't
 // immediately fail when checking the l1 blockhash. Note that EstimateGas uses "latest" state to
 // execute the transaction by default, meaning inside the call, the head block is considered
@@ -341,7 +669,7 @@ func proposeL2OutputTxData(abi *abi.ABI, output *eth.OutputResponse) ([]byte, er
 // will produce a value of 0 within EstimateGas, and the call will fail when the contract checks
 // that l1blockhash matches blockhash(l1blocknum).
 func (l *L2OutputSubmitter) waitForL1Head(ctx context.Context, blockNum uint64) error {
-	ticker := time.NewTicker(l.pollInterval)
+	ticker := l.clock.NewTicker(l.pollInterval)
 	defer ticker.Stop()
 	l1head, err := l.txMgr.BlockNumber(ctx)
 	if err != nil {
@@ -350,7 +678,7 @@ func (l *L2OutputSubmitter) waitForL1Head(ctx context.Context, blockNum uint64)
 	for l1head <= blockNum {
 		l.log.Debug("waiting for l1 head > l1blocknum1+1", "l1head", l1head, "l1blocknum", blockNum)
 		select {
-		case <-ticker.C:
+		case <-ticker.Ch():
 			l1head, err = l.txMgr.BlockNumber(ctx)
 			if err != nil {
 				return err
@@ -369,21 +697,38 @@ func (l *L2OutputSubmitter) sendTransaction(ctx context.Context, output *eth.Out
 	if err != nil {
 		return err
 	}
-	data, err := l.ProposeL2OutputTxData(output)
-	if err != nil {
-		return err
+	var candidate txmgr.TxCandidate
+	if l.dgfContract != nil {
+		data, err := l.ProposeDisputeGameTxData(output)
+		if err != nil {
+			return err
+		}
+		candidate = txmgr.TxCandidate{
+			TxData:   data,
+			To:       &l.dgfContractAddr,
+			GasLimit: 0,
+		}
+	} else {
+		data, err := l.ProposeL2OutputTxData(output)
+		if err != nil {
+			return err
+		}
+		candidate = txmgr.TxCandidate{
+			TxData:   data,
+			To:       &l.l2ooContractAddr,
+			GasLimit: 0,
+		}
 	}
-	receipt, err := l.txMgr.Send(ctx, txmgr.TxCandidate{
-		TxData:   data,
-		To:       &l.l2ooContractAddr,
-		GasLimit: 0,
-	})
+	receipt, err := l.txMgr.Send(ctx, candidate)
 	if err != nil {
 		return err
 	}
 	if receipt.Status == types.ReceiptStatusFailed {
 		l.log.Error("proposer tx successfully published but reverted", "tx_hash", receipt.TxHash)
 	} else {
+		if l.dgfContract != nil {
+			l.lastProposal = &proposedGame{l2BlockNumber: output.BlockRef.Number, timestamp: l.clock.Now()}
+		}
 		l.log.Info("proposer tx successfully published",
 			"tx_hash", receipt.TxHash,
 			"l1blocknum", output.Status.CurrentL1.Number,
@@ -398,11 +743,11 @@ func (l *L2OutputSubmitter) loop() {
 
 	ctx := l.ctx
 
-	ticker := time.NewTicker(l.pollInterval)
+	ticker := l.clock.NewTicker(l.pollInterval)
 	defer ticker.Stop()
 	for {
 		select {
-		case <-ticker.C:
+		case <-ticker.Ch():
 			output, shouldPropose, err := l.FetchNextOutputInfo(ctx)
 			if err != nil {
 				break
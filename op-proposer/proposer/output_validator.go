@@ -0,0 +1,93 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+// OutputSource provides the output root at an L2 block, independently of the rollup node that the
+// proposer fetches its outputs from, to validate the outputs before they are proposed.
+type OutputSource interface {
+	OutputRootAtBlock(ctx context.Context, blockNum uint64) (eth.Bytes32, error)
+	// String identifies the source in logs.
+	String() string
+}
+
+type RollupOutputClient interface {
+	OutputAtBlock(ctx context.Context, blockNum uint64) (*eth.OutputResponse, error)
+}
+
+// RollupOutputSource fetches the output root from a rollup node.
+type RollupOutputSource struct {
+	client RollupOutputClient
+	name   string
+}
+
+func NewRollupOutputSource(client RollupOutputClient, name string) *RollupOutputSource {
+	return &RollupOutputSource{client: client, name: name}
+}
+
+func (s *RollupOutputSource) OutputRootAtBlock(ctx context.Context, blockNum uint64) (eth.Bytes32, error) {
+	output, err := s.client.OutputAtBlock(ctx, blockNum)
+	if err != nil {
+		return eth.Bytes32{}, err
+	}
+	if output.BlockRef.Number != blockNum {
+		return eth.Bytes32{}, fmt.Errorf("output of block %d instead of %d", output.BlockRef.Number, blockNum)
+	}
+	return output.OutputRoot, nil
+}
+
+func (s *RollupOutputSource) String() string {
+	return "rollup:" + s.name
+}
+
+type L2EthOutputClient interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+type L2ProofClient interface {
+	GetProof(ctx context.Context, account common.Address, keys []string, blockNumber *big.Int) (*gethclient.AccountResult, error)
+}
+
+// L2EthOutputSource computes the output root from the block header and the storage root of the
+// L2ToL1MessagePasser of an L2 execution engine, without a rollup node.
+type L2EthOutputSource struct {
+	client L2EthOutputClient
+	proofs L2ProofClient
+	name   string
+}
+
+func NewL2EthOutputSource(client *ethclient.Client, name string) *L2EthOutputSource {
+	return &L2EthOutputSource{client: client, proofs: gethclient.New(client.Client()), name: name}
+}
+
+func (s *L2EthOutputSource) OutputRootAtBlock(ctx context.Context, blockNum uint64) (eth.Bytes32, error) {
+	num := new(big.Int).SetUint64(blockNum)
+	head, err := s.client.HeaderByNumber(ctx, num)
+	if err != nil {
+		return eth.Bytes32{}, fmt.Errorf("failed to get L2 block %d: %w", blockNum, err)
+	}
+	proof, err := s.proofs.GetProof(ctx, predeploys.L2ToL1MessagePasserAddr, nil, num)
+	if err != nil {
+		return eth.Bytes32{}, fmt.Errorf("failed to get message passer proof at block %d: %w", blockNum, err)
+	}
+	return eth.OutputRoot(&eth.OutputV0{
+		StateRoot:                eth.Bytes32(head.Root),
+		MessagePasserStorageRoot: eth.Bytes32(proof.StorageHash),
+		BlockHash:                head.Hash(),
+	}), nil
+}
+
+func (s *L2EthOutputSource) String() string {
+	return "l2:" + s.name
+}
@@ -0,0 +1,95 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-proposer/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	"github.com/ethereum-optimism/optimism/op-service/testutils"
+)
+
+type mockOutputSource struct {
+	root eth.Bytes32
+	err  error
+}
+
+func (m *mockOutputSource) OutputRootAtBlock(ctx context.Context, blockNum uint64) (eth.Bytes32, error) {
+	return m.root, m.err
+}
+
+func (m *mockOutputSource) String() string {
+	return "mock"
+}
+
+func TestValidateOutput(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	output := testutils.RandomOutputResponse(rng)
+
+	tests := []struct {
+		name    string
+		sources []OutputSource
+		err     string
+	}{
+		{name: "NoSources"},
+		{name: "Agree", sources: []OutputSource{&mockOutputSource{root: output.OutputRoot}, &mockOutputSource{root: output.OutputRoot}}},
+		{name: "Mismatch", sources: []OutputSource{&mockOutputSource{root: output.OutputRoot}, &mockOutputSource{root: eth.Bytes32{0x01}}}, err: "output root mismatch"},
+		{name: "SourceError", sources: []OutputSource{&mockOutputSource{err: errors.New("boom")}}, err: "boom"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			l := &L2OutputSubmitter{
+				log:            testlog.Logger(t, log.LvlCrit),
+				metr:           metrics.NoopMetrics,
+				networkTimeout: time.Second,
+				outputSources:  test.sources,
+			}
+			err := l.validateOutput(context.Background(), output)
+			if test.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, test.err)
+			}
+		})
+	}
+}
+
+type mockL2EthClient struct {
+	head  *types.Header
+	proof *gethclient.AccountResult
+}
+
+func (m *mockL2EthClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return m.head, nil
+}
+
+func (m *mockL2EthClient) GetProof(ctx context.Context, account common.Address, keys []string, blockNumber *big.Int) (*gethclient.AccountResult, error) {
+	return m.proof, nil
+}
+
+func TestL2EthOutputSource(t *testing.T) {
+	head := &types.Header{Number: big.NewInt(10), Root: common.Hash{0x01}}
+	client := &mockL2EthClient{head: head, proof: &gethclient.AccountResult{StorageHash: common.Hash{0x02}}}
+	source := &L2EthOutputSource{client: client, proofs: client, name: "0"}
+
+	root, err := source.OutputRootAtBlock(context.Background(), 10)
+	require.NoError(t, err)
+	expected := eth.OutputRoot(&eth.OutputV0{
+		StateRoot:                eth.Bytes32{0x01},
+		MessagePasserStorageRoot: eth.Bytes32{0x02},
+		BlockHash:                head.Hash(),
+	})
+	require.Equal(t, expected, root)
+}
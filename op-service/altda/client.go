@@ -0,0 +1,51 @@
+package altda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ErrNotFound is returned when the DA server does not have the data of a commitment.
+var ErrNotFound = errors.New("not found")
+
+// DAClient fetches the data of commitments from a DA server, which serves the data of the DA layer that the
+// commitments were made with at GET /get/<hex encoded commitment>.
+// DA servers for Celestia and EigenDA implement this API, so they are supported without changes to the node.
+type DAClient struct {
+	url    string
+	client *http.Client
+}
+
+func NewDAClient(url string, timeout time.Duration) *DAClient {
+	return &DAClient{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+// GetInput returns the data of the commitment. The data is not verified against the commitment, see Commitment.Verify.
+func (c *DAClient) GetInput(ctx context.Context, comm Commitment) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/get/%s", c.url, hexutil.Encode(comm)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request input: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get input: status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,87 @@
+package altda
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDAClient(t *testing.T) {
+	data := []byte("batch data")
+	comm := NewKeccak256Commitment(data)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/get/" + hexutil.Encode(comm):
+			_, _ = w.Write(data)
+		case "/get/0x01ff":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := NewDAClient(server.URL, 10*time.Second)
+
+	input, err := client.GetInput(context.Background(), comm)
+	require.NoError(t, err)
+	require.Equal(t, data, input)
+
+	_, err = client.GetInput(context.Background(), NewKeccak256Commitment([]byte("other")))
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = client.GetInput(context.Background(), NewGenericCommitment([]byte{0xff}))
+	require.ErrorContains(t, err, "status 500")
+}
+
+func TestCommitment(t *testing.T) {
+	data := []byte("batch data")
+
+	t.Run("Keccak256", func(t *testing.T) {
+		comm := NewKeccak256Commitment(data)
+		require.Equal(t, Keccak256CommitmentType, comm.Type())
+		require.NoError(t, comm.Verify(data))
+		require.ErrorIs(t, comm.Verify([]byte("other")), ErrCommitmentMismatch)
+
+		txData := comm.TxData()
+		require.Equal(t, byte(TxDataVersion1), txData[0])
+		decoded, err := DecodeCommitment(txData[1:])
+		require.NoError(t, err)
+		require.Equal(t, comm, decoded)
+	})
+
+	t.Run("Generic", func(t *testing.T) {
+		comm := NewGenericCommitment([]byte{0xaa, 0xbb})
+		require.Equal(t, GenericCommitmentType, comm.Type())
+		require.NoError(t, comm.Verify(data), "generic commitments are not verified")
+		decoded, err := DecodeCommitment(comm)
+		require.NoError(t, err)
+		require.Equal(t, comm, decoded)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, data := range [][]byte{
+			nil,
+			{byte(Keccak256CommitmentType), 0xaa},
+			{byte(GenericCommitmentType)},
+			{0x02, 0xaa},
+		} {
+			_, err := DecodeCommitment(data)
+			require.ErrorIs(t, err, ErrInvalidCommitment)
+		}
+	})
+
+	t.Run("ParseType", func(t *testing.T) {
+		for _, typ := range []CommitmentType{Keccak256CommitmentType, GenericCommitmentType} {
+			parsed, err := ParseCommitmentType(typ.String())
+			require.NoError(t, err)
+			require.Equal(t, typ, parsed)
+		}
+		_, err := ParseCommitmentType("sha256")
+		require.Error(t, err)
+	})
+}
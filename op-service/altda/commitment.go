@@ -0,0 +1,107 @@
+package altda
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// TxDataVersion1 is the version byte of batcher transaction data that holds a commitment to data on an alternative
+// data-availability layer, instead of the frames of the batch data.
+const TxDataVersion1 = 0x01
+
+// CommitmentType is the type byte that prefixes an encoded commitment.
+type CommitmentType byte
+
+const (
+	// Keccak256CommitmentType commitments are the keccak256 hash of the data, so the data can be verified against
+	// the commitment, regardless of the DA layer that serves it.
+	Keccak256CommitmentType CommitmentType = 0x00
+	// GenericCommitmentType commitments are opaque to the node, and can only be resolved by the DA layer that they
+	// were made with, e.g. a Celestia or EigenDA blob reference.
+	GenericCommitmentType CommitmentType = 0x01
+)
+
+var (
+	ErrInvalidCommitment  = errors.New("invalid commitment")
+	ErrCommitmentMismatch = errors.New("data does not match commitment")
+)
+
+// ParseCommitmentType parses the name of a commitment type, as used in the rollup config.
+func ParseCommitmentType(name string) (CommitmentType, error) {
+	switch name {
+	case "keccak256":
+		return Keccak256CommitmentType, nil
+	case "generic":
+		return GenericCommitmentType, nil
+	default:
+		return 0, fmt.Errorf("unknown commitment type: %q", name)
+	}
+}
+
+func (t CommitmentType) String() string {
+	switch t {
+	case Keccak256CommitmentType:
+		return "keccak256"
+	case GenericCommitmentType:
+		return "generic"
+	default:
+		return fmt.Sprintf("unknown(%d)", byte(t))
+	}
+}
+
+// Commitment is a commitment to data on an alternative DA layer, encoded with its type byte.
+type Commitment []byte
+
+// NewKeccak256Commitment returns the keccak256 commitment to data.
+func NewKeccak256Commitment(data []byte) Commitment {
+	return append(Commitment{byte(Keccak256CommitmentType)}, crypto.Keccak256(data)...)
+}
+
+// NewGenericCommitment returns a generic commitment with the DA layer specific encoding of the reference.
+func NewGenericCommitment(ref []byte) Commitment {
+	return append(Commitment{byte(GenericCommitmentType)}, ref...)
+}
+
+// DecodeCommitment decodes an encoded commitment, as it follows the version byte in batcher transactions.
+func DecodeCommitment(data []byte) (Commitment, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: empty", ErrInvalidCommitment)
+	}
+	switch CommitmentType(data[0]) {
+	case Keccak256CommitmentType:
+		if len(data) != 33 {
+			return nil, fmt.Errorf("%w: keccak256 commitment of %d bytes", ErrInvalidCommitment, len(data)-1)
+		}
+	case GenericCommitmentType:
+		if len(data) == 1 {
+			return nil, fmt.Errorf("%w: empty generic commitment", ErrInvalidCommitment)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown type %d", ErrInvalidCommitment, data[0])
+	}
+	return Commitment(bytes.Clone(data)), nil
+}
+
+func (c Commitment) Type() CommitmentType {
+	return CommitmentType(c[0])
+}
+
+// TxData returns the batcher transaction data that holds the commitment.
+func (c Commitment) TxData() []byte {
+	return append([]byte{TxDataVersion1}, c...)
+}
+
+// Verify checks that data matches a keccak256 commitment. Generic commitments cannot be verified by the node,
+// and the data that the DA layer serves for them is trusted.
+func (c Commitment) Verify(data []byte) error {
+	if c.Type() != Keccak256CommitmentType {
+		return nil
+	}
+	if !bytes.Equal(c[1:], crypto.Keccak256(data)) {
+		return ErrCommitmentMismatch
+	}
+	return nil
+}
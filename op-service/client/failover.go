@@ -0,0 +1,272 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// DefaultHeadPollInterval is the default interval of the head checks of the endpoints of a FailoverRPC.
+	DefaultHeadPollInterval = 12 * time.Second
+	// DefaultMaxHeadLag is the default number of blocks that an endpoint may be behind the most recent head
+	// of all endpoints, before it is considered to be lagging.
+	DefaultMaxHeadLag = 5
+
+	// healthDecay is the weight of the latest request in the moving averages of the endpoint health.
+	healthDecay = 0.2
+	// errorPenalty is the latency that an error rate of 1 adds to the score of an endpoint.
+	// Errors are penalized independent of the latency, as unreachable endpoints tend to fail fast.
+	errorPenalty = time.Second
+)
+
+// FailoverRPC is an RPC client that routes requests to the healthiest of multiple endpoints that serve the same chain.
+//
+// The health of an endpoint is scored by the moving averages of its error rate and latency,
+// and endpoints whose head is lagging behind the other endpoints are only used when no other endpoint is available.
+// Requests fail over to the next endpoint if an endpoint can not be reached or fails to serve a response.
+// An error response of the RPC server itself, e.g. for a reverted call, is returned without failing over.
+type FailoverRPC struct {
+	log              log.Logger
+	endpoints        []*failoverEndpoint
+	headPollInterval time.Duration
+	maxHeadLag       uint64
+
+	mu sync.Mutex
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+type failoverEndpoint struct {
+	name string
+	rpc  RPC
+
+	// guarded by the mutex of the FailoverRPC
+	sampled   bool
+	errorRate float64
+	latency   time.Duration
+	head      uint64
+}
+
+type FailoverOption func(f *FailoverRPC)
+
+// WithHeadPollInterval configures the interval of the head checks of the endpoints. Head checks are disabled if 0.
+func WithHeadPollInterval(interval time.Duration) FailoverOption {
+	return func(f *FailoverRPC) {
+		f.headPollInterval = interval
+	}
+}
+
+// WithMaxHeadLag configures the number of blocks that an endpoint may be behind the most recent head of all endpoints,
+// before requests are routed to other endpoints.
+func WithMaxHeadLag(blocks uint64) FailoverOption {
+	return func(f *FailoverRPC) {
+		f.maxHeadLag = blocks
+	}
+}
+
+// NewFailoverRPC creates a FailoverRPC over the given endpoints, in order of preference.
+// The names identify the endpoints in logs, and must be of the same length as the endpoints.
+func NewFailoverRPC(lgr log.Logger, names []string, endpoints []RPC, opts ...FailoverOption) (*FailoverRPC, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("no RPC endpoints")
+	}
+	if len(names) != len(endpoints) {
+		return nil, fmt.Errorf("got %d names for %d endpoints", len(names), len(endpoints))
+	}
+	f := &FailoverRPC{
+		log:              lgr,
+		headPollInterval: DefaultHeadPollInterval,
+		maxHeadLag:       DefaultMaxHeadLag,
+	}
+	for i, endpoint := range endpoints {
+		f.endpoints = append(f.endpoints, &failoverEndpoint{name: names[i], rpc: endpoint})
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	f.cancel = cancel
+	if f.headPollInterval > 0 && len(f.endpoints) > 1 {
+		f.wg.Add(1)
+		go f.pollHeads(ctx)
+	}
+	return f, nil
+}
+
+// DialFailoverRPC dials each of the addresses with NewRPC, and returns a FailoverRPC over all endpoints that could be dialed.
+// A single address is returned as a plain RPC client.
+func DialFailoverRPC(ctx context.Context, lgr log.Logger, addrs []string, failoverOpts []FailoverOption, opts ...RPCOption) (RPC, error) {
+	if len(addrs) == 1 {
+		return NewRPC(ctx, lgr, addrs[0], opts...)
+	}
+	var names []string
+	var endpoints []RPC
+	for _, addr := range addrs {
+		endpoint, err := NewRPC(ctx, lgr, addr, opts...)
+		if err != nil {
+			lgr.Warn("Failed to dial RPC endpoint, continuing without it", "addr", addr, "err", err)
+			continue
+		}
+		names = append(names, addr)
+		endpoints = append(endpoints, endpoint)
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("failed to dial any of %d RPC endpoints", len(addrs))
+	}
+	return NewFailoverRPC(lgr, names, endpoints, failoverOpts...)
+}
+
+func (f *FailoverRPC) Close() {
+	f.cancel()
+	f.wg.Wait()
+	for _, e := range f.endpoints {
+		e.rpc.Close()
+	}
+}
+
+func (f *FailoverRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	return f.do(ctx, method, func(e *failoverEndpoint) error {
+		return e.rpc.CallContext(ctx, result, method, args...)
+	})
+}
+
+func (f *FailoverRPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return f.do(ctx, "batch", func(e *failoverEndpoint) error {
+		// Clear the results of an earlier attempt of the batch.
+		for i := range b {
+			b[i].Error = nil
+		}
+		return e.rpc.BatchCallContext(ctx, b)
+	})
+}
+
+func (f *FailoverRPC) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	var sub ethereum.Subscription
+	err := f.do(ctx, "eth_subscribe", func(e *failoverEndpoint) error {
+		var err error
+		sub, err = e.rpc.EthSubscribe(ctx, channel, args...)
+		return err
+	})
+	return sub, err
+}
+
+// do tries the request on the endpoints in order of health, until an endpoint serves a response.
+func (f *FailoverRPC) do(ctx context.Context, method string, fn func(e *failoverEndpoint) error) error {
+	var result error
+	for _, e := range f.ranked() {
+		start := time.Now()
+		err := fn(e)
+		if ctx.Err() != nil {
+			return err
+		}
+		failed := err != nil && !isServerError(err)
+		f.record(e, time.Since(start), failed)
+		if !failed {
+			return err
+		}
+		f.log.Warn("RPC endpoint failed, failing over", "endpoint", e.name, "method", method, "err", err)
+		result = errors.Join(result, fmt.Errorf("endpoint %s: %w", e.name, err))
+	}
+	return result
+}
+
+// isServerError returns true if the error is an error response of the RPC server,
+// which other endpoints of the same chain are expected to respond with too.
+func isServerError(err error) bool {
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr)
+}
+
+// ranked returns the endpoints ordered by health: endpoints that are not lagging before lagging ones,
+// and the lowest score of latency and errors first. Equally healthy endpoints keep their order of preference.
+func (f *FailoverRPC) ranked() []*failoverEndpoint {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var bestHead uint64
+	for _, e := range f.endpoints {
+		if e.head > bestHead {
+			bestHead = e.head
+		}
+	}
+	lagging := func(e *failoverEndpoint) bool {
+		return e.head+f.maxHeadLag < bestHead
+	}
+	score := func(e *failoverEndpoint) float64 {
+		if !e.sampled {
+			// Endpoints that were not tried yet are used once the preferred endpoints fail.
+			return math.Inf(1)
+		}
+		return float64(e.latency) + e.errorRate*float64(errorPenalty)
+	}
+	ranked := make([]*failoverEndpoint, len(f.endpoints))
+	copy(ranked, f.endpoints)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if li, lj := lagging(ranked[i]), lagging(ranked[j]); li != lj {
+			return lj
+		}
+		return score(ranked[i]) < score(ranked[j])
+	})
+	return ranked
+}
+
+func (f *FailoverRPC) record(e *failoverEndpoint, latency time.Duration, failed bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	errValue := 0.0
+	if failed {
+		errValue = 1
+	}
+	if !e.sampled {
+		e.sampled = true
+		e.latency = latency
+	}
+	e.errorRate = e.errorRate*(1-healthDecay) + errValue*healthDecay
+	e.latency = time.Duration(float64(e.latency)*(1-healthDecay) + float64(latency)*healthDecay)
+}
+
+func (f *FailoverRPC) pollHeads(ctx context.Context) {
+	defer f.wg.Done()
+	ticker := time.NewTicker(f.headPollInterval)
+	defer ticker.Stop()
+	for {
+		f.updateHeads(ctx)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// updateHeads fetches the head of each endpoint. The head checks count towards the health of the endpoints.
+func (f *FailoverRPC) updateHeads(ctx context.Context) {
+	for _, e := range f.endpoints {
+		reqCtx, cancel := context.WithTimeout(ctx, f.headPollInterval)
+		var head hexutil.Uint64
+		start := time.Now()
+		err := e.rpc.CallContext(reqCtx, &head, "eth_blockNumber")
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		f.record(e, time.Since(start), err != nil)
+		if err != nil {
+			f.log.Warn("Failed to fetch head of RPC endpoint", "endpoint", e.name, "err", err)
+			continue
+		}
+		f.mu.Lock()
+		e.head = uint64(head)
+		f.mu.Unlock()
+	}
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type serverError struct{}
+
+func (serverError) Error() string  { return "execution reverted" }
+func (serverError) ErrorCode() int { return 3 }
+
+type mockEndpoint struct {
+	mu     sync.Mutex
+	head   uint64
+	err    error
+	calls  map[string]int
+	closed bool
+}
+
+func newMockEndpoint(head uint64) *mockEndpoint {
+	return &mockEndpoint{head: head, calls: make(map[string]int)}
+}
+
+func (m *mockEndpoint) Close() {
+	m.closed = true
+}
+
+func (m *mockEndpoint) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls[method]++
+	if m.err != nil {
+		return m.err
+	}
+	if method == "eth_blockNumber" {
+		*result.(*hexutil.Uint64) = hexutil.Uint64(m.head)
+	}
+	return nil
+}
+
+func (m *mockEndpoint) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls["batch"]++
+	return m.err
+}
+
+func (m *mockEndpoint) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	return nil, errors.New("not supported")
+}
+
+func (m *mockEndpoint) Calls(method string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls[method]
+}
+
+func (m *mockEndpoint) SetErr(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.err = err
+}
+
+func newTestFailoverRPC(t *testing.T, endpoints ...*mockEndpoint) *FailoverRPC {
+	names := make([]string, len(endpoints))
+	rpcs := make([]RPC, len(endpoints))
+	for i, e := range endpoints {
+		names[i] = string(rune('a' + i))
+		rpcs[i] = e
+	}
+	f, err := NewFailoverRPC(testlog.Logger(t, log.LvlCrit), names, rpcs, WithHeadPollInterval(0))
+	require.NoError(t, err)
+	t.Cleanup(f.Close)
+	return f
+}
+
+func TestFailoverRPC(t *testing.T) {
+	primary := newMockEndpoint(10)
+	fallback := newMockEndpoint(10)
+	f := newTestFailoverRPC(t, primary, fallback)
+	ctx := context.Background()
+
+	require.NoError(t, f.CallContext(ctx, nil, "eth_chainId"))
+	require.Equal(t, 1, primary.Calls("eth_chainId"))
+	require.Equal(t, 0, fallback.Calls("eth_chainId"))
+
+	// an error response of the server is returned without failing over
+	primary.SetErr(serverError{})
+	require.ErrorIs(t, f.CallContext(ctx, nil, "eth_call"), serverError{})
+	require.Equal(t, 0, fallback.Calls("eth_call"))
+
+	// a failing endpoint fails over, and is routed around until it is healthy again
+	primary.SetErr(errors.New("connection refused"))
+	require.NoError(t, f.CallContext(ctx, nil, "eth_chainId"))
+	require.NoError(t, f.BatchCallContext(ctx, []rpc.BatchElem{{Method: "eth_chainId"}}))
+	require.Equal(t, 2, primary.Calls("eth_chainId"))
+	require.Equal(t, 1, fallback.Calls("eth_chainId"))
+	require.Equal(t, 0, primary.Calls("batch"))
+	require.Equal(t, 1, fallback.Calls("batch"))
+
+	fallback.SetErr(errors.New("connection refused"))
+	err := f.CallContext(ctx, nil, "eth_chainId")
+	require.ErrorContains(t, err, "endpoint a")
+	require.ErrorContains(t, err, "endpoint b")
+
+	f.Close()
+	require.True(t, primary.closed)
+	require.True(t, fallback.closed)
+}
+
+func TestFailoverRPCLaggingHead(t *testing.T) {
+	primary := newMockEndpoint(10)
+	fallback := newMockEndpoint(10 + DefaultMaxHeadLag + 1)
+	f := newTestFailoverRPC(t, primary, fallback)
+	ctx := context.Background()
+
+	f.updateHeads(ctx)
+	require.NoError(t, f.CallContext(ctx, nil, "eth_chainId"))
+	require.Equal(t, 0, primary.Calls("eth_chainId"))
+	require.Equal(t, 1, fallback.Calls("eth_chainId"))
+
+	// a lagging endpoint is still used if no other endpoint is available
+	fallback.SetErr(errors.New("connection refused"))
+	require.NoError(t, f.CallContext(ctx, nil, "eth_chainId"))
+	require.Equal(t, 1, primary.Calls("eth_chainId"))
+
+	// the endpoint is used again once it caught up
+	fallback.SetErr(nil)
+	primary.head = fallback.head
+	for i := 0; i < 20; i++ {
+		f.updateHeads(ctx)
+	}
+	require.NoError(t, f.CallContext(ctx, nil, "eth_chainId"))
+	require.Equal(t, 2, primary.Calls("eth_chainId"))
+}
+
+func TestFailoverRPCPollsHeads(t *testing.T) {
+	primary := newMockEndpoint(10)
+	fallback := newMockEndpoint(10)
+	f, err := NewFailoverRPC(testlog.Logger(t, log.LvlCrit), []string{"a", "b"}, []RPC{primary, fallback},
+		WithHeadPollInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	defer f.Close()
+	require.Eventually(t, func() bool {
+		return primary.Calls("eth_blockNumber") > 1 && fallback.Calls("eth_blockNumber") > 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestNewFailoverRPCRequiresEndpoints(t *testing.T) {
+	_, err := NewFailoverRPC(testlog.Logger(t, log.LvlCrit), nil, nil)
+	require.Error(t, err)
+	_, err = NewFailoverRPC(testlog.Logger(t, log.LvlCrit), []string{"a"}, []RPC{newMockEndpoint(0), newMockEndpoint(0)})
+	require.Error(t, err)
+}
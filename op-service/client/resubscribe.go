@@ -0,0 +1,208 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+)
+
+// DefaultMaxBackfill is the default maximum number of missed headers that are replayed
+// when a newHeads subscription is resumed.
+const DefaultMaxBackfill = 64
+
+var headerType = reflect.TypeOf((*types.Header)(nil))
+
+// ResubscribingClient is an RPC client that keeps subscriptions alive when the connection drops.
+// Subscriptions are resubscribed with a backoff, instead of failing, until they are unsubscribed.
+// The underlying RPC is responsible for re-establishing the connection itself,
+// which geth RPC clients do for websocket and IPC connections on the next request.
+//
+// newHeads subscriptions with a *types.Header channel resume from the last seen block:
+// headers that were missed while disconnected are fetched and delivered before any new headers,
+// up to the maximum backfill.
+type ResubscribingClient struct {
+	RPC
+	log         log.Logger
+	backoff     retry.Strategy
+	maxBackfill uint64
+}
+
+type ResubscribeOption func(c *ResubscribingClient)
+
+// WithResubscribeBackoff configures the backoff strategy of resubscription attempts.
+func WithResubscribeBackoff(strategy retry.Strategy) ResubscribeOption {
+	return func(c *ResubscribingClient) {
+		c.backoff = strategy
+	}
+}
+
+// WithMaxBackfill configures the maximum number of missed headers that are replayed
+// when a newHeads subscription is resumed. If more headers were missed, the subscription skips ahead.
+func WithMaxBackfill(n uint64) ResubscribeOption {
+	return func(c *ResubscribingClient) {
+		c.maxBackfill = n
+	}
+}
+
+// NewResubscribingClient wraps the given RPC to resume subscriptions after connection drops.
+func NewResubscribingClient(lgr log.Logger, c RPC, opts ...ResubscribeOption) *ResubscribingClient {
+	res := &ResubscribingClient{
+		RPC:         c,
+		log:         lgr,
+		backoff:     retry.Exponential(),
+		maxBackfill: DefaultMaxBackfill,
+	}
+	for _, opt := range opts {
+		opt(res)
+	}
+	return res
+}
+
+// EthSubscribe creates a subscription that is resubscribed when it fails.
+// The initial subscription is established before returning, and its error is returned as-is.
+// The subscription error channel only returns an error if the subscription is not unsubscribed
+// when a resubscription is not possible anymore, e.g. because the underlying client was closed.
+func (c *ResubscribingClient) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	chVal := reflect.ValueOf(channel)
+	if chVal.Kind() != reflect.Chan || chVal.Type().ChanDir()&reflect.SendDir == 0 {
+		return nil, errors.New("channel argument is not a writable channel")
+	}
+	elemType := chVal.Type().Elem()
+	resumeHeads := len(args) > 0 && args[0] == "newHeads" && elemType == headerType
+
+	// subscribe to an internal channel, to observe the events before forwarding them
+	subscribe := func(ctx context.Context) (ethereum.Subscription, reflect.Value, error) {
+		inner := reflect.MakeChan(reflect.ChanOf(reflect.BothDir, elemType), 10)
+		sub, err := c.RPC.EthSubscribe(ctx, inner.Interface(), args...)
+		return sub, inner, err
+	}
+	sub, inner, err := subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		quitCtx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			<-quit
+			cancel()
+		}()
+
+		var lastSeen *big.Int
+		// send delivers the event to the subscriber. Returns false if the subscription was unsubscribed.
+		send := func(ev reflect.Value) bool {
+			chosen, _, _ := reflect.Select([]reflect.SelectCase{
+				{Dir: reflect.SelectSend, Chan: chVal, Send: ev},
+				{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(quit)},
+			})
+			if chosen != 0 {
+				return false
+			}
+			if resumeHeads {
+				if h := ev.Interface().(*types.Header); h != nil && h.Number != nil {
+					lastSeen = new(big.Int).Set(h.Number)
+				}
+			}
+			return true
+		}
+
+		for {
+			chosen, ev, _ := reflect.Select([]reflect.SelectCase{
+				{Dir: reflect.SelectRecv, Chan: inner},
+				{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(sub.Err())},
+				{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(quit)},
+			})
+			switch chosen {
+			case 0: // event
+				if !send(ev) {
+					sub.Unsubscribe()
+					return nil
+				}
+				continue
+			case 2: // unsubscribed
+				sub.Unsubscribe()
+				return nil
+			}
+
+			subErr, _ := ev.Interface().(error)
+			c.log.Warn("subscription failed, resubscribing", "args", args, "err", subErr)
+			sub.Unsubscribe()
+			sub, inner, err = c.resubscribe(quitCtx, subscribe)
+			if err != nil {
+				if quitCtx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			c.log.Info("resubscribed", "args", args)
+			if resumeHeads && lastSeen != nil {
+				if !c.backfill(quitCtx, lastSeen, send) {
+					sub.Unsubscribe()
+					return nil
+				}
+			}
+		}
+	}), nil
+}
+
+// resubscribe retries to subscribe with a backoff, until it succeeds or the ctx is done.
+func (c *ResubscribingClient) resubscribe(ctx context.Context,
+	subscribe func(ctx context.Context) (ethereum.Subscription, reflect.Value, error)) (ethereum.Subscription, reflect.Value, error) {
+	for attempt := 0; ; attempt++ {
+		select {
+		case <-ctx.Done():
+			return nil, reflect.Value{}, ctx.Err()
+		case <-time.After(c.backoff.Duration(attempt)):
+		}
+		sub, inner, err := subscribe(ctx)
+		if err == nil {
+			return sub, inner, nil
+		}
+		if errors.Is(err, ErrSubscriberClosed) {
+			return nil, reflect.Value{}, err
+		}
+		c.log.Debug("failed to resubscribe", "attempt", attempt, "err", err)
+	}
+}
+
+// backfill delivers the headers after lastSeen, up to but excluding the current head,
+// which is delivered by the new subscription.
+// Returns false if the subscription was unsubscribed.
+func (c *ResubscribingClient) backfill(ctx context.Context, lastSeen *big.Int, send func(ev reflect.Value) bool) bool {
+	var head *types.Header
+	if err := c.CallContext(ctx, &head, "eth_getBlockByNumber", "latest", false); err != nil || head == nil {
+		c.log.Warn("failed to fetch head to backfill missed headers", "err", err)
+		return true
+	}
+	from := new(big.Int).Add(lastSeen, big.NewInt(1))
+	missed := new(big.Int).Sub(head.Number, from)
+	if missed.Sign() <= 0 {
+		return true
+	}
+	if !missed.IsUint64() || missed.Uint64() > c.maxBackfill {
+		c.log.Warn("too many missed headers to backfill, skipping ahead", "last_seen", lastSeen, "head", head.Number)
+		return true
+	}
+	for n := from; n.Cmp(head.Number) < 0; n = new(big.Int).Add(n, big.NewInt(1)) {
+		var h *types.Header
+		if err := c.CallContext(ctx, &h, "eth_getBlockByNumber", hexutil.EncodeBig(n), false); err != nil || h == nil {
+			c.log.Warn("failed to backfill missed header", "number", n, "err", err)
+			return true
+		}
+		if !send(reflect.ValueOf(h)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,183 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/retry"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+// fakeSubscribeRPC serves newHeads subscriptions and headers by number.
+type fakeSubscribeRPC struct {
+	mu       sync.Mutex
+	headers  map[uint64]*types.Header
+	head     uint64
+	subs     []chan<- *types.Header
+	fails    []chan error
+	failSubs int // number of upcoming EthSubscribe calls that fail
+	calls    int
+}
+
+func newFakeSubscribeRPC() *fakeSubscribeRPC {
+	return &fakeSubscribeRPC{headers: make(map[uint64]*types.Header)}
+}
+
+func (f *fakeSubscribeRPC) Close() {}
+
+func (f *fakeSubscribeRPC) CallContext(ctx context.Context, result any, method string, args ...any) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if method != "eth_getBlockByNumber" {
+		return errors.New("unsupported method")
+	}
+	n := f.head
+	if args[0] != "latest" {
+		num, err := hexutil.DecodeUint64(args[0].(string))
+		if err != nil {
+			return err
+		}
+		n = num
+	}
+	*(result.(**types.Header)) = f.headers[n]
+	return nil
+}
+
+func (f *fakeSubscribeRPC) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return errors.New("unsupported")
+}
+
+func (f *fakeSubscribeRPC) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if f.failSubs > 0 {
+		f.failSubs--
+		return nil, errors.New("connection refused")
+	}
+	ch := channel.(chan *types.Header)
+	fail := make(chan error, 1)
+	f.subs = append(f.subs, ch)
+	f.fails = append(f.fails, fail)
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		select {
+		case err := <-fail:
+			return err
+		case <-quit:
+			return nil
+		}
+	}), nil
+}
+
+// mine adds a new header, and publishes it to the latest subscription if publish is true.
+func (f *fakeSubscribeRPC) mine(publish bool) {
+	f.mu.Lock()
+	f.head++
+	h := &types.Header{Number: new(big.Int).SetUint64(f.head)}
+	f.headers[f.head] = h
+	var sub chan<- *types.Header
+	if publish && len(f.subs) > 0 {
+		sub = f.subs[len(f.subs)-1]
+	}
+	f.mu.Unlock()
+	if sub != nil {
+		sub <- h
+	}
+}
+
+// drop fails the latest subscription, like a dropped connection.
+func (f *fakeSubscribeRPC) drop(failSubs int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failSubs = failSubs
+	f.fails[len(f.fails)-1] <- errors.New("connection lost")
+}
+
+func (f *fakeSubscribeRPC) subscribeCalls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func receiveNumbers(t *testing.T, ch <-chan *types.Header, count int) []uint64 {
+	var out []uint64
+	for i := 0; i < count; i++ {
+		select {
+		case h := <-ch:
+			out = append(out, h.Number.Uint64())
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for header %d of %d", i, count)
+		}
+	}
+	return out
+}
+
+func TestResubscribingClient(t *testing.T) {
+	fake := newFakeSubscribeRPC()
+	c := NewResubscribingClient(testlog.Logger(t, log.LvlInfo), fake,
+		WithResubscribeBackoff(retry.Fixed(time.Millisecond)))
+
+	heads := make(chan *types.Header)
+	sub, err := c.EthSubscribe(context.Background(), heads, "newHeads")
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	fake.mine(true)
+	fake.mine(true)
+	require.Equal(t, []uint64{1, 2}, receiveNumbers(t, heads, 2))
+
+	t.Run("resumes from last seen block", func(t *testing.T) {
+		// blocks 3 and 4 are missed while the connection is down, and then fail to resubscribe twice
+		fake.drop(2)
+		fake.mine(false)
+		fake.mine(false)
+		fake.mine(false)
+		require.Eventually(t, func() bool { return fake.subscribeCalls() == 4 }, 5*time.Second, time.Millisecond)
+		// the head, block 5, is delivered through the new subscription
+		require.Equal(t, []uint64{3, 4}, receiveNumbers(t, heads, 2))
+		fake.mine(true)
+		require.Equal(t, []uint64{6}, receiveNumbers(t, heads, 1))
+	})
+
+	t.Run("skips ahead when too many blocks were missed", func(t *testing.T) {
+		c.maxBackfill = 2
+		fake.drop(0)
+		for i := 0; i < 5; i++ {
+			fake.mine(false)
+		}
+		require.Eventually(t, func() bool { return fake.subscribeCalls() == 5 }, 5*time.Second, time.Millisecond)
+		fake.mine(true)
+		require.Equal(t, []uint64{12}, receiveNumbers(t, heads, 1))
+	})
+
+	sub.Unsubscribe()
+	select {
+	case err, ok := <-sub.Err():
+		require.False(t, ok, "no error expected after unsubscribing: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected subscription to close")
+	}
+}
+
+func TestResubscribingClientInitialError(t *testing.T) {
+	fake := newFakeSubscribeRPC()
+	fake.failSubs = 1
+	c := NewResubscribingClient(testlog.Logger(t, log.LvlInfo), fake)
+	_, err := c.EthSubscribe(context.Background(), make(chan *types.Header), "newHeads")
+	require.ErrorContains(t, err, "connection refused")
+
+	_, err = c.EthSubscribe(context.Background(), make(<-chan *types.Header), "newHeads")
+	require.ErrorContains(t, err, "not a writable channel")
+}
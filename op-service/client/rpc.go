@@ -2,8 +2,10 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
+	"net/http"
 	"net/url"
 	"regexp"
 	"time"
@@ -11,6 +13,7 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/retry"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/gorilla/websocket"
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/time/rate"
 
@@ -33,6 +36,7 @@ type rpcConfig struct {
 	backoffAttempts  int
 	limit            float64
 	burst            int
+	resubscribe      bool
 }
 
 type RPCOption func(cfg *rpcConfig) error
@@ -62,6 +66,42 @@ func WithGethRPCOptions(gethRPCOptions ...rpc.ClientOption) RPCOption {
 	}
 }
 
+// WithTLSConfig configures the HTTP and websocket transports of the RPC to use the given TLS config.
+// A client certificate can be presented to servers that authenticate clients with mutual TLS,
+// see optls.NewClientTLSConfig.
+func WithTLSConfig(config *tls.Config) RPCOption {
+	return func(cfg *rpcConfig) error {
+		if config == nil {
+			return fmt.Errorf("nil TLS config")
+		}
+		cfg.gethRPCOptions = append(cfg.gethRPCOptions, TLSClientOptions(config)...)
+		return nil
+	}
+}
+
+// TLSClientOptions returns the geth RPC client options to use the given TLS config
+// for the HTTP and websocket transports.
+func TLSClientOptions(config *tls.Config) []rpc.ClientOption {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = config
+	dialer := *websocket.DefaultDialer
+	dialer.TLSClientConfig = config
+	return []rpc.ClientOption{
+		rpc.WithHTTPClient(&http.Client{Transport: transport}),
+		rpc.WithWebsocketDialer(dialer),
+	}
+}
+
+// WithResubscribe configures the RPC to resubscribe subscriptions when the connection drops,
+// resuming newHeads subscriptions from the last seen block. See NewResubscribingClient for more details.
+// This has no effect on HTTP RPCs, which poll for new heads instead.
+func WithResubscribe() RPCOption {
+	return func(cfg *rpcConfig) error {
+		cfg.resubscribe = true
+		return nil
+	}
+}
+
 // WithRateLimit configures the RPC to target the given rate limit (in requests / second).
 // See NewRateLimitingClient for more details.
 func WithRateLimit(rateLimit float64, burst int) RPCOption {
@@ -90,12 +130,16 @@ func NewRPC(ctx context.Context, lgr log.Logger, addr string, opts ...RPCOption)
 		return nil, err
 	}
 
-	var wrapped RPC = &BaseRPCClient{c: underlying}
+	var wrapped RPC = NewTracedRPCClient(&BaseRPCClient{c: underlying})
 
 	if cfg.limit != 0 {
 		wrapped = NewRateLimitingClient(wrapped, rate.Limit(cfg.limit), cfg.burst)
 	}
 
+	if cfg.resubscribe && !httpRegex.MatchString(addr) {
+		wrapped = NewResubscribingClient(lgr, wrapped)
+	}
+
 	return NewRPCWithClient(ctx, lgr, addr, wrapped, cfg.httpPollInterval)
 }
 
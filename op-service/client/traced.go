@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ethereum-optimism/optimism/op-service/tracing"
+)
+
+const tracerName = "github.com/ethereum-optimism/optimism/op-service/client"
+
+// TracedRPCClient is a wrapper around a pure RPC that records an OpenTelemetry span for each request.
+// The spans are not recorded unless tracing is enabled.
+type TracedRPCClient struct {
+	c      RPC
+	tracer trace.Tracer
+}
+
+func NewTracedRPCClient(c RPC) *TracedRPCClient {
+	return &TracedRPCClient{c: c, tracer: tracing.Tracer(tracerName)}
+}
+
+func (t *TracedRPCClient) Close() {
+	t.c.Close()
+}
+
+func (t *TracedRPCClient) CallContext(ctx context.Context, result any, method string, args ...any) (err error) {
+	ctx, span := t.tracer.Start(ctx, method, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("rpc.system", "jsonrpc"), attribute.String("rpc.method", method)))
+	defer func() { tracing.EndSpan(span, err) }()
+	return t.c.CallContext(ctx, result, method, args...)
+}
+
+func (t *TracedRPCClient) BatchCallContext(ctx context.Context, batch []rpc.BatchElem) (err error) {
+	methods := make([]string, len(batch))
+	for i, elem := range batch {
+		methods[i] = elem.Method
+	}
+	ctx, span := t.tracer.Start(ctx, "batch", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("rpc.system", "jsonrpc"), attribute.StringSlice("rpc.methods", methods)))
+	defer func() {
+		if err == nil {
+			for _, elem := range batch {
+				if elem.Error != nil {
+					span.RecordError(elem.Error, trace.WithAttributes(attribute.String("rpc.method", elem.Method)))
+				}
+			}
+		}
+		tracing.EndSpan(span, err)
+	}()
+	return t.c.BatchCallContext(ctx, batch)
+}
+
+func (t *TracedRPCClient) EthSubscribe(ctx context.Context, channel any, args ...any) (ethereum.Subscription, error) {
+	return t.c.EthSubscribe(ctx, channel, args...)
+}
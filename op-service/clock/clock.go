@@ -69,6 +69,12 @@ type Timer interface {
 	// If the caller needs to know whether f is completed, it must coordinate
 	// with f explicitly.
 	Stop() bool
+
+	// Reset changes the timer to expire after duration d.
+	// It returns true if the timer had been active, false if the timer had
+	// expired or been stopped.
+	// Equivalent to time.Timer.Reset
+	Reset(d time.Duration) bool
 }
 
 // SystemClock provides an instance of Clock that uses the system clock via methods in the time package.
@@ -2,6 +2,7 @@ package clock
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 )
@@ -10,7 +11,11 @@ type action interface {
 	// Return true if the action is due to fire
 	isDue(time.Time) bool
 
-	// fire triggers the action. Returns true if the action needs to fire again in the future
+	// dueTime returns the time the action is next due to fire
+	dueTime() time.Time
+
+	// fire triggers the action. Returns true if the action needs to fire again in the future.
+	// fire is called without holding the clock lock, so actions may call back into the clock.
 	fire(time.Time) bool
 }
 
@@ -23,6 +28,10 @@ func (t task) isDue(now time.Time) bool {
 	return !t.due.After(now)
 }
 
+func (t task) dueTime() time.Time {
+	return t.due
+}
+
 func (t task) fire(now time.Time) bool {
 	t.ch <- now
 	close(t.ch)
@@ -30,7 +39,8 @@ func (t task) fire(now time.Time) bool {
 }
 
 type timer struct {
-	f       func()
+	c       *DeterministicClock
+	f       func(now time.Time)
 	ch      chan time.Time
 	due     time.Time
 	stopped bool
@@ -44,13 +54,22 @@ func (t *timer) isDue(now time.Time) bool {
 	return !t.due.After(now)
 }
 
-func (t *timer) fire(now time.Time) bool {
+func (t *timer) dueTime() time.Time {
 	t.Lock()
 	defer t.Unlock()
-	if !t.stopped {
-		t.f()
-		t.run = true
+	return t.due
+}
+
+func (t *timer) fire(now time.Time) bool {
+	t.Lock()
+	if t.stopped || t.run {
+		t.Unlock()
+		return false
 	}
+	t.run = true
+	t.Unlock()
+	// Run the function without holding the lock, so it may stop or reset the timer.
+	t.f(now)
 	return false
 }
 
@@ -66,8 +85,20 @@ func (t *timer) Stop() bool {
 	return r
 }
 
+func (t *timer) Reset(d time.Duration) bool {
+	now := t.c.Now()
+	t.Lock()
+	active := !t.stopped && !t.run
+	t.stopped = false
+	t.run = false
+	t.due = now.Add(d)
+	t.Unlock()
+	t.c.schedule(t)
+	return active
+}
+
 type ticker struct {
-	c       Clock
+	c       *DeterministicClock
 	ch      chan time.Time
 	nextDue time.Time
 	period  time.Duration
@@ -89,10 +120,14 @@ func (t *ticker) Reset(d time.Duration) {
 	if d <= 0 {
 		panic("Continuously firing tickers are a really bad idea")
 	}
+	now := t.c.Now()
 	t.Lock()
-	defer t.Unlock()
 	t.period = d
-	t.nextDue = t.c.Now().Add(d)
+	t.nextDue = now.Add(d)
+	t.stopped = false
+	t.Unlock()
+	// A stopped ticker may have been removed from the pending actions already.
+	t.c.schedule(t)
 }
 
 func (t *ticker) isDue(now time.Time) bool {
@@ -101,6 +136,12 @@ func (t *ticker) isDue(now time.Time) bool {
 	return !t.nextDue.After(now)
 }
 
+func (t *ticker) dueTime() time.Time {
+	t.Lock()
+	defer t.Unlock()
+	return t.nextDue
+}
+
 func (t *ticker) fire(now time.Time) bool {
 	t.Lock()
 	defer t.Unlock()
@@ -125,6 +166,7 @@ type DeterministicClock struct {
 
 // NewDeterministicClock creates a new clock where time only advances when the DeterministicClock.AdvanceTime method is called.
 // This is intended for use in situations where a deterministic clock is required, such as testing or event driven systems.
+// Timers, tickers and AfterFunc callbacks fire synchronously, in order of their due time, within the call that advances time.
 func NewDeterministicClock(now time.Time) *DeterministicClock {
 	return &DeterministicClock{
 		now:          now,
@@ -153,13 +195,15 @@ func (s *DeterministicClock) After(d time.Duration) <-chan time.Time {
 
 func (s *DeterministicClock) AfterFunc(d time.Duration, f func()) Timer {
 	s.lock.Lock()
-	defer s.lock.Unlock()
-	timer := &timer{f: f, due: s.now.Add(d)}
-	if d.Nanoseconds() == 0 {
-		timer.fire(s.now)
-	} else {
+	now := s.now
+	timer := &timer{c: s, f: func(time.Time) { f() }, due: now.Add(d)}
+	if d.Nanoseconds() != 0 {
 		s.addPending(timer)
 	}
+	s.lock.Unlock()
+	if d.Nanoseconds() == 0 {
+		timer.fire(now)
+	}
 	return timer
 }
 
@@ -185,8 +229,13 @@ func (s *DeterministicClock) NewTimer(d time.Duration) Timer {
 	defer s.lock.Unlock()
 	ch := make(chan time.Time, 1)
 	t := &timer{
-		f: func() {
-			ch <- s.now
+		c: s,
+		f: func(now time.Time) {
+			// Publish without blocking, like a stdlib timer that was reset without draining its channel
+			select {
+			case ch <- now:
+			default:
+			}
 		},
 		ch:  ch,
 		due: s.now.Add(d),
@@ -208,6 +257,15 @@ func (s *DeterministicClock) addPending(t action) {
 	}
 }
 
+// schedule adds the action to the pending actions, if it is not pending already.
+func (s *DeterministicClock) schedule(a action) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.isPending(a) {
+		s.addPending(a)
+	}
+}
+
 func (s *DeterministicClock) WaitForNewPendingTaskWithTimeout(timeout time.Duration) bool {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
@@ -225,18 +283,112 @@ func (s *DeterministicClock) WaitForNewPendingTask(ctx context.Context) bool {
 	}
 }
 
+// PendingCount returns the number of scheduled timers, tickers and After channels that have not yet fired.
+// Stopped timers and tickers may be included until the next time the clock is advanced.
+func (s *DeterministicClock) PendingCount() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.pending)
+}
+
 // AdvanceTime moves the time forward by the specific duration
 func (s *DeterministicClock) AdvanceTime(d time.Duration) {
 	s.lock.Lock()
-	defer s.lock.Unlock()
 	s.now = s.now.Add(d)
-	var remaining []action
-	for _, a := range s.pending {
-		if !a.isDue(s.now) || a.fire(s.now) {
-			remaining = append(remaining, a)
+	now := s.now
+	s.lock.Unlock()
+	s.fireDue(now)
+}
+
+// AdvanceTo moves the time forward to the given time. The time is not changed if t is before the current time.
+func (s *DeterministicClock) AdvanceTo(t time.Time) {
+	s.lock.Lock()
+	if t.After(s.now) {
+		s.now = t
+	}
+	now := s.now
+	s.lock.Unlock()
+	s.fireDue(now)
+}
+
+// AdvanceToNext moves the time forward to the earliest due time of the pending actions, and fires the due actions.
+// Returns false, without changing the time, if there are no pending actions.
+func (s *DeterministicClock) AdvanceToNext() bool {
+	s.lock.Lock()
+	if len(s.pending) == 0 {
+		s.lock.Unlock()
+		return false
+	}
+	next := s.pending[0].dueTime()
+	for _, a := range s.pending[1:] {
+		if due := a.dueTime(); due.Before(next) {
+			next = due
 		}
 	}
-	s.pending = remaining
+	s.lock.Unlock()
+	s.AdvanceTo(next)
+	return true
+}
+
+// fireDue fires all pending actions that are due at the given time, in order of their due time.
+// Actions are fired without holding the clock lock, so callbacks may use the clock, e.g. to schedule new timers.
+// Callbacks may also stop or reset other due actions: each action is checked again right before it fires,
+// and actions that were rescheduled by a callback fire in a later pass if they are still due.
+// Each action fires at most once per call.
+func (s *DeterministicClock) fireDue(now time.Time) {
+	fired := make(map[action]struct{})
+	for {
+		s.lock.Lock()
+		var due, remaining []action
+		for _, a := range s.pending {
+			if _, ok := fired[a]; !ok && a.isDue(now) {
+				due = append(due, a)
+			} else {
+				remaining = append(remaining, a)
+			}
+		}
+		s.pending = remaining
+		s.lock.Unlock()
+		if len(due) == 0 {
+			return
+		}
+
+		sort.SliceStable(due, func(i, j int) bool {
+			return due[i].dueTime().Before(due[j].dueTime())
+		})
+		var repeating []action
+		for _, a := range due {
+			s.lock.Lock()
+			rescheduled := s.isPending(a)
+			s.lock.Unlock()
+			// A reset by an earlier callback schedules the action again, with its new due time.
+			if rescheduled || !a.isDue(now) {
+				continue
+			}
+			fired[a] = struct{}{}
+			if a.fire(now) {
+				repeating = append(repeating, a)
+			}
+		}
+		s.lock.Lock()
+		// Repeating actions remain pending without being flagged as new pending tasks.
+		for _, a := range repeating {
+			if !s.isPending(a) {
+				s.pending = append(s.pending, a)
+			}
+		}
+		s.lock.Unlock()
+	}
+}
+
+// isPending returns true if the action is pending. The clock lock must be held.
+func (s *DeterministicClock) isPending(a action) bool {
+	for _, p := range s.pending {
+		if p == a {
+			return true
+		}
+	}
+	return false
 }
 
 var _ Clock = (*DeterministicClock)(nil)
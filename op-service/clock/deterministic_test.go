@@ -350,3 +350,157 @@ func TestSleepCtx(t *testing.T) {
 		require.Nil(t, result.Load())
 	})
 }
+
+func TestTimerReset(t *testing.T) {
+	t.Run("ResetActiveTimer", func(t *testing.T) {
+		clock := NewDeterministicClock(time.UnixMilli(1000))
+		timer := clock.NewTimer(5 * time.Second)
+		require.True(t, timer.Reset(10*time.Second), "timer was active")
+
+		clock.AdvanceTime(5 * time.Second)
+		require.Len(t, timer.Ch(), 0, "should not fire at original due time")
+
+		clock.AdvanceTime(5 * time.Second)
+		require.Len(t, timer.Ch(), 1, "should fire at new due time")
+		require.Equal(t, clock.Now(), <-timer.Ch())
+		require.Equal(t, 0, clock.PendingCount())
+	})
+
+	t.Run("ResetExpiredTimer", func(t *testing.T) {
+		clock := NewDeterministicClock(time.UnixMilli(1000))
+		timer := clock.NewTimer(5 * time.Second)
+		clock.AdvanceTime(5 * time.Second)
+		<-timer.Ch()
+
+		require.False(t, timer.Reset(3*time.Second), "timer already expired")
+		clock.AdvanceTime(3 * time.Second)
+		require.Len(t, timer.Ch(), 1, "should fire again after reset")
+	})
+
+	t.Run("ResetStoppedAfterFunc", func(t *testing.T) {
+		clock := NewDeterministicClock(time.UnixMilli(1000))
+		var runs atomic.Int32
+		timer := clock.AfterFunc(5*time.Second, func() { runs.Add(1) })
+		require.True(t, timer.Stop())
+		require.False(t, timer.Reset(1*time.Second), "timer was stopped")
+		clock.AdvanceTime(10 * time.Second)
+		require.Equal(t, int32(1), runs.Load())
+	})
+}
+
+func TestTickerResetAfterStop(t *testing.T) {
+	clock := NewDeterministicClock(time.UnixMilli(1000))
+	ticker := clock.NewTicker(5 * time.Second)
+	ticker.Stop()
+	clock.AdvanceTime(5 * time.Second)
+	require.Len(t, ticker.Ch(), 0, "should not fire after stop")
+
+	ticker.Reset(2 * time.Second)
+	clock.AdvanceTime(2 * time.Second)
+	require.Len(t, ticker.Ch(), 1, "should fire again after reset")
+}
+
+func TestAfterFuncCallbackMayUseClock(t *testing.T) {
+	clock := NewDeterministicClock(time.UnixMilli(1000))
+	var fired []time.Time
+	clock.AfterFunc(time.Second, func() {
+		fired = append(fired, clock.Now())
+		// schedule a follow-up action from within the callback
+		clock.AfterFunc(time.Second, func() {
+			fired = append(fired, clock.Now())
+		})
+	})
+	clock.AdvanceTime(time.Second)
+	require.Len(t, fired, 1)
+	clock.AdvanceTime(time.Second)
+	require.Equal(t, []time.Time{time.UnixMilli(2000), time.UnixMilli(3000)}, fired)
+}
+
+func TestFireInDueOrder(t *testing.T) {
+	clock := NewDeterministicClock(time.UnixMilli(1000))
+	var order []int
+	clock.AfterFunc(3*time.Second, func() { order = append(order, 3) })
+	clock.AfterFunc(1*time.Second, func() { order = append(order, 1) })
+	clock.AfterFunc(2*time.Second, func() { order = append(order, 2) })
+	clock.AdvanceTime(10 * time.Second)
+	require.Equal(t, []int{1, 2, 3}, order)
+}
+
+func TestAdvanceTo(t *testing.T) {
+	clock := NewDeterministicClock(time.UnixMilli(1000))
+	ch := clock.After(5 * time.Second)
+	clock.AdvanceTo(time.UnixMilli(500))
+	require.Equal(t, time.UnixMilli(1000), clock.Now(), "should not move backwards")
+
+	clock.AdvanceTo(time.UnixMilli(6000))
+	require.Equal(t, time.UnixMilli(6000), clock.Now())
+	require.Len(t, ch, 1)
+}
+
+func TestAdvanceToNext(t *testing.T) {
+	clock := NewDeterministicClock(time.UnixMilli(1000))
+	require.False(t, clock.AdvanceToNext(), "nothing pending")
+
+	late := clock.NewTimer(10 * time.Second)
+	early := clock.NewTimer(3 * time.Second)
+	require.True(t, clock.AdvanceToNext())
+	require.Equal(t, time.UnixMilli(4000), clock.Now())
+	require.Len(t, early.Ch(), 1)
+	require.Len(t, late.Ch(), 0)
+
+	require.True(t, clock.AdvanceToNext())
+	require.Equal(t, time.UnixMilli(11000), clock.Now())
+	require.Len(t, late.Ch(), 1)
+	require.Equal(t, 0, clock.PendingCount())
+}
+
+func TestResetFromCallback(t *testing.T) {
+	t.Run("ResetDueTimer", func(t *testing.T) {
+		clock := NewDeterministicClock(time.UnixMilli(1000))
+		var fired []time.Time
+		var second Timer
+		clock.AfterFunc(1*time.Second, func() {
+			require.True(t, second.Reset(10*time.Second), "second timer is still active")
+		})
+		second = clock.AfterFunc(2*time.Second, func() { fired = append(fired, clock.Now()) })
+		clock.AdvanceTime(5 * time.Second)
+		require.Empty(t, fired, "should not fire before its new due time")
+		clock.AdvanceTime(10 * time.Second)
+		require.Equal(t, []time.Time{time.UnixMilli(16000)}, fired)
+		clock.AdvanceTime(10 * time.Second)
+		require.Len(t, fired, 1, "should fire once")
+	})
+
+	t.Run("ResetToDueTime", func(t *testing.T) {
+		clock := NewDeterministicClock(time.UnixMilli(1000))
+		fired := 0
+		var second Timer
+		clock.AfterFunc(1*time.Second, func() { second.Reset(time.Millisecond) })
+		second = clock.AfterFunc(10*time.Second, func() { fired++ })
+		clock.AdvanceTime(time.Second)
+		require.Zero(t, fired)
+		clock.AdvanceTime(time.Millisecond)
+		require.Equal(t, 1, fired)
+	})
+
+	t.Run("StopDueTimer", func(t *testing.T) {
+		clock := NewDeterministicClock(time.UnixMilli(1000))
+		fired := false
+		var second Timer
+		clock.AfterFunc(1*time.Second, func() { require.True(t, second.Stop()) })
+		second = clock.AfterFunc(2*time.Second, func() { fired = true })
+		clock.AdvanceTime(5 * time.Second)
+		require.False(t, fired)
+	})
+
+	t.Run("ResetDueTicker", func(t *testing.T) {
+		clock := NewDeterministicClock(time.UnixMilli(1000))
+		ticker := clock.NewTicker(2 * time.Second)
+		clock.AfterFunc(1*time.Second, func() { ticker.Reset(10 * time.Second) })
+		clock.AdvanceTime(5 * time.Second)
+		require.Len(t, ticker.Ch(), 0, "should not tick before its new due time")
+		require.Equal(t, 1, clock.PendingCount(), "ticker should be pending once")
+		clock.AdvanceTime(10 * time.Second)
+		require.Len(t, ticker.Ch(), 1)
+	})
+}
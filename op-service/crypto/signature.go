@@ -42,13 +42,31 @@ type SignerFn func(context.Context, common.Address, *types.Transaction) (*types.
 // SignerFactory creates a SignerFn that is bound to a specific ChainID
 type SignerFactory func(chainID *big.Int) SignerFn
 
-// SignerFactoryFromConfig considers three ways that signers are created & then creates single factory from those config options.
-// It can either take a remote signer (via opsigner.CLIConfig) or it can be provided either a mnemonic + derivation path or a private key.
-// It prefers the remote signer, then the mnemonic or private key (only one of which can be provided).
+// SignerFactoryFromConfig considers four ways that signers are created & then creates single factory from those config options.
+// It can either take a cloud KMS key or a remote signer (via opsigner.CLIConfig) or it can be provided either a mnemonic + derivation path or a private key.
+// It prefers the KMS key, then the remote signer, then the mnemonic or private key (only one of which can be provided).
+// The signing policy of the signer config is enforced on all created signers.
 func SignerFactoryFromConfig(l log.Logger, privateKey, mnemonic, hdPath string, signerConfig opsigner.CLIConfig) (SignerFactory, common.Address, error) {
+	policy, err := signerConfig.Policy.Policy()
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("invalid signing policy: %w", err)
+	}
+
 	var signer SignerFactory
 	var fromAddress common.Address
-	if signerConfig.Enabled() {
+	if signerConfig.KMS.Enabled() {
+		kmsSigner, err := opsigner.NewKMSSignerFromConfig(context.Background(), l, signerConfig)
+		if err != nil {
+			l.Error("Unable to create KMS signer", "error", err)
+			return nil, common.Address{}, fmt.Errorf("failed to create the KMS signer: %w", err)
+		}
+		fromAddress = kmsSigner.Address()
+		signer = func(chainID *big.Int) SignerFn {
+			return func(ctx context.Context, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				return kmsSigner.SignTransaction(ctx, chainID, address, tx)
+			}
+		}
+	} else if signerConfig.Enabled() {
 		signerClient, err := opsigner.NewSignerClientFromConfig(l, signerConfig)
 		if err != nil {
 			l.Error("Unable to create Signer Client", "error", err)
@@ -100,5 +118,32 @@ func SignerFactoryFromConfig(l log.Logger, privateKey, mnemonic, hdPath string,
 		}
 	}
 
+	if policy.Enabled() {
+		l.Info("Enforcing signing policy", "allowed_to", policy.AllowedTo, "max_value", policy.MaxValue, "max_fee", policy.MaxFee)
+	}
+	signer = PolicySignerFactory(l, policy, signer)
+
 	return signer, fromAddress, nil
 }
+
+// PolicySignerFactory wraps the signers created by the given factory to enforce the signing policy.
+// The chain ID of every transaction is checked against the chain ID the signer is created for,
+// even if no other constraints are set.
+func PolicySignerFactory(l log.Logger, policy *opsigner.Policy, factory SignerFactory) SignerFactory {
+	return func(chainID *big.Int) SignerFn {
+		return PolicySignerFn(l, policy, chainID, factory(chainID))
+	}
+}
+
+// PolicySignerFn wraps the signer to refuse any transaction that violates the signing policy.
+// Refused requests are logged for auditing.
+func PolicySignerFn(l log.Logger, policy *opsigner.Policy, chainID *big.Int, signer SignerFn) SignerFn {
+	return func(ctx context.Context, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if err := policy.Check(chainID, tx); err != nil {
+			l.Warn("Refused to sign transaction", "from", address, "to", tx.To(), "nonce", tx.Nonce(),
+				"value", tx.Value(), "gas", tx.Gas(), "gas_fee_cap", tx.GasFeeCap(), "chain_id", tx.ChainId(), "err", err)
+			return nil, err
+		}
+		return signer(ctx, address, tx)
+	}
+}
@@ -3,11 +3,13 @@ package dial
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/retry"
 	"github.com/ethereum-optimism/optimism/op-service/sources"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -21,11 +23,11 @@ const defaultRetryTime = 2 * time.Second
 // DialEthClientWithTimeout attempts to dial the L1 provider using the provided
 // URL. If the dial doesn't complete within defaultDialTimeout seconds, this
 // method will return an error.
-func DialEthClientWithTimeout(ctx context.Context, timeout time.Duration, log log.Logger, url string) (*ethclient.Client, error) {
+func DialEthClientWithTimeout(ctx context.Context, timeout time.Duration, log log.Logger, url string, opts ...rpc.ClientOption) (*ethclient.Client, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	c, err := dialRPCClientWithBackoff(ctx, log, url)
+	c, err := dialRPCClientWithBackoff(ctx, log, url, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -35,30 +37,62 @@ func DialEthClientWithTimeout(ctx context.Context, timeout time.Duration, log lo
 
 // DialRollupClientWithTimeout attempts to dial the RPC provider using the provided URL.
 // If the dial doesn't complete within timeout seconds, this method will return an error.
-func DialRollupClientWithTimeout(ctx context.Context, timeout time.Duration, log log.Logger, url string) (*sources.RollupClient, error) {
+// The URL may be a comma-separated list of rollup nodes, which are failed over between with a client.FailoverRPC.
+// The options apply to all rollup nodes, e.g. to authenticate with mutual TLS, see TLSOptions.
+func DialRollupClientWithTimeout(ctx context.Context, timeout time.Duration, log log.Logger, url string, opts ...rpc.ClientOption) (*sources.RollupClient, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	rpcCl, err := dialRPCClientWithBackoff(ctx, log, url)
+	urls := strings.Split(url, ",")
+	if len(urls) == 1 {
+		rpcCl, err := dialRPCClientWithBackoff(ctx, log, url, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return sources.NewRollupClient(client.NewBaseRPCClient(rpcCl)), nil
+	}
+
+	endpoints := make([]client.RPC, 0, len(urls))
+	for _, u := range urls {
+		rpcCl, err := dialRPCClientWithBackoff(ctx, log, u, opts...)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, client.NewBaseRPCClient(rpcCl))
+	}
+	// Rollup nodes do not serve eth_blockNumber, so the endpoints are ranked by their health only.
+	failover, err := client.NewFailoverRPC(log, urls, endpoints, client.WithHeadPollInterval(0))
 	if err != nil {
 		return nil, err
 	}
-
-	return sources.NewRollupClient(client.NewBaseRPCClient(rpcCl)), nil
+	return sources.NewRollupClient(failover), nil
 }
 
 // Dials a JSON-RPC endpoint repeatedly, with a backoff, until a client connection is established. Auth is optional.
-func dialRPCClientWithBackoff(ctx context.Context, log log.Logger, addr string) (*rpc.Client, error) {
+func dialRPCClientWithBackoff(ctx context.Context, log log.Logger, addr string, opts ...rpc.ClientOption) (*rpc.Client, error) {
 	bOff := retry.Fixed(defaultRetryTime)
 	return retry.Do(ctx, defaultRetryCount, bOff, func() (*rpc.Client, error) {
 		if !client.IsURLAvailable(addr) {
 			log.Warn("failed to dial address, but may connect later", "addr", addr)
 			return nil, fmt.Errorf("address unavailable (%s)", addr)
 		}
-		client, err := rpc.DialOptions(ctx, addr)
+		client, err := rpc.DialOptions(ctx, addr, opts...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to dial address (%s): %w", addr, err)
 		}
 		return client, nil
 	})
 }
+
+// TLSOptions returns the client options to dial a server with mutual TLS, if TLS is configured.
+// No options are returned if TLS is not configured.
+func TLSOptions(log log.Logger, cfg optls.CLIConfig) ([]rpc.ClientOption, error) {
+	if !cfg.TLSEnabled() {
+		return nil, nil
+	}
+	tlsConfig, err := optls.NewClientTLSConfig(log, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client tls config: %w", err)
+	}
+	return client.TLSClientOptions(tlsConfig), nil
+}
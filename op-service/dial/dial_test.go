@@ -0,0 +1,51 @@
+package dial
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	oprpc "github.com/ethereum-optimism/optimism/op-service/rpc"
+	"github.com/ethereum-optimism/optimism/op-service/testutils/tlstest"
+)
+
+func TestDialMutualTLS(t *testing.T) {
+	// certman keeps watching the certificates after the test completes, so do not log to the test
+	logger := log.New()
+	logger.SetHandler(log.DiscardHandler())
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t, dir)
+
+	rpcCfg := oprpc.CLIConfig{ListenAddr: "127.0.0.1", ListenPort: 10000 + rand.Intn(22768), TLS: ca.Issue(t, dir, "server", 2)}
+	require.NoError(t, rpcCfg.Check())
+	serverTLS, err := rpcCfg.ServerTLSConfig(logger)
+	require.NoError(t, err)
+	server := oprpc.NewServer(rpcCfg.ListenAddr, rpcCfg.ListenPort, "test", oprpc.WithLogger(logger), oprpc.WithTLSConfig(serverTLS))
+	require.NoError(t, server.Start())
+	defer func() {
+		require.NoError(t, server.Stop())
+	}()
+	url := "https://" + server.Endpoint()
+
+	opts, err := TLSOptions(logger, ca.Issue(t, dir, "client", 3))
+	require.NoError(t, err)
+	client, err := DialEthClientWithTimeout(context.Background(), DefaultDialTimeout, logger, url, opts...)
+	require.NoError(t, err)
+	defer client.Close()
+	var version string
+	require.NoError(t, client.Client().CallContext(context.Background(), &version, "health_status"))
+	require.Equal(t, "test", version)
+
+	// the server requires a client certificate
+	unauthenticated, err := DialEthClientWithTimeout(context.Background(), DefaultDialTimeout, logger, url)
+	require.NoError(t, err)
+	defer unauthenticated.Close()
+	require.Error(t, unauthenticated.Client().CallContext(context.Background(), &version, "health_status"))
+
+	none, err := TLSOptions(logger, oprpc.DefaultCLIConfig().TLS)
+	require.NoError(t, err)
+	require.Nil(t, none, "no options without tls config")
+}
@@ -0,0 +1,60 @@
+package eth
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobEncodeDecode(t *testing.T) {
+	rng := rand.New(rand.NewSource(1234))
+	for _, size := range []int{0, 1, 27, 28, 31, 123, 124, 127, 128, 1000, MaxBlobDataSize - 1, MaxBlobDataSize} {
+		data := make(Data, size)
+		rng.Read(data)
+		var b Blob
+		require.NoError(t, b.FromData(data), "size %d", size)
+		// every field element must be below the BLS modulus, i.e. the top two bits are never set
+		for i := 0; i < BlobSize; i += 32 {
+			require.Zero(t, b[i]&0b1100_0000, "size %d: field element %d", size, i/32)
+		}
+		decoded, err := b.ToData()
+		require.NoError(t, err, "size %d", size)
+		require.Equal(t, data, decoded, "size %d", size)
+	}
+}
+
+func TestBlobEncodeTooLarge(t *testing.T) {
+	var b Blob
+	require.ErrorIs(t, b.FromData(make(Data, MaxBlobDataSize+1)), ErrBlobInputTooLarge)
+}
+
+func TestBlobDecodeInvalid(t *testing.T) {
+	var b Blob
+	require.NoError(t, b.FromData(Data("hello world")))
+
+	invalid := b
+	invalid[VersionOffset] = 1
+	_, err := invalid.ToData()
+	require.ErrorIs(t, err, ErrBlobInvalidEncodingVersion)
+
+	invalid = b
+	invalid[2] = 0xff
+	_, err = invalid.ToData()
+	require.ErrorIs(t, err, ErrBlobInvalidLength)
+
+	invalid = b
+	invalid[32] = 0b1000_0000
+	_, err = invalid.ToData()
+	require.ErrorIs(t, err, ErrBlobInvalidFieldElement)
+
+	invalid = b
+	invalid[BlobSize-1] = 1
+	_, err = invalid.ToData()
+	require.ErrorIs(t, err, ErrBlobExtraneousData)
+
+	invalid = b
+	invalid[100] = 1
+	_, err = invalid.ToData()
+	require.ErrorIs(t, err, ErrBlobExtraneousDataFieldElement)
+}
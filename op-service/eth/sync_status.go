@@ -38,4 +38,10 @@ type SyncStatus struct {
 	// EngineSyncTarget points to the L2 block that the execution engine is syncing to.
 	// If it is ahead from UnsafeL2, the engine is in progress of P2P sync.
 	EngineSyncTarget L2BlockRef `json:"engine_sync_target"`
+	// CrossUnsafeL2 points to the last UnsafeL2 block of which the executing messages were verified
+	// against the initiating messages of the interop dependency set. Before interop it matches UnsafeL2.
+	CrossUnsafeL2 L2BlockRef `json:"cross_unsafe_l2"`
+	// CrossSafeL2 points to the last SafeL2 block of which the executing messages were verified.
+	// Before interop it matches SafeL2. Only cross-safe blocks are finalized.
+	CrossSafeL2 L2BlockRef `json:"cross_safe_l2"`
 }
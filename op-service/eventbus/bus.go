@@ -0,0 +1,271 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	ErrBusClosed     = errors.New("event bus closed")
+	ErrDuplicateName = errors.New("topic name already registered")
+	ErrQueueFull     = errors.New("subscriber queue full")
+)
+
+type Metrics interface {
+	RecordEventPublished(topic string)
+	RecordEventDropped(topic string)
+	RecordQueueLength(topic string, subscriber string, length int)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) RecordEventPublished(topic string) {}
+
+func (noopMetrics) RecordEventDropped(topic string) {}
+
+func (noopMetrics) RecordQueueLength(topic string, subscriber string, length int) {}
+
+// NoopMetrics can be used when the event bus does not need to be metered.
+var NoopMetrics Metrics = noopMetrics{}
+
+// Bus is an in-process registry of typed topics.
+// It allows components to communicate through events, without direct references to each other.
+type Bus struct {
+	log log.Logger
+	m   Metrics
+
+	mu     sync.Mutex
+	topics map[string]closer
+	closed bool
+
+	// done is closed when the bus is closed, to unblock any blocked publishers.
+	done chan struct{}
+}
+
+type closer interface {
+	close()
+}
+
+func NewBus(log log.Logger, m Metrics) *Bus {
+	if m == nil {
+		m = NoopMetrics
+	}
+	return &Bus{
+		log:    log,
+		m:      m,
+		topics: make(map[string]closer),
+		done:   make(chan struct{}),
+	}
+}
+
+// Close closes the bus and all of its topics.
+// Blocked publishers are released, and all subscriptions are ended.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	close(b.done)
+	for _, t := range b.topics {
+		t.close()
+	}
+}
+
+// OverflowPolicy determines what happens when an event is published to a subscriber with a full queue.
+type OverflowPolicy uint8
+
+const (
+	// Block makes the publisher wait until there is space in the queue, or the publish context is done.
+	Block OverflowPolicy = iota
+	// DropNewest drops the event for the subscriber that is not keeping up.
+	DropNewest
+)
+
+func (p OverflowPolicy) String() string {
+	switch p {
+	case Block:
+		return "block"
+	case DropNewest:
+		return "drop-newest"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(p))
+	}
+}
+
+// Topic is a named stream of events of type E.
+// Every event published to the topic is delivered to every subscription of the topic.
+type Topic[E any] struct {
+	name string
+	bus  *Bus
+
+	mu     sync.RWMutex
+	subs   map[*Subscription[E]]struct{}
+	closed bool
+}
+
+// NewTopic registers a new topic on the bus. Topic names must be unique per bus.
+func NewTopic[E any](bus *Bus, name string) (*Topic[E], error) {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+	if bus.closed {
+		return nil, ErrBusClosed
+	}
+	if _, ok := bus.topics[name]; ok {
+		return nil, fmt.Errorf("%w: %q", ErrDuplicateName, name)
+	}
+	t := &Topic[E]{
+		name: name,
+		bus:  bus,
+		subs: make(map[*Subscription[E]]struct{}),
+	}
+	bus.topics[name] = t
+	return t, nil
+}
+
+func (t *Topic[E]) Name() string {
+	return t.name
+}
+
+// Subscribe creates a new subscription to the topic, with a bounded queue of the given size.
+// The name identifies the subscriber in logs and metrics.
+func (t *Topic[E]) Subscribe(name string, queueSize int, policy OverflowPolicy) (*Subscription[E], error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return nil, ErrBusClosed
+	}
+	sub := &Subscription[E]{
+		name:   name,
+		topic:  t,
+		policy: policy,
+		ch:     make(chan E, queueSize),
+		done:   make(chan struct{}),
+	}
+	t.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+// Publish delivers the event to all current subscribers of the topic.
+// Subscribers with the Block policy and a full queue make Publish wait,
+// until there is space, the subscriber unsubscribes, the bus closes, or the ctx is done.
+// Subscribers with the DropNewest policy and a full queue do not receive the event.
+// Publish always attempts delivery to all subscribers, and returns the errors of all failed deliveries.
+func (t *Topic[E]) Publish(ctx context.Context, ev E) error {
+	t.mu.RLock()
+	if t.closed {
+		t.mu.RUnlock()
+		return ErrBusClosed
+	}
+	subs := make([]*Subscription[E], 0, len(t.subs))
+	for sub := range t.subs {
+		subs = append(subs, sub)
+	}
+	t.mu.RUnlock()
+
+	t.bus.m.RecordEventPublished(t.name)
+	var errs []error
+	for _, sub := range subs {
+		if err := sub.deliver(ctx, ev); err != nil {
+			if errors.Is(err, ErrQueueFull) {
+				t.bus.m.RecordEventDropped(t.name)
+				t.bus.log.Warn("Dropped event, subscriber is not keeping up", "topic", t.name, "subscriber", sub.name)
+			}
+			errs = append(errs, fmt.Errorf("subscriber %q: %w", sub.name, err))
+		}
+		t.bus.m.RecordQueueLength(t.name, sub.name, len(sub.ch))
+	}
+	return errors.Join(errs...)
+}
+
+func (t *Topic[E]) unsubscribe(sub *Subscription[E]) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.subs, sub)
+}
+
+func (t *Topic[E]) close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.closed = true
+	for sub := range t.subs {
+		sub.end()
+	}
+	t.subs = nil
+}
+
+// Subscription receives the events of a topic through a bounded queue.
+type Subscription[E any] struct {
+	name   string
+	topic  *Topic[E]
+	policy OverflowPolicy
+
+	ch chan E
+
+	doneOnce sync.Once
+	done     chan struct{}
+}
+
+// Events returns the queue of events. The channel is never closed: use Done to detect the end of the subscription.
+func (s *Subscription[E]) Events() <-chan E {
+	return s.ch
+}
+
+// Done is closed when the subscription ends, either by Unsubscribe or by closing the bus.
+func (s *Subscription[E]) Done() <-chan struct{} {
+	return s.done
+}
+
+// Unsubscribe ends the subscription. Queued events that were not yet received are discarded.
+func (s *Subscription[E]) Unsubscribe() {
+	s.topic.unsubscribe(s)
+	s.end()
+}
+
+func (s *Subscription[E]) end() {
+	s.doneOnce.Do(func() {
+		close(s.done)
+	})
+}
+
+// Handle calls fn for every event, until the subscription ends or the ctx is done.
+func (s *Subscription[E]) Handle(ctx context.Context, fn func(ev E)) {
+	for {
+		select {
+		case ev := <-s.ch:
+			fn(ev)
+		case <-s.done:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Subscription[E]) deliver(ctx context.Context, ev E) error {
+	select {
+	case s.ch <- ev:
+		return nil
+	case <-s.done:
+		return nil
+	default:
+	}
+	if s.policy == DropNewest {
+		return ErrQueueFull
+	}
+	select {
+	case s.ch <- ev:
+		return nil
+	case <-s.done:
+		return nil
+	case <-s.topic.bus.done:
+		return ErrBusClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
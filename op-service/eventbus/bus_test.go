@@ -0,0 +1,151 @@
+package eventbus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func newTestBus(t *testing.T) *Bus {
+	bus := NewBus(testlog.Logger(t, log.LvlInfo), nil)
+	t.Cleanup(bus.Close)
+	return bus
+}
+
+func TestPublishSubscribe(t *testing.T) {
+	bus := newTestBus(t)
+	topic, err := NewTopic[uint64](bus, "numbers")
+	require.NoError(t, err)
+	subA, err := topic.Subscribe("a", 10, Block)
+	require.NoError(t, err)
+	subB, err := topic.Subscribe("b", 10, Block)
+	require.NoError(t, err)
+
+	for i := uint64(0); i < 3; i++ {
+		require.NoError(t, topic.Publish(context.Background(), i))
+	}
+	for _, sub := range []*Subscription[uint64]{subA, subB} {
+		for i := uint64(0); i < 3; i++ {
+			require.Equal(t, i, <-sub.Events())
+		}
+	}
+}
+
+func TestDuplicateTopic(t *testing.T) {
+	bus := newTestBus(t)
+	_, err := NewTopic[string](bus, "x")
+	require.NoError(t, err)
+	_, err = NewTopic[int](bus, "x")
+	require.ErrorIs(t, err, ErrDuplicateName)
+}
+
+func TestDropNewest(t *testing.T) {
+	bus := newTestBus(t)
+	topic, err := NewTopic[int](bus, "ints")
+	require.NoError(t, err)
+	sub, err := topic.Subscribe("slow", 1, DropNewest)
+	require.NoError(t, err)
+	require.NoError(t, topic.Publish(context.Background(), 1))
+	require.ErrorIs(t, topic.Publish(context.Background(), 2), ErrQueueFull)
+	require.Equal(t, 1, <-sub.Events())
+	require.Len(t, sub.Events(), 0)
+}
+
+func TestBlockUntilContextDone(t *testing.T) {
+	bus := newTestBus(t)
+	topic, err := NewTopic[int](bus, "ints")
+	require.NoError(t, err)
+	_, err = topic.Subscribe("slow", 1, Block)
+	require.NoError(t, err)
+	require.NoError(t, topic.Publish(context.Background(), 1))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, topic.Publish(ctx, 2), context.DeadlineExceeded)
+}
+
+func TestPublishDeliversToAllSubscribers(t *testing.T) {
+	bus := newTestBus(t)
+	topic, err := NewTopic[int](bus, "ints")
+	require.NoError(t, err)
+	_, err = topic.Subscribe("slow", 1, Block)
+	require.NoError(t, err)
+	_, err = topic.Subscribe("dropping", 1, DropNewest)
+	require.NoError(t, err)
+	fast, err := topic.Subscribe("fast", 2, Block)
+	require.NoError(t, err)
+	require.NoError(t, topic.Publish(context.Background(), 1))
+
+	// the slow subscriber blocks until the ctx is done and the dropping subscriber drops the event,
+	// but the fast subscriber still receives it
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = topic.Publish(ctx, 2)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorIs(t, err, ErrQueueFull)
+	require.Equal(t, 1, <-fast.Events())
+	require.Equal(t, 2, <-fast.Events())
+}
+
+func TestUnsubscribe(t *testing.T) {
+	bus := newTestBus(t)
+	topic, err := NewTopic[int](bus, "ints")
+	require.NoError(t, err)
+	sub, err := topic.Subscribe("a", 1, Block)
+	require.NoError(t, err)
+	require.NoError(t, topic.Publish(context.Background(), 1))
+	sub.Unsubscribe()
+	<-sub.Done()
+	// no longer blocks on the full queue of the removed subscriber
+	require.NoError(t, topic.Publish(context.Background(), 2))
+}
+
+func TestCloseReleasesPublishers(t *testing.T) {
+	bus := NewBus(testlog.Logger(t, log.LvlInfo), nil)
+	topic, err := NewTopic[int](bus, "ints")
+	require.NoError(t, err)
+	sub, err := topic.Subscribe("a", 1, Block)
+	require.NoError(t, err)
+	require.NoError(t, topic.Publish(context.Background(), 1))
+
+	result := make(chan error, 1)
+	go func() {
+		result <- topic.Publish(context.Background(), 2)
+	}()
+	bus.Close()
+	<-sub.Done()
+	err = <-result
+	// the subscription may end before the publisher observes the bus closing
+	if err != nil {
+		require.ErrorIs(t, err, ErrBusClosed)
+	}
+	require.ErrorIs(t, topic.Publish(context.Background(), 3), ErrBusClosed)
+	_, err = NewTopic[int](bus, "other")
+	require.ErrorIs(t, err, ErrBusClosed)
+}
+
+func TestHandle(t *testing.T) {
+	bus := newTestBus(t)
+	topic, err := NewTopic[string](bus, "strs")
+	require.NoError(t, err)
+	sub, err := topic.Subscribe("a", 10, Block)
+	require.NoError(t, err)
+	var got []string
+	done := make(chan struct{})
+	go func() {
+		sub.Handle(context.Background(), func(ev string) {
+			got = append(got, ev)
+		})
+		close(done)
+	}()
+	require.NoError(t, topic.Publish(context.Background(), "hello"))
+	require.NoError(t, topic.Publish(context.Background(), "world"))
+	require.Eventually(t, func() bool { return len(sub.Events()) == 0 }, time.Second, time.Millisecond)
+	sub.Unsubscribe()
+	<-done
+	require.Equal(t, []string{"hello", "world"}, got)
+}
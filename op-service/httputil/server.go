@@ -2,11 +2,17 @@ package httputil
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"sync"
 	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 )
 
 // HTTPServer wraps a http.Server, while providing conveniences
@@ -15,6 +21,15 @@ type HTTPServer struct {
 	listener net.Listener
 	srv      *http.Server
 	closed   atomic.Bool
+
+	h2c          bool
+	maxBodySize  int64
+	drainTimeout time.Duration
+
+	// active tracks the in-flight requests, to drain them on shutdown
+	activeLock sync.Mutex
+	active     int
+	drained    chan struct{} // closed when there are no more active requests, after shutdown started
 }
 
 // HTTPOption applies a change to an HTTP server
@@ -44,8 +59,17 @@ func StartHTTPServer(addr string, handler http.Handler, opts ...HTTPOption) (*HT
 			return nil, errors.Join(fmt.Errorf("failed to apply HTTP option: %w", err), listener.Close())
 		}
 	}
+	out.srv.Handler = out.wrapHandler(handler)
 	go func() {
-		err := out.srv.Serve(listener)
+		var err error
+		if out.srv.TLSConfig != nil {
+			// certificates are provided by the TLS config
+			err = out.srv.ServeTLS(listener, "", "")
+		} else {
+			err = out.srv.Serve(listener)
+		}
+		// Serve returns as soon as shutdown starts, not when the requests complete.
+		out.drain()
 		srvCancel()
 		// no error, unless ErrServerClosed (or unused base context closes, or unused http2 config error)
 		if errors.Is(err, http.ErrServerClosed) {
@@ -57,6 +81,63 @@ func StartHTTPServer(addr string, handler http.Handler, opts ...HTTPOption) (*HT
 	return out, nil
 }
 
+// wrapHandler applies the handler options
+func (s *HTTPServer) wrapHandler(handler http.Handler) http.Handler {
+	if s.maxBodySize > 0 {
+		handler = http.MaxBytesHandler(handler, s.maxBodySize)
+	}
+	if s.drainTimeout > 0 {
+		handler = s.trackActive(handler)
+	}
+	if s.h2c {
+		if s.srv.TLSConfig != nil {
+			// HTTP/2 is negotiated through TLS already
+			return handler
+		}
+		handler = h2c.NewHandler(handler, &http2.Server{IdleTimeout: s.srv.IdleTimeout})
+	}
+	return handler
+}
+
+func (s *HTTPServer) trackActive(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.activeLock.Lock()
+		s.active++
+		s.activeLock.Unlock()
+		defer func() {
+			s.activeLock.Lock()
+			defer s.activeLock.Unlock()
+			s.active--
+			if s.active == 0 && s.drained != nil {
+				close(s.drained)
+				s.drained = nil
+			}
+		}()
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// drain waits for the active requests to complete, for up to the drain timeout.
+func (s *HTTPServer) drain() {
+	if s.drainTimeout <= 0 {
+		return
+	}
+	s.activeLock.Lock()
+	if s.active == 0 {
+		s.activeLock.Unlock()
+		return
+	}
+	drained := make(chan struct{})
+	s.drained = drained
+	s.activeLock.Unlock()
+	t := time.NewTimer(s.drainTimeout)
+	defer t.Stop()
+	select {
+	case <-drained:
+	case <-t.C:
+	}
+}
+
 func (s *HTTPServer) Closed() bool {
 	return s.closed.Load()
 }
@@ -98,3 +179,48 @@ func WithMaxHeaderBytes(max int) HTTPOption {
 		return nil
 	}
 }
+
+// WithDrainTimeout allows in-flight requests to complete for up to the given duration when the server shuts down,
+// before the request contexts are cancelled. By default request contexts are cancelled as soon as shutdown starts.
+// No new connections are accepted while draining.
+func WithDrainTimeout(timeout time.Duration) HTTPOption {
+	return func(srv *HTTPServer) error {
+		srv.drainTimeout = timeout
+		return nil
+	}
+}
+
+// WithH2C serves HTTP/2 over cleartext connections, in addition to HTTP/1.
+// TLS servers negotiate HTTP/2 regardless.
+func WithH2C() HTTPOption {
+	return func(srv *HTTPServer) error {
+		srv.h2c = true
+		return nil
+	}
+}
+
+// WithMaxRequestBodySize limits the size of request bodies. Handlers fail to read larger bodies,
+// with a *http.MaxBytesError. Request bodies are not limited by default.
+func WithMaxRequestBodySize(max int64) HTTPOption {
+	return func(srv *HTTPServer) error {
+		if max < 0 {
+			return fmt.Errorf("invalid max request body size %d", max)
+		}
+		srv.maxBodySize = max
+		return nil
+	}
+}
+
+// WithTLSConfig serves HTTPS with the given TLS config.
+// The certificate must be provided by the config, e.g. through GetCertificate.
+// Clients can be authenticated with mutual TLS by setting the ClientAuth and ClientCAs of the config,
+// see optls.NewServerTLSConfig.
+func WithTLSConfig(config *tls.Config) HTTPOption {
+	return func(srv *HTTPServer) error {
+		if config == nil {
+			return errors.New("nil TLS config")
+		}
+		srv.srv.TLSConfig = config
+		return nil
+	}
+}
@@ -2,13 +2,19 @@ package httputil
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
 	"net/http"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
 )
 
 func TestStartHTTPServer(t *testing.T) {
@@ -98,3 +104,102 @@ func TestStartHTTPServer(t *testing.T) {
 		require.True(t, srv.Closed())
 	})
 }
+
+func TestDrainTimeout(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		select {
+		case <-release:
+			w.WriteHeader(http.StatusTeapot)
+		case <-r.Context().Done():
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+	srv, err := StartHTTPServer("localhost:0", h, WithDrainTimeout(time.Minute))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		resp, err := http.Get("http://" + srv.Addr().String() + "/")
+		assert.NoError(t, err)
+		assert.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusTeapot, resp.StatusCode, "in-flight request completes while draining")
+	}()
+	<-started
+	shutdownErr := make(chan error, 1)
+	go func() {
+		shutdownErr <- srv.Shutdown(context.Background())
+	}()
+	// new connections are refused while draining
+	require.Eventually(t, func() bool {
+		resp, err := http.Get("http://" + srv.Addr().String() + "/")
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	close(release)
+	wg.Wait()
+	require.NoError(t, <-shutdownErr)
+	require.Eventually(t, srv.Closed, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestMaxRequestBodySize(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			var maxErr *http.MaxBytesError
+			if errors.As(err, &maxErr) {
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	srv, err := StartHTTPServer("localhost:0", h, WithMaxRequestBodySize(10))
+	require.NoError(t, err)
+	defer srv.Close()
+
+	post := func(body string) int {
+		resp, err := http.Post("http://"+srv.Addr().String()+"/", "text/plain", strings.NewReader(body))
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+	require.Equal(t, http.StatusOK, post("0123456789"))
+	require.Equal(t, http.StatusRequestEntityTooLarge, post("0123456789a"))
+
+	_, err = StartHTTPServer("localhost:0", h, WithMaxRequestBodySize(-1))
+	require.ErrorContains(t, err, "invalid max request body size")
+}
+
+func TestH2C(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Proto))
+	})
+	srv, err := StartHTTPServer("localhost:0", h, WithH2C())
+	require.NoError(t, err)
+	defer srv.Close()
+	url := "http://" + srv.Addr().String() + "/"
+
+	h2Client := &http.Client{Transport: &http2.Transport{
+		AllowHTTP: true,
+		DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		},
+	}}
+	for client, proto := range map[*http.Client]string{h2Client: "HTTP/2.0", http.DefaultClient: "HTTP/1.1"} {
+		resp, err := client.Get(url)
+		require.NoError(t, err)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, proto, string(body))
+	}
+}
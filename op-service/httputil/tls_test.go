@@ -0,0 +1,81 @@
+package httputil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testutils/tlstest"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+)
+
+func TestMutualTLS(t *testing.T) {
+	// certman keeps watching the certificates after the test completes, so do not log to the test
+	logger := log.New()
+	logger.SetHandler(log.DiscardHandler())
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t, dir)
+	serverCfg := ca.Issue(t, dir, "server", 2)
+	clientCfg := ca.Issue(t, dir, "client", 3)
+
+	serverTLS, err := optls.NewServerTLSConfig(logger, serverCfg, optls.RequireAndVerifyClientCert)
+	require.NoError(t, err)
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Len(t, r.TLS.PeerCertificates, 1)
+		require.Equal(t, "client", r.TLS.PeerCertificates[0].Subject.CommonName)
+		w.WriteHeader(http.StatusTeapot)
+	})
+	srv, err := StartHTTPServer("127.0.0.1:0", h, WithTLSConfig(serverTLS))
+	require.NoError(t, err)
+	defer srv.Close()
+	url := "https://" + srv.Addr().String() + "/"
+
+	t.Run("authenticated client", func(t *testing.T) {
+		clientTLS, err := optls.NewClientTLSConfig(logger, clientCfg)
+		require.NoError(t, err)
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLS}}
+		resp, err := client.Get(url)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusTeapot, resp.StatusCode)
+	})
+
+	t.Run("client without certificate", func(t *testing.T) {
+		pool, err := optls.LoadCertPool(ca.Path)
+		require.NoError(t, err)
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLSWithoutCert(pool)}}
+		resp, err := client.Get(url)
+		if resp != nil {
+			require.NoError(t, resp.Body.Close())
+		}
+		require.Error(t, err, "server must refuse clients without a certificate")
+	})
+
+	t.Run("untrusted server", func(t *testing.T) {
+		otherDir := t.TempDir()
+		otherCA := tlstest.NewCA(t, otherDir)
+		untrusting := clientCfg
+		untrusting.TLSCaCert = otherCA.Path
+		clientTLS, err := optls.NewClientTLSConfig(logger, untrusting)
+		require.NoError(t, err)
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLS}}
+		resp, err := client.Get(url)
+		if resp != nil {
+			require.NoError(t, resp.Body.Close())
+		}
+		require.ErrorContains(t, err, "certificate")
+	})
+}
+
+func TestNilTLSConfig(t *testing.T) {
+	_, err := StartHTTPServer("127.0.0.1:0", http.NotFoundHandler(), WithTLSConfig(nil))
+	require.ErrorContains(t, err, "nil TLS config")
+}
+
+func clientTLSWithoutCert(pool *x509.CertPool) *tls.Config {
+	return &tls.Config{MinVersion: tls.VersionTLS13, RootCAs: pool}
+}
@@ -0,0 +1,86 @@
+package ioutil
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// AtomicTempExt is appended to the path of an atomically written file, to name the temporary file it is written to.
+// Temporary files that are left over from an interrupted write can be recognized by it.
+const AtomicTempExt = ".tmp"
+
+// AtomicFile is a file that is written atomically: the data is written to a temporary file next to the destination,
+// which is moved into place by Commit. The destination file is never partially written.
+type AtomicFile struct {
+	file *os.File
+	path string
+	done bool
+}
+
+// CreateAtomic creates the temporary file of an atomic write to path, truncating any left over temporary file.
+// Either Commit or Abort must be called once done.
+func CreateAtomic(path string, perm os.FileMode) (*AtomicFile, error) {
+	file, err := os.OpenFile(path+AtomicTempExt, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return nil, err
+	}
+	return &AtomicFile{file: file, path: path}, nil
+}
+
+func (f *AtomicFile) Write(p []byte) (int, error) {
+	return f.file.Write(p)
+}
+
+// Commit syncs the written data to disk, and moves it to the destination path, replacing any existing file.
+// The rename is synced as well, so the file is persisted once Commit returns.
+func (f *AtomicFile) Commit() error {
+	if f.done {
+		return errors.New("atomic file already closed")
+	}
+	f.done = true
+	tmpPath := f.file.Name()
+	if err := f.file.Sync(); err != nil {
+		return errors.Join(fmt.Errorf("sync temp file %v: %w", tmpPath, err), f.file.Close(), os.Remove(tmpPath))
+	}
+	if err := f.file.Close(); err != nil {
+		return errors.Join(fmt.Errorf("close temp file %v: %w", tmpPath, err), os.Remove(tmpPath))
+	}
+	if err := os.Rename(tmpPath, f.path); err != nil {
+		return errors.Join(fmt.Errorf("move temp file into place at %v: %w", f.path, err), os.Remove(tmpPath))
+	}
+	dir, err := os.Open(filepath.Dir(f.path))
+	if err != nil {
+		return fmt.Errorf("open dir of %v: %w", f.path, err)
+	}
+	defer dir.Close()
+	if err := dir.Sync(); err != nil {
+		return fmt.Errorf("sync dir of %v: %w", f.path, err)
+	}
+	return nil
+}
+
+// Abort discards the written data, leaving any existing file at the destination path as is.
+// Abort is a no-op once the file is committed, so it can be deferred.
+func (f *AtomicFile) Abort() {
+	if f.done {
+		return
+	}
+	f.done = true
+	_ = f.file.Close()
+	_ = os.Remove(f.file.Name())
+}
+
+// WriteFileAtomic writes the data to the file at path atomically, see AtomicFile.
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	f, err := CreateAtomic(path, perm)
+	if err != nil {
+		return err
+	}
+	defer f.Abort()
+	if _, err := f.Write(data); err != nil {
+		return err
+	}
+	return f.Commit()
+}
@@ -0,0 +1,66 @@
+package ioutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file.json")
+	require.NoError(t, WriteFileAtomic(path, []byte("first"), 0o644))
+	require.NoError(t, WriteFileAtomic(path, []byte("second"), 0o644))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "second", string(data))
+	require.NoFileExists(t, path+AtomicTempExt)
+}
+
+func TestAtomicFile(t *testing.T) {
+	t.Run("AbortKeepsExistingFile", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "file.json")
+		require.NoError(t, WriteFileAtomic(path, []byte("existing"), 0o644))
+		f, err := CreateAtomic(path, 0o644)
+		require.NoError(t, err)
+		_, err = f.Write([]byte("partial"))
+		require.NoError(t, err)
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, "existing", string(data), "destination must not be written before commit")
+
+		f.Abort()
+		data, err = os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, "existing", string(data))
+		require.NoFileExists(t, path+AtomicTempExt)
+	})
+
+	t.Run("AbortAfterCommit", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "file.json")
+		f, err := CreateAtomic(path, 0o644)
+		require.NoError(t, err)
+		_, err = f.Write([]byte("data"))
+		require.NoError(t, err)
+		require.NoError(t, f.Commit())
+		f.Abort()
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, "data", string(data))
+		require.Error(t, f.Commit(), "cannot commit twice")
+	})
+
+	t.Run("TruncatesLeftOverTempFile", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "file.json")
+		require.NoError(t, os.WriteFile(path+AtomicTempExt, []byte("left over from a crash"), 0o644))
+		require.NoError(t, WriteFileAtomic(path, []byte("new"), 0o644))
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, "new", string(data))
+	})
+}
@@ -3,47 +3,67 @@ package ioutil
 import (
 	"compress/gzip"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"strings"
+
+	"github.com/klauspost/compress/zstd"
 )
 
-// OpenDecompressed opens a reader for the specified file and automatically gzip decompresses the content
-// if the filename ends with .gz
+// OpenDecompressed opens a reader for the specified file and automatically decompresses the content
+// if the filename ends with .gz (gzip) or .zst (zstd)
 func OpenDecompressed(path string) (io.ReadCloser, error) {
-	var r io.ReadCloser
-	r, err := os.Open(path)
+	f, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
-	if IsGzip(path) {
-		r, err = gzip.NewReader(r)
+	switch {
+	case IsGzip(path):
+		r, err := gzip.NewReader(f)
 		if err != nil {
+			_ = f.Close()
 			return nil, fmt.Errorf("failed to create gzip reader: %w", err)
 		}
+		return &wrappedReadCloser{r, r, f}, nil
+	case IsZstd(path):
+		r, err := zstd.NewReader(f)
+		if err != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("failed to create zstd reader: %w", err)
+		}
+		return &wrappedReadCloser{r, r.IOReadCloser(), f}, nil
 	}
-	return r, nil
+	return f, nil
 }
 
-// OpenCompressed opens a file for writing and automatically compresses the content if the filename ends with .gz
+// OpenCompressed opens a file for writing and automatically compresses the content
+// if the filename ends with .gz (gzip) or .zst (zstd)
 func OpenCompressed(file string, flag int, perm os.FileMode) (io.WriteCloser, error) {
-	var out io.WriteCloser
-	out, err := os.OpenFile(file, flag, perm)
+	f, err := os.OpenFile(file, flag, perm)
 	if err != nil {
 		return nil, err
 	}
-	if IsGzip(file) {
-		out = gzip.NewWriter(out)
+	switch {
+	case IsGzip(file):
+		return &wrappedWriteCloser{gzip.NewWriter(f), f}, nil
+	case IsZstd(file):
+		w, err := zstd.NewWriter(f)
+		if err != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("failed to create zstd writer: %w", err)
+		}
+		return &wrappedWriteCloser{w, f}, nil
 	}
-	return out, nil
+	return f, nil
 }
 
 // WriteCompressedJson writes the object to the specified file as a compressed json object
-// if the filename ends with .gz.
+// if the filename ends with .gz or .zst.
 func WriteCompressedJson(file string, obj any) error {
-	if !IsGzip(file) {
-		return fmt.Errorf("file %v does not have .gz extension", file)
+	if !IsGzip(file) && !IsZstd(file) {
+		return fmt.Errorf("file %v does not have .gz extension or .zst extension", file)
 	}
 	out, err := OpenCompressed(file, os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
@@ -58,3 +78,30 @@ func WriteCompressedJson(file string, obj any) error {
 func IsGzip(path string) bool {
 	return strings.HasSuffix(path, ".gz")
 }
+
+// IsZstd determines if a path points to a zstd compressed file.
+// Returns true when the file has a .zst extension.
+func IsZstd(path string) bool {
+	return strings.HasSuffix(path, ".zst")
+}
+
+// wrappedReadCloser reads from the decompressor, and closes both the decompressor and the file.
+type wrappedReadCloser struct {
+	io.Reader
+	decompressor io.Closer
+	file         io.Closer
+}
+
+func (r *wrappedReadCloser) Close() error {
+	return errors.Join(r.decompressor.Close(), r.file.Close())
+}
+
+// wrappedWriteCloser writes to the compressor, and closes the compressor, to flush it, before the file.
+type wrappedWriteCloser struct {
+	io.WriteCloser
+	file io.Closer
+}
+
+func (w *wrappedWriteCloser) Close() error {
+	return errors.Join(w.WriteCloser.Close(), w.file.Close())
+}
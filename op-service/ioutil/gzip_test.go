@@ -18,6 +18,7 @@ func TestReadWriteWithOptionalCompression(t *testing.T) {
 	}{
 		{"Uncompressed", "test.notgz", false},
 		{"Gzipped", "test.gz", true},
+		{"Zstd", "test.zst", true},
 	}
 	for _, test := range tests {
 		test := test
@@ -57,6 +58,7 @@ func TestWriteReadCompressedJson(t *testing.T) {
 	}{
 		{"Uncompressed", "test.notgz", "does not have .gz extension"},
 		{"Gzipped", "test.gz", ""},
+		{"Zstd", "test.zst", ""},
 	}
 	for _, test := range tests {
 		test := test
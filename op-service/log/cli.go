@@ -16,9 +16,10 @@ import (
 )
 
 const (
-	LevelFlagName  = "log.level"
-	FormatFlagName = "log.format"
-	ColorFlagName  = "log.color"
+	LevelFlagName        = "log.level"
+	ModuleLevelsFlagName = "log.module-levels"
+	FormatFlagName       = "log.format"
+	ColorFlagName        = "log.color"
 )
 
 // CLIFlags creates flag definitions for the logging utils.
@@ -32,6 +33,15 @@ func CLIFlags(envPrefix string) []cli.Flag {
 			Value:   NewLvlFlagValue(log.LvlInfo),
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "LOG_LEVEL"),
 		},
+		&cli.StringSliceFlag{
+			Name:    ModuleLevelsFlagName,
+			Usage:   "Log level overrides per module, as comma-separated module=level pairs, e.g. 'p2p=debug,driver=warn'",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "LOG_MODULE_LEVELS"),
+			Action: func(ctx *cli.Context, pairs []string) error {
+				_, err := ParseModuleLevels(pairs)
+				return err
+			},
+		},
 		&cli.GenericFlag{
 			Name:    FormatFlagName,
 			Usage:   "Format the log output. Supported formats: 'text', 'terminal', 'logfmt', 'json', 'json-pretty',",
@@ -150,9 +160,28 @@ func (fv *FormatFlagValue) Clone() any {
 var _ cliapp.CloneableGeneric = (*FormatFlagValue)(nil)
 
 type CLIConfig struct {
-	Level  log.Lvl
-	Color  bool
-	Format FormatType
+	Level log.Lvl
+	// ModuleLevels overrides the log level of records tagged with the module, see Module.
+	ModuleLevels map[string]log.Lvl
+	Color        bool
+	Format       FormatType
+}
+
+// ParseModuleLevels parses module=level pairs into log level overrides per module.
+func ParseModuleLevels(pairs []string) (map[string]log.Lvl, error) {
+	out := make(map[string]log.Lvl, len(pairs))
+	for _, pair := range pairs {
+		module, lvlStr, ok := strings.Cut(pair, "=")
+		if !ok || module == "" {
+			return nil, fmt.Errorf("invalid module log level %q, expected module=level", pair)
+		}
+		lvl, err := log.LvlFromString(strings.ToLower(lvlStr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid log level of module %q: %w", module, err)
+		}
+		out[module] = lvl
+	}
+	return out, nil
 }
 
 // AppOut returns an io.Writer to write app output to, like logs.
@@ -164,12 +193,16 @@ func AppOut(ctx *cli.Context) io.Writer {
 	return ctx.App.Writer
 }
 
-// NewLogHandler creates a new configured handler, compatible as LvlSetter for log-level changes during runtime.
+// NewLogHandler creates a new configured handler, compatible as LvlSetter and ModuleLvlSetter
+// for log-level changes during runtime.
 func NewLogHandler(wr io.Writer, cfg CLIConfig) log.Handler {
 	handler := log.StreamHandler(wr, cfg.Format.Formatter(cfg.Color))
 	handler = log.SyncHandler(handler)
-	handler = NewDynamicLogHandler(cfg.Level, handler)
-	return handler
+	dynHandler := NewDynamicLogHandler(cfg.Level, handler)
+	for module, lvl := range cfg.ModuleLevels {
+		dynHandler.SetModuleLogLevel(module, lvl)
+	}
+	return dynHandler
 }
 
 // NewLogger creates a new configured logger.
@@ -204,6 +237,10 @@ func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 	cfg := DefaultCLIConfig()
 	cfg.Level = ctx.Generic(LevelFlagName).(*LvlFlagValue).LogLvl()
 	cfg.Format = ctx.Generic(FormatFlagName).(*FormatFlagValue).FormatType()
+	if ctx.IsSet(ModuleLevelsFlagName) {
+		// invalid module levels are refused by the flag action, the error is thus not expected here
+		cfg.ModuleLevels, _ = ParseModuleLevels(ctx.StringSlice(ModuleLevelsFlagName))
+	}
 	if ctx.IsSet(ColorFlagName) {
 		cfg.Color = ctx.Bool(ColorFlagName)
 	}
@@ -0,0 +1,48 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestParseModuleLevels(t *testing.T) {
+	lvls, err := ParseModuleLevels([]string{"p2p=debug", "driver=WARN"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]log.Lvl{"p2p": log.LvlDebug, "driver": log.LvlWarn}, lvls)
+
+	_, err = ParseModuleLevels([]string{"p2p"})
+	require.ErrorContains(t, err, "expected module=level")
+	_, err = ParseModuleLevels([]string{"=info"})
+	require.ErrorContains(t, err, "expected module=level")
+	_, err = ParseModuleLevels([]string{"p2p=loud"})
+	require.ErrorContains(t, err, "invalid log level of module")
+}
+
+func TestModuleLevelsFlag(t *testing.T) {
+	cfg, err := configForArgs("--log.module-levels=p2p=debug,driver=error")
+	require.NoError(t, err)
+	require.Equal(t, map[string]log.Lvl{"p2p": log.LvlDebug, "driver": log.LvlError}, cfg.ModuleLevels)
+
+	cfg, err = configForArgs()
+	require.NoError(t, err)
+	require.Nil(t, cfg.ModuleLevels)
+
+	_, err = configForArgs("--log.module-levels=p2p=loud")
+	require.ErrorContains(t, err, "invalid log level of module")
+}
+
+func configForArgs(args ...string) (CLIConfig, error) {
+	app := cli.NewApp()
+	app.Flags = CLIFlags("TEST")
+	app.Name = "test"
+	var config CLIConfig
+	app.Action = func(ctx *cli.Context) error {
+		config = ReadCLIConfig(ctx)
+		return nil
+	}
+	err := app.Run(append([]string{"test"}, args...))
+	return config, err
+}
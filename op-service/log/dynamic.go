@@ -1,31 +1,109 @@
 package log
 
-import "github.com/ethereum/go-ethereum/log"
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ModuleKey is the log context key that identifies the module a log record belongs to.
+// Log levels can be overridden per module, see ModuleLvlSetter.
+const ModuleKey = "module"
+
+// Module returns a child logger of the given logger, tagged with the module name.
+func Module(l log.Logger, name string) log.Logger {
+	return l.New(ModuleKey, name)
+}
 
 type LvlSetter interface {
 	SetLogLevel(lvl log.Lvl)
 }
 
+// ModuleLvlSetter allows the log level of individual modules to deviate from the default log level.
+type ModuleLvlSetter interface {
+	// SetModuleLogLevel overrides the log level of the given module.
+	SetModuleLogLevel(module string, lvl log.Lvl)
+	// ResetModuleLogLevel removes the log level override of the given module.
+	ResetModuleLogLevel(module string)
+	// ModuleLogLevels returns a copy of all log level overrides.
+	ModuleLogLevels() map[string]log.Lvl
+}
+
 // DynamicLogHandler allow runtime-configuration of the log handler.
 type DynamicLogHandler struct {
 	log.Handler // embedded, to expose any extra methods the underlying handler might provide
-	maxLvl      log.Lvl
+
+	mu         sync.RWMutex
+	maxLvl     log.Lvl
+	moduleLvls map[string]log.Lvl
 }
 
 func NewDynamicLogHandler(lvl log.Lvl, h log.Handler) *DynamicLogHandler {
 	return &DynamicLogHandler{
-		Handler: h,
-		maxLvl:  lvl,
+		Handler:    h,
+		maxLvl:     lvl,
+		moduleLvls: make(map[string]log.Lvl),
 	}
 }
 
 func (d *DynamicLogHandler) SetLogLevel(lvl log.Lvl) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
 	d.maxLvl = lvl
 }
 
+func (d *DynamicLogHandler) SetModuleLogLevel(module string, lvl log.Lvl) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.moduleLvls[module] = lvl
+}
+
+func (d *DynamicLogHandler) ResetModuleLogLevel(module string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.moduleLvls, module)
+}
+
+func (d *DynamicLogHandler) ModuleLogLevels() map[string]log.Lvl {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	out := make(map[string]log.Lvl, len(d.moduleLvls))
+	for k, v := range d.moduleLvls {
+		out[k] = v
+	}
+	return out
+}
+
 func (d *DynamicLogHandler) Log(r *log.Record) error {
-	if r.Lvl > d.maxLvl { // lower log level values are more critical
+	if r.Lvl > d.recordLvl(r) { // lower log level values are more critical
 		return nil
 	}
 	return d.Handler.Log(r) // process the log
 }
+
+// recordLvl determines the max log level that applies to the record.
+func (d *DynamicLogHandler) recordLvl(r *log.Record) log.Lvl {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if len(d.moduleLvls) == 0 {
+		return d.maxLvl
+	}
+	// The last module entry takes precedence, like the most specific child logger.
+	for i := len(r.Ctx) - 2; i >= 0; i -= 2 {
+		if k, ok := r.Ctx[i].(string); !ok || k != ModuleKey {
+			continue
+		}
+		if module, ok := r.Ctx[i+1].(string); ok {
+			if lvl, ok := d.moduleLvls[module]; ok {
+				return lvl
+			}
+		}
+		break
+	}
+	return d.maxLvl
+}
+
+var (
+	_ LvlSetter       = (*DynamicLogHandler)(nil)
+	_ ModuleLvlSetter = (*DynamicLogHandler)(nil)
+)
@@ -42,3 +42,35 @@ func TestDynamicLogHandler_SetLogLevel(t *testing.T) {
 	require.Equal(t, records[4].Msg, "visible warning")
 	require.Equal(t, records[5].Msg, "another error")
 }
+
+func TestDynamicLogHandler_ModuleLogLevel(t *testing.T) {
+	var records []*log.Record
+	h := log.FuncHandler(func(r *log.Record) error {
+		records = append(records, r)
+		return nil
+	})
+	d := NewDynamicLogHandler(log.LvlInfo, h)
+	logger := log.New()
+	logger.SetHandler(d)
+	p2p := Module(logger, "p2p")
+	driver := Module(logger, "driver")
+
+	d.SetModuleLogLevel("p2p", log.LvlDebug)
+	d.SetModuleLogLevel("driver", log.LvlError)
+	require.Equal(t, map[string]log.Lvl{"p2p": log.LvlDebug, "driver": log.LvlError}, d.ModuleLogLevels())
+
+	logger.Debug("root debug")   // n
+	p2p.Debug("p2p debug")       // y
+	driver.Warn("driver warn")   // n
+	driver.Error("driver error") // y
+	// the most specific module applies
+	Module(p2p, "driver").Info("nested info") // n
+
+	d.ResetModuleLogLevel("driver")
+	driver.Info("driver info") // y
+
+	require.Len(t, records, 3)
+	require.Equal(t, "p2p debug", records[0].Msg)
+	require.Equal(t, "driver error", records[1].Msg)
+	require.Equal(t, "driver info", records[2].Msg)
+}
@@ -0,0 +1,86 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// slogHandler is a slog.Handler that emits records through a geth logger,
+// so stdlib-slog based code logs with the same format, handler and dynamic log levels as the rest of the service.
+type slogHandler struct {
+	l      log.Logger
+	prefix string
+}
+
+// NewSlogHandler creates a slog.Handler that forwards all records to the given logger.
+// Level filtering is left to the handler of the logger.
+func NewSlogHandler(l log.Logger) slog.Handler {
+	return &slogHandler{l: l}
+}
+
+// NewSlogLogger creates a stdlib *slog.Logger that forwards all records to the given logger.
+func NewSlogLogger(l log.Logger) *slog.Logger {
+	return slog.New(NewSlogHandler(l))
+}
+
+func (h *slogHandler) Enabled(_ context.Context, _ slog.Level) bool {
+	return true
+}
+
+func (h *slogHandler) Handle(_ context.Context, r slog.Record) error {
+	ctx := make([]any, 0, 2*r.NumAttrs())
+	r.Attrs(func(attr slog.Attr) bool {
+		ctx = h.appendAttr(ctx, h.prefix, attr)
+		return true
+	})
+	switch {
+	case r.Level >= slog.LevelError:
+		h.l.Error(r.Message, ctx...)
+	case r.Level >= slog.LevelWarn:
+		h.l.Warn(r.Message, ctx...)
+	case r.Level >= slog.LevelInfo:
+		h.l.Info(r.Message, ctx...)
+	case r.Level >= slog.LevelDebug:
+		h.l.Debug(r.Message, ctx...)
+	default:
+		h.l.Trace(r.Message, ctx...)
+	}
+	return nil
+}
+
+func (h *slogHandler) appendAttr(ctx []any, prefix string, attr slog.Attr) []any {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) {
+		return ctx
+	}
+	if attr.Value.Kind() == slog.KindGroup {
+		groupPrefix := prefix
+		if attr.Key != "" {
+			groupPrefix = prefix + attr.Key + "."
+		}
+		for _, a := range attr.Value.Group() {
+			ctx = h.appendAttr(ctx, groupPrefix, a)
+		}
+		return ctx
+	}
+	return append(ctx, prefix+attr.Key, attr.Value.Any())
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	ctx := make([]any, 0, 2*len(attrs))
+	for _, attr := range attrs {
+		ctx = h.appendAttr(ctx, h.prefix, attr)
+	}
+	return &slogHandler{l: h.l.New(ctx...), prefix: h.prefix}
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &slogHandler{l: h.l, prefix: h.prefix + name + "."}
+}
+
+var _ slog.Handler = (*slogHandler)(nil)
@@ -0,0 +1,28 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlogHandler(t *testing.T) {
+	var records []*log.Record
+	h := log.FuncHandler(func(r *log.Record) error {
+		records = append(records, r)
+		return nil
+	})
+	logger := log.New()
+	logger.SetHandler(NewDynamicLogHandler(log.LvlInfo, h))
+
+	sl := NewSlogLogger(Module(logger, "p2p"))
+	sl.Debug("hidden")
+	sl.With("peer", "abc").WithGroup("req").Warn("slow request", "id", 1)
+
+	require.Len(t, records, 1)
+	r := records[0]
+	require.Equal(t, log.LvlWarn, r.Lvl)
+	require.Equal(t, "slow request", r.Msg)
+	require.Equal(t, []any{ModuleKey, "p2p", "peer", "abc", "req.id", int64(1)}, r.Ctx)
+}
@@ -3,19 +3,20 @@ package metrics
 import (
 	"context"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/ethereum-optimism/optimism/op-service/clock"
 )
 
+const balanceCheckInterval = 10 * time.Second
+
 // weiToEther divides the wei value by 10^18 to get a number in ether as a float64
 func weiToEther(wei *big.Int) float64 {
 	num := new(big.Rat).SetInt(wei)
@@ -25,27 +26,190 @@ func weiToEther(wei *big.Int) float64 {
 	return f
 }
 
+// etherToWei converts a number in ether to wei, rounding down to the nearest wei
+func etherToWei(ether float64) *big.Int {
+	wei, _ := new(big.Float).Mul(big.NewFloat(ether), new(big.Float).SetInt64(params.Ether)).Int(nil)
+	return wei
+}
+
+// BalanceLevel classifies a balance by the thresholds it dropped below.
+type BalanceLevel int
+
+const (
+	BalanceOK BalanceLevel = iota
+	BalanceWarning
+	BalanceCritical
+)
+
+func (l BalanceLevel) String() string {
+	switch l {
+	case BalanceOK:
+		return "ok"
+	case BalanceWarning:
+		return "warning"
+	case BalanceCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// BalanceThresholds are the balances, in wei, below which an account is considered low on funds.
+// A nil threshold is disabled.
+type BalanceThresholds struct {
+	Warning  *big.Int
+	Critical *big.Int
+}
+
+// Level returns the level of the given balance.
+func (t BalanceThresholds) Level(balance *big.Int) BalanceLevel {
+	if t.Critical != nil && balance.Cmp(t.Critical) < 0 {
+		return BalanceCritical
+	}
+	if t.Warning != nil && balance.Cmp(t.Warning) < 0 {
+		return BalanceWarning
+	}
+	return BalanceOK
+}
+
+// MonitoredAccount is an account that is watched by the BalanceMonitor.
+type MonitoredAccount struct {
+	// Name identifies the role of the account in metrics and logs, e.g. "batcher".
+	Name       string
+	Address    common.Address
+	Thresholds BalanceThresholds
+}
+
+type BalanceFetcher interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// LevelChangeFn is called when the balance of an account crosses a threshold.
+type LevelChangeFn func(account MonitoredAccount, level BalanceLevel, balance *big.Int)
+
+// BalanceMonitor watches the balances of accounts, records them as metrics,
+// and logs when the balance of an account drops below, or recovers above, its thresholds.
+type BalanceMonitor struct {
+	log      log.Logger
+	client   BalanceFetcher
+	accounts []MonitoredAccount
+
+	balance *prometheus.GaugeVec
+	level   *prometheus.GaugeVec
+
+	mu            sync.Mutex
+	levels        map[common.Address]BalanceLevel
+	onLevelChange []LevelChangeFn
+}
+
+// NewBalanceMonitor creates a BalanceMonitor of the given accounts.
+// The balances are recorded, in ether, to the "account_balance" metric of the namespace,
+// and the balance levels to the "account_balance_level" metric.
+func NewBalanceMonitor(log log.Logger, factory Factory, ns string, client BalanceFetcher, accounts ...MonitoredAccount) *BalanceMonitor {
+	return &BalanceMonitor{
+		log:      log,
+		client:   client,
+		accounts: accounts,
+		balance: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "account_balance",
+			Help:      "Balance (in ether) of monitored accounts",
+		}, []string{"name", "address"}),
+		level: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Name:      "account_balance_level",
+			Help:      "Balance level of monitored accounts: 0 if ok, 1 if below the warning threshold, 2 if below the critical threshold",
+		}, []string{"name", "address"}),
+		levels: make(map[common.Address]BalanceLevel),
+	}
+}
+
+// OnLevelChange registers a callback that is called when the balance level of an account changes.
+// The first balance check of an account is considered a change if the balance is not ok.
+func (m *BalanceMonitor) OnLevelChange(fn LevelChangeFn) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onLevelChange = append(m.onLevelChange, fn)
+}
+
+// Level returns the last observed balance level of the account.
+func (m *BalanceMonitor) Level(account common.Address) BalanceLevel {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.levels[account]
+}
+
+// CheckBalances fetches and records the balance of all accounts.
+func (m *BalanceMonitor) CheckBalances(ctx context.Context) {
+	for _, account := range m.accounts {
+		m.checkBalance(ctx, account)
+	}
+}
+
+// checkBalance fetches and records the balance of the account. Returns nil if the balance could not be fetched.
+func (m *BalanceMonitor) checkBalance(ctx context.Context, account MonitoredAccount) *big.Int {
+	ctx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+	defer cancel()
+	bal, err := m.client.BalanceAt(ctx, account.Address, nil)
+	if err != nil {
+		m.log.Warn("failed to get balance of account", "err", err, "name", account.Name, "address", account.Address)
+		return nil
+	}
+	level := account.Thresholds.Level(bal)
+	addr := account.Address.String()
+	m.balance.WithLabelValues(account.Name, addr).Set(weiToEther(bal))
+	m.level.WithLabelValues(account.Name, addr).Set(float64(level))
+
+	m.mu.Lock()
+	prev, seen := m.levels[account.Address]
+	m.levels[account.Address] = level
+	callbacks := m.onLevelChange
+	m.mu.Unlock()
+	if prev == level && (seen || level == BalanceOK) {
+		return bal
+	}
+
+	logCtx := []any{"name", account.Name, "address", account.Address, "balance", weiToEther(bal), "level", level}
+	switch level {
+	case BalanceCritical:
+		m.log.Error("account balance is below critical threshold", append(logCtx, "threshold", weiToEther(account.Thresholds.Critical))...)
+	case BalanceWarning:
+		m.log.Warn("account balance is below warning threshold", append(logCtx, "threshold", weiToEther(account.Thresholds.Warning))...)
+	default:
+		m.log.Info("account balance recovered", logCtx...)
+	}
+	for _, fn := range callbacks {
+		fn(account, level, bal)
+	}
+	return bal
+}
+
+// Start periodically checks the balances, until the returned loop is closed.
+func (m *BalanceMonitor) Start(clk clock.Clock, interval time.Duration) *clock.LoopFn {
+	return clock.NewLoopFn(clk, m.CheckBalances, func() error {
+		m.log.Info("balance monitor shutting down")
+		return nil
+	}, interval)
+}
+
 // LaunchBalanceMetrics starts a periodic query of the balance of the supplied account and records it
 // to the "balance" metric of the namespace. The balance of the account is recorded in Ether (not Wei).
+// The balance is also monitored against the thresholds, see BalanceMonitor.
 // Cancel the supplied context to shut down the go routine
-func LaunchBalanceMetrics(log log.Logger, r *prometheus.Registry, ns string, client *ethclient.Client, account common.Address) *clock.LoopFn {
-	balanceGuage := promauto.With(r).NewGauge(prometheus.GaugeOpts{
+func LaunchBalanceMetrics(log log.Logger, r *prometheus.Registry, ns string, client BalanceFetcher, account MonitoredAccount) *clock.LoopFn {
+	factory := With(r)
+	balanceGuage := factory.NewGauge(prometheus.GaugeOpts{
 		Namespace: ns,
 		Name:      "balance",
-		Help:      "balance (in ether) of account " + account.String(),
+		Help:      "balance (in ether) of account " + account.Address.String(),
 	})
+	monitor := NewBalanceMonitor(log, factory, ns, client, account)
 	return clock.NewLoopFn(clock.SystemClock, func(ctx context.Context) {
-		ctx, cancel := context.WithTimeout(ctx, 1*time.Minute)
-		defer cancel()
-		bigBal, err := client.BalanceAt(ctx, account, nil)
-		if err != nil {
-			log.Warn("failed to get balance of account", "err", err, "address", account)
-			return
+		if bal := monitor.checkBalance(ctx, account); bal != nil {
+			balanceGuage.Set(weiToEther(bal))
 		}
-		bal := weiToEther(bigBal)
-		balanceGuage.Set(bal)
 	}, func() error {
 		log.Info("balance metrics shutting down")
 		return nil
-	}, 10*time.Second)
+	}, balanceCheckInterval)
 }
@@ -1,8 +1,17 @@
 package metrics
 
 import (
+	"context"
+	"errors"
 	"math/big"
 	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
 )
 
 type weiToEthTestCase struct {
@@ -38,3 +47,89 @@ func TestWeiToEther(t *testing.T) {
 	}
 
 }
+
+type fakeBalances struct {
+	balances map[common.Address]*big.Int
+}
+
+func (f *fakeBalances) BalanceAt(_ context.Context, account common.Address, _ *big.Int) (*big.Int, error) {
+	bal, ok := f.balances[account]
+	if !ok {
+		return nil, errors.New("unknown account")
+	}
+	return bal, nil
+}
+
+func TestBalanceThresholds(t *testing.T) {
+	thresholds := BalanceThresholds{Warning: big.NewInt(100), Critical: big.NewInt(10)}
+	require.Equal(t, BalanceOK, thresholds.Level(big.NewInt(100)))
+	require.Equal(t, BalanceWarning, thresholds.Level(big.NewInt(99)))
+	require.Equal(t, BalanceWarning, thresholds.Level(big.NewInt(10)))
+	require.Equal(t, BalanceCritical, thresholds.Level(big.NewInt(9)))
+	require.Equal(t, BalanceOK, BalanceThresholds{}.Level(big.NewInt(0)))
+}
+
+func TestBalanceMonitor(t *testing.T) {
+	batcher := common.Address{0xba}
+	proposer := common.Address{0x0b}
+	thresholds := BalanceThresholds{Warning: etherToWei(1), Critical: etherToWei(0.1)}
+	client := &fakeBalances{balances: map[common.Address]*big.Int{
+		batcher:  etherToWei(2),
+		proposer: etherToWei(0.5),
+	}}
+	registry := NewRegistry()
+	monitor := NewBalanceMonitor(testlog.Logger(t, log.LvlInfo), With(registry), "test", client,
+		MonitoredAccount{Name: "batcher", Address: batcher, Thresholds: thresholds},
+		MonitoredAccount{Name: "proposer", Address: proposer, Thresholds: thresholds})
+	var changes []BalanceLevel
+	monitor.OnLevelChange(func(account MonitoredAccount, level BalanceLevel, balance *big.Int) {
+		changes = append(changes, level)
+	})
+
+	monitor.CheckBalances(context.Background())
+	require.Equal(t, BalanceOK, monitor.Level(batcher))
+	require.Equal(t, BalanceWarning, monitor.Level(proposer))
+	require.Equal(t, []BalanceLevel{BalanceWarning}, changes, "only the low balance is reported initially")
+	require.Equal(t, 2.0, testutil.ToFloat64(monitor.balance.WithLabelValues("batcher", batcher.String())))
+	require.Equal(t, 0.5, testutil.ToFloat64(monitor.balance.WithLabelValues("proposer", proposer.String())))
+	require.Equal(t, float64(BalanceWarning), testutil.ToFloat64(monitor.level.WithLabelValues("proposer", proposer.String())))
+
+	// unchanged levels are not reported again
+	monitor.CheckBalances(context.Background())
+	require.Len(t, changes, 1)
+
+	client.balances[proposer] = etherToWei(0.05)
+	client.balances[batcher] = etherToWei(0.5)
+	monitor.CheckBalances(context.Background())
+	require.Equal(t, []BalanceLevel{BalanceWarning, BalanceWarning, BalanceCritical}, changes)
+	require.Equal(t, float64(BalanceCritical), testutil.ToFloat64(monitor.level.WithLabelValues("proposer", proposer.String())))
+
+	client.balances[proposer] = etherToWei(5)
+	monitor.CheckBalances(context.Background())
+	require.Equal(t, BalanceOK, monitor.Level(proposer))
+	require.Equal(t, BalanceOK, changes[len(changes)-1])
+
+	// failing to fetch a balance retains the last level
+	delete(client.balances, proposer)
+	monitor.CheckBalances(context.Background())
+	require.Equal(t, BalanceOK, monitor.Level(proposer))
+}
+
+func TestBalanceThresholdsConfig(t *testing.T) {
+	cfg := DefaultCLIConfig()
+	require.Equal(t, BalanceThresholds{}, cfg.BalanceThresholds())
+
+	cfg.Enabled = true
+	cfg.BalanceWarningThreshold = 1.5
+	cfg.BalanceCriticalThreshold = 0.5
+	require.NoError(t, cfg.Check())
+	require.Equal(t, BalanceThresholds{
+		Warning:  big.NewInt(1_500_000_000_000_000_000),
+		Critical: big.NewInt(500_000_000_000_000_000),
+	}, cfg.BalanceThresholds())
+
+	cfg.BalanceCriticalThreshold = 2
+	require.ErrorContains(t, cfg.Check(), "critical balance threshold")
+	cfg.BalanceCriticalThreshold = -1
+	require.ErrorContains(t, cfg.Check(), "negative")
+}
@@ -10,11 +10,13 @@ import (
 )
 
 const (
-	EnabledFlagName    = "metrics.enabled"
-	ListenAddrFlagName = "metrics.addr"
-	PortFlagName       = "metrics.port"
-	defaultListenAddr  = "0.0.0.0"
-	defaultListenPort  = 7300
+	EnabledFlagName                  = "metrics.enabled"
+	ListenAddrFlagName               = "metrics.addr"
+	PortFlagName                     = "metrics.port"
+	BalanceWarningThresholdFlagName  = "metrics.balance.warning-threshold"
+	BalanceCriticalThresholdFlagName = "metrics.balance.critical-threshold"
+	defaultListenAddr                = "0.0.0.0"
+	defaultListenPort                = 7300
 )
 
 func DefaultCLIConfig() CLIConfig {
@@ -44,6 +46,16 @@ func CLIFlags(envPrefix string) []cli.Flag {
 			Value:   defaultListenPort,
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "METRICS_PORT"),
 		},
+		&cli.Float64Flag{
+			Name:    BalanceWarningThresholdFlagName,
+			Usage:   "Balance (in ether) of the signer account below which a warning is logged. Disabled if 0.",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "METRICS_BALANCE_WARNING_THRESHOLD"),
+		},
+		&cli.Float64Flag{
+			Name:    BalanceCriticalThresholdFlagName,
+			Usage:   "Balance (in ether) of the signer account below which an error is logged. Disabled if 0.",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "METRICS_BALANCE_CRITICAL_THRESHOLD"),
+		},
 	}
 }
 
@@ -51,6 +63,22 @@ type CLIConfig struct {
 	Enabled    bool
 	ListenAddr string
 	ListenPort int
+
+	// BalanceWarningThreshold and BalanceCriticalThreshold are in ether, thresholds are disabled if 0.
+	BalanceWarningThreshold  float64
+	BalanceCriticalThreshold float64
+}
+
+// BalanceThresholds returns the configured balance thresholds of the signer account.
+func (m CLIConfig) BalanceThresholds() BalanceThresholds {
+	var t BalanceThresholds
+	if m.BalanceWarningThreshold > 0 {
+		t.Warning = etherToWei(m.BalanceWarningThreshold)
+	}
+	if m.BalanceCriticalThreshold > 0 {
+		t.Critical = etherToWei(m.BalanceCriticalThreshold)
+	}
+	return t
 }
 
 func (m CLIConfig) Check() error {
@@ -58,6 +86,13 @@ func (m CLIConfig) Check() error {
 		return nil
 	}
 
+	if m.BalanceWarningThreshold < 0 || m.BalanceCriticalThreshold < 0 {
+		return errors.New("balance thresholds must not be negative")
+	}
+	if t := m.BalanceThresholds(); t.Warning != nil && t.Critical != nil && t.Critical.Cmp(t.Warning) > 0 {
+		return errors.New("critical balance threshold must not be larger than the warning threshold")
+	}
+
 	if m.ListenPort < 0 || m.ListenPort > math.MaxUint16 {
 		return errors.New("invalid metrics port")
 	}
@@ -67,8 +102,10 @@ func (m CLIConfig) Check() error {
 
 func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 	return CLIConfig{
-		Enabled:    ctx.Bool(EnabledFlagName),
-		ListenAddr: ctx.String(ListenAddrFlagName),
-		ListenPort: ctx.Int(PortFlagName),
+		Enabled:                  ctx.Bool(EnabledFlagName),
+		ListenAddr:               ctx.String(ListenAddrFlagName),
+		ListenPort:               ctx.Int(PortFlagName),
+		BalanceWarningThreshold:  ctx.Float64(BalanceWarningThresholdFlagName),
+		BalanceCriticalThreshold: ctx.Float64(BalanceCriticalThresholdFlagName),
 	}
 }
@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// EventBusMetrics implements the Metrics interface in the eventbus package.
+type EventBusMetrics struct {
+	PublishedVec *prometheus.CounterVec
+	DroppedVec   *prometheus.CounterVec
+	QueueLenVec  *prometheus.GaugeVec
+}
+
+func (m *EventBusMetrics) RecordEventPublished(topic string) {
+	m.PublishedVec.WithLabelValues(topic).Inc()
+}
+
+func (m *EventBusMetrics) RecordEventDropped(topic string) {
+	m.DroppedVec.WithLabelValues(topic).Inc()
+}
+
+func (m *EventBusMetrics) RecordQueueLength(topic string, subscriber string, length int) {
+	m.QueueLenVec.WithLabelValues(topic, subscriber).Set(float64(length))
+}
+
+func NewEventBusMetrics(factory Factory, ns string, subsystem string) *EventBusMetrics {
+	return &EventBusMetrics{
+		PublishedVec: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: subsystem,
+			Name:      "events_published_total",
+			Help:      "Count of events published to the event bus, per topic",
+		}, []string{
+			"topic",
+		}),
+		DroppedVec: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: subsystem,
+			Name:      "events_dropped_total",
+			Help:      "Count of events dropped because a subscriber queue was full, per topic",
+		}, []string{
+			"topic",
+		}),
+		QueueLenVec: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Subsystem: subsystem,
+			Name:      "queue_length",
+			Help:      "Number of events queued for a subscriber",
+		}, []string{
+			"topic",
+			"subscriber",
+		}),
+	}
+}
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// QueueMetrics implements the Metrics interface in the queue package,
+// implementing reusable metrics for different persistent queues.
+type QueueMetrics struct {
+	PendingVec  *prometheus.GaugeVec
+	InFlightVec *prometheus.GaugeVec
+	ItemsVec    *prometheus.CounterVec
+}
+
+func (m *QueueMetrics) RecordQueueLength(name string, pending int, inFlight int) {
+	m.PendingVec.WithLabelValues(name).Set(float64(pending))
+	m.InFlightVec.WithLabelValues(name).Set(float64(inFlight))
+}
+
+func (m *QueueMetrics) RecordItemPushed(name string) {
+	m.ItemsVec.WithLabelValues(name, "pushed").Inc()
+}
+
+func (m *QueueMetrics) RecordItemAcked(name string) {
+	m.ItemsVec.WithLabelValues(name, "acked").Inc()
+}
+
+func (m *QueueMetrics) RecordItemRequeued(name string) {
+	m.ItemsVec.WithLabelValues(name, "requeued").Inc()
+}
+
+func NewQueueMetrics(factory Factory, ns string, subsystem string) *QueueMetrics {
+	return &QueueMetrics{
+		PendingVec: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Subsystem: subsystem,
+			Name:      "queue_pending",
+			Help:      "Number of queue items waiting for delivery",
+		}, []string{
+			"queue",
+		}),
+		InFlightVec: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: ns,
+			Subsystem: subsystem,
+			Name:      "queue_in_flight",
+			Help:      "Number of delivered queue items that are not yet acknowledged",
+		}, []string{
+			"queue",
+		}),
+		ItemsVec: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: ns,
+			Subsystem: subsystem,
+			Name:      "queue_items_total",
+			Help:      "Count of queue item operations, by type of operation",
+		}, []string{
+			"queue",
+			"op",
+		}),
+	}
+}
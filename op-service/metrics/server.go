@@ -9,10 +9,15 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-func StartServer(r *prometheus.Registry, hostname string, port int) (*httputil.HTTPServer, error) {
+// maxRequestBodySize limits the size of requests to the metrics server, metrics are only scraped
+const maxRequestBodySize = 1024 * 1024
+
+// StartServer serves the metrics of the registry, with the given HTTP server options.
+func StartServer(r *prometheus.Registry, hostname string, port int, opts ...httputil.HTTPOption) (*httputil.HTTPServer, error) {
 	addr := net.JoinHostPort(hostname, strconv.Itoa(port))
 	h := promhttp.InstrumentMetricHandler(
 		r, promhttp.HandlerFor(r, promhttp.HandlerOpts{}),
 	)
-	return httputil.StartHTTPServer(addr, h)
+	opts = append([]httputil.HTTPOption{httputil.WithMaxRequestBodySize(maxRequestBodySize)}, opts...)
+	return httputil.StartHTTPServer(addr, h, opts...)
 }
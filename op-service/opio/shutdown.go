@@ -0,0 +1,122 @@
+package opio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// StopFn stops a subsystem. The context carries the drain deadline of the shutdown:
+// implementations should abort any remaining cleanup work once it is done.
+type StopFn func(ctx context.Context) error
+
+// SubsystemBlockedError is returned when a subsystem did not stop before the drain deadline.
+type SubsystemBlockedError struct {
+	Name string
+	Err  error
+}
+
+func (e *SubsystemBlockedError) Error() string {
+	return fmt.Sprintf("subsystem %q blocked shutdown: %v", e.Name, e.Err)
+}
+
+func (e *SubsystemBlockedError) Unwrap() error {
+	return e.Err
+}
+
+type subsystem struct {
+	name string
+	stop StopFn
+}
+
+// Shutdown coordinates the graceful shutdown of a service composed of multiple subsystems.
+// Subsystems are stopped in the reverse order of registration,
+// so that a dependency registered first is stopped after the subsystems that use it.
+type Shutdown struct {
+	log log.Logger
+
+	mu         sync.Mutex
+	subsystems []subsystem
+	stopped    bool
+}
+
+// NewShutdown creates a new shutdown coordinator.
+func NewShutdown(log log.Logger) *Shutdown {
+	return &Shutdown{log: log}
+}
+
+// Register adds a subsystem with the given name and stop function.
+// Registering after Stop has been called has no effect: the subsystem will not be stopped.
+func (s *Shutdown) Register(name string, stop StopFn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		s.log.Warn("Ignoring subsystem registered after shutdown", "subsystem", name)
+		return
+	}
+	s.subsystems = append(s.subsystems, subsystem{name: name, stop: stop})
+}
+
+// RegisterCloser is a convenience wrapper around Register for subsystems that stop without a context.
+func (s *Shutdown) RegisterCloser(name string, stop func() error) {
+	s.Register(name, func(ctx context.Context) error {
+		return stop()
+	})
+}
+
+// Stop stops all registered subsystems in reverse registration order.
+// Each subsystem is given the same ctx, which carries the drain deadline.
+// If the deadline is exceeded while a subsystem is stopping, Stop returns a *SubsystemBlockedError
+// naming that subsystem, without waiting for it or stopping the remaining subsystems.
+// Errors of subsystems that stopped in time are joined and returned.
+// Stop may only be called once; subsequent calls return nil.
+func (s *Shutdown) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return nil
+	}
+	s.stopped = true
+	subsystems := s.subsystems
+	s.subsystems = nil
+	s.mu.Unlock()
+
+	var result error
+	for i := len(subsystems) - 1; i >= 0; i-- {
+		sub := subsystems[i]
+		s.log.Info("Stopping subsystem", "subsystem", sub.name)
+		start := time.Now()
+		done := make(chan error, 1)
+		go func() {
+			done <- sub.stop(ctx)
+		}()
+		select {
+		case err := <-done:
+			if err != nil {
+				s.log.Error("Failed to stop subsystem", "subsystem", sub.name, "err", err)
+				result = errors.Join(result, fmt.Errorf("failed to stop %s: %w", sub.name, err))
+			} else {
+				s.log.Info("Stopped subsystem", "subsystem", sub.name, "duration", time.Since(start))
+			}
+		case <-ctx.Done():
+			s.log.Error("Subsystem blocked shutdown", "subsystem", sub.name, "duration", time.Since(start))
+			return errors.Join(result, &SubsystemBlockedError{Name: sub.name, Err: ctx.Err()})
+		}
+	}
+	return result
+}
+
+// BlockAndStop blocks until an interrupt signal is received, or the context is closed,
+// and then stops all subsystems within the given drain timeout.
+// Passing in signals will override the default signals.
+func (s *Shutdown) BlockAndStop(ctx context.Context, drainTimeout time.Duration, signals ...os.Signal) error {
+	BlockOnInterruptsContext(ctx, signals...)
+	stopCtx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+	return s.Stop(stopCtx)
+}
@@ -0,0 +1,80 @@
+package opio
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestShutdownReverseOrder(t *testing.T) {
+	s := NewShutdown(testlog.Logger(t, log.LvlInfo))
+	var order []string
+	for _, name := range []string{"a", "b", "c"} {
+		name := name
+		s.Register(name, func(ctx context.Context) error {
+			order = append(order, name)
+			return nil
+		})
+	}
+	require.NoError(t, s.Stop(context.Background()))
+	require.Equal(t, []string{"c", "b", "a"}, order)
+
+	// second stop is a no-op
+	require.NoError(t, s.Stop(context.Background()))
+	require.Len(t, order, 3)
+}
+
+func TestShutdownJoinsErrors(t *testing.T) {
+	s := NewShutdown(testlog.Logger(t, log.LvlInfo))
+	errA := errors.New("a failed")
+	stoppedB := false
+	s.Register("a", func(ctx context.Context) error { return errA })
+	s.RegisterCloser("b", func() error {
+		stoppedB = true
+		return nil
+	})
+	err := s.Stop(context.Background())
+	require.ErrorIs(t, err, errA)
+	require.True(t, stoppedB, "subsystems after a failing one are still stopped")
+}
+
+func TestShutdownReportsBlockedSubsystem(t *testing.T) {
+	s := NewShutdown(testlog.Logger(t, log.LvlInfo))
+	stoppedFirst := false
+	s.Register("first", func(ctx context.Context) error {
+		stoppedFirst = true
+		return nil
+	})
+	release := make(chan struct{})
+	defer close(release)
+	s.Register("stuck", func(ctx context.Context) error {
+		<-release
+		return nil
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := s.Stop(ctx)
+	var blocked *SubsystemBlockedError
+	require.ErrorAs(t, err, &blocked)
+	require.Equal(t, "stuck", blocked.Name)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.False(t, stoppedFirst, "remaining subsystems are not stopped after the deadline")
+}
+
+func TestShutdownIgnoresLateRegistration(t *testing.T) {
+	s := NewShutdown(testlog.Logger(t, log.LvlInfo))
+	require.NoError(t, s.Stop(context.Background()))
+	called := false
+	s.Register("late", func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+	require.NoError(t, s.Stop(context.Background()))
+	require.False(t, called)
+}
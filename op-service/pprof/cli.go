@@ -2,25 +2,32 @@ package pprof
 
 import (
 	"errors"
+	"fmt"
 	"math"
+	"net/url"
+	"time"
 
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 	"github.com/urfave/cli/v2"
 )
 
 const (
-	EnabledFlagName    = "pprof.enabled"
-	ListenAddrFlagName = "pprof.addr"
-	PortFlagName       = "pprof.port"
-	defaultListenAddr  = "0.0.0.0"
-	defaultListenPort  = 6060
+	EnabledFlagName      = "pprof.enabled"
+	ListenAddrFlagName   = "pprof.addr"
+	PortFlagName         = "pprof.port"
+	PushEndpointFlagName = "pprof.push.endpoint"
+	PushIntervalFlagName = "pprof.push.interval"
+	defaultListenAddr    = "0.0.0.0"
+	defaultListenPort    = 6060
+	defaultPushInterval  = 60 * time.Second
 )
 
 func DefaultCLIConfig() CLIConfig {
 	return CLIConfig{
-		Enabled:    false,
-		ListenAddr: defaultListenAddr,
-		ListenPort: defaultListenPort,
+		Enabled:      false,
+		ListenAddr:   defaultListenAddr,
+		ListenPort:   defaultListenPort,
+		PushInterval: defaultPushInterval,
 	}
 }
 
@@ -43,6 +50,17 @@ func CLIFlags(envPrefix string) []cli.Flag {
 			Value:   defaultListenPort,
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "PPROF_PORT"),
 		},
+		&cli.StringFlag{
+			Name:    PushEndpointFlagName,
+			Usage:   "Pyroscope compatible endpoint to periodically push CPU, heap and goroutine profiles to. Disabled if empty.",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "PPROF_PUSH_ENDPOINT"),
+		},
+		&cli.DurationFlag{
+			Name:    PushIntervalFlagName,
+			Usage:   "Interval of the profiles pushed to the pprof push endpoint",
+			Value:   defaultPushInterval,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "PPROF_PUSH_INTERVAL"),
+		},
 	}
 }
 
@@ -50,9 +68,31 @@ type CLIConfig struct {
 	Enabled    bool
 	ListenAddr string
 	ListenPort int
+
+	// PushEndpoint is the base URL of the server that profiles are pushed to, profiles are not pushed if empty.
+	PushEndpoint string
+	PushInterval time.Duration
+}
+
+// PushEnabled returns true if profiles should be pushed to an endpoint.
+func (m CLIConfig) PushEnabled() bool {
+	return m.PushEndpoint != ""
 }
 
 func (m CLIConfig) Check() error {
+	if m.PushEnabled() {
+		u, err := url.Parse(m.PushEndpoint)
+		if err != nil {
+			return fmt.Errorf("invalid pprof push endpoint: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("invalid pprof push endpoint scheme %q", u.Scheme)
+		}
+		if m.PushInterval < time.Second {
+			return errors.New("pprof push interval must be at least 1s")
+		}
+	}
+
 	if !m.Enabled {
 		return nil
 	}
@@ -66,8 +106,10 @@ func (m CLIConfig) Check() error {
 
 func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 	return CLIConfig{
-		Enabled:    ctx.Bool(EnabledFlagName),
-		ListenAddr: ctx.String(ListenAddrFlagName),
-		ListenPort: ctx.Int(PortFlagName),
+		Enabled:      ctx.Bool(EnabledFlagName),
+		ListenAddr:   ctx.String(ListenAddrFlagName),
+		ListenPort:   ctx.Int(PortFlagName),
+		PushEndpoint: ctx.String(PushEndpointFlagName),
+		PushInterval: ctx.Duration(PushIntervalFlagName),
 	}
 }
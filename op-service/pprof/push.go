@@ -0,0 +1,225 @@
+package pprof
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"runtime/pprof"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ProfileType identifies a runtime profile that is pushed by the Pusher.
+type ProfileType string
+
+const (
+	CPUProfile       ProfileType = "cpu"
+	HeapProfile      ProfileType = "heap"
+	GoroutineProfile ProfileType = "goroutine"
+)
+
+// PushProfiles are the profiles that are pushed by default.
+var PushProfiles = []ProfileType{CPUProfile, HeapProfile, GoroutineProfile}
+
+const pushTimeout = 10 * time.Second
+
+// Pusher periodically collects runtime profiles of the process,
+// and pushes them to a profiling server that implements the Pyroscope ingest API.
+//
+// The CPU profile covers the complete push interval,
+// all other profiles are snapshots taken at the end of each interval.
+type Pusher struct {
+	log      log.Logger
+	endpoint string
+	interval time.Duration
+	name     string
+	labels   map[string]string
+	profiles []ProfileType
+	client   *http.Client
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPusher creates a Pusher that pushes the profiles of the given service to the endpoint.
+// The service name and version are attached as labels to all pushed profiles.
+func NewPusher(logger log.Logger, endpoint string, interval time.Duration, service string, version string, profiles ...ProfileType) *Pusher {
+	if len(profiles) == 0 {
+		profiles = PushProfiles
+	}
+	return &Pusher{
+		log:      logger,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		interval: interval,
+		name:     service,
+		labels: map[string]string{
+			"service_name": service,
+			"version":      version,
+		},
+		profiles: profiles,
+		client:   &http.Client{Timeout: pushTimeout},
+	}
+}
+
+// StartPusher starts pushing profiles as configured. Returns nil if pushing profiles is not enabled.
+func StartPusher(logger log.Logger, cfg CLIConfig, service string, version string) *Pusher {
+	if !cfg.PushEnabled() {
+		return nil
+	}
+	p := NewPusher(logger, cfg.PushEndpoint, cfg.PushInterval, service, version)
+	p.Start()
+	logger.Info("pushing profiles", "endpoint", cfg.PushEndpoint, "interval", cfg.PushInterval)
+	return p
+}
+
+// Start starts collecting and pushing profiles in the background, until Stop is called.
+func (p *Pusher) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	p.wg.Add(1)
+	go p.loop(ctx)
+}
+
+// Stop stops collecting profiles, and waits for any in-progress push to complete.
+// Profiles of the last, incomplete, interval are dropped.
+func (p *Pusher) Stop(ctx context.Context) error {
+	if p.cancel == nil {
+		return nil
+	}
+	p.cancel()
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *Pusher) loop(ctx context.Context) {
+	defer p.wg.Done()
+	for {
+		if err := p.pushInterval(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			p.log.Warn("failed to push profiles", "err", err)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// pushInterval collects the profiles of a single interval and pushes them.
+func (p *Pusher) pushInterval(ctx context.Context) error {
+	from := time.Now()
+	var cpu bytes.Buffer
+	cpuActive := false
+	if p.has(CPUProfile) {
+		// CPU profiling fails if it is already active, e.g. through the pprof server.
+		// Continue with the other profiles in that case.
+		if err := pprof.StartCPUProfile(&cpu); err != nil {
+			p.log.Debug("skipping CPU profile", "err", err)
+		} else {
+			cpuActive = true
+		}
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(p.interval):
+	}
+	if cpuActive {
+		pprof.StopCPUProfile()
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	until := time.Now()
+
+	var result error
+	for _, typ := range p.profiles {
+		var data []byte
+		if typ == CPUProfile {
+			if !cpuActive {
+				continue
+			}
+			data = cpu.Bytes()
+		} else {
+			prof := pprof.Lookup(string(typ))
+			if prof == nil {
+				return fmt.Errorf("unknown profile %q", typ)
+			}
+			var buf bytes.Buffer
+			if err := prof.WriteTo(&buf, 0); err != nil {
+				return fmt.Errorf("failed to write %s profile: %w", typ, err)
+			}
+			data = buf.Bytes()
+		}
+		if err := p.push(ctx, typ, from, until, data); err != nil {
+			// keep pushing the other profiles
+			result = err
+		}
+	}
+	return result
+}
+
+func (p *Pusher) has(typ ProfileType) bool {
+	for _, t := range p.profiles {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Pusher) push(ctx context.Context, typ ProfileType, from, until time.Time, data []byte) error {
+	q := url.Values{}
+	q.Set("name", p.appName(typ))
+	q.Set("from", strconv.FormatInt(from.Unix(), 10))
+	q.Set("until", strconv.FormatInt(until.Unix(), 10))
+	q.Set("format", "pprof")
+	q.Set("spyName", "gospy")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/ingest?"+q.Encode(), bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create %s profile request: %w", typ, err)
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to push %s profile: %w", typ, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("failed to push %s profile: status %d: %s", typ, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// appName encodes the application name, profile type and labels as expected by the ingest API,
+// e.g. "op-node.cpu{service_name=op-node,version=v1.0.0}".
+func (p *Pusher) appName(typ ProfileType) string {
+	keys := make([]string, 0, len(p.labels))
+	for k := range p.labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	labels := make([]string, 0, len(keys))
+	for _, k := range keys {
+		labels = append(labels, k+"="+p.labels[k])
+	}
+	return fmt.Sprintf("%s.%s{%s}", p.name, typ, strings.Join(labels, ","))
+}
@@ -0,0 +1,110 @@
+package pprof
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+type pushed struct {
+	name   string
+	format string
+	size   int
+}
+
+type ingestServer struct {
+	mu     sync.Mutex
+	pushes []pushed
+	status int
+}
+
+func (s *ingestServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/ingest" || r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pushes = append(s.pushes, pushed{
+		name:   r.URL.Query().Get("name"),
+		format: r.URL.Query().Get("format"),
+		size:   len(body),
+	})
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+	}
+}
+
+func (s *ingestServer) names() map[string]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[string]int)
+	for _, p := range s.pushes {
+		out[p.name]++
+	}
+	return out
+}
+
+func TestPusher(t *testing.T) {
+	ingest := &ingestServer{}
+	srv := httptest.NewServer(ingest)
+	defer srv.Close()
+
+	p := NewPusher(testlog.Logger(t, log.LvlInfo), srv.URL+"/", 50*time.Millisecond, "op-test", "v1.2.3")
+	p.Start()
+	require.Eventually(t, func() bool {
+		names := ingest.names()
+		return names["op-test.cpu{service_name=op-test,version=v1.2.3}"] >= 2 &&
+			names["op-test.heap{service_name=op-test,version=v1.2.3}"] >= 2 &&
+			names["op-test.goroutine{service_name=op-test,version=v1.2.3}"] >= 2
+	}, 10*time.Second, 10*time.Millisecond)
+	require.NoError(t, p.Stop(context.Background()))
+
+	ingest.mu.Lock()
+	defer ingest.mu.Unlock()
+	for _, push := range ingest.pushes {
+		require.Equal(t, "pprof", push.format)
+		require.NotZero(t, push.size)
+	}
+}
+
+func TestPusherKeepsPushingOnError(t *testing.T) {
+	ingest := &ingestServer{status: http.StatusInternalServerError}
+	srv := httptest.NewServer(ingest)
+	defer srv.Close()
+
+	p := NewPusher(testlog.Logger(t, log.LvlCrit), srv.URL, 20*time.Millisecond, "op-test", "v0.0.0", HeapProfile)
+	p.Start()
+	require.Eventually(t, func() bool {
+		return ingest.names()["op-test.heap{service_name=op-test,version=v0.0.0}"] >= 3
+	}, 10*time.Second, 10*time.Millisecond)
+	require.NoError(t, p.Stop(context.Background()))
+	require.NotContains(t, ingest.names(), "op-test.cpu{service_name=op-test,version=v0.0.0}")
+}
+
+func TestStartPusherDisabled(t *testing.T) {
+	require.Nil(t, StartPusher(testlog.Logger(t, log.LvlInfo), DefaultCLIConfig(), "op-test", "v0.0.0"))
+}
+
+func TestCheckPushConfig(t *testing.T) {
+	cfg := DefaultCLIConfig()
+	cfg.PushEndpoint = "http://localhost:4040"
+	require.NoError(t, cfg.Check())
+
+	cfg.PushEndpoint = "localhost:4040"
+	require.ErrorContains(t, cfg.Check(), "scheme")
+
+	cfg.PushEndpoint = "https://localhost:4040"
+	cfg.PushInterval = time.Millisecond
+	require.ErrorContains(t, cfg.Check(), "interval")
+}
@@ -0,0 +1,247 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+)
+
+const itemExt = ".item"
+
+var (
+	ErrClosed      = errors.New("queue closed")
+	ErrNotInFlight = errors.New("item is not in flight")
+)
+
+type Metrics interface {
+	RecordQueueLength(name string, pending int, inFlight int)
+	RecordItemPushed(name string)
+	RecordItemAcked(name string)
+	RecordItemRequeued(name string)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) RecordQueueLength(name string, pending int, inFlight int) {}
+
+func (noopMetrics) RecordItemPushed(name string) {}
+
+func (noopMetrics) RecordItemAcked(name string) {}
+
+func (noopMetrics) RecordItemRequeued(name string) {}
+
+// NoopMetrics can be used when the queue does not need to be metered.
+var NoopMetrics Metrics = noopMetrics{}
+
+// Item is an entry of the queue.
+type Item struct {
+	// ID identifies the item, and is used to acknowledge it. IDs increase in FIFO order.
+	ID   uint64
+	Data []byte
+}
+
+// Queue is a crash-safe, disk-backed FIFO queue with at-least-once delivery.
+//
+// Every item is stored in its own file in the queue directory, written atomically.
+// Items that are popped remain on disk until they are acknowledged with Ack:
+// items that were in flight when the process stopped are delivered again after reopening the queue.
+// A queue directory must only be opened by a single Queue at a time.
+type Queue struct {
+	dir  string
+	name string
+	m    Metrics
+
+	mu       sync.Mutex
+	pending  []uint64 // sorted IDs of items that are ready for delivery
+	inFlight map[uint64]struct{}
+	nextID   uint64
+	closed   bool
+
+	// notify is signaled whenever an item becomes ready for delivery, or the queue is closed
+	notify chan struct{}
+}
+
+// Open opens the queue stored in the given directory, creating the directory if it does not exist.
+// The name identifies the queue in metrics.
+func Open(dir string, name string, m Metrics) (*Queue, error) {
+	if m == nil {
+		m = NoopMetrics
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create queue dir: %w", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read queue dir: %w", err)
+	}
+	q := &Queue{
+		dir:      dir,
+		name:     name,
+		m:        m,
+		inFlight: make(map[uint64]struct{}),
+		notify:   make(chan struct{}, 1),
+	}
+	for _, entry := range entries {
+		fileName := entry.Name()
+		if strings.HasSuffix(fileName, ioutil.AtomicTempExt) {
+			// left-over of an interrupted write, the item was never pushed
+			if err := os.Remove(filepath.Join(dir, fileName)); err != nil {
+				return nil, fmt.Errorf("failed to remove incomplete item %q: %w", fileName, err)
+			}
+			continue
+		}
+		if !strings.HasSuffix(fileName, itemExt) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(fileName, itemExt), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid queue item file name %q: %w", fileName, err)
+		}
+		q.pending = append(q.pending, id)
+		if id >= q.nextID {
+			q.nextID = id + 1
+		}
+	}
+	sort.Slice(q.pending, func(i, j int) bool { return q.pending[i] < q.pending[j] })
+	q.recordLength()
+	if len(q.pending) > 0 {
+		q.signal()
+	}
+	return q, nil
+}
+
+func (q *Queue) itemPath(id uint64) string {
+	// zero-padded, so files sort in FIFO order in directory listings
+	return filepath.Join(q.dir, fmt.Sprintf("%020d%s", id, itemExt))
+}
+
+// Push durably appends an item to the queue, and returns its ID.
+func (q *Queue) Push(data []byte) (uint64, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return 0, ErrClosed
+	}
+	id := q.nextID
+	path := q.itemPath(id)
+	if err := ioutil.WriteFileAtomic(path, data, 0o644); err != nil {
+		return 0, fmt.Errorf("failed to write queue item %d: %w", id, err)
+	}
+	q.nextID++
+	q.pending = append(q.pending, id)
+	q.m.RecordItemPushed(q.name)
+	q.recordLength()
+	q.signal()
+	return id, nil
+}
+
+// Pop blocks until an item is available, the queue is closed or the ctx is done.
+// The item stays in the queue until it is acknowledged with Ack, or returned to the queue with Requeue.
+func (q *Queue) Pop(ctx context.Context) (Item, error) {
+	for {
+		item, ok, err := q.TryPop()
+		if err != nil || ok {
+			return item, err
+		}
+		select {
+		case <-q.notify:
+		case <-ctx.Done():
+			return Item{}, ctx.Err()
+		}
+	}
+}
+
+// TryPop returns the next item if one is available, without blocking.
+func (q *Queue) TryPop() (Item, bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		q.signal() // wake up any other blocked Pop calls
+		return Item{}, false, ErrClosed
+	}
+	if len(q.pending) == 0 {
+		return Item{}, false, nil
+	}
+	id := q.pending[0]
+	data, err := os.ReadFile(q.itemPath(id))
+	if err != nil {
+		return Item{}, false, fmt.Errorf("failed to read queue item %d: %w", id, err)
+	}
+	q.pending = q.pending[1:]
+	q.inFlight[id] = struct{}{}
+	q.recordLength()
+	if len(q.pending) > 0 {
+		q.signal()
+	}
+	return Item{ID: id, Data: data}, true, nil
+}
+
+// Ack acknowledges the processing of an in-flight item, and permanently removes it from the queue.
+func (q *Queue) Ack(id uint64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, ok := q.inFlight[id]; !ok {
+		return fmt.Errorf("%w: %d", ErrNotInFlight, id)
+	}
+	if err := os.Remove(q.itemPath(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove queue item %d: %w", id, err)
+	}
+	delete(q.inFlight, id)
+	q.m.RecordItemAcked(q.name)
+	q.recordLength()
+	return nil
+}
+
+// Requeue returns an in-flight item to the queue, to be delivered again in its original FIFO position.
+func (q *Queue) Requeue(id uint64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, ok := q.inFlight[id]; !ok {
+		return fmt.Errorf("%w: %d", ErrNotInFlight, id)
+	}
+	delete(q.inFlight, id)
+	i := sort.Search(len(q.pending), func(i int) bool { return q.pending[i] >= id })
+	q.pending = append(q.pending, 0)
+	copy(q.pending[i+1:], q.pending[i:])
+	q.pending[i] = id
+	q.m.RecordItemRequeued(q.name)
+	q.recordLength()
+	q.signal()
+	return nil
+}
+
+// Len returns the number of items that are waiting for delivery, and the number of items in flight.
+func (q *Queue) Len() (pending int, inFlight int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending), len(q.inFlight)
+}
+
+// Close closes the queue. Blocked Pop calls return ErrClosed.
+// Items that are in flight are not acknowledged, and will be delivered again when the queue is reopened.
+func (q *Queue) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	q.signal()
+	return nil
+}
+
+func (q *Queue) signal() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (q *Queue) recordLength() {
+	q.m.RecordQueueLength(q.name, len(q.pending), len(q.inFlight))
+}
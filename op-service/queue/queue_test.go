@@ -0,0 +1,122 @@
+package queue
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushPopAck(t *testing.T) {
+	q, err := Open(t.TempDir(), "test", nil)
+	require.NoError(t, err)
+	defer q.Close()
+
+	for _, v := range []string{"a", "b", "c"} {
+		_, err := q.Push([]byte(v))
+		require.NoError(t, err)
+	}
+	for i, v := range []string{"a", "b", "c"} {
+		item, err := q.Pop(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, uint64(i), item.ID)
+		require.Equal(t, v, string(item.Data))
+		require.NoError(t, q.Ack(item.ID))
+	}
+	pending, inFlight := q.Len()
+	require.Zero(t, pending)
+	require.Zero(t, inFlight)
+	require.ErrorIs(t, q.Ack(0), ErrNotInFlight)
+}
+
+func TestRedeliverAfterReopen(t *testing.T) {
+	dir := t.TempDir()
+	q, err := Open(dir, "test", nil)
+	require.NoError(t, err)
+	for _, v := range []string{"a", "b", "c"} {
+		_, err := q.Push([]byte(v))
+		require.NoError(t, err)
+	}
+	first, err := q.Pop(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, q.Ack(first.ID))
+	// popped but never acknowledged, e.g. a crash during processing
+	_, err = q.Pop(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, q.Close())
+
+	// simulate an interrupted write
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "00000000000000000003.item.tmp"), []byte("partial"), 0o644))
+
+	q, err = Open(dir, "test", nil)
+	require.NoError(t, err)
+	defer q.Close()
+	pending, inFlight := q.Len()
+	require.Equal(t, 2, pending)
+	require.Zero(t, inFlight)
+
+	item, err := q.Pop(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, Item{ID: 1, Data: []byte("b")}, item)
+
+	// IDs continue after the highest existing item
+	id, err := q.Push([]byte("d"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), id)
+	_, err = os.Stat(filepath.Join(dir, "00000000000000000003.item.tmp"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestRequeue(t *testing.T) {
+	q, err := Open(t.TempDir(), "test", nil)
+	require.NoError(t, err)
+	defer q.Close()
+	for _, v := range []string{"a", "b"} {
+		_, err := q.Push([]byte(v))
+		require.NoError(t, err)
+	}
+	a, err := q.Pop(context.Background())
+	require.NoError(t, err)
+	b, err := q.Pop(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, q.Requeue(b.ID))
+	require.NoError(t, q.Requeue(a.ID))
+
+	item, err := q.Pop(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, a, item, "requeued items keep their FIFO position")
+	require.ErrorIs(t, q.Requeue(42), ErrNotInFlight)
+}
+
+func TestPopBlocks(t *testing.T) {
+	q, err := Open(t.TempDir(), "test", nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = q.Pop(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	result := make(chan Item, 1)
+	go func() {
+		item, err := q.Pop(context.Background())
+		require.NoError(t, err)
+		result <- item
+	}()
+	_, err = q.Push([]byte("x"))
+	require.NoError(t, err)
+	require.Equal(t, "x", string((<-result).Data))
+
+	closed := make(chan error, 1)
+	go func() {
+		_, err := q.Pop(context.Background())
+		closed <- err
+	}()
+	require.NoError(t, q.Close())
+	require.ErrorIs(t, <-closed, ErrClosed)
+	_, err = q.Push([]byte("y"))
+	require.ErrorIs(t, err, ErrClosed)
+}
@@ -33,7 +33,7 @@ func (n *CommonAdminAPI) SetLogLevel(ctx context.Context, lvlStr string) error {
 	recordDur := n.M.RecordRPCServerRequest("admin_setLogLevel")
 	defer recordDur()
 
-	h := n.log.GetHandler()
+	h := n.logHandler()
 
 	lvl, err := log.LvlFromString(lvlStr)
 	if err != nil {
@@ -49,3 +49,55 @@ func (n *CommonAdminAPI) SetLogLevel(ctx context.Context, lvlStr string) error {
 	lvlSetter.SetLogLevel(lvl)
 	return nil
 }
+
+// SetModuleLogLevel overrides the log level of a single module. An empty level removes the override.
+func (n *CommonAdminAPI) SetModuleLogLevel(ctx context.Context, module string, lvlStr string) error {
+	recordDur := n.M.RecordRPCServerRequest("admin_setModuleLogLevel")
+	defer recordDur()
+
+	h := n.logHandler()
+	lvlSetter, ok := h.(oplog.ModuleLvlSetter)
+	if !ok {
+		return fmt.Errorf("log handler type %T cannot change module log levels", h)
+	}
+	if lvlStr == "" {
+		lvlSetter.ResetModuleLogLevel(module)
+		return nil
+	}
+	lvl, err := log.LvlFromString(lvlStr)
+	if err != nil {
+		return err
+	}
+	lvlSetter.SetModuleLogLevel(module, lvl)
+	return nil
+}
+
+// ModuleLogLevels returns the log level overrides per module.
+func (n *CommonAdminAPI) ModuleLogLevels(ctx context.Context) (map[string]string, error) {
+	recordDur := n.M.RecordRPCServerRequest("admin_moduleLogLevels")
+	defer recordDur()
+
+	h := n.logHandler()
+	lvlSetter, ok := h.(oplog.ModuleLvlSetter)
+	if !ok {
+		return nil, fmt.Errorf("log handler type %T does not support module log levels", h)
+	}
+	out := make(map[string]string)
+	for module, lvl := range lvlSetter.ModuleLogLevels() {
+		out[module] = lvl.String()
+	}
+	return out, nil
+}
+
+// logHandler returns the handler of the root logger. The handler of a child logger, e.g. a module logger,
+// only forwards to the handler of its parent, and cannot change the log levels itself.
+func (n *CommonAdminAPI) logHandler() log.Handler {
+	h := n.log.GetHandler()
+	for {
+		parent, ok := h.(interface{ Get() log.Handler })
+		if !ok {
+			return h
+		}
+		h = parent.Get()
+	}
+}
@@ -0,0 +1,31 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+
+	oplog "github.com/ethereum-optimism/optimism/op-service/log"
+	"github.com/ethereum-optimism/optimism/op-service/metrics"
+)
+
+func TestAdminAPIWithChildLogger(t *testing.T) {
+	var out bytes.Buffer
+	logger := oplog.NewLogger(&out, oplog.CLIConfig{Level: log.LvlInfo, Format: oplog.FormatText})
+	h := logger.GetHandler().(*oplog.DynamicLogHandler)
+	// services may pass a child logger, e.g. a module logger, which forwards to the root handler
+	api := NewCommonAdminAPI(&metrics.NoopRPCMetrics{}, oplog.Module(logger.New("service", "test"), "rpc"))
+
+	require.NoError(t, api.SetLogLevel(context.Background(), "debug"))
+	logger.Debug("shown")
+	require.Contains(t, out.String(), "shown")
+	require.NoError(t, api.SetModuleLogLevel(context.Background(), "p2p", "warn"))
+	levels, err := api.ModuleLogLevels(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"p2p": "warn"}, levels)
+	require.NoError(t, api.SetModuleLogLevel(context.Background(), "p2p", ""))
+	require.Empty(t, h.ModuleLogLevels())
+}
@@ -2,30 +2,46 @@ package rpc
 
 import (
 	"errors"
+	"fmt"
 	"math"
 
-	opservice "github.com/ethereum-optimism/optimism/op-service"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/urfave/cli/v2"
+
+	opservice "github.com/ethereum-optimism/optimism/op-service"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
 )
 
 const (
 	ListenAddrFlagName  = "rpc.addr"
 	PortFlagName        = "rpc.port"
 	EnableAdminFlagName = "rpc.enable-admin"
+	defaultListenAddr   = "0.0.0.0"
+	defaultListenPort   = 8545
+	// tlsFlagPrefix prefixes the TLS flags of the RPC server, e.g. rpc.tls.cert
+	tlsFlagPrefix = "rpc"
 )
 
+func DefaultCLIConfig() CLIConfig {
+	return CLIConfig{
+		ListenAddr:  defaultListenAddr,
+		ListenPort:  defaultListenPort,
+		EnableAdmin: false,
+	}
+}
+
 func CLIFlags(envPrefix string) []cli.Flag {
-	return []cli.Flag{
+	flags := []cli.Flag{
 		&cli.StringFlag{
 			Name:    ListenAddrFlagName,
 			Usage:   "rpc listening address",
-			Value:   "0.0.0.0", // TODO(CLI-4159): Switch to 127.0.0.1
+			Value:   defaultListenAddr, // TODO(CLI-4159): Switch to 127.0.0.1
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "RPC_ADDR"),
 		},
 		&cli.IntFlag{
 			Name:    PortFlagName,
 			Usage:   "rpc listening port",
-			Value:   8545,
+			Value:   defaultListenPort,
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "RPC_PORT"),
 		},
 		&cli.BoolFlag{
@@ -34,26 +50,46 @@ func CLIFlags(envPrefix string) []cli.Flag {
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "RPC_ENABLE_ADMIN"),
 		},
 	}
+	return append(flags, optls.OptionalCLIFlags(envPrefix, tlsFlagPrefix)...)
 }
 
 type CLIConfig struct {
 	ListenAddr  string
 	ListenPort  int
 	EnableAdmin bool
+	// TLS serves the RPC over HTTPS if set, and requires clients to present a certificate signed by the CA.
+	TLS optls.CLIConfig
 }
 
 func (c CLIConfig) Check() error {
 	if c.ListenPort < 0 || c.ListenPort > math.MaxUint16 {
 		return errors.New("invalid RPC port")
 	}
+	if err := c.TLS.Check(); err != nil {
+		return fmt.Errorf("invalid RPC tls config: %w", err)
+	}
 
 	return nil
 }
 
+// ServerTLSConfig returns the mutual TLS config of the server, or nil if TLS is not configured.
+// The result can be passed to WithTLSConfig either way.
+func (c CLIConfig) ServerTLSConfig(logger log.Logger) (*ServerTLSConfig, error) {
+	if !c.TLS.TLSEnabled() {
+		return nil, nil
+	}
+	config, err := optls.NewServerTLSConfig(logger, c.TLS, optls.RequireAndVerifyClientCert)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RPC server tls config: %w", err)
+	}
+	return &ServerTLSConfig{Config: config, CLIConfig: &c.TLS}, nil
+}
+
 func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 	return CLIConfig{
 		ListenAddr:  ctx.String(ListenAddrFlagName),
 		ListenPort:  ctx.Int(PortFlagName),
 		EnableAdmin: ctx.Bool(EnableAdminFlagName),
+		TLS:         optls.ReadCLIConfigWithPrefix(ctx, tlsFlagPrefix),
 	}
 }
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/ethereum-optimism/optimism/op-service/httputil"
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
 	optls "github.com/ethereum-optimism/optimism/op-service/tls"
@@ -21,6 +22,9 @@ import (
 
 var wildcardHosts = []string{"*"}
 
+// stopTimeout is the time that in-flight requests are allowed to complete in when the server stops
+const stopTimeout = 5 * time.Second
+
 type Server struct {
 	endpoint       string
 	apis           []rpc.API
@@ -32,7 +36,8 @@ type Server struct {
 	rpcPath        string
 	healthzPath    string
 	httpRecorder   opmetrics.HTTPRecorder
-	httpServer     *http.Server
+	httpOptions    []httputil.HTTPOption
+	httpServer     *httputil.HTTPServer
 	log            log.Logger
 	tls            *ServerTLSConfig
 	middlewares    []Middleware
@@ -101,8 +106,16 @@ func WithLogger(lgr log.Logger) ServerOption {
 	}
 }
 
+// WithHTTPOptions configures the underlying HTTP server,
+// e.g. to change its timeouts or to limit the request size.
+func WithHTTPOptions(opts ...httputil.HTTPOption) ServerOption {
+	return func(b *Server) {
+		b.httpOptions = append(b.httpOptions, opts...)
+	}
+}
+
 // WithTLSConfig configures TLS for the RPC server
-// If this option is passed, the server will use ListenAndServeTLS
+// If this option is passed, the server will serve HTTPS
 func WithTLSConfig(tls *ServerTLSConfig) ServerOption {
 	return func(b *Server) {
 		b.tls = tls
@@ -128,16 +141,14 @@ func NewServer(host string, port int, appVersion string, opts ...ServerOption) *
 		rpcPath:        "/",
 		healthzPath:    "/healthz",
 		httpRecorder:   opmetrics.NoopHTTPRecorder,
-		httpServer: &http.Server{
-			Addr: endpoint,
-		},
-		log: log.Root(),
+		httpOptions:    []httputil.HTTPOption{httputil.WithDrainTimeout(stopTimeout)},
+		log:            log.Root(),
 	}
 	for _, opt := range opts {
 		opt(bs)
 	}
 	if bs.tls != nil {
-		bs.httpServer.TLSConfig = bs.tls.Config
+		bs.httpOptions = append(bs.httpOptions, httputil.WithTLSConfig(bs.tls.Config))
 	}
 	bs.AddAPI(rpc.API{
 		Namespace: "health",
@@ -178,35 +189,23 @@ func (b *Server) Start() error {
 	handler = optls.NewPeerTLSMiddleware(handler)
 	handler = opmetrics.NewHTTPRecordingMiddleware(b.httpRecorder, handler)
 	handler = oplog.NewLoggingMiddleware(b.log, handler)
-	b.httpServer.Handler = handler
-
-	errCh := make(chan error, 1)
-	go func() {
-		if b.tls != nil {
-			if err := b.httpServer.ListenAndServeTLS("", ""); err != nil {
-				errCh <- err
-			}
-		} else {
-			if err := b.httpServer.ListenAndServe(); err != nil {
-				errCh <- err
-			}
-		}
-	}()
-
-	// verify that the server comes up
-	tick := time.NewTimer(10 * time.Millisecond)
-	select {
-	case err := <-errCh:
+
+	httpServer, err := httputil.StartHTTPServer(b.endpoint, handler, b.httpOptions...)
+	if err != nil {
 		return fmt.Errorf("http server failed: %w", err)
-	case <-tick.C:
-		return nil
 	}
+	b.httpServer = httpServer
+	return nil
 }
 
+// Stop stops the server, allowing in-flight requests to complete, or force-closes their connections after a timeout.
 func (b *Server) Stop() error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	if b.httpServer == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
 	defer cancel()
-	_ = b.httpServer.Shutdown(ctx)
+	_ = b.httpServer.Stop(ctx)
 	return nil
 }
 
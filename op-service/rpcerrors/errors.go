@@ -0,0 +1,164 @@
+// Package rpcerrors defines the JSON-RPC error codes that are shared by the RPC servers of the services,
+// so RPC clients can act on the category of an error without parsing the error message.
+package rpcerrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Code is a JSON-RPC error code. The codes are stable: they must not be changed once released.
+type Code int
+
+// The codes are picked from a range that is not used by the JSON-RPC spec, geth, or the engine API.
+const (
+	// CodeNotFound indicates the requested data, e.g. a block or output, does not exist (yet).
+	CodeNotFound Code = -39001
+	// CodeReorged indicates the requested data was dropped by a reorg while the request was served.
+	// Retrying the request may return the data of the new canonical chain.
+	CodeReorged Code = -39002
+	// CodeTemporarilyUnavailable indicates the service cannot serve the request right now, e.g. because it is busy
+	// or still starting. The request may be retried later.
+	CodeTemporarilyUnavailable Code = -39003
+	// CodeUnauthorized indicates the caller is not allowed to make the request.
+	CodeUnauthorized Code = -39004
+)
+
+// String returns the category of the code, which is also included as the data of the JSON-RPC error.
+func (c Code) String() string {
+	switch c {
+	case CodeNotFound:
+		return "not_found"
+	case CodeReorged:
+		return "reorged"
+	case CodeTemporarilyUnavailable:
+		return "temporarily_unavailable"
+	case CodeUnauthorized:
+		return "unauthorized"
+	default:
+		return fmt.Sprintf("unknown_%d", int(c))
+	}
+}
+
+// Error is an RPC error with a stable code.
+// It implements the rpc.Error and rpc.DataError interfaces, so RPC servers serve the code and category to clients.
+// Note that RPC servers only use the code of the returned error itself, not of the errors it wraps.
+type Error struct {
+	Code  Code
+	Inner error
+}
+
+var (
+	_ rpc.Error     = (*Error)(nil)
+	_ rpc.DataError = (*Error)(nil)
+)
+
+// New creates an error with the given code, wrapping the inner error.
+func New(code Code, inner error) *Error {
+	return &Error{Code: code, Inner: inner}
+}
+
+// NotFound creates an error with CodeNotFound, formatted like fmt.Errorf.
+func NotFound(format string, args ...any) *Error {
+	return New(CodeNotFound, fmt.Errorf(format, args...))
+}
+
+// Reorged creates an error with CodeReorged, formatted like fmt.Errorf.
+func Reorged(format string, args ...any) *Error {
+	return New(CodeReorged, fmt.Errorf(format, args...))
+}
+
+// TemporarilyUnavailable creates an error with CodeTemporarilyUnavailable, formatted like fmt.Errorf.
+func TemporarilyUnavailable(format string, args ...any) *Error {
+	return New(CodeTemporarilyUnavailable, fmt.Errorf(format, args...))
+}
+
+// Unauthorized creates an error with CodeUnauthorized, formatted like fmt.Errorf.
+func Unauthorized(format string, args ...any) *Error {
+	return New(CodeUnauthorized, fmt.Errorf(format, args...))
+}
+
+func (e *Error) Error() string {
+	if e.Inner == nil {
+		return e.Code.String()
+	}
+	return e.Inner.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Inner
+}
+
+func (e *Error) ErrorCode() int {
+	return int(e.Code)
+}
+
+func (e *Error) ErrorData() any {
+	return e.Code.String()
+}
+
+// Classify returns the error with a code, if the code can be derived from the error:
+// ethereum.NotFound errors are classified as CodeNotFound,
+// and context cancellation and deadline errors as CodeTemporarilyUnavailable.
+// Errors that already have a code, and errors that cannot be classified, are returned as-is.
+func Classify(err error) error {
+	if err == nil {
+		return nil
+	}
+	var codeErr *Error
+	if errors.As(err, &codeErr) {
+		if codeErr == err {
+			return err
+		}
+		// keep the message of the outer error, but lift the code to the top-level, for the RPC server to use.
+		return New(codeErr.Code, err)
+	}
+	switch {
+	case errors.Is(err, ethereum.NotFound):
+		return New(CodeNotFound, err)
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return New(CodeTemporarilyUnavailable, err)
+	default:
+		return err
+	}
+}
+
+// CodeOf returns the code of the error, if it or any error it wraps has a code.
+// This applies to both errors returned by RPC clients and *Error values.
+func CodeOf(err error) (Code, bool) {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return Code(rpcErr.ErrorCode()), true
+	}
+	return 0, false
+}
+
+// Is returns true if the error has the given code.
+func Is(err error, code Code) bool {
+	c, ok := CodeOf(err)
+	return ok && c == code
+}
+
+// IsNotFound returns true if the error has CodeNotFound.
+func IsNotFound(err error) bool {
+	return Is(err, CodeNotFound)
+}
+
+// IsReorged returns true if the error has CodeReorged.
+func IsReorged(err error) bool {
+	return Is(err, CodeReorged)
+}
+
+// IsTemporarilyUnavailable returns true if the error has CodeTemporarilyUnavailable.
+func IsTemporarilyUnavailable(err error) bool {
+	return Is(err, CodeTemporarilyUnavailable)
+}
+
+// IsUnauthorized returns true if the error has CodeUnauthorized.
+func IsUnauthorized(err error) bool {
+	return Is(err, CodeUnauthorized)
+}
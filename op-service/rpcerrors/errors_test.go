@@ -0,0 +1,80 @@
+package rpcerrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type testAPI struct {
+	err error
+}
+
+func (a *testAPI) Fail() error {
+	return a.err
+}
+
+func TestRoundTrip(t *testing.T) {
+	api := &testAPI{}
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("test", api))
+	defer srv.Stop()
+	cl := rpc.DialInProc(srv)
+	defer cl.Close()
+
+	for _, code := range []Code{CodeNotFound, CodeReorged, CodeTemporarilyUnavailable, CodeUnauthorized} {
+		t.Run(code.String(), func(t *testing.T) {
+			api.err = New(code, errors.New("oops"))
+			err := cl.CallContext(context.Background(), nil, "test_fail")
+			require.ErrorContains(t, err, "oops")
+			actual, ok := CodeOf(err)
+			require.True(t, ok)
+			require.Equal(t, code, actual)
+			require.True(t, Is(err, code))
+
+			var dataErr rpc.DataError
+			require.ErrorAs(t, err, &dataErr)
+			require.Equal(t, code.String(), dataErr.ErrorData())
+		})
+	}
+
+	t.Run("uncoded", func(t *testing.T) {
+		api.err = errors.New("plain")
+		err := cl.CallContext(context.Background(), nil, "test_fail")
+		require.False(t, IsNotFound(err))
+		require.False(t, IsReorged(err))
+		require.False(t, IsTemporarilyUnavailable(err))
+		require.False(t, IsUnauthorized(err))
+	})
+}
+
+func TestClassify(t *testing.T) {
+	require.NoError(t, Classify(nil))
+
+	plain := errors.New("plain")
+	require.Same(t, plain, Classify(plain))
+
+	err := Classify(fmt.Errorf("failed to get block: %w", ethereum.NotFound))
+	require.True(t, IsNotFound(err))
+	require.ErrorIs(t, err, ethereum.NotFound)
+	require.Equal(t, "failed to get block: not found", err.Error())
+
+	err = Classify(fmt.Errorf("driver busy: %w", context.DeadlineExceeded))
+	require.True(t, IsTemporarilyUnavailable(err))
+
+	coded := Unauthorized("bad token")
+	require.Same(t, coded, Classify(coded))
+
+	// the code of a wrapped error is lifted to the top-level error, as the RPC server only checks the top-level
+	err = Classify(fmt.Errorf("outer: %w", coded))
+	var top *Error
+	require.True(t, errors.As(err, &top))
+	require.Same(t, err, error(top))
+	require.Equal(t, CodeUnauthorized, top.Code)
+	require.Equal(t, "outer: bad token", err.Error())
+}
@@ -0,0 +1,132 @@
+package secrets
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"sync"
+
+	hdwallet "github.com/ethereum-optimism/go-ethereum-hdwallet"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// TestMnemonic is the well-known mnemonic that is used in testing and local devnets.
+// It must never be used for keys that hold real value.
+const TestMnemonic = "test test test test test test test test test test test junk"
+
+// Role identifies the purpose of a key. Each role has a standard derivation path.
+type Role string
+
+const (
+	RoleCliqueSigner Role = "clique-signer"
+	RoleProposer     Role = "proposer"
+	RoleBatcher      Role = "batcher"
+	RoleDeployer     Role = "deployer"
+	RoleAlice        Role = "alice"
+	RoleSequencerP2P Role = "sequencer-p2p"
+	RoleChallenger   Role = "challenger"
+	RoleBob          Role = "bob"
+	RoleMallory      Role = "mallory"
+	RoleSysCfgOwner  Role = "sys-cfg-owner"
+)
+
+// Roles lists all roles with a standard derivation path, in order of their account index.
+var Roles = []Role{
+	RoleCliqueSigner,
+	RoleProposer,
+	RoleBatcher,
+	RoleDeployer,
+	RoleAlice,
+	RoleSequencerP2P,
+	RoleChallenger,
+	RoleBob,
+	RoleMallory,
+	RoleSysCfgOwner,
+}
+
+// testAccountOffset is the account index of the first generic test account,
+// offset to avoid collisions with the role accounts.
+const testAccountOffset = 1000
+
+func accountPath(index uint64) string {
+	return fmt.Sprintf("m/44'/60'/0'/0/%d", index)
+}
+
+// StandardPath returns the BIP-44 derivation path of the given role.
+func StandardPath(role Role) (string, error) {
+	for i, r := range Roles {
+		if r == role {
+			return accountPath(uint64(i)), nil
+		}
+	}
+	return "", fmt.Errorf("unknown role: %q", role)
+}
+
+// TestAccountPath returns the derivation path of the i-th generic test account.
+func TestAccountPath(i uint64) string {
+	return accountPath(testAccountOffset + i)
+}
+
+// Manager derives and caches the keys of a HD wallet.
+type Manager struct {
+	wallet *hdwallet.Wallet
+
+	mu   sync.Mutex
+	keys map[string]*ecdsa.PrivateKey
+}
+
+// NewManager creates a new secrets manager from the given BIP-39 mnemonic.
+func NewManager(mnemonic string) (*Manager, error) {
+	wallet, err := hdwallet.NewFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create wallet: %w", err)
+	}
+	return &Manager{
+		wallet: wallet,
+		keys:   make(map[string]*ecdsa.PrivateKey),
+	}, nil
+}
+
+// Wallet returns the underlying HD wallet.
+func (m *Manager) Wallet() *hdwallet.Wallet {
+	return m.wallet
+}
+
+// PathKey derives the private key at the given derivation path.
+func (m *Manager) PathKey(path string) (*ecdsa.PrivateKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if key, ok := m.keys[path]; ok {
+		return key, nil
+	}
+	key, err := m.wallet.PrivateKey(accounts.Account{URL: accounts.URL{Path: path}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key at %q: %w", path, err)
+	}
+	m.keys[path] = key
+	return key, nil
+}
+
+// Key derives the private key of the given role, using its standard derivation path.
+func (m *Manager) Key(role Role) (*ecdsa.PrivateKey, error) {
+	path, err := StandardPath(role)
+	if err != nil {
+		return nil, err
+	}
+	return m.PathKey(path)
+}
+
+// Address returns the address of the given role.
+func (m *Manager) Address(role Role) (common.Address, error) {
+	key, err := m.Key(role)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(key.PublicKey), nil
+}
+
+// TestAccount derives the private key of the i-th generic test account.
+func (m *Manager) TestAccount(i uint64) (*ecdsa.PrivateKey, error) {
+	return m.PathKey(TestAccountPath(i))
+}
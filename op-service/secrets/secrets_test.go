@@ -0,0 +1,48 @@
+package secrets
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStandardPath(t *testing.T) {
+	path, err := StandardPath(RoleCliqueSigner)
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/60'/0'/0/0", path)
+	path, err = StandardPath(RoleSysCfgOwner)
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/60'/0'/0/9", path)
+	_, err = StandardPath(Role("unknown"))
+	require.ErrorContains(t, err, "unknown role")
+	require.Equal(t, "m/44'/60'/0'/0/1003", TestAccountPath(3))
+}
+
+func TestManagerKeys(t *testing.T) {
+	m, err := NewManager(TestMnemonic)
+	require.NoError(t, err)
+
+	// well-known addresses of the test mnemonic
+	addr, err := m.Address(RoleCliqueSigner)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"), addr)
+	addr, err = m.Address(RoleProposer)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8"), addr)
+
+	a, err := m.Key(RoleBatcher)
+	require.NoError(t, err)
+	b, err := m.Key(RoleBatcher)
+	require.NoError(t, err)
+	require.Same(t, a, b, "keys are cached")
+
+	testKey, err := m.TestAccount(0)
+	require.NoError(t, err)
+	require.NotEqual(t, a.D, testKey.D)
+}
+
+func TestInvalidMnemonic(t *testing.T) {
+	_, err := NewManager("not a mnemonic")
+	require.Error(t, err)
+}
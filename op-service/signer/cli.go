@@ -2,7 +2,10 @@ package signer
 
 import (
 	"errors"
+	"fmt"
+	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli/v2"
 
 	opservice "github.com/ethereum-optimism/optimism/op-service"
@@ -10,8 +13,19 @@ import (
 )
 
 const (
-	EndpointFlagName = "signer.endpoint"
-	AddressFlagName  = "signer.address"
+	EndpointFlagName          = "signer.endpoint"
+	FallbackEndpointsFlagName = "signer.fallback-endpoints"
+	AddressFlagName           = "signer.address"
+	PolicyAllowedToFlagName   = "signer.policy.allowed-to"
+	PolicyMaxValueFlagName    = "signer.policy.max-value"
+	PolicyMaxFeeFlagName      = "signer.policy.max-fee"
+	KMSProviderFlagName       = "signer.kms.provider"
+	KMSKeyIDFlagName          = "signer.kms.key-id"
+)
+
+const (
+	KMSProviderAWS = "aws"
+	KMSProviderGCP = "gcp"
 )
 
 func CLIFlags(envPrefix string) []cli.Flag {
@@ -22,20 +36,122 @@ func CLIFlags(envPrefix string) []cli.Flag {
 			Usage:   "Signer endpoint the client will connect to",
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "ENDPOINT"),
 		},
+		&cli.StringSliceFlag{
+			Name:    FallbackEndpointsFlagName,
+			Usage:   "Signer endpoints the client fails over to, in order, if the signer endpoint cannot be reached",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "FALLBACK_ENDPOINTS"),
+		},
 		&cli.StringFlag{
 			Name:    AddressFlagName,
 			Usage:   "Address the signer is signing transactions for",
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "ADDRESS"),
 		},
+		&cli.StringSliceFlag{
+			Name:    PolicyAllowedToFlagName,
+			Usage:   "Signing policy: addresses transactions may be sent to. Any recipient is allowed if not set",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "POLICY_ALLOWED_TO"),
+		},
+		&cli.StringFlag{
+			Name:    PolicyMaxValueFlagName,
+			Usage:   "Signing policy: maximum value a transaction may transfer, in wei. Unlimited if not set",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "POLICY_MAX_VALUE"),
+		},
+		&cli.StringFlag{
+			Name:    PolicyMaxFeeFlagName,
+			Usage:   "Signing policy: maximum total fee a transaction may pay, in wei. Unlimited if not set",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "POLICY_MAX_FEE"),
+		},
+		&cli.StringFlag{
+			Name:    KMSProviderFlagName,
+			Usage:   "Cloud KMS provider of the signing key, instead of a signer endpoint: aws or gcp",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "KMS_PROVIDER"),
+		},
+		&cli.StringFlag{
+			Name: KMSKeyIDFlagName,
+			Usage: "Signing key of the KMS provider: the key ID or ARN of an AWS ECC_SECG_P256K1 key, " +
+				"or the key version resource name of a GCP EC_SIGN_SECP256K1_SHA256 key",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "KMS_KEY_ID"),
+		},
 	}
 	flags = append(flags, optls.CLIFlagsWithFlagPrefix(envPrefix, "signer")...)
 	return flags
 }
 
 type CLIConfig struct {
-	Endpoint  string
-	Address   string
-	TLSConfig optls.CLIConfig
+	Endpoint          string
+	FallbackEndpoints []string
+	Address           string
+	TLSConfig         optls.CLIConfig
+	Policy            PolicyCLIConfig
+	KMS               KMSCLIConfig
+}
+
+// KMSCLIConfig configures a signing key that is kept in a cloud KMS.
+type KMSCLIConfig struct {
+	Provider string
+	KeyID    string
+}
+
+func (c KMSCLIConfig) Enabled() bool {
+	return c.Provider != ""
+}
+
+func (c KMSCLIConfig) Check() error {
+	if c.Provider == "" {
+		if c.KeyID != "" {
+			return errors.New("signer KMS key ID requires the KMS provider to be set")
+		}
+		return nil
+	}
+	if c.Provider != KMSProviderAWS && c.Provider != KMSProviderGCP {
+		return fmt.Errorf("unknown signer KMS provider: %q", c.Provider)
+	}
+	if c.KeyID == "" {
+		return errors.New("signer KMS provider requires the KMS key ID to be set")
+	}
+	return nil
+}
+
+// PolicyCLIConfig configures the signing policy that is enforced before signing any transaction.
+type PolicyCLIConfig struct {
+	AllowedTo []string
+	MaxValue  string
+	MaxFee    string
+}
+
+func (c PolicyCLIConfig) Check() error {
+	_, err := c.Policy()
+	return err
+}
+
+// Policy parses the signing policy.
+func (c PolicyCLIConfig) Policy() (*Policy, error) {
+	var policy Policy
+	for _, addr := range c.AllowedTo {
+		if !common.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid signing policy recipient: %q", addr)
+		}
+		policy.AllowedTo = append(policy.AllowedTo, common.HexToAddress(addr))
+	}
+	var err error
+	if policy.MaxValue, err = parseOptionalWei(c.MaxValue); err != nil {
+		return nil, fmt.Errorf("invalid signing policy max value: %w", err)
+	}
+	if policy.MaxFee, err = parseOptionalWei(c.MaxFee); err != nil {
+		return nil, fmt.Errorf("invalid signing policy max fee: %w", err)
+	}
+	return &policy, nil
+}
+
+func parseOptionalWei(v string) (*big.Int, error) {
+	if v == "" {
+		return nil, nil
+	}
+	out, ok := new(big.Int).SetString(v, 10)
+	if !ok || out.Sign() < 0 {
+		return nil, fmt.Errorf("not a non-negative decimal number: %q", v)
+	}
+	return out, nil
 }
 
 func NewCLIConfig() CLIConfig {
@@ -48,9 +164,25 @@ func (c CLIConfig) Check() error {
 	if err := c.TLSConfig.Check(); err != nil {
 		return err
 	}
+	if err := c.Policy.Check(); err != nil {
+		return err
+	}
+	if err := c.KMS.Check(); err != nil {
+		return err
+	}
+	if c.KMS.Enabled() {
+		// the address is derived from the KMS key, and checked against the address if set
+		if c.Endpoint != "" || len(c.FallbackEndpoints) > 0 {
+			return errors.New("signer endpoint and KMS provider cannot both be set")
+		}
+		return nil
+	}
 	if !((c.Endpoint == "" && c.Address == "") || (c.Endpoint != "" && c.Address != "")) {
 		return errors.New("signer endpoint and address must both be set or not set")
 	}
+	if c.Endpoint == "" && len(c.FallbackEndpoints) > 0 {
+		return errors.New("signer fallback endpoints require the signer endpoint to be set")
+	}
 	return nil
 }
 
@@ -61,11 +193,26 @@ func (c CLIConfig) Enabled() bool {
 	return false
 }
 
+// Endpoints returns the signer endpoint, followed by the fallback endpoints.
+func (c CLIConfig) Endpoints() []string {
+	return append([]string{c.Endpoint}, c.FallbackEndpoints...)
+}
+
 func ReadCLIConfig(ctx *cli.Context) CLIConfig {
 	cfg := CLIConfig{
-		Endpoint:  ctx.String(EndpointFlagName),
-		Address:   ctx.String(AddressFlagName),
-		TLSConfig: optls.ReadCLIConfigWithPrefix(ctx, "signer"),
+		Endpoint:          ctx.String(EndpointFlagName),
+		FallbackEndpoints: ctx.StringSlice(FallbackEndpointsFlagName),
+		Address:           ctx.String(AddressFlagName),
+		TLSConfig:         optls.ReadCLIConfigWithPrefix(ctx, "signer"),
+		Policy: PolicyCLIConfig{
+			AllowedTo: ctx.StringSlice(PolicyAllowedToFlagName),
+			MaxValue:  ctx.String(PolicyMaxValueFlagName),
+			MaxFee:    ctx.String(PolicyMaxFeeFlagName),
+		},
+		KMS: KMSCLIConfig{
+			Provider: ctx.String(KMSProviderFlagName),
+			KeyID:    ctx.String(KMSKeyIDFlagName),
+		},
 	}
 	return cfg
 }
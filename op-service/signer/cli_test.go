@@ -38,6 +38,57 @@ func TestInvalidConfig(t *testing.T) {
 				config.Endpoint = "http://localhost"
 			},
 		},
+		{
+			name:     "FallbackWithoutEndpoint",
+			expected: "signer fallback endpoints require the signer endpoint to be set",
+			configChange: func(config *CLIConfig) {
+				config.FallbackEndpoints = []string{"http://localhost"}
+			},
+		},
+		{
+			name:     "UnknownKMSProvider",
+			expected: "unknown signer KMS provider",
+			configChange: func(config *CLIConfig) {
+				config.KMS = KMSCLIConfig{Provider: "azure", KeyID: "key"}
+			},
+		},
+		{
+			name:     "MissingKMSKeyID",
+			expected: "signer KMS provider requires the KMS key ID to be set",
+			configChange: func(config *CLIConfig) {
+				config.KMS.Provider = KMSProviderAWS
+			},
+		},
+		{
+			name:     "KMSWithEndpoint",
+			expected: "signer endpoint and KMS provider cannot both be set",
+			configChange: func(config *CLIConfig) {
+				config.KMS = KMSCLIConfig{Provider: KMSProviderGCP, KeyID: "key"}
+				config.Endpoint = "http://localhost"
+				config.Address = "0x1234"
+			},
+		},
+		{
+			name:     "InvalidPolicyRecipient",
+			expected: "invalid signing policy recipient",
+			configChange: func(config *CLIConfig) {
+				config.Policy.AllowedTo = []string{"0xnope"}
+			},
+		},
+		{
+			name:     "InvalidPolicyMaxValue",
+			expected: "invalid signing policy max value",
+			configChange: func(config *CLIConfig) {
+				config.Policy.MaxValue = "-1"
+			},
+		},
+		{
+			name:     "InvalidPolicyMaxFee",
+			expected: "invalid signing policy max fee",
+			configChange: func(config *CLIConfig) {
+				config.Policy.MaxFee = "1 ether"
+			},
+		},
 		{
 			name:     "InvalidTLSConfig",
 			expected: "all tls flags must be set if at least one is set",
@@ -2,16 +2,14 @@ package signer
 
 import (
 	"context"
-	"crypto/tls"
-	"crypto/x509"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
-	"os"
+	"sync"
 	"time"
 
 	optls "github.com/ethereum-optimism/optimism/op-service/tls"
-	"github.com/ethereum-optimism/optimism/op-service/tls/certman"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -19,43 +17,51 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// endpointRetryDelay is the time for which an unreachable signer endpoint is tried after the reachable ones.
+const endpointRetryDelay = 30 * time.Second
+
+// SignerClient signs transactions through one of multiple remote signer endpoints. The endpoints are
+// tried in order, so the first reachable endpoint signs all transactions. If an endpoint cannot be reached,
+// it is only tried after the other endpoints until the endpointRetryDelay passed, or until it signs again.
+// Endpoints are never skipped entirely, so a single endpoint is still used after a failed request.
 type SignerClient struct {
+	logger log.Logger
+
+	// mu guards the retry times of the endpoints. It is not held during the RPC calls.
+	mu        sync.Mutex
+	endpoints []*signerEndpoint
+}
+
+type signerEndpoint struct {
+	url    string
 	client *rpc.Client
 	status string
-	logger log.Logger
+	// retryAt is the time until which an unreachable endpoint is tried after the reachable endpoints.
+	// The endpoint is reachable if it is zero.
+	retryAt time.Time
 }
 
 func NewSignerClient(logger log.Logger, endpoint string, tlsConfig optls.CLIConfig) (*SignerClient, error) {
+	return NewFailoverSignerClient(logger, []string{endpoint}, tlsConfig)
+}
+
+// NewFailoverSignerClient creates a SignerClient that fails over between the endpoints, in order.
+// At least one of the endpoints must be reachable.
+func NewFailoverSignerClient(logger log.Logger, endpoints []string, tlsConfig optls.CLIConfig) (*SignerClient, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("no signer endpoints")
+	}
 	var httpClient *http.Client
 	if tlsConfig.TLSCaCert != "" {
 		logger.Info("tlsConfig specified, loading tls config")
-		caCert, err := os.ReadFile(tlsConfig.TLSCaCert)
+		config, err := optls.NewClientTLSConfig(logger, tlsConfig)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read tls.ca: %w", err)
-		}
-		caCertPool := x509.NewCertPool()
-		caCertPool.AppendCertsFromPEM(caCert)
-
-		// certman watches for newer client certifictes and automatically reloads them
-		cm, err := certman.New(logger, tlsConfig.TLSCert, tlsConfig.TLSKey)
-		if err != nil {
-			logger.Error("failed to read tls cert or key", "err", err)
-			return nil, err
-		}
-		if err := cm.Watch(); err != nil {
-			logger.Error("failed to start certman watcher", "err", err)
+			logger.Error("failed to load tls config", "err", err)
 			return nil, err
 		}
-
 		httpClient = &http.Client{
 			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					MinVersion: tls.VersionTLS13,
-					RootCAs:    caCertPool,
-					GetClientCertificate: func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
-						return cm.GetCertificate(nil)
-					},
-				},
+				TLSClientConfig: config,
 			},
 		}
 	} else {
@@ -63,47 +69,105 @@ func NewSignerClient(logger log.Logger, endpoint string, tlsConfig optls.CLIConf
 		httpClient = http.DefaultClient
 	}
 
-	rpcClient, err := rpc.DialOptions(context.Background(), endpoint, rpc.WithHTTPClient(httpClient))
-	if err != nil {
-		return nil, err
+	signer := &SignerClient{logger: logger}
+	var lastErr error
+	healthy := 0
+	for _, endpoint := range endpoints {
+		rpcClient, err := rpc.DialOptions(context.Background(), endpoint, rpc.WithHTTPClient(httpClient))
+		if err != nil {
+			return nil, err
+		}
+		e := &signerEndpoint{url: endpoint, client: rpcClient}
+		// Check if reachable
+		if err := e.ping(); err != nil {
+			logger.Warn("Signer endpoint is not reachable", "endpoint", endpoint, "err", err)
+			e.retryAt = time.Now().Add(endpointRetryDelay)
+			lastErr = err
+		} else {
+			healthy++
+		}
+		signer.endpoints = append(signer.endpoints, e)
 	}
-
-	signer := &SignerClient{logger: logger, client: rpcClient}
-	// Check if reachable
-	version, err := signer.pingVersion()
-	if err != nil {
-		return nil, err
+	if healthy == 0 {
+		return nil, lastErr
 	}
-	signer.status = fmt.Sprintf("ok [version=%v]", version)
 	return signer, nil
 }
 
 func NewSignerClientFromConfig(logger log.Logger, config CLIConfig) (*SignerClient, error) {
-	return NewSignerClient(logger, config.Endpoint, config.TLSConfig)
+	return NewFailoverSignerClient(logger, config.Endpoints(), config.TLSConfig)
 }
 
-func (s *SignerClient) pingVersion() (string, error) {
+// ping checks the health of the endpoint, and records its version as its status.
+func (e *signerEndpoint) ping() error {
 	var v string
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
 	defer cancel()
-	if err := s.client.CallContext(ctx, &v, "health_status"); err != nil {
-		return "", err
+	if err := e.client.CallContext(ctx, &v, "health_status"); err != nil {
+		return err
+	}
+	e.status = fmt.Sprintf("ok [version=%v]", v)
+	return nil
+}
+
+// orderedEndpoints returns the endpoints in the order to try them: the reachable endpoints first,
+// and then the endpoints that failed within the endpointRetryDelay.
+func (s *SignerClient) orderedEndpoints() []*signerEndpoint {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	ordered := make([]*signerEndpoint, 0, len(s.endpoints))
+	var unreachable []*signerEndpoint
+	for _, e := range s.endpoints {
+		if e.retryAt.IsZero() || !now.Before(e.retryAt) {
+			ordered = append(ordered, e)
+		} else {
+			unreachable = append(unreachable, e)
+		}
+	}
+	return append(ordered, unreachable...)
+}
+
+func (s *SignerClient) markUnreachable(e *signerEndpoint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e.retryAt = time.Now().Add(endpointRetryDelay)
+}
+
+func (s *SignerClient) markReachable(e *signerEndpoint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !e.retryAt.IsZero() {
+		s.logger.Info("Signer endpoint is reachable again", "endpoint", e.url)
+		e.retryAt = time.Time{}
 	}
-	return v, nil
 }
 
 func (s *SignerClient) SignTransaction(ctx context.Context, chainId *big.Int, from common.Address, tx *types.Transaction) (*types.Transaction, error) {
 	args := NewTransactionArgsFromTransaction(chainId, from, tx)
 
-	var result hexutil.Bytes
-	if err := s.client.CallContext(ctx, &result, "eth_signTransaction", args); err != nil {
-		return nil, fmt.Errorf("eth_signTransaction failed: %w", err)
-	}
+	var lastErr error
+	for _, e := range s.orderedEndpoints() {
+		var result hexutil.Bytes
+		err := e.client.CallContext(ctx, &result, "eth_signTransaction", args)
+		if err != nil {
+			var rpcErr rpc.Error
+			// The signer refused to sign, or the signing was canceled, so another endpoint would not sign either.
+			if errors.As(err, &rpcErr) || ctx.Err() != nil {
+				return nil, fmt.Errorf("eth_signTransaction failed: %w", err)
+			}
+			s.logger.Warn("Signer endpoint failed, failing over to the next endpoint", "endpoint", e.url, "err", err)
+			s.markUnreachable(e)
+			lastErr = err
+			continue
+		}
+		s.markReachable(e)
 
-	signed := &types.Transaction{}
-	if err := signed.UnmarshalBinary(result); err != nil {
-		return nil, err
+		signed := &types.Transaction{}
+		if err := signed.UnmarshalBinary(result); err != nil {
+			return nil, err
+		}
+		return signed, nil
 	}
-
-	return signed, nil
+	return nil, fmt.Errorf("eth_signTransaction failed: %w", lastErr)
 }
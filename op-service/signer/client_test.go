@@ -0,0 +1,194 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+)
+
+type healthService struct{}
+
+func (healthService) Status() string {
+	return "v1.0.0"
+}
+
+type ethService struct {
+	signed hexutil.Bytes
+	err    error
+	calls  int
+}
+
+func (s *ethService) SignTransaction(args TransactionArgs) (hexutil.Bytes, error) {
+	s.calls++
+	return s.signed, s.err
+}
+
+func startSigner(t *testing.T, eth *ethService) *httptest.Server {
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("health", healthService{}))
+	require.NoError(t, srv.RegisterName("eth", eth))
+	httpSrv := httptest.NewServer(srv)
+	t.Cleanup(httpSrv.Close)
+	return httpSrv
+}
+
+func testSignedTx(t *testing.T, chainID *big.Int) (*types.Transaction, hexutil.Bytes) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       21_000,
+		To:        &common.Address{0x01},
+	})
+	require.NoError(t, err)
+	data, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return tx, data
+}
+
+func TestSignerClientFailover(t *testing.T) {
+	chainID := big.NewInt(10)
+	tx, data := testSignedTx(t, chainID)
+	primary := &ethService{signed: data}
+	fallback := &ethService{signed: data}
+	primarySrv := startSigner(t, primary)
+	fallbackSrv := startSigner(t, fallback)
+
+	client, err := NewFailoverSignerClient(testlog.Logger(t, log.LvlCrit), []string{primarySrv.URL, fallbackSrv.URL}, optls.CLIConfig{})
+	require.NoError(t, err)
+
+	signed, err := client.SignTransaction(context.Background(), chainID, common.Address{0x02}, tx)
+	require.NoError(t, err)
+	require.Equal(t, tx.Hash(), signed.Hash())
+	require.Equal(t, 1, primary.calls)
+	require.Equal(t, 0, fallback.calls)
+
+	// a refusal of the signer does not fail over
+	primary.err = errors.New("refused")
+	_, err = client.SignTransaction(context.Background(), chainID, common.Address{0x02}, tx)
+	require.ErrorContains(t, err, "refused")
+	require.Equal(t, 0, fallback.calls)
+
+	// an unreachable signer fails over, and is tried last until the retry delay passed
+	primarySrv.Close()
+	for i := 1; i <= 2; i++ {
+		signed, err = client.SignTransaction(context.Background(), chainID, common.Address{0x02}, tx)
+		require.NoError(t, err)
+		require.Equal(t, tx.Hash(), signed.Hash())
+		require.Equal(t, i, fallback.calls)
+	}
+	require.Equal(t, 2, primary.calls)
+
+	fallbackSrv.Close()
+	_, err = client.SignTransaction(context.Background(), chainID, common.Address{0x02}, tx)
+	require.Error(t, err)
+}
+
+func TestSignerClientRetriesSingleEndpoint(t *testing.T) {
+	chainID := big.NewInt(10)
+	tx, data := testSignedTx(t, chainID)
+	eth := &ethService{signed: data}
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("health", healthService{}))
+	require.NoError(t, srv.RegisterName("eth", eth))
+	var down atomic.Bool
+	httpSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		srv.ServeHTTP(w, r)
+	}))
+	t.Cleanup(httpSrv.Close)
+
+	client, err := NewFailoverSignerClient(testlog.Logger(t, log.LvlCrit), []string{httpSrv.URL}, optls.CLIConfig{})
+	require.NoError(t, err)
+	down.Store(true)
+	_, err = client.SignTransaction(context.Background(), chainID, common.Address{0x02}, tx)
+	require.Error(t, err)
+
+	// the only endpoint is still tried within the retry delay
+	down.Store(false)
+	signed, err := client.SignTransaction(context.Background(), chainID, common.Address{0x02}, tx)
+	require.NoError(t, err)
+	require.Equal(t, tx.Hash(), signed.Hash())
+	require.Equal(t, 1, eth.calls)
+}
+
+// barrierService only signs once the given number of signing requests are in flight at the same time.
+type barrierService struct {
+	signed  hexutil.Bytes
+	pending sync.WaitGroup
+}
+
+func (s *barrierService) SignTransaction(args TransactionArgs) (hexutil.Bytes, error) {
+	s.pending.Done()
+	done := make(chan struct{})
+	go func() {
+		s.pending.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return s.signed, nil
+	case <-time.After(10 * time.Second):
+		return nil, errors.New("signing requests are not concurrent")
+	}
+}
+
+func TestSignerClientConcurrentSigning(t *testing.T) {
+	chainID := big.NewInt(10)
+	tx, data := testSignedTx(t, chainID)
+	eth := &barrierService{signed: data}
+	const requests = 3
+	eth.pending.Add(requests)
+	srv := rpc.NewServer()
+	require.NoError(t, srv.RegisterName("health", healthService{}))
+	require.NoError(t, srv.RegisterName("eth", eth))
+	httpSrv := httptest.NewServer(srv)
+	t.Cleanup(httpSrv.Close)
+
+	client, err := NewFailoverSignerClient(testlog.Logger(t, log.LvlCrit), []string{httpSrv.URL}, optls.CLIConfig{})
+	require.NoError(t, err)
+	errs := make(chan error, requests)
+	for i := 0; i < requests; i++ {
+		go func() {
+			_, err := client.SignTransaction(context.Background(), chainID, common.Address{0x02}, tx)
+			errs <- err
+		}()
+	}
+	for i := 0; i < requests; i++ {
+		require.NoError(t, <-errs)
+	}
+}
+
+func TestSignerClientRequiresReachableEndpoint(t *testing.T) {
+	srv := startSigner(t, &ethService{})
+	srv.Close()
+	_, err := NewFailoverSignerClient(testlog.Logger(t, log.LvlCrit), []string{srv.URL}, optls.CLIConfig{})
+	require.Error(t, err)
+
+	reachable := startSigner(t, &ethService{})
+	_, err = NewFailoverSignerClient(testlog.Logger(t, log.LvlCrit), []string{srv.URL, reachable.URL}, optls.CLIConfig{})
+	require.NoError(t, err)
+}
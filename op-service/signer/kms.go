@@ -0,0 +1,160 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// KMSClient signs with a secp256k1 key that is kept in a cloud key management service.
+type KMSClient interface {
+	// PublicKey returns the DER encoded SubjectPublicKeyInfo of the key.
+	PublicKey(ctx context.Context) ([]byte, error)
+	// SignDigest returns the DER encoded ECDSA signature of the digest.
+	SignDigest(ctx context.Context, digest []byte) ([]byte, error)
+}
+
+var (
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+
+	oidECPublicKey = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidSecp256k1   = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+type subjectPublicKeyInfo struct {
+	Algorithm struct {
+		Algorithm  asn1.ObjectIdentifier
+		Parameters asn1.ObjectIdentifier
+	}
+	PublicKey asn1.BitString
+}
+
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// KMSSigner signs transactions with a KMS key. The public key is fetched once, when the signer is created,
+// to derive the address and the recovery id of the signatures.
+type KMSSigner struct {
+	client  KMSClient
+	pubKey  *ecdsa.PublicKey
+	address common.Address
+}
+
+func NewKMSSigner(ctx context.Context, client KMSClient) (*KMSSigner, error) {
+	der, err := client.PublicKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get KMS public key: %w", err)
+	}
+	pubKey, err := parseSecp256k1PublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	return &KMSSigner{client: client, pubKey: pubKey, address: crypto.PubkeyToAddress(*pubKey)}, nil
+}
+
+// NewKMSSignerFromConfig creates a KMSSigner for the key of the configured provider. If an address is
+// configured, it must be the address of the key.
+func NewKMSSignerFromConfig(ctx context.Context, logger log.Logger, config CLIConfig) (*KMSSigner, error) {
+	var client KMSClient
+	var err error
+	switch config.KMS.Provider {
+	case KMSProviderAWS:
+		client, err = NewAWSKMSClient(ctx, config.KMS.KeyID)
+	case KMSProviderGCP:
+		client, err = NewGCPKMSClient(ctx, config.KMS.KeyID)
+	default:
+		return nil, fmt.Errorf("unknown KMS provider: %q", config.KMS.Provider)
+	}
+	if err != nil {
+		return nil, err
+	}
+	signer, err := NewKMSSigner(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if config.Address != "" && common.HexToAddress(config.Address) != signer.Address() {
+		return nil, fmt.Errorf("KMS key has address %s, expected %s", signer.Address(), config.Address)
+	}
+	logger.Info("Signing with KMS key", "provider", config.KMS.Provider, "address", signer.Address())
+	return signer, nil
+}
+
+// Address returns the address of the KMS key.
+func (s *KMSSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *KMSSigner) SignTransaction(ctx context.Context, chainID *big.Int, from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+	if from != s.address {
+		return nil, fmt.Errorf("attempting to sign for %s, expected %s", from, s.address)
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	sig, err := s.signHash(ctx, signer.Hash(tx).Bytes())
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(signer, sig)
+}
+
+// signHash returns the 65 byte [R || S || V] signature of the hash, with a low S value, as required by Ethereum.
+func (s *KMSSigner) signHash(ctx context.Context, hash []byte) ([]byte, error) {
+	der, err := s.client.SignDigest(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("KMS signing failed: %w", err)
+	}
+	var parsed ecdsaSignature
+	if rest, err := asn1.Unmarshal(der, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid KMS signature: %w", err)
+	} else if len(rest) != 0 {
+		return nil, errors.New("invalid KMS signature: trailing data")
+	}
+	if parsed.R == nil || parsed.S == nil || parsed.R.Sign() <= 0 || parsed.S.Sign() <= 0 {
+		return nil, errors.New("invalid KMS signature: non-positive R or S")
+	}
+	// KMS signatures may have a high S value, which is equally valid, but rejected by Ethereum (EIP-2).
+	sVal := parsed.S
+	if sVal.Cmp(secp256k1HalfN) > 0 {
+		sVal = new(big.Int).Sub(secp256k1N, sVal)
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	parsed.R.FillBytes(sig[:32])
+	sVal.FillBytes(sig[32:64])
+	// KMS does not return the recovery id, so it is the one that recovers the public key of the signer.
+	expected := crypto.FromECDSAPub(s.pubKey)
+	for v := byte(0); v < 2; v++ {
+		sig[64] = v
+		pub, err := crypto.Ecrecover(hash, sig)
+		if err == nil && string(pub) == string(expected) {
+			return sig, nil
+		}
+	}
+	return nil, errors.New("KMS signature does not recover to the KMS public key")
+}
+
+func parseSecp256k1PublicKey(der []byte) (*ecdsa.PublicKey, error) {
+	var info subjectPublicKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, fmt.Errorf("invalid KMS public key: %w", err)
+	} else if len(rest) != 0 {
+		return nil, errors.New("invalid KMS public key: trailing data")
+	}
+	if !info.Algorithm.Algorithm.Equal(oidECPublicKey) || !info.Algorithm.Parameters.Equal(oidSecp256k1) {
+		return nil, fmt.Errorf("KMS key is not a secp256k1 key: algorithm %v, curve %v",
+			info.Algorithm.Algorithm, info.Algorithm.Parameters)
+	}
+	pubKey, err := crypto.UnmarshalPubkey(info.PublicKey.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid KMS public key: %w", err)
+	}
+	return pubKey, nil
+}
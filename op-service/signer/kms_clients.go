@@ -0,0 +1,90 @@
+package signer
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	gcpkms "cloud.google.com/go/kms/apiv1"
+	"cloud.google.com/go/kms/apiv1/kmspb"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	awskms "github.com/aws/aws-sdk-go-v2/service/kms"
+	awskmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+)
+
+// The KMS services sign the digest as is, so the keccak256 transaction hash is passed as the SHA-256 digest.
+
+// AWSKMSClient signs with an AWS KMS key of key spec ECC_SECG_P256K1.
+// The credentials and region are loaded from the default AWS configuration, e.g. the environment.
+type AWSKMSClient struct {
+	client *awskms.Client
+	keyID  string
+}
+
+func NewAWSKMSClient(ctx context.Context, keyID string) (*AWSKMSClient, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+	return &AWSKMSClient{client: awskms.NewFromConfig(cfg), keyID: keyID}, nil
+}
+
+func (c *AWSKMSClient) PublicKey(ctx context.Context) ([]byte, error) {
+	out, err := c.client.GetPublicKey(ctx, &awskms.GetPublicKeyInput{KeyId: &c.keyID})
+	if err != nil {
+		return nil, err
+	}
+	return out.PublicKey, nil
+}
+
+func (c *AWSKMSClient) SignDigest(ctx context.Context, digest []byte) ([]byte, error) {
+	out, err := c.client.Sign(ctx, &awskms.SignInput{
+		KeyId:            &c.keyID,
+		Message:          digest,
+		MessageType:      awskmstypes.MessageTypeDigest,
+		SigningAlgorithm: awskmstypes.SigningAlgorithmSpecEcdsaSha256,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Signature, nil
+}
+
+// GCPKMSClient signs with a GCP Cloud KMS key version of algorithm EC_SIGN_SECP256K1_SHA256.
+// The key is the resource name of the key version, and the credentials are the application default credentials.
+type GCPKMSClient struct {
+	client  *gcpkms.KeyManagementClient
+	keyName string
+}
+
+func NewGCPKMSClient(ctx context.Context, keyName string) (*GCPKMSClient, error) {
+	client, err := gcpkms.NewKeyManagementClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCP KMS client: %w", err)
+	}
+	return &GCPKMSClient{client: client, keyName: keyName}, nil
+}
+
+func (c *GCPKMSClient) PublicKey(ctx context.Context) ([]byte, error) {
+	out, err := c.client.GetPublicKey(ctx, &kmspb.GetPublicKeyRequest{Name: c.keyName})
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode([]byte(out.Pem))
+	if block == nil {
+		return nil, errors.New("invalid PEM public key")
+	}
+	return block.Bytes, nil
+}
+
+func (c *GCPKMSClient) SignDigest(ctx context.Context, digest []byte) ([]byte, error) {
+	out, err := c.client.AsymmetricSign(ctx, &kmspb.AsymmetricSignRequest{
+		Name:   c.keyName,
+		Digest: &kmspb.Digest{Digest: &kmspb.Digest_Sha256{Sha256: digest}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Signature, nil
+}
@@ -0,0 +1,97 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// mockKMSClient signs like a KMS: DER encoded signatures without recovery id, optionally with a high S value.
+type mockKMSClient struct {
+	key   *ecdsa.PrivateKey
+	highS bool
+	calls int
+}
+
+func (m *mockKMSClient) PublicKey(ctx context.Context) ([]byte, error) {
+	m.calls++
+	var info subjectPublicKeyInfo
+	info.Algorithm.Algorithm = oidECPublicKey
+	info.Algorithm.Parameters = oidSecp256k1
+	pub := crypto.FromECDSAPub(&m.key.PublicKey)
+	info.PublicKey = asn1.BitString{Bytes: pub, BitLength: len(pub) * 8}
+	return asn1.Marshal(info)
+}
+
+func (m *mockKMSClient) SignDigest(ctx context.Context, digest []byte) ([]byte, error) {
+	sig, err := crypto.Sign(digest, m.key)
+	if err != nil {
+		return nil, err
+	}
+	s := new(big.Int).SetBytes(sig[32:64])
+	if m.highS {
+		s.Sub(secp256k1N, s)
+	}
+	return asn1.Marshal(ecdsaSignature{R: new(big.Int).SetBytes(sig[:32]), S: s})
+}
+
+func TestKMSSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	chainID := big.NewInt(10)
+	for _, highS := range []bool{false, true} {
+		client := &mockKMSClient{key: key, highS: highS}
+		signer, err := NewKMSSigner(context.Background(), client)
+		require.NoError(t, err)
+		require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), signer.Address())
+
+		for nonce := uint64(0); nonce < 8; nonce++ {
+			tx := types.NewTx(&types.DynamicFeeTx{
+				ChainID:   chainID,
+				Nonce:     nonce,
+				GasTipCap: big.NewInt(1),
+				GasFeeCap: big.NewInt(2),
+				Gas:       21_000,
+				To:        &common.Address{0x01},
+			})
+			signed, err := signer.SignTransaction(context.Background(), chainID, signer.Address(), tx)
+			require.NoError(t, err)
+			from, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+			require.NoError(t, err)
+			require.Equal(t, signer.Address(), from)
+			_, _, s := signed.RawSignatureValues()
+			require.True(t, s.Cmp(secp256k1HalfN) <= 0, "signature must have a low S value")
+		}
+		// the public key is cached
+		require.Equal(t, 1, client.calls)
+
+		_, err = signer.SignTransaction(context.Background(), chainID, common.Address{0x02}, types.NewTx(&types.DynamicFeeTx{ChainID: chainID}))
+		require.ErrorContains(t, err, "attempting to sign for")
+	}
+}
+
+func TestParseSecp256k1PublicKey(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	der, err := (&mockKMSClient{key: key}).PublicKey(context.Background())
+	require.NoError(t, err)
+	pub, err := parseSecp256k1PublicKey(der)
+	require.NoError(t, err)
+	require.Equal(t, crypto.FromECDSAPub(&key.PublicKey), crypto.FromECDSAPub(pub))
+
+	var info subjectPublicKeyInfo
+	_, err = asn1.Unmarshal(der, &info)
+	require.NoError(t, err)
+	info.Algorithm.Parameters = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7} // P-256
+	der, err = asn1.Marshal(info)
+	require.NoError(t, err)
+	_, err = parseSecp256k1PublicKey(der)
+	require.ErrorContains(t, err, "not a secp256k1 key")
+}
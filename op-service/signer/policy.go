@@ -0,0 +1,73 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var ErrPolicyViolation = errors.New("transaction violates signing policy")
+
+// Policy constrains the transactions that may be signed.
+// The zero value of each constraint disables it.
+type Policy struct {
+	// AllowedTo is the set of allowed transaction recipients. Contract creations are never allowed if set.
+	AllowedTo []common.Address
+	// MaxValue is the maximum ETH value a transaction may transfer, in wei.
+	MaxValue *big.Int
+	// MaxFee is the maximum total fee a transaction may pay, in wei.
+	// This is the gas limit times the fee cap, plus the blob gas times the blob fee cap.
+	MaxFee *big.Int
+}
+
+// Enabled returns true if any constraint, besides the chain ID, is set.
+func (p *Policy) Enabled() bool {
+	return len(p.AllowedTo) > 0 || p.MaxValue != nil || p.MaxFee != nil
+}
+
+// Check returns an error wrapping ErrPolicyViolation if the transaction may not be signed for the given chain ID.
+// The chain ID is always enforced: it must match the chain ID of the transaction.
+func (p *Policy) Check(chainID *big.Int, tx *types.Transaction) error {
+	if txChainID := tx.ChainId(); txChainID == nil || txChainID.Cmp(chainID) != 0 {
+		return fmt.Errorf("%w: chain ID %v does not match expected chain ID %v", ErrPolicyViolation, txChainID, chainID)
+	}
+	if len(p.AllowedTo) > 0 {
+		to := tx.To()
+		if to == nil {
+			return fmt.Errorf("%w: contract creation is not allowed", ErrPolicyViolation)
+		}
+		if !p.allowedTo(*to) {
+			return fmt.Errorf("%w: recipient %s is not allowed", ErrPolicyViolation, to)
+		}
+	}
+	if p.MaxValue != nil && tx.Value().Cmp(p.MaxValue) > 0 {
+		return fmt.Errorf("%w: value %v exceeds max value %v", ErrPolicyViolation, tx.Value(), p.MaxValue)
+	}
+	if p.MaxFee != nil {
+		if fee := MaxTxFee(tx); fee.Cmp(p.MaxFee) > 0 {
+			return fmt.Errorf("%w: max fee %v exceeds max allowed fee %v", ErrPolicyViolation, fee, p.MaxFee)
+		}
+	}
+	return nil
+}
+
+func (p *Policy) allowedTo(to common.Address) bool {
+	for _, addr := range p.AllowedTo {
+		if addr == to {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxTxFee computes the maximum fee a transaction may pay, in wei.
+func MaxTxFee(tx *types.Transaction) *big.Int {
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), tx.GasFeeCap())
+	if blobFeeCap := tx.BlobGasFeeCap(); blobFeeCap != nil {
+		fee.Add(fee, new(big.Int).Mul(new(big.Int).SetUint64(tx.BlobGas()), blobFeeCap))
+	}
+	return fee
+}
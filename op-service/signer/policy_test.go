@@ -0,0 +1,76 @@
+package signer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	chainID := big.NewInt(10)
+	allowed := common.Address{0xaa}
+	other := common.Address{0xbb}
+	policy := &Policy{
+		AllowedTo: []common.Address{allowed},
+		MaxValue:  big.NewInt(1000),
+		MaxFee:    big.NewInt(21_000 * 100),
+	}
+	newTx := func(chainID *big.Int, to *common.Address, value int64, feeCap int64) *types.Transaction {
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:   chainID,
+			To:        to,
+			Value:     big.NewInt(value),
+			Gas:       21_000,
+			GasFeeCap: big.NewInt(feeCap),
+			GasTipCap: big.NewInt(1),
+		})
+	}
+
+	tests := []struct {
+		name     string
+		tx       *types.Transaction
+		expected string
+	}{
+		{name: "Valid", tx: newTx(chainID, &allowed, 1000, 100)},
+		{name: "WrongChainID", tx: newTx(big.NewInt(11), &allowed, 0, 100), expected: "chain ID"},
+		{name: "DisallowedRecipient", tx: newTx(chainID, &other, 0, 100), expected: "recipient"},
+		{name: "ContractCreation", tx: newTx(chainID, nil, 0, 100), expected: "contract creation"},
+		{name: "ValueTooHigh", tx: newTx(chainID, &allowed, 1001, 100), expected: "max value"},
+		{name: "FeeTooHigh", tx: newTx(chainID, &allowed, 0, 101), expected: "max fee"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := policy.Check(chainID, test.tx)
+			if test.expected == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrPolicyViolation)
+				require.ErrorContains(t, err, test.expected)
+			}
+		})
+	}
+}
+
+func TestEmptyPolicyEnforcesChainID(t *testing.T) {
+	policy := &Policy{}
+	require.False(t, policy.Enabled())
+	tx := types.NewTx(&types.DynamicFeeTx{ChainID: big.NewInt(1), GasFeeCap: big.NewInt(1), GasTipCap: big.NewInt(1)})
+	require.NoError(t, policy.Check(big.NewInt(1), tx))
+	require.ErrorIs(t, policy.Check(big.NewInt(2), tx), ErrPolicyViolation)
+}
+
+func TestPolicyFromCLIConfig(t *testing.T) {
+	cfg := PolicyCLIConfig{
+		AllowedTo: []string{"0x00000000000000000000000000000000000000aa"},
+		MaxValue:  "1000",
+	}
+	policy, err := cfg.Policy()
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{{19: 0xaa}}, policy.AllowedTo)
+	require.Equal(t, big.NewInt(1000), policy.MaxValue)
+	require.Nil(t, policy.MaxFee)
+	require.True(t, policy.Enabled())
+}
@@ -177,3 +177,15 @@ func TestEthClient_WrongInfoByHash(t *testing.T) {
 	require.Error(t, err, "cannot accept the wrong block")
 	m.Mock.AssertExpectations(t)
 }
+
+func TestL1ClientConfig_SetCacheSize(t *testing.T) {
+	cfg := L1ClientDefaultConfig(&rollup.Config{SeqWindowSize: 10}, true, RPCKindStandard)
+	cfg.SetCacheSize(123)
+	require.Equal(t, 123, cfg.ReceiptsCacheSize)
+	require.Equal(t, 123, cfg.TransactionsCacheSize)
+	require.Equal(t, 123, cfg.HeadersCacheSize)
+	require.Equal(t, 123, cfg.PayloadsCacheSize)
+	require.Equal(t, 123, cfg.L1BlockRefsCacheSize)
+	_, err := NewL1Client(new(mockRPC), nil, nil, cfg)
+	require.NoError(t, err)
+}
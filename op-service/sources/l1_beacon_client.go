@@ -0,0 +1,245 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+const (
+	genesisMethod        = "eth/v1/beacon/genesis"
+	specMethod           = "eth/v1/config/spec"
+	sidecarsMethodPrefix = "eth/v1/beacon/blob_sidecars/"
+)
+
+type APIGenesisResponse struct {
+	Data ReducedGenesisData `json:"data"`
+}
+
+type ReducedGenesisData struct {
+	GenesisTime eth.Uint64String `json:"genesis_time"`
+}
+
+type APIConfigResponse struct {
+	Data ReducedConfigData `json:"data"`
+}
+
+type ReducedConfigData struct {
+	SecondsPerSlot eth.Uint64String `json:"SECONDS_PER_SLOT"`
+}
+
+type APIGetBlobSidecarsResponse struct {
+	Data []*eth.BlobSidecar `json:"data"`
+}
+
+// BlobSideCarsFetcher fetches the blob sidecars of a beacon block.
+// Beacon nodes, and blob archivers that serve the beacon API, implement this.
+type BlobSideCarsFetcher interface {
+	// BeaconBlobSideCars fetches the sidecars of the blobs with the given indices in the block at the slot.
+	BeaconBlobSideCars(ctx context.Context, slot uint64, indices []uint64) (APIGetBlobSidecarsResponse, error)
+}
+
+// BeaconClient is a beacon node API client, as required by the L1BeaconClient.
+type BeaconClient interface {
+	BlobSideCarsFetcher
+	ConfigSpec(ctx context.Context) (APIConfigResponse, error)
+	BeaconGenesis(ctx context.Context) (APIGenesisResponse, error)
+}
+
+// BeaconHTTPClient is a client of the beacon API, or of a blob archiver that serves the same API.
+type BeaconHTTPClient struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewBeaconHTTPClient creates a client of the beacon API at the given base URL.
+// The http.DefaultClient is used if client is nil.
+func NewBeaconHTTPClient(endpoint string, client *http.Client) *BeaconHTTPClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &BeaconHTTPClient{endpoint: strings.TrimSuffix(endpoint, "/") + "/", client: client}
+}
+
+func (cl *BeaconHTTPClient) apiReq(ctx context.Context, dest any, method string, query url.Values) error {
+	u, err := url.Parse(cl.endpoint + method)
+	if err != nil {
+		return fmt.Errorf("failed to parse beacon API URL: %w", err)
+	}
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create beacon API request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := cl.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("beacon API request %s failed: %w", method, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ethereum.NotFound, method)
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("beacon API request %s failed with status %d: %s", method, resp.StatusCode, string(body))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return fmt.Errorf("failed to decode beacon API response of %s: %w", method, err)
+	}
+	return nil
+}
+
+func (cl *BeaconHTTPClient) ConfigSpec(ctx context.Context) (APIConfigResponse, error) {
+	var configResp APIConfigResponse
+	err := cl.apiReq(ctx, &configResp, specMethod, nil)
+	return configResp, err
+}
+
+func (cl *BeaconHTTPClient) BeaconGenesis(ctx context.Context) (APIGenesisResponse, error) {
+	var genesisResp APIGenesisResponse
+	err := cl.apiReq(ctx, &genesisResp, genesisMethod, nil)
+	return genesisResp, err
+}
+
+func (cl *BeaconHTTPClient) BeaconBlobSideCars(ctx context.Context, slot uint64, indices []uint64) (APIGetBlobSidecarsResponse, error) {
+	query := url.Values{}
+	for _, i := range indices {
+		query.Add("indices", strconv.FormatUint(i, 10))
+	}
+	var resp APIGetBlobSidecarsResponse
+	err := cl.apiReq(ctx, &resp, sidecarsMethodPrefix+strconv.FormatUint(slot, 10), query)
+	return resp, err
+}
+
+// L1BeaconClient fetches and verifies the blobs of L1 blocks.
+// Blobs that cannot be retrieved from the beacon node, e.g. because they are past the retention window,
+// are retrieved from the fallback blob archivers, in order.
+type L1BeaconClient struct {
+	cl        BeaconClient
+	fallbacks []BlobSideCarsFetcher
+
+	initLock     sync.Mutex
+	timeToSlotFn TimeToSlotFn
+}
+
+// TimeToSlotFn returns the slot of the given L1 block timestamp.
+type TimeToSlotFn func(timestamp uint64) (uint64, error)
+
+// NewL1BeaconClient creates a client that fetches blobs from the beacon node, or else from the fallbacks.
+func NewL1BeaconClient(cl BeaconClient, fallbacks ...BlobSideCarsFetcher) *L1BeaconClient {
+	return &L1BeaconClient{cl: cl, fallbacks: fallbacks}
+}
+
+// GetTimeToSlotFn returns a function that converts L1 timestamps to beacon slots.
+// The beacon genesis and slot time are fetched on first use, and cached once fetched successfully.
+func (cl *L1BeaconClient) GetTimeToSlotFn(ctx context.Context) (TimeToSlotFn, error) {
+	cl.initLock.Lock()
+	defer cl.initLock.Unlock()
+	if cl.timeToSlotFn != nil {
+		return cl.timeToSlotFn, nil
+	}
+
+	genesis, err := cl.cl.BeaconGenesis(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch beacon genesis: %w", err)
+	}
+	config, err := cl.cl.ConfigSpec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch beacon config spec: %w", err)
+	}
+	genesisTime := uint64(genesis.Data.GenesisTime)
+	secondsPerSlot := uint64(config.Data.SecondsPerSlot)
+	if secondsPerSlot == 0 {
+		return nil, errors.New("beacon config has zero seconds per slot")
+	}
+	cl.timeToSlotFn = func(timestamp uint64) (uint64, error) {
+		if timestamp < genesisTime {
+			return 0, fmt.Errorf("timestamp %d is before beacon genesis %d", timestamp, genesisTime)
+		}
+		return (timestamp - genesisTime) / secondsPerSlot, nil
+	}
+	return cl.timeToSlotFn, nil
+}
+
+// GetBlobSidecars fetches the sidecars of the blobs with the given hashes in the L1 block,
+// and verifies them against the hashes. The sidecars are returned in the order of the hashes.
+func (cl *L1BeaconClient) GetBlobSidecars(ctx context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.BlobSidecar, error) {
+	if len(hashes) == 0 {
+		return nil, nil
+	}
+	timeToSlot, err := cl.GetTimeToSlotFn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := timeToSlot(ref.Time)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute slot of L1 block %s: %w", ref, err)
+	}
+	indices := make([]uint64, 0, len(hashes))
+	for _, h := range hashes {
+		indices = append(indices, h.Index)
+	}
+
+	sidecars, err := fetchBlobSidecars(ctx, cl.cl, slot, hashes, indices)
+	if err == nil {
+		return sidecars, nil
+	}
+	result := fmt.Errorf("failed to fetch blobs of L1 block %s from beacon node: %w", ref, err)
+	for i, fallback := range cl.fallbacks {
+		sidecars, err := fetchBlobSidecars(ctx, fallback, slot, hashes, indices)
+		if err == nil {
+			return sidecars, nil
+		}
+		result = errors.Join(result, fmt.Errorf("failed to fetch blobs of L1 block %s from fallback %d: %w", ref, i, err))
+	}
+	return nil, result
+}
+
+// GetBlobs fetches and verifies the blobs with the given hashes in the L1 block, in the order of the hashes.
+func (cl *L1BeaconClient) GetBlobs(ctx context.Context, ref eth.L1BlockRef, hashes []eth.IndexedBlobHash) ([]*eth.Blob, error) {
+	sidecars, err := cl.GetBlobSidecars(ctx, ref, hashes)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]*eth.Blob, len(sidecars))
+	for i, sc := range sidecars {
+		blobs[i] = &sc.Blob
+	}
+	return blobs, nil
+}
+
+// fetchBlobSidecars fetches the sidecars, and verifies that all requested blobs are present and valid.
+func fetchBlobSidecars(ctx context.Context, fetcher BlobSideCarsFetcher, slot uint64, hashes []eth.IndexedBlobHash, indices []uint64) ([]*eth.BlobSidecar, error) {
+	resp, err := fetcher.BeaconBlobSideCars(ctx, slot, indices)
+	if err != nil {
+		return nil, err
+	}
+	byIndex := make(map[uint64]*eth.BlobSidecar, len(resp.Data))
+	for _, sc := range resp.Data {
+		byIndex[uint64(sc.Index)] = sc
+	}
+	out := make([]*eth.BlobSidecar, len(hashes))
+	for i, h := range hashes {
+		sc, ok := byIndex[h.Index]
+		if !ok {
+			return nil, fmt.Errorf("missing blob %d in slot %d", h.Index, slot)
+		}
+		if err := sc.Verify(h.Hash); err != nil {
+			return nil, err
+		}
+		out[i] = sc
+	}
+	return out, nil
+}
@@ -0,0 +1,129 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+)
+
+func makeTestBlobSidecar(t *testing.T, index uint64, fill byte) (eth.IndexedBlobHash, *eth.BlobSidecar) {
+	var blob eth.Blob
+	// keep every field element below the BLS modulus
+	for i := 0; i < len(blob); i += 32 {
+		blob[i+30] = fill
+		blob[i+31] = byte(i / 32)
+	}
+	commitment, err := kzg4844.BlobToCommitment(*blob.KZGBlob())
+	require.NoError(t, err)
+	proof, err := kzg4844.ComputeBlobProof(*blob.KZGBlob(), commitment)
+	require.NoError(t, err)
+	sc := &eth.BlobSidecar{
+		Slot:          10,
+		Index:         eth.Uint64String(index),
+		Blob:          blob,
+		KZGCommitment: eth.Bytes48(commitment),
+		KZGProof:      eth.Bytes48(proof),
+	}
+	return eth.IndexedBlobHash{Index: index, Hash: eth.KZGToVersionedHash(commitment)}, sc
+}
+
+// beaconServer serves the given sidecars of slot 10, with a beacon genesis at time 100 and 12 second slots.
+func beaconServer(t *testing.T, sidecars ...*eth.BlobSidecar) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp any
+		switch {
+		case r.URL.Path == "/eth/v1/beacon/genesis":
+			resp = APIGenesisResponse{Data: ReducedGenesisData{GenesisTime: 100}}
+		case r.URL.Path == "/eth/v1/config/spec":
+			resp = APIConfigResponse{Data: ReducedConfigData{SecondsPerSlot: 12}}
+		case r.URL.Path == "/eth/v1/beacon/blob_sidecars/10":
+			indices := r.URL.Query()["indices"]
+			var out []*eth.BlobSidecar
+			for _, sc := range sidecars {
+				for _, i := range indices {
+					if i == strconv.FormatUint(uint64(sc.Index), 10) {
+						out = append(out, sc)
+					}
+				}
+			}
+			resp = APIGetBlobSidecarsResponse{Data: out}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestL1BeaconClient(t *testing.T) {
+	hash0, sc0 := makeTestBlobSidecar(t, 0, 1)
+	hash1, sc1 := makeTestBlobSidecar(t, 1, 2)
+	ref := eth.L1BlockRef{Number: 1, Time: 100 + 10*12}
+
+	t.Run("fetch and verify", func(t *testing.T) {
+		srv := beaconServer(t, sc0, sc1)
+		cl := NewL1BeaconClient(NewBeaconHTTPClient(srv.URL, nil))
+		blobs, err := cl.GetBlobs(context.Background(), ref, []eth.IndexedBlobHash{hash1, hash0})
+		require.NoError(t, err)
+		require.Len(t, blobs, 2)
+		require.Equal(t, sc1.Blob, *blobs[0])
+		require.Equal(t, sc0.Blob, *blobs[1])
+	})
+
+	t.Run("invalid commitment", func(t *testing.T) {
+		_, other := makeTestBlobSidecar(t, 0, 3)
+		srv := beaconServer(t, other)
+		cl := NewL1BeaconClient(NewBeaconHTTPClient(srv.URL, nil))
+		_, err := cl.GetBlobs(context.Background(), ref, []eth.IndexedBlobHash{hash0})
+		require.ErrorContains(t, err, "commitment hashes to")
+	})
+
+	t.Run("invalid proof", func(t *testing.T) {
+		invalid := *sc0
+		invalid.KZGProof = sc1.KZGProof
+		srv := beaconServer(t, &invalid)
+		cl := NewL1BeaconClient(NewBeaconHTTPClient(srv.URL, nil))
+		_, err := cl.GetBlobs(context.Background(), ref, []eth.IndexedBlobHash{hash0})
+		require.ErrorContains(t, err, "invalid KZG proof")
+	})
+
+	t.Run("archiver fallback", func(t *testing.T) {
+		// the beacon node pruned blob 1
+		beacon := beaconServer(t, sc0)
+		archiver := beaconServer(t, sc0, sc1)
+		failing := NewBeaconHTTPClient("http://127.0.0.1:1", nil)
+		cl := NewL1BeaconClient(NewBeaconHTTPClient(beacon.URL, nil), failing, NewBeaconHTTPClient(archiver.URL, nil))
+		blobs, err := cl.GetBlobs(context.Background(), ref, []eth.IndexedBlobHash{hash0, hash1})
+		require.NoError(t, err)
+		require.Equal(t, sc1.Blob, *blobs[1])
+
+		cl = NewL1BeaconClient(NewBeaconHTTPClient(beacon.URL, nil), failing)
+		_, err = cl.GetBlobs(context.Background(), ref, []eth.IndexedBlobHash{hash0, hash1})
+		require.ErrorContains(t, err, "missing blob 1")
+		require.ErrorContains(t, err, "fallback 0")
+	})
+
+	t.Run("block before genesis", func(t *testing.T) {
+		srv := beaconServer(t)
+		cl := NewL1BeaconClient(NewBeaconHTTPClient(srv.URL, nil))
+		_, err := cl.GetBlobs(context.Background(), eth.L1BlockRef{Time: 99}, []eth.IndexedBlobHash{hash0})
+		require.ErrorContains(t, err, "before beacon genesis")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		srv := beaconServer(t)
+		_, err := NewBeaconHTTPClient(srv.URL, nil).BeaconBlobSideCars(context.Background(), 11, []uint64{0})
+		require.ErrorIs(t, err, ethereum.NotFound)
+	})
+}
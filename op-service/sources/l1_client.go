@@ -48,6 +48,17 @@ func L1ClientDefaultConfig(config *rollup.Config, trustRPC bool, kind RPCProvide
 	}
 }
 
+// SetCacheSize overrides the number of L1 blocks that headers, receipts, transactions and block references are cached for.
+// Derivation re-reads the L1 data of the sequencing window after every pipeline reset,
+// so the cache should cover the sequencing window to avoid re-fetching the data.
+func (c *L1ClientConfig) SetCacheSize(blocks int) {
+	c.ReceiptsCacheSize = blocks
+	c.TransactionsCacheSize = blocks
+	c.HeadersCacheSize = blocks
+	c.PayloadsCacheSize = blocks
+	c.L1BlockRefsCacheSize = blocks
+}
+
 // L1Client provides typed bindings to retrieve L1 data from an RPC source,
 // with optimized batch requests, cached results, and flag to not trust the RPC
 // (i.e. to verify all returned contents against corresponding block hashes).
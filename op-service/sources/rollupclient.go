@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/driver"
 	"github.com/ethereum-optimism/optimism/op-service/client"
 	"github.com/ethereum-optimism/optimism/op-service/eth"
 )
@@ -44,6 +45,10 @@ func (r *RollupClient) Version(ctx context.Context) (string, error) {
 	return output, err
 }
 
+func (r *RollupClient) SetUnsafeHead(ctx context.Context, num uint64) error {
+	return r.rpc.CallContext(ctx, nil, "admin_setUnsafeHead", hexutil.Uint64(num))
+}
+
 func (r *RollupClient) StartSequencer(ctx context.Context, unsafeHead common.Hash) error {
 	return r.rpc.CallContext(ctx, nil, "admin_startSequencer", unsafeHead)
 }
@@ -60,10 +65,25 @@ func (r *RollupClient) SequencerActive(ctx context.Context) (bool, error) {
 	return result, err
 }
 
+func (r *RollupClient) SequencerPolicy(ctx context.Context) (driver.SequencerPolicy, error) {
+	var result driver.SequencerPolicy
+	err := r.rpc.CallContext(ctx, &result, "admin_sequencerPolicy")
+	return result, err
+}
+
+func (r *RollupClient) SetSequencerPolicy(ctx context.Context, policy driver.SequencerPolicy) error {
+	return r.rpc.CallContext(ctx, nil, "admin_setSequencerPolicy", policy)
+}
+
 func (r *RollupClient) SetLogLevel(ctx context.Context, lvl log.Lvl) error {
 	return r.rpc.CallContext(ctx, nil, "admin_setLogLevel", lvl.String())
 }
 
+// SetModuleLogLevel overrides the log level of a module. An empty level string removes the override.
+func (r *RollupClient) SetModuleLogLevel(ctx context.Context, module string, lvl string) error {
+	return r.rpc.CallContext(ctx, nil, "admin_setModuleLogLevel", module, lvl)
+}
+
 func (r *RollupClient) Close() {
 	r.rpc.Close()
 }
@@ -0,0 +1,34 @@
+package sources
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup/interop"
+	"github.com/ethereum-optimism/optimism/op-service/client"
+)
+
+// SupervisorClient is the client of the interop supervisor, which indexes the initiating messages
+// of the chains in the dependency set, to verify the executing messages in the blocks of each chain.
+type SupervisorClient struct {
+	rpc client.RPC
+}
+
+func NewSupervisorClient(rpc client.RPC) *SupervisorClient {
+	return &SupervisorClient{rpc}
+}
+
+// CheckBlock returns the safety level of the given L2 block of the given chain,
+// based on the executing messages in the block.
+func (cl *SupervisorClient) CheckBlock(ctx context.Context, chainID *big.Int, blockHash common.Hash, blockNumber uint64) (interop.SafetyLevel, error) {
+	var output interop.SafetyLevel
+	err := cl.rpc.CallContext(ctx, &output, "supervisor_checkBlock", (*hexutil.Big)(chainID), blockHash, hexutil.Uint64(blockNumber))
+	return output, err
+}
+
+func (cl *SupervisorClient) Close() {
+	cl.rpc.Close()
+}
@@ -0,0 +1,81 @@
+// Package tlstest creates certificate authorities and certificates, to test mutual TLS between services.
+package tlstest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	optls "github.com/ethereum-optimism/optimism/op-service/tls"
+)
+
+// CA is a certificate authority that issues certificates for tests.
+type CA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	// Path is the path of the PEM encoded CA certificate.
+	Path string
+}
+
+// writePEM writes a PEM file to the dir and returns its path
+func writePEM(t *testing.T, dir, name, blockType string, data []byte) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: data}), 0o600))
+	return path
+}
+
+// NewCA creates a certificate authority, and writes its certificate to the dir.
+func NewCA(t *testing.T, dir string) *CA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &CA{cert: cert, key: key, Path: writePEM(t, dir, "ca.crt", "CERTIFICATE", der)}
+}
+
+// Issue creates a cert/key pair for 127.0.0.1 signed by the CA, usable by both servers and clients,
+// and returns the TLS config that uses it.
+func (ca *CA) Issue(t *testing.T, dir string, name string, serial int64) optls.CLIConfig {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return optls.CLIConfig{
+		TLSCaCert: ca.Path,
+		TLSCert:   writePEM(t, dir, name+".crt", "CERTIFICATE", der),
+		TLSKey:    writePEM(t, dir, name+".key", "EC PRIVATE KEY", keyDER),
+	}
+}
@@ -60,6 +60,36 @@ func CLIFlagsWithFlagPrefix(envPrefix string, flagPrefix string) []cli.Flag {
 	}
 }
 
+// OptionalCLIFlags returns flags with env var and cli flag prefixes, for links that do not use TLS by default.
+// Unlike CLIFlagsWithFlagPrefix, the flags have no default paths, so TLS is disabled unless all flags are set,
+// and the env vars are prefixed with the flag prefix too, e.g. OP_BATCHER_RPC_TLS_CA for the rpc flag prefix.
+func OptionalCLIFlags(envPrefix string, flagPrefix string) []cli.Flag {
+	prefixFunc := func(flagName string) string {
+		return strings.Trim(fmt.Sprintf("%s.%s", flagPrefix, flagName), ".")
+	}
+	envName := strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(flagPrefix))
+	prefixEnvVars := func(name string) []string {
+		return opservice.PrefixEnvVar(envPrefix, strings.Trim(envName+"_"+name, "_"))
+	}
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    prefixFunc(TLSCaCertFlagName),
+			Usage:   "tls ca cert path, to verify the certificates of the other side of the link",
+			EnvVars: prefixEnvVars("TLS_CA"),
+		},
+		&cli.StringFlag{
+			Name:    prefixFunc(TLSCertFlagName),
+			Usage:   "tls cert path",
+			EnvVars: prefixEnvVars("TLS_CERT"),
+		},
+		&cli.StringFlag{
+			Name:    prefixFunc(TLSKeyFlagName),
+			Usage:   "tls key",
+			EnvVars: prefixEnvVars("TLS_KEY"),
+		},
+	}
+}
+
 type CLIConfig struct {
 	TLSCaCert string
 	TLSCert   string
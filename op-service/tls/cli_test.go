@@ -55,3 +55,20 @@ func configForArgs(args ...string) CLIConfig {
 	_ = app.Run(args)
 	return config
 }
+
+func TestOptionalCLIFlags(t *testing.T) {
+	app := cli.NewApp()
+	app.Flags = OptionalCLIFlags("TEST", "rollup-rpc")
+	app.Name = "test"
+	var config CLIConfig
+	app.Action = func(ctx *cli.Context) error {
+		config = ReadCLIConfigWithPrefix(ctx, "rollup-rpc")
+		return nil
+	}
+	require.NoError(t, app.Run([]string{"test"}))
+	require.False(t, config.TLSEnabled(), "disabled by default")
+
+	t.Setenv("TEST_ROLLUP_RPC_TLS_CA", "ca.crt")
+	require.NoError(t, app.Run([]string{"test", "--rollup-rpc.tls.cert=tls.crt", "--rollup-rpc.tls.key=tls.key"}))
+	require.Equal(t, CLIConfig{TLSCaCert: "ca.crt", TLSCert: "tls.crt", TLSKey: "tls.key"}, config)
+}
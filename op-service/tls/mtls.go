@@ -0,0 +1,120 @@
+package tls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/ethereum-optimism/optimism/op-service/tls/certman"
+)
+
+// ClientAuth determines if and how a server verifies the certificates of clients.
+type ClientAuth uint8
+
+const (
+	// NoClientCert does not request a client certificate.
+	NoClientCert ClientAuth = iota
+	// VerifyClientCertIfGiven verifies client certificates against the CA, but does not require clients to present one.
+	VerifyClientCertIfGiven
+	// RequireAndVerifyClientCert requires all clients to present a certificate that is signed by the CA.
+	RequireAndVerifyClientCert
+)
+
+func (c ClientAuth) tlsClientAuth() tls.ClientAuthType {
+	switch c {
+	case VerifyClientCertIfGiven:
+		return tls.VerifyClientCertIfGiven
+	case RequireAndVerifyClientCert:
+		return tls.RequireAndVerifyClientCert
+	default:
+		return tls.NoClientCert
+	}
+}
+
+// LoadCertPool reads the PEM encoded CA certificates at the given path into a new certificate pool.
+func LoadCertPool(caCertPath string) (*x509.CertPool, error) {
+	caCert, err := os.ReadFile(caCertPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tls ca cert: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("no valid certificates found in tls ca cert %q", caCertPath)
+	}
+	return pool, nil
+}
+
+// newCertMan creates a certman that is watching the configured cert and key for changes.
+func newCertMan(logger log.Logger, cfg CLIConfig) (*certman.CertMan, error) {
+	// certman watches for newer certificates and automatically reloads them
+	cm, err := certman.New(logger, cfg.TLSCert, cfg.TLSKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tls cert or key: %w", err)
+	}
+	if err := cm.Watch(); err != nil {
+		return nil, fmt.Errorf("failed to start certman watcher: %w", err)
+	}
+	return cm, nil
+}
+
+// NewServerTLSConfig creates the TLS config of a server, serving the configured certificate and key,
+// which are reloaded when they change on disk.
+// Client certificates are verified against the configured CA, as determined by clientAuth,
+// to authenticate clients with mutual TLS.
+func NewServerTLSConfig(logger log.Logger, cfg CLIConfig, clientAuth ClientAuth) (*tls.Config, error) {
+	if err := cfg.Check(); err != nil {
+		return nil, err
+	}
+	if !cfg.TLSEnabled() {
+		return nil, errors.New("tls is not configured")
+	}
+	cm, err := newCertMan(logger, cfg)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS13,
+		GetCertificate: cm.GetCertificate,
+		ClientAuth:     clientAuth.tlsClientAuth(),
+	}
+	if clientAuth != NoClientCert {
+		pool, err := LoadCertPool(cfg.TLSCaCert)
+		if err != nil {
+			cm.Stop()
+			return nil, err
+		}
+		tlsConfig.ClientCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+// NewClientTLSConfig creates the TLS config of a client, verifying servers against the configured CA,
+// and presenting the configured certificate and key to servers that request a client certificate.
+// The client certificate is reloaded when it changes on disk.
+func NewClientTLSConfig(logger log.Logger, cfg CLIConfig) (*tls.Config, error) {
+	if err := cfg.Check(); err != nil {
+		return nil, err
+	}
+	if !cfg.TLSEnabled() {
+		return nil, errors.New("tls is not configured")
+	}
+	pool, err := LoadCertPool(cfg.TLSCaCert)
+	if err != nil {
+		return nil, err
+	}
+	cm, err := newCertMan(logger, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS13,
+		RootCAs:    pool,
+		GetClientCertificate: func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return cm.GetCertificate(nil)
+		},
+	}, nil
+}
@@ -0,0 +1,41 @@
+package tls
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadCertPool(t *testing.T) {
+	_, err := LoadCertPool("./certman/testdata/server.crt")
+	require.NoError(t, err)
+
+	_, err = LoadCertPool("./certman/testdata/nothere.crt")
+	require.ErrorContains(t, err, "failed to read tls ca cert")
+
+	invalid := filepath.Join(t.TempDir(), "invalid.crt")
+	require.NoError(t, os.WriteFile(invalid, []byte("not a cert"), 0o600))
+	_, err = LoadCertPool(invalid)
+	require.ErrorContains(t, err, "no valid certificates")
+}
+
+func TestNewServerTLSConfig(t *testing.T) {
+	cfg := CLIConfig{
+		TLSCaCert: "./certman/testdata/server.crt",
+		TLSCert:   "./certman/testdata/server.crt",
+		TLSKey:    "./certman/testdata/server.key",
+	}
+	tlsConfig, err := NewServerTLSConfig(log.Root(), cfg, NoClientCert)
+	require.NoError(t, err)
+	require.Nil(t, tlsConfig.ClientCAs)
+
+	tlsConfig, err = NewServerTLSConfig(log.Root(), cfg, RequireAndVerifyClientCert)
+	require.NoError(t, err)
+	require.NotNil(t, tlsConfig.ClientCAs)
+
+	_, err = NewServerTLSConfig(log.Root(), CLIConfig{}, NoClientCert)
+	require.ErrorContains(t, err, "tls is not configured")
+}
@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracer returns the named tracer of the global tracer provider.
+// Spans of the tracer are not recorded unless tracing was started with StartTracing.
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer(name)
+}
+
+// EndSpan ends the span, and marks it as failed if err is not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Provider exports the spans of the process to an OTLP collector.
+type Provider struct {
+	tp *sdktrace.TracerProvider
+}
+
+// StartTracing sets up the global tracer provider to export spans as configured.
+// The service name and version are attached to all exported spans. Returns nil if tracing is not enabled.
+func StartTracing(ctx context.Context, logger log.Logger, cfg CLIConfig, service string, version string) (*Provider, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	// The exporter connects lazily, so an unavailable collector does not prevent the service from starting.
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(service),
+		semconv.ServiceVersion(version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing resource: %w", err)
+	}
+	p := newProvider(exporter, res, cfg.SampleRatio)
+	logger.Info("exporting traces", "endpoint", cfg.Endpoint, "sample_ratio", cfg.SampleRatio)
+	return p, nil
+}
+
+func newProvider(exporter sdktrace.SpanExporter, res *resource.Resource, sampleRatio float64) *Provider {
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return &Provider{tp: tp}
+}
+
+// Stop flushes the spans that were not exported yet, and stops exporting.
+func (p *Provider) Stop(ctx context.Context) error {
+	if err := p.tp.Shutdown(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		return fmt.Errorf("failed to stop tracing: %w", err)
+	}
+	return nil
+}
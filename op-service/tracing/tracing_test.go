@@ -0,0 +1,57 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+)
+
+func TestCLIConfigCheck(t *testing.T) {
+	cfg := DefaultCLIConfig()
+	require.NoError(t, cfg.Check())
+
+	cfg.Enabled = true
+	require.NoError(t, cfg.Check())
+
+	cfg.SampleRatio = 1.5
+	require.ErrorContains(t, cfg.Check(), "sample ratio")
+
+	cfg.SampleRatio = 0.5
+	cfg.Endpoint = ""
+	require.ErrorContains(t, cfg.Check(), "endpoint")
+}
+
+func TestStartTracingDisabled(t *testing.T) {
+	p, err := StartTracing(context.Background(), testlog.Logger(t, log.LvlCrit), DefaultCLIConfig(), "test", "v0.0.0")
+	require.NoError(t, err)
+	require.Nil(t, p)
+}
+
+func TestEndSpan(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	p := newProvider(exporter, resource.Default(), 1)
+
+	_, span := Tracer("test").Start(context.Background(), "ok")
+	EndSpan(span, nil)
+	_, span = Tracer("test").Start(context.Background(), "failed")
+	EndSpan(span, errors.New("boom"))
+
+	// the in-memory exporter discards its spans when it is shut down, so they are flushed without stopping
+	require.NoError(t, p.tp.ForceFlush(context.Background()))
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	require.Equal(t, "ok", spans[0].Name)
+	require.Equal(t, codes.Unset, spans[0].Status.Code)
+	require.Equal(t, "failed", spans[1].Name)
+	require.Equal(t, codes.Error, spans[1].Status.Code)
+	require.Equal(t, "boom", spans[1].Status.Description)
+	require.NoError(t, p.Stop(context.Background()))
+}
@@ -18,6 +18,12 @@ type TxReceipt[T any] struct {
 	Err error
 }
 
+// Queue sends many transactions concurrently through a TxManager, which assigns the nonces of the
+// transactions, and bumps the fees of each transaction until it is confirmed. The number of pending
+// transactions is bounded by the max pending.
+//
+// If a transaction fails, the TxManager resets its nonce, so all pending transactions, which may have
+// higher nonces, are canceled before further transactions are sent.
 type Queue[T any] struct {
 	ctx        context.Context
 	txMgr      TxManager
@@ -80,6 +86,18 @@ func (q *Queue[T]) TrySend(id T, candidate TxCandidate, receiptCh chan TxReceipt
 	})
 }
 
+// SendAsync will wait until the number of pending txs is below the max pending,
+// and then send the next tx, like Send.
+//
+// It returns a future of the receipt: a buffered channel that receives the receipt
+// of the tx once it is confirmed or failed, so that the caller does not have to read
+// from a shared receipt channel.
+func (q *Queue[T]) SendAsync(id T, candidate TxCandidate) <-chan TxReceipt[T] {
+	receiptCh := make(chan TxReceipt[T], 1)
+	q.Send(id, candidate, receiptCh)
+	return receiptCh
+}
+
 func (q *Queue[T]) sendTx(ctx context.Context, id T, candidate TxCandidate, receiptCh chan TxReceipt[T]) error {
 	receipt, err := q.txMgr.Send(ctx, candidate)
 	receiptCh <- TxReceipt[T]{
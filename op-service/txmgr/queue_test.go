@@ -28,8 +28,16 @@ func trySendQueueFunc(id int, candidate TxCandidate, receiptCh chan TxReceipt[in
 	return q.TrySend(id, candidate, receiptCh)
 }
 
+func sendAsyncQueueFunc(id int, candidate TxCandidate, receiptCh chan TxReceipt[int], q *Queue[int]) bool {
+	future := q.SendAsync(id, candidate)
+	go func() {
+		receiptCh <- <-future
+	}()
+	return true
+}
+
 type queueCall struct {
-	call   queueFunc // queue call (either Send or TrySend, use function helpers above)
+	call   queueFunc // queue call (either Send, TrySend or SendAsync, use function helpers above)
 	queued bool      // true if the send was queued
 	txErr  bool      // true if the tx send should return an error
 }
@@ -146,6 +154,24 @@ func TestSend(t *testing.T) {
 			nonces: []uint64{0, 1, 2, 3, 4},
 			total:  3 * time.Second,
 		},
+		{
+			name: "dual threaded async",
+			max:  2,
+			calls: []queueCall{
+				{call: sendAsyncQueueFunc, queued: true},
+				{call: sendAsyncQueueFunc, queued: true},
+				{call: trySendQueueFunc, queued: false},
+				{call: sendAsyncQueueFunc, queued: true},
+			},
+			txs: []testTx{
+				{},
+				{},
+				{},
+				{},
+			},
+			nonces: []uint64{0, 1, 2},
+			total:  2 * time.Second,
+		},
 		{
 			name: "subsequent txs fail after tx failure",
 			max:  1,
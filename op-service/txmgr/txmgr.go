@@ -12,11 +12,15 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/holiman/uint256"
 
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/retry"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
 )
@@ -29,6 +33,7 @@ const (
 // new = old * (100 + priceBump) / 100
 var priceBumpPercent = big.NewInt(100 + priceBump)
 var oneHundred = big.NewInt(100)
+var two = big.NewInt(2)
 
 // TxManager is an interface that allows callers to reliably publish txs,
 // bumping the gas price if needed, and obtain the receipt of the resulting tx.
@@ -146,6 +151,9 @@ type TxCandidate struct {
 	GasLimit uint64
 	// Value is the value to be used in the constructed tx.
 	Value *big.Int
+	// Blobs to send along with the tx. If set, the tx is an EIP-4844 blob tx,
+	// which is priced with the blob base fee of the L1 head, and which must have a recipient.
+	Blobs []*eth.Blob
 }
 
 // Send is used to publish a transaction with incrementally higher gas prices
@@ -201,44 +209,97 @@ func (m *SimpleTxManager) send(ctx context.Context, candidate TxCandidate) (*typ
 // NOTE: If the [TxCandidate.GasLimit] is non-zero, it will be used as the transaction's gas.
 // NOTE: Otherwise, the [SimpleTxManager] will query the specified backend for an estimate.
 func (m *SimpleTxManager) craftTx(ctx context.Context, candidate TxCandidate) (*types.Transaction, error) {
-	gasTipCap, basefee, err := m.suggestGasPriceCaps(ctx)
+	gasTipCap, basefee, blobBaseFee, err := m.suggestGasPriceCaps(ctx)
 	if err != nil {
 		m.metr.RPCError()
 		return nil, fmt.Errorf("failed to get gas price info: %w", err)
 	}
 	gasFeeCap := calcGasFeeCap(basefee, gasTipCap)
 
-	rawTx := &types.DynamicFeeTx{
-		ChainID:   m.chainID,
-		To:        candidate.To,
-		GasTipCap: gasTipCap,
-		GasFeeCap: gasFeeCap,
-		Data:      candidate.TxData,
-		Value:     candidate.Value,
-	}
+	m.l.Info("Creating tx", "to", candidate.To, "from", m.cfg.From, "blobs", len(candidate.Blobs))
 
-	m.l.Info("Creating tx", "to", rawTx.To, "from", m.cfg.From)
-
-	// If the gas limit is set, we can use that as the gas
-	if candidate.GasLimit != 0 {
-		rawTx.Gas = candidate.GasLimit
-	} else {
+	gasLimit := candidate.GasLimit
+	// If the gas limit is not set, estimate it. The blobs do not change the gas of the execution.
+	if gasLimit == 0 {
 		// Calculate the intrinsic gas for the transaction
 		gas, err := m.backend.EstimateGas(ctx, ethereum.CallMsg{
 			From:      m.cfg.From,
 			To:        candidate.To,
 			GasFeeCap: gasFeeCap,
 			GasTipCap: gasTipCap,
-			Data:      rawTx.Data,
-			Value:     rawTx.Value,
+			Data:      candidate.TxData,
+			Value:     candidate.Value,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to estimate gas: %w", err)
 		}
-		rawTx.Gas = gas
+		gasLimit = gas
+	}
+
+	var txMessage types.TxData
+	if len(candidate.Blobs) > 0 {
+		if candidate.To == nil {
+			return nil, errors.New("blob txs cannot deploy contracts")
+		}
+		if blobBaseFee == nil {
+			return nil, errors.New("the L1 head has no blob base fee, blob txs require the Cancun upgrade")
+		}
+		sidecar, blobHashes, err := MakeSidecar(candidate.Blobs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to make sidecar: %w", err)
+		}
+		value := new(uint256.Int)
+		if candidate.Value != nil {
+			value = uint256.MustFromBig(candidate.Value)
+		}
+		txMessage = &types.BlobTx{
+			ChainID:    uint256.MustFromBig(m.chainID),
+			To:         *candidate.To,
+			GasTipCap:  uint256.MustFromBig(gasTipCap),
+			GasFeeCap:  uint256.MustFromBig(gasFeeCap),
+			BlobFeeCap: uint256.MustFromBig(calcBlobFeeCap(blobBaseFee)),
+			Gas:        gasLimit,
+			Value:      value,
+			Data:       candidate.TxData,
+			BlobHashes: blobHashes,
+			Sidecar:    sidecar,
+		}
+	} else {
+		txMessage = &types.DynamicFeeTx{
+			ChainID:   m.chainID,
+			To:        candidate.To,
+			GasTipCap: gasTipCap,
+			GasFeeCap: gasFeeCap,
+			Gas:       gasLimit,
+			Data:      candidate.TxData,
+			Value:     candidate.Value,
+		}
 	}
 
-	return m.signWithNextNonce(ctx, rawTx)
+	return m.signWithNextNonce(ctx, txMessage)
+}
+
+// MakeSidecar builds the sidecar of a blob tx with the given blobs, and returns it with the versioned hashes
+// of the blobs.
+func MakeSidecar(blobs []*eth.Blob) (*types.BlobTxSidecar, []common.Hash, error) {
+	sidecar := &types.BlobTxSidecar{}
+	blobHashes := make([]common.Hash, 0, len(blobs))
+	for i, blob := range blobs {
+		rawBlob := *blob.KZGBlob()
+		commitment, err := kzg4844.BlobToCommitment(rawBlob)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot compute KZG commitment of blob %d: %w", i, err)
+		}
+		proof, err := kzg4844.ComputeBlobProof(rawBlob, commitment)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot compute KZG proof of blob %d: %w", i, err)
+		}
+		sidecar.Blobs = append(sidecar.Blobs, rawBlob)
+		sidecar.Commitments = append(sidecar.Commitments, commitment)
+		sidecar.Proofs = append(sidecar.Proofs, proof)
+		blobHashes = append(blobHashes, eth.KZGToVersionedHash(commitment))
+	}
+	return sidecar, blobHashes, nil
 }
 
 // signWithNextNonce returns a signed transaction with the next available nonce.
@@ -246,7 +307,7 @@ func (m *SimpleTxManager) craftTx(ctx context.Context, candidate TxCandidate) (*
 // then subsequent calls simply increment this number. If the transaction manager
 // is reset, it will query the eth_getTransactionCount nonce again. If signing
 // fails, the nonce is not incremented.
-func (m *SimpleTxManager) signWithNextNonce(ctx context.Context, rawTx *types.DynamicFeeTx) (*types.Transaction, error) {
+func (m *SimpleTxManager) signWithNextNonce(ctx context.Context, txMessage types.TxData) (*types.Transaction, error) {
 	m.nonceLock.Lock()
 	defer m.nonceLock.Unlock()
 
@@ -264,10 +325,18 @@ func (m *SimpleTxManager) signWithNextNonce(ctx context.Context, rawTx *types.Dy
 		*m.nonce++
 	}
 
-	rawTx.Nonce = *m.nonce
+	switch x := txMessage.(type) {
+	case *types.DynamicFeeTx:
+		x.Nonce = *m.nonce
+	case *types.BlobTx:
+		x.Nonce = *m.nonce
+	default:
+		*m.nonce--
+		return nil, fmt.Errorf("unrecognized tx type: %T", x)
+	}
 	ctx, cancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
 	defer cancel()
-	tx, err := m.cfg.Signer(ctx, m.cfg.From, types.NewTx(rawTx))
+	tx, err := m.cfg.Signer(ctx, m.cfg.From, types.NewTx(txMessage))
 	if err != nil {
 		// decrement the nonce, so we can retry signing with the same nonce next time
 		// signWithNextNonce is called
@@ -503,14 +572,22 @@ func (m *SimpleTxManager) queryReceipt(ctx context.Context, txHash common.Hash,
 // rules, and no lower than the values returned by the fee suggestion algorithm to ensure it
 // doesn't linger in the mempool. Finally to avoid runaway price increases, fees are capped at a
 // `feeLimitMultiplier` multiple of the suggested values.
+//
+// The blob pool of geth requires the fees of blob tx replacements to be doubled, so the fees of blob txs,
+// including the blob fee cap, are bumped by at least 100%.
 func (m *SimpleTxManager) increaseGasPrice(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
 	m.l.Info("bumping gas price for tx", "hash", tx.Hash(), "tip", tx.GasTipCap(), "fee", tx.GasFeeCap(), "gaslimit", tx.Gas())
-	tip, basefee, err := m.suggestGasPriceCaps(ctx)
+	tip, basefee, blobBaseFee, err := m.suggestGasPriceCaps(ctx)
 	if err != nil {
 		m.l.Warn("failed to get suggested gas tip and basefee", "err", err)
 		return nil, err
 	}
 	bumpedTip, bumpedFee := updateFees(tx.GasTipCap(), tx.GasFeeCap(), tip, basefee, m.l)
+	isBlobTx := tx.Type() == types.BlobTxType
+	if isBlobTx {
+		bumpedTip = maxBig(bumpedTip, new(big.Int).Mul(tx.GasTipCap(), two))
+		bumpedFee = maxBig(bumpedFee, new(big.Int).Mul(tx.GasFeeCap(), two))
+	}
 
 	// Make sure increase is at most [FeeLimitMultiplier] the suggested values
 	maxTip := new(big.Int).Mul(tip, big.NewInt(int64(m.cfg.FeeLimitMultiplier)))
@@ -521,6 +598,9 @@ func (m *SimpleTxManager) increaseGasPrice(ctx context.Context, tx *types.Transa
 	if bumpedFee.Cmp(maxFee) > 0 {
 		return nil, fmt.Errorf("bumped fee 0x%s is over %dx multiple of the suggested value", bumpedFee.Text(16), m.cfg.FeeLimitMultiplier)
 	}
+	if isBlobTx {
+		return m.increaseBlobGasPrice(ctx, tx, bumpedTip, bumpedFee, blobBaseFee)
+	}
 	rawTx := &types.DynamicFeeTx{
 		ChainID:    tx.ChainId(),
 		Nonce:      tx.Nonce(),
@@ -563,27 +643,68 @@ func (m *SimpleTxManager) increaseGasPrice(ctx context.Context, tx *types.Transa
 	return newTx, nil
 }
 
-// suggestGasPriceCaps suggests what the new tip & new basefee should be based on the current L1 conditions
-func (m *SimpleTxManager) suggestGasPriceCaps(ctx context.Context) (*big.Int, *big.Int, error) {
+// increaseBlobGasPrice returns the blob tx with the bumped tip and fee cap, and a blob fee cap that is
+// at least double the previous one, and no lower than the one suggested by the blob base fee.
+// The gas limit is not re-estimated, since the blobs do not change the gas of the execution.
+func (m *SimpleTxManager) increaseBlobGasPrice(ctx context.Context, tx *types.Transaction, bumpedTip, bumpedFee, blobBaseFee *big.Int) (*types.Transaction, error) {
+	if blobBaseFee == nil {
+		return nil, errors.New("the L1 head has no blob base fee, blob txs require the Cancun upgrade")
+	}
+	bumpedBlobFee := maxBig(calcBlobFeeCap(blobBaseFee), new(big.Int).Mul(tx.BlobGasFeeCap(), two))
+	maxBlobFee := new(big.Int).Mul(calcBlobFeeCap(blobBaseFee), big.NewInt(int64(m.cfg.FeeLimitMultiplier)))
+	if bumpedBlobFee.Cmp(maxBlobFee) > 0 {
+		return nil, fmt.Errorf("bumped blob fee 0x%s is over %dx multiple of the suggested value", bumpedBlobFee.Text(16), m.cfg.FeeLimitMultiplier)
+	}
+	rawTx := &types.BlobTx{
+		ChainID:    uint256.MustFromBig(tx.ChainId()),
+		Nonce:      tx.Nonce(),
+		GasTipCap:  uint256.MustFromBig(bumpedTip),
+		GasFeeCap:  uint256.MustFromBig(bumpedFee),
+		Gas:        tx.Gas(),
+		To:         *tx.To(),
+		Value:      uint256.MustFromBig(tx.Value()),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+		BlobFeeCap: uint256.MustFromBig(bumpedBlobFee),
+		BlobHashes: tx.BlobHashes(),
+		Sidecar:    tx.BlobTxSidecar(),
+	}
+	ctx, cancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
+	defer cancel()
+	newTx, err := m.cfg.Signer(ctx, m.cfg.From, types.NewTx(rawTx))
+	if err != nil {
+		m.l.Warn("failed to sign new transaction", "err", err)
+		return tx, nil
+	}
+	return newTx, nil
+}
+
+// suggestGasPriceCaps suggests what the new tip, basefee & blob base fee should be based on the current L1 conditions.
+// The blob base fee is nil if the L1 head is from before the Cancun upgrade.
+func (m *SimpleTxManager) suggestGasPriceCaps(ctx context.Context) (*big.Int, *big.Int, *big.Int, error) {
 	cCtx, cancel := context.WithTimeout(ctx, m.cfg.NetworkTimeout)
 	defer cancel()
 	tip, err := m.backend.SuggestGasTipCap(cCtx)
 	if err != nil {
 		m.metr.RPCError()
-		return nil, nil, fmt.Errorf("failed to fetch the suggested gas tip cap: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to fetch the suggested gas tip cap: %w", err)
 	} else if tip == nil {
-		return nil, nil, errors.New("the suggested tip was nil")
+		return nil, nil, nil, errors.New("the suggested tip was nil")
 	}
 	cCtx, cancel = context.WithTimeout(ctx, m.cfg.NetworkTimeout)
 	defer cancel()
 	head, err := m.backend.HeaderByNumber(cCtx, nil)
 	if err != nil {
 		m.metr.RPCError()
-		return nil, nil, fmt.Errorf("failed to fetch the suggested basefee: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to fetch the suggested basefee: %w", err)
 	} else if head.BaseFee == nil {
-		return nil, nil, errors.New("txmgr does not support pre-london blocks that do not have a basefee")
+		return nil, nil, nil, errors.New("txmgr does not support pre-london blocks that do not have a basefee")
 	}
-	return tip, head.BaseFee, nil
+	var blobBaseFee *big.Int
+	if head.ExcessBlobGas != nil {
+		blobBaseFee = eip4844.CalcBlobFee(*head.ExcessBlobGas)
+	}
+	return tip, head.BaseFee, blobBaseFee, nil
 }
 
 // calcThresholdValue returns x * priceBumpPercent / 100
@@ -636,6 +757,19 @@ func calcGasFeeCap(baseFee, gasTipCap *big.Int) *big.Int {
 	)
 }
 
+// calcBlobFeeCap computes the recommended blob fee cap given the blob base fee, which is
+// double the blob base fee, so that the tx stays includable if the blob base fee rises for a few blocks.
+func calcBlobFeeCap(blobBaseFee *big.Int) *big.Int {
+	return new(big.Int).Mul(blobBaseFee, two)
+}
+
+func maxBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
+
 // errStringMatch returns true if err.Error() is a substring in target.Error() or if both are nil.
 // It can accept nil errors without issue.
 func errStringMatch(err, target error) bool {
@@ -11,14 +11,17 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/holiman/uint256"
 )
 
 type sendTransactionFunc func(ctx context.Context, tx *types.Transaction) error
@@ -202,8 +205,10 @@ func (b *mockBackend) CallContract(ctx context.Context, call ethereum.CallMsg, b
 }
 
 func (b *mockBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	excessBlobGas := uint64(0)
 	return &types.Header{
-		BaseFee: b.g.basefee(),
+		BaseFee:       b.g.basefee(),
+		ExcessBlobGas: &excessBlobGas,
 	}, nil
 }
 
@@ -403,6 +408,34 @@ func TestTxMgr_CraftTx(t *testing.T) {
 	require.Equal(t, candidate.GasLimit, tx.Gas())
 }
 
+// TestTxMgr_CraftBlobTx ensures that candidates with blobs are crafted as blob txs with a sidecar,
+// and a blob fee cap that is based on the blob base fee.
+func TestTxMgr_CraftBlobTx(t *testing.T) {
+	t.Parallel()
+	h := newTestHarness(t)
+	candidate := h.createTxCandidate()
+	var blob eth.Blob
+	require.NoError(t, blob.FromData([]byte("batch data")))
+	candidate.Blobs = []*eth.Blob{&blob}
+
+	gasTipCap, gasFeeCap := h.gasPricer.feesForEpoch(h.gasPricer.epoch + 1)
+	tx, err := h.mgr.craftTx(context.Background(), candidate)
+	require.NoError(t, err)
+	require.Equal(t, uint8(types.BlobTxType), tx.Type())
+	require.Equal(t, gasTipCap, tx.GasTipCap())
+	require.Equal(t, gasFeeCap, tx.GasFeeCap())
+	require.Equal(t, calcBlobFeeCap(eip4844.CalcBlobFee(0)), tx.BlobGasFeeCap())
+	require.Equal(t, candidate.GasLimit, tx.Gas())
+	require.Equal(t, candidate.TxData, tx.Data())
+
+	commitment, err := blob.ComputeKZGCommitment()
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{eth.KZGToVersionedHash(commitment)}, tx.BlobHashes())
+	require.NotNil(t, tx.BlobTxSidecar())
+	require.Len(t, tx.BlobTxSidecar().Blobs, 1)
+	require.Equal(t, *blob.KZGBlob(), tx.BlobTxSidecar().Blobs[0])
+}
+
 // TestTxMgr_EstimateGas ensures that the tx manager will estimate
 // the gas when candidate gas limit is zero in [CraftTx].
 func TestTxMgr_EstimateGas(t *testing.T) {
@@ -692,6 +725,7 @@ type failingBackend struct {
 	returnSuccessBlockNumber bool
 	returnSuccessReceipt     bool
 	baseFee, gasTip          *big.Int
+	excessBlobGas            *uint64
 }
 
 // BlockNumber for the failingBackend returns errRpcFailure on the first
@@ -723,7 +757,8 @@ func (b *failingBackend) TransactionReceipt(
 
 func (b *failingBackend) HeaderByNumber(_ context.Context, _ *big.Int) (*types.Header, error) {
 	return &types.Header{
-		BaseFee: b.baseFee,
+		BaseFee:       b.baseFee,
+		ExcessBlobGas: b.excessBlobGas,
 	}, nil
 }
 
@@ -885,6 +920,56 @@ func TestIncreaseGasPrice(t *testing.T) {
 	}
 }
 
+// TestIncreaseGasPriceBlobTx asserts that the fees of blob txs, including the blob fee cap, are doubled,
+// as the blob pool requires of replacements.
+func TestIncreaseGasPriceBlobTx(t *testing.T) {
+	t.Parallel()
+	excessBlobGas := uint64(0)
+	borkedBackend := failingBackend{
+		gasTip:        big.NewInt(101),
+		baseFee:       big.NewInt(460),
+		excessBlobGas: &excessBlobGas,
+	}
+	mgr := &SimpleTxManager{
+		cfg: Config{
+			FeeLimitMultiplier: 5,
+			Signer: func(ctx context.Context, from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				return tx, nil
+			},
+		},
+		name:    "TEST",
+		backend: &borkedBackend,
+		l:       testlog.Logger(t, log.LvlCrit),
+		metr:    &metrics.NoopTxMetrics{},
+	}
+	tx := types.NewTx(&types.BlobTx{
+		ChainID:    uint256.NewInt(1),
+		GasTipCap:  uint256.NewInt(100),
+		GasFeeCap:  uint256.NewInt(1000),
+		Gas:        21_000,
+		BlobFeeCap: uint256.NewInt(4),
+		BlobHashes: []common.Hash{{0x01}},
+	})
+	newTx, err := mgr.increaseGasPrice(context.Background(), tx)
+	require.NoError(t, err)
+	require.Equal(t, uint8(types.BlobTxType), newTx.Type())
+	require.Equal(t, big.NewInt(200), newTx.GasTipCap())
+	require.Equal(t, big.NewInt(2000), newTx.GasFeeCap())
+	require.Equal(t, big.NewInt(8), newTx.BlobGasFeeCap())
+	require.Equal(t, tx.Gas(), newTx.Gas(), "the gas of blob txs is not re-estimated")
+	require.Equal(t, tx.BlobHashes(), newTx.BlobHashes())
+
+	// the fee limit applies to the blob fee cap too
+	tx = types.NewTx(&types.BlobTx{
+		ChainID:    uint256.NewInt(1),
+		GasTipCap:  uint256.NewInt(100),
+		GasFeeCap:  uint256.NewInt(1000),
+		BlobFeeCap: uint256.NewInt(100),
+	})
+	_, err = mgr.increaseGasPrice(context.Background(), tx)
+	require.ErrorContains(t, err, "bumped blob fee")
+}
+
 // TestIncreaseGasPriceNotExponential asserts that if the L1 basefee & tip remain the
 // same, repeated calls to IncreaseGasPrice do not continually increase the gas price.
 func TestIncreaseGasPriceNotExponential(t *testing.T) {